@@ -0,0 +1,5 @@
+// Package iqpuzzlerv1 holds the gRPC service of iq-puzzler serve, generated
+// from iqpuzzler.proto.
+package iqpuzzlerv1
+
+//go:generate protoc -I ../../.. --go_out=../../.. --go_opt=paths=source_relative --go-grpc_out=../../.. --go-grpc_opt=paths=source_relative api/iqpuzzler/v1/iqpuzzler.proto
@@ -0,0 +1,1143 @@
+// The gRPC form of the HTTP API of `iq-puzzler serve`, which serves it
+// with -grpc. The messages mirror the JSON DTOs of package iqpuzzler
+// (SolveRequest, SolveResponse, VerifyRequest, GenerateRequest) field by
+// field, so a server only has to copy them across.
+//
+// The Go code in this directory is generated from this file with
+// go generate, which needs protoc, protoc-gen-go and protoc-gen-go-grpc.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: api/iqpuzzler/v1/iqpuzzler.proto
+
+package iqpuzzlerv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Status int32
+
+const (
+	Status_STATUS_SOLVED     Status = 0
+	Status_STATUS_UNSOLVABLE Status = 1
+	Status_STATUS_ABORTED    Status = 2
+)
+
+// Enum value maps for Status.
+var (
+	Status_name = map[int32]string{
+		0: "STATUS_SOLVED",
+		1: "STATUS_UNSOLVABLE",
+		2: "STATUS_ABORTED",
+	}
+	Status_value = map[string]int32{
+		"STATUS_SOLVED":     0,
+		"STATUS_UNSOLVABLE": 1,
+		"STATUS_ABORTED":    2,
+	}
+)
+
+func (x Status) Enum() *Status {
+	p := new(Status)
+	*p = x
+	return p
+}
+
+func (x Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_iqpuzzler_v1_iqpuzzler_proto_enumTypes[0].Descriptor()
+}
+
+func (Status) Type() protoreflect.EnumType {
+	return &file_api_iqpuzzler_v1_iqpuzzler_proto_enumTypes[0]
+}
+
+func (x Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Status.Descriptor instead.
+func (Status) EnumDescriptor() ([]byte, []int) {
+	return file_api_iqpuzzler_v1_iqpuzzler_proto_rawDescGZIP(), []int{0}
+}
+
+// Pos is a cell as row and column.
+type Pos struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Col           int32                  `protobuf:"varint,2,opt,name=col,proto3" json:"col,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Pos) Reset() {
+	*x = Pos{}
+	mi := &file_api_iqpuzzler_v1_iqpuzzler_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pos) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos) ProtoMessage() {}
+
+func (x *Pos) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iqpuzzler_v1_iqpuzzler_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos.ProtoReflect.Descriptor instead.
+func (*Pos) Descriptor() ([]byte, []int) {
+	return file_api_iqpuzzler_v1_iqpuzzler_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Pos) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *Pos) GetCol() int32 {
+	if x != nil {
+		return x.Col
+	}
+	return 0
+}
+
+type SolveRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// preset names the board geometry, "standard" if empty.
+	Preset string `protobuf:"bytes,1,opt,name=preset,proto3" json:"preset,omitempty"`
+	// board is a board string in plain or compact form.
+	Board   string `protobuf:"bytes,2,opt,name=board,proto3" json:"board,omitempty"`
+	Lenient bool   `protobuf:"varint,3,opt,name=lenient,proto3" json:"lenient,omitempty"`
+	Wrap    bool   `protobuf:"varint,4,opt,name=wrap,proto3" json:"wrap,omitempty"`
+	Region  string `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"`
+	Set     string `protobuf:"bytes,6,opt,name=set,proto3" json:"set,omitempty"`
+	// pieces names the pieces to place, all pieces not on the board if empty.
+	Pieces       []string `protobuf:"bytes,7,rep,name=pieces,proto3" json:"pieces,omitempty"`
+	MaxSolutions int32    `protobuf:"varint,8,opt,name=max_solutions,json=maxSolutions,proto3" json:"max_solutions,omitempty"`
+	// timeout is a duration as accepted by time.ParseDuration.
+	Timeout     string `protobuf:"bytes,9,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	MaxNodes    int64  `protobuf:"varint,10,opt,name=max_nodes,json=maxNodes,proto3" json:"max_nodes,omitempty"`
+	Parallelism int32  `protobuf:"varint,11,opt,name=parallelism,proto3" json:"parallelism,omitempty"`
+	Strategy    string `protobuf:"bytes,12,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	Engine      string `protobuf:"bytes,13,opt,name=engine,proto3" json:"engine,omitempty"`
+	Paranoid    bool   `protobuf:"varint,14,opt,name=paranoid,proto3" json:"paranoid,omitempty"`
+	Shuffle     bool   `protobuf:"varint,15,opt,name=shuffle,proto3" json:"shuffle,omitempty"`
+	Seed        uint64 `protobuf:"varint,16,opt,name=seed,proto3" json:"seed,omitempty"`
+	// par_depth is the deepest placement parallel workers share, 0 for the
+	// default and -1 to pick it for the puzzle.
+	ParDepth      int32 `protobuf:"varint,17,opt,name=par_depth,json=parDepth,proto3" json:"par_depth,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SolveRequest) Reset() {
+	*x = SolveRequest{}
+	mi := &file_api_iqpuzzler_v1_iqpuzzler_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SolveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolveRequest) ProtoMessage() {}
+
+func (x *SolveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iqpuzzler_v1_iqpuzzler_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolveRequest.ProtoReflect.Descriptor instead.
+func (*SolveRequest) Descriptor() ([]byte, []int) {
+	return file_api_iqpuzzler_v1_iqpuzzler_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SolveRequest) GetPreset() string {
+	if x != nil {
+		return x.Preset
+	}
+	return ""
+}
+
+func (x *SolveRequest) GetBoard() string {
+	if x != nil {
+		return x.Board
+	}
+	return ""
+}
+
+func (x *SolveRequest) GetLenient() bool {
+	if x != nil {
+		return x.Lenient
+	}
+	return false
+}
+
+func (x *SolveRequest) GetWrap() bool {
+	if x != nil {
+		return x.Wrap
+	}
+	return false
+}
+
+func (x *SolveRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *SolveRequest) GetSet() string {
+	if x != nil {
+		return x.Set
+	}
+	return ""
+}
+
+func (x *SolveRequest) GetPieces() []string {
+	if x != nil {
+		return x.Pieces
+	}
+	return nil
+}
+
+func (x *SolveRequest) GetMaxSolutions() int32 {
+	if x != nil {
+		return x.MaxSolutions
+	}
+	return 0
+}
+
+func (x *SolveRequest) GetTimeout() string {
+	if x != nil {
+		return x.Timeout
+	}
+	return ""
+}
+
+func (x *SolveRequest) GetMaxNodes() int64 {
+	if x != nil {
+		return x.MaxNodes
+	}
+	return 0
+}
+
+func (x *SolveRequest) GetParallelism() int32 {
+	if x != nil {
+		return x.Parallelism
+	}
+	return 0
+}
+
+func (x *SolveRequest) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *SolveRequest) GetEngine() string {
+	if x != nil {
+		return x.Engine
+	}
+	return ""
+}
+
+func (x *SolveRequest) GetParanoid() bool {
+	if x != nil {
+		return x.Paranoid
+	}
+	return false
+}
+
+func (x *SolveRequest) GetShuffle() bool {
+	if x != nil {
+		return x.Shuffle
+	}
+	return false
+}
+
+func (x *SolveRequest) GetSeed() uint64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *SolveRequest) GetParDepth() int32 {
+	if x != nil {
+		return x.ParDepth
+	}
+	return 0
+}
+
+// Move is a piece placed on the board, as in the JSON form of a move.
+type Move struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Piece  string                 `protobuf:"bytes,1,opt,name=piece,proto3" json:"piece,omitempty"`
+	Letter string                 `protobuf:"bytes,2,opt,name=letter,proto3" json:"letter,omitempty"`
+	// transform names the orientation, e.g. "R90M".
+	Transform string `protobuf:"bytes,3,opt,name=transform,proto3" json:"transform,omitempty"`
+	Position  *Pos   `protobuf:"bytes,4,opt,name=position,proto3" json:"position,omitempty"`
+	Shape     []*Pos `protobuf:"bytes,5,rep,name=shape,proto3" json:"shape,omitempty"`
+	Cells     []*Pos `protobuf:"bytes,6,rep,name=cells,proto3" json:"cells,omitempty"`
+	// wrap holds the board dimensions for moves on wrapping boards.
+	Wrap          *Pos `protobuf:"bytes,7,opt,name=wrap,proto3" json:"wrap,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Move) Reset() {
+	*x = Move{}
+	mi := &file_api_iqpuzzler_v1_iqpuzzler_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Move) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Move) ProtoMessage() {}
+
+func (x *Move) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iqpuzzler_v1_iqpuzzler_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Move.ProtoReflect.Descriptor instead.
+func (*Move) Descriptor() ([]byte, []int) {
+	return file_api_iqpuzzler_v1_iqpuzzler_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Move) GetPiece() string {
+	if x != nil {
+		return x.Piece
+	}
+	return ""
+}
+
+func (x *Move) GetLetter() string {
+	if x != nil {
+		return x.Letter
+	}
+	return ""
+}
+
+func (x *Move) GetTransform() string {
+	if x != nil {
+		return x.Transform
+	}
+	return ""
+}
+
+func (x *Move) GetPosition() *Pos {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *Move) GetShape() []*Pos {
+	if x != nil {
+		return x.Shape
+	}
+	return nil
+}
+
+func (x *Move) GetCells() []*Pos {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+func (x *Move) GetWrap() *Pos {
+	if x != nil {
+		return x.Wrap
+	}
+	return nil
+}
+
+type Solution struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Moves         []*Move                `protobuf:"bytes,1,rep,name=moves,proto3" json:"moves,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Solution) Reset() {
+	*x = Solution{}
+	mi := &file_api_iqpuzzler_v1_iqpuzzler_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Solution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Solution) ProtoMessage() {}
+
+func (x *Solution) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iqpuzzler_v1_iqpuzzler_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Solution.ProtoReflect.Descriptor instead.
+func (*Solution) Descriptor() ([]byte, []int) {
+	return file_api_iqpuzzler_v1_iqpuzzler_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Solution) GetMoves() []*Move {
+	if x != nil {
+		return x.Moves
+	}
+	return nil
+}
+
+type Metrics struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nodes         int64                  `protobuf:"varint,1,opt,name=nodes,proto3" json:"nodes,omitempty"`
+	Backtracks    int64                  `protobuf:"varint,2,opt,name=backtracks,proto3" json:"backtracks,omitempty"`
+	MaxDepth      int32                  `protobuf:"varint,3,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	DurationNs    int64                  `protobuf:"varint,4,opt,name=duration_ns,json=durationNs,proto3" json:"duration_ns,omitempty"`
+	Prunes        map[string]int64       `protobuf:"bytes,5,rep,name=prunes,proto3" json:"prunes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Placements    int32                  `protobuf:"varint,6,opt,name=placements,proto3" json:"placements,omitempty"`
+	TableBytes    int64                  `protobuf:"varint,7,opt,name=table_bytes,json=tableBytes,proto3" json:"table_bytes,omitempty"`
+	Versions      int32                  `protobuf:"varint,8,opt,name=versions,proto3" json:"versions,omitempty"`
+	Transforms    int32                  `protobuf:"varint,9,opt,name=transforms,proto3" json:"transforms,omitempty"`
+	MemoryBytes   int64                  `protobuf:"varint,10,opt,name=memory_bytes,json=memoryBytes,proto3" json:"memory_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Metrics) Reset() {
+	*x = Metrics{}
+	mi := &file_api_iqpuzzler_v1_iqpuzzler_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Metrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Metrics) ProtoMessage() {}
+
+func (x *Metrics) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iqpuzzler_v1_iqpuzzler_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Metrics.ProtoReflect.Descriptor instead.
+func (*Metrics) Descriptor() ([]byte, []int) {
+	return file_api_iqpuzzler_v1_iqpuzzler_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Metrics) GetNodes() int64 {
+	if x != nil {
+		return x.Nodes
+	}
+	return 0
+}
+
+func (x *Metrics) GetBacktracks() int64 {
+	if x != nil {
+		return x.Backtracks
+	}
+	return 0
+}
+
+func (x *Metrics) GetMaxDepth() int32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+func (x *Metrics) GetDurationNs() int64 {
+	if x != nil {
+		return x.DurationNs
+	}
+	return 0
+}
+
+func (x *Metrics) GetPrunes() map[string]int64 {
+	if x != nil {
+		return x.Prunes
+	}
+	return nil
+}
+
+func (x *Metrics) GetPlacements() int32 {
+	if x != nil {
+		return x.Placements
+	}
+	return 0
+}
+
+func (x *Metrics) GetTableBytes() int64 {
+	if x != nil {
+		return x.TableBytes
+	}
+	return 0
+}
+
+func (x *Metrics) GetVersions() int32 {
+	if x != nil {
+		return x.Versions
+	}
+	return 0
+}
+
+func (x *Metrics) GetTransforms() int32 {
+	if x != nil {
+		return x.Transforms
+	}
+	return 0
+}
+
+func (x *Metrics) GetMemoryBytes() int64 {
+	if x != nil {
+		return x.MemoryBytes
+	}
+	return 0
+}
+
+type SolveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        Status                 `protobuf:"varint,1,opt,name=status,proto3,enum=iqpuzzler.v1.Status" json:"status,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Complete      bool                   `protobuf:"varint,3,opt,name=complete,proto3" json:"complete,omitempty"`
+	Solutions     []*Solution            `protobuf:"bytes,4,rep,name=solutions,proto3" json:"solutions,omitempty"`
+	Metrics       *Metrics               `protobuf:"bytes,5,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SolveResponse) Reset() {
+	*x = SolveResponse{}
+	mi := &file_api_iqpuzzler_v1_iqpuzzler_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SolveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolveResponse) ProtoMessage() {}
+
+func (x *SolveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iqpuzzler_v1_iqpuzzler_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolveResponse.ProtoReflect.Descriptor instead.
+func (*SolveResponse) Descriptor() ([]byte, []int) {
+	return file_api_iqpuzzler_v1_iqpuzzler_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SolveResponse) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_STATUS_SOLVED
+}
+
+func (x *SolveResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *SolveResponse) GetComplete() bool {
+	if x != nil {
+		return x.Complete
+	}
+	return false
+}
+
+func (x *SolveResponse) GetSolutions() []*Solution {
+	if x != nil {
+		return x.Solutions
+	}
+	return nil
+}
+
+func (x *SolveResponse) GetMetrics() *Metrics {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *SolveResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// SolutionsResponse carries either one solution or, in the last message of
+// the stream, the outcome of the search without its solutions.
+type SolutionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*SolutionsResponse_Solution
+	//	*SolutionsResponse_Done
+	Result        isSolutionsResponse_Result `protobuf_oneof:"result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SolutionsResponse) Reset() {
+	*x = SolutionsResponse{}
+	mi := &file_api_iqpuzzler_v1_iqpuzzler_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SolutionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolutionsResponse) ProtoMessage() {}
+
+func (x *SolutionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iqpuzzler_v1_iqpuzzler_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolutionsResponse.ProtoReflect.Descriptor instead.
+func (*SolutionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_iqpuzzler_v1_iqpuzzler_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SolutionsResponse) GetResult() isSolutionsResponse_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *SolutionsResponse) GetSolution() *Solution {
+	if x != nil {
+		if x, ok := x.Result.(*SolutionsResponse_Solution); ok {
+			return x.Solution
+		}
+	}
+	return nil
+}
+
+func (x *SolutionsResponse) GetDone() *SolveResponse {
+	if x != nil {
+		if x, ok := x.Result.(*SolutionsResponse_Done); ok {
+			return x.Done
+		}
+	}
+	return nil
+}
+
+type isSolutionsResponse_Result interface {
+	isSolutionsResponse_Result()
+}
+
+type SolutionsResponse_Solution struct {
+	Solution *Solution `protobuf:"bytes,1,opt,name=solution,proto3,oneof"`
+}
+
+type SolutionsResponse_Done struct {
+	Done *SolveResponse `protobuf:"bytes,2,opt,name=done,proto3,oneof"`
+}
+
+func (*SolutionsResponse_Solution) isSolutionsResponse_Result() {}
+
+func (*SolutionsResponse_Done) isSolutionsResponse_Result() {}
+
+type VerifyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Puzzle        *SolveRequest          `protobuf:"bytes,1,opt,name=puzzle,proto3" json:"puzzle,omitempty"`
+	Solution      *Solution              `protobuf:"bytes,2,opt,name=solution,proto3" json:"solution,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyRequest) Reset() {
+	*x = VerifyRequest{}
+	mi := &file_api_iqpuzzler_v1_iqpuzzler_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyRequest) ProtoMessage() {}
+
+func (x *VerifyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iqpuzzler_v1_iqpuzzler_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyRequest.ProtoReflect.Descriptor instead.
+func (*VerifyRequest) Descriptor() ([]byte, []int) {
+	return file_api_iqpuzzler_v1_iqpuzzler_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *VerifyRequest) GetPuzzle() *SolveRequest {
+	if x != nil {
+		return x.Puzzle
+	}
+	return nil
+}
+
+func (x *VerifyRequest) GetSolution() *Solution {
+	if x != nil {
+		return x.Solution
+	}
+	return nil
+}
+
+type VerifyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyResponse) Reset() {
+	*x = VerifyResponse{}
+	mi := &file_api_iqpuzzler_v1_iqpuzzler_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyResponse) ProtoMessage() {}
+
+func (x *VerifyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iqpuzzler_v1_iqpuzzler_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyResponse.ProtoReflect.Descriptor instead.
+func (*VerifyResponse) Descriptor() ([]byte, []int) {
+	return file_api_iqpuzzler_v1_iqpuzzler_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *VerifyResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *VerifyResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GenerateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Puzzle        *SolveRequest          `protobuf:"bytes,1,opt,name=puzzle,proto3" json:"puzzle,omitempty"`
+	Remove        int32                  `protobuf:"varint,2,opt,name=remove,proto3" json:"remove,omitempty"`
+	Unique        bool                   `protobuf:"varint,3,opt,name=unique,proto3" json:"unique,omitempty"`
+	Attempts      int32                  `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateRequest) Reset() {
+	*x = GenerateRequest{}
+	mi := &file_api_iqpuzzler_v1_iqpuzzler_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateRequest) ProtoMessage() {}
+
+func (x *GenerateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iqpuzzler_v1_iqpuzzler_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateRequest.ProtoReflect.Descriptor instead.
+func (*GenerateRequest) Descriptor() ([]byte, []int) {
+	return file_api_iqpuzzler_v1_iqpuzzler_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GenerateRequest) GetPuzzle() *SolveRequest {
+	if x != nil {
+		return x.Puzzle
+	}
+	return nil
+}
+
+func (x *GenerateRequest) GetRemove() int32 {
+	if x != nil {
+		return x.Remove
+	}
+	return 0
+}
+
+func (x *GenerateRequest) GetUnique() bool {
+	if x != nil {
+		return x.Unique
+	}
+	return false
+}
+
+func (x *GenerateRequest) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+type GenerateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// puzzle may be passed to Solve as it is.
+	Puzzle        *SolveRequest `protobuf:"bytes,1,opt,name=puzzle,proto3" json:"puzzle,omitempty"`
+	Solution      *Solution     `protobuf:"bytes,2,opt,name=solution,proto3" json:"solution,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateResponse) Reset() {
+	*x = GenerateResponse{}
+	mi := &file_api_iqpuzzler_v1_iqpuzzler_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateResponse) ProtoMessage() {}
+
+func (x *GenerateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_iqpuzzler_v1_iqpuzzler_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateResponse.ProtoReflect.Descriptor instead.
+func (*GenerateResponse) Descriptor() ([]byte, []int) {
+	return file_api_iqpuzzler_v1_iqpuzzler_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GenerateResponse) GetPuzzle() *SolveRequest {
+	if x != nil {
+		return x.Puzzle
+	}
+	return nil
+}
+
+func (x *GenerateResponse) GetSolution() *Solution {
+	if x != nil {
+		return x.Solution
+	}
+	return nil
+}
+
+var File_api_iqpuzzler_v1_iqpuzzler_proto protoreflect.FileDescriptor
+
+const file_api_iqpuzzler_v1_iqpuzzler_proto_rawDesc = "" +
+	"\n" +
+	" api/iqpuzzler/v1/iqpuzzler.proto\x12\fiqpuzzler.v1\")\n" +
+	"\x03Pos\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x10\n" +
+	"\x03col\x18\x02 \x01(\x05R\x03col\"\xc5\x03\n" +
+	"\fSolveRequest\x12\x16\n" +
+	"\x06preset\x18\x01 \x01(\tR\x06preset\x12\x14\n" +
+	"\x05board\x18\x02 \x01(\tR\x05board\x12\x18\n" +
+	"\alenient\x18\x03 \x01(\bR\alenient\x12\x12\n" +
+	"\x04wrap\x18\x04 \x01(\bR\x04wrap\x12\x16\n" +
+	"\x06region\x18\x05 \x01(\tR\x06region\x12\x10\n" +
+	"\x03set\x18\x06 \x01(\tR\x03set\x12\x16\n" +
+	"\x06pieces\x18\a \x03(\tR\x06pieces\x12#\n" +
+	"\rmax_solutions\x18\b \x01(\x05R\fmaxSolutions\x12\x18\n" +
+	"\atimeout\x18\t \x01(\tR\atimeout\x12\x1b\n" +
+	"\tmax_nodes\x18\n" +
+	" \x01(\x03R\bmaxNodes\x12 \n" +
+	"\vparallelism\x18\v \x01(\x05R\vparallelism\x12\x1a\n" +
+	"\bstrategy\x18\f \x01(\tR\bstrategy\x12\x16\n" +
+	"\x06engine\x18\r \x01(\tR\x06engine\x12\x1a\n" +
+	"\bparanoid\x18\x0e \x01(\bR\bparanoid\x12\x18\n" +
+	"\ashuffle\x18\x0f \x01(\bR\ashuffle\x12\x12\n" +
+	"\x04seed\x18\x10 \x01(\x04R\x04seed\x12\x1b\n" +
+	"\tpar_depth\x18\x11 \x01(\x05R\bparDepth\"\xfa\x01\n" +
+	"\x04Move\x12\x14\n" +
+	"\x05piece\x18\x01 \x01(\tR\x05piece\x12\x16\n" +
+	"\x06letter\x18\x02 \x01(\tR\x06letter\x12\x1c\n" +
+	"\ttransform\x18\x03 \x01(\tR\ttransform\x12-\n" +
+	"\bposition\x18\x04 \x01(\v2\x11.iqpuzzler.v1.PosR\bposition\x12'\n" +
+	"\x05shape\x18\x05 \x03(\v2\x11.iqpuzzler.v1.PosR\x05shape\x12'\n" +
+	"\x05cells\x18\x06 \x03(\v2\x11.iqpuzzler.v1.PosR\x05cells\x12%\n" +
+	"\x04wrap\x18\a \x01(\v2\x11.iqpuzzler.v1.PosR\x04wrap\"4\n" +
+	"\bSolution\x12(\n" +
+	"\x05moves\x18\x01 \x03(\v2\x12.iqpuzzler.v1.MoveR\x05moves\"\x93\x03\n" +
+	"\aMetrics\x12\x14\n" +
+	"\x05nodes\x18\x01 \x01(\x03R\x05nodes\x12\x1e\n" +
+	"\n" +
+	"backtracks\x18\x02 \x01(\x03R\n" +
+	"backtracks\x12\x1b\n" +
+	"\tmax_depth\x18\x03 \x01(\x05R\bmaxDepth\x12\x1f\n" +
+	"\vduration_ns\x18\x04 \x01(\x03R\n" +
+	"durationNs\x129\n" +
+	"\x06prunes\x18\x05 \x03(\v2!.iqpuzzler.v1.Metrics.PrunesEntryR\x06prunes\x12\x1e\n" +
+	"\n" +
+	"placements\x18\x06 \x01(\x05R\n" +
+	"placements\x12\x1f\n" +
+	"\vtable_bytes\x18\a \x01(\x03R\n" +
+	"tableBytes\x12\x1a\n" +
+	"\bversions\x18\b \x01(\x05R\bversions\x12\x1e\n" +
+	"\n" +
+	"transforms\x18\t \x01(\x05R\n" +
+	"transforms\x12!\n" +
+	"\fmemory_bytes\x18\n" +
+	" \x01(\x03R\vmemoryBytes\x1a9\n" +
+	"\vPrunesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\xec\x01\n" +
+	"\rSolveResponse\x12,\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x14.iqpuzzler.v1.StatusR\x06status\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12\x1a\n" +
+	"\bcomplete\x18\x03 \x01(\bR\bcomplete\x124\n" +
+	"\tsolutions\x18\x04 \x03(\v2\x16.iqpuzzler.v1.SolutionR\tsolutions\x12/\n" +
+	"\ametrics\x18\x05 \x01(\v2\x15.iqpuzzler.v1.MetricsR\ametrics\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\"\x86\x01\n" +
+	"\x11SolutionsResponse\x124\n" +
+	"\bsolution\x18\x01 \x01(\v2\x16.iqpuzzler.v1.SolutionH\x00R\bsolution\x121\n" +
+	"\x04done\x18\x02 \x01(\v2\x1b.iqpuzzler.v1.SolveResponseH\x00R\x04doneB\b\n" +
+	"\x06result\"w\n" +
+	"\rVerifyRequest\x122\n" +
+	"\x06puzzle\x18\x01 \x01(\v2\x1a.iqpuzzler.v1.SolveRequestR\x06puzzle\x122\n" +
+	"\bsolution\x18\x02 \x01(\v2\x16.iqpuzzler.v1.SolutionR\bsolution\"<\n" +
+	"\x0eVerifyResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\x91\x01\n" +
+	"\x0fGenerateRequest\x122\n" +
+	"\x06puzzle\x18\x01 \x01(\v2\x1a.iqpuzzler.v1.SolveRequestR\x06puzzle\x12\x16\n" +
+	"\x06remove\x18\x02 \x01(\x05R\x06remove\x12\x16\n" +
+	"\x06unique\x18\x03 \x01(\bR\x06unique\x12\x1a\n" +
+	"\battempts\x18\x04 \x01(\x05R\battempts\"z\n" +
+	"\x10GenerateResponse\x122\n" +
+	"\x06puzzle\x18\x01 \x01(\v2\x1a.iqpuzzler.v1.SolveRequestR\x06puzzle\x122\n" +
+	"\bsolution\x18\x02 \x01(\v2\x16.iqpuzzler.v1.SolutionR\bsolution*F\n" +
+	"\x06Status\x12\x11\n" +
+	"\rSTATUS_SOLVED\x10\x00\x12\x15\n" +
+	"\x11STATUS_UNSOLVABLE\x10\x01\x12\x12\n" +
+	"\x0eSTATUS_ABORTED\x10\x022\xa7\x02\n" +
+	"\aPuzzler\x12@\n" +
+	"\x05Solve\x12\x1a.iqpuzzler.v1.SolveRequest\x1a\x1b.iqpuzzler.v1.SolveResponse\x12J\n" +
+	"\tSolutions\x12\x1a.iqpuzzler.v1.SolveRequest\x1a\x1f.iqpuzzler.v1.SolutionsResponse0\x01\x12C\n" +
+	"\x06Verify\x12\x1b.iqpuzzler.v1.VerifyRequest\x1a\x1c.iqpuzzler.v1.VerifyResponse\x12I\n" +
+	"\bGenerate\x12\x1d.iqpuzzler.v1.GenerateRequest\x1a\x1e.iqpuzzler.v1.GenerateResponseB%Z#smaart/api/iqpuzzler/v1;iqpuzzlerv1b\x06proto3"
+
+var (
+	file_api_iqpuzzler_v1_iqpuzzler_proto_rawDescOnce sync.Once
+	file_api_iqpuzzler_v1_iqpuzzler_proto_rawDescData []byte
+)
+
+func file_api_iqpuzzler_v1_iqpuzzler_proto_rawDescGZIP() []byte {
+	file_api_iqpuzzler_v1_iqpuzzler_proto_rawDescOnce.Do(func() {
+		file_api_iqpuzzler_v1_iqpuzzler_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_iqpuzzler_v1_iqpuzzler_proto_rawDesc), len(file_api_iqpuzzler_v1_iqpuzzler_proto_rawDesc)))
+	})
+	return file_api_iqpuzzler_v1_iqpuzzler_proto_rawDescData
+}
+
+var file_api_iqpuzzler_v1_iqpuzzler_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_iqpuzzler_v1_iqpuzzler_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_api_iqpuzzler_v1_iqpuzzler_proto_goTypes = []any{
+	(Status)(0),               // 0: iqpuzzler.v1.Status
+	(*Pos)(nil),               // 1: iqpuzzler.v1.Pos
+	(*SolveRequest)(nil),      // 2: iqpuzzler.v1.SolveRequest
+	(*Move)(nil),              // 3: iqpuzzler.v1.Move
+	(*Solution)(nil),          // 4: iqpuzzler.v1.Solution
+	(*Metrics)(nil),           // 5: iqpuzzler.v1.Metrics
+	(*SolveResponse)(nil),     // 6: iqpuzzler.v1.SolveResponse
+	(*SolutionsResponse)(nil), // 7: iqpuzzler.v1.SolutionsResponse
+	(*VerifyRequest)(nil),     // 8: iqpuzzler.v1.VerifyRequest
+	(*VerifyResponse)(nil),    // 9: iqpuzzler.v1.VerifyResponse
+	(*GenerateRequest)(nil),   // 10: iqpuzzler.v1.GenerateRequest
+	(*GenerateResponse)(nil),  // 11: iqpuzzler.v1.GenerateResponse
+	nil,                       // 12: iqpuzzler.v1.Metrics.PrunesEntry
+}
+var file_api_iqpuzzler_v1_iqpuzzler_proto_depIdxs = []int32{
+	1,  // 0: iqpuzzler.v1.Move.position:type_name -> iqpuzzler.v1.Pos
+	1,  // 1: iqpuzzler.v1.Move.shape:type_name -> iqpuzzler.v1.Pos
+	1,  // 2: iqpuzzler.v1.Move.cells:type_name -> iqpuzzler.v1.Pos
+	1,  // 3: iqpuzzler.v1.Move.wrap:type_name -> iqpuzzler.v1.Pos
+	3,  // 4: iqpuzzler.v1.Solution.moves:type_name -> iqpuzzler.v1.Move
+	12, // 5: iqpuzzler.v1.Metrics.prunes:type_name -> iqpuzzler.v1.Metrics.PrunesEntry
+	0,  // 6: iqpuzzler.v1.SolveResponse.status:type_name -> iqpuzzler.v1.Status
+	4,  // 7: iqpuzzler.v1.SolveResponse.solutions:type_name -> iqpuzzler.v1.Solution
+	5,  // 8: iqpuzzler.v1.SolveResponse.metrics:type_name -> iqpuzzler.v1.Metrics
+	4,  // 9: iqpuzzler.v1.SolutionsResponse.solution:type_name -> iqpuzzler.v1.Solution
+	6,  // 10: iqpuzzler.v1.SolutionsResponse.done:type_name -> iqpuzzler.v1.SolveResponse
+	2,  // 11: iqpuzzler.v1.VerifyRequest.puzzle:type_name -> iqpuzzler.v1.SolveRequest
+	4,  // 12: iqpuzzler.v1.VerifyRequest.solution:type_name -> iqpuzzler.v1.Solution
+	2,  // 13: iqpuzzler.v1.GenerateRequest.puzzle:type_name -> iqpuzzler.v1.SolveRequest
+	2,  // 14: iqpuzzler.v1.GenerateResponse.puzzle:type_name -> iqpuzzler.v1.SolveRequest
+	4,  // 15: iqpuzzler.v1.GenerateResponse.solution:type_name -> iqpuzzler.v1.Solution
+	2,  // 16: iqpuzzler.v1.Puzzler.Solve:input_type -> iqpuzzler.v1.SolveRequest
+	2,  // 17: iqpuzzler.v1.Puzzler.Solutions:input_type -> iqpuzzler.v1.SolveRequest
+	8,  // 18: iqpuzzler.v1.Puzzler.Verify:input_type -> iqpuzzler.v1.VerifyRequest
+	10, // 19: iqpuzzler.v1.Puzzler.Generate:input_type -> iqpuzzler.v1.GenerateRequest
+	6,  // 20: iqpuzzler.v1.Puzzler.Solve:output_type -> iqpuzzler.v1.SolveResponse
+	7,  // 21: iqpuzzler.v1.Puzzler.Solutions:output_type -> iqpuzzler.v1.SolutionsResponse
+	9,  // 22: iqpuzzler.v1.Puzzler.Verify:output_type -> iqpuzzler.v1.VerifyResponse
+	11, // 23: iqpuzzler.v1.Puzzler.Generate:output_type -> iqpuzzler.v1.GenerateResponse
+	20, // [20:24] is the sub-list for method output_type
+	16, // [16:20] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_api_iqpuzzler_v1_iqpuzzler_proto_init() }
+func file_api_iqpuzzler_v1_iqpuzzler_proto_init() {
+	if File_api_iqpuzzler_v1_iqpuzzler_proto != nil {
+		return
+	}
+	file_api_iqpuzzler_v1_iqpuzzler_proto_msgTypes[6].OneofWrappers = []any{
+		(*SolutionsResponse_Solution)(nil),
+		(*SolutionsResponse_Done)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_iqpuzzler_v1_iqpuzzler_proto_rawDesc), len(file_api_iqpuzzler_v1_iqpuzzler_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_iqpuzzler_v1_iqpuzzler_proto_goTypes,
+		DependencyIndexes: file_api_iqpuzzler_v1_iqpuzzler_proto_depIdxs,
+		EnumInfos:         file_api_iqpuzzler_v1_iqpuzzler_proto_enumTypes,
+		MessageInfos:      file_api_iqpuzzler_v1_iqpuzzler_proto_msgTypes,
+	}.Build()
+	File_api_iqpuzzler_v1_iqpuzzler_proto = out.File
+	file_api_iqpuzzler_v1_iqpuzzler_proto_goTypes = nil
+	file_api_iqpuzzler_v1_iqpuzzler_proto_depIdxs = nil
+}
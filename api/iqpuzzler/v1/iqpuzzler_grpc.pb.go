@@ -0,0 +1,255 @@
+// The gRPC form of the HTTP API of `iq-puzzler serve`, which serves it
+// with -grpc. The messages mirror the JSON DTOs of package iqpuzzler
+// (SolveRequest, SolveResponse, VerifyRequest, GenerateRequest) field by
+// field, so a server only has to copy them across.
+//
+// The Go code in this directory is generated from this file with
+// go generate, which needs protoc, protoc-gen-go and protoc-gen-go-grpc.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: api/iqpuzzler/v1/iqpuzzler.proto
+
+package iqpuzzlerv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Puzzler_Solve_FullMethodName     = "/iqpuzzler.v1.Puzzler/Solve"
+	Puzzler_Solutions_FullMethodName = "/iqpuzzler.v1.Puzzler/Solutions"
+	Puzzler_Verify_FullMethodName    = "/iqpuzzler.v1.Puzzler/Verify"
+	Puzzler_Generate_FullMethodName  = "/iqpuzzler.v1.Puzzler/Generate"
+)
+
+// PuzzlerClient is the client API for Puzzler service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PuzzlerClient interface {
+	// Solve searches the board and returns the solutions at once.
+	Solve(ctx context.Context, in *SolveRequest, opts ...grpc.CallOption) (*SolveResponse, error)
+	// Solutions streams the solutions as the search finds them, followed by
+	// a last message with the outcome. Cancelling the call stops the search;
+	// the deadline of the call bounds it like SolveRequest.timeout.
+	Solutions(ctx context.Context, in *SolveRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SolutionsResponse], error)
+	Verify(ctx context.Context, in *VerifyRequest, opts ...grpc.CallOption) (*VerifyResponse, error)
+	Generate(ctx context.Context, in *GenerateRequest, opts ...grpc.CallOption) (*GenerateResponse, error)
+}
+
+type puzzlerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPuzzlerClient(cc grpc.ClientConnInterface) PuzzlerClient {
+	return &puzzlerClient{cc}
+}
+
+func (c *puzzlerClient) Solve(ctx context.Context, in *SolveRequest, opts ...grpc.CallOption) (*SolveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SolveResponse)
+	err := c.cc.Invoke(ctx, Puzzler_Solve_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *puzzlerClient) Solutions(ctx context.Context, in *SolveRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SolutionsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Puzzler_ServiceDesc.Streams[0], Puzzler_Solutions_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SolveRequest, SolutionsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Puzzler_SolutionsClient = grpc.ServerStreamingClient[SolutionsResponse]
+
+func (c *puzzlerClient) Verify(ctx context.Context, in *VerifyRequest, opts ...grpc.CallOption) (*VerifyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyResponse)
+	err := c.cc.Invoke(ctx, Puzzler_Verify_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *puzzlerClient) Generate(ctx context.Context, in *GenerateRequest, opts ...grpc.CallOption) (*GenerateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateResponse)
+	err := c.cc.Invoke(ctx, Puzzler_Generate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PuzzlerServer is the server API for Puzzler service.
+// All implementations must embed UnimplementedPuzzlerServer
+// for forward compatibility.
+type PuzzlerServer interface {
+	// Solve searches the board and returns the solutions at once.
+	Solve(context.Context, *SolveRequest) (*SolveResponse, error)
+	// Solutions streams the solutions as the search finds them, followed by
+	// a last message with the outcome. Cancelling the call stops the search;
+	// the deadline of the call bounds it like SolveRequest.timeout.
+	Solutions(*SolveRequest, grpc.ServerStreamingServer[SolutionsResponse]) error
+	Verify(context.Context, *VerifyRequest) (*VerifyResponse, error)
+	Generate(context.Context, *GenerateRequest) (*GenerateResponse, error)
+	mustEmbedUnimplementedPuzzlerServer()
+}
+
+// UnimplementedPuzzlerServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPuzzlerServer struct{}
+
+func (UnimplementedPuzzlerServer) Solve(context.Context, *SolveRequest) (*SolveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Solve not implemented")
+}
+func (UnimplementedPuzzlerServer) Solutions(*SolveRequest, grpc.ServerStreamingServer[SolutionsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Solutions not implemented")
+}
+func (UnimplementedPuzzlerServer) Verify(context.Context, *VerifyRequest) (*VerifyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Verify not implemented")
+}
+func (UnimplementedPuzzlerServer) Generate(context.Context, *GenerateRequest) (*GenerateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Generate not implemented")
+}
+func (UnimplementedPuzzlerServer) mustEmbedUnimplementedPuzzlerServer() {}
+func (UnimplementedPuzzlerServer) testEmbeddedByValue()                 {}
+
+// UnsafePuzzlerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PuzzlerServer will
+// result in compilation errors.
+type UnsafePuzzlerServer interface {
+	mustEmbedUnimplementedPuzzlerServer()
+}
+
+func RegisterPuzzlerServer(s grpc.ServiceRegistrar, srv PuzzlerServer) {
+	// If the following call pancis, it indicates UnimplementedPuzzlerServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Puzzler_ServiceDesc, srv)
+}
+
+func _Puzzler_Solve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SolveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PuzzlerServer).Solve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Puzzler_Solve_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PuzzlerServer).Solve(ctx, req.(*SolveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Puzzler_Solutions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SolveRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PuzzlerServer).Solutions(m, &grpc.GenericServerStream[SolveRequest, SolutionsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Puzzler_SolutionsServer = grpc.ServerStreamingServer[SolutionsResponse]
+
+func _Puzzler_Verify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PuzzlerServer).Verify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Puzzler_Verify_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PuzzlerServer).Verify(ctx, req.(*VerifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Puzzler_Generate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PuzzlerServer).Generate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Puzzler_Generate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PuzzlerServer).Generate(ctx, req.(*GenerateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Puzzler_ServiceDesc is the grpc.ServiceDesc for Puzzler service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Puzzler_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "iqpuzzler.v1.Puzzler",
+	HandlerType: (*PuzzlerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Solve",
+			Handler:    _Puzzler_Solve_Handler,
+		},
+		{
+			MethodName: "Verify",
+			Handler:    _Puzzler_Verify_Handler,
+		},
+		{
+			MethodName: "Generate",
+			Handler:    _Puzzler_Generate_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Solutions",
+			Handler:       _Puzzler_Solutions_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/iqpuzzler/v1/iqpuzzler.proto",
+}
@@ -0,0 +1,132 @@
+//go:build js && wasm
+
+// Command iq-puzzler-wasm runs the solver in a browser. Build it with
+//
+//	GOOS=js GOARCH=wasm go build -o iq-puzzler.wasm ./cmd/iq-puzzler-wasm
+//
+// and load it with the wasm_exec.js of the Go distribution, as index.html
+// does. It defines a global object iqpuzzler with a single function:
+//
+//	iqpuzzler.solve(board, pieces, options) → Promise<string>
+//
+// board is a board string in plain or compact form, pieces a comma
+// separated list of piece names (empty for the pieces not on the board) and
+// options the JSON of the remaining fields of a SolveRequest, such as
+// {"preset": "mini", "max_solutions": 1, "max_nodes": 1000000}. The promise
+// resolves to the JSON of a SolveResponse, or is rejected with an Error if
+// the request is invalid.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync/atomic"
+	"syscall/js"
+	"time"
+
+	"smaart/iqpuzzler"
+)
+
+// yieldInterval is the longest the search runs before it lets the browser
+// handle its events.
+var yieldInterval = 50 * time.Millisecond
+
+func main() {
+	js.Global().Set("iqpuzzler", js.ValueOf(map[string]any{
+		"solve": js.FuncOf(solve),
+	}))
+	select {}
+}
+
+// solve is iqpuzzler.solve. The search runs in its own goroutine, since a
+// function called from JavaScript must return before other goroutines run.
+func solve(this js.Value, args []js.Value) any {
+	var board, pieces, options string
+	if len(args) > 0 {
+		board = args[0].String()
+	}
+	if len(args) > 1 {
+		pieces = args[1].String()
+	}
+	if len(args) > 2 {
+		options = args[2].String()
+	}
+	var handler = js.FuncOf(func(this js.Value, p []js.Value) any {
+		var resolve, reject = p[0], p[1]
+		go func() {
+			res, err := run(board, pieces, options)
+			if err != nil {
+				reject.Invoke(js.Global().Get("Error").New(err.Error()))
+				return
+			}
+			resolve.Invoke(res)
+		}()
+		return nil
+	})
+	defer handler.Release()
+	return js.Global().Get("Promise").New(handler)
+}
+
+// run answers a call of iqpuzzler.solve with the JSON of the response.
+func run(board, pieces, options string) (string, error) {
+	var req iqpuzzler.SolveRequest
+	if options != "" {
+		var dec = json.NewDecoder(strings.NewReader(options))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&req); err != nil {
+			return "", err
+		}
+	}
+	if req.Parallelism == 0 {
+		// WebAssembly runs on a single thread, where more workers only
+		// keep the search from handing control back in yield.
+		req.Parallelism = 1
+	}
+	req.Board = board
+	req.Pieces = nil
+	for _, n := range strings.Split(pieces, ",") {
+		if n = strings.TrimSpace(n); n != "" {
+			req.Pieces = append(req.Pieces, n)
+		}
+	}
+	if err := req.Validate(); err != nil {
+		return "", err
+	}
+	reg, err := req.Registry()
+	if err != nil {
+		return "", err
+	}
+	b, ps, err := req.Puzzle(reg)
+	if err != nil {
+		return "", err
+	}
+	opts, err := req.Options()
+	if err != nil {
+		return "", err
+	}
+	solver, err := iqpuzzler.NewSolver(append(opts, iqpuzzler.WithYield(yielder()))...)
+	if err != nil {
+		return "", err
+	}
+	res, err := solver.Solve(context.Background(), iqpuzzler.NewGame(b), ps)
+	out, jerr := json.Marshal(iqpuzzler.NewSolveResponse(res, err))
+	if jerr != nil {
+		return "", jerr
+	}
+	return string(out), nil
+}
+
+// yielder returns a yield function for the search which sleeps briefly once
+// every yieldInterval. With a single worker, sleeping leaves no goroutine to
+// run, which hands control back to the browser until the timer fires.
+func yielder() func() {
+	var last atomic.Int64
+	last.Store(time.Now().UnixNano())
+	return func() {
+		var now = time.Now().UnixNano()
+		if prev := last.Load(); time.Duration(now-prev) >= yieldInterval && last.CompareAndSwap(prev, now) {
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
@@ -0,0 +1,342 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"runtime"
+	"strconv"
+	"sync"
+	"time"
+
+	"smaart/iqpuzzler"
+)
+
+// batchPuzzle is a puzzle of the batch input: a solve request with a name.
+type batchPuzzle struct {
+	Name string `json:"name,omitempty"`
+	iqpuzzler.SolveRequest
+}
+
+// batchRow is the outcome of one puzzle, a row of the summary.
+type batchRow struct {
+	name   string
+	res    iqpuzzler.SolveResult
+	status string
+	err    error
+	// hash is the board hash of the puzzle and tier the tier of the
+	// official challenge it is, if any.
+	hash, tier string
+}
+
+var batchHeader = []string{"name", "status", "solutions", "complete", "nodes", "duration", "error", "hash", "tier"}
+
+func newBatchCommand() (*flag.FlagSet, func(args []string)) {
+	var (
+		fs         = newFlagSet("batch", "")
+		gf         = addGlobalFlags(fs, "")
+		input      = fs.String("input", "", "the puzzles, as a JSON array of solve requests with a name or one request per line")
+		summary    = fs.String("summary", "", "write a CSV row per puzzle to this file as it finishes")
+		j          = fs.Int("j", runtime.GOMAXPROCS(0), "the number of puzzles solved concurrently")
+		timeoutPer = fs.Duration("timeout-per", time.Minute, "the longest to search one puzzle, 0 for no limit")
+		maxSol     = fs.Int("max-solutions", 0, "stop each puzzle after this many solutions, 0 to count them all")
+		cf         = addCompletionFlags(fs)
+		tableDir   = addTableCacheFlag(fs)
+		noDead     = fs.Bool("no-dead-states", false, "search each puzzle on its own, without the states found to have no solution by the others")
+	)
+	return fs, func(args []string) {
+		parseFlags(fs, args)
+		if *input == "" || *j < 1 {
+			fs.Usage()
+			os.Exit(exitUsage)
+		}
+		var logger = gf.logger()
+		cf.setup("batch", logger)
+		puzzles, err := readBatch(*input)
+		if err != nil {
+			exit(err)
+		}
+		var sw *csv.Writer
+		if *summary != "" {
+			f, err := os.Create(*summary)
+			if err != nil {
+				exit(err)
+			}
+			atExit(func() { f.Close() })
+			sw = csv.NewWriter(f)
+			if err := writeRow(sw, batchHeader); err != nil {
+				exit(err)
+			}
+		}
+		var (
+			ctx, stop = interruptContext()
+			todo      = make(chan int)
+			rows      = make(chan batchRow)
+			wg        sync.WaitGroup
+			start     = time.Now()
+			tables    = newTableCache(*tableDir)
+			dead      *iqpuzzler.DeadStates
+		)
+		if !*noDead {
+			dead = iqpuzzler.NewDeadStates(deadStatesBytes)
+		}
+		defer stop()
+		for i := 0; i < *j; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := range todo {
+					rows <- solveBatch(ctx, puzzles[i], *timeoutPer, *maxSol, tables, dead, logger)
+				}
+			}()
+		}
+		go func() {
+			defer close(todo)
+			for i := range puzzles {
+				select {
+				case todo <- i:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+		go func() {
+			wg.Wait()
+			close(rows)
+		}()
+		var stats batchStats
+		for r := range rows {
+			stats.add(r)
+			console.printf(levelSummary, "%s: %s, %d solutions, %d nodes, %s\n", r.name, r.status, r.res.Count, r.res.Metrics.Nodes, r.res.Metrics.Duration.Round(time.Millisecond))
+			if r.err != nil {
+				logger.Warn("puzzle failed", slog.String("name", r.name), slog.Any("error", r.err))
+			}
+			if sw != nil {
+				if err := writeRow(sw, r.record()); err != nil {
+					exit(err)
+				}
+			}
+		}
+		stats.print(len(puzzles), time.Since(start))
+		if dead != nil {
+			printDeadStates(dead.Stats())
+		}
+		var status = "done"
+		if ctx.Err() != nil {
+			status = iqpuzzler.Aborted.String()
+		}
+		completion.record(status, stats.done, stats.done == len(puzzles), stats.nodes)
+		if ctx.Err() != nil {
+			exitWith(exitAborted)
+		}
+	}
+}
+
+// readBatch reads the puzzles of the file, naming those without a name by
+// their position.
+func readBatch(path string) ([]batchPuzzle, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return parseBatch(path, data)
+}
+
+// parseBatch parses puzzles in the format of batch input files, the file
+// being named name in errors.
+func parseBatch(path string, data []byte) ([]batchPuzzle, error) {
+	var (
+		dec = json.NewDecoder(bytes.NewReader(data))
+		res []batchPuzzle
+	)
+	dec.DisallowUnknownFields()
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		if err := dec.Decode(&res); err != nil {
+			return nil, fmt.Errorf("%s: %v", path, err)
+		}
+	} else {
+		for {
+			var p batchPuzzle
+			if err := dec.Decode(&p); err == io.EOF {
+				break
+			} else if err != nil {
+				return nil, fmt.Errorf("%s: puzzle %d: %v", path, len(res)+1, err)
+			}
+			res = append(res, p)
+		}
+	}
+	for i := range res {
+		if res[i].Name == "" {
+			res[i].Name = "#" + strconv.Itoa(i+1)
+		}
+	}
+	return res, nil
+}
+
+// solveBatch solves one puzzle on its own game with the budget of the
+// batch and the placement tables and, if dead is not nil, the dead states
+// shared by its puzzles, reporting failures in the row instead of exiting.
+func solveBatch(ctx context.Context, p batchPuzzle, timeout time.Duration, maxSol int, tables *iqpuzzler.TableCache, dead *iqpuzzler.DeadStates, logger *slog.Logger) batchRow {
+	var (
+		row = batchRow{name: p.Name, status: "error"}
+		req = p.SolveRequest
+	)
+	row.hash, row.tier = identifyRequest(req)
+	req.MaxSolutions, req.Parallelism, req.Timeout = maxSol, 1, ""
+	if timeout > 0 {
+		req.Timeout = timeout.String()
+	}
+	var opts = []iqpuzzler.Option{iqpuzzler.WithTableCache(tables)}
+	if dead != nil {
+		opts = append(opts, iqpuzzler.WithDeadStates(dead))
+	}
+	res, err := solveRequest(ctx, req, logger, opts...)
+	var ae *iqpuzzler.AbortedError
+	if err != nil && !errors.As(err, &ae) {
+		row.err = err
+		return row
+	}
+	row.res, row.status = res, res.Status.String()
+	return row
+}
+
+// solveRequest counts the solutions of the request without keeping them,
+// with the extra options on top of those of the request. Like Solve, it returns an *AbortedError along with the result if ctx is
+// cancelled.
+func solveRequest(ctx context.Context, req iqpuzzler.SolveRequest, logger *slog.Logger, extra ...iqpuzzler.Option) (iqpuzzler.SolveResult, error) {
+	if err := req.Validate(); err != nil {
+		return iqpuzzler.SolveResult{}, err
+	}
+	reg, err := req.Registry()
+	if err != nil {
+		return iqpuzzler.SolveResult{}, err
+	}
+	b, ps, err := req.Puzzle(reg)
+	if err != nil {
+		return iqpuzzler.SolveResult{}, err
+	}
+	opts, err := req.Options()
+	if err != nil {
+		return iqpuzzler.SolveResult{}, err
+	}
+	opts = append(append(opts, extra...), iqpuzzler.WithLogger(logger), iqpuzzler.WithOnSolution(func(iqpuzzler.Solution) {}))
+	s, err := iqpuzzler.NewSolver(opts...)
+	if err != nil {
+		return iqpuzzler.SolveResult{}, err
+	}
+	return s.Solve(ctx, iqpuzzler.NewGame(b), ps)
+}
+
+// identifyRequest returns the board hash of the puzzle of the request and
+// the tier of the official challenge it is, both empty if the request does
+// not parse.
+func identifyRequest(req iqpuzzler.SolveRequest) (hash, tier string) {
+	if req.Preset == "" {
+		req.Preset = "standard"
+	}
+	preset, _ := iqpuzzler.LookupPreset(req.Preset)
+	if req.Set == "" {
+		req.Set = preset.Set
+	}
+	reg, err := req.Registry()
+	if err != nil {
+		return "", ""
+	}
+	b, ps, err := req.Puzzle(reg)
+	if err != nil {
+		return "", ""
+	}
+	if c, ok, _ := iqpuzzler.Identify(b, req.Preset, reg.List(), req.Lenient); ok {
+		tier = c.Tier
+	}
+	return iqpuzzler.BoardHash(b, req.Set, ps), tier
+}
+
+func (r batchRow) record() []string {
+	var msg string
+	if r.err != nil {
+		msg = r.err.Error()
+	}
+	return []string{
+		r.name,
+		r.status,
+		strconv.Itoa(r.res.Count),
+		strconv.FormatBool(r.res.Complete),
+		strconv.FormatInt(r.res.Metrics.Nodes, 10),
+		r.res.Metrics.Duration.Round(time.Millisecond).String(),
+		msg,
+		r.hash,
+		r.tier,
+	}
+}
+
+// writeRow writes the row and flushes it to the file, so that the rows of
+// the finished puzzles survive a crash.
+func writeRow(w *csv.Writer, row []string) error {
+	if err := w.Write(row); err != nil {
+		return err
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// deadStatesBytes is the most memory the dead states shared by the puzzles
+// of a batch take.
+const deadStatesBytes = 256 << 20
+
+// printDeadStates prints how often the searches of a batch found the states
+// they reached among the dead states, and among those added by the
+// searches of other puzzles.
+func printDeadStates(st iqpuzzler.DeadStatesStats) {
+	console.printf(levelResult, "dead states: %d hits in %d lookups (%s), %d of them from other puzzles (%s), %d states of %d KiB\n",
+		st.Hits, st.Lookups, percent(st.Hits, st.Lookups), st.CrossHits, percent(st.CrossHits, st.Hits), st.States, st.Bytes>>10)
+	if st.Full > 0 {
+		console.printf(levelResult, "dead states: %d not kept, the table was full\n", st.Full)
+	}
+}
+
+// percent formats n as a percentage of total.
+func percent(n, total int64) string {
+	if total == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f%%", 100*float64(n)/float64(total))
+}
+
+// batchStats aggregates the rows of a batch.
+type batchStats struct {
+	done     int
+	byStatus map[string]int
+	nodes    int64
+	search   time.Duration
+	slowest  batchRow
+}
+
+func (s *batchStats) add(r batchRow) {
+	if s.byStatus == nil {
+		s.byStatus = make(map[string]int)
+	}
+	s.done++
+	s.byStatus[r.status]++
+	s.nodes += r.res.Metrics.Nodes
+	s.search += r.res.Metrics.Duration
+	if r.res.Metrics.Duration > s.slowest.res.Metrics.Duration {
+		s.slowest = r
+	}
+}
+
+func (s *batchStats) print(total int, wall time.Duration) {
+	console.printf(levelResult, "%d of %d puzzles done: %d solved, %d unsolvable, %d aborted, %d failed\n",
+		s.done, total, s.byStatus[iqpuzzler.Solved.String()], s.byStatus[iqpuzzler.Unsolvable.String()], s.byStatus[iqpuzzler.Aborted.String()], s.byStatus["error"])
+	console.printf(levelResult, "%d nodes in %s of search, %s of wall time\n", s.nodes, s.search.Round(time.Millisecond), wall.Round(time.Millisecond))
+	if s.done > 0 {
+		console.printf(levelResult, "slowest: %s, %s\n", s.slowest.name, s.slowest.res.Metrics.Duration.Round(time.Millisecond))
+	}
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// TestBatchDeadStates solves a batch of puzzles of the standard board with
+// the dead states shared by the puzzles and without, and checks that each
+// puzzle has the same status and number of solutions either way.
+func TestBatchDeadStates(t *testing.T) {
+	const input = `{"name": "4 pieces", "board": "5x11:3E3H3B2.G2E2J2HB3.3GCJ2.A4.G.C2.I3A4.3C4I.", "pieces": ["maroon", "olive", "violet", "yellow"]}
+{"name": "6 pieces", "board": "5x11:2D2J3.4L.2DJ4.L7.4I2.F.F.BI5.3F3B5.", "pieces": ["blue", "lightblue", "mint", "orange", "pink", "violet"]}
+{"name": "8 pieces", "board": "5x11:5.B5.C4.2BD3.C4.B.2D2.3C5.D2J10.J", "pieces": ["blue", "mint", "olive", "orange", "pink", "red", "violet", "yellow"]}
+{"name": "unsolvable", "board": "5x11:5.B5.C4.2BD3.C4.B.2D2.3C5.D2J10.J", "pieces": ["blue", "mint", "olive", "orange", "pink", "red", "violet", "lightblue"]}
+{"name": "8 pieces again", "board": "5x11:5.B5.C4.2BD3.C4.B.2D2.3C5.D2J10.J", "pieces": ["blue", "mint", "olive", "orange", "pink", "red", "violet", "yellow"]}
+`
+	var (
+		dir  = t.TempDir()
+		path = filepath.Join(dir, "pack.jsonl")
+	)
+	if err := os.WriteFile(path, []byte(input), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	// batch solves the puzzles with the flags and returns the name, status,
+	// solutions and completeness of each, in the order of the input.
+	var batch = func(name string, flags ...string) (rows [][]string, stdout string) {
+		var summary = filepath.Join(dir, name+".csv")
+		var out, stderr, code = runMain(t, append([]string{"batch", "-input=" + path, "-j=1", "-summary=" + summary}, flags...)...)
+		if code != 0 {
+			t.Fatalf("batch %s: exit code %d, stderr:\n%s", strings.Join(flags, " "), code, stderr)
+		}
+		f, err := os.Open(summary)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		records, err := csv.NewReader(f).ReadAll()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, r := range records[1:] {
+			rows = append(rows, r[:4])
+		}
+		return rows, out
+	}
+	var (
+		shared, out = batch("shared")
+		isolated, _ = batch("isolated", "-no-dead-states")
+	)
+	if len(isolated) != 5 {
+		t.Fatalf("got %d rows without dead states, want 5", len(isolated))
+	}
+	if !slices.EqualFunc(shared, isolated, slices.Equal) {
+		t.Errorf("with the dead states shared, the puzzles have the outcomes\n%q\nwithout\n%q", shared, isolated)
+	}
+	if !strings.Contains(out, "dead states: ") {
+		t.Errorf("batch printed no statistics of the dead states:\n%s", out)
+	}
+}
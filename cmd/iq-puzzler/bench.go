@@ -0,0 +1,261 @@
+package main
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"smaart/iqpuzzler"
+)
+
+// benchSuite holds the built-in cases of bench, in the format of batch
+// input files.
+//
+//go:embed bench.jsonl
+var benchSuite []byte
+
+// benchCase is the outcome of the runs of a case.
+type benchCase struct {
+	Name      string `json:"name"`
+	Solutions int    `json:"solutions"`
+	Complete  bool   `json:"complete"`
+	// The median of an even number of runs is the higher of the middle two.
+	NodesMin       int64         `json:"nodes_min"`
+	NodesMedian    int64         `json:"nodes_median"`
+	DurationMin    time.Duration `json:"duration_min_ns"`
+	DurationMedian time.Duration `json:"duration_median_ns"`
+	// AllocsMedian is the median number of heap allocations of a run.
+	AllocsMedian uint64 `json:"allocs_median"`
+}
+
+// benchReport is the JSON output of bench, with the settings the numbers
+// depend on.
+type benchReport struct {
+	Go          string `json:"go"`
+	OS          string `json:"os"`
+	Arch        string `json:"arch"`
+	GOMAXPROCS  int    `json:"gomaxprocs"`
+	Parallelism int    `json:"parallelism"`
+	Runs        int    `json:"runs"`
+	// Learn is the file of placement scores ordering the search, if any.
+	Learn string      `json:"learn,omitempty"`
+	Cases []benchCase `json:"cases"`
+}
+
+func newBenchCommand() (*flag.FlagSet, func(args []string)) {
+	var (
+		fs      = newFlagSet("bench", "")
+		gf      = addGlobalFlags(fs, "write the report to this file")
+		n       = fs.Int("n", 5, "the number of runs of each case")
+		j       = fs.Int("j", 1, "the number of goroutines searching each case")
+		procs   = fs.Int("procs", runtime.NumCPU(), "the value of GOMAXPROCS")
+		suite   = fs.String("suite", "", "run the cases in this file, in the format of batch input, instead of the built-in ones")
+		chall   = fs.Bool("challenges", false, "run the first solution of every official challenge instead of the built-in cases (not available yet: the catalog of official challenges is empty)")
+		learn   = fs.String("learn", "", "order the placements by the scores in this file, as recorded by solve -learn, without recording more")
+		depths  = fs.String("par-depths", "", "run every case with each of these comma-separated -par-depth settings, such as 1,2,4,8,auto, naming them after it")
+		format  = fs.String("format", "text", "the format of the report, text or json")
+		timeout = fs.Duration("timeout", time.Minute, "the longest a run of a case may search, 0 for no limit")
+		base    = fs.String("baseline", "", "compare the report with this one, written with -format=json, and fail if a median regressed")
+		thresh  = fs.Float64("threshold", 0.1, "the rise of a median over the baseline which is a regression, as a fraction")
+	)
+	return fs, func(args []string) {
+		parseFlags(fs, args)
+		var matrix, derr = parseParDepths(*depths)
+		if derr != nil || *n < 1 || *j < 1 || *procs < 1 || *format != "text" && *format != "json" || *thresh < 0 || fs.NArg() > 1 || fs.NArg() == 1 && *base == "" {
+			fs.Usage()
+			os.Exit(exitUsage)
+		}
+		if fs.NArg() == 1 {
+			// Compare two reports without running the suite.
+			var w = gf.create()
+			defer w.Close()
+			if compareBench(w, readBenchReport(*base), readBenchReport(fs.Arg(0)), *thresh) {
+				exitWith(exitFailure)
+			}
+			return
+		}
+		var logger = gf.logger()
+		var cases, err = parseBatch("the built-in suite", benchSuite)
+		switch {
+		case *suite != "" && *chall:
+			fs.Usage()
+			os.Exit(exitUsage)
+		case *suite != "":
+			cases, err = readBatch(*suite)
+		case *chall:
+			cases, err = challengeCases()
+		}
+		if err != nil {
+			exit(err)
+		}
+		var scores *iqpuzzler.PlacementScores
+		if *learn != "" {
+			if scores, err = iqpuzzler.ReadPlacementScores(*learn); err != nil {
+				exit(err)
+			}
+		}
+		runtime.GOMAXPROCS(*procs)
+		var (
+			ctx, stop = interruptContext()
+			report    = benchReport{Go: runtime.Version(), OS: runtime.GOOS, Arch: runtime.GOARCH, GOMAXPROCS: *procs, Parallelism: *j, Runs: *n, Learn: *learn}
+		)
+		defer stop()
+		for _, c := range benchMatrix(cases, matrix) {
+			var req = c.SolveRequest
+			req.Parallelism, req.Timeout = *j, ""
+			if *timeout > 0 {
+				req.Timeout = timeout.String()
+			}
+			var (
+				nodes  = make([]int64, *n)
+				times  = make([]time.Duration, *n)
+				allocs = make([]uint64, *n)
+				res    iqpuzzler.SolveResult
+			)
+			for i := range *n {
+				var (
+					extra         []iqpuzzler.Option
+					before, after runtime.MemStats
+				)
+				if scores != nil {
+					// Every run starts from the same scores.
+					extra = append(extra, iqpuzzler.WithPlacementScores(scores.Clone()))
+				}
+				runtime.ReadMemStats(&before)
+				res, err = solveRequest(ctx, req, logger, extra...)
+				runtime.ReadMemStats(&after)
+				var ae *iqpuzzler.AbortedError
+				if errors.As(err, &ae) {
+					console.println(levelResult, "interrupted")
+					exitWith(exitAborted)
+				}
+				if err != nil {
+					exit(fmt.Errorf("case %s: %w", c.Name, err))
+				}
+				nodes[i], times[i], allocs[i] = res.Metrics.Nodes, res.Metrics.Duration, after.Mallocs-before.Mallocs
+			}
+			slices.Sort(nodes)
+			slices.Sort(times)
+			slices.Sort(allocs)
+			var bc = benchCase{
+				Name:           c.Name,
+				Solutions:      res.Count,
+				Complete:       res.Complete,
+				NodesMin:       nodes[0],
+				NodesMedian:    nodes[*n/2],
+				DurationMin:    times[0],
+				DurationMedian: times[*n/2],
+				AllocsMedian:   allocs[*n/2],
+			}
+			console.printf(levelSummary, "%s: %s\n", c.Name, bc.DurationMedian.Round(time.Microsecond))
+			report.Cases = append(report.Cases, bc)
+		}
+		var w = gf.create()
+		defer w.Close()
+		if *format == "json" {
+			var enc = json.NewEncoder(w)
+			enc.SetIndent("", "  ")
+			if err := enc.Encode(report); err != nil {
+				exit(err)
+			}
+		} else {
+			writeBenchReport(w, report)
+		}
+		if *base != "" {
+			// The comparison goes with a text report, and to the console with a
+			// JSON one.
+			var cw io.Writer = w
+			if *format == "json" {
+				cw = os.Stderr
+			} else {
+				fmt.Fprintln(w)
+			}
+			if compareBench(cw, readBenchReport(*base), report, *thresh) {
+				exitWith(exitFailure)
+			}
+		}
+	}
+}
+
+// parseParDepths parses the comma-separated settings of -par-depth of
+// -par-depths, none if it is empty.
+func parseParDepths(s string) ([]parDepth, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var res []parDepth
+	for _, f := range strings.Split(s, ",") {
+		var d parDepth
+		if err := d.Set(strings.TrimSpace(f)); err != nil {
+			return nil, err
+		}
+		res = append(res, d)
+	}
+	return res, nil
+}
+
+// benchMatrix returns the cases with each of the settings of -par-depth,
+// named after the case and the setting, or the cases if there are none.
+func benchMatrix(cases []batchPuzzle, depths []parDepth) []batchPuzzle {
+	if len(depths) == 0 {
+		return cases
+	}
+	var res []batchPuzzle
+	for _, c := range cases {
+		for _, d := range depths {
+			var m = c
+			m.Name = fmt.Sprintf("%s/par-depth=%s", c.Name, &d)
+			m.ParDepth = int(d)
+			res = append(res, m)
+		}
+	}
+	return res
+}
+
+// writeBenchReport writes the report as tables.
+func writeBenchReport(w io.Writer, report benchReport) {
+	fmt.Fprintf(w, "%s %s/%s, GOMAXPROCS %d, parallelism %d, %d runs each", report.Go, report.OS, report.Arch, report.GOMAXPROCS, report.Parallelism, report.Runs)
+	if report.Learn != "" {
+		fmt.Fprintf(w, ", placements ordered by %s", report.Learn)
+	}
+	fmt.Fprintln(w)
+	var tw = tabwriter.NewWriter(w, 0, 4, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "CASE\tSOLUTIONS\tNODES MIN\tNODES MEDIAN\tTIME MIN\tTIME MEDIAN\tALLOCS MEDIAN\t")
+	for _, c := range report.Cases {
+		var count = fmt.Sprint(c.Solutions)
+		if !c.Complete && c.Solutions != 1 {
+			count = "≥" + count
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%s\t%s\t%d\t\n", c.Name, count, c.NodesMin, c.NodesMedian, c.DurationMin.Round(time.Microsecond), c.DurationMedian.Round(time.Microsecond), c.AllocsMedian)
+	}
+	tw.Flush()
+}
+
+// challengeCases returns a case finding the first solution of each
+// official challenge.
+func challengeCases() ([]batchPuzzle, error) {
+	cs, err := iqpuzzler.LoadChallenges()
+	if err != nil {
+		return nil, err
+	}
+	if len(cs) == 0 {
+		return nil, iqpuzzler.ErrNoChallenges
+	}
+	var res []batchPuzzle
+	for _, c := range cs {
+		res = append(res, batchPuzzle{
+			Name:         fmt.Sprintf("challenge-%d", c.Number),
+			SolveRequest: iqpuzzler.SolveRequest{Preset: c.Preset, Board: c.Board, MaxSolutions: 1},
+		})
+	}
+	return res, nil
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// readBenchReport reads a report written by bench -format=json, exiting if
+// it cannot.
+func readBenchReport(path string) benchReport {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		exit(err)
+	}
+	var r benchReport
+	if err := json.Unmarshal(data, &r); err != nil {
+		exit(fmt.Errorf("%s: %v", path, err))
+	}
+	return r
+}
+
+// benchMetric is a median of a case or step in two reports.
+type benchMetric struct {
+	name, metric string
+	old, new     float64
+	format       func(float64) string
+}
+
+// compareBench writes the medians of the cases of both reports
+// side by side, marking those which rose by more than the threshold, a
+// fraction, over the baseline, and cases whose number of solutions
+// changed. It reports whether any did.
+func compareBench(w io.Writer, base, cur benchReport, threshold float64) bool {
+	fmt.Fprintf(w, "baseline %s %s/%s, GOMAXPROCS %d, parallelism %d, %d runs each\n", base.Go, base.OS, base.Arch, base.GOMAXPROCS, base.Parallelism, base.Runs)
+	if d := benchSettingsDiff(base, cur); d != "" {
+		fmt.Fprintf(w, "the settings differ: %s\n", d)
+	}
+	var (
+		metrics []benchMetric
+		changed []string
+		cases   = make(map[string]benchCase)
+	)
+	for _, c := range base.Cases {
+		cases[c.Name] = c
+	}
+	for _, c := range cur.Cases {
+		var o, ok = cases[c.Name]
+		if !ok {
+			continue
+		}
+		delete(cases, c.Name)
+		if o.Solutions != c.Solutions || o.Complete != c.Complete {
+			changed = append(changed, c.Name)
+		}
+		metrics = append(metrics,
+			benchMetric{c.Name, "time", float64(o.DurationMedian), float64(c.DurationMedian), formatDuration},
+			benchMetric{c.Name, "nodes", float64(o.NodesMedian), float64(c.NodesMedian), formatCount},
+			benchMetric{c.Name, "allocs", float64(o.AllocsMedian), float64(c.AllocsMedian), formatCount},
+		)
+	}
+	var (
+		tw        = tabwriter.NewWriter(w, 0, 4, 2, ' ', tabwriter.AlignRight)
+		regressed int
+	)
+	fmt.Fprintln(tw, "CASE\tMEDIAN\tBASELINE\tNOW\tCHANGE\t\t")
+	for _, m := range metrics {
+		var (
+			change = benchChange(m.old, m.new)
+			mark   string
+		)
+		if change > threshold {
+			mark = "REGRESSED"
+			regressed++
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t\n", m.name, m.metric, m.format(m.old), m.format(m.new), formatChange(change), mark)
+	}
+	tw.Flush()
+	for _, name := range changed {
+		fmt.Fprintf(w, "FAIL  %s: the number of solutions changed\n", name)
+	}
+	var missing []string
+	for _, c := range base.Cases {
+		if _, ok := cases[c.Name]; ok {
+			missing = append(missing, c.Name)
+		}
+	}
+	if len(missing) > 0 {
+		fmt.Fprintf(w, "not run: %s\n", strings.Join(missing, ", "))
+	}
+	if regressed > 0 {
+		fmt.Fprintf(w, "FAIL  %d of %d medians rose by more than %g%%\n", regressed, len(metrics), 100*threshold)
+	} else {
+		fmt.Fprintf(w, "ok    none of %d medians rose by more than %g%%\n", len(metrics), 100*threshold)
+	}
+	return regressed > 0 || len(changed) > 0
+}
+
+// benchSettingsDiff describes the settings the numbers depend on which
+// differ between the reports, if any.
+func benchSettingsDiff(a, b benchReport) string {
+	var diffs []string
+	var add = func(name string, x, y any) {
+		if x != y {
+			diffs = append(diffs, fmt.Sprintf("%s %v, now %v", name, x, y))
+		}
+	}
+	add("Go", a.Go, b.Go)
+	add("platform", a.OS+"/"+a.Arch, b.OS+"/"+b.Arch)
+	add("GOMAXPROCS", a.GOMAXPROCS, b.GOMAXPROCS)
+	add("parallelism", a.Parallelism, b.Parallelism)
+	add("runs", a.Runs, b.Runs)
+	add("learn", a.Learn, b.Learn)
+	return strings.Join(diffs, "; ")
+}
+
+// benchChange returns the change from old to new as a fraction of old. A
+// rise from 0 is infinite.
+func benchChange(old, new float64) float64 {
+	switch {
+	case old == new:
+		return 0
+	case old == 0:
+		return math.Inf(1)
+	}
+	return new/old - 1
+}
+
+func formatChange(f float64) string {
+	if math.IsInf(f, 1) {
+		return "+∞"
+	}
+	return fmt.Sprintf("%+.1f%%", 100*f)
+}
+
+func formatDuration(ns float64) string {
+	return time.Duration(ns).Round(time.Microsecond).String()
+}
+
+func formatCount(n float64) string {
+	return fmt.Sprint(int64(n))
+}
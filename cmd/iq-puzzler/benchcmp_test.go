@@ -0,0 +1,63 @@
+package main
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCompareBench(t *testing.T) {
+	var base = benchReport{Go: "go1.23", OS: "linux", Arch: "amd64", GOMAXPROCS: 4, Parallelism: 1, Runs: 5, Cases: []benchCase{
+		{Name: "mini", Solutions: 3, Complete: true, NodesMedian: 100, DurationMedian: time.Millisecond, AllocsMedian: 10},
+		{Name: "standard", Solutions: 1, Complete: true, NodesMedian: 5000, DurationMedian: 2 * time.Millisecond, AllocsMedian: 20},
+	}}
+	var tests = []struct {
+		name   string
+		change func(*benchReport)
+		fail   bool
+		// want are parts of the output.
+		want []string
+	}{
+		{"same", func(*benchReport) {}, false, []string{"ok    none of 6 medians"}},
+		{"within the threshold", func(r *benchReport) { r.Cases[0].DurationMedian = 1050 * time.Microsecond }, false, []string{"+5.0%", "ok"}},
+		{"slower", func(r *benchReport) { r.Cases[1].DurationMedian = 3 * time.Millisecond }, true, []string{"+50.0%", "REGRESSED", "FAIL  1 of 6 medians rose by more than 10%"}},
+		{"fewer allocations", func(r *benchReport) { r.Cases[0].AllocsMedian = 0; r.Cases[1].AllocsMedian = 0 }, false, []string{"-100.0%", "ok"}},
+		{"other solutions", func(r *benchReport) { r.Cases[0].Solutions = 2 }, true, []string{"FAIL  mini: the number of solutions changed"}},
+		{"not run", func(r *benchReport) { r.Cases = r.Cases[1:] }, false, []string{"not run: mini", "none of 3 medians"}},
+		{"other settings", func(r *benchReport) { r.GOMAXPROCS, r.Runs = 8, 3 }, false, []string{"the settings differ: GOMAXPROCS 4, now 8; runs 5, now 3"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var cur = base
+			cur.Cases = append([]benchCase(nil), base.Cases...)
+			test.change(&cur)
+			var out strings.Builder
+			if got := compareBench(&out, base, cur, 0.1); got != test.fail {
+				t.Errorf("compareBench = %t, want %t; output:\n%s", got, test.fail, out.String())
+			}
+			for _, w := range test.want {
+				if !strings.Contains(out.String(), w) {
+					t.Errorf("the output does not contain %q:\n%s", w, out.String())
+				}
+			}
+		})
+	}
+}
+
+func TestBenchChange(t *testing.T) {
+	var tests = []struct {
+		old, new, want float64
+	}{
+		{0, 0, 0},
+		{10, 10, 0},
+		{10, 15, 0.5},
+		{10, 5, -0.5},
+		{0, 1, math.Inf(1)},
+	}
+	for _, test := range tests {
+		if got := benchChange(test.old, test.new); got != test.want {
+			t.Errorf("benchChange(%g, %g) = %g, want %g", test.old, test.new, got, test.want)
+		}
+	}
+}
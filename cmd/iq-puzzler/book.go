@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"runtime"
+	"slices"
+	"time"
+
+	"smaart/iqpuzzler"
+)
+
+func newBookCommand() (*flag.FlagSet, func(args []string)) {
+	var (
+		fs      = newFlagSet("book", "build")
+		gf      = addGlobalFlags(fs, "write the book to this file")
+		pack    = fs.String("pack", "", "solve the challenges in this file, in the format of the catalog, instead of the official ones")
+		count   = fs.Bool("count", false, "count the solutions of every puzzle, so that the book also answers count")
+		timeout = fs.Duration("timeout", time.Minute, "the longest to search one puzzle, 0 for no limit")
+		j       = fs.Int("j", runtime.GOMAXPROCS(0), "the number of goroutines searching concurrently")
+	)
+	return fs, func(args []string) {
+		var flags, words = splitFlags(fs, args)
+		parseFlags(fs, flags)
+		if len(words) != 1 || words[0] != "build" {
+			fs.Usage()
+			os.Exit(exitUsage)
+		}
+		if *gf.output == "" {
+			console.errorln("book build needs -o for the book file")
+			os.Exit(exitUsage)
+		}
+		var logger = gf.logger()
+		var cs, err = iqpuzzler.LoadChallenges()
+		if *pack != "" {
+			var data []byte
+			if data, err = os.ReadFile(*pack); err == nil {
+				cs, err = iqpuzzler.ParseChallenges(*pack, string(data))
+			}
+		}
+		if err == nil && len(cs) == 0 && *pack == "" {
+			err = fmt.Errorf("%w; give the challenges with -pack", iqpuzzler.ErrNoChallenges)
+		}
+		if err != nil {
+			exit(err)
+		}
+		var entries []iqpuzzler.BookEntry
+		for _, c := range cs {
+			e, err := bookEntry(c, *count, *timeout, *j, logger)
+			if err != nil {
+				exit(fmt.Errorf("challenge %d: %w", c.Number, err))
+			}
+			if !slices.ContainsFunc(entries, func(f iqpuzzler.BookEntry) bool { return f.Hash == e.Hash }) {
+				entries = append(entries, e)
+			}
+		}
+		var f = gf.create()
+		defer f.Close()
+		if err := iqpuzzler.WriteBook(f, entries); err != nil {
+			exit(err)
+		}
+		console.printf(levelSummary, "%d puzzles in %s\n", len(entries), *gf.output)
+	}
+}
+
+// bookEntry solves the challenge for the book.
+func bookEntry(c iqpuzzler.Challenge, count bool, timeout time.Duration, j int, logger *slog.Logger) (iqpuzzler.BookEntry, error) {
+	var req = iqpuzzler.SolveRequest{Preset: c.Preset, Board: c.Board}
+	reg, err := req.Registry()
+	if err != nil {
+		return iqpuzzler.BookEntry{}, err
+	}
+	b, ps, err := req.Puzzle(reg)
+	if err != nil {
+		return iqpuzzler.BookEntry{}, err
+	}
+	var max = 1
+	if count {
+		max = 0
+	}
+	s, err := iqpuzzler.NewSolver(iqpuzzler.WithMaxSolutions(max), iqpuzzler.WithParallelism(j), iqpuzzler.WithLogger(logger), iqpuzzler.WithOnSolution(func(iqpuzzler.Solution) {}))
+	if err != nil {
+		return iqpuzzler.BookEntry{}, err
+	}
+	var ctx, cancel = context.Background(), context.CancelFunc(func() {})
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	}
+	defer cancel()
+	res, err := s.Solve(ctx, iqpuzzler.NewGame(b), ps)
+	var ae *iqpuzzler.AbortedError
+	if err != nil && !errors.As(err, &ae) {
+		return iqpuzzler.BookEntry{}, err
+	}
+	var preset, _ = iqpuzzler.LookupPreset(c.Preset)
+	var e = iqpuzzler.BookEntry{
+		Hash:     iqpuzzler.BoardHash(b, preset.Set, ps),
+		Solution: res.Solution,
+		Counted:  res.Complete,
+		Count:    int64(res.Count),
+	}
+	if !res.Complete && (count || res.Count == 0) {
+		logger.Warn("search stopped before its end", slog.Int("challenge", c.Number), slog.Int("solutions", res.Count))
+	}
+	return e, nil
+}
+
+// lookupBook returns the entry of the puzzle in the book file, if it has
+// one, with its solution resolved against the pieces of the registry.
+func lookupBook(path string, b *iqpuzzler.Board, set string, ps []iqpuzzler.Piece, reg *iqpuzzler.Registry, logger *slog.Logger) (iqpuzzler.BookEntry, bool) {
+	if path == "" {
+		return iqpuzzler.BookEntry{}, false
+	}
+	book, err := iqpuzzler.ReadBook(path)
+	if err != nil {
+		exit(err)
+	}
+	var hash = iqpuzzler.BoardHash(b, set, ps)
+	e, ok, err := book.Lookup(hash)
+	if err != nil {
+		exit(err)
+	}
+	if !ok {
+		logger.Info("not in the book", slog.String("hash", hash))
+		return e, false
+	}
+	if err := e.Solution.Resolve(reg.List()); err != nil {
+		exit(err)
+	}
+	return e, true
+}
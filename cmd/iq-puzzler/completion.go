@@ -0,0 +1,233 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"smaart/iqpuzzler"
+)
+
+func newCompletionCommand() (*flag.FlagSet, func(args []string)) {
+	var (
+		fs = newFlagSet("completion", "bash|zsh|fish")
+		gf = addGlobalFlags(fs, "write the script to this file")
+	)
+	return fs, func(args []string) {
+		parseFlags(fs, args)
+		if fs.NArg() != 1 {
+			fs.Usage()
+			os.Exit(exitUsage)
+		}
+		script, ok := completionScripts[fs.Arg(0)]
+		if !ok {
+			console.errorf("unknown shell %q, want bash, zsh or fish\n", fs.Arg(0))
+			os.Exit(exitUsage)
+		}
+		var f = gf.create()
+		defer f.Close()
+		fmt.Fprint(f, script)
+	}
+}
+
+// The scripts ask the hidden __complete command for the candidates, so that
+// they never go out of date.
+var completionScripts = map[string]string{
+	"bash": `# bash completion for iq-puzzler; load with
+#   source <(iq-puzzler completion bash)
+_iq_puzzler() {
+	local cur=${COMP_WORDS[COMP_CWORD]} line=${COMP_LINE:0:COMP_POINT} words
+	read -ra words <<<"$line"
+	[[ $line == *[[:space:]] ]] && words+=("")
+	local IFS=$'\n' c
+	COMPREPLY=()
+	for c in $(iq-puzzler __complete "${words[@]:1}" 2>/dev/null); do
+		# bash splits -flag=value at the =, so only the value is completed.
+		if [[ ${words[-1]} == *=* && $cur != "${words[-1]}" ]]; then
+			c=${c#*=}
+			[[ $cur == = ]] && c="=$c"
+		fi
+		COMPREPLY+=("$c")
+	done
+}
+complete -o default -F _iq_puzzler iq-puzzler
+`,
+	"zsh": `#compdef iq-puzzler
+# zsh completion for iq-puzzler; load with
+#   source <(iq-puzzler completion zsh)
+_iq_puzzler() {
+	local -a cands
+	cands=("${(@f)$(iq-puzzler __complete "${(@)words[2,CURRENT]}" 2>/dev/null)}")
+	if [[ -n ${cands[1]} ]]; then
+		compadd -Q -- "${cands[@]}"
+	else
+		_files
+	fi
+}
+compdef _iq_puzzler iq-puzzler
+`,
+	"fish": `# fish completion for iq-puzzler; load with
+#   iq-puzzler completion fish | source
+function __iq_puzzler_complete
+	set -l cands (iq-puzzler __complete (commandline -opc)[2..-1] (commandline -ct) 2>/dev/null)
+	if test (count $cands) -gt 0
+		printf '%s\n' $cands
+	else
+		__fish_complete_path (commandline -ct)
+	end
+end
+complete -c iq-puzzler -f -a '(__iq_puzzler_complete)'
+`,
+}
+
+// flagValues returns the values a flag may take, for the flags which take
+// one of a fixed set. words are the words of the command line, from which
+// the piece set is taken.
+var flagValues = map[string]func(words []string) []string{
+	"board-preset": func([]string) []string { return append(iqpuzzler.PresetNames(), "list") },
+	"set":          func([]string) []string { return iqpuzzler.PieceSetNames() },
+	"strategy":     func([]string) []string { return iqpuzzler.StrategyNames() },
+	"engine":       func([]string) []string { return iqpuzzler.EngineNames() },
+	"stats":        func([]string) []string { return []string{"text", "json"} },
+	"log-format":   func([]string) []string { return []string{"text", "json"} },
+	"color":        func([]string) []string { return []string{"auto", "always", "never"} },
+	"v":            func([]string) []string { return []string{"0", "1", "2", "3"} },
+	"pieces":       pieceNames,
+	"challenge": func([]string) []string {
+		var cs, _ = iqpuzzler.LoadChallenges()
+		var res []string
+		for _, c := range cs {
+			res = append(res, strconv.Itoa(c.Number))
+		}
+		return res
+	},
+}
+
+// pieceNames returns the names of the pieces of the set the command line
+// selects with -set or -board-preset.
+func pieceNames(words []string) []string {
+	var req iqpuzzler.SolveRequest
+	for i, w := range words {
+		for _, name := range []string{"set", "board-preset"} {
+			var v, ok = strings.CutPrefix(w, "-"+name+"=")
+			if !ok && w == "-"+name && i+1 < len(words) {
+				v, ok = words[i+1], true
+			}
+			if ok && name == "set" {
+				req.Set = v
+			} else if ok {
+				req.Preset = v
+			}
+		}
+	}
+	set, err := req.PieceSet()
+	if err != nil {
+		return nil
+	}
+	var res []string
+	for _, p := range set.Pieces {
+		res = append(res, p.Name())
+	}
+	return res
+}
+
+// complete returns the candidates for the last of the words following the
+// program name, which is the word being completed.
+func complete(words []string) []string {
+	if len(words) == 0 {
+		return nil
+	}
+	var (
+		cur  = words[len(words)-1]
+		args = words[:len(words)-1]
+		cmd  *command
+	)
+	switch {
+	case len(args) == 0 && !strings.HasPrefix(cur, "-"):
+		var res []string
+		for _, c := range commands {
+			res = append(res, c.name)
+		}
+		return filterPrefix(append(res, "help"), cur)
+	case len(args) > 0 && args[0] == "help":
+		if len(args) > 1 {
+			return nil
+		}
+		return complete([]string{cur})
+	case len(args) == 0 || strings.HasPrefix(args[0], "-"):
+		cmd = lookupCommand("solve")
+	default:
+		if cmd = lookupCommand(args[0]); cmd == nil {
+			return nil
+		}
+		args = args[1:]
+	}
+	var fs = commandFlags(cmd)
+	// The value of a flag, as -flag=value or after -flag.
+	if name, value, ok := strings.Cut(strings.TrimLeft(cur, "-"), "="); ok && strings.HasPrefix(cur, "-") {
+		var res []string
+		for _, v := range valueCandidates(name, value, words) {
+			res = append(res, cur[:len(cur)-len(value)]+v)
+		}
+		return res
+	}
+	if len(args) > 0 && strings.HasPrefix(args[len(args)-1], "-") && !strings.Contains(args[len(args)-1], "=") {
+		if f := fs.Lookup(strings.TrimLeft(args[len(args)-1], "-")); f != nil && !isBoolFlag(f) {
+			return valueCandidates(f.Name, cur, words)
+		}
+	}
+	if !strings.HasPrefix(cur, "-") {
+		return nil
+	}
+	var res []string
+	fs.VisitAll(func(f *flag.Flag) {
+		if !hiddenFlags[f.Name] {
+			res = append(res, "-"+f.Name)
+		}
+	})
+	return filterPrefix(res, cur)
+}
+
+// valueCandidates returns the values of the flag starting with value. For
+// -pieces, only the name after the last comma is completed.
+func valueCandidates(name, value string, words []string) []string {
+	var fn = flagValues[name]
+	if fn == nil {
+		return nil
+	}
+	var prefix string
+	if name == "pieces" {
+		var i = strings.LastIndexByte(value, ',') + 1
+		prefix, value = value[:i], value[i:]
+	}
+	var res []string
+	for _, v := range filterPrefix(fn(words), value) {
+		res = append(res, prefix+v)
+	}
+	return res
+}
+
+func filterPrefix(cands []string, prefix string) []string {
+	var res []string
+	for _, c := range cands {
+		if strings.HasPrefix(c, prefix) {
+			res = append(res, c)
+		}
+	}
+	slices.Sort(res)
+	return res
+}
+
+func isBoolFlag(f *flag.Flag) bool {
+	var b, ok = f.Value.(interface{ IsBoolFlag() bool })
+	return ok && b.IsBoolFlag()
+}
+
+// commandFlags returns the flags of the command.
+func commandFlags(c *command) *flag.FlagSet {
+	var fs, _ = c.flags()
+	return fs
+}
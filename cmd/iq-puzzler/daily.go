@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"strings"
+	"time"
+
+	"smaart/iqpuzzler"
+)
+
+// The daily puzzle is generated on the standard board with the seed of the
+// date, so that it is the same for everyone on the same day.
+const (
+	dailyPreset = "standard"
+	dailyRemove = 4
+	dateLayout  = "2006-01-02"
+)
+
+func newDailyCommand() (*flag.FlagSet, func(args []string)) {
+	var (
+		fs    = newFlagSet("daily", "")
+		gf    = addGlobalFlags(fs, "")
+		tz    = fs.String("tz", "UTC", "the time zone whose date selects the puzzle, such as Europe/Zurich or Local")
+		solve = fs.Bool("solve", false, "print the solution of the puzzle")
+		done  = fs.Bool("done", false, "record the puzzle as done and print the streak")
+	)
+	return fs, func(args []string) {
+		parseFlags(fs, args)
+		loc, err := time.LoadLocation(*tz)
+		if err != nil {
+			console.errorf("invalid time zone %q: %v\n", *tz, err)
+			os.Exit(exitUsage)
+		}
+		var day = time.Now().In(loc).Format(dateLayout)
+		if *done {
+			var streak DailyStreak
+			err := updateProgress(func(p *Progress) {
+				if p.Daily == nil {
+					p.Daily = &DailyStreak{}
+				}
+				p.Daily.done(day)
+				streak = *p.Daily
+			})
+			if err != nil {
+				exit(err)
+			}
+			console.printf(levelResult, "%d-day streak\n", streak.Streak)
+			console.printf(levelSummary, "longest streak %d days\n", streak.Longest)
+			return
+		}
+		var req = iqpuzzler.SolveRequest{Preset: dailyPreset}
+		set, err := req.PieceSet()
+		if err != nil {
+			exit(err)
+		}
+		reg, err := req.Registry()
+		if err != nil {
+			exit(err)
+		}
+		b, ps, err := req.Puzzle(reg)
+		if err != nil {
+			exit(err)
+		}
+		var (
+			y, m, d = time.Now().In(loc).Date()
+			logger  = gf.logger()
+		)
+		gen, err := iqpuzzler.Generate(context.Background(), b, ps, iqpuzzler.GenerateOptions{
+			Remove: dailyRemove,
+			Unique: true,
+			Rand:   iqpuzzler.NewRand(iqpuzzler.DailySeed(y, m, d)),
+			Logger: logger,
+		})
+		if err != nil {
+			exit(err)
+		}
+		var style = iqpuzzler.RenderStyle{Lines: true}
+		if gf.colored(os.Stdout) {
+			style.Palette = set.Palette
+		}
+		console.printf(levelResult, "daily puzzle of %s\n", day)
+		if *solve {
+			console.println(levelResult, gen.Solution.Render(b, style))
+			return
+		}
+		var puzzle = gen.Request(req)
+		console.printf(levelResult, "iq-puzzler solve -board-preset=%s -board=%s -pieces=%s\n", dailyPreset, puzzle.Board, strings.Join(puzzle.Pieces, ","))
+		console.println(levelResult, iqpuzzler.Solution(nil).Render(gen.Board, style))
+		if pr, err := loadProgressFile(); err == nil && pr.Daily != nil {
+			if n := pr.Daily.current(day); n > 0 {
+				console.printf(levelSummary, "%d-day streak\n", n)
+			}
+		}
+	}
+}
+
+// DailyStreak counts the consecutive days on which the daily puzzle was done.
+type DailyStreak struct {
+	// Last is the last day done, as 2006-01-02.
+	Last    string `json:"last"`
+	Streak  int    `json:"streak"`
+	Longest int    `json:"longest"`
+}
+
+// done records the puzzle of the day as done. The day after Last extends
+// the streak, Last itself or an earlier day, which a change of time zone
+// can give, leaves it, and any later day starts a new one.
+func (s *DailyStreak) done(day string) {
+	switch {
+	case s.Last != "" && day <= s.Last:
+		return
+	case nextDay(s.Last) == day:
+		s.Streak++
+	default:
+		s.Streak = 1
+	}
+	s.Last = day
+	s.Longest = max(s.Longest, s.Streak)
+}
+
+// current returns the streak as of the day: it still holds on the day after
+// Last, until the puzzle of that day is done.
+func (s *DailyStreak) current(day string) int {
+	if day == s.Last || nextDay(s.Last) == day {
+		return s.Streak
+	}
+	return 0
+}
+
+// nextDay returns the day after the given one, or "" if it is invalid.
+func nextDay(day string) string {
+	t, err := time.Parse(dateLayout, day)
+	if err != nil {
+		return ""
+	}
+	return t.AddDate(0, 0, 1).Format(dateLayout)
+}
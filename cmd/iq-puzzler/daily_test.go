@@ -0,0 +1,69 @@
+package main
+
+import "testing"
+
+func TestDailyStreak(t *testing.T) {
+	var tests = []struct {
+		name            string
+		days            []string
+		streak, longest int
+	}{
+		{"first day", []string{"2024-03-01"}, 1, 1},
+		{"consecutive days", []string{"2024-03-01", "2024-03-02", "2024-03-03"}, 3, 3},
+		{"same day twice", []string{"2024-03-01", "2024-03-01", "2024-03-02", "2024-03-02"}, 2, 2},
+		{"missed day", []string{"2024-03-01", "2024-03-02", "2024-03-04"}, 1, 2},
+		{"missed day and back", []string{"2024-03-01", "2024-03-02", "2024-03-03", "2024-03-05", "2024-03-06"}, 2, 3},
+		{"earlier day", []string{"2024-03-02", "2024-03-01", "2024-03-03"}, 2, 2},
+		{"end of month", []string{"2024-01-31", "2024-02-01"}, 2, 2},
+		{"leap day", []string{"2024-02-28", "2024-02-29", "2024-03-01"}, 3, 3},
+		{"no leap day", []string{"2023-02-28", "2023-03-01"}, 2, 2},
+		{"end of year", []string{"2023-12-31", "2024-01-01"}, 2, 2},
+		{"a year later", []string{"2023-03-01", "2024-03-01"}, 1, 1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var s DailyStreak
+			for _, day := range test.days {
+				s.done(day)
+			}
+			if s.Streak != test.streak || s.Longest != test.longest {
+				t.Errorf("streak %d, longest %d, want %d and %d", s.Streak, s.Longest, test.streak, test.longest)
+			}
+			if last := test.days[len(test.days)-1]; s.Last < last {
+				t.Errorf("last %s, want at least %s", s.Last, last)
+			}
+		})
+	}
+}
+
+func TestDailyStreakCurrent(t *testing.T) {
+	var s = DailyStreak{Last: "2024-03-05", Streak: 4, Longest: 6}
+	var tests = []struct {
+		day  string
+		want int
+	}{
+		{"2024-03-05", 4},
+		// The streak holds until the puzzle of the next day is missed.
+		{"2024-03-06", 4},
+		{"2024-03-07", 0},
+		{"2024-03-04", 0},
+	}
+	for _, test := range tests {
+		if got := s.current(test.day); got != test.want {
+			t.Errorf("current(%s) = %d, want %d", test.day, got, test.want)
+		}
+	}
+	var none DailyStreak
+	if got := none.current("2024-03-05"); got != 0 {
+		t.Errorf("current of no streak = %d, want 0", got)
+	}
+}
+
+func TestDailyTimeZone(t *testing.T) {
+	if _, _, code := runMain(t, "daily", "-done", "-tz=Nowhere/Atlantis"); code != exitUsage {
+		t.Errorf("exit code %d for an unknown time zone, want %d", code, exitUsage)
+	}
+	if stdout, stderr, code := runMain(t, "daily", "-done", "-tz=Pacific/Kiritimati"); code != 0 || stdout != "1-day streak\n" {
+		t.Errorf("daily -done printed %q with exit code %d, want a 1-day streak; stderr:\n%s", stdout, code, stderr)
+	}
+}
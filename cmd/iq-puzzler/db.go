@@ -0,0 +1,186 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"smaart/iqpuzzler"
+)
+
+// storeWriter adds the solutions of one board to a store file.
+type storeWriter struct {
+	store *iqpuzzler.Store
+	hash  string
+	// added counts the solutions which were not stored already.
+	added int
+	// logger, if not nil, warns once the set of the solutions stored
+	// turns into a Bloom filter, which bloom records.
+	logger *slog.Logger
+	bloom  bool
+}
+
+// openStore opens the store file for the solutions of the board, or returns
+// nil if there is none. It holds a lock on the store until the program
+// exits, so that only one solve adds to it at a time.
+func openStore(path string, b *iqpuzzler.Board, set string, ps []iqpuzzler.Piece) *storeWriter {
+	if path == "" {
+		return nil
+	}
+	unlock, err := lockFile(path + ".lock")
+	if err != nil {
+		exit(err)
+	}
+	s, err := iqpuzzler.OpenStore(path)
+	if err != nil {
+		unlock()
+		exit(err)
+	}
+	atExit(func() {
+		s.Close()
+		unlock()
+	})
+	hash, err := s.AddBoard(b, set, ps)
+	if err != nil {
+		exit(err)
+	}
+	return &storeWriter{store: s, hash: hash}
+}
+
+func (w *storeWriter) add(sol iqpuzzler.Solution) {
+	added, err := w.store.Add(w.hash, sol)
+	if err != nil {
+		exit(err)
+	}
+	if added {
+		w.added++
+	}
+	if w.logger != nil && !w.bloom {
+		if size, bloom := w.store.Dedup(); bloom {
+			w.bloom = true
+			w.logger.Warn("the solutions stored outgrew their share of -max-mem, skipping repeats with a Bloom filter from now on, which skips some new ones too", slog.Int64("bytes", size))
+		}
+	}
+}
+
+// limitDedup keeps the set of the solutions stored within size bytes,
+// warning when it turns into a Bloom filter to stay so.
+func (w *storeWriter) limitDedup(size int64, logger *slog.Logger) {
+	w.store.DedupLimit(int(size))
+	_, w.bloom = w.store.Dedup()
+	w.logger = logger
+}
+
+func newDBCommand() (*flag.FlagSet, func(args []string)) {
+	var (
+		fs     = newFlagSet("db", "list | show HASH | sample HASH | count | export")
+		pf     = addPuzzleFlags(fs)
+		gf     = addGlobalFlags(fs, "write the export to this file")
+		path   = fs.String("store", "", "the store file, as written by solve -store")
+		format = fs.String("format", "json", "the format of the export; only json")
+		n      = fs.Int("n", 1, "the number of solutions sample draws")
+		seed   = fs.Uint64("seed", 0, "the seed of sample, 0 for one based on the time")
+	)
+	return fs, func(args []string) {
+		// The flags may follow the action, as in db count -board=....
+		var flags, words = splitFlags(fs, args)
+		parseFlags(fs, flags)
+		var want = 1
+		if len(words) > 0 && (words[0] == "show" || words[0] == "sample") {
+			want = 2
+		}
+		if len(words) != want || *n < 1 {
+			fs.Usage()
+			os.Exit(exitUsage)
+		}
+		if *path == "" {
+			console.errorln("no store file given, use -store")
+			os.Exit(exitUsage)
+		}
+		s, err := iqpuzzler.ReadStore(*path)
+		if err != nil {
+			exit(err)
+		}
+		switch action, rest := words[0], words[1:]; action {
+		case "list":
+			var tw = tabwriter.NewWriter(console.at(levelResult), 0, 4, 2, ' ', 0)
+			fmt.Fprintln(tw, "HASH\tSOLUTIONS\tSET\tPIECES\tBOARD")
+			for _, b := range s.Boards() {
+				fmt.Fprintf(tw, "%s\t%d\t%s\t%d\t%s%s\n", b.Hash, s.Count(b.Hash), b.Set, len(b.Pieces), iqpuzzler.CompactBoard(b.Board), wrapFlag(b.Wrap))
+			}
+			tw.Flush()
+		case "show", "sample":
+			b, err := s.Lookup(rest[0])
+			if err != nil {
+				exit(err)
+			}
+			var sols []iqpuzzler.StoredSolution
+			if action == "show" {
+				sols, err = s.Solutions(b.Hash)
+			} else {
+				if *seed == 0 {
+					*seed = uint64(time.Now().UnixNano())
+				}
+				sols, err = s.Sample(b.Hash, *n, iqpuzzler.NewRand(*seed))
+			}
+			if err != nil {
+				exit(err)
+			}
+			var style = iqpuzzler.RenderStyle{Lines: true}
+			if set, ok := iqpuzzler.LookupPieceSet(b.Set); ok && gf.colored(os.Stdout) {
+				style.Palette = set.Palette
+			}
+			console.printf(levelResult, "board %s%s, set %s, pieces %s\n", iqpuzzler.CompactBoard(b.Board), wrapFlag(b.Wrap), b.Set, strings.Join(b.Pieces, ","))
+			console.printf(levelResult, "%d solutions, stored since %s by %s\n", s.Count(b.Hash), b.Created.Format("2006-01-02 15:04:05"), b.Solver)
+			if action == "sample" {
+				console.printf(levelResult, "%d drawn with seed %d\n", len(sols), *seed)
+			}
+			for i, sol := range sols {
+				console.printf(levelResult, "\n#%d, found %s\n", i+1, sol.Found.Format("2006-01-02 15:04:05"))
+				console.println(levelResult, sol.Solution.Render(b.Board, style))
+			}
+		case "count":
+			var (
+				p  = pf.load()
+				b  = p.board()
+				ps = p.searchPieces(b, false)
+			)
+			console.println(levelResult, s.Count(iqpuzzler.BoardHash(b, p.setID, ps)))
+		case "export":
+			if *format != "json" {
+				console.errorf("unknown export format %q, want json\n", *format)
+				os.Exit(exitUsage)
+			}
+			type exportBoard struct {
+				iqpuzzler.StoredBoard
+				Solutions []iqpuzzler.StoredSolution `json:"solutions"`
+			}
+			var res = struct {
+				Version int           `json:"version"`
+				Boards  []exportBoard `json:"boards"`
+			}{Version: iqpuzzler.StoreFormatVersion, Boards: []exportBoard{}}
+			for _, b := range s.Boards() {
+				sols, err := s.Solutions(b.Hash)
+				if err != nil {
+					exit(err)
+				}
+				res.Boards = append(res.Boards, exportBoard{b, append([]iqpuzzler.StoredSolution{}, sols...)})
+			}
+			var f = gf.create()
+			defer f.Close()
+			var enc = json.NewEncoder(f)
+			enc.SetIndent("", "  ")
+			if err := enc.Encode(res); err != nil {
+				exit(err)
+			}
+		default:
+			console.errorf("unknown action %q, want list, show, sample, count or export\n", action)
+			os.Exit(exitUsage)
+		}
+	}
+}
@@ -0,0 +1,224 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"smaart/iqpuzzler"
+)
+
+// doctorPuzzle is the puzzle doctor solves, with its unique solution.
+var doctorPuzzle = struct {
+	board, pieces, solution string
+}{"xIIII,....I,...x.,.....", "blue,green,mint", "xIIII,EBBBI,EEBxA,EEAAA"}
+
+// doctorCount is a puzzle of which the number of solutions is known, told
+// apart by the cells each piece covers.
+type doctorCount struct {
+	preset, board, pieces string
+	strategy              string
+	want                  int
+}
+
+var doctorCounts = []doctorCount{
+	{"mini", "4x5:x12.x6.", "blue,green,mint,red", "", 3},
+	// The two tilings of the 3x20 rectangle, each in its four images.
+	{"pentomino-3x20", "", "", "first-empty-cell", 8},
+}
+
+// errOverBudget is the error of checks doctor skips for lack of time.
+var errOverBudget = errors.New("over the time budget")
+
+func newDoctorCommand() (*flag.FlagSet, func(args []string)) {
+	var (
+		fs        = newFlagSet("doctor", "")
+		gf        = addGlobalFlags(fs, "write the report to this file")
+		set       = fs.String("set", "iq-puzzler", "check the built-in piece set, one of "+strings.Join(iqpuzzler.PieceSetNames(), ", "))
+		pieceFile = fs.String("piece-file", "", "check the pieces of this piece file instead")
+		preset    = fs.String("board-preset", "", "the board the pieces must fit, by default the standard one or the first of the piece set")
+		timeout   = fs.Duration("timeout", 30*time.Second, "the longest to spend on each known count")
+	)
+	return fs, func(args []string) {
+		parseFlags(fs, args)
+		var w = gf.create()
+		defer w.Close()
+		var failed bool
+		var report = func(name string, err error, format string, args ...any) {
+			switch {
+			case errors.Is(err, errOverBudget):
+				fmt.Fprintf(w, "skip  %s: %v\n", name, err)
+			case err != nil:
+				failed = true
+				fmt.Fprintf(w, "FAIL  %s: %s\n", name, strings.ReplaceAll(err.Error(), "\n", "\n        "))
+			default:
+				fmt.Fprintf(w, "ok    %s: %s\n", name, fmt.Sprintf(format, args...))
+			}
+		}
+		desc, err := doctorPieces(*set, *pieceFile, *preset)
+		report("pieces", err, "%s", desc)
+		report("transforms", iqpuzzler.CheckOrientations(), "%d orientations forming a group, named after their geometry", len(iqpuzzler.Orientations()))
+		report("puzzle", doctorSolve(), "%s has its one solution %s", doctorPuzzle.board, doctorPuzzle.solution)
+		for _, c := range doctorCounts {
+			d, err := c.check(*timeout)
+			report("count "+c.preset, err, "%d solutions in %s", c.want, d.Round(time.Millisecond))
+		}
+		if failed {
+			exitWith(exitFailure)
+		}
+	}
+}
+
+// doctorPieces loads and validates the pieces of the set or piece file,
+// describing them.
+func doctorPieces(set, pieceFile, preset string) (string, error) {
+	pset, ok := iqpuzzler.LookupPieceSet(set)
+	if !ok {
+		return "", fmt.Errorf("unknown piece set %q, want one of %s", set, strings.Join(iqpuzzler.PieceSetNames(), ", "))
+	}
+	var (
+		pieces = pset.Pieces
+		source = "piece set " + set
+	)
+	if pieceFile != "" {
+		fps, err := iqpuzzler.ReadPieceFile(pieceFile, pieces)
+		if err != nil {
+			return "", err
+		}
+		pieces, source = fps, pieceFile
+	}
+	if p, _ := iqpuzzler.LookupPreset("standard"); preset == "" && p.Set == set {
+		preset = "standard"
+	}
+	for _, n := range iqpuzzler.PresetNames() {
+		if p, _ := iqpuzzler.LookupPreset(n); preset == "" && p.Set == set {
+			preset = n
+		}
+	}
+	if preset == "" {
+		preset = "standard"
+	}
+	p, ok := iqpuzzler.LookupPreset(preset)
+	if !ok {
+		return "", fmt.Errorf("unknown board preset %q, want one of %s", preset, strings.Join(iqpuzzler.PresetNames(), ", "))
+	}
+	if err := iqpuzzler.ValidatePieces(pieces, p.Rows, p.Cols); err != nil {
+		return "", err
+	}
+	var area int
+	for _, pc := range pieces {
+		area += pc.Size()
+	}
+	var (
+		b    = p.Block(iqpuzzler.NewBoard(p.Rows, p.Cols))
+		desc = fmt.Sprintf("%d connected, distinct pieces of %s covering %d cells", len(pieces), source, area)
+	)
+	if b.Free() != area {
+		desc += fmt.Sprintf(", not the %d of the %s board", b.Free(), preset)
+	}
+	return desc, nil
+}
+
+// doctorSolve solves doctorPuzzle, checking its solution and that there is
+// no other.
+func doctorSolve() error {
+	var ps = iqpuzzler.DefaultRegistry.List()
+	b, err := iqpuzzler.ParseBoard(doctorPuzzle.board, 4, 5, ps, false)
+	if err != nil {
+		return err
+	}
+	avail, err := iqpuzzler.ParseAvailable(doctorPuzzle.pieces, ps)
+	if err != nil {
+		return err
+	}
+	s, err := iqpuzzler.NewSolver()
+	if err != nil {
+		return err
+	}
+	res, err := s.Solve(context.Background(), iqpuzzler.NewGame(b), avail)
+	if err != nil {
+		return err
+	}
+	if res.Count != 1 || !res.Complete {
+		return fmt.Errorf("got %d solutions, want 1", res.Count)
+	}
+	if err := iqpuzzler.VerifySolution(b, avail, res.Solution); err != nil {
+		return fmt.Errorf("invalid solution: %v", err)
+	}
+	if got := res.Solution.Render(b, iqpuzzler.RenderStyle{}); got != doctorPuzzle.solution {
+		return fmt.Errorf("got the solution %s, want %s", got, doctorPuzzle.solution)
+	}
+	return nil
+}
+
+// check counts the distinct solutions of the puzzle within the timeout,
+// returning how long it took. Each solution found is verified.
+func (c doctorCount) check(timeout time.Duration) (time.Duration, error) {
+	res, distinct, err := c.count(timeout, runtime.GOMAXPROCS(0))
+	if err != nil {
+		return res.Metrics.Duration, err
+	}
+	if len(distinct) != c.want {
+		return res.Metrics.Duration, fmt.Errorf("got %d distinct solutions, want %d", len(distinct), c.want)
+	}
+	if res.Count != len(distinct) {
+		return res.Metrics.Duration, fmt.Errorf("got %d solutions, but only %d distinct ones", res.Count, len(distinct))
+	}
+	return res.Metrics.Duration, nil
+}
+
+// count counts the solutions of the puzzle within the timeout with the
+// given number of workers, verifying each and counting how often each
+// distinct one was found.
+func (c doctorCount) count(timeout time.Duration, workers int) (iqpuzzler.SolveResult, map[string]int, error) {
+	var req = iqpuzzler.SolveRequest{Preset: c.preset, Board: c.board, Strategy: c.strategy, Parallelism: workers}
+	if c.pieces != "" {
+		req.Pieces = strings.Split(c.pieces, ",")
+	}
+	if timeout > 0 {
+		req.Timeout = timeout.String()
+	}
+	reg, err := req.Registry()
+	if err != nil {
+		return iqpuzzler.SolveResult{}, nil, err
+	}
+	b, ps, err := req.Puzzle(reg)
+	if err != nil {
+		return iqpuzzler.SolveResult{}, nil, err
+	}
+	opts, err := req.Options()
+	if err != nil {
+		return iqpuzzler.SolveResult{}, nil, err
+	}
+	var (
+		mu       sync.Mutex
+		distinct = make(map[string]int)
+		invalid  error
+	)
+	s, err := iqpuzzler.NewSolver(append(opts, iqpuzzler.WithOnSolution(func(sol iqpuzzler.Solution) {
+		var err = iqpuzzler.VerifySolution(b, ps, sol)
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil && invalid == nil {
+			invalid = fmt.Errorf("invalid solution: %v", err)
+		}
+		distinct[sol.Canonical()]++
+	}))...)
+	if err != nil {
+		return iqpuzzler.SolveResult{}, nil, err
+	}
+	res, err := s.Solve(context.Background(), iqpuzzler.NewGame(b), ps)
+	switch {
+	case err != nil:
+	case invalid != nil:
+		err = invalid
+	case !res.Complete:
+		err = fmt.Errorf("%w, %d distinct solutions found in %s", errOverBudget, len(distinct), timeout)
+	}
+	return res, distinct, err
+}
@@ -0,0 +1,324 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+
+	"smaart/iqpuzzler"
+)
+
+const editHelp = "arrows move  space occupied  A-Z hint  # blocked  . clear  s solve  w write  q quit"
+
+func newEditCommand() (*flag.FlagSet, func(args []string)) {
+	var (
+		fs      = newFlagSet("edit", "")
+		pf      = addPuzzleFlags(fs)
+		gf      = addGlobalFlags(fs, "write the board to this file, instead of -board-file")
+		timeout = fs.Duration("timeout", 10*time.Second, "the longest s searches for a solution")
+	)
+	return fs, func(args []string) {
+		parseFlags(fs, args)
+		if !isTerminal(os.Stdin) || !isTerminal(os.Stdout) {
+			console.errorln("edit needs a terminal")
+			os.Exit(exitUsage)
+		}
+		var path = *gf.output
+		if path == "" {
+			path = *pf.boardFile
+		}
+		if _, err := os.Stat(*pf.boardFile); *pf.boardFile != "" && errors.Is(err, os.ErrNotExist) {
+			// A new board file: start from the board of the preset.
+			*pf.boardFile = ""
+		}
+		*pf.region = ""
+		var p = pf.load()
+		var e = &editor{p: p, path: path, timeout: *timeout, in: bufio.NewReader(os.Stdin), out: bufio.NewWriter(os.Stdout)}
+		for _, row := range strings.Split(p.board().String(), ",") {
+			e.cells = append(e.cells, []byte(row))
+		}
+		if gf.colored(os.Stdout) {
+			e.pal = p.pal
+		}
+		restore, err := makeRaw(int(os.Stdin.Fd()))
+		if err != nil {
+			exit(err)
+		}
+		// The alternate screen keeps the terminal's contents, and the cursor is
+		// hidden in favor of the highlighted cell.
+		fmt.Print("\x1b[?1049h\x1b[?25l")
+		atExit(func() {
+			fmt.Print("\x1b[?25h\x1b[?1049l")
+			restore()
+		})
+		e.run()
+	}
+}
+
+// editor is the state of the board editor.
+type editor struct {
+	p     *puzzle
+	cells [][]byte
+	// x and y are the row and column of the cursor.
+	x, y int
+	// overlay holds the letters of the last solution found, nil if there is
+	// none or the board changed since.
+	overlay [][]byte
+	pal     iqpuzzler.Palette
+	path    string
+	timeout time.Duration
+	// dirty tells whether the board changed since it was last written.
+	dirty bool
+	// quitting is set by a q which was not obeyed because of changes.
+	quitting bool
+	in       *bufio.Reader
+	out      *bufio.Writer
+}
+
+// run reads keys until the editor is quit.
+func (e *editor) run() {
+	e.draw()
+	for {
+		e.out.Flush()
+		k, err := e.key()
+		if err != nil {
+			return
+		}
+		var quitting = e.quitting
+		e.quitting = false
+		switch k {
+		case "up":
+			e.move(-1, 0)
+		case "down":
+			e.move(1, 0)
+		case "left":
+			e.move(0, -1)
+		case "right":
+			e.move(0, 1)
+		case " ":
+			e.toggle('x')
+		case "#":
+			e.toggle('#')
+		case ".", "\x7f", "\b", "delete":
+			e.set('.')
+		case "s":
+			e.solve()
+		case "w":
+			e.write()
+		case "q", "\x03", "\x04":
+			if e.dirty && !quitting {
+				e.quitting = true
+				e.status("the board has changes, w writes them, q again quits")
+				break
+			}
+			return
+		default:
+			if len(k) == 1 && k[0] >= 'A' && k[0] <= 'Z' {
+				if _, ok := e.p.reg.ByLetter(rune(k[0])); !ok {
+					e.status(fmt.Sprintf("no piece has the letter %s", k))
+					break
+				}
+				e.set(k[0])
+			}
+		}
+	}
+}
+
+// key reads a key, naming the arrow and delete keys.
+func (e *editor) key() (string, error) {
+	c, err := e.in.ReadByte()
+	if err != nil || c != 27 {
+		return string(c), err
+	}
+	if b, _ := e.in.ReadByte(); b != '[' && b != 'O' {
+		return "", nil
+	}
+	var seq []byte
+	for {
+		b, err := e.in.ReadByte()
+		if err != nil {
+			return "", err
+		}
+		seq = append(seq, b)
+		if b >= 0x40 && b <= 0x7e {
+			break
+		}
+	}
+	switch string(seq) {
+	case "A":
+		return "up", nil
+	case "B":
+		return "down", nil
+	case "C":
+		return "right", nil
+	case "D":
+		return "left", nil
+	case "3~":
+		return "delete", nil
+	}
+	return "", nil
+}
+
+// move moves the cursor, redrawing only the cells it leaves and enters.
+func (e *editor) move(dx, dy int) {
+	var x, y = e.x + dx, e.y + dy
+	if x < 0 || x >= len(e.cells) || y < 0 || y >= len(e.cells[0]) {
+		return
+	}
+	var ox, oy = e.x, e.y
+	e.x, e.y = x, y
+	e.drawCell(ox, oy)
+	e.drawCell(x, y)
+}
+
+// toggle sets the cell under the cursor to c, or clears it if it is c.
+func (e *editor) toggle(c byte) {
+	if e.cells[e.x][e.y] == c {
+		c = '.'
+	}
+	e.set(c)
+}
+
+// set sets the cell under the cursor, dropping the solution shown.
+func (e *editor) set(c byte) {
+	if e.cells[e.x][e.y] == c {
+		return
+	}
+	e.cells[e.x][e.y], e.dirty = c, true
+	if e.overlay != nil {
+		e.overlay = nil
+		e.draw()
+		return
+	}
+	e.drawCell(e.x, e.y)
+}
+
+// board returns the board string of the cells.
+func (e *editor) board() string {
+	var rows = make([]string, len(e.cells))
+	for i, r := range e.cells {
+		rows[i] = string(r)
+	}
+	return strings.Join(rows, ",")
+}
+
+// solve searches a solution of the board and shows it over the free cells.
+func (e *editor) solve() {
+	var req = e.p.req
+	req.Board = e.board()
+	b, ps, err := req.Puzzle(e.p.reg)
+	if err != nil {
+		e.status(err.Error())
+		return
+	}
+	e.status("searching")
+	e.out.Flush()
+	solver, err := iqpuzzler.NewSolver(iqpuzzler.WithMaxSolutions(1), iqpuzzler.WithParallelism(runtime.GOMAXPROCS(0)), iqpuzzler.WithTimeout(e.timeout))
+	if err != nil {
+		e.status(err.Error())
+		return
+	}
+	res, err := solver.Solve(context.Background(), iqpuzzler.NewGame(b), ps)
+	if err != nil {
+		e.status(err.Error())
+		return
+	}
+	var msg = fmt.Sprintf("%s in %s", res.Status, res.Metrics.Duration.Round(time.Millisecond))
+	if res.Solution == nil {
+		if !res.Complete {
+			msg = fmt.Sprintf("no solution found within %s", e.timeout)
+		} else if w := areaWarning(b, ps); w != "" {
+			msg += ": " + w
+		}
+		e.status(msg)
+		return
+	}
+	e.overlay = make([][]byte, len(e.cells))
+	for x := range e.overlay {
+		e.overlay[x] = make([]byte, len(e.cells[x]))
+	}
+	for _, m := range res.Solution {
+		var l = byte(m.Piece.Letter())
+		if l == 0 {
+			l = '*'
+		}
+		for _, c := range m.Image() {
+			e.overlay[c[0]][c[1]] = l
+		}
+	}
+	e.draw()
+	e.status(msg)
+}
+
+// write writes the board to the board file, one row per line.
+func (e *editor) write() {
+	if e.path == "" {
+		e.status("no file to write to, start edit with -board-file or -o")
+		return
+	}
+	var s = strings.ReplaceAll(e.board(), ",", "\n") + "\n"
+	if err := os.WriteFile(e.path, []byte(s), 0o644); err != nil {
+		e.status(err.Error())
+		return
+	}
+	e.dirty = false
+	e.status("wrote " + e.path)
+}
+
+// The grid starts on the third line of the screen, with two columns a cell.
+const editTop = 3
+
+// draw redraws the whole screen.
+func (e *editor) draw() {
+	fmt.Fprint(e.out, "\x1b[H\x1b[2J")
+	fmt.Fprintf(e.out, "%s, %dx%d, piece set %s", e.p.req.Preset, len(e.cells), len(e.cells[0]), e.p.setID)
+	for x := range e.cells {
+		for y := range e.cells[x] {
+			e.drawCell(x, y)
+		}
+	}
+	fmt.Fprintf(e.out, "\x1b[%d;1H%s", editTop+len(e.cells)+2, editHelp)
+}
+
+// drawCell draws a cell in place, highlighted if the cursor is on it.
+func (e *editor) drawCell(x, y int) {
+	var (
+		c       = e.cells[x][y]
+		letter  = c >= 'A' && c <= 'Z'
+		overlay bool
+	)
+	if e.overlay != nil && e.overlay[x][y] != 0 && c == '.' {
+		c, overlay = e.overlay[x][y], true
+		letter = c >= 'A' && c <= 'Z'
+	}
+	fmt.Fprintf(e.out, "\x1b[%d;%dH", editTop+x, 2*y+1)
+	var sgr []string
+	if x == e.x && y == e.y {
+		sgr = append(sgr, "7")
+	}
+	if letter && e.pal != nil {
+		if p, ok := e.p.reg.ByLetter(rune(c)); ok && e.pal[p.Name()].ANSI != "" {
+			sgr = append(sgr, e.pal[p.Name()].ANSI)
+		}
+	}
+	if overlay && letter {
+		// Show the solution in lower case, apart from the hints.
+		c += 'a' - 'A'
+	}
+	if len(sgr) > 0 {
+		fmt.Fprintf(e.out, "\x1b[%sm%c\x1b[0m", strings.Join(sgr, ";"), c)
+	} else {
+		fmt.Fprintf(e.out, "%c", c)
+	}
+}
+
+// status shows the message below the grid.
+func (e *editor) status(msg string) {
+	fmt.Fprintf(e.out, "\x1b[%d;1H\x1b[2K%s", editTop+len(e.cells)+1, msg)
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+)
+
+// envPrefix starts the names of the environment variables setting flags.
+const envPrefix = "IQPUZZLER_"
+
+// envName returns the environment variable setting the flag with the given
+// name, e.g. IQPUZZLER_BOARD_PRESET for -board-preset.
+func envName(flag string) string {
+	return envPrefix + strings.ToUpper(strings.ReplaceAll(flag, "-", "_"))
+}
+
+// parseFlags parses the command line arguments and then sets every flag not
+// given on the command line from its environment variable, if that is set.
+// The values are parsed exactly like those of the flags. Invalid values exit
+// with the name of the variable. With -show-config, it prints the resulting
+// settings and exits.
+func parseFlags(fs *flag.FlagSet, args []string) {
+	fs.Parse(args)
+	var (
+		explicit = make(map[string]bool)
+		fromEnv  = make(map[string]bool)
+	)
+	fs.Visit(func(f *flag.Flag) { explicit[f.Name] = true })
+	fs.VisitAll(func(f *flag.Flag) {
+		if explicit[f.Name] {
+			return
+		}
+		var v, ok = os.LookupEnv(envName(f.Name))
+		if !ok {
+			return
+		}
+		if err := fs.Set(f.Name, v); err != nil {
+			fmt.Fprintf(fs.Output(), "invalid value %q for %s: %v\n", v, envName(f.Name), err)
+			os.Exit(exitUsage)
+		}
+		fromEnv[f.Name] = true
+	})
+	if f := fs.Lookup("show-config"); f != nil && f.Value.String() == "true" {
+		printConfig(fs, explicit, fromEnv)
+		os.Exit(0)
+	}
+}
+
+// printConfig prints the value of every flag and where it came from.
+func printConfig(fs *flag.FlagSet, explicit, fromEnv map[string]bool) {
+	var w = tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fs.VisitAll(func(f *flag.Flag) {
+		if f.Name == "show-config" {
+			return
+		}
+		var source = "default"
+		switch {
+		case explicit[f.Name]:
+			source = "flag"
+		case fromEnv[f.Name]:
+			source = envName(f.Name)
+		}
+		fmt.Fprintf(w, "-%s\t%q\t%s\n", f.Name, f.Value.String(), source)
+	})
+	w.Flush()
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"smaart/iqpuzzler"
+)
+
+// pruneSample is how many prunes of a kind are counted for every one
+// written to the event log.
+const pruneSample = 1024
+
+// event is a line of the event log. Seq numbers the events of a run from
+// 1, Type says which fields are set:
+//
+//	start     the command and its arguments
+//	parse     the board, the pieces to place, the number of free cells,
+//	          the board hash and the official challenge it is, if any
+//	solution  a solution, with its number in count
+//	prune     a sampled placement cut off, with the prunes of its kind so
+//	          far in count
+//	end       the status, count, completeness and metrics of the search
+type event struct {
+	Seq  int64     `json:"seq"`
+	Type string    `json:"type"`
+	Time time.Time `json:"time"`
+
+	Command string   `json:"command,omitempty"`
+	Args    []string `json:"args,omitempty"`
+
+	Board     string   `json:"board,omitempty"`
+	Pieces    []string `json:"pieces,omitempty"`
+	Free      int      `json:"free,omitempty"`
+	Hash      string   `json:"hash,omitempty"`
+	Challenge int      `json:"challenge,omitempty"`
+	Tier      string   `json:"tier,omitempty"`
+
+	Solution iqpuzzler.Solution `json:"solution,omitempty"`
+	Kind     string             `json:"kind,omitempty"`
+	Move     string             `json:"move,omitempty"`
+	Depth    int                `json:"depth,omitempty"`
+	Count    int64              `json:"count,omitempty"`
+
+	Status   string             `json:"status,omitempty"`
+	Complete *bool              `json:"complete,omitempty"`
+	Metrics  *iqpuzzler.Metrics `json:"metrics,omitempty"`
+}
+
+// eventLog appends the events of a run to a file. Its methods do nothing
+// on a nil log, which is what runs without -events get.
+type eventLog struct {
+	mu        sync.Mutex
+	w         *bufio.Writer
+	enc       *json.Encoder
+	seq       int64
+	solutions int64
+	prunes    map[string]int64
+}
+
+// openEvents opens the event log at path, if any, and writes the start
+// event. The log is flushed and closed at exit.
+func openEvents(path, command string) *eventLog {
+	if path == "" {
+		return nil
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		exit(err)
+	}
+	var l = &eventLog{w: bufio.NewWriter(f), prunes: make(map[string]int64)}
+	l.enc = json.NewEncoder(l.w)
+	atExit(func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		l.w.Flush()
+		f.Close()
+	})
+	l.emit(event{Type: "start", Command: command, Args: os.Args[2:]})
+	return l
+}
+
+func (l *eventLog) emit(e event) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.seq++
+	e.Seq, e.Time = l.seq, time.Now()
+	if e.Type == "solution" {
+		if l.solutions++; e.Count == 0 {
+			e.Count = l.solutions
+		}
+	}
+	// A failed write leaves a truncated log, which must not fail the run.
+	l.enc.Encode(e)
+}
+
+func (l *eventLog) parsed(p *puzzle, b *iqpuzzler.Board, ps []iqpuzzler.Piece) {
+	if l == nil {
+		return
+	}
+	var names = make([]string, 0, len(ps))
+	for _, p := range ps {
+		names = append(names, p.Name())
+	}
+	var e = event{Type: "parse", Board: iqpuzzler.CompactBoard(b), Pieces: names, Free: b.Free(), Hash: iqpuzzler.BoardHash(b, p.setID, ps)}
+	if c, ok, _ := iqpuzzler.Identify(b, p.req.Preset, p.pieces, p.req.Lenient); ok {
+		e.Challenge, e.Tier = c.Number, c.Tier
+	}
+	l.emit(e)
+}
+
+func (l *eventLog) solution(s iqpuzzler.Solution) {
+	l.emit(event{Type: "solution", Solution: s})
+}
+
+// prune counts the prune, writing every pruneSample-th of its kind.
+func (l *eventLog) prune(m iqpuzzler.Move, depth int, kind string) {
+	l.mu.Lock()
+	l.prunes[kind]++
+	var n = l.prunes[kind]
+	l.mu.Unlock()
+	if n%pruneSample == 1 {
+		l.emit(event{Type: "prune", Kind: kind, Move: m.String(), Depth: depth, Count: n})
+	}
+}
+
+func (l *eventLog) end(res iqpuzzler.SolveResult) {
+	if l == nil {
+		return
+	}
+	l.emit(event{Type: "end", Status: res.Status.String(), Count: int64(res.Count), Complete: &res.Complete, Metrics: &res.Metrics})
+}
+
+// options returns the solver options feeding the log.
+func (l *eventLog) options() []iqpuzzler.Option {
+	if l == nil {
+		return nil
+	}
+	return []iqpuzzler.Option{iqpuzzler.WithOnPrune(l.prune)}
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"smaart/iqpuzzler"
+)
+
+func newGenerateCommand() (*flag.FlagSet, func(args []string)) {
+	var (
+		fs       = newFlagSet("generate", "")
+		pf       = addPuzzleFlags(fs)
+		gf       = addGlobalFlags(fs, "write the puzzle to this file")
+		rf       = addRemoteFlags(fs)
+		remove   = fs.Int("remove", 3, "the number of pieces left to place")
+		unique   = fs.Bool("unique", false, "only generate puzzles with a single solution")
+		attempts = fs.Int("attempts", 100, "give up after this many puzzles without a single solution")
+		seed     = fs.Uint64("seed", 0, "the seed of the generator, 0 for one based on the time")
+	)
+	return fs, func(args []string) {
+		parseFlags(fs, args)
+		var logger = gf.logger()
+		if *seed == 0 {
+			*seed = uint64(time.Now().UnixNano())
+		}
+		var (
+			rc  = rf.client(pf)
+			p   = pf.load()
+			b   = p.board()
+			ps  = p.searchPieces(b, false)
+			req iqpuzzler.SolveRequest
+		)
+		if rc != nil {
+			var res generateResponse
+			if err := rc.call("/generate", iqpuzzler.GenerateRequest{
+				SolveRequest: remoteRequest(iqpuzzler.SolveRequest{Preset: p.req.Preset, Board: p.req.Board, Lenient: p.req.Lenient, Wrap: p.req.Wrap, Region: p.req.Region, Seed: *seed}, p.setID, ps),
+				Remove:       *remove,
+				Unique:       *unique,
+				Attempts:     *attempts,
+			}, &res); err != nil {
+				exit(err)
+			}
+			var err error
+			req = res.Puzzle
+			if b, err = req.ParseBoard(p.reg); err != nil {
+				exit(err)
+			}
+		} else {
+			gen, err := iqpuzzler.Generate(context.Background(), b, ps, iqpuzzler.GenerateOptions{
+				Remove:   *remove,
+				Unique:   *unique,
+				Attempts: *attempts,
+				Rand:     iqpuzzler.NewRand(*seed),
+				Logger:   logger,
+			})
+			if err != nil {
+				exit(err)
+			}
+			req, b = gen.Request(p.req), gen.Board
+		}
+		var w = gf.create()
+		defer w.Close()
+		fmt.Fprintf(w, "iq-puzzler solve -board-preset=%s%s -board=%s -pieces=%s\n", *pf.boardPreset, wrapFlag(req.Wrap), req.Board, strings.Join(req.Pieces, ","))
+		fmt.Fprintln(w, iqpuzzler.Solution(nil).Render(b, iqpuzzler.RenderStyle{Lines: true}))
+	}
+}
+
+func wrapFlag(wrap bool) string {
+	if wrap {
+		return " -wrap"
+	}
+	return ""
+}
@@ -0,0 +1,368 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "smaart/api/iqpuzzler/v1"
+	"smaart/iqpuzzler"
+)
+
+// grpcServer answers the requests of the gRPC API with the limits of the
+// HTTP server.
+type grpcServer struct {
+	pb.UnimplementedPuzzlerServer
+	s *server
+}
+
+// newGRPCServer returns a gRPC server with the service registered.
+func (s *server) newGRPCServer(opts ...grpc.ServerOption) *grpc.Server {
+	var gs = grpc.NewServer(append(opts,
+		grpc.MaxRecvMsgSize(int(s.maxBody)),
+		grpc.ChainUnaryInterceptor(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, h grpc.UnaryHandler) (any, error) {
+			if err := s.authorizedRPC(ctx); err != nil {
+				return nil, err
+			}
+			var start = time.Now()
+			defer s.logRPC(info.FullMethod, start)
+			return h(ctx, req)
+		}),
+		grpc.ChainStreamInterceptor(func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, h grpc.StreamHandler) error {
+			if err := s.authorizedRPC(ss.Context()); err != nil {
+				return err
+			}
+			var start = time.Now()
+			defer s.logRPC(info.FullMethod, start)
+			return h(srv, ss)
+		}),
+	)...)
+	pb.RegisterPuzzlerServer(gs, &grpcServer{s: s})
+	return gs
+}
+
+// authorizedRPC rejects calls without the server's token in their
+// authorization metadata.
+func (s *server) authorizedRPC(ctx context.Context) error {
+	if s.token == "" {
+		return nil
+	}
+	var md, _ = metadata.FromIncomingContext(ctx)
+	for _, v := range md.Get("authorization") {
+		if subtle.ConstantTimeCompare([]byte(v), []byte("Bearer "+s.token)) == 1 {
+			return nil
+		}
+	}
+	return status.Error(codes.Unauthenticated, "missing or wrong bearer token")
+}
+
+func (s *server) logRPC(method string, start time.Time) {
+	s.logger.Info("call", slog.String("method", method), slog.Duration("elapsed", time.Since(start)))
+}
+
+// solver checks the request and returns a solver for it, with its board
+// and pieces.
+func (g *grpcServer) solver(in *pb.SolveRequest, opts ...iqpuzzler.Option) (*iqpuzzler.Solver, *iqpuzzler.Board, []iqpuzzler.Piece, error) {
+	var req = fromProtoRequest(in)
+	_, b, ps, err := g.s.check(&req)
+	if err != nil {
+		return nil, nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ropts, err := req.Options()
+	if err != nil {
+		return nil, nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	solver, err := iqpuzzler.NewSolver(append(append(ropts, iqpuzzler.WithLogger(g.s.logger), iqpuzzler.WithTableCache(g.s.tables)), opts...)...)
+	if err != nil {
+		return nil, nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return solver, b, ps, nil
+}
+
+// Solve searches within the deadline of the call and the server's timeout,
+// whichever comes first.
+func (g *grpcServer) Solve(ctx context.Context, in *pb.SolveRequest) (*pb.SolveResponse, error) {
+	solver, b, ps, err := g.solver(in)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, g.s.timeout)
+	defer cancel()
+	res, err := solver.Solve(ctx, iqpuzzler.NewGame(b), ps)
+	var ae *iqpuzzler.AbortedError
+	if err != nil && !errors.As(err, &ae) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return toProtoResponse(iqpuzzler.NewSolveResponse(res, err))
+}
+
+// Solutions streams the solutions as they are found. Once the client
+// cancels the call, or the stream breaks, the search stops.
+func (g *grpcServer) Solutions(in *pb.SolveRequest, stream grpc.ServerStreamingServer[pb.SolutionsResponse]) error {
+	ctx, cancel := context.WithTimeout(stream.Context(), g.s.timeout)
+	defer cancel()
+	var sendErr error
+	solver, b, ps, err := g.solver(in, iqpuzzler.WithOnSolution(func(sol iqpuzzler.Solution) {
+		if sendErr != nil {
+			return
+		}
+		var p *pb.Solution
+		if p, sendErr = toProtoSolution(sol); sendErr == nil {
+			sendErr = stream.Send(&pb.SolutionsResponse{Result: &pb.SolutionsResponse_Solution{Solution: p}})
+		}
+		if sendErr != nil {
+			cancel()
+		}
+	}))
+	if err != nil {
+		return err
+	}
+	res, err := solver.Solve(ctx, iqpuzzler.NewGame(b), ps)
+	var ae *iqpuzzler.AbortedError
+	switch {
+	case sendErr != nil:
+		return sendErr
+	case err != nil && !errors.As(err, &ae):
+		return status.Error(codes.Internal, err.Error())
+	}
+	done, err := toProtoResponse(iqpuzzler.NewSolveResponse(res, err))
+	if err != nil {
+		return err
+	}
+	return stream.Send(&pb.SolutionsResponse{Result: &pb.SolutionsResponse_Done{Done: done}})
+}
+
+func (g *grpcServer) Verify(ctx context.Context, in *pb.VerifyRequest) (*pb.VerifyResponse, error) {
+	var req = iqpuzzler.VerifyRequest{SolveRequest: fromProtoRequest(in.GetPuzzle())}
+	sol, err := fromProtoSolution(in.GetSolution())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	req.Solution = sol
+	if len(req.Pieces) == 0 {
+		// Without pieces, the solution is checked to cover the board.
+		req.Pieces = req.Solution.Pieces()
+	}
+	reg, b, ps, err := g.s.check(&req.SolveRequest)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := req.Solution.Resolve(reg.List()); err != nil {
+		return &pb.VerifyResponse{Error: err.Error()}, nil
+	}
+	if err := iqpuzzler.VerifySolution(b, ps, req.Solution); err != nil {
+		return &pb.VerifyResponse{Error: err.Error()}, nil
+	}
+	return &pb.VerifyResponse{Valid: true}, nil
+}
+
+func (g *grpcServer) Generate(ctx context.Context, in *pb.GenerateRequest) (*pb.GenerateResponse, error) {
+	var req = iqpuzzler.GenerateRequest{
+		SolveRequest: fromProtoRequest(in.GetPuzzle()),
+		Remove:       int(in.GetRemove()),
+		Unique:       in.GetUnique(),
+		Attempts:     int(in.GetAttempts()),
+	}
+	_, b, ps, err := g.s.check(&req.SolveRequest)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.Seed == 0 {
+		req.Seed = uint64(time.Now().UnixNano())
+	}
+	ctx, cancel := context.WithTimeout(ctx, g.s.timeout)
+	defer cancel()
+	gen, err := iqpuzzler.Generate(ctx, b, ps, iqpuzzler.GenerateOptions{
+		Remove:   req.Remove,
+		Unique:   req.Unique,
+		Attempts: req.Attempts,
+		Rand:     iqpuzzler.NewRand(req.Seed),
+		Logger:   g.s.logger,
+	})
+	var ae *iqpuzzler.AbortedError
+	switch {
+	case errors.As(err, &ae):
+		return nil, status.Error(codes.DeadlineExceeded, err.Error())
+	case err != nil:
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	sol, err := toProtoSolution(gen.Solution)
+	if err != nil {
+		return nil, err
+	}
+	var puzzle = gen.Request(iqpuzzler.SolveRequest{Preset: req.Preset, Set: req.Set, Wrap: req.Wrap})
+	return &pb.GenerateResponse{Puzzle: toProtoRequest(puzzle), Solution: sol}, nil
+}
+
+func fromProtoRequest(r *pb.SolveRequest) iqpuzzler.SolveRequest {
+	return iqpuzzler.SolveRequest{
+		Preset:       r.GetPreset(),
+		Board:        r.GetBoard(),
+		Lenient:      r.GetLenient(),
+		Wrap:         r.GetWrap(),
+		Region:       r.GetRegion(),
+		Set:          r.GetSet(),
+		Pieces:       r.GetPieces(),
+		MaxSolutions: int(r.GetMaxSolutions()),
+		Timeout:      r.GetTimeout(),
+		MaxNodes:     r.GetMaxNodes(),
+		Parallelism:  int(r.GetParallelism()),
+		ParDepth:     int(r.GetParDepth()),
+		Strategy:     r.GetStrategy(),
+		Engine:       r.GetEngine(),
+		Paranoid:     r.GetParanoid(),
+		Shuffle:      r.GetShuffle(),
+		Seed:         r.GetSeed(),
+	}
+}
+
+func toProtoRequest(r iqpuzzler.SolveRequest) *pb.SolveRequest {
+	return &pb.SolveRequest{
+		Preset:       r.Preset,
+		Board:        r.Board,
+		Lenient:      r.Lenient,
+		Wrap:         r.Wrap,
+		Region:       r.Region,
+		Set:          r.Set,
+		Pieces:       r.Pieces,
+		MaxSolutions: int32(r.MaxSolutions),
+		Timeout:      r.Timeout,
+		MaxNodes:     r.MaxNodes,
+		Parallelism:  int32(r.Parallelism),
+		ParDepth:     int32(r.ParDepth),
+		Strategy:     r.Strategy,
+		Engine:       r.Engine,
+		Paranoid:     r.Paranoid,
+		Shuffle:      r.Shuffle,
+		Seed:         r.Seed,
+	}
+}
+
+func toProtoResponse(r iqpuzzler.SolveResponse) (*pb.SolveResponse, error) {
+	var res = &pb.SolveResponse{
+		Status:   pb.Status(r.Status),
+		Count:    int32(r.Count),
+		Complete: r.Complete,
+		Metrics: &pb.Metrics{
+			Nodes:       r.Metrics.Nodes,
+			Backtracks:  r.Metrics.Backtracks,
+			MaxDepth:    int32(r.Metrics.MaxDepth),
+			DurationNs:  int64(r.Metrics.Duration),
+			Prunes:      r.Metrics.Prunes,
+			Placements:  int32(r.Metrics.Placements),
+			TableBytes:  r.Metrics.TableBytes,
+			Versions:    int32(r.Metrics.Versions),
+			Transforms:  int32(r.Metrics.Transforms),
+			MemoryBytes: r.Metrics.MemoryBytes,
+		},
+		Error: r.Error,
+	}
+	for _, sol := range r.Solutions {
+		p, err := toProtoSolution(sol)
+		if err != nil {
+			return nil, err
+		}
+		res.Solutions = append(res.Solutions, p)
+	}
+	return res, nil
+}
+
+// moveBody is the JSON form of a move, which the moves of the gRPC API
+// mirror. The moves are converted through it, as it is the only form in
+// which a move can be made outside of package iqpuzzler.
+type moveBody struct {
+	Piece     string          `json:"piece"`
+	Letter    string          `json:"letter,omitempty"`
+	Transform string          `json:"transform"`
+	Position  iqpuzzler.Pos   `json:"position"`
+	Shape     []iqpuzzler.Pos `json:"shape"`
+	Cells     []iqpuzzler.Pos `json:"cells,omitempty"`
+	Wrap      *iqpuzzler.Pos  `json:"wrap,omitempty"`
+}
+
+func toProtoSolution(sol iqpuzzler.Solution) (*pb.Solution, error) {
+	data, err := json.Marshal(sol)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	var moves []moveBody
+	if err := json.Unmarshal(data, &moves); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	var res = &pb.Solution{}
+	for _, m := range moves {
+		var pm = &pb.Move{
+			Piece:     m.Piece,
+			Letter:    m.Letter,
+			Transform: m.Transform,
+			Position:  toProtoPos(m.Position),
+			Shape:     toProtoCells(m.Shape),
+			Cells:     toProtoCells(m.Cells),
+		}
+		if m.Wrap != nil {
+			pm.Wrap = toProtoPos(*m.Wrap)
+		}
+		res.Moves = append(res.Moves, pm)
+	}
+	return res, nil
+}
+
+func fromProtoSolution(sol *pb.Solution) (iqpuzzler.Solution, error) {
+	var moves []moveBody
+	for _, m := range sol.GetMoves() {
+		var mb = moveBody{
+			Piece:     m.GetPiece(),
+			Letter:    m.GetLetter(),
+			Transform: m.GetTransform(),
+			Position:  fromProtoPos(m.GetPosition()),
+			Shape:     fromProtoCells(m.GetShape()),
+			Cells:     fromProtoCells(m.GetCells()),
+		}
+		if m.GetWrap() != nil {
+			var w = fromProtoPos(m.GetWrap())
+			mb.Wrap = &w
+		}
+		moves = append(moves, mb)
+	}
+	data, err := json.Marshal(moves)
+	if err != nil {
+		return nil, err
+	}
+	var res iqpuzzler.Solution
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func toProtoPos(p iqpuzzler.Pos) *pb.Pos {
+	return &pb.Pos{Row: int32(p[0]), Col: int32(p[1])}
+}
+
+func fromProtoPos(p *pb.Pos) iqpuzzler.Pos {
+	return iqpuzzler.Pos{int(p.GetRow()), int(p.GetCol())}
+}
+
+func toProtoCells(ps []iqpuzzler.Pos) []*pb.Pos {
+	var res []*pb.Pos
+	for _, p := range ps {
+		res = append(res, toProtoPos(p))
+	}
+	return res
+}
+
+func fromProtoCells(ps []*pb.Pos) []iqpuzzler.Pos {
+	var res []iqpuzzler.Pos
+	for _, p := range ps {
+		res = append(res, fromProtoPos(p))
+	}
+	return res
+}
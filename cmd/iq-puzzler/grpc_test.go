@@ -0,0 +1,199 @@
+package main
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	pb "smaart/api/iqpuzzler/v1"
+)
+
+// newTestClient serves the gRPC API in memory with the given timeout and
+// returns a client of it. done receives the error of every streaming call
+// as its handler returns.
+func newTestClient(t *testing.T, timeout time.Duration) (client pb.PuzzlerClient, done <-chan error) {
+	t.Helper()
+	var (
+		s = &server{
+			timeout:        timeout,
+			maxSolutions:   1 << 20,
+			maxParallelism: 2,
+			maxBody:        1 << 16,
+			logger:         slog.New(slog.NewTextHandler(io.Discard, nil)),
+			tables:         newTableCache(""),
+		}
+		lis     = bufconn.Listen(1 << 20)
+		streams = make(chan error, 16)
+		gs      = s.newGRPCServer(grpc.ChainStreamInterceptor(func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, h grpc.StreamHandler) error {
+			var err = h(srv, ss)
+			streams <- err
+			return err
+		}))
+	)
+	go gs.Serve(lis)
+	t.Cleanup(gs.Stop)
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return pb.NewPuzzlerClient(conn), streams
+}
+
+func miniProtoRequest() *pb.SolveRequest {
+	return &pb.SolveRequest{Preset: "mini", Board: "4x5:x12.x6.", Pieces: []string{"blue", "green", "mint", "red"}}
+}
+
+func TestGRPCSolve(t *testing.T) {
+	var client, _ = newTestClient(t, time.Minute)
+	res, err := client.Solve(context.Background(), miniProtoRequest())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Status != pb.Status_STATUS_SOLVED || res.Count != 3 || len(res.Solutions) != 3 || !res.Complete {
+		t.Fatalf("got status %v with %d solutions (count %d), want 3", res.Status, len(res.Solutions), res.Count)
+	}
+	// A solution found verifies, and moving one of its pieces does not.
+	var puzzle = miniProtoRequest()
+	v, err := client.Verify(context.Background(), &pb.VerifyRequest{Puzzle: puzzle, Solution: res.Solutions[0]})
+	if err != nil || !v.Valid {
+		t.Errorf("verifying a solution found: %v, %v", v, err)
+	}
+	var moved = res.Solutions[1]
+	moved.Moves[0].Position.Row++
+	moved.Moves[0].Cells = nil
+	v, err = client.Verify(context.Background(), &pb.VerifyRequest{Puzzle: puzzle, Solution: moved})
+	if err != nil || v.Valid || v.Error == "" {
+		t.Errorf("verifying a solution with a piece moved: %v, %v", v, err)
+	}
+}
+
+func TestGRPCInvalid(t *testing.T) {
+	var client, _ = newTestClient(t, time.Minute)
+	var tests = []struct {
+		name string
+		req  *pb.SolveRequest
+	}{
+		{"unknown preset", &pb.SolveRequest{Preset: "huge"}},
+		{"bad board", &pb.SolveRequest{Preset: "mini", Board: "4x5:x12?x6."}},
+		{"unknown engine", &pb.SolveRequest{Preset: "mini", Engine: "quantum"}},
+		{"paranoid", &pb.SolveRequest{Preset: "mini", Paranoid: true}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := client.Solve(context.Background(), test.req); status.Code(err) != codes.InvalidArgument {
+				t.Errorf("got %v, want an invalid argument", err)
+			}
+		})
+	}
+}
+
+func TestGRPCSolutions(t *testing.T) {
+	var client, done = newTestClient(t, time.Minute)
+	stream, err := client.Solutions(context.Background(), miniProtoRequest())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var (
+		solutions int
+		result    *pb.SolveResponse
+	)
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		switch r := res.Result.(type) {
+		case *pb.SolutionsResponse_Solution:
+			solutions++
+		case *pb.SolutionsResponse_Done:
+			result = r.Done
+		}
+	}
+	if solutions != 3 || result == nil || result.Count != 3 || len(result.Solutions) != 0 {
+		t.Errorf("got %d solutions and result %v, want 3", solutions, result)
+	}
+	if err := <-done; err != nil {
+		t.Errorf("the call ended with %v", err)
+	}
+}
+
+// TestGRPCCancel cancels the stream of the solutions of the empty standard
+// board, which takes far longer to enumerate than the test, after the
+// first, and checks that the search on the server stops.
+func TestGRPCCancel(t *testing.T) {
+	var (
+		client, done = newTestClient(t, time.Minute)
+		ctx, cancel  = context.WithCancel(context.Background())
+	)
+	defer cancel()
+	stream, err := client.Solutions(ctx, &pb.SolveRequest{Strategy: "first-empty-cell"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res, err := stream.Recv(); err != nil || res.GetSolution() == nil {
+		t.Fatalf("got %v, %v, want a solution", res, err)
+	}
+	cancel()
+	select {
+	case err := <-done:
+		if status.Code(err) != codes.Canceled {
+			t.Errorf("the call ended with %v, want it canceled", err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("the search goes on after the call was canceled")
+	}
+}
+
+// TestGRPCDeadline checks that the deadline of the call, well before the
+// server's timeout, stops the search.
+func TestGRPCDeadline(t *testing.T) {
+	var client, _ = newTestClient(t, time.Hour)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	var start = time.Now()
+	_, err := client.Solve(ctx, &pb.SolveRequest{MaxSolutions: 1 << 20})
+	if status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("got %v, want the deadline exceeded", err)
+	}
+	if d := time.Since(start); d > 10*time.Second {
+		t.Errorf("the call took %s", d)
+	}
+}
+
+func TestGRPCGenerate(t *testing.T) {
+	var client, _ = newTestClient(t, time.Minute)
+	var puzzle = miniProtoRequest()
+	puzzle.Seed = 1
+	gen, err := client.Generate(context.Background(), &pb.GenerateRequest{Puzzle: puzzle, Remove: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(gen.Puzzle.Pieces) != 2 || gen.Solution == nil {
+		t.Fatalf("got the puzzle %v with the solution %v, want 2 pieces to place", gen.Puzzle, gen.Solution)
+	}
+	res, err := client.Solve(context.Background(), gen.Puzzle)
+	switch {
+	case err != nil:
+		t.Fatal(err)
+	case res.Status != pb.Status_STATUS_SOLVED:
+		t.Errorf("the generated puzzle is %v", res.Status)
+	}
+	if _, err := client.Generate(context.Background(), &pb.GenerateRequest{Puzzle: &pb.SolveRequest{Preset: "huge"}}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("generating on an unknown preset: %v", err)
+	}
+}
@@ -0,0 +1,229 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log/slog"
+	"os"
+	"os/exec"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// completionFlags select a command to run when a long search ends.
+type completionFlags struct {
+	command *string
+	timeout *time.Duration
+}
+
+func addCompletionFlags(fs *flag.FlagSet) *completionFlags {
+	return &completionFlags{
+		command: fs.String("on-complete", "", "run this command when the run ends, with {{.Status}}, {{.Count}}, {{.Duration}} and the like replaced"),
+		timeout: fs.Duration("on-complete-timeout", 10*time.Second, "kill the -on-complete command after this long"),
+	}
+}
+
+// completionInfo is what the -on-complete command is told about the run.
+type completionInfo struct {
+	// Command is the iq-puzzler command, such as count.
+	Command hookValue
+	// Status is the status of the search, or if there was none "done" or
+	// "error" depending on whether the run failed.
+	Status   hookValue
+	Count    int
+	Complete bool
+	Nodes    int64
+	// Duration is the time since the start of the run.
+	Duration time.Duration
+	ExitCode int
+	// Error is the error the run failed with, if any.
+	Error hookValue
+}
+
+// literal encloses the values the template puts into the -on-complete
+// command, so that splitWords keeps each within its word as it is.
+const literal = '\x00'
+
+// hookValue is a string of completionInfo. It prints enclosed in literal,
+// so that whatever it holds, spaces, quotes or backslashes, it cannot split
+// the word it is put in or add arguments.
+type hookValue string
+
+func (v hookValue) String() string {
+	return string(literal) + strings.ReplaceAll(string(v), string(literal), "") + string(literal)
+}
+
+// completionHook runs the -on-complete command at exit.
+type completionHook struct {
+	tmpl    *template.Template
+	timeout time.Duration
+	logger  *slog.Logger
+	start   time.Time
+	info    completionInfo
+}
+
+// completion is the hook of the run, nil if there is none.
+var completion *completionHook
+
+// setup installs the hook of the flags, for runs of the command. The
+// command line is a template, executed before it is split into words, and
+// no shell is involved. A command which does not parse, or split into
+// words for a run with nothing to tell, exits with a usage error.
+func (f *completionFlags) setup(command string, logger *slog.Logger) {
+	if *f.command == "" {
+		return
+	}
+	var h = &completionHook{timeout: *f.timeout, logger: logger, start: time.Now(), info: completionInfo{Command: hookValue(command)}}
+	var err error
+	h.tmpl, err = template.New("on-complete").Option("missingkey=error").Parse(*f.command)
+	if err == nil {
+		_, err = h.command(completionInfo{})
+	}
+	if err != nil {
+		console.errorf("invalid -on-complete: %v\n", err)
+		os.Exit(exitUsage)
+	}
+	completion = h
+}
+
+// command returns the words of the command telling info.
+func (h *completionHook) command(info completionInfo) ([]string, error) {
+	var sb strings.Builder
+	if err := h.tmpl.Execute(&sb, info); err != nil {
+		return nil, err
+	}
+	words, err := splitWords(sb.String())
+	if err == nil && len(words) == 0 {
+		err = errors.New("no command")
+	}
+	return words, err
+}
+
+// record notes the outcome of the search for the hook.
+func (h *completionHook) record(status string, count int, complete bool, nodes int64) {
+	if h == nil {
+		return
+	}
+	h.info.Status, h.info.Count, h.info.Complete, h.info.Nodes = hookValue(status), count, complete, nodes
+}
+
+// failed notes the error the run fails with.
+func (h *completionHook) failed(err error) {
+	if h == nil {
+		return
+	}
+	h.info.Error = hookValue(err.Error())
+}
+
+// run runs the command, printing on standard error how it failed and
+// logging that it succeeded. It does not change the exit code of the run.
+func (h *completionHook) run(code int) {
+	if h == nil {
+		return
+	}
+	h.info.Duration, h.info.ExitCode = time.Since(h.start).Round(time.Millisecond), code
+	switch {
+	case h.info.Status != "":
+	case code == 0:
+		h.info.Status = "done"
+	default:
+		h.info.Status = "error"
+	}
+	argv, err := h.command(h.info)
+	if err != nil {
+		console.errorf("on-complete command not run: %v\n", err)
+		return
+	}
+	var ctx, cancel = context.WithTimeout(context.Background(), h.timeout)
+	defer cancel()
+	var cmd = exec.CommandContext(ctx, argv[0], argv[1:]...)
+	cmd.Stdout, cmd.Stderr = os.Stderr, os.Stderr
+	err = cmd.Run()
+	switch {
+	case ctx.Err() != nil:
+		console.errorf("on-complete command %s killed after %s\n", argv[0], h.timeout)
+	case err != nil:
+		console.errorf("on-complete command %s failed: %v\n", argv[0], err)
+	default:
+		h.logger.Info("on-complete command done", slog.String("command", argv[0]))
+	}
+}
+
+// splitWords splits s into words at spaces, like a shell without
+// expansions: single quotes keep everything up to the next one, double
+// quotes everything but backslash escapes of " and \, and a backslash
+// outside quotes escapes the next character. What is enclosed in literal
+// is kept as it is, in quotes or not.
+func splitWords(s string) ([]string, error) {
+	var (
+		words []string
+		word  strings.Builder
+		in    bool
+	)
+	// value writes the value starting at s[i] to the word and returns the
+	// index of its end.
+	var value = func(i int) int {
+		var j = strings.IndexByte(s[i+1:], literal)
+		if j < 0 {
+			j = len(s) - i - 1
+		}
+		word.WriteString(s[i+1 : i+1+j])
+		return i + 1 + j
+	}
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == literal:
+			i, in = value(i), true
+		case c == ' ' || c == '\t' || c == '\n':
+			if in {
+				words = append(words, word.String())
+				word.Reset()
+				in = false
+			}
+		case c == '\'':
+			for i++; i < len(s) && s[i] != '\''; i++ {
+				if s[i] == literal {
+					i = value(i)
+				} else {
+					word.WriteByte(s[i])
+				}
+			}
+			if i == len(s) {
+				return nil, errors.New("unterminated single quote")
+			}
+			in = true
+		case c == '"':
+			for i++; i < len(s) && s[i] != '"'; i++ {
+				switch {
+				case s[i] == literal:
+					i = value(i)
+				case s[i] == '\\' && i+1 < len(s) && (s[i+1] == '"' || s[i+1] == '\\'):
+					i++
+					word.WriteByte(s[i])
+				default:
+					word.WriteByte(s[i])
+				}
+			}
+			if i == len(s) {
+				return nil, errors.New("unterminated double quote")
+			}
+			in = true
+		case c == '\\' && i+1 < len(s):
+			if i++; s[i] == literal {
+				i = value(i)
+			} else {
+				word.WriteByte(s[i])
+			}
+			in = true
+		default:
+			word.WriteByte(c)
+			in = true
+		}
+	}
+	if in {
+		words = append(words, word.String())
+	}
+	return words, nil
+}
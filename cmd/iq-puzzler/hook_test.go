@@ -0,0 +1,64 @@
+package main
+
+import (
+	"slices"
+	"testing"
+	"text/template"
+)
+
+func TestSplitWords(t *testing.T) {
+	var tests = []struct {
+		s    string
+		want []string
+	}{
+		{"", nil},
+		{"  notify-send  done ", []string{"notify-send", "done"}},
+		{`echo 'a  b' "c \"d\" \\" e\ f`, []string{"echo", "a  b", `c "d" \`, "e f"}},
+		{`echo '' x""y`, []string{"echo", "", "xy"}},
+		{"echo \x00a 'b\x00 c", []string{"echo", "a 'b", "c"}},
+		{"echo '\x00it's\x00' \"\x00\"x\x00\"", []string{"echo", "it's", `"x`}},
+		{"echo pre\x00 \x00post", []string{"echo", "pre post"}},
+	}
+	for _, test := range tests {
+		got, err := splitWords(test.s)
+		if err != nil || !slices.Equal(got, test.want) {
+			t.Errorf("splitWords(%q) = %q, %v, want %q", test.s, got, err, test.want)
+		}
+	}
+	for _, s := range []string{"echo 'a", `echo "a`} {
+		if _, err := splitWords(s); err == nil {
+			t.Errorf("splitWords(%q) did not fail", s)
+		}
+	}
+}
+
+// TestCompletionCommand checks that the template is executed before the
+// command is split, so that its actions may hold spaces and quotes, and
+// that the values it puts in stay within their words.
+func TestCompletionCommand(t *testing.T) {
+	var info = completionInfo{Command: "count", Status: "solved", Count: 3, Error: `it's "bad" \ ' x`}
+	var tests = []struct {
+		command string
+		want    []string
+	}{
+		{`notify-send '{{printf "%d solutions" .Count}}' done`, []string{"notify-send", "3 solutions", "done"}},
+		{"notify-send '{{.Command}}: {{.Status}} {{.Error}}'", []string{"notify-send", `count: solved it's "bad" \ ' x`}},
+		{"notify-send {{.Error}}", []string{"notify-send", `it's "bad" \ ' x`}},
+		{`notify-send {{if eq .Status "solved"}}yes{{else}}no{{end}}`, []string{"notify-send", "yes"}},
+	}
+	for _, test := range tests {
+		var h = &completionHook{tmpl: template.Must(template.New("on-complete").Parse(test.command))}
+		got, err := h.command(info)
+		if err != nil || !slices.Equal(got, test.want) {
+			t.Errorf("%s: got %q, %v, want %q", test.command, got, err, test.want)
+		}
+	}
+	var h = &completionHook{tmpl: template.Must(template.New("on-complete").Parse("{{.Error}}"))}
+	if _, err := h.command(completionInfo{}); err != nil {
+		t.Errorf("a command of an empty value: %v", err)
+	}
+	h.tmpl = template.Must(template.New("on-complete").Parse("  "))
+	if _, err := h.command(info); err == nil {
+		t.Error("an empty command did not fail")
+	}
+}
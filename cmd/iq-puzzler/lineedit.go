@@ -0,0 +1,116 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// lineReader reads command lines. If standard input is a terminal, it edits
+// them itself so that Tab completes the word before the cursor.
+type lineReader struct {
+	in  *bufio.Reader
+	out io.Writer
+	// complete returns the candidates for the last word of the line.
+	complete func(line string) []string
+	tty      bool
+}
+
+func newLineReader(out io.Writer, complete func(string) []string) *lineReader {
+	var tty bool
+	if fi, err := os.Stdin.Stat(); err == nil && fi.Mode()&os.ModeCharDevice != 0 {
+		tty = true
+	}
+	return &lineReader{in: bufio.NewReader(os.Stdin), out: out, complete: complete, tty: tty}
+}
+
+// readLine prints the prompt and returns the line entered, without the line
+// break. It returns io.EOF at the end of the input.
+func (l *lineReader) readLine(prompt string) (string, error) {
+	fmt.Fprint(l.out, prompt)
+	if l.tty {
+		if restore, err := makeRaw(int(os.Stdin.Fd())); err == nil {
+			defer restore()
+			return l.edit(prompt)
+		}
+	}
+	line, err := l.in.ReadString('\n')
+	if err == io.EOF && line != "" {
+		err = nil
+	}
+	return strings.TrimRight(line, "\r\n"), err
+}
+
+// edit reads a line key by key from a terminal in raw mode.
+func (l *lineReader) edit(prompt string) (string, error) {
+	var line []byte
+	for {
+		c, err := l.in.ReadByte()
+		if err != nil {
+			return "", err
+		}
+		switch {
+		case c == '\r' || c == '\n':
+			fmt.Fprint(l.out, "\r\n")
+			return string(line), nil
+		case c == 3: // Ctrl-C discards the line.
+			fmt.Fprint(l.out, "^C\r\n")
+			return "", nil
+		case c == 4: // Ctrl-D ends the input on an empty line.
+			if len(line) == 0 {
+				fmt.Fprint(l.out, "\r\n")
+				return "", io.EOF
+			}
+		case c == 127 || c == 8:
+			if len(line) > 0 {
+				line = line[:len(line)-1]
+				fmt.Fprint(l.out, "\b \b")
+			}
+		case c == '\t':
+			line = l.completeLine(prompt, line)
+		case c == 27: // Escape sequences such as the arrow keys are ignored.
+			if b, _ := l.in.ReadByte(); b == '[' {
+				for {
+					b, err := l.in.ReadByte()
+					if err != nil || b >= 0x40 && b <= 0x7e {
+						break
+					}
+				}
+			}
+		case c >= ' ':
+			line = append(line, c)
+			l.out.Write([]byte{c})
+		}
+	}
+}
+
+// completeLine completes the last word of the line as far as the candidates
+// agree, listing them if that does not extend it.
+func (l *lineReader) completeLine(prompt string, line []byte) []byte {
+	var (
+		s     = string(line)
+		word  = s[strings.LastIndexByte(s, ' ')+1:]
+		cands = l.complete(s)
+	)
+	if len(cands) == 0 {
+		return line
+	}
+	var prefix = cands[0]
+	for _, c := range cands[1:] {
+		for !strings.HasPrefix(c, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	if len(cands) == 1 {
+		prefix += " "
+	}
+	if len(prefix) > len(word) {
+		line = append(line[:len(line)-len(word)], prefix...)
+	} else {
+		fmt.Fprintf(l.out, "\r\n%s\r\n", strings.Join(cands, "  "))
+	}
+	fmt.Fprintf(l.out, "\r\x1b[K%s%s", prompt, line)
+	return line
+}
@@ -0,0 +1,364 @@
+// Command iq-puzzler solves IQ Puzzler style puzzles.
+//
+// Usage:
+//
+//	iq-puzzler <command> [flags]
+//
+// Run iq-puzzler help for the list of commands. Flags without a command are
+// those of solve.
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+
+	"smaart/iqpuzzler"
+)
+
+// command is a subcommand of the tool. run executes it with the arguments
+// following its name, exiting on errors.
+type command struct {
+	name    string
+	summary string
+	// flags returns a new flag set with the flags of the command, and the
+	// function running the command, which parses them from its arguments.
+	flags func() (*flag.FlagSet, func(args []string))
+}
+
+// run runs the command with the arguments.
+func (c *command) run(args []string) {
+	var _, run = c.flags()
+	run(args)
+}
+
+// commands lists the subcommands in the order of the usage message.
+var commands = []*command{
+	{"solve", "solve a board and print the solutions", newSolveCommand},
+	{"count", "count the solutions of a board", newCountCommand},
+	{"batch", "solve a file of puzzles in parallel", newBatchCommand},
+	{"pipe", "solve a board per input line, printing a JSON result per line", newPipeCommand},
+	{"generate", "generate a random puzzle", newGenerateCommand},
+	{"verify", "check the solutions in a solution file", newVerifyCommand},
+	{"render", "draw a board or the solutions in a solution file", newRenderCommand},
+	{"db", "query the solutions in a store file", newDBCommand},
+	{"book", "solve a pack of challenges ahead of time", newBookCommand},
+	{"bench", "time the solver on a suite of boards", newBenchCommand},
+	{"stats", "aggregate event logs and batch summaries", newStatsCommand},
+	{"doctor", "check the pieces and the solver against known results", newDoctorCommand},
+	{"pieces", "list the pieces of a set", newPiecesCommand},
+	{"edit", "edit a board on a grid in the terminal", newEditCommand},
+	{"repl", "explore a board interactively", newREPLCommand},
+	{"play", "solve a dealt puzzle against the clock", newPlayCommand},
+	{"daily", "print the puzzle of the day and track the streak", newDailyCommand},
+	{"progress", "print the challenges solved and the best times", newProgressCommand},
+	{"serve", "answer solve requests over HTTP", newServeCommand},
+	{"engine", "speak a line protocol for graphical frontends", newEngineCommand},
+	{"completion", "print a shell completion script", newCompletionCommand},
+}
+
+func main() {
+	if len(os.Args) > 1 && os.Args[1] == "__complete" {
+		for _, c := range complete(os.Args[2:]) {
+			fmt.Println(c)
+		}
+		return
+	}
+	cmd, args, err := route(os.Args[1:])
+	if err != nil {
+		console.errorln(err)
+		usage(console.errw)
+		os.Exit(exitUsage)
+	}
+	if cmd == nil {
+		usage(os.Stdout)
+		return
+	}
+	cmd.run(args)
+	exitWith(0)
+}
+
+// route returns the command to run for the arguments, and the arguments
+// left for it. Arguments starting with a flag run solve, so that command
+// lines from before there were commands keep working. It returns a nil
+// command for a request for help.
+func route(args []string) (*command, []string, error) {
+	if len(args) == 0 {
+		return nil, nil, errors.New("no command given")
+	}
+	switch args[0] {
+	case "help", "-h", "-help", "--help":
+		if len(args) > 1 && args[0] == "help" {
+			if c := lookupCommand(args[1]); c != nil {
+				return c, []string{"-h"}, nil
+			}
+			return nil, nil, fmt.Errorf("unknown command %q", args[1])
+		}
+		return nil, nil, nil
+	}
+	if strings.HasPrefix(args[0], "-") {
+		return lookupCommand("solve"), args, nil
+	}
+	if c := lookupCommand(args[0]); c != nil {
+		return c, args[1:], nil
+	}
+	return nil, nil, fmt.Errorf("unknown command %q", args[0])
+}
+
+func lookupCommand(name string) *command {
+	for _, c := range commands {
+		if c.name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "usage: iq-puzzler <command> [flags]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+	for _, c := range commands {
+		fmt.Fprintf(w, "  %-10s %s\n", c.name, c.summary)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Run iq-puzzler <command> -h for the flags of a command. Flags without a")
+	fmt.Fprintln(w, "command are those of solve.")
+}
+
+// newFlagSet returns the flag set of the command, which exits on errors.
+func newFlagSet(name, args string) *flag.FlagSet {
+	var fs = flag.NewFlagSet(name, flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), strings.TrimSpace("usage: iq-puzzler "+name+" [flags] "+args))
+		var visible = flag.NewFlagSet(name, flag.ContinueOnError)
+		fs.VisitAll(func(f *flag.Flag) {
+			if !hiddenFlags[f.Name] {
+				visible.Var(f.Value, f.Name, f.Usage)
+				visible.Lookup(f.Name).DefValue = f.DefValue
+			}
+		})
+		visible.SetOutput(fs.Output())
+		visible.PrintDefaults()
+	}
+	return fs
+}
+
+// isFlagSet reports whether the flag with the given name was set explicitly.
+func isFlagSet(fs *flag.FlagSet, name string) bool {
+	var res bool
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			res = true
+		}
+	})
+	return res
+}
+
+// splitFlags separates the flags among args from the other arguments, for
+// commands which take flags after their arguments. The arguments after --
+// are never flags.
+func splitFlags(fs *flag.FlagSet, args []string) (flags, rest []string) {
+	for i := 0; i < len(args); i++ {
+		var a = args[i]
+		switch {
+		case a == "--":
+			return flags, append(rest, args[i+1:]...)
+		case a == "-" || !strings.HasPrefix(a, "-"):
+			rest = append(rest, a)
+			continue
+		}
+		flags = append(flags, a)
+		var name = strings.TrimLeft(a, "-")
+		if strings.Contains(name, "=") {
+			continue
+		}
+		if f := fs.Lookup(name); f != nil && !isBoolFlag(f) && i+1 < len(args) {
+			i++
+			flags = append(flags, args[i])
+		}
+	}
+	return flags, rest
+}
+
+// globalFlags are the flags every command accepts.
+type globalFlags struct {
+	logFormat *string
+	color     *string
+	output    *string
+	// showConfig is read by parseFlags.
+	showConfig *bool
+}
+
+// addGlobalFlags adds the global flags to fs, with the given usage for -o.
+// Commands without output pass an empty usage and do not get -o.
+func addGlobalFlags(fs *flag.FlagSet, output string) *globalFlags {
+	var g = &globalFlags{
+		logFormat:  fs.String("log-format", "text", "the format of the log on standard error, text or json"),
+		color:      fs.String("color", "auto", "color pieces in the output: auto, always or never"),
+		output:     new(string),
+		showConfig: fs.Bool("show-config", false, "print the settings and whether they came from flags, the environment or the defaults, and exit"),
+	}
+	fs.Var(verbosityFlag{console}, "v", "print more: 1 adds the stats and notes, 2 the phases of the search, 3 every placement; repeatable")
+	fs.Var(quietFlag{console}, "q", "print nothing but errors")
+	if output != "" {
+		fs.StringVar(g.output, "o", "", output)
+	}
+	return g
+}
+
+// logger returns the logger selected by the flags.
+func (g *globalFlags) logger() *slog.Logger {
+	l, err := newLogger(os.Stderr, console.level, *g.logFormat)
+	if err != nil {
+		console.errorln(err)
+		os.Exit(exitUsage)
+	}
+	return l
+}
+
+// colored reports whether output to f should be colored.
+func (g *globalFlags) colored(f *os.File) bool {
+	switch *g.color {
+	case "always":
+		return true
+	case "never":
+		return false
+	case "auto":
+		return isTerminal(f) && os.Getenv("NO_COLOR") == ""
+	}
+	console.errorf("unknown color mode %q, want auto, always or never\n", *g.color)
+	os.Exit(exitUsage)
+	return false
+}
+
+// isTerminal reports whether f is a terminal.
+func isTerminal(f *os.File) bool {
+	fi, err := f.Stat()
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
+
+// create returns the file given by -o, or standard output if there is none.
+func (g *globalFlags) create() *os.File {
+	if *g.output == "" {
+		return os.Stdout
+	}
+	f, err := os.Create(*g.output)
+	if err != nil {
+		exit(err)
+	}
+	return f
+}
+
+// newLogger returns a logger writing to w in the given format, at level
+// Error when quiet, Warn up to levelSummary, Info for levelPhases and Debug
+// for levelTrace.
+func newLogger(w io.Writer, verbosity int, format string) (*slog.Logger, error) {
+	var level slog.Level
+	switch {
+	case verbosity <= levelQuiet:
+		level = slog.LevelError
+	case verbosity < levelPhases:
+		level = slog.LevelWarn
+	case verbosity == levelPhases:
+		level = slog.LevelInfo
+	default:
+		level = slog.LevelDebug
+	}
+	var opts = &slog.HandlerOptions{Level: level}
+	switch format {
+	case "text":
+		return slog.New(slog.NewTextHandler(w, opts)), nil
+	case "json":
+		return slog.New(slog.NewJSONHandler(w, opts)), nil
+	}
+	return nil, fmt.Errorf("unknown log format %q, want text or json", format)
+}
+
+// Exit codes.
+const (
+	exitFailure = 1
+	// exitUsage is used for invalid flags, boards and piece names.
+	exitUsage = 2
+	// exitAborted is used when the search was cancelled.
+	exitAborted = 3
+)
+
+// cleanups are run before the program exits, last first.
+var cleanups []func()
+
+// atExit registers fn to run before the program exits, also through exit
+// and exitWith, so that output files and profiles are complete.
+func atExit(fn func()) {
+	cleanups = append(cleanups, fn)
+}
+
+// exitWith runs the cleanups and exits with the code.
+func exitWith(code int) {
+	for i := len(cleanups) - 1; i >= 0; i-- {
+		cleanups[i]()
+	}
+	completion.run(code)
+	os.Exit(code)
+}
+
+// exit prints the error and exits with the code for its category.
+func exit(err error) {
+	console.errorln(err)
+	completion.failed(err)
+	var (
+		pe *iqpuzzler.ParseError
+		ue *iqpuzzler.UnknownPieceError
+		ae *iqpuzzler.AmbiguousPieceError
+		ce *iqpuzzler.AbortedError
+	)
+	if errors.As(err, &pe) {
+		if d := pe.Diagram(); d != "" {
+			console.errorln(d)
+		}
+	}
+	switch {
+	case errors.As(err, &pe), errors.As(err, &ue), errors.As(err, &ae):
+		exitWith(exitUsage)
+	case errors.As(err, &ce):
+		exitWith(exitAborted)
+	}
+	exitWith(exitFailure)
+}
+
+func printPresets() {
+	for _, n := range iqpuzzler.PresetNames() {
+		var p, _ = iqpuzzler.LookupPreset(n)
+		console.printf(levelResult, "%-16s %2dx%-2d  %-10s  %s\n", n, p.Rows, p.Cols, p.Set, p.Description)
+	}
+}
+
+// confirmBoard prints the inferred board and, if standard input is a
+// terminal, asks the user to confirm it.
+func confirmBoard(b string) (bool, error) {
+	console.errorf("Inferred board: %s\n", b)
+	for _, row := range strings.Split(b, ",") {
+		console.errorf("  %s\n", row)
+	}
+	if fi, err := os.Stdin.Stat(); err != nil || fi.Mode()&os.ModeCharDevice == 0 {
+		return true, nil
+	}
+	console.errorf("Use this board? [Y/n] ")
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err == io.EOF {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "", "y", "yes":
+		return true, nil
+	}
+	return false, nil
+}
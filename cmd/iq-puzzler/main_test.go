@@ -0,0 +1,156 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"os/exec"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// TestMain runs main instead of the tests in the processes started by
+// runMain.
+func TestMain(m *testing.M) {
+	if os.Getenv("IQ_PUZZLER_TEST_MAIN") != "" {
+		main()
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// runMain runs the program with the arguments in a process of its own, with
+// a home directory of its own, and returns its output and exit code.
+func runMain(t *testing.T, args ...string) (stdout, stderr string, code int) {
+	t.Helper()
+	return runMainInput(t, "", args...)
+}
+
+// runMainInput is runMain with the given standard input.
+func runMainInput(t *testing.T, stdin string, args ...string) (stdout, stderr string, code int) {
+	t.Helper()
+	exe, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var (
+		cmd       = exec.Command(exe, args...)
+		out, eout bytes.Buffer
+		home      = t.TempDir()
+	)
+	cmd.Env = append(os.Environ(), "IQ_PUZZLER_TEST_MAIN=1", "HOME="+home, "XDG_CONFIG_HOME="+home, "XDG_DATA_HOME="+home, "XDG_STATE_HOME="+home)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = strings.NewReader(stdin), &out, &eout
+	err = cmd.Run()
+	var ee *exec.ExitError
+	switch {
+	case errors.As(err, &ee):
+		code = ee.ExitCode()
+	case err != nil:
+		t.Fatal(err)
+	}
+	return out.String(), eout.String(), code
+}
+
+func TestRoute(t *testing.T) {
+	var tests = []struct {
+		name string
+		args []string
+		// cmd is the name of the command routed to, empty for help.
+		cmd  string
+		rest []string
+		err  bool
+	}{
+		{"command", []string{"count", "-board=5x11:55."}, "count", []string{"-board=5x11:55."}, false},
+		{"command alone", []string{"pieces"}, "pieces", []string{}, false},
+		{"bare flags", []string{"-board=5x11:55.", "-pieces=blue"}, "solve", []string{"-board=5x11:55.", "-pieces=blue"}, false},
+		{"double dash flag", []string{"--board=5x11:55."}, "solve", []string{"--board=5x11:55."}, false},
+		{"help", []string{"help"}, "", nil, false},
+		{"help flag", []string{"-h"}, "", nil, false},
+		{"long help flag", []string{"--help"}, "", nil, false},
+		{"help on a command", []string{"help", "count"}, "count", []string{"-h"}, false},
+		{"help on an unknown command", []string{"help", "sovle"}, "", nil, true},
+		{"unknown command", []string{"sovle"}, "", nil, true},
+		{"nothing", nil, "", nil, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cmd, rest, err := route(test.args)
+			if (err != nil) != test.err {
+				t.Fatalf("route(%q) = %v, want an error: %t", test.args, err, test.err)
+			}
+			var name string
+			if cmd != nil {
+				name = cmd.name
+			}
+			if name != test.cmd || !slices.Equal(rest, test.rest) {
+				t.Errorf("route(%q) = %q, %q, want %q, %q", test.args, name, rest, test.cmd, test.rest)
+			}
+		})
+	}
+}
+
+// TestCommandNames checks that the commands have distinct names, none of
+// which is taken for help or a flag.
+func TestCommandNames(t *testing.T) {
+	var seen = make(map[string]bool)
+	for _, c := range commands {
+		if seen[c.name] || c.name == "help" || c.name == "" || c.name[0] == '-' {
+			t.Errorf("invalid or duplicate command name %q", c.name)
+		}
+		seen[c.name] = true
+		if lookupCommand(c.name) != c {
+			t.Errorf("lookupCommand(%q) does not find the command", c.name)
+		}
+	}
+}
+
+// TestComplete checks the candidates of the completion, which takes the
+// flags of each command from its flag set.
+func TestComplete(t *testing.T) {
+	var tests = []struct {
+		words, want []string
+	}{
+		{[]string{"ba"}, []string{"batch"}},
+		{[]string{"batch", "-no-"}, []string{"-no-dead-states"}},
+		{[]string{"serve", "-tls"}, []string{"-tls-cert", "-tls-key"}},
+		{[]string{"-strategy=first"}, []string{"-strategy=first-empty-cell"}},
+		{[]string{"count", "-strategy", "piece"}, []string{"piece-order"}},
+		{[]string{"nope", "-"}, nil},
+	}
+	for _, test := range tests {
+		if got := complete(test.words); !slices.Equal(got, test.want) {
+			t.Errorf("complete(%q) = %q, want %q", test.words, got, test.want)
+		}
+	}
+	for _, c := range commands {
+		if fs := commandFlags(c); fs.Name() != c.name {
+			t.Errorf("the flags of %s are those of %s", c.name, fs.Name())
+		}
+	}
+}
+
+// TestErrorOutput checks that errors and usage messages go to standard
+// error, even with -q, and leave standard output to the results.
+func TestErrorOutput(t *testing.T) {
+	var tests = []struct {
+		args []string
+		want string
+		code int
+	}{
+		{[]string{"solve", "-board-preset=huge"}, "unknown board preset", exitUsage},
+		{[]string{"solve", "-q", "-board-preset=mini", "-board=4x5:x12?x6."}, "invalid character '?'", exitUsage},
+		{[]string{"solve", "-mark-solved"}, "-mark-solved requires -challenge", exitUsage},
+		{[]string{"serve", "-tls-cert=x.pem"}, "-tls-cert and -tls-key go together", exitUsage},
+		{[]string{"daily", "-tz=Nowhere/Else"}, "invalid time zone", exitUsage},
+		{[]string{"count", "-on-complete=echo {{"}, "invalid -on-complete", exitUsage},
+	}
+	for _, test := range tests {
+		t.Run(strings.Join(test.args, " "), func(t *testing.T) {
+			var stdout, stderr, code = runMain(t, test.args...)
+			if code != test.code || stdout != "" || !strings.Contains(stderr, test.want) {
+				t.Errorf("exit code %d, stdout %q, stderr %q; want %d with %q on standard error", code, stdout, stderr, test.code, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math"
+	"runtime/debug"
+	"strconv"
+	"strings"
+)
+
+// byteSize is a flag holding a number of bytes, written with an optional
+// unit such as 512MiB or 2GB.
+type byteSize int64
+
+// byteUnits are the units of byteSize, longest first so that MiB is not
+// taken for B.
+var byteUnits = []struct {
+	name string
+	size int64
+}{
+	{"KiB", 1 << 10}, {"MiB", 1 << 20}, {"GiB", 1 << 30}, {"TiB", 1 << 40},
+	{"KB", 1e3}, {"MB", 1e6}, {"GB", 1e9}, {"TB", 1e12},
+	{"K", 1 << 10}, {"M", 1 << 20}, {"G", 1 << 30}, {"T", 1 << 40},
+	{"B", 1},
+}
+
+func (b *byteSize) Set(s string) error {
+	var num, unit = s, int64(1)
+	for _, u := range byteUnits {
+		if n, ok := strings.CutSuffix(s, u.name); ok {
+			num, unit = strings.TrimSpace(n), u.size
+			break
+		}
+	}
+	f, err := strconv.ParseFloat(num, 64)
+	if err != nil || f < 0 || f*float64(unit) >= math.MaxInt64 {
+		return fmt.Errorf("invalid size %q, want a number of bytes such as 512MiB or 2GiB", s)
+	}
+	*b = byteSize(f * float64(unit))
+	return nil
+}
+
+func (b *byteSize) String() string {
+	for _, u := range byteUnits[:4] {
+		if n := int64(*b); n != 0 && n%u.size == 0 && n/u.size < 1024 {
+			return fmt.Sprintf("%d%s", n/u.size, u.name)
+		}
+	}
+	return strconv.FormatInt(int64(*b), 10)
+}
+
+// The shares of -max-mem given to the placement tables kept between
+// searches and to the set of the solutions of -store, which are the memory a
+// long enumeration grows; the rest is left to the search and the runtime.
+const (
+	tableCacheShare = 8
+	dedupShare      = 4
+)
+
+// addMaxMemFlag adds -max-mem.
+func addMaxMemFlag(fs *flag.FlagSet) *byteSize {
+	var b byteSize
+	fs.Var(&b, "max-mem", "the memory to stay within, such as 2GiB, 0 for no limit: sizes the table cache and the set of the solutions of -store to fit, and makes the garbage collector work harder near it")
+	return &b
+}
+
+// applyMaxMem makes the runtime collect garbage more often as the memory of
+// the process nears the limit of -max-mem, instead of growing past it. The
+// limit is soft: the process fails only if what it holds alive takes more.
+func applyMaxMem(limit byteSize) {
+	if limit > 0 {
+		debug.SetMemoryLimit(int64(limit))
+	}
+}
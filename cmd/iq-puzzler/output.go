@@ -0,0 +1,132 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+)
+
+// Output levels, chosen with -v and -q. Every message belongs to the lowest
+// level that needs it, so that level 0 stays stable for scripts.
+const (
+	// levelQuiet prints nothing but errors.
+	levelQuiet = -1
+	// levelResult prints solutions, counts and the outcome.
+	levelResult = 0
+	// levelSummary adds the stats of the search and notes on the board.
+	levelSummary = 1
+	// levelPhases adds the log of the phases of the search and their timing.
+	levelPhases = 2
+	// levelTrace adds every placement tried.
+	levelTrace = 3
+)
+
+// console writes the messages of the commands to standard output, and
+// errors and usage messages to standard error.
+var console = &printer{w: os.Stdout, errw: os.Stderr}
+
+// printer writes the messages up to its level to w, and errors to errw at
+// every level. It may be used concurrently.
+type printer struct {
+	mu    sync.Mutex
+	w     io.Writer
+	errw  io.Writer
+	level int
+}
+
+func (p *printer) printf(level int, format string, args ...any) {
+	fmt.Fprintf(p.at(level), format, args...)
+}
+
+func (p *printer) println(level int, args ...any) {
+	fmt.Fprintln(p.at(level), args...)
+}
+
+// errorf prints an error, which -q does not silence.
+func (p *printer) errorf(format string, args ...any) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	fmt.Fprintf(p.errw, format, args...)
+}
+
+func (p *printer) errorln(args ...any) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	fmt.Fprintln(p.errw, args...)
+}
+
+// at returns a writer for messages of the given level.
+func (p *printer) at(level int) io.Writer {
+	if level > p.level {
+		return io.Discard
+	}
+	return levelWriter{p}
+}
+
+type levelWriter struct {
+	p *printer
+}
+
+func (w levelWriter) Write(b []byte) (int, error) {
+	w.p.mu.Lock()
+	defer w.p.mu.Unlock()
+	return w.p.w.Write(b)
+}
+
+// verbosityFlag is -v: alone it raises the level by one, so that it may be
+// repeated, and -v=N sets it.
+type verbosityFlag struct {
+	p *printer
+}
+
+func (f verbosityFlag) String() string {
+	if f.p == nil {
+		return "0"
+	}
+	return strconv.Itoa(f.p.level)
+}
+
+func (f verbosityFlag) Set(s string) error {
+	if s == "true" {
+		f.p.level = max(f.p.level, levelResult) + 1
+		return nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 {
+		return fmt.Errorf("want a level from 0 to %d", levelTrace)
+	}
+	f.p.level = n
+	return nil
+}
+
+func (f verbosityFlag) IsBoolFlag() bool {
+	return true
+}
+
+// quietFlag is -q.
+type quietFlag struct {
+	p *printer
+}
+
+func (f quietFlag) String() string {
+	return strconv.FormatBool(f.p != nil && f.p.level == levelQuiet)
+}
+
+func (f quietFlag) Set(s string) error {
+	q, err := strconv.ParseBool(s)
+	if err != nil {
+		return err
+	}
+	if q {
+		f.p.level = levelQuiet
+	} else if f.p.level == levelQuiet {
+		f.p.level = levelResult
+	}
+	return nil
+}
+
+func (f quietFlag) IsBoolFlag() bool {
+	return true
+}
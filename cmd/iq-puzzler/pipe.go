@@ -0,0 +1,182 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"log/slog"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"smaart/iqpuzzler"
+)
+
+// pipeResult is a line of the output of pipe: the result of the search or
+// why the input line could not be searched.
+type pipeResult struct {
+	// Line is the number of the input line, from 1.
+	Line  int    `json:"line"`
+	Board string `json:"board,omitempty"`
+	*iqpuzzler.SolveResult
+	Error string `json:"error,omitempty"`
+}
+
+func newPipeCommand() (*flag.FlagSet, func(args []string)) {
+	var (
+		fs         = newFlagSet("pipe", "")
+		gf         = addGlobalFlags(fs, "")
+		preset     = fs.String("board-preset", "standard", "the board geometry of the lines, one of "+strings.Join(iqpuzzler.PresetNames(), ", "))
+		set        = fs.String("set", "", "the built-in piece set, by default the one of the preset")
+		lenient    = fs.Bool("lenient", false, "accept any character in the boards, treating everything except x as empty")
+		wrap       = fs.Bool("wrap", false, "make the boards toroidal, letting pieces wrap around their edges")
+		strategy   = fs.String("strategy", "piece-order", "the search strategy, piece-order or first-empty-cell")
+		j          = fs.Int("j", runtime.GOMAXPROCS(0), "the number of lines solved concurrently")
+		timeoutPer = fs.Duration("timeout-per", time.Minute, "the longest to search one line, 0 for no limit")
+		maxSol     = fs.Int("max-solutions", 1, "stop each line after this many solutions, 0 to count them all")
+		tableDir   = addTableCacheFlag(fs)
+	)
+	return fs, func(args []string) {
+		parseFlags(fs, args)
+		if *j < 1 || *maxSol < 0 {
+			fs.Usage()
+			os.Exit(exitUsage)
+		}
+		var (
+			logger    = gf.logger()
+			w         = gf.create()
+			ctx, stop = interruptContext()
+			base      = iqpuzzler.SolveRequest{Preset: *preset, Set: *set, Lenient: *lenient, Wrap: *wrap, Strategy: *strategy, MaxSolutions: *maxSol}
+			tables    = newTableCache(*tableDir)
+			todo      = make(chan pipeLine)
+			done      = make(chan pipeResult)
+			// slots bounds the lines read ahead of the one written next, and so
+			// the results held back to keep the output in order.
+			slots = make(chan struct{}, 4**j)
+			wg    sync.WaitGroup
+		)
+		defer w.Close()
+		defer stop()
+		if *timeoutPer > 0 {
+			base.Timeout = timeoutPer.String()
+		}
+		for range *j {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for l := range todo {
+					done <- solveLine(ctx, base, l, tables, logger)
+				}
+			}()
+		}
+		var readErr error
+		go func() {
+			defer close(todo)
+			var sc = bufio.NewScanner(os.Stdin)
+			sc.Buffer(nil, 1<<20)
+			for n := 1; sc.Scan(); n++ {
+				select {
+				case slots <- struct{}{}:
+				case <-ctx.Done():
+					return
+				}
+				todo <- pipeLine{n, sc.Text()}
+			}
+			readErr = sc.Err()
+		}()
+		go func() {
+			wg.Wait()
+			close(done)
+		}()
+		var order = newReorder()
+		for r := range done {
+			for _, r := range order.add(r) {
+				data, err := json.Marshal(r)
+				if err != nil {
+					exit(err)
+				}
+				if _, err := w.Write(append(data, '\n')); err != nil {
+					exit(err)
+				}
+				<-slots
+			}
+		}
+		if readErr != nil {
+			exit(readErr)
+		}
+		if ctx.Err() != nil {
+			exitWith(exitAborted)
+		}
+	}
+}
+
+// reorder holds back the results of lines finished before earlier ones,
+// so that they are written in the order of the input.
+type reorder struct {
+	pending map[int]pipeResult
+	// next is the number of the line to write next.
+	next int
+}
+
+func newReorder() *reorder {
+	return &reorder{pending: make(map[int]pipeResult), next: 1}
+}
+
+// add adds the result of a line and returns the results which can be
+// written now, in order.
+func (o *reorder) add(r pipeResult) []pipeResult {
+	o.pending[r.Line] = r
+	var ready []pipeResult
+	for r, ok := o.pending[o.next]; ok; r, ok = o.pending[o.next] {
+		delete(o.pending, o.next)
+		ready = append(ready, r)
+		o.next++
+	}
+	return ready
+}
+
+// pipeLine is a line of the input of pipe with its number.
+type pipeLine struct {
+	n    int
+	text string
+}
+
+// solveLine solves the board of the line, optionally followed by a tab and
+// the pieces to place separated by commas, with the placement tables shared
+// by the lines, reporting failures in the result.
+func solveLine(ctx context.Context, req iqpuzzler.SolveRequest, l pipeLine, tables *iqpuzzler.TableCache, logger *slog.Logger) pipeResult {
+	var (
+		res                = pipeResult{Line: l.n}
+		board, pieces, tab = strings.Cut(strings.TrimRight(l.text, "\r"), "\t")
+	)
+	res.Board = strings.TrimSpace(board)
+	switch {
+	case res.Board == "":
+		res.Error = "no board"
+		return res
+	case tab && strings.TrimSpace(pieces) == "":
+		res.Error = "no pieces after the tab"
+		return res
+	}
+	req.Board = res.Board
+	if tab {
+		for _, p := range strings.Split(pieces, ",") {
+			req.Pieces = append(req.Pieces, strings.TrimSpace(p))
+		}
+	}
+	sr, err := solveRequest(ctx, req, logger, iqpuzzler.WithTableCache(tables))
+	var ae *iqpuzzler.AbortedError
+	if err != nil && !errors.As(err, &ae) {
+		res.Error = err.Error()
+		return res
+	}
+	res.SolveResult = &sr
+	if err != nil {
+		res.Error = err.Error()
+	}
+	return res
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestReorder(t *testing.T) {
+	var tests = []struct {
+		name  string
+		lines []int
+		// ready holds the lines returned by each add.
+		ready [][]int
+	}{
+		{"in order", []int{1, 2, 3}, [][]int{{1}, {2}, {3}}},
+		{"reversed", []int{3, 2, 1}, [][]int{nil, nil, {1, 2, 3}}},
+		{"gap", []int{2, 4, 1, 3, 5}, [][]int{nil, nil, {1, 2}, {3, 4}, {5}}},
+		{"first late", []int{2, 3, 4, 5, 1}, [][]int{nil, nil, nil, nil, {1, 2, 3, 4, 5}}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var o = newReorder()
+			for i, n := range test.lines {
+				var got []int
+				for _, r := range o.add(pipeResult{Line: n}) {
+					got = append(got, r.Line)
+				}
+				if !slices.Equal(got, test.ready[i]) {
+					t.Errorf("add(%d) returned lines %v, want %v", n, got, test.ready[i])
+				}
+			}
+			if len(o.pending) != 0 {
+				t.Errorf("%d results left pending", len(o.pending))
+			}
+		})
+	}
+}
+
+func TestPipe(t *testing.T) {
+	const (
+		puzzle = "4x5:x12.x6.\tblue,green,mint,red"
+		lines  = 12
+	)
+	var (
+		in []string
+		// bad holds the lines which are malformed.
+		bad = map[int]string{3: "4x5:x12?x6.", 7: "", 8: "4x5:x12.x6.\t ", 11: "4x5:x12.x6.\tblue,purple"}
+	)
+	for n := 1; n <= lines; n++ {
+		if l, ok := bad[n]; ok {
+			in = append(in, l)
+		} else {
+			in = append(in, puzzle)
+		}
+	}
+	stdout, stderr, code := runMainInput(t, strings.Join(in, "\n")+"\n", "pipe", "-board-preset=mini", "-j=4", "-max-solutions=0")
+	if code != 0 {
+		t.Fatalf("exit code %d; stderr:\n%s", code, stderr)
+	}
+	var (
+		sc = bufio.NewScanner(strings.NewReader(stdout))
+		n  int
+	)
+	for sc.Scan() {
+		n++
+		var r struct {
+			Line  int    `json:"line"`
+			Count int    `json:"count"`
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(sc.Bytes(), &r); err != nil {
+			t.Fatalf("line %d: %v", n, err)
+		}
+		if r.Line != n {
+			t.Errorf("output line %d is the result of line %d", n, r.Line)
+		}
+		if _, ok := bad[n]; ok {
+			if r.Error == "" {
+				t.Errorf("line %d: no error for %q", n, bad[n])
+			}
+		} else if r.Error != "" || r.Count != 3 {
+			t.Errorf("line %d: %d solutions, error %q, want 3", n, r.Count, r.Error)
+		}
+	}
+	if n != lines {
+		t.Errorf("%d output lines, want %d", n, lines)
+	}
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"smaart/iqpuzzler"
+)
+
+// playSession is a game of the play command: a puzzle to complete against
+// the clock.
+type playSession struct {
+	// tier is the tier of the puzzle, whose best time is recorded.
+	tier string
+	// challenge is the number of the official challenge played, or 0.
+	challenge int
+	start     time.Time
+	// warn checks after each placement that the board can still be
+	// completed.
+	warn   bool
+	track  bool
+	hinted bool
+	gaveUp bool
+}
+
+func newPlayCommand() (*flag.FlagSet, func(args []string)) {
+	var (
+		fs      = newFlagSet("play", "")
+		pf      = addPuzzleFlags(fs)
+		gf      = addGlobalFlags(fs, "")
+		remove  = fs.Int("remove", 3, "the number of pieces left to place in generated puzzles")
+		unique  = fs.Bool("unique", false, "only deal generated puzzles with a single solution")
+		seed    = fs.Uint64("seed", 0, "the seed of the generator, 0 for one based on the time")
+		warn    = fs.Bool("warn", false, "warn when a placement leaves a board which cannot be completed")
+		noTrack = fs.Bool("no-track", false, "do not record best times and solved challenges")
+	)
+	return fs, func(args []string) {
+		parseFlags(fs, args)
+		var (
+			logger = gf.logger()
+			p      = pf.load()
+			b      = p.board()
+			ps     = p.searchPieces(b, false)
+			play   = &playSession{warn: *warn, track: !*noTrack}
+		)
+		switch {
+		case *pf.challenge != 0:
+			c, err := iqpuzzler.FindChallenge(*pf.challenge)
+			if err != nil {
+				exit(err)
+			}
+			play.tier, play.challenge = c.Tier, c.Number
+		case p.req.Board == "":
+			// Without a board, the puzzle is dealt by the generator.
+			if *seed == 0 {
+				*seed = uint64(time.Now().UnixNano())
+			}
+			gen, err := iqpuzzler.Generate(context.Background(), b, ps, iqpuzzler.GenerateOptions{
+				Remove: *remove,
+				Unique: *unique,
+				Rand:   iqpuzzler.NewRand(*seed),
+				Logger: logger,
+			})
+			if err != nil {
+				exit(err)
+			}
+			console.printf(levelSummary, "dealt with -seed=%d\n", *seed)
+			b, ps = gen.Board, gen.Pieces
+			fallthrough
+		default:
+			play.tier = fmt.Sprintf("%s-%d", *pf.boardPreset, len(ps))
+		}
+		var r = &repl{
+			w:        os.Stdout,
+			board:    b,
+			reg:      p.reg,
+			pieces:   ps,
+			game:     iqpuzzler.NewGame(b),
+			style:    iqpuzzler.RenderStyle{Lines: true},
+			logger:   logger,
+			commands: playCommands,
+			play:     play,
+		}
+		if gf.colored(os.Stdout) {
+			r.style.Palette = p.pal
+		}
+		console.printf(levelResult, "tier %s\n", play.tier)
+		if pr, err := loadProgressFile(); err == nil && pr.Best[play.tier] != 0 {
+			console.printf(levelResult, "best time %s\n", pr.Best[play.tier].Round(time.Millisecond))
+		}
+		r.show()
+		play.start = time.Now()
+		r.interact(io.Discard)
+	}
+}
+
+// played ends the game once the board is complete, after the command with
+// the given name.
+func (r *repl) played(cmd string) {
+	var s = r.play
+	switch {
+	case cmd == "hint":
+		s.hinted = true
+	case cmd == "place" && s.warn && len(r.unplaced()) > 0:
+		if _, err := r.solveRest(); errors.Is(err, errUnsolvable) {
+			fmt.Fprintf(r.w, "warning: %v\n", err)
+		}
+	}
+	if len(r.unplaced()) > 0 || r.game.Free() != 0 {
+		return
+	}
+	r.quit = true
+	var elapsed = time.Since(s.start)
+	switch {
+	case s.gaveUp:
+		fmt.Fprintf(r.w, "gave up after %s\n", elapsed.Round(time.Millisecond))
+		return
+	case s.hinted:
+		fmt.Fprintf(r.w, "well done, solved in %s with hints, which is not recorded\n", elapsed.Round(time.Millisecond))
+		return
+	}
+	fmt.Fprintf(r.w, "congratulations, solved in %s\n", elapsed.Round(time.Millisecond))
+	if !s.track {
+		return
+	}
+	best, err := recordTime(s.tier, elapsed)
+	if err != nil {
+		fmt.Fprintln(r.w, err)
+	} else if best == 0 || elapsed < best {
+		fmt.Fprintf(r.w, "a new best time for tier %s\n", s.tier)
+	} else {
+		fmt.Fprintf(r.w, "the best time for tier %s is %s\n", s.tier, best.Round(time.Millisecond))
+	}
+	if s.challenge != 0 {
+		if err := markSolved(s.challenge, time.Now()); err != nil {
+			fmt.Fprintln(r.w, err)
+		}
+	}
+}
+
+// cmdGiveUp completes the board from the current position, or failing that
+// from the empty one, and ends the game.
+func (r *repl) cmdGiveUp([]string) error {
+	if len(r.unplaced()) == 0 {
+		return errors.New("all pieces are placed")
+	}
+	sol, err := r.solveRest()
+	if errors.Is(err, errUnsolvable) {
+		var g = r.game
+		r.game = iqpuzzler.NewGame(r.board)
+		if sol, err = r.solveRest(); err != nil {
+			r.game = g
+		} else {
+			fmt.Fprintf(r.w, "%v, but from the start it can be\n", errUnsolvable)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	var g = r.game.Clone()
+	for _, m := range sol {
+		if err := g.Play(m.Piece, m.Translate); err != nil {
+			return err
+		}
+	}
+	r.save()
+	r.game = g
+	r.play.gaveUp = true
+	return nil
+}
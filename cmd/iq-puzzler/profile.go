@@ -0,0 +1,96 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"runtime"
+	"runtime/pprof"
+	"runtime/trace"
+)
+
+// profileFlags select the profiles written by the searching commands.
+type profileFlags struct {
+	cpu, mem, trace, block, mutex *string
+}
+
+func addProfileFlags(fs *flag.FlagSet) *profileFlags {
+	return &profileFlags{
+		cpu:   fs.String("cpuprofile", "", "write cpu profile to file"),
+		mem:   fs.String("memprofile", "", "write a heap profile to file at exit"),
+		trace: fs.String("trace", "", "write an execution trace of the run to file"),
+		block: fs.String("blockprofile", "", "write a goroutine blocking profile to file at exit"),
+		mutex: fs.String("mutexprofile", "", "write a mutex contention profile to file at exit"),
+	}
+}
+
+// hiddenFlags are left out of the usage messages and completions.
+var hiddenFlags = map[string]bool{"blockprofile": true, "mutexprofile": true}
+
+// start starts the profiles asked for and registers writing them with
+// atExit, so that they are complete even if the command fails or is
+// interrupted.
+func (p *profileFlags) start() {
+	if *p.cpu != "" {
+		var f = createProfile(*p.cpu)
+		if err := pprof.StartCPUProfile(f); err != nil {
+			exit(err)
+		}
+		atExit(func() {
+			pprof.StopCPUProfile()
+			closeProfile(f)
+		})
+	}
+	if *p.trace != "" {
+		var f = createProfile(*p.trace)
+		if err := trace.Start(f); err != nil {
+			exit(err)
+		}
+		atExit(func() {
+			trace.Stop()
+			closeProfile(f)
+		})
+	}
+	if *p.block != "" {
+		runtime.SetBlockProfileRate(1)
+		atExit(func() { writeProfile("block", *p.block) })
+	}
+	if *p.mutex != "" {
+		runtime.SetMutexProfileFraction(1)
+		atExit(func() { writeProfile("mutex", *p.mutex) })
+	}
+	if *p.mem != "" {
+		atExit(func() {
+			// Collect garbage so that the profile shows the live heap.
+			runtime.GC()
+			writeProfile("heap", *p.mem)
+		})
+	}
+}
+
+func createProfile(path string) *os.File {
+	f, err := os.Create(path)
+	if err != nil {
+		exit(err)
+	}
+	return f
+}
+
+func closeProfile(f *os.File) {
+	if err := f.Close(); err != nil {
+		console.errorln(err)
+	}
+}
+
+// writeProfile writes the named runtime profile to the file at path. It
+// runs while exiting, so it only reports errors.
+func writeProfile(name, path string) {
+	f, err := os.Create(path)
+	if err != nil {
+		console.errorln(err)
+		return
+	}
+	if err := pprof.Lookup(name).WriteTo(f, 0); err != nil {
+		console.errorln(err)
+	}
+	closeProfile(f)
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestProfiles(t *testing.T) {
+	var tests = []struct {
+		name string
+		args []string
+		code int
+	}{
+		{"success", []string{"-board-preset=mini", "-board=4x5:x12.x6.", "-pieces=blue,green,mint,red"}, 0},
+		{"failure", []string{"-board-preset=mini", "-board=4x5:x12?x6.", "-pieces=blue,green,mint,red"}, exitUsage},
+		{"stopped by a timeout", []string{"-timeout=10ms", "-j=1"}, 0},
+	}
+	var profiles = []string{"cpuprofile", "memprofile", "trace", "blockprofile", "mutexprofile"}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				dir  = t.TempDir()
+				args = []string{"solve"}
+			)
+			for _, p := range profiles {
+				args = append(args, "-"+p+"="+filepath.Join(dir, p))
+			}
+			_, stderr, code := runMain(t, append(args, test.args...)...)
+			if code != test.code {
+				t.Fatalf("exit code %d, want %d; stderr:\n%s", code, test.code, stderr)
+			}
+			for _, p := range profiles {
+				fi, err := os.Stat(filepath.Join(dir, p))
+				switch {
+				case err != nil:
+					t.Errorf("-%s: %v", p, err)
+				case fi.Size() == 0:
+					t.Errorf("-%s wrote an empty file", p)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"smaart/iqpuzzler"
+)
+
+// Progress records which official challenges have been solved and the best
+// times of play.
+type Progress struct {
+	// Solved maps challenge numbers to the time they were first solved.
+	Solved map[string]time.Time `json:"solved"`
+	// Best maps tiers to the shortest time a puzzle of the tier was solved
+	// in by play, in nanoseconds.
+	Best map[string]time.Duration `json:"best,omitempty"`
+	// Daily is the streak of daily puzzles done.
+	Daily *DailyStreak `json:"daily,omitempty"`
+}
+
+func newProgressCommand() (*flag.FlagSet, func(args []string)) {
+	var fs = newFlagSet("progress", "")
+	addGlobalFlags(fs, "")
+	return fs, func(args []string) {
+		parseFlags(fs, args)
+		if fs.NArg() != 0 {
+			fs.Usage()
+			os.Exit(exitUsage)
+		}
+		showProgress()
+	}
+}
+
+// showProgress prints the progress stored in the user's configuration
+// directory against the official challenges.
+func showProgress() {
+	cs, err := iqpuzzler.LoadChallenges()
+	if err != nil {
+		exit(err)
+	}
+	p, err := loadProgressFile()
+	if err != nil {
+		exit(err)
+	}
+	printProgress(cs, p)
+}
+
+func progressPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "iq-puzzler", "progress.json"), nil
+}
+
+// loadProgressFile loads the progress stored in the user's configuration
+// directory.
+func loadProgressFile() (*Progress, error) {
+	path, err := progressPath()
+	if err != nil {
+		return nil, err
+	}
+	return loadProgress(path)
+}
+
+func loadProgress(path string) (*Progress, error) {
+	var p = &Progress{Solved: make(map[string]time.Time), Best: make(map[string]time.Duration)}
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return p, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, p); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	if p.Solved == nil {
+		p.Solved = make(map[string]time.Time)
+	}
+	if p.Best == nil {
+		p.Best = make(map[string]time.Duration)
+	}
+	return p, nil
+}
+
+// markSolved records the challenge as solved, unless it has been already.
+func markSolved(n int, t time.Time) error {
+	return updateProgress(func(p *Progress) {
+		var k = strconv.Itoa(n)
+		if _, ok := p.Solved[k]; !ok {
+			p.Solved[k] = t.UTC()
+		}
+	})
+}
+
+// recordTime records d as the best time of the tier if it is shorter than
+// the one recorded. It returns the best time before, zero if there was none.
+func recordTime(tier string, d time.Duration) (time.Duration, error) {
+	var best time.Duration
+	var err = updateProgress(func(p *Progress) {
+		best = p.Best[tier]
+		if best == 0 || d < best {
+			p.Best[tier] = d
+		}
+	})
+	return best, err
+}
+
+// updateProgress applies f to the stored progress. Concurrent updates are
+// serialized with a lock file, and the file is replaced atomically so that
+// readers never see a partial write.
+func updateProgress(f func(*Progress)) error {
+	path, err := progressPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	unlock, err := lockFile(path + ".lock")
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	p, err := loadProgress(path)
+	if err != nil {
+		return err
+	}
+	f(p)
+	b, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(path, append(b, '\n'))
+}
+
+// lockFile acquires an exclusive lock by creating the given file, waiting
+// for up to five seconds for another process to release it.
+func lockFile(path string) (func(), error) {
+	for deadline := time.Now().Add(5 * time.Second); ; {
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+		if err == nil {
+			f.Close()
+			return func() { os.Remove(path) }, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, err
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("%s is locked by another process, remove it if that is not the case", path)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+func writeFileAtomic(path string, b []byte) error {
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// printProgress prints the completion per tier and the remaining challenges.
+func printProgress(cs []iqpuzzler.Challenge, p *Progress) {
+	type tier struct {
+		name          string
+		solved, total int
+	}
+	var (
+		tiers     []*tier
+		byName    = make(map[string]*tier)
+		remaining []int
+	)
+	for _, c := range cs {
+		var t = byName[c.Tier]
+		if t == nil {
+			t = &tier{name: c.Tier}
+			byName[c.Tier] = t
+			tiers = append(tiers, t)
+		}
+		t.total++
+		if _, ok := p.Solved[strconv.Itoa(c.Number)]; ok {
+			t.solved++
+		} else {
+			remaining = append(remaining, c.Number)
+		}
+	}
+	for _, t := range tiers {
+		console.printf(levelResult, "%-10s %3d/%d\n", t.name, t.solved, t.total)
+	}
+	sort.Ints(remaining)
+	if len(cs) > 0 {
+		console.printf(levelResult, "%d of %d challenges remaining: %v\n", len(remaining), len(cs), remaining)
+	} else {
+		console.printf(levelResult, "no challenges to solve: %v\n", iqpuzzler.ErrNoChallenges)
+	}
+	var best []string
+	for t := range p.Best {
+		best = append(best, t)
+	}
+	sort.Strings(best)
+	for _, t := range best {
+		console.printf(levelResult, "best time %-10s %s\n", t, p.Best[t].Round(time.Millisecond))
+	}
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestProgressFile updates the progress from concurrent goroutines, as
+// concurrent runs would, and checks that no update is lost and that a
+// challenge keeps the time it was first solved.
+func TestProgressFile(t *testing.T) {
+	var dir = t.TempDir()
+	t.Setenv("HOME", dir)
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	var (
+		first = time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+		wg    sync.WaitGroup
+	)
+	if err := markSolved(1, first); err != nil {
+		t.Fatal(err)
+	}
+	for i := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := markSolved(1+i, first.Add(time.Hour)); err != nil {
+				t.Error(err)
+			}
+			if _, err := recordTime(fmt.Sprintf("standard-%d", i), time.Duration(i+1)*time.Second); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	p, err := loadProgressFile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p.Solved) != 8 || len(p.Best) != 8 {
+		t.Errorf("got %d challenges solved and %d best times, want 8 each", len(p.Solved), len(p.Best))
+	}
+	if got := p.Solved["1"]; !got.Equal(first) {
+		t.Errorf("challenge 1 solved at %s, want the first time %s", got, first)
+	}
+	if best, err := recordTime("standard-0", time.Minute); err != nil || best != time.Second {
+		t.Errorf("the best time before was %s, %v, want 1s", best, err)
+	}
+}
+
+func TestProgressCommand(t *testing.T) {
+	var stdout, stderr, code = runMain(t, "progress")
+	if code != 0 {
+		t.Fatalf("exit code %d, stderr:\n%s", code, stderr)
+	}
+	if !strings.Contains(stdout, "no challenges to solve") && !strings.Contains(stdout, "challenges remaining") {
+		t.Errorf("progress printed\n%s", stdout)
+	}
+	if _, _, code := runMain(t, "progress", "extra"); code != exitUsage {
+		t.Errorf("progress with an argument: exit code %d, want %d", code, exitUsage)
+	}
+}
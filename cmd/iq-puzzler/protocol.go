@@ -0,0 +1,262 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"smaart/iqpuzzler"
+)
+
+// The engine command speaks a line protocol on standard input and output,
+// in the manner of chess engines, for graphical frontends. Each line holds
+// one command, words are separated by blanks, and empty lines are ignored:
+//
+//	isready                  answer readyok, also during a search
+//	preset NAME              select a board preset and clear the position
+//	set [NAME]               select a piece set; none for the preset's
+//	position BOARD           set the board string, in plain or compact form;
+//	                         "position empty" clears it
+//	pieces [NAME...]         set the pieces to place, separated by blanks or
+//	                         commas; none for all pieces not on the board
+//	go [movetime MS] [nodes N] [solutions N]
+//	                         start a search for up to N solutions, 1 by
+//	                         default and 0 for all
+//	stop                     stop the search
+//	quit                     stop the search and exit
+//
+// At the end of the input the engine exits once the search has ended.
+//
+// The engine greets with "id name iq-puzzler" and answers with:
+//
+//	readyok
+//	info nodes N depth D solutions S time MS
+//	                         about once a second during a search
+//	solution MOVE...         a solution, each MOVE as PIECE:ORIENTATION:CELL,
+//	                         CELL being the top left corner of the bounding
+//	                         box of the piece such as B3
+//	done STATUS solutions N nodes N time MS
+//	                         the end of a search; STATUS is solved,
+//	                         unsolvable or aborted
+//	error MESSAGE            a command failed
+//
+// A go which starts a search is followed by exactly one done line, preceded
+// by an error line if the search failed. Only isready, stop and quit are
+// accepted while a search runs.
+
+func newEngineCommand() (*flag.FlagSet, func(args []string)) {
+	var (
+		fs = newFlagSet("engine", "")
+		gf = addGlobalFlags(fs, "")
+	)
+	return fs, func(args []string) {
+		parseFlags(fs, args)
+		var e = &engine{w: os.Stdout, logger: gf.logger()}
+		if err := e.run(os.Stdin); err != nil {
+			exit(err)
+		}
+	}
+}
+
+// engine runs the protocol. Searches run in their own goroutine, so that
+// the commands read meanwhile can stop them.
+type engine struct {
+	// mu serializes the lines written to w.
+	mu     sync.Mutex
+	w      io.Writer
+	logger *slog.Logger
+	req    iqpuzzler.SolveRequest
+	// cancel stops the running search, and done is closed once it has
+	// ended. Both are nil while idle.
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// run reads commands from in until quit, which stops the running search, or
+// the end of the input, which waits for it to end.
+func (e *engine) run(in io.Reader) error {
+	e.println("id name iq-puzzler")
+	var sc = bufio.NewScanner(in)
+	for sc.Scan() {
+		var fields = strings.Fields(sc.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if fields[0] == "quit" {
+			e.stop()
+			return nil
+		}
+		if err := e.exec(fields[0], fields[1:]); err != nil {
+			e.println("error " + err.Error())
+		}
+	}
+	if e.done != nil {
+		<-e.done
+	}
+	return sc.Err()
+}
+
+func (e *engine) println(line string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	fmt.Fprintln(e.w, line)
+}
+
+// searching reports whether a search is running.
+func (e *engine) searching() bool {
+	if e.done == nil {
+		return false
+	}
+	select {
+	case <-e.done:
+		e.cancel, e.done = nil, nil
+		return false
+	default:
+		return true
+	}
+}
+
+// stop stops the running search, if any, and waits for it to end.
+func (e *engine) stop() {
+	if e.done != nil {
+		e.cancel()
+		<-e.done
+		e.cancel, e.done = nil, nil
+	}
+}
+
+func (e *engine) exec(cmd string, args []string) error {
+	switch cmd {
+	case "isready":
+		e.println("readyok")
+		return nil
+	case "stop":
+		e.stop()
+		return nil
+	}
+	if e.searching() {
+		return fmt.Errorf("%s: a search is running", cmd)
+	}
+	switch cmd {
+	case "preset":
+		if len(args) != 1 {
+			return errors.New("usage: preset NAME")
+		}
+		e.req.Preset, e.req.Board = args[0], ""
+	case "set":
+		if len(args) > 1 {
+			return errors.New("usage: set NAME")
+		}
+		e.req.Set = strings.Join(args, "")
+	case "position":
+		if len(args) != 1 {
+			return errors.New("usage: position BOARD")
+		}
+		e.req.Board = args[0]
+		if args[0] == "empty" {
+			e.req.Board = ""
+		}
+	case "pieces":
+		e.req.Pieces = nil
+		for _, a := range args {
+			for _, n := range strings.Split(a, ",") {
+				if n != "" {
+					e.req.Pieces = append(e.req.Pieces, n)
+				}
+			}
+		}
+	case "go":
+		return e.goSearch(args)
+	default:
+		return fmt.Errorf("unknown command %q", cmd)
+	}
+	return nil
+}
+
+// goSearch starts a search with the limits given as arguments of go.
+func (e *engine) goSearch(args []string) error {
+	var req = e.req
+	req.MaxSolutions = 1
+	// With a worker per placement of the first piece, the runnable workers
+	// can keep the goroutine reading stop from running for seconds.
+	req.Parallelism = runtime.GOMAXPROCS(0)
+	if len(args)%2 != 0 {
+		return errors.New("usage: go [movetime MS] [nodes N] [solutions N]")
+	}
+	for i := 0; i < len(args); i += 2 {
+		n, err := strconv.ParseInt(args[i+1], 10, 64)
+		if err != nil || n < 0 {
+			return fmt.Errorf("invalid %s %q", args[i], args[i+1])
+		}
+		switch args[i] {
+		case "movetime":
+			req.Timeout = (time.Duration(n) * time.Millisecond).String()
+		case "nodes":
+			req.MaxNodes = n
+		case "solutions":
+			req.MaxSolutions = int(n)
+		default:
+			return fmt.Errorf("unknown limit %q", args[i])
+		}
+	}
+	if err := req.Validate(); err != nil {
+		return err
+	}
+	reg, err := req.Registry()
+	if err != nil {
+		return err
+	}
+	b, ps, err := req.Puzzle(reg)
+	if err != nil {
+		return err
+	}
+	opts, err := req.Options()
+	if err != nil {
+		return err
+	}
+	var start = time.Now()
+	opts = append(opts,
+		iqpuzzler.WithLogger(e.logger),
+		iqpuzzler.WithOnSolution(func(sol iqpuzzler.Solution) {
+			var moves = make([]string, len(sol))
+			for i, m := range sol {
+				moves[i] = moveNotation(m, b)
+			}
+			e.println("solution " + strings.Join(moves, " "))
+		}),
+		iqpuzzler.WithProgress(func(p iqpuzzler.Progress) {
+			var depth int
+			if p.Snapshot != nil {
+				depth = len(p.Snapshot.Moves())
+			}
+			e.println(fmt.Sprintf("info nodes %d depth %d solutions %d time %d", p.Nodes, depth, p.Solutions, p.Elapsed.Milliseconds()))
+		}),
+	)
+	solver, err := iqpuzzler.NewSolver(opts...)
+	if err != nil {
+		return err
+	}
+	var ctx context.Context
+	ctx, e.cancel = context.WithCancel(context.Background())
+	e.done = make(chan struct{})
+	go func(done chan struct{}) {
+		defer close(done)
+		res, err := solver.Solve(ctx, iqpuzzler.NewGame(b), ps)
+		var ae *iqpuzzler.AbortedError
+		if err != nil && !errors.As(err, &ae) {
+			e.println("error " + err.Error())
+		}
+		e.println(fmt.Sprintf("done %s solutions %d nodes %d time %d", res.Status, res.Count, res.Metrics.Nodes, time.Since(start).Milliseconds()))
+	}(e.done)
+	return nil
+}
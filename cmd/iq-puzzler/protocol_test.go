@@ -0,0 +1,128 @@
+package main
+
+import (
+	"io"
+	"log/slog"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+var infoLine = regexp.MustCompile(`^info nodes \d+ depth \d+ solutions \d+ time \d+$`)
+
+// session runs the engine on the script and returns the lines it wrote but
+// the info lines, whose number depends on timing, checking their format.
+func session(t *testing.T, script string) []string {
+	t.Helper()
+	var (
+		out strings.Builder
+		e   = &engine{w: &out, logger: slog.New(slog.NewTextHandler(io.Discard, nil))}
+	)
+	if err := e.run(strings.NewReader(script)); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	var lines []string
+	for _, l := range strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n") {
+		switch {
+		case !strings.HasPrefix(l, "info "):
+			lines = append(lines, l)
+		case !infoLine.MatchString(l):
+			t.Errorf("malformed info line %q", l)
+		}
+	}
+	return lines
+}
+
+// match checks that the lines match the patterns, one regular expression
+// per line.
+func match(t *testing.T, lines []string, patterns ...string) {
+	t.Helper()
+	if len(lines) != len(patterns) {
+		t.Fatalf("got %d lines, want %d:\n%s", len(lines), len(patterns), strings.Join(lines, "\n"))
+	}
+	for i, p := range patterns {
+		if !regexp.MustCompile("^" + p + "$").MatchString(lines[i]) {
+			t.Errorf("line %d = %q, want to match %q", i+1, lines[i], p)
+		}
+	}
+}
+
+const miniSetup = "preset mini\nposition 4x5:x12.x6.\npieces blue,green mint red\n"
+
+func TestEngineSession(t *testing.T) {
+	var lines = session(t, "isready\n\n"+miniSetup+"go solutions 0\n")
+	match(t, lines,
+		"id name iq-puzzler",
+		"readyok",
+		"solution( [a-z]+:[IMR0-9]+:[A-Z][0-9]+){4}",
+		"solution( [a-z]+:[IMR0-9]+:[A-Z][0-9]+){4}",
+		"solution( [a-z]+:[IMR0-9]+:[A-Z][0-9]+){4}",
+		`done solved solutions 3 nodes \d+ time \d+`,
+	)
+}
+
+func TestEngineLimits(t *testing.T) {
+	var tests = []struct {
+		name, setup, limits string
+		done                string
+		solutions           int
+	}{
+		{"default", miniSetup, "", "done solved solutions 1 .*", 1},
+		{"solutions", miniSetup, "solutions 2", "done solved solutions 2 .*", 2},
+		{"nodes", "", "nodes 1 solutions 0", "done aborted solutions 0 .*", 0},
+		{"movetime", "", "movetime 1 solutions 0", "done aborted solutions 0 .*", 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var lines = session(t, test.setup+"go "+test.limits+"\n")
+			var patterns = []string{"id name iq-puzzler"}
+			for range test.solutions {
+				patterns = append(patterns, "solution .*")
+			}
+			match(t, lines, append(patterns, test.done)...)
+		})
+	}
+}
+
+func TestEngineErrors(t *testing.T) {
+	var tests = []struct {
+		name, script, want string
+	}{
+		{"unknown command", "castle\n", `error unknown command "castle"`},
+		{"preset without name", "preset\n", "error usage: preset NAME"},
+		{"set with two names", "set a b\n", "error usage: set NAME"},
+		{"position without board", "position\n", "error usage: position BOARD"},
+		{"odd limits", "go movetime\n", `error usage: go .*`},
+		{"unknown limit", "go depth 3\n", `error unknown limit "depth"`},
+		{"negative limit", "go nodes -1\n", `error invalid nodes "-1"`},
+		{"unknown preset", "preset huge\ngo\n", `error unknown board preset "huge".*`},
+		{"bad board", "preset mini\nposition 4x5:x12?x6.\ngo\n", "error .*"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			match(t, session(t, test.script), "id name iq-puzzler", test.want)
+		})
+	}
+}
+
+func TestEngineStop(t *testing.T) {
+	// The empty standard board takes far longer to count than the script
+	// takes to reach stop, so the commands in between meet a running
+	// search.
+	var lines = session(t, "go solutions 0\nisready\npreset mini\nstop\nisready\n")
+	match(t, lines,
+		"id name iq-puzzler",
+		"readyok",
+		`error preset: a search is running`,
+		`done aborted solutions 0 nodes \d+ time \d+`,
+		"readyok",
+	)
+}
+
+func TestEngineQuit(t *testing.T) {
+	var lines = session(t, "go solutions 0\nquit\nisready\n")
+	match(t, lines,
+		"id name iq-puzzler",
+		`done aborted solutions 0 nodes \d+ time \d+`,
+	)
+}
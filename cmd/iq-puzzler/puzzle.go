@@ -0,0 +1,312 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"smaart/iqpuzzler"
+)
+
+// puzzleFlags select the board and the pieces.
+type puzzleFlags struct {
+	fs          *flag.FlagSet
+	board       *string
+	boardFile   *string
+	boardPreset *string
+	available   *string
+	lenient     *bool
+	wrap        *bool
+	set         *string
+	pieceFile   *string
+	boardImage  *string
+	paletteFile *string
+	region      *string
+	challenge   *int
+}
+
+func addPuzzleFlags(fs *flag.FlagSet) *puzzleFlags {
+	return &puzzleFlags{
+		fs:          fs,
+		board:       fs.String("board", "", "The board (0 for empty, x for occupied), optionally in compact form (e.g. 5x11:55.), empty by default"),
+		boardFile:   fs.String("board-file", "", "read the board from this file, one row per line"),
+		boardPreset: fs.String("board-preset", "standard", "the board geometry, one of "+strings.Join(iqpuzzler.PresetNames(), ", ")+", or list to print them"),
+		available:   fs.String("pieces", "", "the available pieces, by default those whose letters are not on the board"),
+		lenient:     fs.Bool("lenient", false, "accept any character in the board, treating everything except x as empty"),
+		wrap:        fs.Bool("wrap", false, "make the board toroidal, letting pieces wrap around its edges"),
+		set:         fs.String("set", "iq-puzzler", "the built-in piece set, one of "+strings.Join(iqpuzzler.PieceSetNames(), ", ")),
+		pieceFile:   fs.String("piece-file", "", "read the piece set from this file instead of using a built-in one"),
+		boardImage:  fs.String("board-image", "", "infer the board from a PNG photo (experimental)"),
+		paletteFile: fs.String("palette", "", "read piece colors, letters and emojis from this file"),
+		region:      fs.String("region", "", "restrict the board to the rectangle r1,c1,r2,c2 (1-based, inclusive)"),
+		challenge:   fs.Int("challenge", 0, "solve the official challenge with this number (not available yet: the catalog of official challenges is empty)"),
+	}
+}
+
+// puzzle is the piece set and the request selected by the puzzle flags.
+type puzzle struct {
+	preset iqpuzzler.Preset
+	// pieces is the whole piece set.
+	pieces []iqpuzzler.Piece
+	pal    iqpuzzler.Palette
+	reg    *iqpuzzler.Registry
+	// setID identifies the piece set in solution files.
+	setID string
+	req   iqpuzzler.SolveRequest
+}
+
+// load resolves the piece set and reads the board given by the flags.
+func (f *puzzleFlags) load() *puzzle {
+	if *f.challenge != 0 {
+		c, err := iqpuzzler.FindChallenge(*f.challenge)
+		if err != nil {
+			exit(err)
+		}
+		*f.board, *f.boardPreset = c.Board, c.Preset
+	}
+	preset, ok := iqpuzzler.LookupPreset(*f.boardPreset)
+	if !ok {
+		console.errorf("unknown board preset %q, want one of %s\n", *f.boardPreset, strings.Join(iqpuzzler.PresetNames(), ", "))
+		os.Exit(exitUsage)
+	}
+	if !isFlagSet(f.fs, "set") && preset.Set != "" {
+		*f.set = preset.Set
+	}
+	pset, ok := iqpuzzler.LookupPieceSet(*f.set)
+	if !ok {
+		console.errorf("unknown piece set %q, want one of %s\n", *f.set, strings.Join(iqpuzzler.PieceSetNames(), ", "))
+		os.Exit(exitUsage)
+	}
+	var pieces = pset.Pieces
+	if *f.pieceFile != "" {
+		fps, err := iqpuzzler.ReadPieceFile(*f.pieceFile, pieces)
+		if err != nil {
+			exit(err)
+		}
+		pieces = fps
+	}
+	pal, err := iqpuzzler.ResolvePalette(pieces, pset.Palette, *f.paletteFile)
+	if err != nil {
+		exit(err)
+	}
+	pal.ApplyLetters(pieces)
+	if err := iqpuzzler.ValidatePieces(pieces, preset.Rows, preset.Cols); err != nil {
+		exit(err)
+	}
+	reg, err := iqpuzzler.NewRegistry(pieces...)
+	if err != nil {
+		exit(err)
+	}
+	var p = &puzzle{preset: preset, pieces: pieces, pal: pal, reg: reg, setID: *f.set}
+	if *f.pieceFile != "" {
+		p.setID = *f.pieceFile
+	}
+	if *f.boardImage != "" {
+		b, err := iqpuzzler.ReadBoardImage(*f.boardImage, pal, pieces, preset.Rows, preset.Cols)
+		if err != nil {
+			exit(err)
+		}
+		ok, err := confirmBoard(b)
+		if err != nil {
+			exit(err)
+		}
+		if !ok {
+			os.Exit(exitFailure)
+		}
+		*f.board = b
+	}
+	if *f.boardFile != "" {
+		b, err := iqpuzzler.ReadBoardFile(*f.boardFile)
+		if err != nil {
+			exit(err)
+		}
+		*f.board = b
+	}
+	p.req = iqpuzzler.SolveRequest{
+		Preset:  *f.boardPreset,
+		Board:   *f.board,
+		Lenient: *f.lenient,
+		Wrap:    *f.wrap,
+		Region:  *f.region,
+	}
+	if *f.available != "" {
+		p.req.Pieces = strings.Split(*f.available, ",")
+	}
+	return p
+}
+
+// board parses the board of the request.
+func (p *puzzle) board() *iqpuzzler.Board {
+	if err := p.req.Validate(); err != nil {
+		console.errorln(err)
+		os.Exit(exitUsage)
+	}
+	b, err := p.req.ParseBoard(p.reg)
+	if err != nil {
+		exit(err)
+	}
+	return b
+}
+
+// searchFlags are the settings of the search.
+type searchFlags struct {
+	maxSolutions *int
+	timeout      *time.Duration
+	maxNodes     *int64
+	parallelism  *int
+	parDepth     *parDepth
+	strategy     *string
+	engine       *string
+	stats        *string
+	paranoid     *bool
+	shuffle      *bool
+	seed         *uint64
+	book         *string
+	learn        *string
+	events       *string
+	tableCache   *string
+	maxMem       *byteSize
+	profiles     *profileFlags
+	complete     *completionFlags
+}
+
+func addSearchFlags(fs *flag.FlagSet) *searchFlags {
+	return &searchFlags{
+		maxSolutions: fs.Int("max-solutions", 0, "stop after this many solutions, 0 for all"),
+		timeout:      fs.Duration("timeout", 0, "stop searching after this long, 0 for no limit"),
+		maxNodes:     fs.Int64("max-nodes", 0, "stop searching after about this many placements, 0 for no limit"),
+		parallelism:  fs.Int("j", runtime.GOMAXPROCS(0), "the number of goroutines searching concurrently, 0 for one per placement of the first piece"),
+		parDepth:     addParDepthFlag(fs),
+		strategy:     fs.String("strategy", "piece-order", "the search strategy, piece-order or first-empty-cell"),
+		engine:       fs.String("engine", "", "the search engine, one of "+strings.Join(iqpuzzler.EngineNames(), ", ")+"; overrides -strategy"),
+		stats:        fs.String("stats", "", "print the outcome and metrics of the search as text or json"),
+		paranoid:     fs.Bool("paranoid", false, "check the invariants of the game at every step of the search (slow)"),
+		shuffle:      fs.Bool("shuffle", false, "search the pieces and placements in a random order"),
+		seed:         fs.Uint64("seed", 0, "the seed of the random order, 0 for one based on the time"),
+		book:         fs.String("book", "", "answer from this book file, as written by book build, if it holds the puzzle"),
+		learn:        fs.String("learn", "", "try the placements which were part of the most solutions recorded in this file first, and record the solutions found in it"),
+		events:       fs.String("events", "", "append the events of the run to this file as JSON lines"),
+		tableCache:   addTableCacheFlag(fs),
+		maxMem:       addMaxMemFlag(fs),
+		profiles:     addProfileFlags(fs),
+		complete:     addCompletionFlags(fs),
+	}
+}
+
+// apply copies the settings into the request.
+func (f *searchFlags) apply(req *iqpuzzler.SolveRequest) {
+	req.MaxSolutions = *f.maxSolutions
+	req.MaxNodes = *f.maxNodes
+	req.Parallelism = *f.parallelism
+	req.ParDepth = int(*f.parDepth)
+	req.Strategy = *f.strategy
+	req.Engine = *f.engine
+	req.Paranoid = *f.paranoid
+	req.Shuffle = *f.shuffle
+	req.Seed = *f.seed
+	if *f.timeout != 0 {
+		req.Timeout = f.timeout.String()
+	}
+	if req.Shuffle && req.Seed == 0 {
+		req.Seed = uint64(time.Now().UnixNano())
+	}
+}
+
+// parDepth is a flag holding the deepest placement parallel workers share,
+// a number or auto.
+type parDepth int
+
+func (d *parDepth) Set(s string) error {
+	if s == "auto" {
+		*d = iqpuzzler.ParDepthAuto
+		return nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 1 {
+		return fmt.Errorf("invalid depth %q, want a number from 1 or auto", s)
+	}
+	*d = parDepth(n)
+	return nil
+}
+
+func (d *parDepth) String() string {
+	if *d == iqpuzzler.ParDepthAuto {
+		return "auto"
+	}
+	return strconv.Itoa(int(*d))
+}
+
+// addParDepthFlag adds -par-depth.
+func addParDepthFlag(fs *flag.FlagSet) *parDepth {
+	var d parDepth
+	fs.Var(&d, "par-depth", "the deepest placement a goroutine hands to an idle one rather than trying it itself, or auto to pick it from the branching of the first placements; by default those leaving three pieces or more")
+	return &d
+}
+
+// tableCacheBytes is the most memory the placement tables kept between the
+// searches of a process take.
+const tableCacheBytes = 64 << 20
+
+// addTableCacheFlag adds the flag naming the directory keeping the
+// placement tables between processes.
+func addTableCacheFlag(fs *flag.FlagSet) *string {
+	return fs.String("table-cache", "", "keep the placement tables of the pieces in this directory, to skip building them in later runs")
+}
+
+// newTableCache returns the cache of the placement tables of a process,
+// keeping them in dir as well if it is not empty.
+func newTableCache(dir string) *iqpuzzler.TableCache {
+	return iqpuzzler.NewTableCache(tableCacheBytes, dir)
+}
+
+// tables returns the options taking the placement tables from the
+// directory of -table-cache, none without it, keeping no more of them in
+// memory than the share of -max-mem.
+func (f *searchFlags) tables() []iqpuzzler.Option {
+	if *f.tableCache == "" {
+		return nil
+	}
+	var size int64 = tableCacheBytes
+	if *f.maxMem > 0 {
+		size = min(size, int64(*f.maxMem)/tableCacheShare)
+	}
+	return []iqpuzzler.Option{iqpuzzler.WithTableCache(iqpuzzler.NewTableCache(size, *f.tableCache))}
+}
+
+// placementScores returns the options ordering the search by the scores of
+// -learn and a function saving them with the solutions found, which does
+// nothing without -learn.
+func (f *searchFlags) placementScores() ([]iqpuzzler.Option, func()) {
+	if *f.learn == "" {
+		return nil, func() {}
+	}
+	scores, err := readPlacementScores(*f.learn)
+	if err != nil {
+		exit(err)
+	}
+	return []iqpuzzler.Option{iqpuzzler.WithPlacementScores(scores)}, func() {
+		var buf bytes.Buffer
+		if err := scores.Write(&buf); err != nil {
+			exit(err)
+		}
+		if err := writeFileAtomic(*f.learn, buf.Bytes()); err != nil {
+			exit(err)
+		}
+	}
+}
+
+// readPlacementScores reads the placement scores in the file, which are
+// empty if it does not exist yet.
+func readPlacementScores(path string) (*iqpuzzler.PlacementScores, error) {
+	s, err := iqpuzzler.ReadPlacementScores(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return iqpuzzler.NewPlacementScores(), nil
+	}
+	return s, err
+}
@@ -0,0 +1,213 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"smaart/iqpuzzler"
+)
+
+// remoteFlags select a server, as run by serve, to compute on instead of
+// locally.
+type remoteFlags struct {
+	url      *string
+	timeout  *time.Duration
+	token    *string
+	caFile   *string
+	insecure *bool
+}
+
+func addRemoteFlags(fs *flag.FlagSet) *remoteFlags {
+	return &remoteFlags{
+		url:      fs.String("remote", "", "send the request to the server at this URL, as run by serve, instead of computing locally"),
+		timeout:  fs.Duration("remote-timeout", time.Minute, "the longest to wait for the server, 0 for no limit"),
+		token:    fs.String("token", "", "the bearer token of the server"),
+		caFile:   fs.String("ca-file", "", "trust the server certificates signed by the PEM certificates in this file"),
+		insecure: fs.Bool("insecure", false, "do not check the certificate of the server"),
+	}
+}
+
+// remoteClient sends requests to a server.
+type remoteClient struct {
+	base  string
+	token string
+	http  *http.Client
+}
+
+// client returns the client of the server, or nil if there is none. The
+// piece set must be a built-in one, since only its name is sent.
+func (f *remoteFlags) client(pf *puzzleFlags) *remoteClient {
+	if *f.url == "" {
+		return nil
+	}
+	if pf != nil && *pf.pieceFile != "" {
+		console.errorln("-remote only knows the built-in piece sets, not -piece-file")
+		os.Exit(exitUsage)
+	}
+	var conf = &tls.Config{InsecureSkipVerify: *f.insecure}
+	if *f.caFile != "" {
+		pem, err := os.ReadFile(*f.caFile)
+		if err != nil {
+			exit(err)
+		}
+		conf.RootCAs = x509.NewCertPool()
+		if !conf.RootCAs.AppendCertsFromPEM(pem) {
+			exit(fmt.Errorf("%s: no PEM certificates", *f.caFile))
+		}
+	}
+	var tr = http.DefaultTransport.(*http.Transport).Clone()
+	tr.TLSClientConfig = conf
+	return &remoteClient{
+		base:  strings.TrimSuffix(*f.url, "/"),
+		token: *f.token,
+		http:  &http.Client{Transport: tr, Timeout: *f.timeout},
+	}
+}
+
+// post sends body as JSON to the endpoint and returns the response if it
+// succeeded, or the error the server answered with.
+func (c *remoteClient) post(ctx context.Context, path string, body any, accept string) (*http.Response, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.base+path, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", accept)
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusOK {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+	var e errorBody
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&e); err != nil || e.Error == "" {
+		return nil, fmt.Errorf("%s%s: %s", c.base, path, resp.Status)
+	}
+	if p := e.Parse; p != nil {
+		return nil, &iqpuzzler.ParseError{Row: p.Row, Col: p.Col, Msg: p.Message, Line: p.Line}
+	}
+	return nil, errors.New(e.Error)
+}
+
+// call posts body to the endpoint and decodes the JSON answer into res.
+func (c *remoteClient) call(path string, body, res any) error {
+	resp, err := c.post(context.Background(), path, body, "application/json")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return json.NewDecoder(resp.Body).Decode(res)
+}
+
+// search is search on the server: it streams the solutions of the request
+// to onSolution as the server finds them, resolved against reg, and reports
+// whether it was interrupted by SIGINT.
+func (c *remoteClient) search(req iqpuzzler.SolveRequest, reg *iqpuzzler.Registry, onSolution func(iqpuzzler.Solution)) (iqpuzzler.SolveResult, bool) {
+	var (
+		ctx, stop = interruptContext()
+		res       iqpuzzler.SolveResult
+	)
+	defer stop()
+	resp, err := c.post(ctx, "/solve", req, ndjson)
+	if ctx.Err() != nil {
+		return res, true
+	}
+	if err != nil {
+		exit(err)
+	}
+	defer resp.Body.Close()
+	var sc = bufio.NewScanner(resp.Body)
+	sc.Buffer(nil, 1<<20)
+	for sc.Scan() {
+		var e solveEvent
+		if err := json.Unmarshal(sc.Bytes(), &e); err != nil {
+			exit(fmt.Errorf("%s/solve: %v", c.base, err))
+		}
+		if e.Result != nil {
+			var r = e.Result
+			res.Status, res.Count, res.Complete, res.Metrics = r.Status, r.Count, r.Complete, r.Metrics
+			if e.Failed {
+				exit(fmt.Errorf("%s/solve: %s", c.base, r.Error))
+			}
+			return res, false
+		}
+		if err := e.Solution.Resolve(reg.List()); err != nil {
+			exit(fmt.Errorf("%s/solve: %w", c.base, err))
+		}
+		if res.Solution == nil {
+			res.Solution = e.Solution
+		}
+		res.Count++
+		onSolution(e.Solution)
+	}
+	if ctx.Err() != nil {
+		return res, true
+	}
+	if err := sc.Err(); err != nil {
+		exit(err)
+	}
+	exit(fmt.Errorf("%s/solve: the response ended before the result", c.base))
+	return res, false
+}
+
+// verify checks the solution of the board on the server.
+func (c *remoteClient) verify(b *iqpuzzler.Board, set string, sol iqpuzzler.Solution) (verifyResponse, error) {
+	var req = iqpuzzler.VerifyRequest{
+		SolveRequest: iqpuzzler.SolveRequest{Preset: presetOf(b, set), Board: iqpuzzler.CompactBoard(b), Wrap: b.Wrap(), Set: set},
+		Solution:     sol,
+	}
+	var res verifyResponse
+	err := c.call("/verify", req, &res)
+	return res, err
+}
+
+// presetOf returns the preset of the board in solution files, which only
+// keep the board: the first whose size matches, preferring those of the
+// piece set.
+func presetOf(b *iqpuzzler.Board, set string) string {
+	var res string
+	for _, n := range iqpuzzler.PresetNames() {
+		var p, _ = iqpuzzler.LookupPreset(n)
+		if p.Rows != b.Rows() || p.Cols != b.Cols() {
+			continue
+		}
+		if p.Set == set || p.Set == "" && set == "iq-puzzler" {
+			return n
+		}
+		if res == "" {
+			res = n
+		}
+	}
+	return res
+}
+
+// remoteRequest returns the request to send for the puzzle: the server
+// looks the pieces up by name in the piece set.
+func remoteRequest(req iqpuzzler.SolveRequest, set string, ps []iqpuzzler.Piece) iqpuzzler.SolveRequest {
+	req.Set, req.Pieces = set, make([]string, 0, len(ps))
+	for _, p := range ps {
+		req.Pieces = append(req.Pieces, p.Name())
+	}
+	return req
+}
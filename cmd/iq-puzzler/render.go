@@ -0,0 +1,190 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+
+	"smaart/iqpuzzler"
+)
+
+// renderFormats are the values of render -format.
+var renderFormats = []string{"text", "compact", "emoji", "svg"}
+
+func newRenderCommand() (*flag.FlagSet, func(args []string)) {
+	var (
+		fs     = newFlagSet("render", "[FILE...]")
+		pf     = addPuzzleFlags(fs)
+		gf     = addGlobalFlags(fs, "write the drawing to this file")
+		input  = fs.String("input", "", "draw the solutions in this solution or store file, like a FILE argument")
+		format = fs.String("format", "text", "how to draw the boards: text, compact, emoji or svg")
+		nth    = fs.Int("nth", 0, "draw only the nth solution of each file")
+		hash   = fs.String("hash", "", "draw only the board of store files whose hash starts with this")
+	)
+	return fs, func(args []string) {
+		parseFlags(fs, args)
+		var paths = fs.Args()
+		if *input != "" {
+			paths = append([]string{*input}, paths...)
+		}
+		if *nth < 0 || !slices.Contains(renderFormats, *format) {
+			fs.Usage()
+			os.Exit(exitUsage)
+		}
+		var (
+			p     = pf.load()
+			w     = gf.create()
+			style = iqpuzzler.RenderStyle{Lines: *format != "compact", Emoji: *format == "emoji"}
+		)
+		defer w.Close()
+		if gf.colored(w) || style.Emoji {
+			style.Palette = p.pal
+		}
+		var ds = []drawing{{board: p.board()}}
+		if len(paths) > 0 {
+			ds = nil
+		}
+		for _, path := range paths {
+			sd, err := savedSolutions(path, p.setID, p.reg, *hash)
+			if err != nil {
+				exit(err)
+			}
+			if *nth > len(sd) {
+				exit(fmt.Errorf("%s: no solution %d, the file has %d", path, *nth, len(sd)))
+			}
+			if *nth > 0 {
+				sd = sd[*nth-1 : *nth]
+			}
+			ds = append(ds, sd...)
+		}
+		if *format == "svg" {
+			if len(ds) != 1 {
+				exit(fmt.Errorf("an SVG image shows one solution, not %d; select one with -nth", len(ds)))
+			}
+			fmt.Fprint(w, ds[0].sol.SVG(ds[0].board, p.pal))
+			return
+		}
+		for _, d := range ds {
+			switch {
+			case d.label == "":
+				fmt.Fprintln(w, d.sol.Render(d.board, style))
+			case style.Lines:
+				fmt.Fprintf(w, "%s\n%s\n\n", d.label, d.sol.Render(d.board, style))
+			default:
+				fmt.Fprintf(w, "%s: %s\n", d.label, d.sol.Render(d.board, style))
+			}
+		}
+	}
+}
+
+// drawing is a solution to draw, with the label to draw it under.
+type drawing struct {
+	label string
+	board *iqpuzzler.Board
+	sol   iqpuzzler.Solution
+}
+
+// savedSolutions reads the solutions in the solution or store file at
+// path, checking each against its board. Of store files it reads the
+// boards whose hash starts with hash.
+func savedSolutions(path, setID string, reg *iqpuzzler.Registry, hash string) ([]drawing, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	// Unlike solution files, store files keep their boards in records of
+	// their own, after a header with only the version.
+	var first, _, _ = bytes.Cut(data, []byte("\n"))
+	var header struct {
+		Board json.RawMessage `json:"board"`
+	}
+	if json.Unmarshal(first, &header) == nil && header.Board == nil {
+		return storedSolutions(path, setID, reg, hash)
+	}
+	r, err := iqpuzzler.NewSolutionReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	var h = r.Header()
+	if h.Set != setID {
+		return nil, fmt.Errorf("%s: solutions are for piece set %q, not %q", path, h.Set, setID)
+	}
+	var res []drawing
+	for n := 1; ; n++ {
+		sol, err := r.Read()
+		if err == io.EOF {
+			return res, nil
+		}
+		if err == nil {
+			err = checkSolution(h.Board, reg, sol)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: solution %d: %w", path, n, err)
+		}
+		res = append(res, drawing{label: fmt.Sprintf("%s: solution %d", path, n), board: h.Board, sol: sol})
+	}
+}
+
+// storedSolutions is savedSolutions for store files.
+func storedSolutions(path, setID string, reg *iqpuzzler.Registry, hash string) ([]drawing, error) {
+	s, err := iqpuzzler.ReadStore(path)
+	if err != nil {
+		return nil, err
+	}
+	var boards = s.Boards()
+	if hash != "" {
+		b, err := s.Lookup(hash)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		boards = []iqpuzzler.StoredBoard{b}
+	}
+	var res []drawing
+	for _, b := range boards {
+		if b.Set != setID {
+			return nil, fmt.Errorf("%s: board %s is for piece set %q, not %q", path, b.Hash, b.Set, setID)
+		}
+		sols, err := s.Solutions(b.Hash)
+		if err != nil {
+			return nil, err
+		}
+		for n, sol := range sols {
+			if err := checkSolution(b.Board, reg, sol.Solution); err != nil {
+				return nil, fmt.Errorf("%s: board %s, solution %d: %w", path, b.Hash, n+1, err)
+			}
+			res = append(res, drawing{label: fmt.Sprintf("%s: board %s, solution %d", path, b.Hash, n+1), board: b.Board, sol: sol.Solution})
+		}
+	}
+	return res, nil
+}
+
+func newPiecesCommand() (*flag.FlagSet, func(args []string)) {
+	var (
+		fs = newFlagSet("pieces", "")
+		pf = addPuzzleFlags(fs)
+		gf = addGlobalFlags(fs, "write the list to this file")
+	)
+	return fs, func(args []string) {
+		parseFlags(fs, args)
+		var (
+			p     = pf.load()
+			w     = gf.create()
+			color = gf.colored(w)
+		)
+		defer w.Close()
+		for _, pc := range p.reg.List() {
+			var l = string(pc.Letter())
+			if pc.Letter() == 0 {
+				l = "-"
+			}
+			if st := p.pal[pc.Name()]; color && st.ANSI != "" {
+				l = "\x1b[" + st.ANSI + "m" + l + "\x1b[0m"
+			}
+			fmt.Fprintf(w, "%-12s %s  %2d cells  %d orientations\n", pc.Name(), l, pc.Size(), len(pc.Orientations()))
+		}
+	}
+}
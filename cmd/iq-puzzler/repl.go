@@ -0,0 +1,505 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"smaart/iqpuzzler"
+)
+
+// repl is an interactive session on one board.
+type repl struct {
+	w      io.Writer
+	board  *iqpuzzler.Board
+	reg    *iqpuzzler.Registry
+	pieces []iqpuzzler.Piece
+	game   *iqpuzzler.Game
+	// undo holds the games before the commands which changed them, the
+	// last one on top.
+	undo   []*iqpuzzler.Game
+	style  iqpuzzler.RenderStyle
+	logger *slog.Logger
+	// commands are the commands of the session, replCommands or
+	// playCommands.
+	commands []replCommand
+	// play is the game against the clock, nil outside of play.
+	play *playSession
+	quit bool
+}
+
+// replCommand is a command of the session.
+type replCommand struct {
+	name string
+	args string
+	help string
+	run  func(r *repl, args []string) error
+	// complete returns the candidates for argument i.
+	complete func(r *repl, i int) []string
+}
+
+var replCommands, playCommands []replCommand
+
+func init() {
+	// The table refers to cmdHelp, which reads it.
+	replCommands = []replCommand{
+		{"show", "", "draw the board", func(*repl, []string) error { return nil }, nil},
+		{"place", "PIECE ORIENTATION CELL", "place a piece in an orientation with the top left corner of its bounding box at a cell such as B3", (*repl).cmdPlace, (*repl).completePlace},
+		{"remove", "PIECE", "take a placed piece off the board", (*repl).cmdRemove, (*repl).completeRemove},
+		{"undo", "", "take back the last command changing the board", (*repl).cmdUndo, nil},
+		{"reset", "", "take all pieces off the board", (*repl).cmdReset, nil},
+		{"legal", "PIECE", "list the placements of a piece which fit", (*repl).cmdLegal, (*repl).completePlace},
+		{"hint", "", "suggest a placement leading to a solution", (*repl).cmdHint, nil},
+		{"solve", "", "complete the board if it can be", (*repl).cmdSolve, nil},
+		{"help", "[COMMAND]", "describe the commands", (*repl).cmdHelp, (*repl).completeHelp},
+		{"quit", "", "end the session", (*repl).cmdQuit, nil},
+	}
+	// Play has giveup instead of solve.
+	for _, c := range replCommands {
+		switch c.name {
+		case "solve":
+		case "help":
+			playCommands = append(playCommands, replCommand{"giveup", "", "end the game and complete the board if it can be", (*repl).cmdGiveUp, nil}, c)
+		default:
+			playCommands = append(playCommands, c)
+		}
+	}
+}
+
+func newREPLCommand() (*flag.FlagSet, func(args []string)) {
+	var (
+		fs     = newFlagSet("repl", "")
+		pf     = addPuzzleFlags(fs)
+		gf     = addGlobalFlags(fs, "write a transcript of the commands to this file")
+		replay = fs.String("replay", "", "run the commands of this transcript first")
+	)
+	return fs, func(args []string) {
+		parseFlags(fs, args)
+		var (
+			p = pf.load()
+			b = p.board()
+			r = &repl{
+				w:        os.Stdout,
+				board:    b,
+				reg:      p.reg,
+				pieces:   p.searchPieces(b, false),
+				game:     iqpuzzler.NewGame(b),
+				style:    iqpuzzler.RenderStyle{Lines: true},
+				logger:   gf.logger(),
+				commands: replCommands,
+			}
+			transcript io.Writer = io.Discard
+		)
+		if gf.colored(os.Stdout) {
+			r.style.Palette = p.pal
+		}
+		if *gf.output != "" {
+			var f = gf.create()
+			defer f.Close()
+			transcript = f
+		}
+		r.show()
+		if *replay != "" {
+			f, err := os.Open(*replay)
+			if err != nil {
+				exit(err)
+			}
+			var sc = bufio.NewScanner(f)
+			for sc.Scan() && !r.quit {
+				var line = strings.TrimSpace(sc.Text())
+				if line == "" || strings.HasPrefix(line, "#") {
+					continue
+				}
+				fmt.Fprintf(r.w, "> %s\n", line)
+				fmt.Fprintln(transcript, line)
+				r.exec(line)
+			}
+			f.Close()
+			if err := sc.Err(); err != nil {
+				exit(err)
+			}
+		}
+		r.interact(transcript)
+	}
+}
+
+// interact runs the commands read from the terminal, writing them to the
+// transcript, until quit or the end of the input.
+func (r *repl) interact(transcript io.Writer) {
+	var lr = newLineReader(r.w, r.complete)
+	for !r.quit {
+		line, err := lr.readLine("> ")
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			exit(err)
+		}
+		if line = strings.TrimSpace(line); line == "" {
+			continue
+		}
+		fmt.Fprintln(transcript, line)
+		r.exec(line)
+	}
+}
+
+// exec runs a command line, printing errors, and draws the board after
+// commands other than help and quit. A line such as red:R90:B3 is short for
+// place red R90 B3.
+func (r *repl) exec(line string) {
+	var fields = strings.Fields(line)
+	if len(fields) == 1 && strings.Count(fields[0], ":") == 2 {
+		fields = append([]string{"place"}, strings.Split(fields[0], ":")...)
+	}
+	var c = r.lookup(fields[0])
+	if c == nil {
+		fmt.Fprintf(r.w, "unknown command %q, try help\n", fields[0])
+		return
+	}
+	if err := c.run(r, fields[1:]); err != nil {
+		fmt.Fprintln(r.w, err)
+		return
+	}
+	if c.name != "help" && c.name != "quit" {
+		r.show()
+	}
+	if r.play != nil {
+		r.played(c.name)
+	}
+}
+
+func (r *repl) lookup(name string) *replCommand {
+	for i := range r.commands {
+		if r.commands[i].name == name {
+			return &r.commands[i]
+		}
+	}
+	return nil
+}
+
+// show draws the board with row letters and column numbers.
+func (r *repl) show() {
+	var header strings.Builder
+	header.WriteString("  ")
+	for y := 0; y < r.board.Cols(); y++ {
+		header.WriteString(strconv.Itoa((y + 1) % 10))
+	}
+	fmt.Fprintln(r.w, header.String())
+	var rows = strings.Split(iqpuzzler.Solution(r.game.Moves()).Render(r.board, r.style), "\n")
+	for x, row := range rows {
+		fmt.Fprintf(r.w, "%c %s\n", 'A'+x, row)
+	}
+	if n := len(r.unplaced()); n > 0 {
+		fmt.Fprintf(r.w, "%d pieces left: %s\n", n, strings.Join(names(r.unplaced()), ", "))
+	} else if r.game.Free() == 0 {
+		fmt.Fprintln(r.w, "solved")
+	}
+}
+
+// unplaced returns the pieces which are not on the board.
+func (r *repl) unplaced() []iqpuzzler.Piece {
+	var res []iqpuzzler.Piece
+	for _, p := range r.pieces {
+		if r.placed(p.Name()) < 0 {
+			res = append(res, p)
+		}
+	}
+	return res
+}
+
+// placed returns the index of the move placing the piece, or -1.
+func (r *repl) placed(name string) int {
+	return slices.IndexFunc(r.game.Moves(), func(m iqpuzzler.Move) bool { return m.Piece.Name() == name })
+}
+
+// piece looks up a piece which may be placed.
+func (r *repl) piece(name string) (iqpuzzler.Piece, error) {
+	p, err := r.reg.Lookup(name)
+	if err != nil {
+		return p, err
+	}
+	if !slices.ContainsFunc(r.pieces, func(q iqpuzzler.Piece) bool { return q.Name() == p.Name() }) {
+		return p, fmt.Errorf("%s is not one of the pieces to place", p.Name())
+	}
+	return p, nil
+}
+
+// save pushes the current game onto the undo stack.
+func (r *repl) save() {
+	r.undo = append(r.undo, r.game.Clone())
+}
+
+func (r *repl) cmdPlace(args []string) error {
+	if len(args) != 3 {
+		return errors.New("usage: place PIECE ORIENTATION CELL")
+	}
+	p, err := r.piece(args[0])
+	if err != nil {
+		return err
+	}
+	if r.placed(p.Name()) >= 0 {
+		return fmt.Errorf("%s is placed already", p.Name())
+	}
+	o, err := iqpuzzler.ParseOrientation(args[1])
+	if err != nil {
+		return err
+	}
+	c, err := parseCell(args[2], r.board)
+	if err != nil {
+		return err
+	}
+	var g = r.game.Clone()
+	if err := g.Play(p.Orient(o), c); err != nil {
+		return err
+	}
+	r.save()
+	r.game = g
+	return nil
+}
+
+func (r *repl) cmdRemove(args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: remove PIECE")
+	}
+	p, err := r.reg.Lookup(args[0])
+	if err != nil {
+		return err
+	}
+	var (
+		moves = r.game.Moves()
+		i     = r.placed(p.Name())
+	)
+	if i < 0 {
+		return fmt.Errorf("%s is not on the board", p.Name())
+	}
+	var g = iqpuzzler.NewGame(r.board)
+	for _, m := range slices.Delete(moves, i, i+1) {
+		if err := g.Play(m.Piece, m.Translate); err != nil {
+			return err
+		}
+	}
+	r.save()
+	r.game = g
+	return nil
+}
+
+func (r *repl) cmdUndo([]string) error {
+	if len(r.undo) == 0 {
+		return errors.New("nothing to undo")
+	}
+	r.game = r.undo[len(r.undo)-1]
+	r.undo = r.undo[:len(r.undo)-1]
+	return nil
+}
+
+func (r *repl) cmdReset([]string) error {
+	r.save()
+	r.game = iqpuzzler.NewGame(r.board)
+	return nil
+}
+
+func (r *repl) cmdLegal(args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: legal PIECE")
+	}
+	p, err := r.piece(args[0])
+	if err != nil {
+		return err
+	}
+	if r.placed(p.Name()) >= 0 {
+		return fmt.Errorf("%s is placed already", p.Name())
+	}
+	var ms = r.game.LegalMoves(p)
+	fmt.Fprintf(r.w, "%d placements of %s:\n", len(ms), p.Name())
+	for _, m := range ms {
+		fmt.Fprintf(r.w, "  %s\n", r.placement(m))
+	}
+	return nil
+}
+
+func (r *repl) cmdHint([]string) error {
+	sol, err := r.solveRest()
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(r.w, "try place %s\n", r.placement(sol[0]))
+	return nil
+}
+
+func (r *repl) cmdSolve([]string) error {
+	sol, err := r.solveRest()
+	if err != nil {
+		return err
+	}
+	var g = r.game.Clone()
+	for _, m := range sol {
+		if err := g.Play(m.Piece, m.Translate); err != nil {
+			return err
+		}
+	}
+	r.save()
+	r.game = g
+	return nil
+}
+
+var errUnsolvable = errors.New("the board cannot be completed from here")
+
+// solveRest returns a solution for the pieces not on the board.
+func (r *repl) solveRest() (iqpuzzler.Solution, error) {
+	var ps = r.unplaced()
+	if len(ps) == 0 {
+		return nil, errors.New("all pieces are placed")
+	}
+	s, err := iqpuzzler.NewSolver(iqpuzzler.WithStrategy(iqpuzzler.FirstEmptyCell), iqpuzzler.WithMaxSolutions(1), iqpuzzler.WithLogger(r.logger))
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.Solve(context.Background(), r.game, ps)
+	if err != nil {
+		return nil, err
+	}
+	if res.Count == 0 {
+		return nil, errUnsolvable
+	}
+	return res.Solution, nil
+}
+
+func (r *repl) cmdHelp(args []string) error {
+	if len(args) > 0 {
+		var c = r.lookup(args[0])
+		if c == nil {
+			return fmt.Errorf("unknown command %q", args[0])
+		}
+		fmt.Fprintf(r.w, "%s %s\n  %s\n", c.name, c.args, c.help)
+		return nil
+	}
+	for _, c := range r.commands {
+		fmt.Fprintf(r.w, "  %-32s %s\n", c.name+" "+c.args, c.help)
+	}
+	return nil
+}
+
+func (r *repl) cmdQuit([]string) error {
+	r.quit = true
+	return nil
+}
+
+// complete returns the candidates for the last word of the line.
+func (r *repl) complete(line string) []string {
+	var (
+		fields = strings.Fields(line)
+		word   string
+	)
+	if len(fields) > 0 && !strings.HasSuffix(line, " ") {
+		word, fields = fields[len(fields)-1], fields[:len(fields)-1]
+	}
+	var cands []string
+	if len(fields) == 0 {
+		for _, c := range r.commands {
+			cands = append(cands, c.name)
+		}
+	} else if c := r.lookup(fields[0]); c != nil && c.complete != nil {
+		cands = c.complete(r, len(fields)-1)
+	}
+	var res []string
+	for _, c := range cands {
+		if strings.HasPrefix(c, word) {
+			res = append(res, c)
+		}
+	}
+	return res
+}
+
+func (r *repl) completePlace(i int) []string {
+	switch i {
+	case 0:
+		return names(r.unplaced())
+	case 1:
+		var res []string
+		for _, o := range iqpuzzler.Orientations() {
+			res = append(res, o.String())
+		}
+		return res
+	}
+	return nil
+}
+
+func (r *repl) completeRemove(i int) []string {
+	if i != 0 {
+		return nil
+	}
+	var res []string
+	for _, m := range r.game.Moves() {
+		res = append(res, m.Piece.Name())
+	}
+	return res
+}
+
+func (r *repl) completeHelp(i int) []string {
+	if i != 0 {
+		return nil
+	}
+	var res []string
+	for _, c := range r.commands {
+		res = append(res, c.name)
+	}
+	return res
+}
+
+// placement formats a move as the arguments of place.
+func (r *repl) placement(m iqpuzzler.Move) string {
+	return fmt.Sprintf("%s %s %s", m.Piece.Name(), m.Piece.Orientation(), cellName(topLeft(m, r.board)))
+}
+
+// moveNotation formats a move as PIECE:ORIENTATION:CELL, the short form of
+// place.
+func moveNotation(m iqpuzzler.Move, b *iqpuzzler.Board) string {
+	return fmt.Sprintf("%s:%s:%s", m.Piece.Name(), m.Piece.Orientation(), cellName(topLeft(m, b)))
+}
+
+// topLeft returns the top left corner of the bounding box of the move, which
+// place takes as its cell.
+func topLeft(m iqpuzzler.Move, b *iqpuzzler.Board) iqpuzzler.Pos {
+	var min, _ = iqpuzzler.BoundingBox(m.Piece.Cells())
+	return m.Translate.Add(min).Mod(iqpuzzler.Pos{b.Rows(), b.Cols()})
+}
+
+// cellName returns the name of a cell, its row letter followed by its
+// column number.
+func cellName(p iqpuzzler.Pos) string {
+	return fmt.Sprintf("%c%d", 'A'+p[0], p[1]+1)
+}
+
+// parseCell parses a cell name such as B3 on the board.
+func parseCell(s string, b *iqpuzzler.Board) (iqpuzzler.Pos, error) {
+	var bad = fmt.Errorf("invalid cell %q, want a row letter and a column number such as B3", s)
+	if len(s) < 2 {
+		return iqpuzzler.Pos{}, bad
+	}
+	var row = strings.ToUpper(s[:1])[0]
+	col, err := strconv.Atoi(s[1:])
+	if err != nil || row < 'A' || row > 'Z' {
+		return iqpuzzler.Pos{}, bad
+	}
+	var p = iqpuzzler.Pos{int(row - 'A'), col - 1}
+	if p[0] >= b.Rows() || p[1] < 0 || p[1] >= b.Cols() {
+		return iqpuzzler.Pos{}, fmt.Errorf("cell %s is not on the board", s)
+	}
+	return p, nil
+}
+
+func names(ps []iqpuzzler.Piece) []string {
+	var res = make([]string, 0, len(ps))
+	for _, p := range ps {
+		res = append(res, p.Name())
+	}
+	return res
+}
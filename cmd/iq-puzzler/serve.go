@@ -0,0 +1,399 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"mime"
+	"net"
+	"net/http"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"smaart/iqpuzzler"
+)
+
+func newServeCommand() (*flag.FlagSet, func(args []string)) {
+	var (
+		fs     = newFlagSet("serve", "")
+		gf     = addGlobalFlags(fs, "")
+		listen = fs.String("listen", ":8080", "the address to listen on")
+		grpcAt = fs.String("grpc", "", "also serve the gRPC API on this address, such as :9090")
+		cert   = fs.String("tls-cert", "", "serve HTTPS with this PEM certificate file, along with -tls-key")
+		key    = fs.String("tls-key", "", "the PEM key file of -tls-cert")
+		tables = addTableCacheFlag(fs)
+		s      = &server{}
+	)
+	fs.StringVar(&s.token, "token", "", "require this bearer token in the Authorization header of API requests")
+	fs.DurationVar(&s.timeout, "timeout", 10*time.Second, "the longest a request may search")
+	fs.IntVar(&s.maxSolutions, "max-solutions", 100, "the most solutions a request may ask for")
+	fs.IntVar(&s.maxParallelism, "max-parallelism", runtime.NumCPU(), "the most workers a request may search with")
+	fs.Int64Var(&s.maxBody, "max-body", 1<<20, "the largest request body accepted, in bytes")
+	return fs, func(args []string) {
+		parseFlags(fs, args)
+		if (*cert == "") != (*key == "") {
+			console.errorln("-tls-cert and -tls-key go together")
+			os.Exit(exitUsage)
+		}
+		s.logger, s.tables = gf.logger(), newTableCache(*tables)
+		var srv = &http.Server{
+			Addr:              *listen,
+			Handler:           s.handler(),
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+		if *grpcAt != "" {
+			var opts []grpc.ServerOption
+			if *cert != "" {
+				creds, err := credentials.NewServerTLSFromFile(*cert, *key)
+				if err != nil {
+					exit(err)
+				}
+				opts = append(opts, grpc.Creds(creds))
+			}
+			lis, err := net.Listen("tcp", *grpcAt)
+			if err != nil {
+				exit(err)
+			}
+			var gs = s.newGRPCServer(opts...)
+			s.logger.Warn("listening for gRPC", slog.String("address", *grpcAt), slog.Bool("tls", *cert != ""))
+			go func() { exit(gs.Serve(lis)) }()
+		}
+		s.logger.Warn("listening", slog.String("address", *listen), slog.Bool("tls", *cert != ""))
+		if *cert != "" {
+			exit(srv.ListenAndServeTLS(*cert, *key))
+		}
+		exit(srv.ListenAndServe())
+	}
+}
+
+// server answers the requests of the HTTP API.
+type server struct {
+	timeout        time.Duration
+	maxSolutions   int
+	maxParallelism int
+	maxBody        int64
+	// token, if set, is the bearer token API requests must carry.
+	token  string
+	logger *slog.Logger
+	// tables keeps the placement tables between the requests.
+	tables *iqpuzzler.TableCache
+}
+
+func (s *server) handler() http.Handler {
+	var mux = http.NewServeMux()
+	mux.HandleFunc("POST /solve", s.authorized(s.solve))
+	mux.HandleFunc("POST /verify", s.authorized(s.verify))
+	mux.HandleFunc("POST /generate", s.authorized(s.generate))
+	mux.HandleFunc("GET /pieces", s.authorized(s.pieces))
+	s.handleWeb(mux)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var start = time.Now()
+		mux.ServeHTTP(w, r)
+		s.logger.Info("request", slog.String("method", r.Method), slog.String("path", r.URL.Path), slog.Duration("elapsed", time.Since(start)))
+	})
+}
+
+// authorized wraps h to reject requests without the server's token.
+func (s *server) authorized(h http.HandlerFunc) http.HandlerFunc {
+	if s.token == "" {
+		return h
+	}
+	var want = "Bearer " + s.token
+	return func(w http.ResponseWriter, r *http.Request) {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte(want)) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			s.fail(w, http.StatusUnauthorized, errors.New("missing or wrong bearer token"))
+			return
+		}
+		h(w, r)
+	}
+}
+
+// errorBody is the body of responses to failed requests.
+type errorBody struct {
+	Error string `json:"error"`
+	// Parse locates the error in the board or piece definitions.
+	Parse *parseErrorBody `json:"parse,omitempty"`
+}
+
+type parseErrorBody struct {
+	Row     int    `json:"row"`
+	Col     int    `json:"col,omitempty"`
+	Message string `json:"message"`
+	Line    string `json:"line,omitempty"`
+	Diagram string `json:"diagram,omitempty"`
+}
+
+// fail writes the error with the given status code.
+func (s *server) fail(w http.ResponseWriter, code int, err error) {
+	var (
+		body = errorBody{Error: err.Error()}
+		pe   *iqpuzzler.ParseError
+	)
+	if errors.As(err, &pe) {
+		body.Parse = &parseErrorBody{Row: pe.Row, Col: pe.Col, Message: pe.Msg, Line: pe.Line, Diagram: pe.Diagram()}
+	}
+	s.reply(w, code, body)
+}
+
+func (s *server) reply(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		s.logger.Warn("writing response", slog.Any("error", err))
+	}
+}
+
+// decode reads the JSON body of the request into v, replying with an
+// error if it cannot.
+func (s *server) decode(w http.ResponseWriter, r *http.Request, v any) bool {
+	var dec = json.NewDecoder(http.MaxBytesReader(w, r.Body, s.maxBody))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		var me *http.MaxBytesError
+		if errors.As(err, &me) {
+			s.fail(w, http.StatusRequestEntityTooLarge, fmt.Errorf("request body larger than %d bytes", me.Limit))
+		} else {
+			s.fail(w, http.StatusBadRequest, fmt.Errorf("invalid request: %w", err))
+		}
+		return false
+	}
+	return true
+}
+
+// puzzle checks the request and returns its board and pieces, replying with
+// an error if it is invalid.
+func (s *server) puzzle(w http.ResponseWriter, req *iqpuzzler.SolveRequest) (*iqpuzzler.Registry, *iqpuzzler.Board, []iqpuzzler.Piece, bool) {
+	reg, b, ps, err := s.check(req)
+	if err != nil {
+		s.fail(w, http.StatusBadRequest, err)
+		return nil, nil, nil, false
+	}
+	return reg, b, ps, true
+}
+
+// check limits the search of the request to the server's limits, checks it
+// and returns its board and pieces.
+func (s *server) check(req *iqpuzzler.SolveRequest) (*iqpuzzler.Registry, *iqpuzzler.Board, []iqpuzzler.Piece, error) {
+	if req.MaxSolutions == 0 || req.MaxSolutions > s.maxSolutions {
+		req.MaxSolutions = s.maxSolutions
+	}
+	if req.Parallelism == 0 || req.Parallelism > s.maxParallelism {
+		req.Parallelism = s.maxParallelism
+	}
+	if err := req.Validate(); err != nil {
+		return nil, nil, nil, err
+	}
+	if req.Paranoid {
+		// Paranoid mode checks every placement and may take far longer
+		// than the server's timeout.
+		return nil, nil, nil, errors.New("paranoid mode is not available from the server")
+	}
+	reg, err := req.Registry()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	b, ps, err := req.Puzzle(reg)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return reg, b, ps, nil
+}
+
+func (s *server) solve(w http.ResponseWriter, r *http.Request) {
+	var req iqpuzzler.SolveRequest
+	if !s.decode(w, r, &req) {
+		return
+	}
+	_, b, ps, ok := s.puzzle(w, &req)
+	if !ok {
+		return
+	}
+	opts, err := req.Options()
+	if err != nil {
+		s.fail(w, http.StatusBadRequest, err)
+		return
+	}
+	opts = append(opts, iqpuzzler.WithLogger(s.logger), iqpuzzler.WithTableCache(s.tables))
+	ctx, cancel := context.WithTimeout(r.Context(), s.timeout)
+	defer cancel()
+	if acceptsNDJSON(r) {
+		s.streamSolve(ctx, w, opts, b, ps)
+		return
+	}
+	solver, err := iqpuzzler.NewSolver(opts...)
+	if err != nil {
+		s.fail(w, http.StatusBadRequest, err)
+		return
+	}
+	res, err := solver.Solve(ctx, iqpuzzler.NewGame(b), ps)
+	var ae *iqpuzzler.AbortedError
+	switch {
+	case err == nil, errors.As(err, &ae):
+		s.reply(w, http.StatusOK, iqpuzzler.NewSolveResponse(res, err))
+	default:
+		s.reply(w, http.StatusInternalServerError, iqpuzzler.NewSolveResponse(res, err))
+	}
+}
+
+const ndjson = "application/x-ndjson"
+
+// acceptsNDJSON tells whether the request's Accept header lists NDJSON.
+func acceptsNDJSON(r *http.Request) bool {
+	for _, v := range r.Header.Values("Accept") {
+		for _, part := range strings.Split(v, ",") {
+			if mt, _, err := mime.ParseMediaType(part); err == nil && mt == ndjson {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// solveEvent is a line of a streamed /solve response: a solution as it is
+// found, and finally the result, without the solutions.
+type solveEvent struct {
+	Solution iqpuzzler.Solution       `json:"solution,omitempty"`
+	Result   *iqpuzzler.SolveResponse `json:"result,omitempty"`
+	// Failed tells that the search failed, rather than being stopped by
+	// a limit, along with the result.
+	Failed bool `json:"failed,omitempty"`
+}
+
+// streamSolve answers a /solve request which accepts NDJSON with a line
+// per solution, flushed as it is found, and a last line with the result.
+func (s *server) streamSolve(ctx context.Context, w http.ResponseWriter, opts []iqpuzzler.Option, b *iqpuzzler.Board, ps []iqpuzzler.Piece) {
+	var (
+		enc = json.NewEncoder(w)
+		rc  = http.NewResponseController(w)
+	)
+	var send = func(e solveEvent) {
+		if err := enc.Encode(e); err != nil {
+			s.logger.Warn("writing response", slog.Any("error", err))
+			return
+		}
+		rc.Flush()
+	}
+	solver, err := iqpuzzler.NewSolver(append(opts, iqpuzzler.WithOnSolution(func(sol iqpuzzler.Solution) { send(solveEvent{Solution: sol}) }))...)
+	if err != nil {
+		s.fail(w, http.StatusBadRequest, err)
+		return
+	}
+	w.Header().Set("Content-Type", ndjson)
+	w.WriteHeader(http.StatusOK)
+	res, err := solver.Solve(ctx, iqpuzzler.NewGame(b), ps)
+	var (
+		resp = iqpuzzler.NewSolveResponse(res, err)
+		ae   *iqpuzzler.AbortedError
+	)
+	send(solveEvent{Result: &resp, Failed: err != nil && !errors.As(err, &ae)})
+}
+
+// verifyResponse is the body of responses to /verify.
+type verifyResponse struct {
+	Valid bool   `json:"valid"`
+	Error string `json:"error,omitempty"`
+}
+
+func (s *server) verify(w http.ResponseWriter, r *http.Request) {
+	var req iqpuzzler.VerifyRequest
+	if !s.decode(w, r, &req) {
+		return
+	}
+	if len(req.Pieces) == 0 {
+		// Without pieces, the solution is checked to cover the board.
+		req.Pieces = req.Solution.Pieces()
+	}
+	reg, b, ps, ok := s.puzzle(w, &req.SolveRequest)
+	if !ok {
+		return
+	}
+	if err := req.Solution.Resolve(reg.List()); err != nil {
+		s.reply(w, http.StatusOK, verifyResponse{Error: err.Error()})
+		return
+	}
+	if err := iqpuzzler.VerifySolution(b, ps, req.Solution); err != nil {
+		s.reply(w, http.StatusOK, verifyResponse{Error: err.Error()})
+		return
+	}
+	s.reply(w, http.StatusOK, verifyResponse{Valid: true})
+}
+
+// generateResponse is the body of responses to /generate. Puzzle may be
+// posted to /solve as it is.
+type generateResponse struct {
+	Puzzle   iqpuzzler.SolveRequest `json:"puzzle"`
+	Solution iqpuzzler.Solution     `json:"solution"`
+}
+
+func (s *server) generate(w http.ResponseWriter, r *http.Request) {
+	var req iqpuzzler.GenerateRequest
+	if !s.decode(w, r, &req) {
+		return
+	}
+	_, b, ps, ok := s.puzzle(w, &req.SolveRequest)
+	if !ok {
+		return
+	}
+	if req.Seed == 0 {
+		req.Seed = uint64(time.Now().UnixNano())
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), s.timeout)
+	defer cancel()
+	gen, err := iqpuzzler.Generate(ctx, b, ps, iqpuzzler.GenerateOptions{
+		Remove:   req.Remove,
+		Unique:   req.Unique,
+		Attempts: req.Attempts,
+		Rand:     iqpuzzler.NewRand(req.Seed),
+		Logger:   s.logger,
+	})
+	var ae *iqpuzzler.AbortedError
+	switch {
+	case errors.As(err, &ae):
+		s.fail(w, http.StatusServiceUnavailable, err)
+		return
+	case err != nil:
+		s.fail(w, http.StatusUnprocessableEntity, err)
+		return
+	}
+	var puzzle = gen.Request(iqpuzzler.SolveRequest{Preset: req.Preset, Set: req.Set, Wrap: req.Wrap})
+	s.reply(w, http.StatusOK, generateResponse{puzzle, gen.Solution})
+}
+
+// pieceBody describes a piece in responses to /pieces.
+type pieceBody struct {
+	Name         string          `json:"name"`
+	Letter       string          `json:"letter,omitempty"`
+	Cells        []iqpuzzler.Pos `json:"cells"`
+	Orientations int             `json:"orientations"`
+}
+
+func (s *server) pieces(w http.ResponseWriter, r *http.Request) {
+	var name = r.URL.Query().Get("set")
+	if name == "" {
+		name = "iq-puzzler"
+	}
+	set, ok := iqpuzzler.LookupPieceSet(name)
+	if !ok {
+		s.fail(w, http.StatusNotFound, fmt.Errorf("unknown piece set %q", name))
+		return
+	}
+	var res = make([]pieceBody, 0, len(set.Pieces))
+	for _, p := range set.Pieces {
+		var b = pieceBody{Name: p.Name(), Cells: p.Cells(), Orientations: len(p.Orientations())}
+		if p.Letter() != 0 {
+			b.Letter = string(p.Letter())
+		}
+		res = append(res, b)
+	}
+	s.reply(w, http.StatusOK, res)
+}
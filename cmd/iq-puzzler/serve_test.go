@@ -0,0 +1,225 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"smaart/iqpuzzler"
+)
+
+// newTestServer starts a server with the given limits on the search.
+func newTestServer(t *testing.T, timeout time.Duration) *httptest.Server {
+	t.Helper()
+	var s = &server{
+		timeout:        timeout,
+		maxSolutions:   10,
+		maxParallelism: 2,
+		maxBody:        1 << 16,
+		logger:         slog.New(slog.NewTextHandler(io.Discard, nil)),
+		tables:         newTableCache(""),
+	}
+	var ts = httptest.NewServer(s.handler())
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+const miniRequest = `{"preset": "mini", "board": "4x5:x12.x6.", "pieces": ["blue", "green", "mint", "red"]`
+
+func post(t *testing.T, ts *httptest.Server, path, accept, body string) *http.Response {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodPost, ts.URL+path, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	res, err := ts.Client().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { res.Body.Close() })
+	return res
+}
+
+func TestServeSolve(t *testing.T) {
+	var ts = newTestServer(t, time.Minute)
+	var tests = []struct {
+		name, body string
+		count      int
+	}{
+		{"all", miniRequest + `}`, 3},
+		{"limited", miniRequest + `, "max_solutions": 2}`, 2},
+		{"engine", miniRequest + `, "engine": "stack"}`, 3},
+		{"parallelism beyond the limit", miniRequest + `, "parallelism": 64}`, 3},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res = post(t, ts, "/solve", "", test.body)
+			if res.StatusCode != http.StatusOK {
+				t.Fatalf("status %d, want %d", res.StatusCode, http.StatusOK)
+			}
+			var resp iqpuzzler.SolveResponse
+			if err := json.NewDecoder(res.Body).Decode(&resp); err != nil {
+				t.Fatal(err)
+			}
+			if resp.Status != iqpuzzler.Solved || resp.Count != test.count || len(resp.Solutions) != test.count {
+				t.Errorf("got status %v with %d solutions (count %d), want %d", resp.Status, len(resp.Solutions), resp.Count, test.count)
+			}
+		})
+	}
+}
+
+func TestServeInvalid(t *testing.T) {
+	var ts = newTestServer(t, time.Minute)
+	var tests = []struct {
+		name, body string
+		code       int
+		parse      bool
+	}{
+		{"not json", `{"preset":`, http.StatusBadRequest, false},
+		{"unknown field", `{"presets": "mini"}`, http.StatusBadRequest, false},
+		{"unknown preset", `{"preset": "huge"}`, http.StatusBadRequest, false},
+		{"unknown engine", miniRequest + `, "engine": "quantum"}`, http.StatusBadRequest, false},
+		{"negative parallelism", miniRequest + `, "parallelism": -1}`, http.StatusBadRequest, false},
+		{"paranoid", miniRequest + `, "paranoid": true}`, http.StatusBadRequest, false},
+		{"bad board", `{"preset": "mini", "board": "4x5:x12?x6."}`, http.StatusBadRequest, true},
+		{"too large", `{"board": "` + strings.Repeat(".", 1<<16) + `"}`, http.StatusRequestEntityTooLarge, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res = post(t, ts, "/solve", "", test.body)
+			if res.StatusCode != test.code {
+				t.Errorf("status %d, want %d", res.StatusCode, test.code)
+			}
+			var body errorBody
+			if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+				t.Fatal(err)
+			}
+			if body.Error == "" {
+				t.Error("no error message")
+			}
+			if (body.Parse != nil) != test.parse {
+				t.Errorf("parse = %+v, want one: %v", body.Parse, test.parse)
+			}
+		})
+	}
+}
+
+func TestServeTimeout(t *testing.T) {
+	var ts = newTestServer(t, time.Millisecond)
+	// The empty standard board takes far longer than the timeout to count.
+	var res = post(t, ts, "/solve", "", `{"max_solutions": 10}`)
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("status %d, want %d", res.StatusCode, http.StatusOK)
+	}
+	var resp iqpuzzler.SolveResponse
+	if err := json.NewDecoder(res.Body).Decode(&resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Status != iqpuzzler.Aborted || resp.Error == "" {
+		t.Errorf("got status %v, error %q, want aborted with an error", resp.Status, resp.Error)
+	}
+}
+
+func TestServeStream(t *testing.T) {
+	var ts = newTestServer(t, time.Minute)
+	for _, accept := range []string{ndjson, "application/json;q=0.5, application/x-ndjson", "Application/X-NDJSON; charset=utf-8"} {
+		t.Run(accept, func(t *testing.T) {
+			var res = post(t, ts, "/solve", accept, miniRequest+`}`)
+			if ct := res.Header.Get("Content-Type"); ct != ndjson {
+				t.Fatalf("Content-Type %q, want %q", ct, ndjson)
+			}
+			var (
+				sc        = bufio.NewScanner(res.Body)
+				solutions int
+				result    *iqpuzzler.SolveResponse
+			)
+			for sc.Scan() {
+				var e solveEvent
+				if err := json.Unmarshal(sc.Bytes(), &e); err != nil {
+					t.Fatalf("line %q: %v", sc.Text(), err)
+				}
+				if e.Solution != nil {
+					solutions++
+				}
+				if e.Result != nil {
+					result = e.Result
+				}
+			}
+			if err := sc.Err(); err != nil {
+				t.Fatal(err)
+			}
+			if solutions != 3 || result == nil || result.Count != 3 {
+				t.Errorf("got %d solutions and result %+v, want 3", solutions, result)
+			}
+		})
+	}
+}
+
+func TestAcceptsNDJSON(t *testing.T) {
+	var tests = []struct {
+		accept []string
+		want   bool
+	}{
+		{nil, false},
+		{[]string{"application/json"}, false},
+		{[]string{ndjson}, true},
+		{[]string{"text/html, application/x-ndjson;q=0.9"}, true},
+		{[]string{"application/json", "application/x-ndjson"}, true},
+		{[]string{"application/x-ndjsonx"}, false},
+		{[]string{";;;"}, false},
+	}
+	for _, test := range tests {
+		var r = httptest.NewRequest(http.MethodPost, "/solve", nil)
+		for _, v := range test.accept {
+			r.Header.Add("Accept", v)
+		}
+		if got := acceptsNDJSON(r); got != test.want {
+			t.Errorf("acceptsNDJSON(%q) = %v, want %v", test.accept, got, test.want)
+		}
+	}
+}
+
+// BenchmarkServeSolve times challenge-like /solve requests, four pieces to
+// place on the standard board, plain and wrapped around, with the tables
+// taken from the server's table cache and built for every request.
+func BenchmarkServeSolve(b *testing.B) {
+	const request = `{"board": "5x11:3E3H3B2.G2E2J2HB3.3GCJ2.A4.G.C2.I3A4.3C4I.", "pieces": ["maroon", "olive", "violet", "yellow"], "max_solutions": 1`
+	for _, wrap := range []bool{false, true} {
+		for _, cached := range []bool{false, true} {
+			var s = &server{
+				timeout:        time.Minute,
+				maxSolutions:   1,
+				maxParallelism: 1,
+				maxBody:        1 << 16,
+				logger:         slog.New(slog.NewTextHandler(io.Discard, nil)),
+			}
+			if cached {
+				s.tables = newTableCache("")
+			}
+			var body = request + fmt.Sprintf(`, "wrap": %t}`, wrap)
+			b.Run(fmt.Sprintf("wrap=%t/cached=%t", wrap, cached), func(b *testing.B) {
+				var h = s.handler()
+				for range b.N {
+					var (
+						w = httptest.NewRecorder()
+						r = httptest.NewRequest(http.MethodPost, "/solve", strings.NewReader(body))
+					)
+					h.ServeHTTP(w, r)
+					if w.Code != http.StatusOK {
+						b.Fatalf("status %d: %s", w.Code, w.Body)
+					}
+				}
+			})
+		}
+	}
+}
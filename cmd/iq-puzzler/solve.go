@@ -0,0 +1,661 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"smaart/iqpuzzler"
+)
+
+func newSolveCommand() (*flag.FlagSet, func(args []string)) {
+	var (
+		fs           = newFlagSet("solve", "")
+		pf           = addPuzzleFlags(fs)
+		sf           = addSearchFlags(fs)
+		gf           = addGlobalFlags(fs, "write the solutions to this solution file")
+		rf           = addRemoteFlags(fs)
+		compactPrint = fs.Bool("compact-board", false, "print the board in compact form and exit")
+		hints        = fs.Bool("check-hints", false, "check that the occupied cells can be formed by the unavailable pieces")
+		identifyOnly = fs.Bool("identify", false, "print which official challenge the board is and exit (not available yet: the catalog of official challenges is empty)")
+		markSolvedF  = fs.Bool("mark-solved", false, "record the challenge given by -challenge as solved and exit (not available yet: the catalog of official challenges is empty)")
+		progressF    = fs.Bool("progress", false, "print which official challenges have been solved and exit, like the progress command")
+		noTrack      = fs.Bool("no-track", false, "do not record solved challenges")
+		verifyFile   = fs.String("verify", "", "check the solutions in this solution file and exit")
+		storePath    = fs.String("store", "", "add the solutions to this store file, which db queries")
+		storeBloom   = fs.Int("store-bloom", 0, "skip the solutions in -store with a Bloom filter of this many MiB instead of a set growing with them, which skips some new ones")
+		watchF       = fs.Bool("watch", false, "solve the board of -board-file again whenever the file changes")
+		nth          = fs.Int("nth", 0, "print only the nth solution in the fixed search order, searching with one goroutine")
+	)
+	return fs, func(args []string) {
+		parseFlags(fs, args)
+		var logger = gf.logger()
+		if *pf.boardPreset == "list" {
+			printPresets()
+			return
+		}
+		if *progressF {
+			showProgress()
+			return
+		}
+		if *markSolvedF {
+			if *pf.challenge == 0 {
+				console.errorln("-mark-solved requires -challenge")
+				os.Exit(exitUsage)
+			}
+			c, err := iqpuzzler.FindChallenge(*pf.challenge)
+			if err != nil {
+				exit(err)
+			}
+			if err := markSolved(c.Number, time.Now()); err != nil {
+				exit(err)
+			}
+			return
+		}
+		sf.profiles.start()
+		applyMaxMem(*sf.maxMem)
+		var rc = rf.client(pf)
+		if rc != nil && (*watchF || *nth != 0 || *sf.book != "" || *sf.learn != "") {
+			console.errorln("-remote solves on the server, without -watch, -nth, -book or -learn")
+			os.Exit(exitUsage)
+		}
+		if *watchF {
+			watch(pf, sf, gf, logger)
+			return
+		}
+		sf.complete.setup("solve", logger)
+		var p = pf.load()
+		if *verifyFile != "" {
+			if err := verifySolutions(os.Stdout, *verifyFile, p.setID, p.reg, rc); err != nil {
+				exit(err)
+			}
+			return
+		}
+		sf.apply(&p.req)
+		var b = p.board()
+		if *compactPrint {
+			console.println(levelResult, iqpuzzler.CompactBoard(b))
+			return
+		}
+		if *identifyOnly {
+			// Without a catalog, no match would say nothing about the board.
+			cs, err := iqpuzzler.LoadChallenges()
+			if err == nil && len(cs) == 0 {
+				err = iqpuzzler.ErrNoChallenges
+			}
+			if err != nil {
+				exit(err)
+			}
+		}
+		c, found, err := iqpuzzler.Identify(b, *pf.boardPreset, p.pieces, *pf.lenient)
+		if err != nil {
+			exit(err)
+		}
+		switch {
+		case found && *identifyOnly:
+			console.printf(levelResult, "This is official challenge %d (%s).\n", c.Number, c.Tier)
+		case found:
+			console.printf(levelSummary, "This is official challenge %d (%s).\n", c.Number, c.Tier)
+		case *identifyOnly:
+			console.println(levelResult, "no official match")
+		}
+		if *identifyOnly {
+			return
+		}
+		var ps = p.searchPieces(b, *hints)
+		var ev = openEvents(*sf.events, "solve")
+		ev.parsed(p, b, ps)
+		if *nth != 0 {
+			if *nth < 0 || p.req.Shuffle || *sf.learn != "" {
+				console.errorln("-nth needs a positive number and the fixed order, without -shuffle or -learn")
+				os.Exit(exitUsage)
+			}
+			// The order of the solutions is only fixed with one goroutine.
+			p.req.Parallelism, p.req.MaxSolutions = 1, *nth
+		}
+		opts, err := p.req.Options()
+		if err != nil {
+			exit(err)
+		}
+		opts = append(opts, sf.tables()...)
+		if *nth != 0 {
+			solveNth(b, p, ps, *nth, *sf.stats, gf.colored(os.Stdout), ev, append(opts, iqpuzzler.WithLogger(logger)))
+			return
+		}
+		var out *iqpuzzler.SolutionWriter
+		if *gf.output != "" {
+			var f = gf.create()
+			atExit(func() { f.Close() })
+			if out, err = iqpuzzler.NewSolutionWriter(f, iqpuzzler.NewSolutionHeader(b, p.setID)); err != nil {
+				exit(err)
+			}
+		}
+		if p.req.Shuffle {
+			logger.Info("shuffling the search", slog.Uint64("seed", p.req.Seed))
+		}
+		var store = openStore(*storePath, b, p.setID, ps)
+		if store != nil && *storeBloom > 0 {
+			// Tuned for 16 bits a solution, where 1 in 2000 new ones is
+			// skipped.
+			store.store.DedupBloom(*storeBloom<<20, *storeBloom<<20/2)
+		}
+		if store != nil && *sf.maxMem > 0 {
+			store.limitDedup(int64(*sf.maxMem)/dedupShare, logger)
+		}
+		var style = iqpuzzler.RenderStyle{Lines: true}
+		if gf.colored(os.Stdout) {
+			style.Palette = p.pal
+		}
+		var solved bool
+		var onSolution = func(r iqpuzzler.Solution) {
+			ev.solution(r)
+			console.println(levelResult, "Solution found")
+			// The moves as Move.String prints them, with the cells they cover.
+			console.println(levelSummary, []iqpuzzler.Move(r))
+			console.println(levelResult, formatSolution(r, b, style))
+			if out != nil {
+				if err := out.Write(r); err != nil {
+					exit(err)
+				}
+			}
+			if store != nil {
+				store.add(r)
+			}
+			if !solved && *pf.challenge != 0 && !*noTrack {
+				if err := markSolved(*pf.challenge, time.Now()); err != nil {
+					console.errorln(err)
+				}
+			}
+			solved = true
+		}
+		if e, ok := lookupBook(*sf.book, b, p.setID, ps, p.reg, logger); ok {
+			if res, ok := e.Result(p.req.MaxSolutions); ok {
+				console.printf(levelResult, "answered from the book %s\n", *sf.book)
+				if res.Solution != nil {
+					onSolution(res.Solution)
+				}
+				console.println(levelSummary, "all done")
+				ev.end(res)
+				completion.record(res.Status.String(), res.Count, res.Complete, res.Metrics.Nodes)
+				printSummary(*sf.stats, res)
+				return
+			}
+			logger.Info("the book does not hold all the solutions asked for")
+		}
+		var learned, saveScores = sf.placementScores()
+		opts = append(append(append(opts, ev.options()...), learned...), iqpuzzler.WithLogger(logger), iqpuzzler.WithOnSolution(onSolution))
+		var (
+			res         iqpuzzler.SolveResult
+			best        *iqpuzzler.Snapshot
+			interrupted bool
+		)
+		if rc != nil {
+			res, interrupted = rc.search(remoteRequest(p.req, p.setID, ps), p.reg, onSolution)
+		} else {
+			res, best, interrupted = search(b, ps, opts)
+		}
+		if store != nil {
+			var size, _ = store.store.Dedup()
+			res.Metrics.MemoryBytes += size
+		}
+		saveScores()
+		ev.end(res)
+		completion.record(res.Status.String(), res.Count, res.Complete, res.Metrics.Nodes)
+		if interrupted {
+			console.printf(levelResult, "interrupted after %d solutions\n", res.Count)
+			printPartial(*gf.output, *sf.stats, res, best)
+		}
+		console.println(levelSummary, "all done")
+		if store != nil {
+			console.printf(levelSummary, "%d new solutions stored in %s under %s\n", store.added, *storePath, store.hash)
+		}
+		printSummary(*sf.stats, res)
+	}
+}
+
+// solveNth prints the nth solution of the board in the fixed search order,
+// with the board hash, so that "board H, solution #n" names it for good.
+// The solutions before it are only counted.
+func solveNth(b *iqpuzzler.Board, p *puzzle, ps []iqpuzzler.Piece, n int, stats string, colored bool, ev *eventLog, opts []iqpuzzler.Option) {
+	var (
+		seen int
+		sol  iqpuzzler.Solution
+	)
+	opts = append(append(opts, ev.options()...), iqpuzzler.WithOnSolution(func(s iqpuzzler.Solution) {
+		if seen++; seen == n {
+			sol = s
+			ev.emit(event{Type: "solution", Solution: s, Count: int64(n)})
+		}
+	}))
+	res, best, interrupted := search(b, ps, opts)
+	ev.end(res)
+	completion.record(res.Status.String(), res.Count, res.Complete, res.Metrics.Nodes)
+	if interrupted {
+		console.printf(levelResult, "interrupted after %d solutions\n", res.Count)
+		printPartial("", stats, res, best)
+	}
+	switch {
+	case sol == nil && res.Complete:
+		exit(fmt.Errorf("the board has %d solutions, not %d", res.Count, n))
+	case sol == nil:
+		exit(fmt.Errorf("the search stopped after %d solutions, before solution #%d", res.Count, n))
+	}
+	var style = iqpuzzler.RenderStyle{Lines: true}
+	if colored {
+		style.Palette = p.pal
+	}
+	console.printf(levelResult, "board %s, solution #%d\n", iqpuzzler.BoardHash(b, p.setID, ps), n)
+	console.println(levelResult, formatSolution(sol, b, style))
+	printSummary(stats, res)
+}
+
+// formatSolution returns the solution as solve prints it: its moves, one a
+// line as Solution.String lists them, and then the board it covers.
+func formatSolution(sol iqpuzzler.Solution, b *iqpuzzler.Board, style iqpuzzler.RenderStyle) string {
+	return sol.String() + "\n" + sol.Render(b, style)
+}
+
+func newCountCommand() (*flag.FlagSet, func(args []string)) {
+	var (
+		fs          = newFlagSet("count", "")
+		pf          = addPuzzleFlags(fs)
+		sf          = addSearchFlags(fs)
+		gf          = addGlobalFlags(fs, "write the solutions to this solution file")
+		writers     = fs.Int("write-workers", 0, "the number of goroutines encoding the solutions for -o while the search goes on, 0 to encode each as it is found")
+		checkpointF = fs.String("checkpoint", "", "write the count so far to this file when interrupted, by default checkpoint.json in the user's cache directory")
+	)
+	return fs, func(args []string) {
+		parseFlags(fs, args)
+		var logger = gf.logger()
+		sf.profiles.start()
+		applyMaxMem(*sf.maxMem)
+		sf.complete.setup("count", logger)
+		var p = pf.load()
+		sf.apply(&p.req)
+		var (
+			b  = p.board()
+			ps = p.searchPieces(b, false)
+			ev = openEvents(*sf.events, "count")
+		)
+		ev.parsed(p, b, ps)
+		opts, err := p.req.Options()
+		if err != nil {
+			exit(err)
+		}
+		opts = append(opts, sf.tables()...)
+		if e, ok := lookupBook(*sf.book, b, p.setID, ps, p.reg, logger); ok && *gf.output == "" {
+			if res, ok := e.CountResult(); ok {
+				console.printf(levelResult, "%d from the book %s\n", res.Count, *sf.book)
+				ev.end(res)
+				completion.record(res.Status.String(), res.Count, res.Complete, res.Metrics.Nodes)
+				printSummary(*sf.stats, res)
+				return
+			}
+			logger.Info("the book has no count of the solutions")
+		}
+		var learned, saveScores = sf.placementScores()
+		opts = append(append(append(opts, ev.options()...), learned...), iqpuzzler.WithLogger(logger))
+		var pl *iqpuzzler.Pipeline
+		if *gf.output != "" {
+			var f = gf.create()
+			atExit(func() { f.Close() })
+			out, err := iqpuzzler.NewSolutionWriter(f, iqpuzzler.NewSolutionHeader(b, p.setID))
+			if err != nil {
+				exit(err)
+			}
+			switch {
+			case *writers > 0:
+				pl = iqpuzzler.NewPipeline(*writers, writeDepth**writers, iqpuzzler.EncodeSolution, out.WriteEncoded)
+				opts = append(opts, iqpuzzler.WithOnSolution(func(r iqpuzzler.Solution) {
+					ev.solution(r)
+					pl.Add(r)
+				}))
+			default:
+				opts = append(opts, iqpuzzler.WithOnSolution(func(r iqpuzzler.Solution) {
+					ev.solution(r)
+					if err := out.Write(r); err != nil {
+						exit(err)
+					}
+				}))
+			}
+		} else {
+			opts = append(opts, iqpuzzler.WithOnSolution(ev.solution))
+		}
+		res, best, interrupted := search(b, ps, opts)
+		if pl != nil {
+			if err := pl.Close(); err != nil {
+				exit(err)
+			}
+			logger.Info("solutions written", slog.Duration("stalled", pl.Stalled()))
+		}
+		saveScores()
+		ev.end(res)
+		completion.record(res.Status.String(), res.Count, res.Complete, res.Metrics.Nodes)
+		if res.Complete {
+			console.println(levelResult, res.Count)
+		} else {
+			console.printf(levelResult, "at least %d\n", res.Count)
+		}
+		if interrupted {
+			writeCheckpoint(*checkpointF, p.req, res, best)
+			printPartial(*gf.output, *sf.stats, res, best)
+		}
+		printSummary(*sf.stats, res)
+	}
+}
+
+// writeDepth is the number of solutions each goroutine of count
+// -write-workers may hold.
+const writeDepth = 16
+
+// searchPieces returns the pieces to place on b, checking the hints if
+// asked to, and warns if they do not cover the free cells.
+func (p *puzzle) searchPieces(b *iqpuzzler.Board, hints bool) []iqpuzzler.Piece {
+	ps, err := p.req.ParsePieces(p.reg, b)
+	if err != nil {
+		exit(err)
+	}
+	if hints {
+		if err := iqpuzzler.CheckHints(b, p.pieces, ps); err != nil {
+			exit(err)
+		}
+	}
+	if w := areaWarning(b, ps); w != "" {
+		console.errorln("warning:", w)
+	}
+	return ps
+}
+
+// areaWarning describes why the pieces cannot fill the free cells of b if
+// their cells do not add up, and returns "" otherwise. Such a puzzle is
+// merely unsolvable, so the search still runs and says so.
+func areaWarning(b *iqpuzzler.Board, ps []iqpuzzler.Piece) string {
+	var area int
+	for _, p := range ps {
+		area += p.Size()
+	}
+	if free := b.Free(); area != free {
+		return fmt.Sprintf("the pieces cover %d cells, but %d cells are free", area, free)
+	}
+	return ""
+}
+
+// search runs a solver with the options and reports whether it was
+// interrupted by SIGINT, returning the partial game with the most pieces
+// placed that the search reported, or nil.
+func search(b *iqpuzzler.Board, ps []iqpuzzler.Piece, opts []iqpuzzler.Option) (iqpuzzler.SolveResult, *iqpuzzler.Snapshot, bool) {
+	if console.level >= levelTrace {
+		// Before the other options, which may set OnSolution.
+		opts = append([]iqpuzzler.Option{iqpuzzler.WithHooks(traceHooks)}, opts...)
+	}
+	var best atomic.Pointer[iqpuzzler.Snapshot]
+	opts = append(opts, iqpuzzler.WithProgress(func(p iqpuzzler.Progress) {
+		if cur := best.Load(); p.Snapshot != nil && (cur == nil || len(p.Snapshot.Moves()) > len(cur.Moves())) {
+			best.Store(p.Snapshot)
+		}
+	}))
+	solver, err := iqpuzzler.NewSolver(opts...)
+	if err != nil {
+		exit(err)
+	}
+	ctx, stop := interruptContext()
+	res, err := solver.Solve(ctx, iqpuzzler.NewGame(b), ps)
+	stop()
+	var ae *iqpuzzler.AbortedError
+	if errors.As(err, &ae) {
+		return res, best.Load(), true
+	}
+	if err != nil {
+		exit(err)
+	}
+	return res, best.Load(), false
+}
+
+// traceHooks print every placement of the search and its backtracking.
+var traceHooks = iqpuzzler.Hooks{
+	OnPlace: func(m iqpuzzler.Move, depth int) {
+		console.printf(levelTrace, "trace: depth %d: placed %s\n", depth, m)
+	},
+	OnBacktrack: func(depth int) {
+		console.printf(levelTrace, "trace: depth %d: removed\n", depth)
+	},
+}
+
+// interruptContext returns a context cancelled by the first SIGINT, so that
+// the search stops and the results so far can be printed. A second SIGINT
+// exits at once. stop restores the default handling.
+func interruptContext() (context.Context, func()) {
+	var (
+		ch          = make(chan os.Signal, 1)
+		done        = make(chan struct{})
+		ctx, cancel = context.WithCancel(context.Background())
+	)
+	signal.Notify(ch, os.Interrupt)
+	go func() {
+		select {
+		case <-ch:
+		case <-done:
+			return
+		}
+		console.errorln("interrupted, stopping the search; interrupt again to quit at once")
+		cancel()
+		select {
+		case <-ch:
+			os.Exit(exitAborted)
+		case <-done:
+		}
+	}()
+	return ctx, func() {
+		signal.Stop(ch)
+		close(done)
+		cancel()
+	}
+}
+
+// printPartial prints what an interrupted search leaves: the partial board
+// with the most pieces placed, if any, where the solutions found were
+// written and the stats, as text unless another format was chosen. It exits
+// with exitAborted.
+func printPartial(output, stats string, res iqpuzzler.SolveResult, best *iqpuzzler.Snapshot) {
+	if best != nil {
+		var moves = best.Moves()
+		console.printf(levelResult, "partial board with %d pieces placed:\n%s\n", len(moves), iqpuzzler.Solution(moves).Render(best.Board(), iqpuzzler.RenderStyle{Lines: true}))
+	}
+	if output != "" {
+		console.printf(levelResult, "the %d solutions found are in %s\n", res.Count, output)
+	}
+	if stats == "" {
+		stats = "text"
+	}
+	if err := printStats(console.at(levelResult), stats, res); err != nil {
+		exit(err)
+	}
+	exitWith(exitAborted)
+}
+
+// checkpoint is what an interrupted count writes: the puzzle and settings,
+// the count and metrics so far and the partial board with the most pieces
+// placed, as a board string.
+type checkpoint struct {
+	Request iqpuzzler.SolveRequest `json:"request"`
+	Count   int                    `json:"count"`
+	Metrics iqpuzzler.Metrics      `json:"metrics"`
+	Board   string                 `json:"board,omitempty"`
+}
+
+// writeCheckpoint writes the checkpoint of an interrupted count to path, or
+// to checkpoint.json in the user's cache directory if path is empty, and
+// prints where. Failing to is reported but does not stop the rest of the
+// partial output.
+func writeCheckpoint(path string, req iqpuzzler.SolveRequest, res iqpuzzler.SolveResult, best *iqpuzzler.Snapshot) {
+	var cp = checkpoint{Request: req, Count: res.Count, Metrics: res.Metrics}
+	if best != nil {
+		cp.Board = best.String()
+	}
+	var err = func() error {
+		if path == "" {
+			dir, err := os.UserCacheDir()
+			if err != nil {
+				return err
+			}
+			path = filepath.Join(dir, "iq-puzzler", "checkpoint.json")
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				return err
+			}
+		}
+		b, err := json.MarshalIndent(cp, "", "  ")
+		if err != nil {
+			return err
+		}
+		return writeFileAtomic(path, append(b, '\n'))
+	}()
+	if err != nil {
+		console.errorln("cannot write the checkpoint:", err)
+		return
+	}
+	console.printf(levelResult, "checkpoint written to %s\n", path)
+}
+
+// printSummary prints the stats of the search in the format chosen with
+// -stats, or as text from levelSummary on.
+func printSummary(stats string, res iqpuzzler.SolveResult) {
+	var level = levelResult
+	if stats == "" {
+		stats, level = "text", levelSummary
+	}
+	if err := printStats(console.at(level), stats, res); err != nil {
+		exit(err)
+	}
+}
+
+// printStats writes the outcome and metrics of the search in the given
+// format, which is empty for none.
+func printStats(w io.Writer, format string, res iqpuzzler.SolveResult) error {
+	switch format {
+	case "":
+		return nil
+	case "text":
+		var m = res.Metrics
+		fmt.Fprintf(w, "status:     %s\n", res.Status)
+		fmt.Fprintf(w, "solutions:  %d\n", res.Count)
+		fmt.Fprintf(w, "nodes:      %d\n", m.Nodes)
+		fmt.Fprintf(w, "backtracks: %d\n", m.Backtracks)
+		fmt.Fprintf(w, "max depth:  %d\n", m.MaxDepth)
+		fmt.Fprintf(w, "duration:   %s\n", m.Duration.Round(time.Millisecond))
+		if m.Placements > 0 {
+			fmt.Fprintf(w, "tables:     %d placements of %d orientations (of %d transforms) in %d KiB\n", m.Placements, m.Versions, m.Transforms, (m.TableBytes+1023)/1024)
+		}
+		if m.MemoryBytes > 0 {
+			fmt.Fprintf(w, "memory:     %d KiB estimated\n", (m.MemoryBytes+1023)/1024)
+		}
+		var kinds = make([]string, 0, len(m.Prunes))
+		for k := range m.Prunes {
+			kinds = append(kinds, k)
+		}
+		sort.Strings(kinds)
+		for _, k := range kinds {
+			fmt.Fprintf(w, "pruned:     %d %s\n", m.Prunes[k], k)
+		}
+		return nil
+	case "json":
+		// The solutions were printed as they were found.
+		res.Solutions = nil
+		var enc = json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(iqpuzzler.NewSolveResponse(res, nil))
+	}
+	return fmt.Errorf("unknown stats format %q, want text or json", format)
+}
+
+func newVerifyCommand() (*flag.FlagSet, func(args []string)) {
+	var (
+		fs = newFlagSet("verify", "FILE...")
+		pf = addPuzzleFlags(fs)
+		gf = addGlobalFlags(fs, "write the report to this file")
+		rf = addRemoteFlags(fs)
+	)
+	return fs, func(args []string) {
+		parseFlags(fs, args)
+		if fs.NArg() == 0 {
+			fs.Usage()
+			os.Exit(exitUsage)
+		}
+		var (
+			rc = rf.client(pf)
+			p  = pf.load()
+			w  = gf.create()
+		)
+		defer w.Close()
+		for _, path := range fs.Args() {
+			if err := verifySolutions(w, path, p.setID, p.reg, rc); err != nil {
+				exit(err)
+			}
+		}
+	}
+}
+
+// verifySolutions checks that the solutions in the solution file at path
+// solve its board with the pieces of the registry, and reports to w. With
+// a client, the server checks them.
+func verifySolutions(w io.Writer, path, setID string, reg *iqpuzzler.Registry, rc *remoteClient) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	r, err := iqpuzzler.NewSolutionReader(f)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	var h = r.Header()
+	if h.Set != setID {
+		return fmt.Errorf("%s: solutions are for piece set %q, not %q", path, h.Set, setID)
+	}
+	for n := 1; ; n++ {
+		sol, err := r.Read()
+		if err == io.EOF {
+			fmt.Fprintf(w, "%s: %d solutions ok\n", path, n-1)
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		if rc != nil {
+			res, err := rc.verify(h.Board, h.Set, sol)
+			if err != nil {
+				return err
+			}
+			if !res.Valid {
+				return fmt.Errorf("%s: solution %d: %s", path, n, res.Error)
+			}
+			continue
+		}
+		if err := checkSolution(h.Board, reg, sol); err != nil {
+			return fmt.Errorf("%s: solution %d: %w", path, n, err)
+		}
+	}
+}
+
+// checkSolution resolves the pieces of the solution in the registry and
+// checks that it solves the board.
+func checkSolution(b *iqpuzzler.Board, reg *iqpuzzler.Registry, sol iqpuzzler.Solution) error {
+	if err := sol.Resolve(reg.List()); err != nil {
+		return err
+	}
+	var ps = make([]iqpuzzler.Piece, 0, len(sol))
+	for _, m := range sol {
+		var p, _ = reg.Lookup(m.Piece.Name())
+		ps = append(ps, p)
+	}
+	return iqpuzzler.VerifySolution(b, ps, sol)
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"smaart/iqpuzzler"
+)
+
+// TestSolveOutput pins the format solve prints solutions in, which scripts
+// read: a line per move with the piece, its orientation and the top left
+// corner of the cells it covers, and then the board, with the moves and
+// the cells they cover on one line before them and "all done" after them at
+// -v=1. The output is not a terminal, so the board is not colored.
+func TestSolveOutput(t *testing.T) {
+	const solved = "mint  R270  at 1,0\n" +
+		"green R90   at 1,1\n" +
+		"blue  R180  at 2,2\n" +
+		"xIIII\n" +
+		"EBBBI\n" +
+		"EEBxA\n" +
+		"EEAAA\n"
+	var puzzle = []string{"solve", "-board-preset=mini", "-board=xIIII,....I,...x.,.....", "-pieces=blue,green,mint"}
+	var tests = []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"default", nil, "^Solution found\n" + regexp.QuoteMeta(solved) + "$"},
+		{"quiet", []string{"-q"}, "^$"},
+		{"verbose", []string{"-v=1"}, "^Solution found\n" +
+			regexp.QuoteMeta("[mint R270 at position ([1 0]): [[3 0] [2 0] [1 0] [3 1] [2 1]] "+
+				"green R90 at position ([1 1]): [[1 3] [1 2] [1 1] [2 2]] "+
+				"blue R180 at position ([2 2]): [[3 4] [3 3] [3 2] [2 4]]]\n"+solved) +
+			"all done\n(?s:.*)"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var stdout, stderr, code = runMain(t, append(puzzle, test.args...)...)
+			if code != 0 {
+				t.Fatalf("exit code %d, stderr:\n%s", code, stderr)
+			}
+			if !regexp.MustCompile(test.want).MatchString(stdout) {
+				t.Errorf("solve printed\n%s\nwant to match\n%s", stdout, test.want)
+			}
+		})
+	}
+}
+
+// TestWriteCheckpoint writes the checkpoint of an interrupted count and
+// checks that it holds the request, the count and the partial board, and
+// that its path is printed.
+func TestWriteCheckpoint(t *testing.T) {
+	var set, _ = iqpuzzler.LookupPieceSet("standard")
+	b, err := iqpuzzler.ParseBoard("x....,.....,...x.,.....", 4, 5, set.Pieces, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var (
+		path = filepath.Join(t.TempDir(), "checkpoint.json")
+		req  = iqpuzzler.SolveRequest{Preset: "mini", Board: "x....,.....,...x.,....."}
+		res  = iqpuzzler.SolveResult{Count: 7, Metrics: iqpuzzler.Metrics{Nodes: 1000}}
+		out  strings.Builder
+	)
+	defer func(w io.Writer) { console.w = w }(console.w)
+	console.w = &out
+	writeCheckpoint(path, req, res, iqpuzzler.NewGame(b).Snapshot())
+	if got, want := out.String(), "checkpoint written to "+path+"\n"; got != want {
+		t.Errorf("writeCheckpoint printed %q, want %q", got, want)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var cp checkpoint
+	if err := json.Unmarshal(data, &cp); err != nil {
+		t.Fatal(err)
+	}
+	if cp.Request.Board != req.Board || cp.Count != 7 || cp.Metrics.Nodes != 1000 || cp.Board != req.Board {
+		t.Errorf("the checkpoint is\n%s", data)
+	}
+}
@@ -0,0 +1,366 @@
+package main
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"slices"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"smaart/iqpuzzler"
+)
+
+// statsRun is a search read by stats from an event log or a batch summary.
+type statsRun struct {
+	Name     string        `json:"name"`
+	Hash     string        `json:"hash,omitempty"`
+	Tier     string        `json:"tier,omitempty"`
+	Status   string        `json:"status"`
+	Count    int64         `json:"count"`
+	Complete bool          `json:"complete"`
+	Nodes    int64         `json:"nodes"`
+	Duration time.Duration `json:"duration_ns"`
+	// prunes is set for the runs of event logs, batch summaries do not
+	// count prunes.
+	prunes map[string]int64
+}
+
+// statsTier aggregates the runs of a tier.
+type statsTier struct {
+	Tier       string `json:"tier"`
+	Runs       int    `json:"runs"`
+	Solved     int    `json:"solved"`
+	Unsolvable int    `json:"unsolvable"`
+	Aborted    int    `json:"aborted"`
+	Failed     int    `json:"failed"`
+	// The median of an even number of runs is the higher of the middle two.
+	NodesMedian    int64         `json:"nodes_median"`
+	DurationMedian time.Duration `json:"duration_median_ns"`
+}
+
+// statsPrune is how often placements were cut off for a reason, as a
+// fraction of the placements considered by the runs of event logs: those
+// tried and those cut off.
+type statsPrune struct {
+	Kind  string  `json:"kind"`
+	Count int64   `json:"count"`
+	Ratio float64 `json:"ratio"`
+}
+
+// statsReport is the output of stats.
+type statsReport struct {
+	Files int         `json:"files"`
+	Runs  int         `json:"runs"`
+	Tiers []statsTier `json:"tiers"`
+	All   statsTier   `json:"all"`
+	// TimeoutRate is the fraction of the runs aborted, by their time limit
+	// or otherwise.
+	TimeoutRate float64      `json:"timeout_rate"`
+	Prunes      []statsPrune `json:"prunes,omitempty"`
+	Slowest     []statsRun   `json:"slowest"`
+}
+
+func newStatsCommand() (*flag.FlagSet, func(args []string)) {
+	var (
+		fs      = newFlagSet("stats", "FILE...")
+		gf      = addGlobalFlags(fs, "write the report to this file")
+		format  = fs.String("format", "text", "the format of the report, text or json")
+		slowest = fs.Int("slowest", 10, "list this many of the slowest runs")
+	)
+	return fs, func(args []string) {
+		parseFlags(fs, args)
+		if fs.NArg() == 0 || *slowest < 0 || *format != "text" && *format != "json" {
+			fs.Usage()
+			os.Exit(exitUsage)
+		}
+		var (
+			logger = gf.logger()
+			runs   []statsRun
+		)
+		for _, path := range fs.Args() {
+			rs, err := readRuns(path, logger)
+			if err != nil {
+				exit(err)
+			}
+			runs = append(runs, rs...)
+		}
+		var report = aggregateRuns(runs, *slowest)
+		report.Files = fs.NArg()
+		var w = gf.create()
+		defer w.Close()
+		if *format == "json" {
+			var enc = json.NewEncoder(w)
+			enc.SetIndent("", "  ")
+			if err := enc.Encode(report); err != nil {
+				exit(err)
+			}
+			return
+		}
+		printReport(w, report)
+	}
+}
+
+// readRuns reads the runs in the event log or batch summary at path,
+// telling them apart by their first character.
+func readRuns(path string, logger *slog.Logger) ([]statsRun, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if t := bytes.TrimSpace(data); len(t) > 0 && t[0] == '{' {
+		return eventRuns(path, data, logger), nil
+	}
+	return summaryRuns(path, data)
+}
+
+// eventRuns returns the runs of the event log which ended, one for every
+// start event. Events of unknown types, as written by later versions, are
+// skipped with a warning, like lines which do not parse, such as a last one
+// cut short.
+func eventRuns(path string, data []byte, logger *slog.Logger) []statsRun {
+	var (
+		res     []statsRun
+		cur     *statsRun
+		unknown = make(map[string]bool)
+	)
+	for n := 1; len(data) > 0; n++ {
+		var line []byte
+		line, data, _ = bytes.Cut(data, []byte("\n"))
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		var e event
+		if err := json.Unmarshal(line, &e); err != nil {
+			logger.Warn("skipping invalid event", slog.String("file", path), slog.Int("line", n), slog.Any("error", err))
+			continue
+		}
+		switch e.Type {
+		case "start":
+			if cur != nil {
+				logger.Warn("skipping run without an end event", slog.String("file", path), slog.String("run", cur.Name))
+			}
+			cur = &statsRun{Name: fmt.Sprintf("%s:%d", path, n)}
+		case "parse":
+			if cur == nil {
+				cur = &statsRun{Name: fmt.Sprintf("%s:%d", path, n)}
+			}
+			cur.Hash, cur.Tier = e.Hash, e.Tier
+			if e.Challenge != 0 {
+				cur.Name = fmt.Sprintf("challenge %d", e.Challenge)
+			}
+		case "solution", "prune":
+		case "end":
+			if cur == nil {
+				cur = &statsRun{Name: fmt.Sprintf("%s:%d", path, n)}
+			}
+			cur.Status, cur.Count = e.Status, e.Count
+			cur.Complete = e.Complete != nil && *e.Complete
+			cur.prunes = make(map[string]int64)
+			if m := e.Metrics; m != nil {
+				cur.Nodes, cur.Duration = m.Nodes, m.Duration
+				for k, v := range m.Prunes {
+					cur.prunes[k] = v
+				}
+			}
+			res = append(res, *cur)
+			cur = nil
+		default:
+			if !unknown[e.Type] {
+				unknown[e.Type] = true
+				logger.Warn("skipping events of unknown type", slog.String("file", path), slog.String("type", e.Type))
+			}
+		}
+	}
+	if cur != nil {
+		logger.Warn("skipping run without an end event", slog.String("file", path), slog.String("run", cur.Name))
+	}
+	return res
+}
+
+// summaryRuns returns the rows of the batch summary. Columns are found by
+// the names in the header, so that summaries from before a column was
+// added can be read, and columns added later are ignored.
+func summaryRuns(path string, data []byte) ([]statsRun, error) {
+	var r = csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	header, err := r.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	var cols = make(map[string]int)
+	for i, h := range header {
+		cols[h] = i
+	}
+	for _, c := range []string{"name", "status"} {
+		if _, ok := cols[c]; !ok {
+			return nil, fmt.Errorf("%s: neither an event log nor a batch summary: no column %q", path, c)
+		}
+	}
+	var res []statsRun
+	for {
+		row, err := r.Read()
+		if err == io.EOF {
+			return res, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", path, err)
+		}
+		var n, _ = r.FieldPos(0)
+		var field = func(c string) string {
+			if i, ok := cols[c]; ok && i < len(row) {
+				return row[i]
+			}
+			return ""
+		}
+		var run = statsRun{Name: field("name"), Status: field("status"), Hash: field("hash"), Tier: field("tier")}
+		var ints = []struct {
+			col string
+			v   *int64
+		}{{"solutions", &run.Count}, {"nodes", &run.Nodes}}
+		for _, c := range ints {
+			if v := field(c.col); v != "" {
+				if *c.v, err = strconv.ParseInt(v, 10, 64); err != nil {
+					return nil, fmt.Errorf("%s:%d: invalid %s %q", path, n, c.col, v)
+				}
+			}
+		}
+		if v := field("complete"); v != "" {
+			if run.Complete, err = strconv.ParseBool(v); err != nil {
+				return nil, fmt.Errorf("%s:%d: invalid complete %q", path, n, v)
+			}
+		}
+		if v := field("duration"); v != "" {
+			if run.Duration, err = time.ParseDuration(v); err != nil {
+				return nil, fmt.Errorf("%s:%d: invalid duration %q", path, n, v)
+			}
+		}
+		res = append(res, run)
+	}
+}
+
+// aggregateRuns returns the report on the runs, with the tiers in the order
+// of the challenge catalog and the n slowest runs.
+func aggregateRuns(runs []statsRun, n int) statsReport {
+	var (
+		report = statsReport{Runs: len(runs)}
+		tiers  = make(map[string][]statsRun)
+		order  []string
+	)
+	if cs, err := iqpuzzler.LoadChallenges(); err == nil {
+		for _, c := range cs {
+			if !slices.Contains(order, c.Tier) {
+				order = append(order, c.Tier)
+			}
+		}
+	}
+	for _, r := range runs {
+		if r.Tier != "" && !slices.Contains(order, r.Tier) {
+			order = append(order, r.Tier)
+		}
+		tiers[r.Tier] = append(tiers[r.Tier], r)
+	}
+	for _, t := range append(order, "") {
+		if rs := tiers[t]; len(rs) > 0 {
+			report.Tiers = append(report.Tiers, aggregateTier(t, rs))
+		}
+	}
+	report.All = aggregateTier("all", runs)
+	if len(runs) > 0 {
+		report.TimeoutRate = float64(report.All.Aborted) / float64(len(runs))
+	}
+	var (
+		prunes     = make(map[string]int64)
+		considered int64
+	)
+	for _, r := range runs {
+		if r.prunes == nil {
+			continue
+		}
+		considered += r.Nodes
+		for k, v := range r.prunes {
+			prunes[k] += v
+			considered += v
+		}
+	}
+	for k, v := range prunes {
+		report.Prunes = append(report.Prunes, statsPrune{Kind: k, Count: v, Ratio: float64(v) / float64(considered)})
+	}
+	slices.SortFunc(report.Prunes, func(a, b statsPrune) int { return cmp.Compare(a.Kind, b.Kind) })
+	report.Slowest = slices.Clone(runs)
+	slices.SortStableFunc(report.Slowest, func(a, b statsRun) int { return cmp.Compare(b.Duration, a.Duration) })
+	report.Slowest = report.Slowest[:min(n, len(runs))]
+	return report
+}
+
+func aggregateTier(name string, runs []statsRun) statsTier {
+	var (
+		t     = statsTier{Tier: name, Runs: len(runs)}
+		nodes = make([]int64, 0, len(runs))
+		times = make([]time.Duration, 0, len(runs))
+	)
+	for _, r := range runs {
+		switch r.Status {
+		case iqpuzzler.Solved.String():
+			t.Solved++
+		case iqpuzzler.Unsolvable.String():
+			t.Unsolvable++
+		case iqpuzzler.Aborted.String():
+			t.Aborted++
+		default:
+			t.Failed++
+		}
+		nodes, times = append(nodes, r.Nodes), append(times, r.Duration)
+	}
+	if len(runs) > 0 {
+		slices.Sort(nodes)
+		slices.Sort(times)
+		t.NodesMedian, t.DurationMedian = nodes[len(runs)/2], times[len(runs)/2]
+	}
+	return t
+}
+
+func printReport(w io.Writer, r statsReport) {
+	fmt.Fprintf(w, "%d runs from %d files\n\n", r.Runs, r.Files)
+	var tw = tabwriter.NewWriter(w, 0, 4, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "TIER\tRUNS\tSOLVED\tUNSOLVABLE\tABORTED\tFAILED\tNODES MEDIAN\tTIME MEDIAN\t")
+	for _, t := range append(r.Tiers, r.All) {
+		var name = t.Tier
+		if name == "" {
+			name = "-"
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%d\t%d\t%d\t%s\t\n", name, t.Runs, t.Solved, t.Unsolvable, t.Aborted, t.Failed, t.NodesMedian, t.DurationMedian.Round(time.Microsecond))
+	}
+	tw.Flush()
+	fmt.Fprintf(w, "\ntimeout rate: %.1f%% (%d of %d runs aborted)\n", 100*r.TimeoutRate, r.All.Aborted, r.Runs)
+	for _, p := range r.Prunes {
+		fmt.Fprintf(w, "pruned:       %d %s, %.1f%% of the placements considered\n", p.Count, p.Kind, 100*p.Ratio)
+	}
+	if len(r.Slowest) == 0 {
+		return
+	}
+	fmt.Fprintln(w)
+	tw = tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "SLOWEST\tHASH\tTIER\tSTATUS\tNODES\tTIME")
+	for _, s := range r.Slowest {
+		var hash, tier = s.Hash, s.Tier
+		if hash == "" {
+			hash = "-"
+		}
+		if tier == "" {
+			tier = "-"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%d\t%s\n", s.Name, hash, tier, s.Status, s.Nodes, s.Duration.Round(time.Microsecond))
+	}
+	tw.Flush()
+}
@@ -0,0 +1,33 @@
+//go:build linux
+
+package main
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+// makeRaw puts the terminal on fd into raw mode, in which input is passed
+// on key by key without echo, and returns a function restoring its state.
+func makeRaw(fd int) (func(), error) {
+	var old syscall.Termios
+	if err := termios(fd, syscall.TCGETS, &old); err != nil {
+		return nil, err
+	}
+	var raw = old
+	raw.Iflag &^= syscall.ICRNL | syscall.IXON
+	raw.Lflag &^= syscall.ECHO | syscall.ICANON | syscall.ISIG | syscall.IEXTEN
+	raw.Cc[syscall.VMIN] = 1
+	raw.Cc[syscall.VTIME] = 0
+	if err := termios(fd, syscall.TCSETS, &raw); err != nil {
+		return nil, err
+	}
+	return func() { termios(fd, syscall.TCSETS, &old) }, nil
+}
+
+func termios(fd int, req uintptr, t *syscall.Termios) error {
+	if _, _, e := syscall.Syscall(syscall.SYS_IOCTL, uintptr(fd), req, uintptr(unsafe.Pointer(t))); e != 0 {
+		return e
+	}
+	return nil
+}
@@ -0,0 +1,11 @@
+//go:build !linux
+
+package main
+
+import "errors"
+
+// makeRaw is only supported on Linux; elsewhere lines are read without
+// completion.
+func makeRaw(fd int) (func(), error) {
+	return nil, errors.New("raw terminal mode is not supported")
+}
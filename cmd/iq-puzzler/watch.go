@@ -0,0 +1,192 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"time"
+
+	"smaart/iqpuzzler"
+)
+
+// How often watch looks at the board file, and how long it has to stay the
+// same before it is read, so that an editor saving it in several writes
+// causes one search.
+const (
+	watchPoll     = 200 * time.Millisecond
+	watchDebounce = 300 * time.Millisecond
+)
+
+// watch solves the board of the board file every time it changes, until
+// interrupted. The other puzzle and search flags apply to every search.
+func watch(pf *puzzleFlags, sf *searchFlags, gf *globalFlags, logger *slog.Logger) {
+	var path = *pf.boardFile
+	if path == "" {
+		console.errorln("-watch requires -board-file")
+		os.Exit(exitUsage)
+	}
+	// The file is read on every change instead of by load, which would exit
+	// on a board saved halfway.
+	*pf.boardFile = ""
+	var p = pf.load()
+	sf.apply(&p.req)
+	var style = iqpuzzler.RenderStyle{Lines: true}
+	if gf.colored(os.Stdout) {
+		style.Palette = p.pal
+	}
+	var (
+		ticker  = time.NewTicker(watchPoll)
+		sigs    = make(chan os.Signal, 1)
+		w       = &watcher{p: p, path: path, style: style, logger: logger, clear: isTerminal(os.Stdout)}
+		last    fileStamp
+		changed time.Time
+	)
+	defer ticker.Stop()
+	signal.Notify(sigs, os.Interrupt)
+	defer signal.Stop(sigs)
+	last = stampOf(path)
+	w.restart()
+	for {
+		select {
+		case <-sigs:
+			w.stop()
+			console.println(levelResult, "stopped watching")
+			return
+		case now := <-ticker.C:
+			switch s := stampOf(path); {
+			case s != last:
+				last, changed = s, now
+			case !changed.IsZero() && now.Sub(changed) >= watchDebounce:
+				changed = time.Time{}
+				w.restart()
+			}
+		}
+	}
+}
+
+// fileStamp tells whether a file changed.
+type fileStamp struct {
+	mod  time.Time
+	size int64
+	err  string
+}
+
+func stampOf(path string) fileStamp {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return fileStamp{err: err.Error()}
+	}
+	return fileStamp{mod: fi.ModTime(), size: fi.Size()}
+}
+
+// watcher runs the search of the latest board.
+type watcher struct {
+	p      *puzzle
+	path   string
+	style  iqpuzzler.RenderStyle
+	logger *slog.Logger
+	// clear clears the terminal before each search.
+	clear bool
+	// cancel stops the running search, and done is closed once it has
+	// ended. Both are nil while idle.
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// stop stops the running search, if any, and waits for it to end.
+func (w *watcher) stop() {
+	if w.done != nil {
+		w.cancel()
+		<-w.done
+		w.cancel, w.done = nil, nil
+	}
+}
+
+// restart stops the running search and starts one on the board file as
+// it is now, printing why not if it cannot be solved.
+func (w *watcher) restart() {
+	w.stop()
+	if w.clear {
+		fmt.Print("\x1b[H\x1b[2J")
+	}
+	console.printf(levelResult, "%s at %s\n", w.path, time.Now().Format("15:04:05"))
+	b, ps, err := w.puzzle()
+	if err != nil {
+		console.println(levelResult, err)
+		var pe *iqpuzzler.ParseError
+		if errors.As(err, &pe) && pe.Diagram() != "" {
+			console.println(levelResult, pe.Diagram())
+		}
+		console.println(levelResult, "waiting for the file to change")
+		return
+	}
+	opts, err := w.p.req.Options()
+	if err != nil {
+		console.println(levelResult, err)
+		return
+	}
+	var first iqpuzzler.Solution
+	opts = append(opts, iqpuzzler.WithLogger(w.logger), iqpuzzler.WithOnSolution(func(s iqpuzzler.Solution) {
+		if first == nil {
+			first = s
+		}
+	}))
+	solver, err := iqpuzzler.NewSolver(opts...)
+	if err != nil {
+		console.println(levelResult, err)
+		return
+	}
+	console.println(levelResult, iqpuzzler.Solution(nil).Render(b, w.style))
+	console.println(levelResult, "searching")
+	var ctx context.Context
+	ctx, w.cancel = context.WithCancel(context.Background())
+	w.done = make(chan struct{})
+	go func(done chan struct{}) {
+		defer close(done)
+		res, err := solver.Solve(ctx, iqpuzzler.NewGame(b), ps)
+		var ae *iqpuzzler.AbortedError
+		switch {
+		case ctx.Err() != nil:
+			// Superseded by a newer board, or interrupted.
+			return
+		case err != nil && !errors.As(err, &ae):
+			console.println(levelResult, err)
+			return
+		}
+		if w.clear {
+			fmt.Print("\x1b[H\x1b[2J")
+			console.printf(levelResult, "%s at %s\n", w.path, time.Now().Format("15:04:05"))
+		}
+		if first != nil {
+			console.println(levelResult, first.Render(b, w.style))
+		}
+		var n = fmt.Sprint(res.Count)
+		if !res.Complete {
+			n = "at least " + n
+		}
+		console.printf(levelResult, "%s: %s solutions in %s\n", res.Status, n, res.Metrics.Duration.Round(time.Millisecond))
+	}(w.done)
+}
+
+// puzzle reads the board file and returns its board and pieces.
+func (w *watcher) puzzle() (*iqpuzzler.Board, []iqpuzzler.Piece, error) {
+	s, err := iqpuzzler.ReadBoardFile(w.path)
+	if err != nil {
+		return nil, nil, err
+	}
+	w.p.req.Board = s
+	if err := w.p.req.Validate(); err != nil {
+		return nil, nil, err
+	}
+	b, ps, err := w.p.req.Puzzle(w.p.reg)
+	if err != nil {
+		return nil, nil, err
+	}
+	if w := areaWarning(b, ps); w != "" {
+		console.errorln("warning:", w)
+	}
+	return b, ps, nil
+}
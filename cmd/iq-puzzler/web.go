@@ -0,0 +1,136 @@
+package main
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"strings"
+
+	"smaart/iqpuzzler"
+)
+
+// webFiles holds the page served at /, which drives the HTTP API.
+//
+//go:embed web
+var webFiles embed.FS
+
+// handleWeb adds the web UI and the endpoints only it uses to mux.
+func (s *server) handleWeb(mux *http.ServeMux) {
+	var static, err = fs.Sub(webFiles, "web")
+	if err != nil {
+		panic(err)
+	}
+	mux.Handle("GET /", http.FileServerFS(static))
+	mux.HandleFunc("GET /presets", s.presets)
+	mux.HandleFunc("GET /grid", s.gridQuery)
+	mux.HandleFunc("POST /grid", s.gridBody)
+}
+
+// presetBody describes a board preset in responses to /presets.
+type presetBody struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Rows        int    `json:"rows"`
+	Cols        int    `json:"cols"`
+	Set         string `json:"set"`
+}
+
+func (s *server) presets(w http.ResponseWriter, r *http.Request) {
+	var res []presetBody
+	for _, n := range iqpuzzler.PresetNames() {
+		var p, _ = iqpuzzler.LookupPreset(n)
+		res = append(res, presetBody{n, p.Description, p.Rows, p.Cols, p.Set})
+	}
+	s.reply(w, http.StatusOK, res)
+}
+
+// gridResponse is a board ready to be drawn: one cell per row and column,
+// in the state "empty", "occupied", "blocked" or "piece".
+type gridResponse struct {
+	Rows  int          `json:"rows"`
+	Cols  int          `json:"cols"`
+	Cells [][]gridCell `json:"cells"`
+	// Board is the board, without the solution, in its URL form.
+	Board string `json:"board"`
+}
+
+type gridCell struct {
+	State  string `json:"state"`
+	Piece  string `json:"piece,omitempty"`
+	Letter string `json:"letter,omitempty"`
+	// Color is the color of the piece as #rrggbb.
+	Color string `json:"color,omitempty"`
+}
+
+// gridQuery answers the grid of the board given by the query parameters
+// preset, set, board (in its URL form) and wrap.
+func (s *server) gridQuery(w http.ResponseWriter, r *http.Request) {
+	var (
+		q   = r.URL.Query()
+		req = iqpuzzler.SolveRequest{
+			Preset: q.Get("preset"),
+			Set:    q.Get("set"),
+			Board:  iqpuzzler.DecodeBoardURL(q.Get("board")),
+			Wrap:   q.Get("wrap") == "true",
+		}
+	)
+	s.grid(w, &req, nil)
+}
+
+// gridBody answers the grid of the board of a VerifyRequest with its
+// solution drawn on it.
+func (s *server) gridBody(w http.ResponseWriter, r *http.Request) {
+	var req iqpuzzler.VerifyRequest
+	if !s.decode(w, r, &req) {
+		return
+	}
+	s.grid(w, &req.SolveRequest, req.Solution)
+}
+
+func (s *server) grid(w http.ResponseWriter, req *iqpuzzler.SolveRequest, sol iqpuzzler.Solution) {
+	set, err := req.PieceSet()
+	if err != nil {
+		s.fail(w, http.StatusBadRequest, err)
+		return
+	}
+	reg, err := req.Registry()
+	if err != nil {
+		s.fail(w, http.StatusBadRequest, err)
+		return
+	}
+	b, err := req.ParseBoard(reg)
+	if err != nil {
+		s.fail(w, http.StatusBadRequest, err)
+		return
+	}
+	if err := sol.Resolve(reg.List()); err != nil {
+		s.fail(w, http.StatusBadRequest, err)
+		return
+	}
+	var res = gridResponse{Rows: b.Rows(), Cols: b.Cols(), Board: iqpuzzler.EncodeBoardURL(b)}
+	for _, row := range strings.Split(sol.Render(b, iqpuzzler.RenderStyle{}), ",") {
+		var cells = make([]gridCell, len(row))
+		for y := range row {
+			switch c := row[y]; c {
+			case '.':
+				cells[y].State = "empty"
+			case '#':
+				cells[y].State = "blocked"
+			case 'x':
+				cells[y].State = "occupied"
+			default:
+				cells[y].State = "piece"
+				cells[y].Letter = string(c)
+				if p, ok := reg.ByLetter(rune(c)); ok {
+					cells[y].Piece = p.Name()
+					if col := set.Palette[p.Name()].Color; col.A != 0 {
+						cells[y].Color = fmt.Sprintf("#%02x%02x%02x", col.R, col.G, col.B)
+					}
+				}
+			}
+		}
+		res.Cells = append(res.Cells, cells)
+	}
+	s.reply(w, http.StatusOK, res)
+}
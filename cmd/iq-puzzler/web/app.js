@@ -0,0 +1,144 @@
+// The page keeps the puzzle in its URL: preset, board (rows separated by
+// commas, with '#' written as '_') and wrap, so that a board can be shared
+// by its link.
+
+const params = new URLSearchParams(location.search);
+const state = {
+  preset: params.get("preset") || "standard",
+  board: params.get("board") || "",
+  wrap: params.get("wrap") === "true",
+  grid: null,
+};
+
+const $ = (id) => document.getElementById(id);
+
+async function api(method, path, body) {
+  const res = await fetch(path, {
+    method,
+    headers: body ? { "Content-Type": "application/json" } : {},
+    body: body ? JSON.stringify(body) : undefined,
+  });
+  const data = await res.json();
+  if (!res.ok) {
+    const err = new Error(data.error);
+    err.diagram = data.parse && data.parse.diagram;
+    throw err;
+  }
+  return data;
+}
+
+function status(msg, err) {
+  $("status").textContent = err && err.diagram ? msg + "\n" + err.diagram : msg;
+  $("status").className = err ? "error" : "";
+}
+
+function updateURL() {
+  const p = new URLSearchParams({ preset: state.preset });
+  if (state.board) p.set("board", state.board);
+  if (state.wrap) p.set("wrap", "true");
+  history.replaceState(null, "", "?" + p);
+}
+
+function request() {
+  return {
+    preset: state.preset,
+    board: state.board.replaceAll("_", "#"),
+    wrap: state.wrap,
+  };
+}
+
+// boardOf returns the board drawn by the grid, without any solution on it.
+function boardOf(grid) {
+  return grid.cells
+    .map((row) =>
+      row
+        .map((c) => ({ empty: ".", occupied: "x", blocked: "_" })[c.state] || ".")
+        .join(""),
+    )
+    .join(",");
+}
+
+function draw(grid) {
+  state.grid = grid;
+  const board = $("board");
+  board.style.gridTemplateColumns = `repeat(${grid.cols}, auto)`;
+  board.replaceChildren();
+  grid.cells.forEach((row, x) =>
+    row.forEach((c, y) => {
+      const el = document.createElement("div");
+      el.className = "cell " + c.state;
+      if (c.state === "piece") {
+        el.textContent = c.letter;
+        el.title = c.piece;
+        if (c.color) el.style.background = c.color;
+      } else if (c.state !== "blocked") {
+        el.addEventListener("click", () => toggle(x, y));
+      }
+      board.appendChild(el);
+    }),
+  );
+}
+
+async function load() {
+  updateURL();
+  const p = new URLSearchParams({ preset: state.preset, board: state.board });
+  if (state.wrap) p.set("wrap", "true");
+  try {
+    draw(await api("GET", "/grid?" + p));
+    state.board = state.grid.board;
+    updateURL();
+    status("");
+  } catch (err) {
+    status(err.message, err);
+  }
+}
+
+function toggle(x, y) {
+  const c = state.grid.cells[x][y];
+  c.state = c.state === "empty" ? "occupied" : "empty";
+  state.board = boardOf(state.grid);
+  load();
+}
+
+async function solve(ev) {
+  ev.preventDefault();
+  status("Solving…");
+  try {
+    const res = await api("POST", "/solve", { ...request(), max_solutions: 1 });
+    if (res.count === 0) {
+      status(res.complete ? "No solution." : `No solution found (${res.status}).`);
+      return;
+    }
+    draw(await api("POST", "/grid", { ...request(), solution: res.solutions[0] }));
+    status(`Solved in ${(res.metrics.duration_ns / 1e6).toFixed(1)} ms.`);
+  } catch (err) {
+    status(err.message, err);
+  }
+}
+
+async function init() {
+  const presets = await api("GET", "/presets");
+  for (const p of presets) {
+    const opt = new Option(`${p.name} (${p.rows}×${p.cols})`, p.name);
+    $("preset").add(opt);
+  }
+  $("preset").value = state.preset;
+  $("wrap").checked = state.wrap;
+  $("preset").addEventListener("change", () => {
+    state.preset = $("preset").value;
+    state.board = "";
+    load();
+  });
+  $("wrap").addEventListener("change", () => {
+    state.wrap = $("wrap").checked;
+    load();
+  });
+  $("clear").addEventListener("click", () => {
+    state.board = "";
+    load();
+  });
+  $("controls").addEventListener("submit", solve);
+  load();
+}
+
+init();
@@ -0,0 +1,232 @@
+package iqpuzzler
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// benchSink keeps the compiler from dropping the results of the
+// benchmarks.
+var benchSink int
+
+// standardPuzzle returns the empty standard board and the standard set.
+func standardPuzzle() (*Board, []Piece) {
+	var p = presets["standard"]
+	return NewBoard(p.Rows, p.Cols), pieceSets[p.Set].Pieces
+}
+
+// BenchmarkPrecompute builds the tables of the pieces of the standard set on
+// the standard board from the generated ones, from scratch and from a table
+// cache holding them all.
+func BenchmarkPrecompute(b *testing.B) {
+	var bd, ps = standardPuzzle()
+	b.Run("generated", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			benchSink += len(precompute(bd, ps, FirstEmptyCell))
+		}
+	})
+	b.Run("built", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			for _, piece := range ps {
+				var t = newTable(bd, piece, FirstEmptyCell)
+				benchSink += t.n
+			}
+		}
+	})
+	b.Run("cached", func(b *testing.B) {
+		var c = NewTableCache(64<<20, "")
+		c.precompute(bd, ps, FirstEmptyCell)
+		b.ReportAllocs()
+		b.ResetTimer()
+		for range b.N {
+			benchSink += len(c.precompute(bd, ps, FirstEmptyCell))
+		}
+	})
+}
+
+// BenchmarkPlacementCheck checks one placement of a piece against the
+// occupied cells of the board with every other column filled, going through
+// the placements of the pieces in turn.
+func BenchmarkPlacementCheck(b *testing.B) {
+	var (
+		bd, ps = standardPuzzle()
+		g      = NewGame(bd)
+		masks  []bitboard
+		fits   int
+	)
+	for x := 0; x < bd.rows; x++ {
+		for y := 0; y < bd.cols; y += 2 {
+			g.occ.set(bd.bit(Pos{x, y}))
+		}
+	}
+	for _, t := range precompute(bd, ps, PieceOrder) {
+		for _, sp := range t.spots {
+			var _, mask = t.spot(sp)
+			masks = append(masks, mask)
+		}
+	}
+	b.ResetTimer()
+	for i := range b.N {
+		if !g.occ.overlaps(masks[i%len(masks)]) {
+			fits++
+		}
+	}
+	benchSink += fits
+}
+
+// BenchmarkSearch searches the pentominoes on the 6x10 rectangle and on the
+// 8x8 square with the top right 2x2 corner blocked, stopping after 20000
+// placements. The tables come from a table cache, so that it times the
+// search alone. The blocked cells are occupied from the start, so that the
+// two should cost the same per placement.
+func BenchmarkSearch(b *testing.B) {
+	var (
+		p      = presets["pentomino-6x10"]
+		ps     = pieceSets[p.Set].Pieces
+		lshape = NewBoard(8, 8)
+	)
+	for x := 0; x < 2; x++ {
+		for y := 6; y < 8; y++ {
+			lshape.block(x, y)
+		}
+	}
+	for _, c := range []struct {
+		name string
+		bd   *Board
+	}{
+		{"rectangle", NewBoard(p.Rows, p.Cols)},
+		{"l-shape", lshape},
+	} {
+		b.Run(c.name, func(b *testing.B) {
+			var opts = Options{MaxNodes: 20000, Tables: NewTableCache(64<<20, "")}
+			opts.Tables.precompute(c.bd, ps, FirstEmptyCell)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for range b.N {
+				var res, _ = dfs{FirstEmptyCell}.Solve(context.Background(), c.bd, ps, opts)
+				benchSink += int(res.Metrics.Nodes)
+			}
+		})
+	}
+}
+
+// BenchmarkFirstSolution finds the first solution of the empty standard
+// board and of generated puzzles with 4, 6 and 8 pieces to place, the
+// cases of bench standing in for challenges of increasing difficulty.
+func BenchmarkFirstSolution(b *testing.B) {
+	for _, c := range []struct {
+		name, board, pieces string
+	}{
+		{"empty", "", ""},
+		{"4-pieces", "5x11:3E3H3B2.G2E2J2HB3.3GCJ2.A4.G.C2.I3A4.3C4I.", "maroon,olive,violet,yellow"},
+		{"6-pieces", "5x11:2D2J3.4L.2DJ4.L7.4I2.F.F.BI5.3F3B5.", "blue,lightblue,mint,orange,pink,violet"},
+		{"8-pieces", "5x11:5.B5.C4.2BD3.C4.B.2D2.3C5.D2J10.J", "blue,mint,olive,orange,pink,red,violet,yellow"},
+	} {
+		b.Run(c.name, func(b *testing.B) {
+			var bd, ps = standardPuzzle()
+			if c.board != "" {
+				var err error
+				if bd, err = ParseBoard(c.board, bd.rows, bd.cols, ps, false); err != nil {
+					b.Fatal(err)
+				}
+				if ps, err = ParseAvailable(c.pieces, ps); err != nil {
+					b.Fatal(err)
+				}
+			}
+			var opts = Options{MaxSolutions: 1, Hooks: Hooks{OnSolution: func(Solution) {}}}
+			b.ReportAllocs()
+			for range b.N {
+				var res, err = dfs{FirstEmptyCell}.Solve(context.Background(), bd, ps, opts)
+				if err != nil || res.Count != 1 {
+					b.Fatalf("found %d solutions: %v", res.Count, err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkCount counts the solutions of the mini puzzle and the tilings
+// of the pentomino 3x20 rectangle.
+func BenchmarkCount(b *testing.B) {
+	b.Run("mini", func(b *testing.B) {
+		var bd, ps = miniPuzzle(b)
+		benchCount(b, bd, ps, Options{}, 3)
+	})
+	b.Run("pentomino-3x20", func(b *testing.B) {
+		var p = presets["pentomino-3x20"]
+		benchCount(b, NewBoard(p.Rows, p.Cols), pieceSets[p.Set].Pieces, Options{}, 8)
+	})
+}
+
+// BenchmarkParallelCount counts the tilings of the pentomino 3x20 rectangle
+// with 16 workers, with a progress report every millisecond rather than
+// every second, so that the two show what reading the counters of the
+// workers costs them.
+func BenchmarkParallelCount(b *testing.B) {
+	var (
+		p  = presets["pentomino-3x20"]
+		bd = NewBoard(p.Rows, p.Cols)
+		ps = pieceSets[p.Set].Pieces
+	)
+	b.Run("plain", func(b *testing.B) {
+		benchCount(b, bd, ps, Options{Parallelism: 16}, 8)
+	})
+	b.Run("progress", func(b *testing.B) {
+		var opts = Options{Parallelism: 16, Progress: func(pr Progress) { benchSink += int(pr.Nodes) }}
+		opts.progressEvery = time.Millisecond
+		benchCount(b, bd, ps, opts, 8)
+	})
+}
+
+// benchCount counts the solutions with the options, which must be want.
+func benchCount(b *testing.B, bd *Board, ps []Piece, opts Options, want int) {
+	opts.Hooks.OnSolution = func(Solution) {}
+	b.ReportAllocs()
+	for range b.N {
+		var res, err = dfs{FirstEmptyCell}.Solve(context.Background(), bd, ps, opts)
+		if err != nil || res.Count != want {
+			b.Fatalf("found %d solutions, want %d: %v", res.Count, want, err)
+		}
+	}
+}
+
+// BenchmarkSlowSink enumerates the first 500 solutions of the empty
+// standard board into a sink taking 100µs for each, as writing them to a
+// slow disk or database might, from the goroutine of the search and
+// through a Pipeline of 8 workers, which takes the time of the sink out of
+// the search.
+func BenchmarkSlowSink(b *testing.B) {
+	const (
+		solutions = 500
+		delay     = 100 * time.Microsecond
+		workers   = 8
+	)
+	var (
+		bd, ps = standardPuzzle()
+		work   = func(sol Solution) ([]byte, error) {
+			time.Sleep(delay)
+			return nil, nil
+		}
+	)
+	b.Run("direct", func(b *testing.B) {
+		var opts = Options{MaxSolutions: solutions, Hooks: Hooks{OnSolution: func(sol Solution) { work(sol) }}}
+		for range b.N {
+			var res, _ = dfs{FirstEmptyCell}.Solve(context.Background(), bd, ps, opts)
+			benchSink += res.Count
+		}
+	})
+	b.Run("pipeline", func(b *testing.B) {
+		for range b.N {
+			var (
+				pl   = NewPipeline(workers, 4*workers, work, func([]byte) error { return nil })
+				opts = Options{MaxSolutions: solutions, Hooks: Hooks{OnSolution: pl.Add}}
+			)
+			var res, _ = dfs{FirstEmptyCell}.Solve(context.Background(), bd, ps, opts)
+			pl.Close()
+			benchSink += res.Count
+		}
+	})
+}
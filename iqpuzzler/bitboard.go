@@ -0,0 +1,201 @@
+package iqpuzzler
+
+import "math/bits"
+
+// bitboard is a set of cells of a board, held in as many words as the board
+// needs: the cell (x, y) of a board with c columns is bit x*c+y.
+type bitboard []uint64
+
+// words returns the number of words of the bitboards of the board.
+func (b *Board) words() int {
+	return (b.rows*b.cols + 63) / 64
+}
+
+// bit returns the index of the cell in the bitboards of the board.
+func (b *Board) bit(p Pos) int {
+	return p[0]*b.cols + p[1]
+}
+
+// newBitboard returns a bitboard of the pre-occupied cells of the board.
+func newBitboard(b *Board) bitboard {
+	var bb = make(bitboard, b.words())
+	for x := range b.marks {
+		for y, m := range b.marks[x] {
+			if m != 0 {
+				bb.set(b.bit(Pos{x, y}))
+			}
+		}
+	}
+	return bb
+}
+
+func (bb bitboard) has(i int) bool {
+	return bb[uint(i)/64]&(1<<(uint(i)%64)) != 0
+}
+
+func (bb bitboard) set(i int) {
+	bb[uint(i)/64] |= 1 << (uint(i) % 64)
+}
+
+// overlaps reports whether the bitboards have a cell in common.
+func (bb bitboard) overlaps(o bitboard) bool {
+	for i, w := range o {
+		if bb[i]&w != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// empty reports whether the bitboard has no cells.
+func (bb bitboard) empty() bool {
+	for _, w := range bb {
+		if w != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// or adds the cells of o.
+func (bb bitboard) or(o bitboard) {
+	for i, w := range o {
+		bb[i] |= w
+	}
+}
+
+// andNot removes the cells of o.
+func (bb bitboard) andNot(o bitboard) {
+	for i, w := range o {
+		bb[i] &^= w
+	}
+}
+
+// count returns the number of cells in the bitboard.
+func (bb bitboard) count() int {
+	var n int
+	for _, w := range bb {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// first returns the index of the first cell not in the bitboard, or n if all
+// of the first n are.
+func (bb bitboard) first(n int) int {
+	for i, w := range bb {
+		if w != ^uint64(0) {
+			return min(i*64+bits.TrailingZeros64(^w), n)
+		}
+	}
+	return n
+}
+
+// bits appends the indices of the cells in the bitboard to dst, in
+// increasing order.
+func (bb bitboard) bits(dst []int) []int {
+	for i, w := range bb {
+		for w != 0 {
+			dst = append(dst, i*64+bits.TrailingZeros64(w))
+			w &= w - 1
+		}
+	}
+	return dst
+}
+
+// grid returns the bitboard as a grid of the board's dimensions, true for
+// the cells in it.
+func (bb bitboard) grid(b *Board) [][]bool {
+	var res = make([][]bool, b.rows)
+	for x := range res {
+		res[x] = make([]bool, b.cols)
+		for y := range res[x] {
+			res[x][y] = bb.has(b.bit(Pos{x, y}))
+		}
+	}
+	return res
+}
+
+// version is an orientation of a piece with the masks of the cells it
+// covers at every translation which keeps it on the board.
+type version struct {
+	piece Piece
+	// lo is the smallest such translation and n the number of them along
+	// each axis, from lo on. On a toroidal board, lo is the origin and n its
+	// dimensions.
+	lo, n Pos
+	// masks holds the bitboard of every translation, row by row, words
+	// words each. Placements covering a cell twice, which can happen on a
+	// toroidal board, have an empty mask.
+	masks []uint64
+	words int
+	wrap  bool
+}
+
+// newVersion returns the piece version with its masks on the board.
+func newVersion(b *Board, piece Piece) version {
+	var v = version{piece: piece, n: Pos{b.rows, b.cols}, words: b.words(), wrap: b.wrap}
+	if !b.wrap && len(piece.pos) > 0 {
+		var min, max = BoundingBox(piece.pos)
+		v.lo = Pos{-min[0], -min[1]}
+		v.n = Pos{b.rows - (max[0] - min[0]), b.cols - (max[1] - min[1])}
+	}
+	if v.n[0] <= 0 || v.n[1] <= 0 {
+		v.n = Pos{}
+		return v
+	}
+	v.masks = make([]uint64, v.n[0]*v.n[1]*v.words)
+	for i := 0; i < v.n[0]; i++ {
+		for j := 0; j < v.n[1]; j++ {
+			var (
+				t    = v.lo.Add(Pos{i, j})
+				mask = bitboard(v.masks[(i*v.n[1]+j)*v.words:][:v.words])
+			)
+			for _, p := range piece.pos {
+				var pi = p.Add(t)
+				if b.wrap {
+					pi = pi.Mod(Pos{b.rows, b.cols})
+				}
+				var c = b.bit(pi)
+				if mask.has(c) {
+					clear(mask)
+					break
+				}
+				mask.set(c)
+			}
+		}
+	}
+	return v
+}
+
+// mask returns the cells the piece covers at the translation, or nil if it
+// does not fit on the empty board there.
+func (v *version) mask(pos Pos) bitboard {
+	var i = v.offset(pos)
+	if i < 0 {
+		return nil
+	}
+	return v.at(i)
+}
+
+// offset returns the offset in masks of the mask of the translation, or -1
+// if the piece does not fit on the empty board there.
+func (v *version) offset(pos Pos) int {
+	var x, y = pos[0] - v.lo[0], pos[1] - v.lo[1]
+	if v.wrap && v.n != (Pos{}) {
+		x, y = (x%v.n[0]+v.n[0])%v.n[0], (y%v.n[1]+v.n[1])%v.n[1]
+	}
+	if uint(x) >= uint(v.n[0]) || uint(y) >= uint(v.n[1]) {
+		return -1
+	}
+	var i = (x*v.n[1] + y) * v.words
+	if v.wrap && len(v.piece.pos) > 0 && v.at(i).empty() {
+		return -1
+	}
+	return i
+}
+
+// at returns the mask at the offset.
+func (v *version) at(i int) bitboard {
+	return v.masks[i : i+v.words]
+}
@@ -0,0 +1,283 @@
+package iqpuzzler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Board describes a puzzle: the dimensions of the board and the cells which
+// are occupied initially. Boards are not modified once created, so they can
+// be shared between games and goroutines.
+type Board struct {
+	rows, cols int
+	// marks holds the board symbol of pre-occupied cells: 'x' for occupied,
+	// '#' for blocked, or a piece letter. It is zero for empty cells.
+	marks [][]byte
+	// count is the number of pre-occupied cells. Games start with all of
+	// them occupied, blocked ones included, so that boards of any shape
+	// take a single test of the mask of a placement against the occupied
+	// cells; Free, rather than the mask, tells the cells left to fill.
+	count int
+	// wrap makes the board toroidal: pieces leaving it on one edge continue
+	// on the opposite one.
+	wrap bool
+}
+
+// NewBoard returns an empty board with the given dimensions.
+func NewBoard(rows, cols int) *Board {
+	var b = &Board{rows: rows, cols: cols, marks: make([][]byte, rows)}
+	for x := range b.marks {
+		b.marks[x] = make([]byte, cols)
+	}
+	return b
+}
+
+func (b *Board) clone() *Board {
+	var c = *b
+	c.marks = make([][]byte, b.rows)
+	for x := range b.marks {
+		c.marks[x] = append([]byte(nil), b.marks[x]...)
+	}
+	return &c
+}
+
+// Rows returns the height of the board.
+func (b *Board) Rows() int {
+	return b.rows
+}
+
+// Cols returns the width of the board.
+func (b *Board) Cols() int {
+	return b.cols
+}
+
+// Free returns the number of cells which are not pre-occupied.
+func (b *Board) Free() int {
+	return b.rows*b.cols - b.count
+}
+
+// Wrap reports whether the board is toroidal.
+func (b *Board) Wrap() bool {
+	return b.wrap
+}
+
+// WithWrap returns a copy of the board which is toroidal or not.
+func (b *Board) WithWrap(wrap bool) *Board {
+	var c = b.clone()
+	c.wrap = wrap
+	return c
+}
+
+func (b *Board) inBounds(p Pos) bool {
+	return p[0] >= 0 && p[0] < b.rows && p[1] >= 0 && p[1] < b.cols
+}
+
+// block marks the cell as blocked.
+func (b *Board) block(x, y int) {
+	if b.marks[x][y] == 0 {
+		b.count++
+	}
+	b.marks[x][y] = '#'
+}
+
+// ParseBoard parses a board string. Rows are separated by commas and each
+// cell is one of:
+//
+//	. or 0    empty
+//	x or X    occupied
+//	#         blocked (not part of the board)
+//	A-L       occupied by the piece of ps with that letter
+//
+// In lenient mode, x marks an occupied cell and every other character an empty one.
+// Malformed boards are reported as *ParseError.
+func ParseBoard(b string, dimX, dimY int, ps []Piece, lenient bool) (*Board, error) {
+	return parseBoard(b, dimX, dimY, func(l byte) (byte, bool) {
+		p, ok := pieceByLetter(ps, l)
+		return p.letter, ok
+	}, lenient)
+}
+
+// parseBoard parses a board string, mapping piece letters with letter.
+func parseBoard(b string, dimX, dimY int, letter func(byte) (byte, bool), lenient bool) (*Board, error) {
+	if isCompact(b) {
+		var err error
+		if b, err = expandCompact(b, dimX, dimY); err != nil {
+			return nil, err
+		}
+	}
+	var rows = strings.Split(b, ",")
+	if len(rows) != dimX {
+		return nil, &ParseError{Msg: fmt.Sprintf("board %q has an invalid number of rows, got %d, want %d", b, len(rows), dimX)}
+	}
+	var res = NewBoard(dimX, dimY)
+	for x, row := range rows {
+		if len(row) != dimY {
+			var e = &ParseError{Row: x + 1, Col: len(row) + 1, Line: row, Msg: fmt.Sprintf("row %q has an invalid number of items, got %d, want %d", row, len(row), dimY)}
+			if len(row) > dimY {
+				e.Col, e.Char = dimY+1, row[dimY]
+			}
+			return nil, e
+		}
+		for y := 0; y < len(row); y++ {
+			var c = row[y]
+			switch {
+			case c == 'x' || c == 'X' && !lenient:
+				c = 'x'
+			case lenient:
+				continue
+			case c == '.' || c == '0':
+				continue
+			case c == '#':
+			default:
+				l, ok := letter(c)
+				if !ok && (c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z') {
+					return nil, &ParseError{Row: x + 1, Col: y + 1, Char: c, Line: row, Msg: fmt.Sprintf("no piece has the letter %q", c)}
+				}
+				if !ok {
+					return nil, &ParseError{Row: x + 1, Col: y + 1, Char: c, Line: row, Msg: fmt.Sprintf("invalid character %q", c)}
+				}
+				c = l
+			}
+			res.marks[x][y] = c
+			res.count++
+		}
+	}
+	return res, nil
+}
+
+// String returns the board string of the board.
+func (b *Board) String() string {
+	var rows = make([]string, b.rows)
+	for x := range rows {
+		var row = make([]byte, b.cols)
+		for y := range row {
+			row[y] = cellSymbol(b, x, y)
+		}
+		rows[x] = string(row)
+	}
+	return strings.Join(rows, ",")
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the board as a
+// board string. Whether the board is toroidal is not part of the encoding.
+func (b *Board) MarshalText() ([]byte, error) {
+	return []byte(b.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts board
+// strings in the plain or compact form and takes the dimensions from the
+// text. Since no piece set is at hand, any piece letter is accepted; use
+// ParseBoard to check them against the pieces.
+func (b *Board) UnmarshalText(text []byte) error {
+	var (
+		s          = string(text)
+		rows, cols int
+	)
+	if isCompact(s) {
+		fmt.Sscanf(s, "%dx%d:", &rows, &cols)
+	} else if s != "" {
+		rows = strings.Count(s, ",") + 1
+		cols = strings.IndexByte(s+",", ',')
+	}
+	if rows <= 0 || cols <= 0 {
+		return &ParseError{Msg: fmt.Sprintf("board %q is empty", s)}
+	}
+	res, err := parseBoard(s, rows, cols, func(l byte) (byte, bool) {
+		if l >= 'a' && l <= 'z' {
+			l -= 'a' - 'A'
+		}
+		return l, isPieceLetter(l)
+	}, false)
+	if err != nil {
+		return err
+	}
+	*b = *res
+	return nil
+}
+
+// ParseRegion parses a rectangle on the board given as "r1,c1,r2,c2" with
+// 1-based, inclusive row and column numbers, and returns its corners as
+// positions.
+func (b *Board) ParseRegion(r string) (Pos, Pos, error) {
+	var fs = strings.Split(r, ",")
+	if len(fs) != 4 {
+		return Pos{}, Pos{}, fmt.Errorf("region %q must have the form r1,c1,r2,c2", r)
+	}
+	var v [4]int
+	for i, f := range fs {
+		n, err := strconv.Atoi(strings.TrimSpace(f))
+		if err != nil {
+			return Pos{}, Pos{}, fmt.Errorf("region %q: invalid number %q", r, f)
+		}
+		v[i] = n - 1
+	}
+	var min, max = Pos{v[0], v[1]}, Pos{v[2], v[3]}
+	if !b.inBounds(min) || !b.inBounds(max) || min[0] > max[0] || min[1] > max[1] {
+		return Pos{}, Pos{}, fmt.Errorf("region %q is not a rectangle within the %dx%d board", r, b.rows, b.cols)
+	}
+	return min, max, nil
+}
+
+// Restrict returns a copy of the board with all cells outside of the
+// rectangle from min to max blocked.
+func (b *Board) Restrict(min, max Pos) *Board {
+	var c = b.clone()
+	for x := 0; x < b.rows; x++ {
+		for y := 0; y < b.cols; y++ {
+			if x < min[0] || x > max[0] || y < min[1] || y > max[1] {
+				c.block(x, y)
+			}
+		}
+	}
+	return c
+}
+
+// Unplaced returns the pieces whose letters do not appear on the board.
+func Unplaced(b *Board, ps []Piece) []Piece {
+	var placed = make(map[byte]bool)
+	for _, row := range b.marks {
+		for _, m := range row {
+			placed[m] = true
+		}
+	}
+	var res []Piece
+	for _, p := range ps {
+		if !placed[p.letter] {
+			res = append(res, p)
+		}
+	}
+	return res
+}
+
+// ParseAvailable parses a comma-separated list of piece names, looking each
+// up in ps.
+func ParseAvailable(a string, ps []Piece) ([]Piece, error) {
+	var (
+		names = strings.Split(a, ",")
+		res   []Piece
+	)
+	if len(a) == 0 {
+		return res, nil
+	}
+	for _, p := range names {
+		piece, err := LookupPiece(ps, p)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, piece)
+	}
+	return res, nil
+}
+
+func pieceByLetter(ps []Piece, l byte) (Piece, bool) {
+	if l >= 'a' && l <= 'z' {
+		l -= 'a' - 'A'
+	}
+	for _, pc := range ps {
+		if pc.letter == l {
+			return pc, true
+		}
+	}
+	return Piece{}, false
+}
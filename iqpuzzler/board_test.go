@@ -0,0 +1,65 @@
+package iqpuzzler
+
+import (
+	"errors"
+	"testing"
+)
+
+// standardPieces are the pieces of the standard set.
+var standardPieces = pieceSets["iq-puzzler"].Pieces
+
+func TestParseBoard(t *testing.T) {
+	var tests = []struct {
+		name, board string
+		lenient     bool
+		// want is the board as String returns it.
+		want string
+	}{
+		{"empty dots", ".....,.....", false, ".....,....."},
+		{"empty zeros", "00000,00000", false, ".....,....."},
+		{"occupied", "xX...,...xx", false, "xx...,...xx"},
+		{"blocked", "#....,....#", false, "#....,....#"},
+		{"pieces", "AAA..,A....", false, "AAA..,A...."},
+		{"lower case pieces", "aaa..,a....", false, "AAA..,A...."},
+		{"lenient", "xXO?.,0abx.", true, "x....,...x."},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b, err := ParseBoard(test.board, 2, 5, standardPieces, test.lenient)
+			if err != nil {
+				t.Fatalf("ParseBoard(%q): %v", test.board, err)
+			}
+			if got := b.String(); got != test.want {
+				t.Errorf("ParseBoard(%q) = %q, want %q", test.board, got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseBoardErrors(t *testing.T) {
+	var tests = []struct {
+		name, board string
+		row, col    int
+		char        byte
+	}{
+		{"typo", "xxOxx,.....", 1, 3, 'O'},
+		{"symbol", ".....,..?..", 2, 3, '?'},
+		{"unknown letter", ".....,....Z", 2, 5, 'Z'},
+		{"row too long", "......,.....", 1, 6, '.'},
+		{"row too short", "....,.....", 1, 5, 0},
+		{"rows", ".....", 0, 0, 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var pe *ParseError
+			_, err := ParseBoard(test.board, 2, 5, standardPieces, false)
+			if !errors.As(err, &pe) {
+				t.Fatalf("ParseBoard(%q) = %v, want a *ParseError", test.board, err)
+			}
+			if pe.Row != test.row || pe.Col != test.col || pe.Char != test.char {
+				t.Errorf("ParseBoard(%q) fails at row %d, column %d on %q, want row %d, column %d on %q",
+					test.board, pe.Row, pe.Col, pe.Char, test.row, test.col, test.char)
+			}
+		})
+	}
+}
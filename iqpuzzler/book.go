@@ -0,0 +1,193 @@
+package iqpuzzler
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sort"
+)
+
+// BookFormatVersion is the version of the book file format.
+const BookFormatVersion = 1
+
+// A book file answers known puzzles without a search. It is made to be read
+// into memory and searched as it is, without decoding: a 16 byte header
+// holding bookMagic, the version and the number of entries, all numbers
+// little endian, then the entries sorted by hash, each bookEntrySize bytes:
+//
+//	hash     8 bytes, the BoardHash in binary
+//	count    8 bytes, the number of solutions if counted
+//	offset   4 bytes, where the solution starts after the entries
+//	length   4 bytes, the length of the solution, 0 for none
+//	flags    4 bytes, bookCounted if count holds the number of solutions
+//	reserved 4 bytes
+//
+// and finally the solutions, as JSON like in solution files.
+
+const (
+	bookMagic      = "IQPBOOK\x00"
+	bookHeaderSize = 16
+	bookEntrySize  = 32
+	bookCounted    = 1
+)
+
+// BookEntry is what a book knows about a puzzle.
+type BookEntry struct {
+	// Hash is the BoardHash of the puzzle.
+	Hash string
+	// Solution is a solution of the puzzle, nil if it has none.
+	Solution Solution
+	// Counted tells whether Count is the number of solutions.
+	Counted bool
+	Count   int64
+}
+
+// WriteBook writes a book file with the entries to w.
+func WriteBook(w io.Writer, entries []BookEntry) error {
+	type entry struct {
+		hash [8]byte
+		BookEntry
+	}
+	var es = make([]entry, len(entries))
+	for i, e := range entries {
+		h, err := hex.DecodeString(e.Hash)
+		if err != nil || len(h) != 8 {
+			return fmt.Errorf("invalid board hash %q", e.Hash)
+		}
+		es[i].BookEntry = e
+		copy(es[i].hash[:], h)
+	}
+	slices.SortFunc(es, func(a, b entry) int { return bytes.Compare(a.hash[:], b.hash[:]) })
+	var (
+		head = make([]byte, bookHeaderSize, bookHeaderSize+len(es)*bookEntrySize)
+		sols []byte
+	)
+	copy(head, bookMagic)
+	binary.LittleEndian.PutUint32(head[8:], BookFormatVersion)
+	binary.LittleEndian.PutUint32(head[12:], uint32(len(es)))
+	for i, e := range es {
+		if i > 0 && e.hash == es[i-1].hash {
+			return fmt.Errorf("board %s is in the book twice", e.Hash)
+		}
+		var ent [bookEntrySize]byte
+		copy(ent[:8], e.hash[:])
+		binary.LittleEndian.PutUint64(ent[8:], uint64(e.Count))
+		if e.Solution != nil {
+			b, err := json.Marshal(e.Solution)
+			if err != nil {
+				return err
+			}
+			binary.LittleEndian.PutUint32(ent[16:], uint32(len(sols)))
+			binary.LittleEndian.PutUint32(ent[20:], uint32(len(b)))
+			sols = append(sols, b...)
+		}
+		if e.Counted {
+			binary.LittleEndian.PutUint32(ent[24:], bookCounted)
+		}
+		head = append(head, ent[:]...)
+	}
+	if _, err := w.Write(head); err != nil {
+		return err
+	}
+	_, err := w.Write(sols)
+	return err
+}
+
+// Book is a book file read into memory.
+type Book struct {
+	entries, sols []byte
+}
+
+// ReadBook reads the book file. Only the header is checked; the entries
+// are decoded as they are looked up.
+func ReadBook(path string) (*Book, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < bookHeaderSize || string(data[:8]) != bookMagic {
+		return nil, fmt.Errorf("%s is not a book file", path)
+	}
+	if v := binary.LittleEndian.Uint32(data[8:]); v != BookFormatVersion {
+		return nil, fmt.Errorf("%s: unsupported book version %d, want %d", path, v, BookFormatVersion)
+	}
+	var end = bookHeaderSize + int(binary.LittleEndian.Uint32(data[12:]))*bookEntrySize
+	if end > len(data) {
+		return nil, fmt.Errorf("%s: truncated book file", path)
+	}
+	return &Book{entries: data[bookHeaderSize:end], sols: data[end:]}, nil
+}
+
+// Len returns the number of puzzles in the book.
+func (b *Book) Len() int {
+	return len(b.entries) / bookEntrySize
+}
+
+// Lookup returns the entry of the puzzle with the hash, if the book has one.
+func (b *Book) Lookup(hash string) (BookEntry, bool, error) {
+	h, err := hex.DecodeString(hash)
+	if err != nil || len(h) != 8 {
+		return BookEntry{}, false, fmt.Errorf("invalid board hash %q", hash)
+	}
+	var i = sort.Search(b.Len(), func(i int) bool {
+		return bytes.Compare(b.entries[i*bookEntrySize:i*bookEntrySize+8], h) >= 0
+	})
+	if i == b.Len() {
+		return BookEntry{}, false, nil
+	}
+	var ent = b.entries[i*bookEntrySize : (i+1)*bookEntrySize]
+	if !bytes.Equal(ent[:8], h) {
+		return BookEntry{}, false, nil
+	}
+	var (
+		res = BookEntry{
+			Hash:    hash,
+			Count:   int64(binary.LittleEndian.Uint64(ent[8:])),
+			Counted: binary.LittleEndian.Uint32(ent[24:])&bookCounted != 0,
+		}
+		off = int(binary.LittleEndian.Uint32(ent[16:]))
+		n   = int(binary.LittleEndian.Uint32(ent[20:]))
+	)
+	if n == 0 {
+		return res, true, nil
+	}
+	if off+n > len(b.sols) {
+		return BookEntry{}, false, errors.New("the solution of the book entry lies beyond the end of the book")
+	}
+	if err := json.Unmarshal(b.sols[off:off+n], &res.Solution); err != nil {
+		return BookEntry{}, false, fmt.Errorf("book entry %s: %v", hash, err)
+	}
+	return res, true, nil
+}
+
+// Result returns the result of a search for up to max solutions of the
+// puzzle, 0 for all, if the entry holds them all: its solution answers a
+// search for one, and a count of at most one answers any.
+func (e BookEntry) Result(max int) (SolveResult, bool) {
+	var res = SolveResult{Complete: e.Counted && e.Count <= 1}
+	if e.Solution != nil {
+		res.Solution, res.Count = e.Solution, 1
+	}
+	if !res.Complete && (max != 1 || e.Solution == nil) {
+		return SolveResult{}, false
+	}
+	res.Status = res.status()
+	return res, true
+}
+
+// CountResult returns the result of counting the solutions of the puzzle
+// if the entry has their number, with its solution as the first one.
+func (e BookEntry) CountResult() (SolveResult, bool) {
+	if !e.Counted {
+		return SolveResult{}, false
+	}
+	var res = SolveResult{Solution: e.Solution, Count: int(e.Count), Complete: true}
+	res.Status = res.status()
+	return res, true
+}
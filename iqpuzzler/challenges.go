@@ -0,0 +1,140 @@
+package iqpuzzler
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+//go:embed data/challenges.txt
+var challengeData string
+
+// Challenge is an official challenge.
+type Challenge struct {
+	Number int
+	Tier   string
+	Preset string
+	Board  string
+}
+
+// ErrNoChallenges reports that the embedded catalog holds no challenges:
+// the official challenge cards have not been transcribed into it yet, and
+// no board is identified as one of them.
+var ErrNoChallenges = errors.New("the catalog of official challenges is empty")
+
+// LoadChallenges parses the embedded challenge catalog, which may be empty,
+// see ErrNoChallenges.
+func LoadChallenges() ([]Challenge, error) {
+	return ParseChallenges("challenges.txt", challengeData)
+}
+
+// ParseChallenges parses challenges in the format of the catalog, one per
+// line as number, tier, preset and board; name is used in errors.
+func ParseChallenges(name, data string) ([]Challenge, error) {
+	var res []Challenge
+	for i, l := range strings.Split(data, "\n") {
+		if l = strings.TrimSpace(l); l == "" || strings.HasPrefix(l, "#") {
+			continue
+		}
+		var fs = strings.Fields(l)
+		if len(fs) != 4 {
+			return nil, fmt.Errorf("%s:%d: expected number, tier, preset and board", name, i+1)
+		}
+		n, err := strconv.Atoi(fs[0])
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: invalid number %q", name, i+1, fs[0])
+		}
+		res = append(res, Challenge{n, fs[1], fs[2], fs[3]})
+	}
+	return res, nil
+}
+
+// FindChallenge returns the official challenge with the given number, or
+// ErrNoChallenges while the catalog is empty.
+func FindChallenge(n int) (Challenge, error) {
+	cs, err := LoadChallenges()
+	if err != nil {
+		return Challenge{}, err
+	}
+	if len(cs) == 0 {
+		return Challenge{}, ErrNoChallenges
+	}
+	for _, c := range cs {
+		if c.Number == n {
+			return c, nil
+		}
+	}
+	return Challenge{}, fmt.Errorf("there is no official challenge %d", n)
+}
+
+// Identify returns the challenge for the preset whose board equals b, up to
+// the symmetries of the board. Piece letters on the challenge boards refer
+// to ps.
+func Identify(b *Board, preset string, ps []Piece, lenient bool) (Challenge, bool, error) {
+	cs, err := LoadChallenges()
+	if err != nil {
+		return Challenge{}, false, err
+	}
+	var key = canonicalBoard(b)
+	for _, c := range cs {
+		if c.Preset != preset {
+			continue
+		}
+		var p = presets[c.Preset]
+		cg, err := ParseBoard(c.Board, p.Rows, p.Cols, ps, lenient)
+		if err != nil {
+			return Challenge{}, false, fmt.Errorf("challenge %d: %v", c.Number, err)
+		}
+		if canonicalBoard(p.Block(cg)) == key {
+			return c, true, nil
+		}
+	}
+	return Challenge{}, false, nil
+}
+
+// canonicalBoard returns the smallest board string among the mirror images
+// and rotations of the board which have the same dimensions.
+func canonicalBoard(b *Board) string {
+	var best string
+	for _, t := range boardSymmetries(b.rows, b.cols) {
+		var rows []string
+		for x := 0; x < t.rows; x++ {
+			var row = make([]byte, t.cols)
+			for y := range row {
+				var p = t.f(x, y)
+				row[y] = cellSymbol(b, p[0], p[1])
+			}
+			rows = append(rows, string(row))
+		}
+		if s := strings.Join(rows, ","); best == "" || s < best {
+			best = s
+		}
+	}
+	return best
+}
+
+type boardSymmetry struct {
+	rows, cols int
+	// f maps a cell of the transformed board to the original one.
+	f func(x, y int) Pos
+}
+
+func boardSymmetries(r, c int) []boardSymmetry {
+	var res = []boardSymmetry{
+		{r, c, func(x, y int) Pos { return Pos{x, y} }},
+		{r, c, func(x, y int) Pos { return Pos{r - 1 - x, y} }},
+		{r, c, func(x, y int) Pos { return Pos{x, c - 1 - y} }},
+		{r, c, func(x, y int) Pos { return Pos{r - 1 - x, c - 1 - y} }},
+	}
+	if r == c {
+		res = append(res,
+			boardSymmetry{r, c, func(x, y int) Pos { return Pos{y, x} }},
+			boardSymmetry{r, c, func(x, y int) Pos { return Pos{r - 1 - y, x} }},
+			boardSymmetry{r, c, func(x, y int) Pos { return Pos{y, c - 1 - x} }},
+			boardSymmetry{r, c, func(x, y int) Pos { return Pos{r - 1 - y, c - 1 - x} }},
+		)
+	}
+	return res
+}
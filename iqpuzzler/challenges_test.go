@@ -0,0 +1,53 @@
+package iqpuzzler
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestFindChallengeEmpty checks that looking up a challenge in the empty
+// catalog says that it is empty rather than that the challenge does not
+// exist.
+func TestFindChallengeEmpty(t *testing.T) {
+	cs, err := LoadChallenges()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cs) > 0 {
+		t.Skip("the catalog holds challenges")
+	}
+	if _, err := FindChallenge(1); !errors.Is(err, ErrNoChallenges) {
+		t.Errorf("got %v, want %v", err, ErrNoChallenges)
+	}
+	b, ps := standardPuzzle()
+	if _, ok, err := Identify(b, "standard", ps, false); ok || err != nil {
+		t.Errorf("identified the empty board: %v, %v", ok, err)
+	}
+}
+
+func TestParseChallenges(t *testing.T) {
+	cs, err := ParseChallenges("pack", "# comment\n\n1 starter standard 5x11:55.\n")
+	if err != nil || len(cs) != 1 || cs[0] != (Challenge{1, "starter", "standard", "5x11:55."}) {
+		t.Errorf("got %v, %v", cs, err)
+	}
+	if _, err := ParseChallenges("pack", "x starter standard 5x11:55.\n"); err == nil {
+		t.Error("parsed an invalid number")
+	}
+}
+
+func TestIdentify(t *testing.T) {
+	defer func(d string) { challengeData = d }(challengeData)
+	challengeData = "# comment\n\n1 starter standard AAA........,A..........,...........,...........,...........\n"
+	// The board of the challenge mirrored left to right.
+	g, err := ParseBoard("........AAA,..........A,...........,...........,...........", 5, 11, standardPieces, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, ok, err := Identify(g, "standard", standardPieces, false)
+	if err != nil || !ok || c != (Challenge{1, "starter", "standard", "AAA........,A..........,...........,...........,..........."}) {
+		t.Errorf("got %v, %t, %v", c, ok, err)
+	}
+	if _, ok, err := Identify(g, "mini", standardPieces, false); ok || err != nil {
+		t.Errorf("identified the board in another preset: %t, %v", ok, err)
+	}
+}
@@ -0,0 +1,167 @@
+package iqpuzzler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// The compact board format is a run-length encoding of the board string,
+// introduced by a dimension header, e.g. "5x11:11x,11x,3x7.,11x,11x". Each run
+// is an optional decimal count followed by a cell symbol. The rows may
+// either be given separately (comma-separated) or as a single flattened run
+// list covering the whole board, e.g. "5x11:55.". Since digits denote counts,
+// '.' has to be used for empty cells in this format.
+
+func isCompact(b string) bool {
+	var i = strings.IndexByte(b, ':')
+	if i < 0 {
+		return false
+	}
+	var dims = strings.Split(b[:i], "x")
+	if len(dims) != 2 {
+		return false
+	}
+	for _, d := range dims {
+		if _, err := strconv.Atoi(d); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// expandCompact expands a compact board with the given dimensions into the
+// plain comma-separated format.
+func expandCompact(b string, dimX, dimY int) (string, error) {
+	var i = strings.IndexByte(b, ':')
+	if header := fmt.Sprintf("%dx%d", dimX, dimY); b[:i] != header {
+		return "", &ParseError{Msg: fmt.Sprintf("compact board %q has dimensions %s, want %s", b, b[:i], header)}
+	}
+	var segments = strings.Split(b[i+1:], ",")
+	if len(segments) == 1 && dimX > 1 {
+		row, err := expandRuns(segments[0], dimX*dimY)
+		if err != nil {
+			return "", err
+		}
+		var rows []string
+		for x := 0; x < dimX; x++ {
+			rows = append(rows, row[x*dimY:(x+1)*dimY])
+		}
+		return strings.Join(rows, ","), nil
+	}
+	if len(segments) != dimX {
+		return "", &ParseError{Msg: fmt.Sprintf("compact board %q has an invalid number of rows, got %d, want %d or 1", b, len(segments), dimX)}
+	}
+	var rows []string
+	for _, s := range segments {
+		row, err := expandRuns(s, dimY)
+		if err != nil {
+			return "", err
+		}
+		rows = append(rows, row)
+	}
+	return strings.Join(rows, ","), nil
+}
+
+// expandRuns expands a list of runs into exactly n cells. Cells are single
+// ASCII bytes, so counts and cell symbols are both measured in bytes.
+func expandRuns(s string, n int) (string, error) {
+	var (
+		sb    strings.Builder
+		count string
+	)
+	for i := 0; i < len(s); i++ {
+		var c = s[i]
+		if c >= '0' && c <= '9' {
+			if count == "" && c == '0' {
+				return "", &ParseError{Msg: fmt.Sprintf("ambiguous run %q: counts must not start with 0", s)}
+			}
+			count += string(c)
+			continue
+		}
+		if c >= utf8.RuneSelf {
+			return "", &ParseError{Msg: fmt.Sprintf("runs %q contain a non-ASCII cell symbol", s)}
+		}
+		var k = 1
+		if count != "" {
+			var err error
+			if k, err = strconv.Atoi(count); err != nil || k > n-sb.Len() {
+				return "", &ParseError{Msg: fmt.Sprintf("runs %q exceed %d cells", s, n)}
+			}
+			count = ""
+		}
+		if sb.Len()+k > n {
+			return "", &ParseError{Msg: fmt.Sprintf("runs %q exceed %d cells", s, n)}
+		}
+		sb.WriteString(strings.Repeat(string(c), k))
+	}
+	if count != "" {
+		return "", &ParseError{Msg: fmt.Sprintf("ambiguous run %q: count %s is not followed by a cell symbol", s, count)}
+	}
+	if sb.Len() != n {
+		return "", &ParseError{Msg: fmt.Sprintf("runs %q cover %d cells, want %d", s, sb.Len(), n)}
+	}
+	return sb.String(), nil
+}
+
+// CompactBoard returns the compact encoding of the board.
+func CompactBoard(b *Board) string {
+	var (
+		rows []string
+		flat strings.Builder
+	)
+	for x := 0; x < b.rows; x++ {
+		var row strings.Builder
+		for y := 0; y < b.cols; y++ {
+			row.WriteByte(cellSymbol(b, x, y))
+			flat.WriteByte(cellSymbol(b, x, y))
+		}
+		rows = append(rows, encodeRuns(row.String()))
+	}
+	var (
+		header = fmt.Sprintf("%dx%d:", b.rows, b.cols)
+		res    = header + strings.Join(rows, ",")
+	)
+	if f := header + encodeRuns(flat.String()); len(f) < len(res) {
+		return f
+	}
+	return res
+}
+
+// EncodeBoardURL returns the compact form of the board for use in URLs,
+// with the '#' of blocked cells, which would start the fragment, written as
+// '_'.
+func EncodeBoardURL(b *Board) string {
+	return strings.ReplaceAll(CompactBoard(b), "#", "_")
+}
+
+// DecodeBoardURL returns the board string encoded in a URL by
+// EncodeBoardURL. Plain board strings with '_' for blocked cells are
+// accepted as well.
+func DecodeBoardURL(s string) string {
+	return strings.ReplaceAll(s, "_", "#")
+}
+
+func cellSymbol(b *Board, x, y int) byte {
+	if b.marks[x][y] != 0 {
+		return b.marks[x][y]
+	}
+	return '.'
+}
+
+func encodeRuns(s string) string {
+	var sb strings.Builder
+	for i := 0; i < len(s); {
+		var j = i
+		for j < len(s) && s[j] == s[i] {
+			j++
+		}
+		if j-i > 1 {
+			sb.WriteString(strconv.Itoa(j - i))
+		}
+		sb.WriteByte(s[i])
+		i = j
+	}
+	return sb.String()
+}
@@ -0,0 +1,72 @@
+package iqpuzzler
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCompactRoundTrip(t *testing.T) {
+	var tests = []struct {
+		name, board string
+		rows, cols  int
+	}{
+		{"empty", "...........,...........,...........,...........,...........", 5, 11},
+		{"full", "xxxxxxxxxxx,xxxxxxxxxxx,xxxxxxxxxxx,xxxxxxxxxxx,xxxxxxxxxxx", 5, 11},
+		{"pieces", "AAAB.......,A.BBB......,...B.......,....CC.....,#####CCC###", 5, 11},
+		{"single row", "x.x.x", 1, 5},
+		{"long runs", "............,............,............,............,............,............,............,............,............,............,............,.x..........", 12, 12},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b, err := ParseBoard(test.board, test.rows, test.cols, standardPieces, false)
+			if err != nil {
+				t.Fatalf("ParseBoard(%q): %v", test.board, err)
+			}
+			var c = CompactBoard(b)
+			got, err := ParseBoard(c, test.rows, test.cols, standardPieces, false)
+			if err != nil {
+				t.Fatalf("ParseBoard(%q): %v", c, err)
+			}
+			if got.String() != b.String() {
+				t.Errorf("round trip through %q = %q, want %q", c, got, b)
+			}
+			var u Board
+			if err := u.UnmarshalText([]byte(DecodeBoardURL(EncodeBoardURL(b)))); err != nil {
+				t.Fatalf("UnmarshalText(%q): %v", c, err)
+			}
+			if u.String() != b.String() {
+				t.Errorf("UnmarshalText(%q) = %q, want %q", c, &u, b)
+			}
+		})
+	}
+}
+
+func TestCompactInvalid(t *testing.T) {
+	var tests = []struct {
+		name, board string
+	}{
+		{"leading zero", "5x11:055."},
+		{"trailing count", "5x11:54.1"},
+		{"too few cells", "5x11:54."},
+		{"too many cells", "5x11:56."},
+		{"row too long", "5x11:12.,11.,11.,11.,11."},
+		{"too many rows", "5x11:11.,11.,11.,11.,11.,11."},
+		{"wrong dimensions", "5x10:55."},
+		{"count overflow", "5x11:.9223372036854775807."},
+		{"count beyond int", "5x11:.99999999999999999999999."},
+		{"count beyond row", "5x11:12.,11.,11.,11.,11."},
+		{"non-ascii symbol", "5x11:54.é"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var perr *ParseError
+			if _, err := ParseBoard(test.board, 5, 11, standardPieces, false); !errors.As(err, &perr) {
+				t.Errorf("ParseBoard(%q) = %v, want a *ParseError", test.board, err)
+			}
+			var b Board
+			if err := b.UnmarshalText([]byte(test.board)); err == nil {
+				t.Errorf("UnmarshalText(%q) succeeded", test.board)
+			}
+		})
+	}
+}
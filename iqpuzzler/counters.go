@@ -0,0 +1,55 @@
+package iqpuzzler
+
+import "sync/atomic"
+
+// cacheLine is the size the counters of a worker are padded to: two lines
+// of 64 bytes, as common CPUs fetch neighbouring lines together.
+const cacheLine = 128
+
+// workerCounters are the counters of a worker of a parallel search. Only
+// the worker writes them, adding its placements every pollInterval of them
+// and the rest when a task ends, so that no two workers contend for them;
+// they are atomic so that the goroutine reporting progress can read them
+// while it searches. Each is padded to a cache line of its own, so that a
+// worker adding to its counters does not take the line of another's away.
+type workerCounters struct {
+	nodes, backtracks, blocked atomic.Int64
+	// maxDepth is the largest depth the worker reached.
+	maxDepth atomic.Int64
+	_        [cacheLine - 4*8]byte
+}
+
+// raiseDepth raises the depth reached by the worker to d.
+func (c *workerCounters) raiseDepth(d int) {
+	if int64(d) > c.maxDepth.Load() {
+		c.maxDepth.Store(int64(d))
+	}
+}
+
+// counters are those of the workers of a search.
+type counters []workerCounters
+
+// nodes returns the placements the workers tried so far.
+func (c counters) nodes() int64 {
+	var n int64
+	for i := range c {
+		n += c[i].nodes.Load()
+	}
+	return n
+}
+
+// metrics returns the sums of the counters, and the largest depth.
+func (c counters) metrics() Metrics {
+	var m Metrics
+	var blocked int64
+	for i := range c {
+		m.Nodes += c[i].nodes.Load()
+		m.Backtracks += c[i].backtracks.Load()
+		m.MaxDepth = max(m.MaxDepth, int(c[i].maxDepth.Load()))
+		blocked += c[i].blocked.Load()
+	}
+	if blocked > 0 {
+		m.Prunes = map[string]int64{PruneBlocked: blocked}
+	}
+	return m
+}
@@ -0,0 +1,180 @@
+package iqpuzzler
+
+import (
+	"encoding/binary"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// deadStateMinPieces is the fewest pieces left to place for which a search
+// looks up and adds dead states: the subtrees of fewer are searched faster
+// than the table is.
+const deadStateMinPieces = 3
+
+// deadStateOverhead estimates the memory a state costs a DeadStates beyond
+// its key: the entry of the map and the header of the string.
+const deadStateOverhead = 48
+
+// DeadStates remembers the states of searches which have no solution, so
+// that searches reaching one of them again skip it. A state is the
+// dimensions of the board, whether it wraps around, its occupied cells and
+// the multiset of the shapes of the pieces left to place, in all the
+// orientations they may take: whatever the puzzle, completing such a board
+// with such pieces has a solution or not, and so searches of different
+// puzzles share the states. A state is only added once the search has
+// tried all of its placements without finding a solution; searches which
+// are stopped, or hand part of the state to another worker, do not add it.
+// The hits of a search on the states added by another count as cross hits.
+// States are no longer added once they hold more than the limit of bytes.
+// A DeadStates is safe for concurrent use.
+type DeadStates struct {
+	max int64
+	// searches numbers the searches using the states.
+	searches atomic.Int64
+
+	mu sync.Mutex
+	// states holds the number of the search which added each state.
+	states map[string]int64
+	// shapes numbers the shapes of the pieces, by their orientations.
+	shapes map[string]uint16
+	bytes  int64
+	stats  DeadStatesStats
+}
+
+// DeadStatesStats count the lookups of a DeadStates.
+type DeadStatesStats struct {
+	// Lookups are the states searches looked up, Hits those found and
+	// CrossHits those of the hits added by another search.
+	Lookups, Hits, CrossHits int64
+	// Adds are the states added and Full those which were not for the
+	// limit of bytes.
+	Adds, Full int64
+	// States and Bytes are the states held and their estimated size.
+	States int
+	Bytes  int64
+}
+
+// NewDeadStates returns a table holding up to about maxBytes of states.
+func NewDeadStates(maxBytes int64) *DeadStates {
+	return &DeadStates{max: maxBytes, states: make(map[string]int64), shapes: make(map[string]uint16)}
+}
+
+// WithDeadStates makes the solver skip the states of d and add those it
+// finds to have no solution.
+func WithDeadStates(d *DeadStates) Option {
+	return func(s *Solver) error {
+		s.opts.DeadStates = d
+		return nil
+	}
+}
+
+// Stats returns the counts of the lookups so far.
+func (d *DeadStates) Stats() DeadStatesStats {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var st = d.stats
+	st.States, st.Bytes = len(d.states), d.bytes
+	return st
+}
+
+// deadSearch is the view of a DeadStates of one search.
+type deadSearch struct {
+	d *DeadStates
+	// id is the number of the search.
+	id int64
+	// prefix starts the keys of the states of the board.
+	prefix []byte
+}
+
+// search returns the view of the search of the board with the pieces,
+// numbering the shapes of the pieces in their shape fields.
+func (d *DeadStates) search(b *Board, ps []pieceTable) *deadSearch {
+	var ds = &deadSearch{d: d, id: d.searches.Add(1)}
+	ds.prefix = binary.AppendUvarint(ds.prefix, uint64(b.rows))
+	ds.prefix = binary.AppendUvarint(ds.prefix, uint64(b.cols))
+	if b.wrap {
+		ds.prefix = append(ds.prefix, 1)
+	} else {
+		ds.prefix = append(ds.prefix, 0)
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for i := range ps {
+		var key = versionsKey(&ps[i])
+		var id, ok = d.shapes[key]
+		if !ok {
+			id = uint16(len(d.shapes))
+			d.shapes[key] = id
+		}
+		ps[i].shape = id
+	}
+	return ds
+}
+
+// versionsKey returns the cells of the orientations of the piece of the
+// table, which are the same for pieces of the same shape whatever their
+// names.
+func versionsKey(t *pieceTable) string {
+	var vs = make([]string, len(t.versions))
+	for i, v := range t.versions {
+		vs[i] = shapeKey(normalize(v.piece.pos))
+	}
+	slices.Sort(vs)
+	return strings.Join(vs, "|")
+}
+
+// key appends the key of the state of the occupied cells with the pieces
+// left to dst, using shapes to sort the numbers of their shapes.
+func (ds *deadSearch) key(dst []byte, shapes []uint16, occ bitboard, ps []pieceTable) ([]byte, []uint16) {
+	dst = append(dst, ds.prefix...)
+	for _, w := range occ {
+		dst = binary.LittleEndian.AppendUint64(dst, w)
+	}
+	shapes = shapes[:0]
+	for i := range ps {
+		shapes = append(shapes, ps[i].shape)
+	}
+	slices.Sort(shapes)
+	for _, id := range shapes {
+		dst = binary.LittleEndian.AppendUint16(dst, id)
+	}
+	return dst, shapes
+}
+
+// has reports whether the state of the key has no solution.
+func (ds *deadSearch) has(key []byte) bool {
+	var d = ds.d
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.stats.Lookups++
+	var id, ok = d.states[string(key)]
+	if ok {
+		d.stats.Hits++
+		if id != ds.id {
+			d.stats.CrossHits++
+		}
+	}
+	return ok
+}
+
+// add records that the state of the key has no solution.
+func (ds *deadSearch) add(key []byte) {
+	var (
+		d    = ds.d
+		size = int64(len(key)) + deadStateOverhead
+	)
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if _, ok := d.states[string(key)]; ok {
+		return
+	}
+	if d.bytes+size > d.max {
+		d.stats.Full++
+		return
+	}
+	d.states[string(key)] = ds.id
+	d.bytes += size
+	d.stats.Adds++
+}
@@ -0,0 +1,103 @@
+package iqpuzzler
+
+import (
+	"maps"
+	"testing"
+)
+
+// TestDeadStates counts the solutions of puzzles of the standard board one
+// after the other, twice, sharing the dead states between all of them, and
+// checks that each finds the solutions of its search without them: that a
+// state is only skipped where it has no solution, whichever puzzle added
+// it.
+func TestDeadStates(t *testing.T) {
+	type puzzle struct {
+		name, board, pieces string
+	}
+	var puzzles = []puzzle{
+		{"corner", "LLLL.......,KL.........,KK.........,JKK........,JJ.........", ""},
+		{"maroon right", "LLLL......D,KL.......DD,KK.......D.,JKK........,JJ.........", ""},
+		{"4 pieces", "5x11:3E3H3B2.G2E2J2HB3.3GCJ2.A4.G.C2.I3A4.3C4I.", "maroon,olive,violet,yellow"},
+		{"6 pieces", "5x11:2D2J3.4L.2DJ4.L7.4I2.F.F.BI5.3F3B5.", "blue,lightblue,mint,orange,pink,violet"},
+		{"8 pieces", "5x11:5.B5.C4.2BD3.C4.B.2D2.3C5.D2J10.J", "blue,mint,olive,orange,pink,red,violet,yellow"},
+		{"unsolvable", "5x11:5.B5.C4.2BD3.C4.B.2D2.3C5.D2J10.J", "blue,mint,olive,orange,pink,red,violet,lightblue"},
+	}
+	if testing.Short() || raceEnabled {
+		// The corner takes most of the time, counting 1708 solutions.
+		puzzles = puzzles[1:]
+	}
+	var (
+		boards = make([]*Board, len(puzzles))
+		pieces = make([][]Piece, len(puzzles))
+		want   = make([]map[string]int, len(puzzles))
+	)
+	for i, p := range puzzles {
+		var err error
+		if boards[i], err = ParseBoard(p.board, 5, 11, standardPieces, false); err != nil {
+			t.Fatalf("%s: %v", p.name, err)
+		}
+		pieces[i] = Unplaced(boards[i], standardPieces)
+		if p.pieces != "" {
+			if pieces[i], err = ParseAvailable(p.pieces, standardPieces); err != nil {
+				t.Fatalf("%s: %v", p.name, err)
+			}
+		}
+		_, want[i] = countDistinct(t, boards[i], pieces[i], Options{Parallelism: 1})
+	}
+	if len(want[len(want)-1]) != 0 {
+		t.Fatalf("the unsolvable puzzle has %d solutions", len(want[len(want)-1]))
+	}
+	for _, workers := range []int{1, 4} {
+		var dead = NewDeadStates(64 << 20)
+		for run := range 2 {
+			for i, p := range puzzles {
+				res, got := countDistinct(t, boards[i], pieces[i], Options{Parallelism: workers, DeadStates: dead})
+				if res.Count != len(want[i]) || !maps.Equal(got, want[i]) {
+					t.Errorf("%d workers, run %d: %s: found %d solutions, %d distinct, want the %d without dead states",
+						workers, run+1, p.name, res.Count, len(got), len(want[i]))
+				}
+			}
+		}
+		var st = dead.Stats()
+		if st.Adds == 0 || st.Hits == 0 || st.CrossHits == 0 || st.CrossHits > st.Hits || st.Hits > st.Lookups {
+			t.Errorf("%d workers: %+v, want states added and hit, some by other searches", workers, st)
+		}
+	}
+}
+
+// TestDeadStatesKey checks that the keys of the states depend on the
+// shapes of the pieces left, in any order and whatever their names, and on
+// how many of each there are.
+func TestDeadStatesKey(t *testing.T) {
+	var (
+		b     = NewBoard(5, 11)
+		blue  = standardPieces[0]
+		other = NewPiece("other", 'z', blue.Cells())
+		occ   = newBitboard(b)
+		d     = NewDeadStates(1 << 20)
+	)
+	var key = func(ps ...Piece) string {
+		var ts = make([]pieceTable, len(ps))
+		for i, p := range ps {
+			ts[i] = newTable(b, p, FirstEmptyCell)
+		}
+		var k, _ = d.search(b, ts).key(nil, nil, occ, ts)
+		return string(k)
+	}
+	var (
+		red  = standardPieces[1]
+		base = key(blue, red)
+	)
+	if key(red, blue) != base {
+		t.Error("the key depends on the order of the pieces")
+	}
+	if key(other, red) != base {
+		t.Error("the key depends on the names of the pieces")
+	}
+	if key(blue, blue, red) == base || key(blue) == base {
+		t.Error("the key does not depend on the number of the pieces")
+	}
+	if key(blue, standardPieces[2]) == base {
+		t.Error("the key does not depend on the shapes of the pieces")
+	}
+}
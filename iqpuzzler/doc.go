@@ -0,0 +1,18 @@
+// Package iqpuzzler solves IQ Puzzler style puzzles: tiling a board, which
+// may be partially occupied, with a set of polyomino pieces.
+//
+// A typical use parses a board, picks the pieces to place and solves it:
+//
+//	set, _ := iqpuzzler.LookupPieceSet("iq-puzzler")
+//	b, err := iqpuzzler.ParseBoard("5x11:55.", 5, 11, set.Pieces, false)
+//	...
+//	s, err := iqpuzzler.NewSolver(iqpuzzler.WithMaxSolutions(1))
+//	...
+//	res, err := s.Solve(ctx, iqpuzzler.NewGame(b), set.Pieces)
+//
+// A Board is the immutable description of a puzzle and may be shared; a
+// Game holds the moves made on a board and belongs to one goroutine. To
+// look at a game from another goroutine, take a Snapshot on the goroutine
+// owning it and hand it over; snapshots are never modified. The solver does
+// so for progress reports.
+package iqpuzzler
@@ -0,0 +1,185 @@
+package iqpuzzler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Engine is a search algorithm. Solve searches for the ways to cover the
+// free cells of the board with the given pieces. Engines report solutions
+// to opts.Hooks.OnSolution if it is set, and in the result otherwise, and
+// stop at opts.MaxSolutions and opts.Timeout. Cancelling ctx must stop the
+// search and return an *AbortedError. Engines need not call the other hooks
+// or fill in the Status of the result, which the Solver derives.
+type Engine interface {
+	Solve(ctx context.Context, b *Board, ps []Piece, opts Options) (SolveResult, error)
+}
+
+// Options are the settings of a search which apply to every engine.
+type Options struct {
+	// MaxSolutions stops the search after that many solutions. Zero means
+	// no limit.
+	MaxSolutions int
+	// Timeout stops the search after that long. Zero means no limit.
+	Timeout time.Duration
+	// MaxNodes stops the search after about that many placements. Zero
+	// means no limit.
+	MaxNodes int64
+	// Parallelism limits the number of goroutines searching concurrently.
+	// Zero lets the engine choose.
+	Parallelism int
+	// ParDepth is the deepest placement, the first of the search being at
+	// depth 1, which a worker of a parallel search hands to an idle one
+	// instead of trying it itself; deeper subtrees are searched by the
+	// worker reaching them. Zero shares the placements leaving at least
+	// three pieces, and ParDepthAuto picks the depth from the branching of
+	// the first placements. Engines may ignore it.
+	ParDepth int
+	Progress func(Progress)
+	Hooks    Hooks
+	Logger   *slog.Logger
+	// Paranoid asks the engine to check its state at every step.
+	Paranoid bool
+	// Rand, if not nil, randomizes the order of the search. Engines may
+	// only use it from the goroutine calling Solve.
+	Rand *rand.Rand
+	// Yield, if not nil, is called by the searching goroutines every few
+	// thousand placements.
+	Yield func()
+	// Scores, if not nil, order the placements of each piece the search
+	// tries, and the solutions found are recorded in them. Engines may
+	// ignore them.
+	Scores *PlacementScores
+	// Tables, if not nil, keeps the placement tables of the pieces between
+	// searches. Engines may ignore it.
+	Tables *TableCache
+	// DeadStates, if not nil, holds the states without a solution the
+	// search skips, and takes those it finds. Engines may ignore it.
+	DeadStates *DeadStates
+	// progressEvery, if not zero, replaces progressInterval for the
+	// self-checks.
+	progressEvery time.Duration
+}
+
+// dfs is the built-in depth-first search with the given strategy.
+type dfs struct {
+	strategy Strategy
+}
+
+func (e dfs) Solve(ctx context.Context, b *Board, ps []Piece, opts Options) (SolveResult, error) {
+	var s = &Solver{opts: opts, strategy: e.strategy}
+	return s.solve(ctx, NewGame(b), ps)
+}
+
+var (
+	enginesMu sync.RWMutex
+	engines   = map[string]Engine{
+		PieceOrder.String():     dfs{PieceOrder},
+		FirstEmptyCell.String(): dfs{FirstEmptyCell},
+		"stack":                 stackEngine{},
+	}
+)
+
+// RegisterEngine makes the engine available under the given name. It panics
+// if the name is already taken or the engine is nil.
+func RegisterEngine(name string, e Engine) {
+	enginesMu.Lock()
+	defer enginesMu.Unlock()
+	if e == nil {
+		panic("iqpuzzler: RegisterEngine with nil engine")
+	}
+	if _, ok := engines[name]; ok {
+		panic("iqpuzzler: RegisterEngine called twice for " + name)
+	}
+	engines[name] = e
+}
+
+// LookupEngine returns the engine registered under the given name.
+func LookupEngine(name string) (Engine, bool) {
+	enginesMu.RLock()
+	defer enginesMu.RUnlock()
+	e, ok := engines[name]
+	return e, ok
+}
+
+// EngineNames returns the names of the registered engines, sorted.
+func EngineNames() []string {
+	enginesMu.RLock()
+	defer enginesMu.RUnlock()
+	var res []string
+	for n := range engines {
+		res = append(res, n)
+	}
+	slices.Sort(res)
+	return res
+}
+
+// ParseEngine returns the engine registered under the given name.
+func ParseEngine(name string) (Engine, error) {
+	if e, ok := LookupEngine(name); ok {
+		return e, nil
+	}
+	return nil, fmt.Errorf("unknown engine %q, want one of %s", name, quoteList(EngineNames()))
+}
+
+// WithEngine makes the solver search with e instead of its depth-first
+// search. The strategy is ignored then.
+func WithEngine(e Engine) Option {
+	return func(s *Solver) error {
+		if e == nil {
+			return fmt.Errorf("invalid engine nil")
+		}
+		s.engine = e
+		return nil
+	}
+}
+
+// position returns a board on which the cells covered by the game's moves
+// are pre-occupied, marked with the letters of their pieces.
+func (g *Game) position() *Board {
+	if g.numMoves() == 0 {
+		return g.board
+	}
+	var b = g.board.clone()
+	for _, m := range g.Moves() {
+		var l = m.Piece.letter
+		if l == 0 {
+			l = 'x'
+		}
+		for _, p := range m.Image() {
+			if b.marks[p[0]][p[1]] == 0 {
+				b.count++
+			}
+			b.marks[p[0]][p[1]] = l
+		}
+	}
+	return b
+}
+
+// VerifySolution checks that the solution covers the free cells of the
+// board exactly, using every piece of ps once.
+func VerifySolution(b *Board, ps []Piece, sol Solution) error {
+	if len(sol) != len(ps) {
+		return fmt.Errorf("solution has %d moves, want %d", len(sol), len(ps))
+	}
+	var used = make([]bool, len(ps))
+	for _, m := range sol {
+		var i = slices.IndexFunc(ps, func(p Piece) bool { return p.name == m.Piece.name })
+		if i < 0 {
+			return fmt.Errorf("solution uses unknown piece %s", m.Piece.name)
+		}
+		if used[i] {
+			return fmt.Errorf("solution uses piece %s twice", m.Piece.name)
+		}
+		used[i] = true
+		if !m.Piece.Equal(ps[i]) {
+			return fmt.Errorf("solution changes the shape of piece %s", m.Piece.name)
+		}
+	}
+	return sol.Validate(b)
+}
@@ -0,0 +1,121 @@
+package iqpuzzler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// TestEngineConformance runs the conformance suite on every registered
+// engine.
+func TestEngineConformance(t *testing.T) {
+	for _, name := range EngineNames() {
+		var e, _ = LookupEngine(name)
+		if err := checkEngine(e); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+	}
+}
+
+// conformanceCase is a puzzle every engine must agree on with the built-in
+// depth-first search.
+type conformanceCase struct {
+	name   string
+	board  string
+	rows   int
+	cols   int
+	set    string
+	pieces string
+}
+
+var conformanceCases = []conformanceCase{
+	{"mini", "4x5:x12.x6.", 4, 5, "iq-puzzler", "blue,green,mint,red"},
+	{"wrapped", "000,000,000", 3, 3, "iq-puzzler", "green,mint"},
+	{"unsolvable", "0000000000", 1, 10, "pentomino", "l,x"},
+	{"pentomino", "00000,00000,00000", 3, 5, "pentomino", "l,p,v"},
+}
+
+// checkEngine runs the engine on a set of small puzzles and reports the
+// first way in which it departs from the contract of Engine: solutions
+// differing from those of the built-in search, incomplete searches, invalid
+// solutions, ignored limits and hooks, or cancellation not being reported.
+func checkEngine(e Engine) error {
+	var ctx = context.Background()
+	for _, c := range conformanceCases {
+		b, ps, err := c.load()
+		if err != nil {
+			return fmt.Errorf("%s: %v", c.name, err)
+		}
+		if c.name == "wrapped" {
+			b = b.WithWrap(true)
+		}
+		want, err := dfs{PieceOrder}.Solve(ctx, b, ps, Options{})
+		if err != nil {
+			return fmt.Errorf("%s: reference search: %v", c.name, err)
+		}
+		got, err := e.Solve(ctx, b, ps, Options{})
+		if err != nil {
+			return fmt.Errorf("%s: %v", c.name, err)
+		}
+		if got.Count != want.Count || len(got.Solutions) != got.Count {
+			return fmt.Errorf("%s: got %d solutions (%d in result), want %d", c.name, got.Count, len(got.Solutions), want.Count)
+		}
+		if !got.Complete {
+			return fmt.Errorf("%s: search not complete", c.name)
+		}
+		var found = make(map[string]int)
+		for _, sol := range got.Solutions {
+			if err := VerifySolution(b, ps, sol); err != nil {
+				return fmt.Errorf("%s: %v", c.name, err)
+			}
+			found[sol.Canonical()]++
+		}
+		for _, sol := range want.Solutions {
+			if found[sol.Canonical()]--; found[sol.Canonical()] < 0 {
+				return fmt.Errorf("%s: solution %s not found", c.name, sol.Render(b, RenderStyle{}))
+			}
+		}
+		if want.Count > 1 {
+			got, err := e.Solve(ctx, b, ps, Options{MaxSolutions: 1})
+			if err != nil {
+				return fmt.Errorf("%s: %v", c.name, err)
+			}
+			if got.Count != 1 {
+				return fmt.Errorf("%s: got %d solutions with a limit of 1", c.name, got.Count)
+			}
+		}
+		var n int
+		got, err = e.Solve(ctx, b, ps, Options{Hooks: Hooks{OnSolution: func(Solution) { n++ }}})
+		if err != nil {
+			return fmt.Errorf("%s: %v", c.name, err)
+		}
+		if n != want.Count || len(got.Solutions) != 0 {
+			return fmt.Errorf("%s: OnSolution called %d times with %d solutions in the result, want %d and 0", c.name, n, len(got.Solutions), want.Count)
+		}
+		var cctx, cancel = context.WithCancel(ctx)
+		cancel()
+		_, err = e.Solve(cctx, b, ps, Options{})
+		if ae := (*AbortedError)(nil); !errors.As(err, &ae) || !errors.Is(err, context.Canceled) {
+			return fmt.Errorf("%s: got %v from a cancelled search, want an *AbortedError", c.name, err)
+		}
+	}
+	return nil
+}
+
+// load parses the case's board and pieces.
+func (c conformanceCase) load() (*Board, []Piece, error) {
+	set, ok := LookupPieceSet(c.set)
+	if !ok {
+		return nil, nil, fmt.Errorf("unknown piece set %q", c.set)
+	}
+	b, err := ParseBoard(c.board, c.rows, c.cols, set.Pieces, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	ps, err := ParseAvailable(c.pieces, set.Pieces)
+	if err != nil {
+		return nil, nil, err
+	}
+	return b, ps, nil
+}
@@ -0,0 +1,97 @@
+package iqpuzzler
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrBoardFull was returned when a piece had more cells than were left
+	// on the board.
+	//
+	// Deprecated: Game.Add and the search take such a piece for one which
+	// does not fit.
+	ErrBoardFull = errors.New("board is already full")
+	// ErrNoMoves is returned when taking back a move of a game without
+	// moves.
+	ErrNoMoves = errors.New("failed to pop from empty game")
+	// ErrNothingToRedo is returned by Redo if no move has been taken back.
+	ErrNothingToRedo = errors.New("there is no move to redo")
+)
+
+// OutOfBoundsError reports that a piece would cover the position Pos, which
+// is outside of the board.
+type OutOfBoundsError struct {
+	Pos Pos
+}
+
+func (e *OutOfBoundsError) Error() string {
+	return fmt.Sprintf("position %v is outside of the board", e.Pos)
+}
+
+// OverlapError reports that a piece would cover the position Pos, which is
+// already occupied.
+type OverlapError struct {
+	Pos Pos
+}
+
+func (e *OverlapError) Error() string {
+	return fmt.Sprintf("position %v is already occupied", e.Pos)
+}
+
+// ParseError is an error in a board string or a piece file. Row and Col
+// are 1-based and zero if the error is not about a particular row or cell.
+type ParseError struct {
+	Row, Col int
+	Msg      string
+	// Char is the offending character, or zero if there is none, for
+	// example because the row is too short.
+	Char byte
+	// Line is the text of the row the error is in, if known.
+	Line string
+}
+
+func (e *ParseError) Error() string {
+	switch {
+	case e.Col > 0:
+		return fmt.Sprintf("%s at row %d, column %d", e.Msg, e.Row, e.Col)
+	case e.Row > 0:
+		return fmt.Sprintf("%s at row %d", e.Msg, e.Row)
+	}
+	return e.Msg
+}
+
+// Diagram returns the offending row with a caret under the column of the
+// error, or the empty string if the error has no position.
+func (e *ParseError) Diagram() string {
+	if e.Line == "" || e.Col <= 0 {
+		return ""
+	}
+	return fmt.Sprintf("  %s\n  %s^", e.Line, strings.Repeat(" ", e.Col-1))
+}
+
+// UnknownPieceError reports that no piece has the given name. Suggestions
+// holds the closest known names, if any are plausible typos.
+type UnknownPieceError struct {
+	Name        string
+	Suggestions []string
+}
+
+func (e *UnknownPieceError) Error() string {
+	if len(e.Suggestions) > 0 {
+		return fmt.Sprintf("unknown piece %q, did you mean %s?", e.Name, quoteList(e.Suggestions))
+	}
+	return fmt.Sprintf("unknown piece %q", e.Name)
+}
+
+// AmbiguousPieceError reports that a name is a prefix of the names of
+// several pieces.
+type AmbiguousPieceError struct {
+	Name    string
+	Matches []string
+}
+
+func (e *AmbiguousPieceError) Error() string {
+	return fmt.Sprintf("ambiguous piece %q, could be %s", e.Name, quoteList(e.Matches))
+}
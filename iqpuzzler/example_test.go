@@ -0,0 +1,69 @@
+package iqpuzzler_test
+
+import (
+	"context"
+	"fmt"
+
+	"smaart/iqpuzzler"
+)
+
+// The mini board with the red piece placed has one solution with the blue,
+// green and mint pieces.
+func ExampleSolver_Solve() {
+	set, _ := iqpuzzler.LookupPieceSet("iq-puzzler")
+	b, err := iqpuzzler.ParseBoard("xIIII,....I,...x.,.....", 4, 5, set.Pieces, false)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	ps, err := iqpuzzler.ParseAvailable("blue,green,mint", set.Pieces)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	s, err := iqpuzzler.NewSolver()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	res, err := s.Solve(context.Background(), iqpuzzler.NewGame(b), ps)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(res.Status, res.Count)
+	fmt.Println(res.Solution)
+	fmt.Println(res.Solution.Render(b, iqpuzzler.RenderStyle{Lines: true}))
+	// Output:
+	// solved 1
+	// mint  R270  at 1,0
+	// green R90   at 1,1
+	// blue  R180  at 2,2
+	// xIIII
+	// EBBBI
+	// EEBxA
+	// EEAAA
+}
+
+// Solutions can be counted without keeping them, by handing them to a hook.
+func ExampleWithOnSolution() {
+	p, _ := iqpuzzler.LookupPreset("pentomino-3x20")
+	set, _ := iqpuzzler.LookupPieceSet(p.Set)
+	var b = iqpuzzler.NewBoard(p.Rows, p.Cols)
+	var tilings int
+	s, err := iqpuzzler.NewSolver(
+		iqpuzzler.WithStrategy(iqpuzzler.FirstEmptyCell),
+		iqpuzzler.WithOnSolution(func(iqpuzzler.Solution) { tilings++ }),
+	)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	res, err := s.Solve(context.Background(), iqpuzzler.NewGame(b), set.Pieces)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(res.Count, tilings, res.Complete)
+	// Output: 8 8 true
+}
@@ -0,0 +1,401 @@
+package iqpuzzler
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Move descries the position of a piece on the board.
+type Move struct {
+	Piece     Piece
+	Translate Pos
+	// wrap holds the dimensions of the board if the move was made on a
+	// toroidal one.
+	wrap Pos
+}
+
+func (m Move) String() string {
+	var s = fmt.Sprintf("%s %s at position (%v): %v", m.Piece.name, m.Piece.orient, m.Translate, m.Image())
+	if m.wrapped() {
+		s += " (wrapped)"
+	}
+	return s
+}
+
+// Image returns the board cells covered by the move.
+func (m Move) Image() []Pos {
+	return m.appendImage(nil)
+}
+
+// appendImage appends the board cells covered by the move to dst.
+func (m Move) appendImage(dst []Pos) []Pos {
+	for _, p := range m.Piece.pos {
+		var pi = p.Add(m.Translate)
+		if m.wrap != (Pos{}) {
+			pi = pi.Mod(m.wrap)
+		}
+		dst = append(dst, pi)
+	}
+	return dst
+}
+
+// wrapped reports whether the piece crosses an edge of a toroidal board.
+func (m Move) wrapped() bool {
+	var buf [8]Pos
+	for i, p := range m.appendImage(buf[:0]) {
+		if p != m.Piece.pos[i].Add(m.Translate) {
+			return true
+		}
+	}
+	return false
+}
+
+// Game is a sequence of moves on a board.
+type Game struct {
+	// board is shared between games and never modified.
+	board *Board
+	moves []Move
+	// placed holds the moves a search made after moves, as the ids of
+	// their placements in ids, which are only made into moves when they
+	// are asked for.
+	placed []int32
+	ids    *placementIDs
+	// occ and count describe the occupancy of the board: the cells
+	// occupied initially or by a move, and how many there are.
+	occ   bitboard
+	count int
+	// masks holds the cells covered by each move, len(occ) words per
+	// move, so that Pop can clear them.
+	masks []uint64
+	// redo holds the moves taken back by Undo, the last one on top.
+	redo []Move
+}
+
+// NewGame returns a game without moves on the board.
+func NewGame(b *Board) *Game {
+	return &Game{
+		board: b,
+		occ:   newBitboard(b),
+		count: b.count,
+	}
+}
+
+// Clone returns a deep copy of the game, which can be modified without
+// affecting g. It takes time proportional to the size of the board plus the
+// number of moves. The board is shared.
+func (g *Game) Clone() *Game {
+	var c = &Game{
+		board:  g.board,
+		moves:  append([]Move(nil), g.moves...),
+		placed: append([]int32(nil), g.placed...),
+		ids:    g.ids,
+		redo:   append([]Move(nil), g.redo...),
+		occ:    append(bitboard(nil), g.occ...),
+		count:  g.count,
+		masks:  append([]uint64(nil), g.masks...),
+	}
+	return c
+}
+
+// reset makes g a copy of src like Clone, reusing the memory of g.
+func (g *Game) reset(src *Game) {
+	g.board = src.board
+	g.moves = append(g.moves[:0], src.moves...)
+	g.placed = append(g.placed[:0], src.placed...)
+	g.ids = src.ids
+	g.redo = append(g.redo[:0], src.redo...)
+	g.occ = append(g.occ[:0], src.occ...)
+	g.count = src.count
+	g.masks = append(g.masks[:0], src.masks...)
+}
+
+// Board returns the board the game is played on.
+func (g *Game) Board() *Board {
+	return g.board
+}
+
+// Free returns the number of cells which are neither occupied nor blocked.
+func (g *Game) Free() int {
+	return g.board.rows*g.board.cols - g.count
+}
+
+// Moves returns a copy of the moves made so far.
+func (g *Game) Moves() []Move {
+	var res = append([]Move(nil), g.moves...)
+	for _, id := range g.placed {
+		res = append(res, g.resolve(id))
+	}
+	return res
+}
+
+// numMoves returns the number of moves made so far.
+func (g *Game) numMoves() int {
+	return len(g.moves) + len(g.placed)
+}
+
+// moveAt returns the i-th move made.
+func (g *Game) moveAt(i int) Move {
+	if i < len(g.moves) {
+		return g.moves[i]
+	}
+	return g.resolve(g.placed[i-len(g.moves)])
+}
+
+// resolve returns the move of the placement with the id.
+func (g *Game) resolve(id int32) Move {
+	var v, pos = g.ids.placement(id)
+	return g.move(v.piece, pos)
+}
+
+func (g *Game) inBounds(p Pos) bool {
+	return g.board.inBounds(p)
+}
+
+// Occupied reports whether the cell is pre-occupied or covered by a move.
+func (g *Game) Occupied(p Pos) bool {
+	return g.inBounds(p) && g.occupied(p)
+}
+
+// Cells returns the occupancy of the board as a grid, true for occupied
+// cells.
+func (g *Game) Cells() [][]bool {
+	return g.occ.grid(g.board)
+}
+
+// occupied is like Occupied for a cell on the board.
+func (g *Game) occupied(p Pos) bool {
+	return g.occ.has(g.board.bit(p))
+}
+
+// Add places the piece at the given position and reports whether it fits,
+// which a piece with more cells than are left never does. It returns no
+// error.
+func (g *Game) Add(piece Piece, pos Pos) (bool, error) {
+	if g.count+len(piece.pos) > g.board.rows*g.board.cols {
+		return false, nil
+	}
+	var n = len(g.masks)
+	g.masks = append(g.masks, make([]uint64, len(g.occ))...)
+	var mask = bitboard(g.masks[n:])
+	for _, p := range piece.pos {
+		var pi = p.Add(pos)
+		if g.board.wrap {
+			pi = pi.Mod(Pos{g.board.rows, g.board.cols})
+		} else if !g.inBounds(pi) {
+			g.masks = g.masks[:n]
+			return false, nil
+		}
+		var i = g.board.bit(pi)
+		if g.occ.has(i) || mask.has(i) {
+			g.masks = g.masks[:n]
+			return false, nil
+		}
+		mask.set(i)
+	}
+	g.push(piece, pos, mask)
+	return true, nil
+}
+
+// addResult is the outcome of addPlacement.
+type addResult uint8
+
+const (
+	added addResult = iota
+	// blocked is a piece which does not fit, such as one with more cells
+	// than are left.
+	blocked
+)
+
+// addPlacement is like Add for the placement with the id in g.ids, of a
+// piece of size cells covering those of mask, a nil mask being a placement
+// off the board. It records the id rather than the move, and does not
+// allocate once reserve has made room for it.
+func (g *Game) addPlacement(id int32, size int, mask bitboard) addResult {
+	if g.count+size > g.board.rows*g.board.cols || mask == nil || g.occ.overlaps(mask) {
+		return blocked
+	}
+	var n = len(g.masks)
+	g.masks = append(g.masks, mask...)
+	g.placed = append(g.placed, id)
+	g.count += size
+	g.occ.or(g.masks[n:])
+	return added
+}
+
+// prepare makes room for the moves of a search with the tables, so that
+// making them does not allocate, and numbers their placements for
+// addPlacement.
+func (g *Game) prepare(ts []pieceTable) {
+	g.ids = numberPlacements(ts)
+	g.reserve(len(ts))
+}
+
+// reserve makes room for n more moves, so that adding them does not
+// allocate.
+func (g *Game) reserve(n int) {
+	g.placed = slices.Grow(g.placed, n)
+	g.masks = slices.Grow(g.masks, n*len(g.occ))
+}
+
+// push records the move of the piece covering the cells of mask, the last
+// words of g.masks.
+func (g *Game) push(piece Piece, pos Pos, mask bitboard) {
+	// Moves made on top of those of a search follow them.
+	for _, id := range g.placed {
+		g.moves = append(g.moves, g.resolve(id))
+	}
+	g.placed = g.placed[:0]
+	g.moves = append(g.moves, g.move(piece, pos))
+	g.count += len(piece.pos)
+	g.occ.or(mask)
+}
+
+// Place is like Add, but returns an *OutOfBoundsError or *OverlapError for
+// the first cell which keeps the piece from fitting.
+func (g *Game) Place(piece Piece, pos Pos) error {
+	var image []Pos
+	for _, p := range piece.pos {
+		var pi = p.Add(pos)
+		if g.board.wrap {
+			pi = pi.Mod(Pos{g.board.rows, g.board.cols})
+		} else if !g.inBounds(pi) {
+			return &OutOfBoundsError{pi}
+		}
+		if g.occupied(pi) || slices.Contains(image, pi) {
+			return &OverlapError{pi}
+		}
+		image = append(image, pi)
+	}
+	_, err := g.Add(piece, pos)
+	return err
+}
+
+// Pop takes back the last move. It returns ErrNoMoves if there is none.
+func (g *Game) Pop() error {
+	if g.numMoves() == 0 {
+		return ErrNoMoves
+	}
+	var n = len(g.masks) - len(g.occ)
+	if len(g.placed) > 0 {
+		g.count -= bitboard(g.masks[n:]).count()
+		g.placed = g.placed[:len(g.placed)-1]
+	} else {
+		g.count -= len(g.moves[len(g.moves)-1].Piece.pos)
+		g.moves = g.moves[:len(g.moves)-1]
+	}
+	g.occ.andNot(g.masks[n:])
+	g.masks = g.masks[:n]
+	return nil
+}
+
+// Play places the piece at pos like Place and clears the moves which could
+// be redone. Unlike Add and Pop, which the solver uses for backtracking,
+// Play, Undo and Redo maintain a history for interactive use.
+func (g *Game) Play(piece Piece, pos Pos) error {
+	if err := g.Place(piece, pos); err != nil {
+		return err
+	}
+	g.redo = nil
+	return nil
+}
+
+// Undo takes back the last move so that it can be redone. It returns
+// ErrNoMoves if there is none.
+func (g *Game) Undo() error {
+	if g.numMoves() == 0 {
+		return ErrNoMoves
+	}
+	var m = g.moveAt(g.numMoves() - 1)
+	if err := g.Pop(); err != nil {
+		return err
+	}
+	g.redo = append(g.redo, m)
+	return nil
+}
+
+// Redo makes the move last taken back by Undo again. It returns
+// ErrNothingToRedo if there is none.
+func (g *Game) Redo() error {
+	if len(g.redo) == 0 {
+		return ErrNothingToRedo
+	}
+	var m = g.redo[len(g.redo)-1]
+	if err := g.Place(m.Piece, m.Translate); err != nil {
+		return err
+	}
+	g.redo = g.redo[:len(g.redo)-1]
+	return nil
+}
+
+// History returns a copy of the moves made so far, the first one at index
+// 0. It is the same as Moves.
+func (g *Game) History() []Move {
+	return g.Moves()
+}
+
+// fits reports whether the piece can be placed at pos.
+func (g *Game) fits(piece Piece, pos Pos) bool {
+	for _, p := range piece.pos {
+		var pi = p.Add(pos)
+		if g.board.wrap {
+			pi = pi.Mod(Pos{g.board.rows, g.board.cols})
+		} else if !g.inBounds(pi) {
+			return false
+		}
+		if g.occupied(pi) {
+			return false
+		}
+	}
+	return true
+}
+
+// LegalMoves returns the placements of the piece in each of its distinct
+// orientations which fit on the board in its current state.
+func (g *Game) LegalMoves(p Piece) []Move {
+	var res []Move
+	for _, o := range p.Orientations() {
+		var (
+			_, max = BoundingBox(o.Piece.pos)
+			rows   = g.board.rows - max[0]
+			cols   = g.board.cols - max[1]
+		)
+		if g.board.wrap {
+			rows, cols = g.board.rows, g.board.cols
+		}
+		for x := 0; x < rows; x++ {
+			for y := 0; y < cols; y++ {
+				if g.fits(o.Piece, Pos{x, y}) {
+					res = append(res, g.move(o.Piece, Pos{x, y}))
+				}
+			}
+		}
+	}
+	return res
+}
+
+// LegalMovesCovering returns the legal moves of the piece which cover the
+// cell.
+func (g *Game) LegalMovesCovering(p Piece, cell Pos) []Move {
+	var res []Move
+	if !g.inBounds(cell) {
+		return nil
+	}
+	for _, o := range p.Orientations() {
+		for _, c := range o.Piece.pos {
+			var pos = cell.Sub(c)
+			if g.fits(o.Piece, pos) {
+				res = append(res, g.move(o.Piece, pos))
+			}
+		}
+	}
+	return res
+}
+
+// move returns the move placing the piece at pos on the game's board.
+func (g *Game) move(piece Piece, pos Pos) Move {
+	var m = Move{Piece: piece, Translate: pos}
+	if g.board.wrap {
+		m.wrap = Pos{g.board.rows, g.board.cols}
+	}
+	return m
+}
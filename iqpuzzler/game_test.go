@@ -0,0 +1,302 @@
+package iqpuzzler
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+)
+
+// TestConcurrentGames solves two puzzles at once on separate games, which
+// share no state, so that the race detector catches any they do. Each must
+// find the solutions it finds alone.
+func TestConcurrentGames(t *testing.T) {
+	var pent, _ = LookupPieceSet("pentomino")
+	b1, ps1 := miniPuzzle(t)
+	b2, err := ParseBoard("00000,00000,00000", 3, 5, pent.Pieces, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ps2, err := ParseAvailable("l,p,v", pent.Pieces)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var count = func(b *Board, ps []Piece) (int, error) {
+		var n int
+		_, err := NewGame(b).Search(ps, func([]Move) bool {
+			n++
+			return true
+		})
+		return n, err
+	}
+	want1, err := count(b1, ps1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want2, err := count(b2, ps2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want1 == 0 || want2 == 0 {
+		t.Fatalf("the puzzles have %d and %d solutions", want1, want2)
+	}
+	var (
+		wg         sync.WaitGroup
+		got1, got2 [8]int
+		errs       [16]error
+	)
+	for i := range got1 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			got1[i], errs[2*i] = count(b1, ps1)
+		}()
+		go func() {
+			defer wg.Done()
+			got2[i], errs[2*i+1] = count(b2, ps2)
+		}()
+	}
+	wg.Wait()
+	for i := range got1 {
+		if errs[2*i] != nil || errs[2*i+1] != nil {
+			t.Fatal(errs[2*i], errs[2*i+1])
+		}
+		if got1[i] != want1 || got2[i] != want2 {
+			t.Errorf("run %d found %d and %d solutions, alone %d and %d", i+1, got1[i], got2[i], want1, want2)
+		}
+	}
+}
+
+func TestUndoRedo(t *testing.T) {
+	var (
+		b, ps = miniPuzzle(t)
+		g     = NewGame(b)
+		free  = g.Free()
+	)
+	// check checks the invariants of the game and the number of moves.
+	var check = func(step string, moves int) {
+		t.Helper()
+		if err := g.Validate(); err != nil {
+			t.Fatalf("%s: %v", step, err)
+		}
+		if n := len(g.History()); n != moves {
+			t.Fatalf("%s: %d moves in the history, want %d", step, n, moves)
+		}
+		var covered int
+		for _, m := range g.History() {
+			covered += len(m.Piece.pos)
+		}
+		if g.Free() != free-covered {
+			t.Fatalf("%s: %d free cells, want %d", step, g.Free(), free-covered)
+		}
+	}
+	if err := g.Undo(); err != ErrNoMoves {
+		t.Errorf("Undo of a new game = %v, want %v", err, ErrNoMoves)
+	}
+	if err := g.Redo(); err != ErrNothingToRedo {
+		t.Errorf("Redo of a new game = %v, want %v", err, ErrNothingToRedo)
+	}
+	var sol = testSolutions(t, b, ps, 1)[0]
+	for i, m := range sol[:2] {
+		if err := g.Play(m.Piece, m.Translate); err != nil {
+			t.Fatal(err)
+		}
+		check("play", i+1)
+	}
+	if err := g.Undo(); err != nil {
+		t.Fatal(err)
+	}
+	check("undo", 1)
+	if err := g.Redo(); err != nil {
+		t.Fatal(err)
+	}
+	check("redo", 2)
+	if m := g.History()[1]; m.Piece.name != sol[1].Piece.name || m.Translate != sol[1].Translate {
+		t.Errorf("redo made %v, want %v", m, sol[1])
+	}
+	if err := g.Undo(); err != nil {
+		t.Fatal(err)
+	}
+	// A hint from the solver is a new move, which clears the moves to redo.
+	s, err := NewSolver(WithMaxSolutions(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := s.Solve(context.Background(), g, Unplaced(g.position(), ps))
+	if err != nil || res.Solution == nil {
+		t.Fatalf("no hint: %v", err)
+	}
+	check("solve", 1)
+	var hint = res.Solution[0]
+	if err := g.Play(hint.Piece, hint.Translate); err != nil {
+		t.Fatal(err)
+	}
+	check("hint", 2)
+	if err := g.Redo(); err != ErrNothingToRedo {
+		t.Errorf("Redo after a hint = %v, want %v", err, ErrNothingToRedo)
+	}
+	for i := 2; i > 0; i-- {
+		if err := g.Undo(); err != nil {
+			t.Fatal(err)
+		}
+		check("undo", i-1)
+	}
+	if err := g.Redo(); err != nil {
+		t.Fatal(err)
+	}
+	check("redo", 1)
+}
+
+// TestClone places pieces on a clone of a game and checks that the occupied
+// cells and the moves of the original stay as they were, and that the board
+// is shared.
+func TestClone(t *testing.T) {
+	var (
+		b, ps = miniPuzzle(t)
+		g     = NewGame(b)
+		sol   []Move
+	)
+	if _, err := g.Search(ps, func(ms []Move) bool {
+		sol = ms
+		return false
+	}); err != nil || sol == nil {
+		t.Fatalf("no solution to clone from: %v", err)
+	}
+	if ok, err := g.Add(sol[0].Piece, sol[0].Translate); !ok || err != nil {
+		t.Fatalf("Add(%v) = %t, %v", sol[0], ok, err)
+	}
+	var (
+		cells, moves = g.Cells(), g.Moves()
+		c            = g.Clone()
+	)
+	for _, m := range sol[1:] {
+		if ok, err := c.Add(m.Piece, m.Translate); !ok || err != nil {
+			t.Fatalf("Add(%v) on the clone = %t, %v", m, ok, err)
+		}
+	}
+	if c.Free() != 0 || len(c.Moves()) != len(sol) {
+		t.Errorf("the clone has %d free cells and %d moves, want 0 and %d", c.Free(), len(c.Moves()), len(sol))
+	}
+	if got := g.Cells(); !reflect.DeepEqual(got, cells) {
+		t.Errorf("the occupied cells changed from %v to %v", cells, got)
+	}
+	if c.Board() != g.Board() {
+		t.Error("the clone has a board of its own")
+	}
+	if got := g.Moves(); !reflect.DeepEqual(got, moves) {
+		t.Errorf("the moves changed from %v to %v", moves, got)
+	}
+	// Taking back the move of the original leaves the clone complete.
+	if err := g.Pop(); err != nil {
+		t.Fatal(err)
+	}
+	if c.Free() != 0 || len(c.Moves()) != len(sol) {
+		t.Errorf("after Pop on the original, the clone has %d free cells and %d moves", c.Free(), len(c.Moves()))
+	}
+}
+
+// TestLegalMoves compares the legal moves of a piece, and those covering
+// each cell, with the placements found by trying every orientation at every
+// translation near the board.
+func TestLegalMoves(t *testing.T) {
+	var tests = []struct {
+		name, board, piece string
+		rows, cols         int
+		wrap               bool
+		// want is the number of legal moves.
+		want int
+	}{
+		{"empty", ".....,.....,.....,.....", "blue", 4, 5, false, 68},
+		{"edge", "xxxx.,xxxx.,xxxx.,.....", "blue", 4, 5, false, 2},
+		{"nearly full", "xxxxx,xxx..,xxxx.,xxxxx", "turquoise", 4, 5, false, 1},
+		{"no legal move", "xxxxx,x.x.x,xxxxx,x..xx", "turquoise", 4, 5, false, 0},
+		{"toroidal", "..xxx,xxxxx,xxxxx,.xxxx", "turquoise", 4, 5, true, 1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b, err := ParseBoard(test.board, test.rows, test.cols, standardPieces, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			p, err := LookupPiece(standardPieces, test.piece)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var (
+				g     = NewGame(b.WithWrap(test.wrap))
+				brute = bruteForceMoves(g, p)
+				got   = moveKeys(t, g.LegalMoves(p))
+			)
+			if len(got) != test.want {
+				t.Errorf("%d legal moves, want %d", len(got), test.want)
+			}
+			if !reflect.DeepEqual(got, brute) {
+				t.Errorf("LegalMoves = %v, want %v", got, brute)
+			}
+			for x := range test.rows {
+				for y := range test.cols {
+					var want = make(map[string][]Pos)
+					for k, cells := range brute {
+						for _, c := range cells {
+							if c == (Pos{x, y}) {
+								want[k] = cells
+							}
+						}
+					}
+					if got := moveKeys(t, g.LegalMovesCovering(p, Pos{x, y})); !reflect.DeepEqual(got, want) {
+						t.Errorf("LegalMovesCovering(%v) = %v, want %v", Pos{x, y}, got, want)
+					}
+				}
+			}
+			if got := g.LegalMovesCovering(p, Pos{-1, 0}); got != nil {
+				t.Errorf("LegalMovesCovering off the board = %v", got)
+			}
+		})
+	}
+}
+
+// bruteForceMoves returns the moves of the piece which Add accepts, by
+// orientation and covered cells.
+func bruteForceMoves(g *Game, p Piece) map[string][]Pos {
+	var res = make(map[string][]Pos)
+	for _, o := range p.Orientations() {
+		for x := -5; x < g.board.rows+5; x++ {
+			for y := -5; y < g.board.cols+5; y++ {
+				if ok, err := g.Add(o.Piece, Pos{x, y}); !ok || err != nil {
+					continue
+				}
+				var m = g.moves[len(g.moves)-1]
+				if err := g.Pop(); err != nil {
+					panic(err)
+				}
+				res[moveKey(m)] = m.Image()
+			}
+		}
+	}
+	return res
+}
+
+// moveKeys returns the cells covered by the moves by orientation and
+// covered cells, failing if two moves have the same.
+func moveKeys(t *testing.T, ms []Move) map[string][]Pos {
+	t.Helper()
+	var res = make(map[string][]Pos)
+	for _, m := range ms {
+		var k = moveKey(m)
+		if _, ok := res[k]; ok {
+			t.Fatalf("the move %v is listed twice", m)
+		}
+		res[k] = m.Image()
+	}
+	return res
+}
+
+func moveKey(m Move) string {
+	var cells = m.Image()
+	sort.Slice(cells, func(i, j int) bool {
+		return cells[i][0] < cells[j][0] || cells[i][0] == cells[j][0] && cells[i][1] < cells[j][1]
+	})
+	return fmt.Sprint(m.Piece.orient, cells)
+}
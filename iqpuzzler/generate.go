@@ -0,0 +1,132 @@
+package iqpuzzler
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"hash/fnv"
+	"log/slog"
+	"math/rand/v2"
+	"slices"
+	"time"
+)
+
+// GenerateOptions are the settings of Generate.
+type GenerateOptions struct {
+	// Remove is the number of pieces left to place.
+	Remove int
+	// Unique only accepts puzzles with a single solution.
+	Unique bool
+	// Attempts limits the number of puzzles tried for Unique. Zero means
+	// 100.
+	Attempts int
+	// Rand chooses the solution and the pieces to remove. If nil, a source
+	// seeded from the time is used.
+	Rand   *rand.Rand
+	Logger *slog.Logger
+}
+
+// Generated is a puzzle made by Generate.
+type Generated struct {
+	// Board has the cells of the pieces left on it marked with their
+	// letters.
+	Board *Board
+	// Pieces are the pieces to place, sorted by name.
+	Pieces []Piece
+	// Solution is the solution the puzzle was made from.
+	Solution Solution
+}
+
+// Generate makes a puzzle by solving the board with the pieces in a random
+// order and taking opts.Remove of them off again. The search is single
+// threaded, so the same random source gives the same puzzle.
+func Generate(ctx context.Context, b *Board, ps []Piece, opts GenerateOptions) (Generated, error) {
+	if opts.Remove <= 0 || opts.Remove > len(ps) {
+		return Generated{}, fmt.Errorf("invalid number of pieces to remove %d, want 1 to %d", opts.Remove, len(ps))
+	}
+	if opts.Attempts == 0 {
+		opts.Attempts = 100
+	}
+	if opts.Rand == nil {
+		opts.Rand = NewRand(uint64(time.Now().UnixNano()))
+	}
+	s, err := NewSolver(WithStrategy(FirstEmptyCell), WithRand(opts.Rand), WithMaxSolutions(1), WithParallelism(1), WithLogger(opts.Logger))
+	if err != nil {
+		return Generated{}, err
+	}
+	res, err := s.Solve(ctx, NewGame(b), ps)
+	if err != nil {
+		return Generated{}, err
+	}
+	if res.Count == 0 {
+		return Generated{}, fmt.Errorf("the board has no solution")
+	}
+	for i := 0; i < opts.Attempts; i++ {
+		var (
+			g    = NewGame(b)
+			perm = opts.Rand.Perm(len(res.Solution))
+			left []Piece
+		)
+		for k, j := range perm {
+			var m = res.Solution[j]
+			if k < opts.Remove {
+				var p, _ = pieceByName(ps, m.Piece.name)
+				left = append(left, p)
+				continue
+			}
+			if err := g.Place(m.Piece, m.Translate); err != nil {
+				return Generated{}, err
+			}
+		}
+		slices.SortFunc(left, func(a, b Piece) int { return cmp.Compare(a.name, b.name) })
+		var gen = Generated{Board: g.position(), Pieces: left, Solution: res.Solution}
+		if !opts.Unique {
+			return gen, nil
+		}
+		unique, err := uniquelySolvable(ctx, gen.Board, left)
+		if err != nil {
+			return Generated{}, err
+		}
+		if unique {
+			return gen, nil
+		}
+		if opts.Logger != nil {
+			opts.Logger.Info("puzzle has several solutions", slog.Int("attempt", i+1))
+		}
+	}
+	return Generated{}, fmt.Errorf("no puzzle with a single solution found in %d attempts", opts.Attempts)
+}
+
+// DailySeed returns the seed of the random source for the puzzle of the
+// day, the same on every machine.
+func DailySeed(year int, month time.Month, day int) uint64 {
+	var h = fnv.New64a()
+	fmt.Fprintf(h, "iq-puzzler daily %04d-%02d-%02d", year, month, day)
+	return h.Sum64()
+}
+
+// uniquelySolvable reports whether the pieces complete the board in
+// exactly one way.
+func uniquelySolvable(ctx context.Context, b *Board, ps []Piece) (bool, error) {
+	var n int
+	s, err := NewSolver(WithMaxSolutions(2), WithOnSolution(func(Solution) { n++ }))
+	if err != nil {
+		return false, err
+	}
+	if _, err := s.Solve(ctx, NewGame(b), ps); err != nil {
+		return false, err
+	}
+	return n == 1, nil
+}
+
+// Request returns the request solving the puzzle, with the settings of r
+// other than the board, the region and the pieces.
+func (g Generated) Request(r SolveRequest) SolveRequest {
+	r.Board = CompactBoard(g.Board)
+	r.Region = ""
+	r.Pieces = make([]string, 0, len(g.Pieces))
+	for _, p := range g.Pieces {
+		r.Pieces = append(r.Pieces, p.name)
+	}
+	return r
+}
@@ -0,0 +1,30 @@
+package iqpuzzler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+// TestGenerateSeed checks that the same seed makes the same puzzle.
+func TestGenerateSeed(t *testing.T) {
+	var b = NewBoard(5, 11)
+	var generate = func(seed uint64) Generated {
+		t.Helper()
+		gen, err := Generate(context.Background(), b, standardPieces, GenerateOptions{Remove: 3, Rand: NewRand(seed)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(gen.Pieces) != 3 {
+			t.Fatalf("seed %d: %d pieces to place, want 3", seed, len(gen.Pieces))
+		}
+		return gen
+	}
+	var a = generate(7)
+	if b := generate(7); b.Board.String() != a.Board.String() || !reflect.DeepEqual(b.Pieces, a.Pieces) || !reflect.DeepEqual(b.Solution, a.Solution) {
+		t.Errorf("the seed 7 made the puzzle\n%s\nand then\n%s", a.Board, b.Board)
+	}
+	if c := generate(8); c.Board.String() == a.Board.String() {
+		t.Errorf("the seeds 7 and 8 made the same puzzle\n%s", a.Board)
+	}
+}
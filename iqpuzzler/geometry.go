@@ -0,0 +1,341 @@
+package iqpuzzler
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Pos describes a position. We use coordinates starting at the top-left origin, with
+// x going down and y going right (like mathematical matrix index notation).
+type Pos [2]int
+
+// Add returns p translated by q.
+func (p Pos) Add(q Pos) Pos {
+	return Pos{p[0] + q[0], p[1] + q[1]}
+}
+
+// Sub returns the offset from q to p.
+func (p Pos) Sub(q Pos) Pos {
+	return Pos{p[0] - q[0], p[1] - q[1]}
+}
+
+// Mod maps p onto a board with the given dimensions, wrapping around its
+// edges.
+func (p Pos) Mod(dims Pos) Pos {
+	return Pos{(p[0]%dims[0] + dims[0]) % dims[0], (p[1]%dims[1] + dims[1]) % dims[1]}
+}
+
+// Less orders positions by row and then column.
+func (p Pos) Less(q Pos) bool {
+	return p[0] < q[0] || p[0] == q[0] && p[1] < q[1]
+}
+
+// BoundingBox returns the smallest and largest coordinates of the
+// positions, which must not be empty.
+func BoundingBox(ps []Pos) (min, max Pos) {
+	min, max = ps[0], ps[0]
+	for _, p := range ps[1:] {
+		for i := range p {
+			if p[i] < min[i] {
+				min[i] = p[i]
+			}
+			if p[i] > max[i] {
+				max[i] = p[i]
+			}
+		}
+	}
+	return min, max
+}
+
+// NormalizeToOrigin returns a copy of the positions translated such that
+// their bounding box starts at the origin. The order is kept.
+func NormalizeToOrigin(ps []Pos) []Pos {
+	if len(ps) == 0 {
+		return nil
+	}
+	var (
+		min, _ = BoundingBox(ps)
+		res    = make([]Pos, 0, len(ps))
+	)
+	for _, p := range ps {
+		res = append(res, p.Sub(min))
+	}
+	return res
+}
+
+// normalize translates the cells so that the bounding box starts at the
+// origin, and sorts them. The result does not depend on the order of the
+// cells.
+func normalize(ps []Pos) []Pos {
+	var res = NormalizeToOrigin(ps)
+	sortCells(res)
+	return res
+}
+
+// sortedCells returns a sorted copy of the cells.
+func sortedCells(ps []Pos) []Pos {
+	var res = append([]Pos(nil), ps...)
+	sortCells(res)
+	return res
+}
+
+func sortCells(ps []Pos) {
+	slices.SortFunc(ps, func(a, b Pos) int {
+		switch {
+		case a.Less(b):
+			return -1
+		case b.Less(a):
+			return 1
+		}
+		return 0
+	})
+}
+
+// Matrix represents a 2D transformation.
+type Matrix [2][2]int
+
+// Transform transforms the position given the matrix.
+func (m Matrix) Transform(p Pos) Pos {
+	return Pos{
+		m[0][0]*p[0] + m[0][1]*p[1],
+		m[1][0]*p[0] + m[1][1]*p[1],
+	}
+}
+
+// Mult multiplies the given matrices. The product transforms a position by
+// m2 first and then by m.
+func (m Matrix) Mult(m2 Matrix) Matrix {
+	return Matrix{
+		{m[0][0]*m2[0][0] + m[0][1]*m2[1][0], m[0][0]*m2[0][1] + m[0][1]*m2[1][1]},
+		{m[1][0]*m2[0][0] + m[1][1]*m2[1][0], m[1][0]*m2[0][1] + m[1][1]*m2[1][1]},
+	}
+}
+
+// Inverse returns the transformation undoing m. It is only defined for the
+// standard transformations, whose inverse is their transpose.
+func (m Matrix) Inverse() Matrix {
+	return Matrix{
+		{m[0][0], m[1][0]},
+		{m[0][1], m[1][1]},
+	}
+}
+
+// Apply transforms the positions and translates the result to the origin.
+// The order is kept.
+func (m Matrix) Apply(ps []Pos) []Pos {
+	var res = make([]Pos, 0, len(ps))
+	for _, p := range ps {
+		res = append(res, m.Transform(p))
+	}
+	return NormalizeToOrigin(res)
+}
+
+// String returns the name of a standard transformation's Orientation, and
+// the matrix elements otherwise.
+func (m Matrix) String() string {
+	if o, ok := OrientationOf(m); ok {
+		return o.String()
+	}
+	return fmt.Sprintf("Matrix%v", [2][2]int(m))
+}
+
+// The standard transformations of a piece: the rotations, each with and
+// without mirroring first.
+var (
+	// Identity is the identity matrix.
+	Identity = Matrix{
+		{1, 0},
+		{0, 1},
+	}
+	// Rot90 is a Rotation by 90 degrees.
+	Rot90 = Matrix{
+		{0, 1},
+		{-1, 0},
+	}
+	// Mirror mirrors a piece on its x axis
+	Mirror = Matrix{
+		{1, 0},
+		{0, -1},
+	}
+	Rot180       = Rot90.Mult(Rot90)
+	Rot270       = Rot180.Mult(Rot90)
+	Rot90Mirror  = Rot90.Mult(Mirror)
+	Rot180Mirror = Rot180.Mult(Mirror)
+	Rot270Mirror = Rot270.Mult(Mirror)
+)
+
+// tx contains all possible transformations, indexed by Orientation.
+var tx = []Matrix{
+	Identity,
+	Mirror,
+	Rot90,
+	Rot90Mirror,
+	Rot180,
+	Rot270,
+	Rot180Mirror,
+	Rot270Mirror,
+}
+
+// txNames names the transformations in tx.
+var txNames = []string{"I", "M", "R90", "R90M", "R180", "R270", "R180M", "R270M"}
+
+// Orientation names one of the standard transformations of a piece. With
+// rows going down and columns going right, R90 turns a piece clockwise by 90
+// degrees, so that a cell to the right of the origin ends up below it, and M
+// mirrors it left to right. The combined names mirror first: R90M is M, then
+// R90.
+type Orientation int
+
+// The orientations, in the order of tx.
+const (
+	OrientI Orientation = iota
+	OrientM
+	OrientR90
+	OrientR90M
+	OrientR180
+	OrientR270
+	OrientR180M
+	OrientR270M
+)
+
+// Orientations returns all orientations, in the order in which the solver
+// tries them.
+func Orientations() []Orientation {
+	var res = make([]Orientation, len(tx))
+	for i := range res {
+		res[i] = Orientation(i)
+	}
+	return res
+}
+
+func (o Orientation) valid() bool {
+	return o >= 0 && int(o) < len(tx)
+}
+
+func (o Orientation) String() string {
+	if !o.valid() {
+		return fmt.Sprintf("Orientation(%d)", int(o))
+	}
+	return txNames[o]
+}
+
+// ParseOrientation returns the orientation with the given name, ignoring
+// case.
+func ParseOrientation(name string) (Orientation, error) {
+	for i, n := range txNames {
+		if strings.EqualFold(n, name) {
+			return Orientation(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown orientation %q, want one of %s", name, quoteList(txNames))
+}
+
+// MarshalText encodes the orientation as its name.
+func (o Orientation) MarshalText() ([]byte, error) {
+	if !o.valid() {
+		return nil, fmt.Errorf("invalid orientation %d", int(o))
+	}
+	return []byte(txNames[o]), nil
+}
+
+// UnmarshalText decodes an orientation name.
+func (o *Orientation) UnmarshalText(b []byte) error {
+	v, err := ParseOrientation(string(b))
+	if err != nil {
+		return err
+	}
+	*o = v
+	return nil
+}
+
+// Matrix returns the transformation matrix of the orientation.
+func (o Orientation) Matrix() Matrix {
+	return tx[o]
+}
+
+// OrientationOf returns the orientation with the given matrix, if it is one
+// of the standard transformations.
+func OrientationOf(m Matrix) (Orientation, bool) {
+	if i := slices.Index(tx, m); i >= 0 {
+		return Orientation(i), true
+	}
+	return 0, false
+}
+
+// Then returns the orientation transforming a piece by o first and then by
+// p.
+func (o Orientation) Then(p Orientation) Orientation {
+	r, _ := OrientationOf(p.Matrix().Mult(o.Matrix()))
+	return r
+}
+
+// Inverse returns the orientation undoing o.
+func (o Orientation) Inverse() Orientation {
+	r, _ := OrientationOf(o.Matrix().Inverse())
+	return r
+}
+
+// CheckOrientations checks the standard transformations against their
+// documentation: that there are eight distinct ones forming a group, that
+// R90 turns a piece clockwise and M mirrors it left to right, that the other
+// names are the compositions they say, and that names and inverses agree
+// with the matrices.
+func CheckOrientations() error {
+	if len(tx) != 8 || len(txNames) != len(tx) {
+		return fmt.Errorf("%d transformations with %d names, want 8", len(tx), len(txNames))
+	}
+	for i, m := range tx {
+		if j := slices.Index(tx, m); j != i {
+			return fmt.Errorf("%s and %s are the same transformation %v", txNames[j], txNames[i], [2][2]int(m))
+		}
+		for j, m2 := range tx {
+			if _, ok := OrientationOf(m.Mult(m2)); !ok {
+				return fmt.Errorf("%s then %s is not a standard transformation", txNames[j], txNames[i])
+			}
+		}
+		if m.Mult(m.Inverse()) != Identity {
+			return fmt.Errorf("the inverse of %s does not undo it", txNames[i])
+		}
+	}
+	var checks = []struct {
+		o       Orientation
+		p, want Pos
+	}{
+		{OrientI, Pos{0, 1}, Pos{0, 1}},
+		{OrientI, Pos{1, 0}, Pos{1, 0}},
+		{OrientR90, Pos{0, 1}, Pos{1, 0}},
+		{OrientR90, Pos{1, 0}, Pos{0, -1}},
+		{OrientM, Pos{0, 1}, Pos{0, -1}},
+		{OrientM, Pos{1, 0}, Pos{1, 0}},
+	}
+	for _, c := range checks {
+		if got := c.o.Matrix().Transform(c.p); got != c.want {
+			return fmt.Errorf("%s maps %v to %v, want %v", c.o, c.p, got, c.want)
+		}
+	}
+	var compositions = []struct {
+		o    Orientation
+		want Matrix
+	}{
+		{OrientR180, Rot90.Mult(Rot90)},
+		{OrientR270, Rot90.Mult(Rot90).Mult(Rot90)},
+		{OrientR90M, Rot90.Mult(Mirror)},
+		{OrientR180M, Rot90.Mult(Rot90).Mult(Mirror)},
+		{OrientR270M, Rot90.Mult(Rot90).Mult(Rot90).Mult(Mirror)},
+	}
+	for _, c := range compositions {
+		if c.o.Matrix() != c.want {
+			return fmt.Errorf("%s is %v, want %v", c.o, [2][2]int(c.o.Matrix()), [2][2]int(c.want))
+		}
+	}
+	for _, o := range Orientations() {
+		if p, err := ParseOrientation(o.String()); err != nil || p != o {
+			return fmt.Errorf("the name %s does not parse back to it", o)
+		}
+		if r := o.Then(o.Inverse()); r != OrientI {
+			return fmt.Errorf("%s then its inverse is %s, not I", o, r)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package iqpuzzler
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPosArithmetic(t *testing.T) {
+	var tests = []struct {
+		p, q     Pos
+		add, sub Pos
+		less     bool
+	}{
+		{Pos{0, 0}, Pos{0, 0}, Pos{0, 0}, Pos{0, 0}, false},
+		{Pos{1, 2}, Pos{3, 4}, Pos{4, 6}, Pos{-2, -2}, true},
+		{Pos{3, 0}, Pos{2, 9}, Pos{5, 9}, Pos{1, -9}, false},
+		{Pos{2, 1}, Pos{2, 5}, Pos{4, 6}, Pos{0, -4}, true},
+		{Pos{-1, -1}, Pos{1, -1}, Pos{0, -2}, Pos{-2, 0}, true},
+	}
+	for _, test := range tests {
+		if got := test.p.Add(test.q); got != test.add {
+			t.Errorf("%v.Add(%v) = %v, want %v", test.p, test.q, got, test.add)
+		}
+		if got := test.p.Sub(test.q); got != test.sub {
+			t.Errorf("%v.Sub(%v) = %v, want %v", test.p, test.q, got, test.sub)
+		}
+		if got := test.p.Sub(test.q).Add(test.q); got != test.p {
+			t.Errorf("%v.Sub(%v).Add(%v) = %v, want %v", test.p, test.q, test.q, got, test.p)
+		}
+		if got := test.p.Less(test.q); got != test.less {
+			t.Errorf("%v.Less(%v) = %t, want %t", test.p, test.q, got, test.less)
+		}
+	}
+}
+
+func TestPosMod(t *testing.T) {
+	var tests = []struct {
+		p, dims, want Pos
+	}{
+		{Pos{2, 3}, Pos{5, 11}, Pos{2, 3}},
+		{Pos{5, 11}, Pos{5, 11}, Pos{0, 0}},
+		{Pos{-1, -1}, Pos{5, 11}, Pos{4, 10}},
+		{Pos{-6, 23}, Pos{5, 11}, Pos{4, 1}},
+		{Pos{7, -3}, Pos{1, 1}, Pos{0, 0}},
+	}
+	for _, test := range tests {
+		if got := test.p.Mod(test.dims); got != test.want {
+			t.Errorf("%v.Mod(%v) = %v, want %v", test.p, test.dims, got, test.want)
+		}
+	}
+}
+
+func TestBoundingBox(t *testing.T) {
+	var tests = []struct {
+		name     string
+		ps       []Pos
+		min, max Pos
+	}{
+		{"single", []Pos{{3, -2}}, Pos{3, -2}, Pos{3, -2}},
+		{"corner", []Pos{{0, 0}, {0, 1}, {1, 0}}, Pos{0, 0}, Pos{1, 1}},
+		{"negative", []Pos{{-1, 2}, {0, -3}, {2, 0}}, Pos{-1, -3}, Pos{2, 2}},
+		{"line", []Pos{{4, 0}, {4, 1}, {4, 2}, {4, 3}}, Pos{4, 0}, Pos{4, 3}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if min, max := BoundingBox(test.ps); min != test.min || max != test.max {
+				t.Errorf("BoundingBox(%v) = %v, %v, want %v, %v", test.ps, min, max, test.min, test.max)
+			}
+		})
+	}
+}
+
+func TestNormalizeToOrigin(t *testing.T) {
+	var tests = []struct {
+		name     string
+		ps, want []Pos
+	}{
+		{"empty", nil, nil},
+		{"at the origin", []Pos{{0, 1}, {0, 0}, {1, 0}}, []Pos{{0, 1}, {0, 0}, {1, 0}}},
+		{"negative", []Pos{{-1, -2}, {0, -2}, {-1, -1}}, []Pos{{0, 0}, {1, 0}, {0, 1}}},
+		{"offset", []Pos{{4, 7}, {3, 5}, {4, 6}}, []Pos{{1, 2}, {0, 0}, {1, 1}}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var in = append([]Pos(nil), test.ps...)
+			if got := NormalizeToOrigin(test.ps); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("NormalizeToOrigin(%v) = %v, want %v in the same order", test.ps, got, test.want)
+			}
+			if !reflect.DeepEqual(test.ps, in) {
+				t.Errorf("NormalizeToOrigin modified its argument to %v", test.ps)
+			}
+		})
+	}
+}
+
+func TestMatrix(t *testing.T) {
+	for i, m := range tx {
+		if got := m.String(); got != txNames[i] {
+			t.Errorf("tx[%d].String() = %q, want %q", i, got, txNames[i])
+		}
+		if got := m.Mult(m.Inverse()); got != Identity {
+			t.Errorf("%s times its inverse = %v", m, got)
+		}
+		if got := m.Inverse().Mult(m); got != Identity {
+			t.Errorf("the inverse of %s times it = %v", m, got)
+		}
+	}
+	if got, want := (Matrix{{2, 0}, {0, 2}}).String(), "Matrix[[2 0] [0 2]]"; got != want {
+		t.Errorf("String of a scaling = %q, want %q", got, want)
+	}
+	var tests = []struct {
+		m        Matrix
+		ps, want []Pos
+	}{
+		{Identity, []Pos{{1, 1}, {1, 2}}, []Pos{{0, 0}, {0, 1}}},
+		{Rot90, []Pos{{0, 0}, {0, 1}, {0, 2}, {1, 0}}, []Pos{{0, 1}, {1, 1}, {2, 1}, {0, 0}}},
+		{Mirror, []Pos{{0, 0}, {0, 1}, {1, 0}}, []Pos{{0, 1}, {0, 0}, {1, 1}}},
+		{Rot180, []Pos{{0, 0}, {0, 1}, {1, 0}}, []Pos{{1, 1}, {1, 0}, {0, 1}}},
+	}
+	for _, test := range tests {
+		if got := test.m.Apply(test.ps); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s.Apply(%v) = %v, want %v", test.m, test.ps, got, test.want)
+		}
+	}
+}
+
+// TestOrientations runs CheckOrientations.
+func TestOrientations(t *testing.T) {
+	if err := CheckOrientations(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,63 @@
+package iqpuzzler
+
+import "fmt"
+
+// CheckHints verifies that the pre-occupied cells of the board can be tiled
+// exactly by the pieces of all which are not available, with cells carrying
+// a piece letter covered by that piece. Blocked cells are not part of the region.
+func CheckHints(b *Board, all, available []Piece) error {
+	var used = make(map[string]bool)
+	for _, p := range available {
+		used[p.name] = true
+	}
+	var (
+		missing []Piece
+		area    int
+	)
+	for _, p := range all {
+		if !used[p.name] {
+			missing = append(missing, p)
+			area += len(p.pos)
+		}
+	}
+	var (
+		inv    = NewBoard(b.rows, b.cols)
+		region int
+	)
+	inv.wrap = b.wrap
+	for x := 0; x < b.rows; x++ {
+		for y := 0; y < b.cols; y++ {
+			if b.marks[x][y] == 0 || b.marks[x][y] == '#' {
+				inv.block(x, y)
+			} else {
+				region++
+			}
+		}
+	}
+	if area != region {
+		return fmt.Errorf("the occupied cells cover %d cells, but the unavailable pieces cover %d", region, area)
+	}
+	found, err := NewGame(inv).search(precompute(inv, missing, PieceOrder), func(ms []Move) bool {
+		return !matchesLetters(b, ms)
+	})
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("the occupied cells cannot be formed by the unavailable pieces")
+	}
+	return nil
+}
+
+// matchesLetters reports whether every lettered cell of b is covered by the
+// piece with that letter.
+func matchesLetters(b *Board, ms []Move) bool {
+	for _, m := range ms {
+		for _, p := range m.Image() {
+			if l := b.marks[p[0]][p[1]]; l != 'x' && l != m.Piece.letter {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,112 @@
+package iqpuzzler
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"strings"
+)
+
+// ReadBoardImage infers a board string of the given dimensions from a PNG
+// photo of the board, telling the pieces of ps apart by their colors in the
+// palette. The photo should be roughly cropped to the board; a margin in a uniform color
+// is trimmed. Each cell is classified by the average color around the center
+// of its grid position.
+func ReadBoardImage(path string, pal Palette, ps []Piece, dimX, dimY int) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	img, err := png.Decode(f)
+	if err != nil {
+		return "", fmt.Errorf("%s: %v", path, err)
+	}
+	var r = trimMargin(img)
+	if r.Dx() < dimY || r.Dy() < dimX {
+		return "", fmt.Errorf("%s: image is too small to contain a board", path)
+	}
+	var rows []string
+	for x := 0; x < dimX; x++ {
+		var row strings.Builder
+		for y := 0; y < dimY; y++ {
+			var (
+				cx = r.Min.X + (2*y+1)*r.Dx()/(2*dimY)
+				cy = r.Min.Y + (2*x+1)*r.Dy()/(2*dimX)
+				d  = r.Dx() / dimY / 6
+			)
+			row.WriteByte(classify(pal, ps, average(img, image.Rect(cx-d, cy-d, cx+d+1, cy+d+1))))
+		}
+		rows = append(rows, row.String())
+	}
+	return strings.Join(rows, ","), nil
+}
+
+// trimMargin returns the bounding box of the pixels which differ noticeably
+// from the color of the image's corners.
+func trimMargin(img image.Image) image.Rectangle {
+	var (
+		b  = img.Bounds()
+		bg = average(img, image.Rect(b.Min.X, b.Min.Y, b.Min.X+2, b.Min.Y+2))
+		r  image.Rectangle
+	)
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			if distance(toRGBA(img.At(x, y)), bg) > 40*40 {
+				r = r.Union(image.Rect(x, y, x+1, y+1))
+			}
+		}
+	}
+	if r.Dx() < b.Dx()/2 || r.Dy() < b.Dy()/2 {
+		return b
+	}
+	return r
+}
+
+func average(img image.Image, r image.Rectangle) color.RGBA {
+	var (
+		sr, sg, sb, n int
+	)
+	r = r.Intersect(img.Bounds())
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			var c = toRGBA(img.At(x, y))
+			sr, sg, sb, n = sr+int(c.R), sg+int(c.G), sb+int(c.B), n+1
+		}
+	}
+	if n == 0 {
+		return color.RGBA{}
+	}
+	return color.RGBA{uint8(sr / n), uint8(sg / n), uint8(sb / n), 0xff}
+}
+
+func toRGBA(c color.Color) color.RGBA {
+	var r, g, b, _ = c.RGBA()
+	return color.RGBA{uint8(r >> 8), uint8(g >> 8), uint8(b >> 8), 0xff}
+}
+
+// classify returns the board symbol of the piece whose color in the palette
+// is closest to c, or '.' if the empty board is closer.
+func classify(pal Palette, ps []Piece, c color.RGBA) byte {
+	var (
+		best = distance(c, emptyColor)
+		res  = byte('.')
+	)
+	for _, p := range ps {
+		var s, ok = pal[p.name]
+		if !ok || s.Color == (color.RGBA{}) {
+			continue
+		}
+		if d := distance(c, s.Color); d < best {
+			best, res = d, s.Letter
+		}
+	}
+	return res
+}
+
+func distance(a, b color.RGBA) int {
+	var dr, dg, db = int(a.R) - int(b.R), int(a.G) - int(b.G), int(a.B) - int(b.B)
+	return dr*dr + dg*dg + db*db
+}
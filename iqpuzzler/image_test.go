@@ -0,0 +1,48 @@
+package iqpuzzler
+
+import (
+	"image/color"
+	"testing"
+)
+
+func TestReadBoardImage(t *testing.T) {
+	var set = pieceSets["iq-puzzler"]
+	pal, err := ResolvePalette(set.Pieces, set.Palette, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Without a color for yellow, its cells are read as the piece nearest in
+	// color.
+	var noYellow = make(Palette)
+	for name, s := range pal {
+		noYellow[name] = s
+	}
+	var s = noYellow["yellow"]
+	s.Color = color.RGBA{}
+	noYellow["yellow"] = s
+	var tests = []struct {
+		name string
+		pal  Palette
+		want string
+	}{
+		{"default", pal, "AAA.LLLL...,A....L.....,...........,.......IIII,.......I..."},
+		{"palette miss", noYellow, "AAA.GGGG...,A....G.....,...........,.......IIII,.......I..."},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// The photo has a white margin and pieces on a board with grey
+			// rims; the cell in the middle is in a grey of no piece, nearer
+			// to an empty hole than to any piece.
+			got, err := ReadBoardImage("testdata/board.png", test.pal, set.Pieces, 5, 11)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != test.want {
+				t.Errorf("got the board %q, want %q", got, test.want)
+			}
+		})
+	}
+	if _, err := ReadBoardImage("testdata/missing.png", pal, set.Pieces, 5, 11); err == nil {
+		t.Error("reading a missing image succeeded")
+	}
+}
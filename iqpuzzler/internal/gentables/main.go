@@ -0,0 +1,35 @@
+// Command gentables writes the placement tables of the pieces of the
+// built-in sets on the standard board as Go source, run by go generate in
+// the iqpuzzler package.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+
+	"smaart/iqpuzzler"
+)
+
+func main() {
+	var out = flag.String("o", "tables_gen.go", "the file to write")
+	flag.Parse()
+	var buf bytes.Buffer
+	if err := iqpuzzler.WriteTables(&buf); err != nil {
+		exit(err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		exit(err)
+	}
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		exit(err)
+	}
+}
+
+func exit(err error) {
+	fmt.Fprintln(os.Stderr, "gentables:", err)
+	os.Exit(1)
+}
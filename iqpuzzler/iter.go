@@ -0,0 +1,137 @@
+package iqpuzzler
+
+import (
+	"context"
+	"iter"
+	"log/slog"
+)
+
+// Solutions returns an iterator over the ways to complete the game with the
+// given pieces. With the built-in engines, the search runs on the goroutine
+// ranging over the iterator and advances only as solutions are consumed, and
+// all hooks are called on it; the solver's parallelism does not apply. Other
+// engines run as with SolveStream, ahead of the consumer by at most one
+// solution. Either way breaking out of the loop stops the search and leaves
+// nothing running, and every solution is a copy owned by the caller. If the
+// search fails or ctx is done, the last pair carries the error, which is an
+// *AbortedError in the latter case. As with Solve, reaching the solver's
+// timeout or its limit on placements ends the iteration without an error,
+// and its other limits and yield function apply. The search runs on a copy
+// of the game, which is left unchanged.
+func (s *Solver) Solutions(ctx context.Context, g *Game, ps []Piece) iter.Seq2[Solution, error] {
+	if s.engine != nil {
+		e, ok := s.engine.(dfs)
+		if !ok {
+			return s.streamSolutions(ctx, g, ps)
+		}
+		var s2 = *s
+		s2.strategy, s2.engine = e.strategy, nil
+		s = &s2
+	}
+	return func(yield func(Solution, error) bool) {
+		if err := ctx.Err(); err != nil {
+			yield(nil, &AbortedError{err, 0})
+			return
+		}
+		var (
+			sctx   context.Context
+			cancel context.CancelFunc
+		)
+		if s.opts.Timeout > 0 {
+			sctx, cancel = context.WithTimeout(ctx, s.opts.Timeout)
+		} else {
+			sctx, cancel = context.WithCancel(ctx)
+		}
+		defer cancel()
+		var (
+			n    int
+			done bool
+			g    = g.Clone()
+			// state cancels sctx once the placements reach the solver's
+			// limit, like its timeout, and calls its yield function.
+			state = searchState{counters: make(counters, 1), maxNodes: s.opts.MaxNodes, cancel: cancel, yield: s.opts.Yield}
+		)
+		s.logInfo(ctx, "search started", slog.String("strategy", s.strategy.String()))
+		var sr = &searcher{g: g, strategy: s.strategy, hooks: s.opts.Hooks, base: g.numMoves(), ctx: sctx, state: &state, counters: &state.counters[0], log: s.debugLogger(ctx), paranoid: s.opts.Paranoid, fn: func(ms []Move) bool {
+			n++
+			s.logInfo(ctx, "solution found", slog.Int("count", n))
+			if s.opts.Hooks.OnSolution != nil {
+				s.opts.Hooks.OnSolution(ms)
+			}
+			if !yield(Solution(ms), nil) {
+				done = true
+				return false
+			}
+			return s.opts.MaxSolutions == 0 || n < s.opts.MaxSolutions
+		}}
+		var tables = s.precompute(g.board, ps)
+		g.prepare(tables)
+		_, err := sr.search(tables)
+		switch {
+		case done:
+		case err != nil:
+			yield(nil, err)
+		case ctx.Err() != nil:
+			yield(nil, &AbortedError{ctx.Err(), sr.nodes})
+		}
+	}
+}
+
+// streamSolutions returns an iterator over the solutions SolveStream sends,
+// stopping it when the loop ends.
+func (s *Solver) streamSolutions(ctx context.Context, g *Game, ps []Piece) iter.Seq2[Solution, error] {
+	return func(yield func(Solution, error) bool) {
+		var sols, errc, stop = s.SolveStream(ctx, g, ps)
+		defer stop()
+		for sol := range sols {
+			if !yield(sol, nil) {
+				return
+			}
+		}
+		if err := <-errc; err != nil {
+			yield(nil, err)
+		}
+	}
+}
+
+// SolveStream runs Solve in a new goroutine and sends the solutions on the
+// first channel as they are found. The channel is unbuffered, so the search
+// blocks while the consumer is busy. Both channels are closed when the
+// search ends; the error channel receives Solve's error first, if any.
+// Calling stop stops the search if it is still running and waits for the
+// goroutine to exit, so consumers which stop reading early leave nothing
+// running; like the cancel function of a context, it should be called in
+// any case and may be called more than once. The solver's OnSolution hook
+// is called before each solution is sent.
+func (s *Solver) SolveStream(ctx context.Context, g *Game, ps []Piece) (sols <-chan Solution, errs <-chan error, stop func()) {
+	var (
+		out         = make(chan Solution)
+		errc        = make(chan error, 1)
+		exited      = make(chan struct{})
+		sctx, abort = context.WithCancel(ctx)
+		s2          = *s
+	)
+	s2.opts.Hooks.OnSolution = func(ms Solution) {
+		if s.opts.Hooks.OnSolution != nil {
+			s.opts.Hooks.OnSolution(ms)
+		}
+		select {
+		case out <- Solution(ms):
+		case <-sctx.Done():
+		}
+	}
+	go func() {
+		defer close(exited)
+		defer close(errc)
+		defer close(out)
+		_, err := s2.Solve(sctx, g, ps)
+		// An error from being stopped is of no interest to the consumer.
+		if stopped := sctx.Err() != nil && ctx.Err() == nil; err != nil && !stopped {
+			errc <- err
+		}
+	}()
+	return out, errc, func() {
+		abort()
+		<-exited
+	}
+}
@@ -0,0 +1,223 @@
+package iqpuzzler
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"runtime"
+	"testing"
+	"time"
+)
+
+// TestSolutions breaks out of the iteration after two solutions and checks
+// that the game is left as it was.
+func TestSolutions(t *testing.T) {
+	var b, ps = miniPuzzle(t)
+	s, err := NewSolver(WithMaxSolutions(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var (
+		g     = NewGame(b)
+		moves = g.Moves()
+		cells = g.Cells()
+		n     int
+	)
+	for sol, err := range s.Solutions(context.Background(), g, ps) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(sol) != len(ps) {
+			t.Fatalf("the solution %v has %d moves, want %d", sol, len(sol), len(ps))
+		}
+		if n++; n == 2 {
+			break
+		}
+	}
+	if n != 2 {
+		t.Errorf("got %d solutions, want 2", n)
+	}
+	if !reflect.DeepEqual(g.Moves(), moves) || !reflect.DeepEqual(g.Cells(), cells) {
+		t.Errorf("the game has the moves %v after the iteration", g.Moves())
+	}
+}
+
+// TestSolveStreamStop reads a few solutions from SolveStream and stops it,
+// checking that the producer goroutine exits and reports no error.
+func TestSolveStreamStop(t *testing.T) {
+	var b, ps = testPuzzle(t)
+	s, err := NewSolver(WithStrategy(FirstEmptyCell))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var before = runtime.NumGoroutine()
+	sols, errs, stop := s.SolveStream(context.Background(), NewGame(b), ps)
+	for range 3 {
+		if sol := <-sols; sol == nil {
+			t.Fatal("the stream ended before the third solution")
+		}
+	}
+	stop()
+	stop()
+	if err := <-errs; err != nil {
+		t.Errorf("got %v from a stopped stream", err)
+	}
+	if _, ok := <-sols; ok {
+		t.Error("the solutions channel is still open")
+	}
+	// Goroutines which have exited may take a moment to be counted out.
+	for i := 0; runtime.NumGoroutine() > before; i++ {
+		if i == 100 {
+			t.Fatalf("%d goroutines are left running, %d before the stream", runtime.NumGoroutine(), before)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// TestSolveStreamCancel checks that cancelling the context of a stream
+// ends it with an *AbortedError.
+func TestSolveStreamCancel(t *testing.T) {
+	var b, ps = testPuzzle(t)
+	s, err := NewSolver(WithStrategy(FirstEmptyCell))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	sols, errs, stop := s.SolveStream(ctx, NewGame(b), ps)
+	defer stop()
+	<-sols
+	cancel()
+	for range sols {
+	}
+	var ae *AbortedError
+	if err := <-errs; !errors.As(err, &ae) {
+		t.Errorf("got %v from a cancelled stream, want an *AbortedError", err)
+	}
+}
+
+// TestSolveStreamAll checks that a stream read to its end has every
+// solution.
+func TestSolveStreamAll(t *testing.T) {
+	var b, ps = testPuzzle(t)
+	s, err := NewSolver(WithStrategy(FirstEmptyCell))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sols, errs, stop := s.SolveStream(context.Background(), NewGame(b), ps)
+	defer stop()
+	var n int
+	for range sols {
+		n++
+	}
+	if err := <-errs; err != nil || n != 1708 {
+		t.Errorf("got %d solutions and %v, want 1708", n, err)
+	}
+}
+
+// TestSolutionsEngine checks that Solutions searches with the solver's
+// engine, finding the same solutions with each, and leaves the game as it
+// was.
+func TestSolutionsEngine(t *testing.T) {
+	var b, ps = miniPuzzle(t)
+	for _, name := range EngineNames() {
+		t.Run(name, func(t *testing.T) {
+			var e, _ = LookupEngine(name)
+			s, err := NewSolver(WithEngine(e), WithMaxSolutions(3))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var (
+				g        = NewGame(b)
+				occupied = g.Cells()
+				n        int
+			)
+			for sol, err := range s.Solutions(context.Background(), g, ps) {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := VerifySolution(b, ps, sol); err != nil {
+					t.Fatal(err)
+				}
+				if n++; n == 2 {
+					break
+				}
+			}
+			if n != 2 {
+				t.Errorf("got %d solutions, want 2", n)
+			}
+			if len(g.Moves()) != 0 || !reflect.DeepEqual(g.Cells(), occupied) {
+				t.Errorf("the game has the moves %v after the iteration", g.Moves())
+			}
+		})
+	}
+}
+
+// recordingEngine is an engine which records that it ran and finds the
+// solutions of the stack engine.
+type recordingEngine struct {
+	ran *bool
+}
+
+func (e recordingEngine) Solve(ctx context.Context, b *Board, ps []Piece, opts Options) (SolveResult, error) {
+	*e.ran = true
+	return stackEngine{}.Solve(ctx, b, ps, opts)
+}
+
+// TestSolutionsCustomEngine checks that Solutions runs an engine other than
+// the built-in ones and that breaking out of the loop stops it.
+func TestSolutionsCustomEngine(t *testing.T) {
+	var (
+		b, ps  = testPuzzle(t)
+		ran    bool
+		before = runtime.NumGoroutine()
+	)
+	s, err := NewSolver(WithEngine(recordingEngine{&ran}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, err := range s.Solutions(context.Background(), NewGame(b), ps) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		break
+	}
+	if !ran {
+		t.Error("the engine did not run")
+	}
+	if n := runtime.NumGoroutine(); n > before {
+		t.Errorf("%d goroutines are left running, %d before the iteration", n, before)
+	}
+}
+
+// TestSolutionsMaxNodes checks that the solver's limit on placements ends
+// the iteration early without an error, and that its yield function is
+// called on the way.
+func TestSolutionsMaxNodes(t *testing.T) {
+	var (
+		b, ps  = testPuzzle(t)
+		yields int
+		placed int64
+	)
+	s, err := NewSolver(WithStrategy(FirstEmptyCell), WithMaxNodes(2*pollInterval), WithYield(func() { yields++ }), WithHooks(Hooks{
+		OnPlace: func(Move, int) { placed++ },
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var n int
+	for _, err := range s.Solutions(context.Background(), NewGame(b), ps) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		n++
+	}
+	if n == 0 || n >= 1708 {
+		t.Errorf("got %d solutions, want some of the 1708", n)
+	}
+	if placed > 3*pollInterval {
+		t.Errorf("%d placements, want about %d", placed, 2*pollInterval)
+	}
+	if yields == 0 {
+		t.Error("the yield function was not called")
+	}
+}
@@ -0,0 +1,128 @@
+package iqpuzzler
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// moveJSON is the JSON form of a move. Shape holds the cells of the piece in
+// its orientation, relative to Position, and Cells the board cells covered.
+type moveJSON struct {
+	Piece     string `json:"piece"`
+	Letter    string `json:"letter,omitempty"`
+	Transform string `json:"transform"`
+	Position  Pos    `json:"position"`
+	Shape     []Pos  `json:"shape"`
+	Cells     []Pos  `json:"cells,omitempty"`
+	Wrap      *Pos   `json:"wrap,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (m Move) MarshalJSON() ([]byte, error) {
+	var j = moveJSON{
+		Piece:     m.Piece.name,
+		Transform: m.Piece.orient.String(),
+		Position:  m.Translate,
+		Shape:     m.Piece.pos,
+		Cells:     m.Image(),
+	}
+	if m.Piece.letter != 0 {
+		j.Letter = string(m.Piece.letter)
+	}
+	if m.wrap != (Pos{}) {
+		var w = m.wrap
+		j.Wrap = &w
+	}
+	return json.Marshal(j)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The move is self-contained, but
+// its piece is only known by name; Solution.Resolve checks it against a
+// piece set.
+func (m *Move) UnmarshalJSON(data []byte) error {
+	var j moveJSON
+	if err := json.Unmarshal(data, &j); err != nil {
+		return err
+	}
+	if j.Piece == "" {
+		return fmt.Errorf("move without a piece")
+	}
+	orient, err := ParseOrientation(j.Transform)
+	if err != nil {
+		return fmt.Errorf("move of %q: %w", j.Piece, err)
+	}
+	if len(j.Shape) == 0 {
+		return fmt.Errorf("move of %q has no shape", j.Piece)
+	}
+	if len(j.Letter) > 1 {
+		return fmt.Errorf("move of %q: letter %q is not a single character", j.Piece, j.Letter)
+	}
+	var res = Move{
+		Piece:     Piece{name: j.Piece, pos: j.Shape, orient: orient},
+		Translate: j.Position,
+	}
+	if j.Letter != "" {
+		res.Piece.letter = j.Letter[0]
+	}
+	if j.Wrap != nil {
+		if j.Wrap[0] <= 0 || j.Wrap[1] <= 0 {
+			return fmt.Errorf("move of %q: invalid board dimensions %v", j.Piece, *j.Wrap)
+		}
+		res.wrap = *j.Wrap
+	}
+	if j.Cells != nil && !sameCells(j.Cells, res.Image()) {
+		return fmt.Errorf("move of %q: cells %v do not match the shape at %v", j.Piece, j.Cells, j.Position)
+	}
+	*m = res
+	return nil
+}
+
+// Resolve replaces the pieces of the moves by the pieces of ps with the same
+// name in the same orientation. It fails if a piece is unknown or its shape
+// does not match the transformation. Shapes which do not start at the
+// origin, as older versions wrote them, are moved there, and their moves
+// by as much the other way.
+func (s Solution) Resolve(ps []Piece) error {
+	for i, m := range s {
+		var base, ok = pieceByName(ps, m.Piece.name)
+		if !ok {
+			return &UnknownPieceError{m.Piece.name, suggest(ps, m.Piece.name)}
+		}
+		var p = base.transform(m.Piece.orient.Matrix())
+		p.orient = m.Piece.orient
+		if !sameCells(p.pos, NormalizeToOrigin(m.Piece.pos)) {
+			return fmt.Errorf("move %d: the shape of %q is not its %s transformation", i+1, base.name, p.orient)
+		}
+		var min, _ = BoundingBox(m.Piece.pos)
+		s[i].Piece = p
+		s[i].Translate = m.Translate.Add(min)
+	}
+	return nil
+}
+
+func pieceByName(ps []Piece, name string) (Piece, bool) {
+	for _, p := range ps {
+		if p.name == name {
+			return p, true
+		}
+	}
+	return Piece{}, false
+}
+
+// sameCells reports whether a and b contain the same cells in any order.
+func sameCells(a, b []Pos) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	var n = make(map[Pos]int)
+	for i := range a {
+		n[a[i]]++
+		n[b[i]]--
+	}
+	for _, c := range n {
+		if c != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,116 @@
+package iqpuzzler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// testSolutions returns the first n solutions of the puzzle.
+func testSolutions(t testing.TB, b *Board, ps []Piece, n int) []Solution {
+	t.Helper()
+	s, err := NewSolver(WithStrategy(FirstEmptyCell), WithMaxSolutions(n))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := s.Solve(context.Background(), NewGame(b), ps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res.Solutions
+}
+
+func TestSolutionJSONRoundTrip(t *testing.T) {
+	var b, ps = testPuzzle(t)
+	for _, wrap := range []bool{false, true} {
+		var b = b.WithWrap(wrap)
+		for _, sol := range testSolutions(t, b, ps, 10) {
+			data, err := json.Marshal(sol)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got Solution
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatalf("Unmarshal(%s): %v", data, err)
+			}
+			if err := got.Resolve(standardPieces); err != nil {
+				t.Fatalf("Resolve(%s): %v", data, err)
+			}
+			var g = NewGame(b)
+			for _, m := range got {
+				if err := g.Place(m.Piece, m.Translate); err != nil {
+					t.Fatalf("replaying %s: %v", data, err)
+				}
+			}
+			if want := sol.Render(b, RenderStyle{}); got.Render(b, RenderStyle{}) != want || g.Free() != 0 {
+				t.Errorf("%s replays to %s, want %s", data, got.Render(b, RenderStyle{}), want)
+			}
+		}
+	}
+}
+
+func TestMoveJSONErrors(t *testing.T) {
+	var tests = []struct {
+		name, json string
+		// want is a part of the error of Unmarshal or Resolve.
+		want string
+	}{
+		{"no piece", `{"transform":"I","position":[0,0],"shape":[[0,0]]}`, "move without a piece"},
+		{"bad transform", `{"piece":"blue","transform":"R45","position":[0,0],"shape":[[0,0]]}`, `move of "blue"`},
+		{"no shape", `{"piece":"blue","transform":"I","position":[0,0]}`, "has no shape"},
+		{"long letter", `{"piece":"blue","letter":"AB","transform":"I","position":[0,0],"shape":[[0,0]]}`, "not a single character"},
+		{"bad wrap", `{"piece":"blue","transform":"I","position":[0,0],"shape":[[0,0]],"wrap":[0,11]}`, "invalid board dimensions"},
+		{"wrong cells", `{"piece":"blue","transform":"I","position":[1,1],"shape":[[0,0],[0,1],[0,2],[1,0]],"cells":[[0,0],[0,1],[0,2],[1,0]]}`, "do not match the shape"},
+		{"unknown piece", `{"piece":"bleu","transform":"I","position":[0,0],"shape":[[0,0],[0,1],[0,2],[1,0]]}`, `unknown piece "bleu"`},
+		{"wrong shape", `{"piece":"blue","transform":"R90","position":[0,0],"shape":[[0,0],[0,1],[0,2],[1,0]]}`, "is not its R90 transformation"},
+		{"not an object", `[1,2]`, "cannot unmarshal"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var sol Solution
+			var err = json.Unmarshal([]byte("["+test.json+"]"), &sol)
+			if err == nil {
+				err = sol.Resolve(standardPieces)
+			}
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Errorf("got %v, want an error containing %q", err, test.want)
+			}
+		})
+	}
+}
+
+// FuzzMoveJSON feeds corrupted moves to the unmarshaler, which must reject
+// them with an error rather than panic, and checks that the moves it
+// accepts marshal again.
+func FuzzMoveJSON(f *testing.F) {
+	var b, ps = testPuzzle(f)
+	for _, m := range testSolutions(f, b.WithWrap(true), ps, 1)[0] {
+		data, err := json.Marshal(m)
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(data)
+		f.Add(data[:len(data)/2])
+	}
+	f.Add([]byte(`{"piece":"x","transform":"I","position":[-9223372036854775808,0],"shape":[[0,0]],"wrap":[1,1]}`))
+	f.Add([]byte(`{"piece":"x","transform":"I","position":[0,0],"shape":[[0,0]],"cells":[]}`))
+	f.Add([]byte(`null`))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var m Move
+		if err := json.Unmarshal(data, &m); err != nil {
+			return
+		}
+		if _, err := json.Marshal(m); err != nil {
+			t.Errorf("Marshal of the move %s: %v", data, err)
+		}
+		var sol = Solution{m}
+		if err := sol.Resolve(standardPieces); err != nil {
+			var ue *UnknownPieceError
+			if !errors.As(err, &ue) && !strings.Contains(err.Error(), "transformation") {
+				t.Errorf("Resolve of the move %s: %v", data, err)
+			}
+		}
+	})
+}
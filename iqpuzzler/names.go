@@ -0,0 +1,106 @@
+package iqpuzzler
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// LookupPiece finds a piece by name. Names are matched case-insensitively,
+// and an unambiguous prefix of a name is accepted as well. If no piece
+// matches, the error is an *UnknownPieceError suggesting the closest known
+// names.
+func LookupPiece(ps []Piece, name string) (Piece, error) {
+	var (
+		n        = strings.ToLower(name)
+		prefixed []Piece
+	)
+	for _, p := range ps {
+		if strings.ToLower(p.name) == n {
+			return p, nil
+		}
+		if n != "" && strings.HasPrefix(strings.ToLower(p.name), n) {
+			prefixed = append(prefixed, p)
+		}
+	}
+	switch len(prefixed) {
+	case 1:
+		return prefixed[0], nil
+	case 0:
+	default:
+		var names []string
+		for _, p := range prefixed {
+			names = append(names, p.name)
+		}
+		return Piece{}, &AmbiguousPieceError{name, names}
+	}
+	return Piece{}, &UnknownPieceError{name, suggest(ps, n)}
+}
+
+// suggest returns the names of the pieces closest to the given name, if
+// they are close enough to be plausible typos.
+func suggest(ps []Piece, name string) []string {
+	var (
+		best  = len(name)/3 + 1
+		names []string
+	)
+	for _, p := range ps {
+		var d = editDistance(name, strings.ToLower(p.name))
+		if d > best {
+			continue
+		}
+		if d < best {
+			best, names = d, nil
+		}
+		if d == best {
+			names = append(names, p.name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func quoteList(names []string) string {
+	var qs []string
+	for _, n := range names {
+		qs = append(qs, fmt.Sprintf("%q", n))
+	}
+	if len(qs) == 1 {
+		return qs[0]
+	}
+	return strings.Join(qs[:len(qs)-1], ", ") + " or " + qs[len(qs)-1]
+}
+
+// editDistance computes the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	var (
+		ra, rb = []rune(a), []rune(b)
+		prev   = make([]int, len(rb)+1)
+		cur    = make([]int, len(rb)+1)
+	)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			var cost = 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min3(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
@@ -0,0 +1,5 @@
+//go:build !race
+
+package iqpuzzler
+
+const raceEnabled = false
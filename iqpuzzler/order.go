@@ -0,0 +1,212 @@
+package iqpuzzler
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"sync"
+)
+
+// PlacementScoresVersion is the version of the file format of
+// PlacementScores.
+const PlacementScoresVersion = 1
+
+// PlacementScores count how often each placement of a piece was part of
+// the solutions found on boards of the same class, see BoardClass, so that
+// a search can try the placements which solved similar puzzles first. A
+// placement is a piece, by name, covering a set of cells. It is safe for
+// concurrent use.
+type PlacementScores struct {
+	mu      sync.Mutex
+	classes map[string]*classScores
+}
+
+// classScores are the scores of a board class.
+type classScores struct {
+	// Solutions is the number of solutions recorded, and Placements the
+	// number of them each placement was part of, by appendPlacementKey.
+	Solutions  int64            `json:"solutions"`
+	Placements map[string]int64 `json:"placements"`
+}
+
+// scoresFile is the JSON form of PlacementScores.
+type scoresFile struct {
+	Version int                     `json:"version"`
+	Classes map[string]*classScores `json:"classes"`
+}
+
+// NewPlacementScores returns scores without any solutions recorded.
+func NewPlacementScores() *PlacementScores {
+	return &PlacementScores{classes: make(map[string]*classScores)}
+}
+
+// ReadPlacementScores reads scores written by Write.
+func ReadPlacementScores(path string) (*PlacementScores, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var f scoresFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	if f.Version != PlacementScoresVersion {
+		return nil, fmt.Errorf("%s: unsupported placement scores version %d, want %d", path, f.Version, PlacementScoresVersion)
+	}
+	var s = NewPlacementScores()
+	for k, c := range f.Classes {
+		if c != nil && c.Placements != nil {
+			s.classes[k] = c
+		}
+	}
+	return s, nil
+}
+
+// Write writes the scores as JSON.
+func (s *PlacementScores) Write(w io.Writer) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var enc = json.NewEncoder(w)
+	return enc.Encode(scoresFile{PlacementScoresVersion, s.classes})
+}
+
+// Clone returns a copy of the scores.
+func (s *PlacementScores) Clone() *PlacementScores {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var c = NewPlacementScores()
+	for k, cs := range s.classes {
+		var p = make(map[string]int64, len(cs.Placements))
+		for pk, n := range cs.Placements {
+			p[pk] = n
+		}
+		c.classes[k] = &classScores{cs.Solutions, p}
+	}
+	return c
+}
+
+// Solutions returns the number of solutions recorded for the class of the
+// board.
+func (s *PlacementScores) Solutions(b *Board) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if c := s.classes[BoardClass(b)]; c != nil {
+		return c.Solutions
+	}
+	return 0
+}
+
+// Record adds the placements of the solution of a puzzle on the board to
+// the scores of its class.
+func (s *PlacementScores) Record(b *Board, sol Solution) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var class = BoardClass(b)
+	var c = s.classes[class]
+	if c == nil {
+		c = &classScores{Placements: make(map[string]int64)}
+		s.classes[class] = c
+	}
+	c.Solutions++
+	var cells []int
+	for _, m := range sol {
+		cells = cells[:0]
+		for _, p := range m.Image() {
+			cells = append(cells, b.bit(p))
+		}
+		slices.Sort(cells)
+		c.Placements[string(appendPlacementKey(nil, m.Piece.name, cells))]++
+	}
+}
+
+// BoardClass returns the class of boards sharing placement scores: those
+// of the same dimensions, toroidal or not, whichever cells are occupied.
+func BoardClass(b *Board) string {
+	var s = fmt.Sprintf("%dx%d", b.rows, b.cols)
+	if b.wrap {
+		s += "-wrap"
+	}
+	return s
+}
+
+// appendPlacementKey appends the key of the piece covering the cells with
+// the given bits, in increasing order, to dst.
+func appendPlacementKey(dst []byte, name string, cells []int) []byte {
+	dst = append(dst, name...)
+	dst = append(dst, ':')
+	for i, c := range cells {
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		dst = strconv.AppendInt(dst, int64(c), 10)
+	}
+	return dst
+}
+
+// order sorts the placements of each table by their scores on the board,
+// highest first, and then by the distance of their cells from the centre
+// of the board, nearest first. Only the order within a piece changes, and
+// for FirstEmptyCell within the placements covering a cell.
+func (s *PlacementScores) order(b *Board, ts []pieceTable) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var scores map[string]int64
+	if c := s.classes[BoardClass(b)]; c != nil {
+		scores = c.Placements
+	}
+	var (
+		cells []int
+		key   []byte
+	)
+	for i := range ts {
+		var t = &ts[i]
+		// ranks holds the rank of every translation of every version, by
+		// the index of its mask.
+		var ranks = make([][]placementRank, len(t.versions))
+		for j := range t.versions {
+			var v = &t.versions[j]
+			ranks[j] = make([]placementRank, v.n[0]*v.n[1])
+			for k := range ranks[j] {
+				cells = v.at(k * v.words).bits(cells[:0])
+				key = appendPlacementKey(key[:0], v.piece.name, cells)
+				ranks[j][k] = placementRank{score: scores[string(key)], dist: centreDistance(b, cells)}
+			}
+		}
+		var byRank = func(a, b spot) int {
+			var va, vb = &t.versions[a.v], &t.versions[b.v]
+			return ranks[a.v][int(a.mask)/va.words].compare(ranks[b.v][int(b.mask)/vb.words])
+		}
+		slices.SortStableFunc(t.spots, byRank)
+		for c := 0; c+1 < len(t.start); c++ {
+			slices.SortStableFunc(t.cover[t.start[c]:t.start[c+1]], byRank)
+		}
+	}
+}
+
+// placementRank orders placements by their score and then their distance
+// from the centre of the board.
+type placementRank struct {
+	score, dist int64
+}
+
+func (r placementRank) compare(o placementRank) int {
+	if c := cmp.Compare(o.score, r.score); c != 0 {
+		return c
+	}
+	return cmp.Compare(r.dist, o.dist)
+}
+
+// centreDistance returns the sum of the squared distances of the cells with
+// the given bits from the centre of the board, in half cells.
+func centreDistance(b *Board, cells []int) int64 {
+	var d int64
+	for _, c := range cells {
+		var x, y = int64(2*(c/b.cols) - (b.rows - 1)), int64(2*(c%b.cols) - (b.cols - 1))
+		d += x*x + y*y
+	}
+	return d
+}
@@ -0,0 +1,99 @@
+package iqpuzzler
+
+import (
+	"context"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+// TestPlacementScores enumerates puzzles without scores, with empty ones
+// ordering by the centre only, with the scores recorded by that run and
+// with those scores written to a file and read back, and checks that each
+// finds the same solutions, only in another order.
+func TestPlacementScores(t *testing.T) {
+	var tests = []struct {
+		st     Strategy
+		puzzle func(testing.TB) (*Board, []Piece)
+		want   int
+	}{
+		{PieceOrder, miniPuzzle, 3},
+		{FirstEmptyCell, testPuzzle, 1708},
+	}
+	for _, test := range tests {
+		t.Run(test.st.String(), func(t *testing.T) {
+			var (
+				b, ps  = test.puzzle(t)
+				want   = enumerate(t, b, ps, test.st, nil)
+				scores = NewPlacementScores()
+			)
+			if len(want) != test.want {
+				t.Fatalf("%d solutions without scores, want %d", len(want), test.want)
+			}
+			var centre = enumerate(t, b, ps, test.st, scores)
+			if !sameSolutions(centre, want) {
+				t.Error("ordering by the centre changed the solutions")
+			}
+			if n := scores.Solutions(b); n != int64(len(want)) {
+				t.Errorf("%d solutions recorded, want %d", n, len(want))
+			}
+			var path = filepath.Join(t.TempDir(), "scores.json")
+			f, err := os.Create(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := scores.Write(f); err != nil {
+				t.Fatal(err)
+			}
+			if err := f.Close(); err != nil {
+				t.Fatal(err)
+			}
+			read, err := ReadPlacementScores(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var trained, reread = enumerate(t, b, ps, test.st, scores), enumerate(t, b, ps, test.st, read)
+			if !sameSolutions(trained, want) {
+				t.Error("the recorded scores changed the solutions")
+			}
+			if !slices.Equal(reread, trained) {
+				t.Error("the scores read back order the solutions differently")
+			}
+		})
+	}
+}
+
+// enumerate returns the canonical keys of the solutions of the puzzle in
+// the order a single worker finds them with the scores.
+func enumerate(t *testing.T, b *Board, ps []Piece, st Strategy, scores *PlacementScores) []string {
+	t.Helper()
+	var keys []string
+	var opts = []Option{WithStrategy(st), WithParallelism(1), WithOnSolution(func(sol Solution) {
+		keys = append(keys, sol.Canonical())
+	})}
+	if scores != nil {
+		opts = append(opts, WithPlacementScores(scores))
+	}
+	s, err := NewSolver(opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Solve(context.Background(), NewGame(b), ps); err != nil {
+		t.Fatal(err)
+	}
+	return keys
+}
+
+// sameSolutions reports whether the keys are the same, in any order.
+func sameSolutions(a, b []string) bool {
+	var count = func(keys []string) map[string]int {
+		var res = make(map[string]int)
+		for _, k := range keys {
+			res[k]++
+		}
+		return res
+	}
+	return maps.Equal(count(a), count(b))
+}
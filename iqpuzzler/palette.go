@@ -0,0 +1,154 @@
+package iqpuzzler
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"image/color"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Style describes how a piece is displayed.
+type Style struct {
+	// Color is the color of the physical piece.
+	Color color.RGBA
+	// ANSI is the SGR parameter string used to color the piece in terminals.
+	ANSI   string
+	Letter byte
+	Emoji  string
+}
+
+// Palette maps piece names to their styles.
+type Palette map[string]Style
+
+// emptyColor is the color of an empty hole on the board.
+var emptyColor = color.RGBA{0xe6, 0xe6, 0xe6, 0xff}
+
+// ResolvePalette returns the palette for the given pieces: the defaults,
+// overridden by the file at path if it is not empty. Pieces keep their
+// letter unless the file overrides it.
+func ResolvePalette(ps []Piece, defaults Palette, path string) (Palette, error) {
+	var res = make(Palette)
+	for _, p := range ps {
+		var s = defaults[p.name]
+		s.Letter = p.letter
+		res[p.name] = s
+	}
+	if path != "" {
+		if err := readPalette(res, ps, path); err != nil {
+			return nil, err
+		}
+	}
+	return res, validatePalette(res)
+}
+
+// readPalette reads palette overrides from a file with lines of the form
+//
+//	name [color=#rrggbb] [ansi=SGR] [letter=L] [emoji=E]
+func readPalette(pal Palette, ps []Piece, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	var (
+		sc   = bufio.NewScanner(f)
+		line int
+	)
+	for sc.Scan() {
+		line++
+		var fields = strings.Fields(sc.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		p, err := LookupPiece(ps, fields[0])
+		if err != nil {
+			return fmt.Errorf("%s:%d: %v", path, line, err)
+		}
+		var s = pal[p.name]
+		for _, kv := range fields[1:] {
+			var i = strings.IndexByte(kv, '=')
+			if i < 0 {
+				return fmt.Errorf("%s:%d: expected key=value, got %q", path, line, kv)
+			}
+			var k, v = kv[:i], kv[i+1:]
+			switch k {
+			case "color":
+				c, err := parseHexColor(v)
+				if err != nil {
+					return fmt.Errorf("%s:%d: %v", path, line, err)
+				}
+				s.Color = c
+			case "ansi":
+				s.ANSI = v
+			case "letter":
+				if len(v) != 1 || !isPieceLetter(v[0]) {
+					return fmt.Errorf("%s:%d: invalid letter %q, want one of A-Z except X", path, line, v)
+				}
+				s.Letter = v[0]
+			case "emoji":
+				s.Emoji = v
+			default:
+				return fmt.Errorf("%s:%d: unknown key %q", path, line, k)
+			}
+		}
+		pal[p.name] = s
+	}
+	return sc.Err()
+}
+
+func parseHexColor(s string) (color.RGBA, error) {
+	if len(s) != 7 || s[0] != '#' {
+		return color.RGBA{}, fmt.Errorf("invalid color %q, want #rrggbb", s)
+	}
+	v, err := strconv.ParseUint(s[1:], 16, 32)
+	if err != nil {
+		return color.RGBA{}, fmt.Errorf("invalid color %q, want #rrggbb", s)
+	}
+	return color.RGBA{uint8(v >> 16), uint8(v >> 8), uint8(v), 0xff}, nil
+}
+
+// validatePalette checks that no two pieces share a letter, an emoji or a
+// color.
+func validatePalette(pal Palette) error {
+	var (
+		names    []string
+		problems []string
+		letters  = make(map[byte]string)
+		emojis   = make(map[string]string)
+		colors   = make(map[color.RGBA]string)
+	)
+	for n := range pal {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	for _, n := range names {
+		var s = pal[n]
+		if other, ok := letters[s.Letter]; ok {
+			problems = append(problems, fmt.Sprintf("pieces %q and %q have the same letter %c", other, n, s.Letter))
+		}
+		letters[s.Letter] = n
+		if other, ok := emojis[s.Emoji]; ok && s.Emoji != "" {
+			problems = append(problems, fmt.Sprintf("pieces %q and %q have the same emoji %s", other, n, s.Emoji))
+		}
+		emojis[s.Emoji] = n
+		if other, ok := colors[s.Color]; ok && s.Color != (color.RGBA{}) {
+			problems = append(problems, fmt.Sprintf("pieces %q and %q have the same color", other, n))
+		}
+		colors[s.Color] = n
+	}
+	if len(problems) > 0 {
+		return errors.New(strings.Join(problems, "\n"))
+	}
+	return nil
+}
+
+// ApplyLetters sets the letters of the pieces to the ones of the palette.
+func (pal Palette) ApplyLetters(ps []Piece) {
+	for i := range ps {
+		ps[i].letter = pal[ps[i].name].Letter
+	}
+}
@@ -0,0 +1,76 @@
+package iqpuzzler
+
+// Piece represents a piece.
+type Piece struct {
+	name   string
+	letter byte
+	pos    []Pos
+	sym    bool
+	// orient is the transformation turning the piece as defined into this
+	// orientation.
+	orient Orientation
+}
+
+// NewPiece returns a piece with the given name, board letter and cells.
+func NewPiece(name string, letter byte, cells []Pos) Piece {
+	return Piece{name: name, letter: letter, pos: append([]Pos(nil), cells...)}
+}
+
+// Name returns the name of the piece.
+func (p Piece) Name() string {
+	return p.name
+}
+
+// Letter returns the letter marking the piece on a board.
+func (p Piece) Letter() byte {
+	return p.letter
+}
+
+// Cells returns a copy of the cells covered by the piece.
+func (p Piece) Cells() []Pos {
+	return append([]Pos(nil), p.pos...)
+}
+
+// Orientation returns the transformation turning the piece as defined into
+// this one.
+func (p Piece) Orientation() Orientation {
+	return p.orient
+}
+
+// Size returns the number of cells covered by the piece.
+func (p Piece) Size() int {
+	return len(p.pos)
+}
+
+// transform returns the piece transformed by m and moved so that its
+// bounding box starts at the origin, keeping the order of the cells. The
+// translation of a move of it is then the top left corner of the cells it
+// covers.
+func (p Piece) transform(m Matrix) Piece {
+	var posi = make([]Pos, 0, len(p.pos))
+	for _, pos := range p.pos {
+		posi = append(posi, m.Transform(pos))
+	}
+	return Piece{name: p.name, letter: p.letter, pos: NormalizeToOrigin(posi), sym: p.sym}
+}
+
+// versions returns the distinct versions of the piece, transformed by each
+// of tx, dropping those covering the same cells as an earlier one up to
+// translation, which would only be placed twice. Each is named after the
+// first transformation producing it.
+func (p Piece) versions() []Piece {
+	var (
+		res  []Piece
+		seen = make(map[string]bool, len(tx))
+	)
+	for t := range tx {
+		var v = p.transform(tx[t])
+		v.orient = Orientation(t)
+		var key = shapeKey(normalize(v.pos))
+		if !seen[key] {
+			seen[key] = true
+			res = append(res, v)
+		}
+	}
+	return res
+}
@@ -0,0 +1,371 @@
+package iqpuzzler
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestOrientationCounts(t *testing.T) {
+	var want = map[string]map[string]int{
+		"iq-puzzler": {
+			"blue": 8, "green": 4, "lightblue": 4, "maroon": 4, "mint": 8, "olive": 4,
+			"orange": 8, "pink": 8, "red": 8, "turquoise": 4, "violet": 4, "yellow": 8,
+		},
+		"kanoodle": {
+			"red": 8, "orange": 8, "blue": 8, "pink": 8, "green": 4, "white": 4,
+			"lightblue": 4, "gray": 1, "purple": 8, "yellow": 4, "lime": 4, "darkgreen": 4,
+		},
+		"pentomino": {
+			"f": 8, "i": 2, "l": 8, "n": 8, "p": 8, "t": 4,
+			"u": 4, "v": 4, "w": 4, "x": 1, "y": 8, "z": 4,
+		},
+	}
+	for _, name := range PieceSetNames() {
+		var set, _ = LookupPieceSet(name)
+		if len(set.Pieces) != len(want[name]) {
+			t.Errorf("piece set %s has %d pieces, want %d", name, len(set.Pieces), len(want[name]))
+		}
+		for _, p := range set.Pieces {
+			if n := len(p.Orientations()); n != want[name][p.name] {
+				t.Errorf("%s %s: %d orientations, want %d", name, p.name, n, want[name][p.name])
+			}
+		}
+	}
+}
+
+// TestOrientationTransforms checks that each transformation of a piece
+// produces exactly one of its orientations, the same shape as Orient, and
+// that all are produced by equally many, as the symmetries of a shape form a
+// subgroup.
+func TestOrientationTransforms(t *testing.T) {
+	for _, name := range PieceSetNames() {
+		var set, _ = LookupPieceSet(name)
+		for _, p := range set.Pieces {
+			var (
+				ors  = p.Orientations()
+				seen = make(map[Orientation]bool)
+			)
+			if len(ors) == 0 || len(tx)%len(ors) != 0 {
+				t.Errorf("%s %s: %d orientations, not a divisor of %d", name, p.name, len(ors), len(tx))
+				continue
+			}
+			for _, o := range ors {
+				if len(o.Transforms) != len(tx)/len(ors) {
+					t.Errorf("%s %s: the orientation %s is produced by %d transformations, want %d", name, p.name, o.Piece.orient, len(o.Transforms), len(tx)/len(ors))
+				}
+				for _, tr := range o.Transforms {
+					if seen[tr] {
+						t.Errorf("%s %s: %s produces two orientations", name, p.name, tr)
+					}
+					seen[tr] = true
+					if v := p.Orient(tr); shapeKey(v.pos) != shapeKey(o.Piece.pos) {
+						t.Errorf("%s %s: %s produces %v, but its orientation is %v", name, p.name, tr, v.pos, o.Piece.pos)
+					}
+				}
+			}
+			if len(seen) != len(tx) {
+				t.Errorf("%s %s: %d of %d transformations produce an orientation", name, p.name, len(seen), len(tx))
+			}
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	var tests = []struct {
+		name        string
+		cells, want []Pos
+	}{
+		{"normalized", []Pos{{0, 0}, {0, 1}, {1, 0}}, []Pos{{0, 0}, {0, 1}, {1, 0}}},
+		{"negative", []Pos{{-1, -2}, {-1, -1}, {0, -2}}, []Pos{{0, 0}, {0, 1}, {1, 0}}},
+		{"unsorted", []Pos{{1, 0}, {0, 1}, {0, 0}}, []Pos{{0, 0}, {0, 1}, {1, 0}}},
+		{"offset", []Pos{{4, 7}, {3, 5}, {4, 6}, {4, 5}}, []Pos{{0, 0}, {1, 0}, {1, 1}, {1, 2}}},
+		{"single", []Pos{{-4, 7}}, []Pos{{0, 0}}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := normalize(test.cells); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("normalize(%v) = %v, want %v", test.cells, got, test.want)
+			}
+		})
+	}
+}
+
+func TestOrigin(t *testing.T) {
+	var p = Piece{name: "a", pos: []Pos{{2, 3}, {1, 3}, {1, 4}}}
+	if got, want := p.origin().pos, []Pos{{1, 0}, {0, 0}, {0, 1}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("origin of %v = %v, want %v in the order drawn", p.pos, got, want)
+	}
+	if got := (Piece{name: "empty"}).origin(); got.pos != nil {
+		t.Errorf("origin of an empty piece = %v", got.pos)
+	}
+}
+
+func TestEqual(t *testing.T) {
+	var tests = []struct {
+		name string
+		a, b []Pos
+		want bool
+	}{
+		{"rotated", []Pos{{0, 0}, {1, 0}, {2, 0}, {2, 1}}, []Pos{{0, 0}, {0, 1}, {0, 2}, {1, 0}}, true},
+		{"mirrored", []Pos{{0, 0}, {0, 1}, {1, 1}, {1, 2}}, []Pos{{0, 1}, {0, 2}, {1, 0}, {1, 1}}, true},
+		{"translated", []Pos{{0, 0}, {0, 1}, {1, 0}}, []Pos{{5, 5}, {5, 6}, {6, 5}}, true},
+		{"reordered", []Pos{{0, 0}, {0, 1}, {1, 0}}, []Pos{{1, 0}, {0, 0}, {0, 1}}, true},
+		{"different", []Pos{{0, 0}, {1, 0}, {2, 0}, {2, 1}}, []Pos{{0, 0}, {0, 1}, {0, 2}, {1, 1}}, false},
+		{"different sizes", []Pos{{0, 0}, {0, 1}, {1, 0}}, []Pos{{0, 0}, {0, 1}, {1, 0}, {1, 1}}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var a, b = Piece{name: "a", pos: test.a}, Piece{name: "b", pos: test.b}
+			if got := a.Equal(b); got != test.want {
+				t.Errorf("Equal(%v, %v) = %t, want %t", test.a, test.b, got, test.want)
+			}
+		})
+	}
+}
+
+// TestCanonicalForm checks that every transformation of a piece, moved
+// anywhere, has the canonical form, equality and hash of the piece, for a
+// piece symmetric under all transformations, one symmetric under a mirror
+// and one without symmetries.
+func TestCanonicalForm(t *testing.T) {
+	var tests = []struct {
+		name  string
+		cells []Pos
+		want  []Pos
+	}{
+		{"plus", []Pos{{0, 1}, {1, 0}, {1, 1}, {1, 2}, {2, 1}}, []Pos{{0, 1}, {1, 0}, {1, 1}, {1, 2}, {2, 1}}},
+		{"tee", []Pos{{0, 0}, {0, 1}, {0, 2}, {1, 1}}, []Pos{{0, 0}, {0, 1}, {0, 2}, {1, 1}}},
+		{"hook", []Pos{{0, 0}, {1, 0}, {2, 0}, {2, 1}}, []Pos{{0, 0}, {0, 1}, {0, 2}, {1, 0}}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var p = Piece{name: test.name, pos: test.cells}
+			if got := p.CanonicalForm(); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("CanonicalForm = %v, want %v", got, test.want)
+			}
+			for i, m := range tx {
+				var q = p.transform(m)
+				for j := range q.pos {
+					q.pos[j] = q.pos[j].Add(Pos{3, -7})
+				}
+				if got := q.CanonicalForm(); !reflect.DeepEqual(got, test.want) {
+					t.Errorf("transformation %d: CanonicalForm of %v = %v, want %v", i, q.pos, got, test.want)
+				}
+				if !q.Equal(p) || !p.Equal(q) {
+					t.Errorf("transformation %d: %v is not Equal to %v", i, q.pos, p.pos)
+				}
+				if q.Hash() != p.Hash() {
+					t.Errorf("transformation %d: Hash of %v = %x, want %x", i, q.pos, q.Hash(), p.Hash())
+				}
+			}
+		})
+	}
+	var plus, tee = Piece{pos: tests[0].cells}, Piece{pos: tests[1].cells}
+	if plus.Equal(tee) || plus.Hash() == tee.Hash() {
+		t.Errorf("the plus and the tee are Equal or have the same hash %x", plus.Hash())
+	}
+	var t4 = Piece{pos: []Pos{{0, 0}, {0, 1}, {0, 2}, {0, 3}, {1, 1}}}
+	if tee.Equal(t4) {
+		t.Error("pieces of different sizes are Equal")
+	}
+}
+
+// TestSearchVersions checks that the search tries each distinct orientation
+// of a piece once, in the order and under the names of Orientations.
+func TestSearchVersions(t *testing.T) {
+	for _, name := range PieceSetNames() {
+		var set, _ = LookupPieceSet(name)
+		for _, p := range set.Pieces {
+			var ors, vs = p.Orientations(), p.versions()
+			if len(vs) != len(ors) {
+				t.Errorf("%s %s: the search tries %d orientations, want %d", name, p.name, len(vs), len(ors))
+				continue
+			}
+			for i, v := range vs {
+				if v.orient != ors[i].Piece.orient || shapeKey(normalize(v.pos)) != shapeKey(ors[i].Piece.pos) {
+					t.Errorf("%s %s: the search tries %s %v, want %s %v", name, p.name, v.orient, v.pos, ors[i].Piece.orient, ors[i].Piece.pos)
+				}
+			}
+		}
+	}
+}
+
+// TestPlacements checks that the tables of both strategies hold every
+// placement of every orientation of the pieces of the built-in sets, and of
+// a piece defined with negative cells, on empty boards, and no other: for
+// every cell of the board and every cell of an orientation, the translation
+// moving the one onto the other, if it keeps the orientation on the board.
+// The orientations start at the origin, so that the translations are the
+// top left corners of the placements. Each placement must cover the cells
+// it moves the piece to.
+func TestPlacements(t *testing.T) {
+	var pieces = []Piece{NewPiece("hook", 'H', []Pos{{-1, -1}, {-1, 0}, {0, 0}, {1, 0}, {1, 1}})}
+	for _, name := range PieceSetNames() {
+		pieces = append(pieces, pieceSets[name].Pieces...)
+	}
+	for _, dims := range []Pos{{5, 11}, {4, 4}, {3, 20}} {
+		var b = NewBoard(dims[0], dims[1])
+		for _, p := range pieces {
+			checkPlacements(t, b, p)
+		}
+	}
+}
+
+// checkPlacements checks the placements of the piece on the empty board.
+func checkPlacements(t *testing.T, b *Board, p Piece) {
+	t.Helper()
+	type placement struct {
+		v   int32
+		pos Pos
+	}
+	var (
+		want = make(map[placement]bool)
+		t1   = precompute(b, []Piece{p}, PieceOrder)[0]
+	)
+	for i, v := range t1.versions {
+		if min, _ := BoundingBox(v.piece.pos); min != (Pos{}) {
+			t.Errorf("%s %s: %v does not start at the origin", p.name, v.piece.orient, v.piece.pos)
+		}
+		for x := range b.rows {
+			for y := range b.cols {
+				for _, c := range v.piece.pos {
+					var pos, on = Pos{x, y}.Sub(c), true
+					for _, d := range v.piece.pos {
+						on = on && b.inBounds(d.Add(pos))
+					}
+					if on {
+						want[placement{int32(i), pos}] = true
+					}
+				}
+			}
+		}
+	}
+	// covers checks the mask of the placement.
+	var covers = func(t1 *pieceTable, sp spot) bool {
+		var v, mask = t1.spot(sp)
+		if mask.count() != len(v.piece.pos) {
+			t.Errorf("%s %s at %v covers %d cells, want %d", p.name, v.piece.orient, sp.pos, mask.count(), len(v.piece.pos))
+			return false
+		}
+		for _, c := range v.piece.pos {
+			if !mask.has(b.bit(c.Add(sp.pos))) {
+				t.Errorf("%s %s at %v does not cover %v", p.name, v.piece.orient, sp.pos, c.Add(sp.pos))
+				return false
+			}
+		}
+		return true
+	}
+	var got = make(map[placement]bool)
+	for _, sp := range t1.spots {
+		if !covers(&t1, sp) {
+			return
+		}
+		got[placement{sp.v, sp.pos}] = true
+	}
+	var cover = precompute(b, []Piece{p}, FirstEmptyCell)[0]
+	for i := range b.rows * b.cols {
+		for _, sp := range cover.covering(i) {
+			if !covers(&cover, sp) {
+				return
+			}
+			if !want[placement{sp.v, sp.pos}] {
+				t.Errorf("%s %s at %v is off the board but listed for cell %d", p.name, cover.versions[sp.v].piece.orient, sp.pos, i)
+			}
+			if _, mask := cover.spot(sp); !mask.has(i) {
+				t.Errorf("%s %s at %v is listed for cell %d, which it does not cover", p.name, cover.versions[sp.v].piece.orient, sp.pos, i)
+			}
+		}
+	}
+	for pl := range want {
+		var v = t1.versions[pl.v].piece
+		if !got[pl] {
+			t.Errorf("%s %s %v is never tried at %v", p.name, v.orient, v.pos, pl.pos)
+			continue
+		}
+		for _, c := range v.pos {
+			var i, found = b.bit(c.Add(pl.pos)), false
+			for _, sp := range cover.covering(i) {
+				found = found || sp.v == pl.v && sp.pos == pl.pos
+			}
+			if !found {
+				t.Errorf("%s %s at %v is not listed for the cell %v it covers", p.name, v.orient, pl.pos, c.Add(pl.pos))
+			}
+		}
+	}
+	if len(t1.spots) != len(want) {
+		t.Errorf("%s: %d placements on the %dx%d board, want %d", p.name, len(t1.spots), b.rows, b.cols, len(want))
+	}
+}
+
+// TestSymmetricPieceNodes searches with tables holding every
+// transformation of the pieces, as before symmetric pieces were placed
+// once per distinct orientation, and checks that the tables of distinct
+// orientations find the same solutions once each, in fewer placements.
+func TestSymmetricPieceNodes(t *testing.T) {
+	var tests = []struct {
+		st     Strategy
+		puzzle func(testing.TB) (*Board, []Piece)
+		want   int
+	}{
+		// Placing the pieces in order takes long on larger puzzles.
+		{PieceOrder, miniPuzzle, 3},
+		{FirstEmptyCell, testPuzzle, 1708},
+	}
+	for _, test := range tests {
+		t.Run(test.st.String(), func(t *testing.T) {
+			var b, ps = test.puzzle(t)
+			// Each solution is found once per combination of the
+			// transformations giving the orientations of its pieces.
+			var copies = 1
+			for _, p := range ps {
+				copies *= len(tx) / len(p.Orientations())
+			}
+			if copies == 1 {
+				t.Fatal("no symmetric pieces in the puzzle")
+			}
+			var all = make([]pieceTable, len(ps))
+			for i, p := range ps {
+				for j := range tx {
+					var v = p.transform(tx[j])
+					v.orient = Orientation(j)
+					all[i].versions = append(all[i].versions, newVersion(b, v))
+				}
+				all[i].list(b, test.st)
+			}
+			var (
+				nodes, sols       = searchTables(t, b, test.st, precompute(b, ps, test.st))
+				allNodes, allSols = searchTables(t, b, test.st, all)
+			)
+			if len(sols) != test.want || len(allSols) != len(sols) {
+				t.Fatalf("%d and %d distinct solutions, want %d", len(sols), len(allSols), test.want)
+			}
+			for k, n := range sols {
+				if n != 1 || allSols[k] != copies {
+					t.Fatalf("solution %s found %d times, and %d times with every transformation, want 1 and %d", k, n, allSols[k], copies)
+				}
+			}
+			if nodes >= allNodes {
+				t.Errorf("%d placements, with every transformation %d", nodes, allNodes)
+			}
+		})
+	}
+}
+
+// searchTables searches the board with the tables, returning the number of
+// placements tried and how often each distinct solution was found.
+func searchTables(t *testing.T, b *Board, st Strategy, tables []pieceTable) (int64, map[string]int) {
+	t.Helper()
+	var (
+		g    = NewGame(b)
+		sols = make(map[string]int)
+		s    = &searcher{g: g, strategy: st, fn: func(ms []Move) bool {
+			sols[Solution(ms).Canonical()]++
+			return true
+		}}
+	)
+	g.prepare(tables)
+	if _, err := s.search(tables); err != nil {
+		t.Fatal(err)
+	}
+	return s.nodes, sols
+}
@@ -0,0 +1,317 @@
+package iqpuzzler
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// A piece file defines a set of pieces, one per line:
+//
+//	# comment
+//	name [letter] = expr
+//
+// where expr is one of
+//
+//	(x,y) (x,y) ...          the given cells
+//	base                     the cells of another piece
+//	transform(expr, T)       expr transformed by T, an Orientation name
+//	expr + (x,y)             expr with an additional cell
+//
+// Pieces may refer to each other in any order, and to the base pieces.
+// Letters that are not given are assigned automatically. Shapes may be drawn
+// in any orientation and at any offset; they are translated to the origin.
+
+type pieceDef struct {
+	name   string
+	letter byte
+	expr   string
+	line   int
+	// text is the line defining the piece and col the offset of expr in
+	// it, for error messages.
+	text string
+	col  int
+}
+
+// ReadPieceFile reads the pieces defined in the file at path. Definitions
+// may refer to the pieces of base by name.
+func ReadPieceFile(path string, base []Piece) ([]Piece, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	res, err := parsePieceDefs(f, base)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return res, nil
+}
+
+func parsePieceDefs(r io.Reader, base []Piece) ([]Piece, error) {
+	var (
+		defs   []pieceDef
+		byName = make(map[string]int)
+		sc     = bufio.NewScanner(r)
+		line   int
+	)
+	for sc.Scan() {
+		line++
+		var (
+			text = sc.Text()
+			l    = strings.TrimSpace(text)
+		)
+		if l == "" || strings.HasPrefix(l, "#") {
+			continue
+		}
+		var i = strings.IndexByte(text, '=')
+		if i < 0 {
+			return nil, &ParseError{Row: line, Line: text, Msg: `expected "name = definition"`}
+		}
+		var (
+			head  = strings.Fields(text[:i])
+			start = len(text) - len(strings.TrimLeft(text, " \t"))
+			expr  = strings.TrimLeft(text[i+1:], " \t")
+			def   = pieceDef{expr: strings.TrimRight(expr, " \t"), line: line, text: text, col: len(text) - len(expr)}
+		)
+		switch {
+		case len(head) == 1:
+		case len(head) == 2 && len(head[1]) == 1 && isPieceLetter(head[1][0]):
+			def.letter = head[1][0]
+		default:
+			return nil, &ParseError{Row: line, Col: start + 1, Char: text[start], Line: text, Msg: fmt.Sprintf("expected a name and an optional letter (A-Z except X), got %q", strings.TrimSpace(text[:i]))}
+		}
+		def.name = head[0]
+		if _, ok := byName[def.name]; ok {
+			return nil, &ParseError{Row: line, Col: start + 1, Char: text[start], Line: text, Msg: fmt.Sprintf("piece %q is defined twice", def.name)}
+		}
+		byName[def.name] = len(defs)
+		defs = append(defs, def)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	var e = &pieceEvaluator{defs: defs, base: base, byName: byName, done: make(map[string][]Pos)}
+	var res []Piece
+	for _, d := range defs {
+		pos, err := e.eval(d.name)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, Piece{name: d.name, letter: d.letter, pos: pos}.origin())
+	}
+	assignLetters(res)
+	return res, nil
+}
+
+func isPieceLetter(l byte) bool {
+	return l >= 'A' && l <= 'Z' && l != 'X'
+}
+
+// assignLetters gives every piece without a letter the first unused one.
+func assignLetters(ps []Piece) {
+	var used = make(map[byte]bool)
+	for _, p := range ps {
+		used[p.letter] = true
+	}
+	var next byte = 'A'
+	for i := range ps {
+		if ps[i].letter != 0 {
+			continue
+		}
+		for next <= 'Z' && (used[next] || !isPieceLetter(next)) {
+			next++
+		}
+		if next > 'Z' {
+			return
+		}
+		ps[i].letter = next
+		used[next] = true
+	}
+}
+
+type pieceEvaluator struct {
+	defs     []pieceDef
+	base     []Piece
+	byName   map[string]int
+	done     map[string][]Pos
+	visiting []string
+}
+
+// eval returns the cells of the named piece, resolving references to other
+// definitions first and detecting cycles.
+func (e *pieceEvaluator) eval(name string) ([]Pos, error) {
+	if pos, ok := e.done[name]; ok {
+		return pos, nil
+	}
+	i, ok := e.byName[name]
+	if !ok {
+		for _, p := range e.base {
+			if p.name == name {
+				return p.pos, nil
+			}
+		}
+		return nil, fmt.Errorf("unknown piece %q", name)
+	}
+	for j, v := range e.visiting {
+		if v == name {
+			return nil, fmt.Errorf("cyclic piece definition %s", strings.Join(append(e.visiting[j:], name), " -> "))
+		}
+	}
+	e.visiting = append(e.visiting, name)
+	var p = &exprParser{s: e.defs[i].expr, e: e}
+	pos, err := p.parse()
+	if err != nil {
+		var pe *ParseError
+		if errors.As(err, &pe) {
+			return nil, err
+		}
+		var d = e.defs[i]
+		pe = &ParseError{Row: d.line, Col: d.col + p.i + 1, Line: d.text, Msg: fmt.Sprintf("piece %q: %v", name, err)}
+		if p.i < len(p.s) {
+			pe.Char = p.s[p.i]
+		}
+		return nil, pe
+	}
+	e.visiting = e.visiting[:len(e.visiting)-1]
+	e.done[name] = pos
+	return pos, nil
+}
+
+type exprParser struct {
+	s string
+	i int
+	e *pieceEvaluator
+}
+
+func (p *exprParser) parse() ([]Pos, error) {
+	res, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	if p.skip(); p.i < len(p.s) {
+		return nil, fmt.Errorf("unexpected %q in %q", p.s[p.i:], p.s)
+	}
+	return res, nil
+}
+
+// expr := term { "+" cell }
+func (p *exprParser) expr() ([]Pos, error) {
+	res, err := p.term()
+	if err != nil {
+		return nil, err
+	}
+	for p.skip(); p.peek() == '+'; p.skip() {
+		p.i++
+		c, err := p.cell()
+		if err != nil {
+			return nil, err
+		}
+		res = append(append([]Pos(nil), res...), c)
+	}
+	return res, nil
+}
+
+// term := cell { cell } | "transform" "(" expr "," name ")" | name
+func (p *exprParser) term() ([]Pos, error) {
+	p.skip()
+	if p.peek() == '(' {
+		var res []Pos
+		for p.skip(); p.peek() == '('; p.skip() {
+			c, err := p.cell()
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, c)
+		}
+		return res, nil
+	}
+	var name = p.ident()
+	if name == "" {
+		return nil, fmt.Errorf("expected cells, a piece name or transform(...) at %q", p.s[p.i:])
+	}
+	if p.skip(); name != "transform" || p.peek() != '(' {
+		return p.e.eval(name)
+	}
+	p.i++
+	base, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(','); err != nil {
+		return nil, err
+	}
+	p.skip()
+	var t = p.ident()
+	if err := p.expect(')'); err != nil {
+		return nil, err
+	}
+	o, err := ParseOrientation(t)
+	if err != nil {
+		return nil, err
+	}
+	return o.Matrix().Apply(base), nil
+}
+
+// cell := "(" int "," int ")"
+func (p *exprParser) cell() (Pos, error) {
+	var res Pos
+	if err := p.expect('('); err != nil {
+		return res, err
+	}
+	for k := range res {
+		if k > 0 {
+			if err := p.expect(','); err != nil {
+				return res, err
+			}
+		}
+		p.skip()
+		var j = p.i
+		if p.peek() == '-' {
+			p.i++
+		}
+		for p.i < len(p.s) && p.s[p.i] >= '0' && p.s[p.i] <= '9' {
+			p.i++
+		}
+		n, err := strconv.Atoi(p.s[j:p.i])
+		if err != nil {
+			return res, fmt.Errorf("invalid coordinate %q", p.s[j:p.i])
+		}
+		res[k] = n
+	}
+	return res, p.expect(')')
+}
+
+func (p *exprParser) ident() string {
+	var j = p.i
+	for p.i < len(p.s) && (p.s[p.i] == '_' || p.s[p.i] == '-' ||
+		p.s[p.i] >= 'a' && p.s[p.i] <= 'z' || p.s[p.i] >= 'A' && p.s[p.i] <= 'Z' || p.s[p.i] >= '0' && p.s[p.i] <= '9') {
+		p.i++
+	}
+	return p.s[j:p.i]
+}
+
+func (p *exprParser) expect(c byte) error {
+	if p.skip(); p.peek() != c {
+		return fmt.Errorf("expected %q at %q", c, p.s[p.i:])
+	}
+	p.i++
+	return nil
+}
+
+func (p *exprParser) skip() {
+	for p.i < len(p.s) && (p.s[p.i] == ' ' || p.s[p.i] == '\t') {
+		p.i++
+	}
+}
+
+func (p *exprParser) peek() byte {
+	if p.i < len(p.s) {
+		return p.s[p.i]
+	}
+	return 0
+}
@@ -0,0 +1,57 @@
+package iqpuzzler
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParsePieceDefs(t *testing.T) {
+	const defs = `# pieces derived from a corner, defined after them
+hook = corner + (2,0)
+corner C = (0,0) (0,1) (1,0)
+mirrored = transform(corner, M)
+tee = turquoise + (0,2)
+`
+	got, err := parsePieceDefs(strings.NewReader(defs), standardPieces)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want = []Piece{
+		{name: "hook", letter: 'A', pos: []Pos{{0, 0}, {0, 1}, {1, 0}, {2, 0}}},
+		{name: "corner", letter: 'C', pos: []Pos{{0, 0}, {0, 1}, {1, 0}}},
+		{name: "mirrored", letter: 'B', pos: []Pos{{0, 1}, {0, 0}, {1, 1}}},
+		{name: "tee", letter: 'D', pos: []Pos{{0, 0}, {0, 1}, {1, 0}, {0, 2}}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got the pieces %v, want %v", got, want)
+	}
+}
+
+func TestParsePieceDefsErrors(t *testing.T) {
+	var tests = []struct {
+		name, defs string
+		// row is the line of the error and want the start of its message.
+		row  int
+		want string
+	}{
+		{"cycle", "a = b + (0,1)\nb = a", 2, `piece "b": cyclic piece definition a -> b -> a`},
+		{"self", "a = transform(a, M)", 1, `piece "a": cyclic piece definition a -> a`},
+		{"long cycle", "a = (0,0)\nb = c\nc = d\nd = transform(b, R90)", 4, `piece "d": cyclic piece definition b -> c -> d -> b`},
+		{"dangling", "a = missing + (0,1)", 1, `piece "a": unknown piece "missing"`},
+		{"dangling through another", "a = b\nb = transform(missing, M)", 2, `piece "b": unknown piece "missing"`},
+		{"unknown transformation", "a = transform((0,0) (0,1), R45)", 1, `piece "a": unknown orientation "R45"`},
+		{"twice", "a = (0,0)\na = (0,1)", 2, `piece "a" is defined twice`},
+		{"no definition", "a (0,0)", 1, `expected "name = definition"`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := parsePieceDefs(strings.NewReader(test.defs), standardPieces)
+			var pe *ParseError
+			if !errors.As(err, &pe) || pe.Row != test.row || !strings.HasPrefix(pe.Msg, test.want) {
+				t.Errorf("got %v, want %q at row %d", err, test.want, test.row)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package iqpuzzler
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Pipeline passes the solutions of a search on to a slow sink, one
+// rendering or storing each, without holding up the search. Its Add method
+// is an OnSolution hook: it numbers the solutions in the order they are
+// found and queues them for a pool of goroutines, which turn each into bytes
+// with the work function, and the bytes are handed to the sink in the order
+// of the solutions, from one goroutine. No more than depth solutions are
+// queued, worked on or waiting for those before them, and Add waits while
+// there are, so that the memory taken stays the same however far the sink
+// falls behind.
+//
+// Once work or the sink fails, the solutions after it are dropped, and
+// Close returns the error.
+type Pipeline struct {
+	work func(Solution) ([]byte, error)
+	sink func([]byte) error
+
+	jobs    chan pipelineJob
+	results chan pipelineJob
+	// slots holds a value for each solution queued and not yet sunk.
+	slots   chan struct{}
+	workers sync.WaitGroup
+	written chan struct{}
+	next    int64
+	stalled atomic.Int64
+	failed  atomic.Bool
+	err     error
+}
+
+// pipelineJob is a solution of a Pipeline with its number, and then the
+// bytes work turned it into.
+type pipelineJob struct {
+	seq  int64
+	sol  Solution
+	data []byte
+	err  error
+}
+
+// NewPipeline starts a pipeline with the number of workers, at least one,
+// holding up to depth solutions, at least as many as there are workers.
+func NewPipeline(workers, depth int, work func(Solution) ([]byte, error), sink func([]byte) error) *Pipeline {
+	workers = max(workers, 1)
+	depth = max(depth, workers)
+	var p = &Pipeline{
+		work:    work,
+		sink:    sink,
+		jobs:    make(chan pipelineJob, depth),
+		results: make(chan pipelineJob, depth),
+		slots:   make(chan struct{}, depth),
+		written: make(chan struct{}),
+	}
+	for range workers {
+		p.workers.Add(1)
+		go func() {
+			defer p.workers.Done()
+			for j := range p.jobs {
+				if !p.failed.Load() {
+					j.data, j.err = p.work(j.sol)
+				}
+				j.sol = nil
+				p.results <- j
+			}
+		}()
+	}
+	go p.write()
+	return p
+}
+
+// write hands the bytes of the solutions to the sink in order, keeping
+// those which come early until the ones before them are done.
+func (p *Pipeline) write() {
+	defer close(p.written)
+	var (
+		early = make(map[int64]pipelineJob)
+		next  int64
+	)
+	for j := range p.results {
+		early[j.seq] = j
+		for {
+			j, ok := early[next]
+			if !ok {
+				break
+			}
+			delete(early, next)
+			next++
+			if p.err == nil {
+				if p.err = j.err; p.err == nil {
+					p.err = p.sink(j.data)
+				}
+				if p.err != nil {
+					p.failed.Store(true)
+				}
+			}
+			<-p.slots
+		}
+	}
+}
+
+// Add queues the solution, waiting while the pipeline holds as many as it
+// can. It must not be called concurrently nor after Close.
+func (p *Pipeline) Add(sol Solution) {
+	select {
+	case p.slots <- struct{}{}:
+	default:
+		var start = time.Now()
+		p.slots <- struct{}{}
+		p.stalled.Add(int64(time.Since(start)))
+	}
+	p.jobs <- pipelineJob{seq: p.next, sol: sol}
+	p.next++
+}
+
+// Stalled returns how long Add has waited for the sink so far.
+func (p *Pipeline) Stalled() time.Duration {
+	return time.Duration(p.stalled.Load())
+}
+
+// Close waits until the sink has the solutions added, and returns the
+// first error of work or the sink.
+func (p *Pipeline) Close() error {
+	close(p.jobs)
+	p.workers.Wait()
+	close(p.results)
+	<-p.written
+	return p.err
+}
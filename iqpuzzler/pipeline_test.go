@@ -0,0 +1,88 @@
+package iqpuzzler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"runtime"
+	"testing"
+)
+
+// TestPipeline enumerates the first 1000 solutions of the empty standard
+// board through a Pipeline of 4 workers holding up to 8 of them, which
+// yield the processor a different number of times for each so that they
+// finish out of order, and checks that the sink gets the lines of the
+// solution file of every one in the order found and that the pipeline
+// never holds more than 8.
+func TestPipeline(t *testing.T) {
+	const workers, depth = 4, 8
+	var (
+		b, ps = standardPuzzle()
+		want  [][]byte
+		got   [][]byte
+		held  int
+		pl    = NewPipeline(workers, depth, func(sol Solution) ([]byte, error) {
+			for range len(sol[0].Piece.pos) * 3 {
+				runtime.Gosched()
+			}
+			return EncodeSolution(sol)
+		}, func(line []byte) error {
+			got = append(got, line)
+			return nil
+		})
+	)
+	_, err := dfs{FirstEmptyCell}.Solve(context.Background(), b, ps, Options{MaxSolutions: 1000, Hooks: Hooks{OnSolution: func(sol Solution) {
+		line, err := EncodeSolution(sol)
+		if err != nil {
+			t.Error(err)
+		}
+		want = append(want, line)
+		pl.Add(sol)
+		held = max(held, len(pl.slots))
+	}}})
+	if err = errors.Join(err, pl.Close()); err != nil {
+		t.Fatal(err)
+	}
+	switch {
+	case len(got) != len(want):
+		t.Fatalf("the sink got %d solutions, want %d", len(got), len(want))
+	case held > depth:
+		t.Errorf("the pipeline held %d solutions, more than %d", held, depth)
+	}
+	for i := range want {
+		if !bytes.Equal(got[i], want[i]) {
+			t.Fatalf("the sink got solution %d out of order", i+1)
+		}
+	}
+}
+
+// TestPipelineError checks that once work fails the sink gets nothing
+// more, and that Close returns the error.
+func TestPipelineError(t *testing.T) {
+	var (
+		errWork = errors.New("work failed")
+		sunk    int
+		pl      = NewPipeline(4, 8, func(sol Solution) ([]byte, error) {
+			if len(sol) == 0 {
+				return nil, errWork
+			}
+			return nil, nil
+		}, func([]byte) error {
+			sunk++
+			return nil
+		})
+	)
+	for i := range 100 {
+		if i == 50 {
+			pl.Add(nil)
+		} else {
+			pl.Add(Solution{Move{}})
+		}
+	}
+	switch err := pl.Close(); {
+	case err != errWork:
+		t.Errorf("Close returned %v, want %v", err, errWork)
+	case sunk != 50:
+		t.Errorf("the sink of a pipeline failing at solution 51 got %d solutions", sunk)
+	}
+}
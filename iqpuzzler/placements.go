@@ -0,0 +1,76 @@
+package iqpuzzler
+
+// PlacementIndex indexes the placements of a set of pieces on a board by
+// the cells they cover, so that the placements covering a cell can be found
+// without trying every position and orientation. It is built once for a
+// board and may then be queried for any game on that board, from several
+// goroutines at once.
+type PlacementIndex struct {
+	board  *Board
+	pieces []Piece
+	// tables index the placements of the distinct orientations of each
+	// piece by the cells they cover, as the first-empty-cell search does.
+	tables []pieceTable
+}
+
+// PiecePlacements are the placements of one piece.
+type PiecePlacements struct {
+	Piece Piece
+	Moves []Move
+}
+
+// NewPlacementIndex returns an index of the legal moves of the pieces on
+// the board in each of their distinct orientations.
+func NewPlacementIndex(b *Board, ps []Piece) *PlacementIndex {
+	var ix = &PlacementIndex{board: b, pieces: append([]Piece(nil), ps...)}
+	for _, p := range ps {
+		var t pieceTable
+		for _, o := range p.Orientations() {
+			t.versions = append(t.versions, newVersion(b, o.Piece))
+		}
+		t.index(b)
+		ix.tables = append(ix.tables, t)
+	}
+	return ix
+}
+
+// Covering returns the placements of the pieces which are not yet placed in
+// g, matched by name, that cover the cell and fit in g's current state. They
+// are grouped by piece in the order of the index, leaving out pieces which
+// have none. g must be played on the board of the index.
+func (ix *PlacementIndex) Covering(g *Game, cell Pos) []PiecePlacements {
+	if g.board != ix.board {
+		panic("iqpuzzler: PlacementIndex used with a game on another board")
+	}
+	if !ix.board.inBounds(cell) || g.occupied(cell) {
+		return nil
+	}
+	var placed = make(map[string]bool, g.numMoves())
+	for i := range g.numMoves() {
+		placed[g.moveAt(i).Piece.name] = true
+	}
+	var res []PiecePlacements
+	for i := range ix.tables {
+		if placed[ix.pieces[i].name] {
+			continue
+		}
+		var pp = PiecePlacements{Piece: ix.pieces[i]}
+		for _, sp := range ix.tables[i].covering(ix.board.bit(cell)) {
+			if v, mask := ix.tables[i].spot(sp); !g.occ.overlaps(mask) {
+				pp.Moves = append(pp.Moves, g.move(v.piece, sp.pos))
+			}
+		}
+		if len(pp.Moves) > 0 {
+			res = append(res, pp)
+		}
+	}
+	return res
+}
+
+// PlacementsCovering returns the placements of the pieces which are not yet
+// placed that cover the cell, grouped by piece. It builds a PlacementIndex
+// for the game's board; callers asking repeatedly should build one
+// themselves and use its Covering method.
+func (g *Game) PlacementsCovering(ps []Piece, cell Pos) []PiecePlacements {
+	return NewPlacementIndex(g.board, ps).Covering(g, cell)
+}
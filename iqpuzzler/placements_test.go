@@ -0,0 +1,76 @@
+package iqpuzzler
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestPlacementsCovering checks for every cell of a game in progress that
+// the placements covering it are the legal moves of the pieces not yet
+// placed which cover the cell, grouped by piece in the given order.
+func TestPlacementsCovering(t *testing.T) {
+	var b, ps = testPuzzle(t)
+	var g = NewGame(b)
+	if err := g.Play(ps[0], g.LegalMoves(ps[0])[0].Translate); err != nil {
+		t.Fatal(err)
+	}
+	var ix = NewPlacementIndex(b, ps)
+	for x := 0; x < b.rows; x++ {
+		for y := 0; y < b.cols; y++ {
+			var (
+				cell = Pos{x, y}
+				got  = ix.Covering(g, cell)
+				want []PiecePlacements
+			)
+			for _, p := range ps[1:] {
+				var pp = PiecePlacements{Piece: p}
+				for _, m := range g.LegalMoves(p) {
+					if covers(m, cell) {
+						pp.Moves = append(pp.Moves, m)
+					}
+				}
+				if len(pp.Moves) > 0 {
+					want = append(want, pp)
+				}
+			}
+			if len(got) != len(want) {
+				t.Fatalf("%v: got placements of %d pieces, want %d", cell, len(got), len(want))
+			}
+			for i := range want {
+				if got[i].Piece.name != want[i].Piece.name {
+					t.Fatalf("%v: piece %d is %s, want %s", cell, i, got[i].Piece.name, want[i].Piece.name)
+				}
+				if gk, wk := moveKeys(t, got[i].Moves), moveKeys(t, want[i].Moves); !reflect.DeepEqual(gk, wk) {
+					t.Errorf("%v: the placements of %s are\n%v\nwant\n%v", cell, want[i].Piece.name, gk, wk)
+				}
+			}
+			if pc := g.PlacementsCovering(ps, cell); !reflect.DeepEqual(pc, got) {
+				t.Errorf("%v: PlacementsCovering differs from Covering", cell)
+			}
+		}
+	}
+}
+
+// covers reports whether the move covers the cell.
+func covers(m Move, cell Pos) bool {
+	for _, c := range m.Image() {
+		if c == cell {
+			return true
+		}
+	}
+	return false
+}
+
+func BenchmarkPlacementsCovering(b *testing.B) {
+	var board, ps = testPuzzle(b)
+	var g = NewGame(board)
+	var ix = NewPlacementIndex(board, ps)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for x := 0; x < board.rows; x++ {
+			for y := 0; y < board.cols; y++ {
+				ix.Covering(g, Pos{x, y})
+			}
+		}
+	}
+}
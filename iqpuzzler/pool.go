@@ -0,0 +1,75 @@
+package iqpuzzler
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// gamePool reuses the copies of a game the workers of a parallel search
+// make for their tasks, so that their moves, masks and occupancy are
+// allocated once per worker rather than once per task.
+type gamePool struct {
+	pool sync.Pool
+	// check verifies every game handed out, see checkCopy.
+	check bool
+}
+
+// get returns a copy of src, reusing a game put back if there is one.
+func (p *gamePool) get(src *Game) *Game {
+	var g, _ = p.pool.Get().(*Game)
+	if g == nil {
+		return src.Clone()
+	}
+	g.reset(src)
+	if p.check {
+		if err := g.checkCopy(src); err != nil {
+			panic(fmt.Sprintf("iqpuzzler: reused game: %v", err))
+		}
+	}
+	return g
+}
+
+// put hands a game back for reuse. The caller must not use it afterwards.
+func (p *gamePool) put(g *Game) {
+	p.pool.Put(g)
+}
+
+// checkCopy checks that g is a valid game in the same state as src.
+func (g *Game) checkCopy(src *Game) error {
+	switch {
+	case g.board != src.board:
+		return fmt.Errorf("board differs")
+	case len(g.moves) != len(src.moves) || len(g.placed) != len(src.placed) || len(g.redo) != len(src.redo):
+		return fmt.Errorf("%d moves and %d to redo, want %d and %d", g.numMoves(), len(g.redo), src.numMoves(), len(src.redo))
+	case !slices.Equal(g.placed, src.placed) || g.ids != src.ids:
+		return fmt.Errorf("placements of the moves differ")
+	case g.count != src.count || !slices.Equal(g.occ, src.occ):
+		return fmt.Errorf("%d occupied cells differ from the %d of the copied game", g.count, src.count)
+	case !slices.Equal(g.masks, src.masks):
+		return fmt.Errorf("masks of the moves differ")
+	}
+	return g.Validate()
+}
+
+// solutionSlab is the number of solutions a moveSlab makes room for at
+// once.
+const solutionSlab = 64
+
+// moveSlab hands out the copies of the moves of the solutions a worker
+// finds from larger blocks, so that enumerating allocates once every
+// solutionSlab solutions instead of once each.
+type moveSlab struct {
+	buf []Move
+}
+
+// take returns a slice of n moves with no room to grow into the moves of
+// another solution.
+func (s *moveSlab) take(n int) []Move {
+	if len(s.buf) < n {
+		s.buf = make([]Move, n*solutionSlab)
+	}
+	var res = s.buf[:n:n]
+	s.buf = s.buf[n:]
+	return res
+}
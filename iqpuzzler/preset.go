@@ -0,0 +1,92 @@
+package iqpuzzler
+
+import (
+	"os"
+	"sort"
+	"strings"
+)
+
+// Preset describes the geometry of a board.
+type Preset struct {
+	Description string
+	Rows, Cols  int
+	// Layout is a board string marking the cells which are not part of the
+	// board with '#'. It is empty for rectangular boards.
+	Layout string
+	// Set is the piece set used on the board unless another one is chosen.
+	Set string
+}
+
+// presets contains the built-in board presets by name.
+var presets = map[string]Preset{
+	"standard":       {"the 5x11 board of IQ Puzzler Pro", 5, 11, "", "iq-puzzler"},
+	"mini":           {"a 4x5 board for quick experiments", 4, 5, "", "iq-puzzler"},
+	"pyramid-layer1": {"the 5x5 bottom layer of the pyramid", 5, 5, "", "iq-puzzler"},
+	"pentomino-6x10": {"the 6x10 pentomino rectangle", 6, 10, "", "pentomino"},
+	"pentomino-5x12": {"the 5x12 pentomino rectangle", 5, 12, "", "pentomino"},
+	"pentomino-4x15": {"the 4x15 pentomino rectangle", 4, 15, "", "pentomino"},
+	"pentomino-3x20": {"the 3x20 pentomino rectangle", 3, 20, "", "pentomino"},
+	"pentomino-8x8": {"the 8x8 pentomino square with a hole in the center", 8, 8,
+		"........,........,........,...##...,...##...,........,........,........", "pentomino"},
+}
+
+// LookupPreset returns the built-in board preset with the given name.
+func LookupPreset(name string) (Preset, bool) {
+	p, ok := presets[name]
+	return p, ok
+}
+
+// PresetNames returns the names of the built-in board presets in order.
+func PresetNames() []string {
+	var res []string
+	for n := range presets {
+		res = append(res, n)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// Board returns the board string of the empty board.
+func (p Preset) Board() string {
+	if p.Layout != "" {
+		return p.Layout
+	}
+	var rows = make([]string, p.Rows)
+	for i := range rows {
+		rows[i] = strings.Repeat(".", p.Cols)
+	}
+	return strings.Join(rows, ",")
+}
+
+// Block returns a copy of the board with the cells which are not part of
+// the preset's board blocked.
+func (p Preset) Block(b *Board) *Board {
+	var c = b.clone()
+	if p.Layout == "" {
+		return c
+	}
+	for x, row := range strings.Split(p.Layout, ",") {
+		for y := 0; y < len(row); y++ {
+			if row[y] == '#' {
+				c.block(x, y)
+			}
+		}
+	}
+	return c
+}
+
+// ReadBoardFile reads a board from a file with one row per line. Blank lines
+// are ignored.
+func ReadBoardFile(path string) (string, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	var rows []string
+	for _, l := range strings.Split(string(b), "\n") {
+		if l = strings.TrimSpace(l); l != "" {
+			rows = append(rows, l)
+		}
+	}
+	return strings.Join(rows, ","), nil
+}
@@ -0,0 +1,7 @@
+//go:build race
+
+package iqpuzzler
+
+// raceEnabled is set in builds with the race detector, which check the
+// invariants of the games the parallel search reuses.
+const raceEnabled = true
@@ -0,0 +1,162 @@
+package iqpuzzler
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"strings"
+)
+
+// RecordCodec encodes the solutions of a puzzle as records of a fixed
+// size, for storing millions of them: for each piece, in the order of their
+// names, the index of its placement among those of the piece on the empty
+// board, in one byte if no piece has more than 256 placements and in two
+// otherwise. The indices only mean something for the placements this
+// solver lists for the board and pieces, which change with the dimensions
+// of the board, the shapes of the pieces and the way versions of the solver
+// order them; Hash identifies them, and a record must be decoded by a codec
+// with the hash of the one which encoded it.
+type RecordCodec struct {
+	board  *Board
+	pieces []Piece
+	tables []pieceTable
+	// index holds the placements of each piece by the words of their
+	// masks, as bytes.
+	index []map[string]int
+	width int
+	hash  string
+}
+
+// NewRecordCodec returns the codec of the solutions of the pieces on the
+// board. Only the dimensions of the board count, not its occupied cells.
+func NewRecordCodec(b *Board, ps []Piece) (*RecordCodec, error) {
+	var c = &RecordCodec{board: b, pieces: slices.Clone(ps), width: 1}
+	slices.SortFunc(c.pieces, func(a, b Piece) int { return strings.Compare(a.name, b.name) })
+	for i := 1; i < len(c.pieces); i++ {
+		if c.pieces[i].name == c.pieces[i-1].name {
+			return nil, fmt.Errorf("piece %s given twice", c.pieces[i].name)
+		}
+	}
+	c.tables = precompute(b, c.pieces, PieceOrder)
+	var (
+		h   = fnv.New64a()
+		key []byte
+	)
+	fmt.Fprintf(h, "%dx%d wrap=%t", b.rows, b.cols, b.wrap)
+	for i := range c.tables {
+		var t = &c.tables[i]
+		switch {
+		case t.n > 1<<16:
+			return nil, fmt.Errorf("piece %s has %d placements, more than a record holds", c.pieces[i].name, t.n)
+		case t.n > 1<<8:
+			c.width = 2
+		}
+		fmt.Fprintf(h, " %s:", c.pieces[i].name)
+		var index = make(map[string]int, t.n)
+		for j, sp := range t.spots {
+			var _, mask = t.spot(sp)
+			key = appendMaskKey(key[:0], mask)
+			index[string(key)] = j
+			h.Write(key)
+		}
+		c.index = append(c.index, index)
+	}
+	c.hash = hex.EncodeToString(h.Sum(nil))
+	return c, nil
+}
+
+// appendMaskKey appends the words of the mask to dst, as bytes.
+func appendMaskKey(dst []byte, mask bitboard) []byte {
+	for _, w := range mask {
+		dst = binary.LittleEndian.AppendUint64(dst, w)
+	}
+	return dst
+}
+
+// Size returns the size of a record in bytes.
+func (c *RecordCodec) Size() int {
+	return c.width * len(c.pieces)
+}
+
+// Hash returns the hash of the placements the records index: the dimensions
+// of the board, and the names of the pieces with the cells of each of their
+// placements in order.
+func (c *RecordCodec) Hash() string {
+	return c.hash
+}
+
+// Append appends the record of the solution to dst. The solution must place
+// each piece of the codec once, in any order.
+func (c *RecordCodec) Append(dst []byte, sol Solution) ([]byte, error) {
+	if len(sol) != len(c.pieces) {
+		return dst, fmt.Errorf("solution has %d moves, want %d", len(sol), len(c.pieces))
+	}
+	var (
+		rec  = make([]int, len(c.pieces))
+		mask = make(bitboard, c.board.words())
+		key  []byte
+	)
+	for i := range rec {
+		rec[i] = -1
+	}
+	for _, m := range sol {
+		var i, ok = slices.BinarySearchFunc(c.pieces, m.Piece.name, func(p Piece, name string) int { return strings.Compare(p.name, name) })
+		switch {
+		case !ok:
+			return dst, fmt.Errorf("solution uses unknown piece %s", m.Piece.name)
+		case rec[i] >= 0:
+			return dst, fmt.Errorf("solution uses piece %s twice", m.Piece.name)
+		}
+		clear(mask)
+		for _, p := range m.Image() {
+			if !c.board.inBounds(p) {
+				return dst, fmt.Errorf("move of %s covers %v outside the board", m.Piece.name, p)
+			}
+			mask.set(c.board.bit(p))
+		}
+		key = appendMaskKey(key[:0], mask)
+		if rec[i], ok = c.index[i][string(key)]; !ok {
+			return dst, fmt.Errorf("move of %s is none of its placements", m.Piece.name)
+		}
+	}
+	for _, j := range rec {
+		if c.width == 1 {
+			dst = append(dst, byte(j))
+		} else {
+			dst = binary.LittleEndian.AppendUint16(dst, uint16(j))
+		}
+	}
+	return dst, nil
+}
+
+// Decode returns the solution of the record, with the moves in the order of
+// the names of their pieces.
+func (c *RecordCodec) Decode(rec []byte) (Solution, error) {
+	if len(rec) != c.Size() {
+		return nil, fmt.Errorf("record of %d bytes, want %d", len(rec), c.Size())
+	}
+	var sol = make(Solution, len(c.pieces))
+	for i := range c.tables {
+		var j int
+		if c.width == 1 {
+			j = int(rec[i])
+		} else {
+			j = int(binary.LittleEndian.Uint16(rec[2*i:]))
+		}
+		var t = &c.tables[i]
+		if j >= len(t.spots) {
+			return nil, fmt.Errorf("placement %d of piece %s, which has %d", j, c.pieces[i].name, len(t.spots))
+		}
+		var (
+			sp   = t.spots[j]
+			move = Move{Piece: t.versions[sp.v].piece, Translate: sp.pos}
+		)
+		if c.board.wrap {
+			move.wrap = Pos{c.board.rows, c.board.cols}
+		}
+		sol[i] = move
+	}
+	return sol, nil
+}
@@ -0,0 +1,144 @@
+package iqpuzzler
+
+import (
+	"context"
+	"testing"
+)
+
+// TestRecordRoundTrip encodes the first 2000 solutions of the empty
+// standard board, and of the pentomino 6x10 rectangle wrapped around, as
+// records and checks that each decodes to a solution of the board with the
+// same hash, and that distinct solutions have distinct records.
+func TestRecordRoundTrip(t *testing.T) {
+	const n = 2000
+	for _, test := range []struct {
+		preset string
+		size   int
+	}{
+		{"standard", 24},
+		{"pentomino-6x10", 24},
+	} {
+		t.Run(test.preset, func(t *testing.T) {
+			var (
+				p  = presets[test.preset]
+				b  = NewBoard(p.Rows, p.Cols).WithWrap(test.preset != "standard")
+				ps = pieceSets[p.Set].Pieces
+			)
+			c, err := NewRecordCodec(b, ps)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if c.Size() != test.size {
+				t.Errorf("records of %d bytes, want %d", c.Size(), test.size)
+			}
+			var (
+				seen  = make(map[string]bool)
+				count int
+				rec   []byte
+			)
+			s, err := NewSolver(WithEngine(stackEngine{}), WithMaxSolutions(n), WithOnSolution(func(sol Solution) {
+				count++
+				if t.Failed() {
+					return
+				}
+				var err error
+				if rec, err = c.Append(rec[:0], sol); err != nil {
+					t.Errorf("solution %d: %v", count, err)
+					return
+				}
+				if seen[string(rec)] {
+					t.Errorf("solution %d: record %x taken by another", count, rec)
+				}
+				seen[string(rec)] = true
+				dec, err := c.Decode(rec)
+				if err != nil {
+					t.Errorf("solution %d: %v", count, err)
+					return
+				}
+				if verr := dec.Validate(b); verr != nil || dec.Hash() != sol.Hash() {
+					t.Errorf("solution %d: record %x decodes to another solution: %v", count, rec, verr)
+				}
+			}))
+			if err != nil {
+				t.Fatal(err)
+			}
+			res, err := s.Solve(context.Background(), NewGame(b), ps)
+			switch {
+			case err != nil:
+				t.Fatal(err)
+			case res.Count != n:
+				t.Fatalf("found %d solutions, want %d", res.Count, n)
+			}
+		})
+	}
+}
+
+func TestRecordErrors(t *testing.T) {
+	var b, ps = miniPuzzle(t)
+	c, err := NewRecordCodec(b, ps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sol = testSolutions(t, b, ps, 1)[0]
+	rec, err := c.Append(nil, sol)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var others, _ = ParseAvailable("orange", standardPieces)
+	var appends = []struct {
+		name string
+		sol  Solution
+	}{
+		{"missing move", sol[1:]},
+		{"piece twice", append(Solution{sol[0]}, sol[:len(sol)-1]...)},
+		{"unknown piece", append(Solution{{Piece: others[0]}}, sol[1:]...)},
+		{"outside the board", append(Solution{{Piece: sol[0].Piece, Translate: Pos{10, 10}}}, sol[1:]...)},
+	}
+	for _, test := range appends {
+		if _, err := c.Append(nil, test.sol); err == nil {
+			t.Errorf("Append of a solution with a %s succeeded", test.name)
+		}
+	}
+	var decodes = []struct {
+		name string
+		rec  []byte
+	}{
+		{"short", rec[1:]},
+		{"long", append(rec[:len(rec):len(rec)], 0)},
+		{"placement out of range", append([]byte{255}, rec[1:]...)},
+	}
+	for _, test := range decodes {
+		if _, err := c.Decode(test.rec); err == nil {
+			t.Errorf("Decode of a %s record succeeded", test.name)
+		}
+	}
+}
+
+// TestRecordHash checks that the hash of a codec tells the puzzles whose
+// records index other placements apart.
+func TestRecordHash(t *testing.T) {
+	var hash = func(b *Board, ps []Piece) string {
+		t.Helper()
+		c, err := NewRecordCodec(b, ps)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c.Hash()
+	}
+	var (
+		b, ps = miniPuzzle(t)
+		want  = hash(b, ps)
+	)
+	if got := hash(b, ps); got != want {
+		t.Errorf("hash %s, then %s", want, got)
+	}
+	if got := hash(NewBoard(5, 4), ps); got == want {
+		t.Error("the transposed board has the same hash")
+	}
+	if got := hash(b, ps[1:]); got == want {
+		t.Error("fewer pieces have the same hash")
+	}
+	if got := hash(b.WithWrap(true), ps); got == want {
+		t.Error("the wrapped board has the same hash")
+	}
+}
@@ -0,0 +1,122 @@
+package iqpuzzler
+
+import "fmt"
+
+// Registry is a collection of pieces with distinct names, letters and
+// shapes, indexed for lookups. Parsers and commands working with a piece set
+// take a registry, so that independent sets do not interfere.
+type Registry struct {
+	pieces   []Piece
+	byName   map[string]int
+	byLetter map[byte]int
+	byShape  map[string]int
+}
+
+// DefaultRegistry holds the pieces of the IQ Puzzler set.
+var DefaultRegistry = mustRegistry(pieceSets["iq-puzzler"].Pieces...)
+
+// NewRegistry returns a registry with the given pieces, registered in order.
+func NewRegistry(ps ...Piece) (*Registry, error) {
+	var r = &Registry{
+		byName:   make(map[string]int),
+		byLetter: make(map[byte]int),
+		byShape:  make(map[string]int),
+	}
+	for _, p := range ps {
+		if err := r.Register(p); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+func mustRegistry(ps ...Piece) *Registry {
+	r, err := NewRegistry(ps...)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// Register adds the piece. It fails if the piece is empty, or if a piece
+// with the same name, letter or shape under the transformations in tx is
+// registered already. Pieces without a letter may share it.
+func (r *Registry) Register(p Piece) error {
+	if p.name == "" {
+		return fmt.Errorf("piece without a name")
+	}
+	if len(p.pos) == 0 {
+		return fmt.Errorf("piece %q is empty", p.name)
+	}
+	if _, ok := r.byName[p.name]; ok {
+		return fmt.Errorf("duplicate piece name %q", p.name)
+	}
+	if i, ok := r.byLetter[p.letter]; ok && p.letter != 0 {
+		return fmt.Errorf("pieces %q and %q have the same letter %c", r.pieces[i].name, p.name, p.letter)
+	}
+	var key = shapeKey(p.CanonicalForm())
+	if i, ok := r.byShape[key]; ok {
+		return fmt.Errorf("pieces %q and %q have the same shape", r.pieces[i].name, p.name)
+	}
+	var i = len(r.pieces)
+	r.pieces = append(r.pieces, Piece{name: p.name, letter: p.letter, pos: append([]Pos(nil), p.pos...), sym: p.sym})
+	r.byName[p.name] = i
+	if p.letter != 0 {
+		r.byLetter[p.letter] = i
+	}
+	r.byShape[key] = i
+	return nil
+}
+
+// Lookup finds a piece by name like LookupPiece.
+func (r *Registry) Lookup(name string) (Piece, error) {
+	if i, ok := r.byName[name]; ok {
+		return r.pieces[i], nil
+	}
+	return LookupPiece(r.pieces, name)
+}
+
+// ByLetter returns the piece marked with the given letter. Letters are
+// matched case-insensitively.
+func (r *Registry) ByLetter(l rune) (Piece, bool) {
+	if l >= 'a' && l <= 'z' {
+		l -= 'a' - 'A'
+	}
+	if l <= 0 || l > 0xff {
+		return Piece{}, false
+	}
+	i, ok := r.byLetter[byte(l)]
+	if !ok {
+		return Piece{}, false
+	}
+	return r.pieces[i], true
+}
+
+// List returns the pieces in the order they were registered.
+func (r *Registry) List() []Piece {
+	return append([]Piece(nil), r.pieces...)
+}
+
+// Len returns the number of pieces.
+func (r *Registry) Len() int {
+	return len(r.pieces)
+}
+
+// ParseBoard is like the function ParseBoard, with the piece letters of r.
+func (r *Registry) ParseBoard(b string, dimX, dimY int, lenient bool) (*Board, error) {
+	return parseBoard(b, dimX, dimY, func(l byte) (byte, bool) {
+		p, ok := r.ByLetter(rune(l))
+		return p.letter, ok
+	}, lenient)
+}
+
+// ParseAvailable is like the function ParseAvailable, looking the names up
+// in r.
+func (r *Registry) ParseAvailable(a string) ([]Piece, error) {
+	return ParseAvailable(a, r.pieces)
+}
+
+// Unplaced returns the pieces of r whose letters do not appear on the board.
+func (r *Registry) Unplaced(b *Board) []Piece {
+	return Unplaced(b, r.pieces)
+}
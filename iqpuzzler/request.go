@@ -0,0 +1,257 @@
+package iqpuzzler
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// SolveRequest describes a search: the puzzle and the solver settings. It
+// is what the command line, the HTTP API and the engine protocol translate
+// their input into.
+type SolveRequest struct {
+	// Preset names the board geometry, "standard" if empty.
+	Preset string `json:"preset,omitempty"`
+	// Board is a board string in plain or compact form. If empty, the board
+	// of the preset is empty.
+	Board   string `json:"board,omitempty"`
+	Lenient bool   `json:"lenient,omitempty"`
+	Wrap    bool   `json:"wrap,omitempty"`
+	// Region restricts the board to a rectangle, see Board.ParseRegion.
+	Region string `json:"region,omitempty"`
+	// Set names the built-in piece set, the preset's if empty.
+	Set string `json:"set,omitempty"`
+	// Pieces names the pieces to place. If empty, all pieces of the set
+	// whose letters are not on the board are placed.
+	Pieces []string `json:"pieces,omitempty"`
+
+	MaxSolutions int `json:"max_solutions,omitempty"`
+	// Timeout is a duration as accepted by time.ParseDuration.
+	Timeout string `json:"timeout,omitempty"`
+	// MaxNodes limits the number of placements tried.
+	MaxNodes    int64 `json:"max_nodes,omitempty"`
+	Parallelism int   `json:"parallelism,omitempty"`
+	// ParDepth is the deepest placement parallel workers share, 0 for
+	// the default and -1 to pick it for the puzzle; see Options.ParDepth.
+	ParDepth int `json:"par_depth,omitempty"`
+	// Strategy names the strategy of the depth-first search, and Engine a
+	// registered engine replacing it.
+	Strategy string `json:"strategy,omitempty"`
+	Engine   string `json:"engine,omitempty"`
+	Paranoid bool   `json:"paranoid,omitempty"`
+	// Shuffle randomizes the search with the given seed.
+	Shuffle bool   `json:"shuffle,omitempty"`
+	Seed    uint64 `json:"seed,omitempty"`
+}
+
+// preset returns the request's preset.
+func (r *SolveRequest) preset() (Preset, error) {
+	var name = r.Preset
+	if name == "" {
+		name = "standard"
+	}
+	p, ok := LookupPreset(name)
+	if !ok {
+		return Preset{}, fmt.Errorf("unknown board preset %q, want one of %s", name, quoteList(PresetNames()))
+	}
+	return p, nil
+}
+
+// Validate checks the fields of the request which do not depend on the
+// pieces, and reports all problems found.
+func (r *SolveRequest) Validate() error {
+	var errs []error
+	if _, err := r.preset(); err != nil {
+		errs = append(errs, err)
+	}
+	if r.Set != "" {
+		if _, ok := LookupPieceSet(r.Set); !ok {
+			errs = append(errs, fmt.Errorf("unknown piece set %q, want one of %s", r.Set, quoteList(PieceSetNames())))
+		}
+	}
+	for i, p := range r.Pieces {
+		if strings.TrimSpace(p) == "" {
+			errs = append(errs, fmt.Errorf("piece %d has an empty name", i+1))
+		}
+	}
+	if _, err := r.Options(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// Options returns the solver options of the request. It reports all
+// invalid settings.
+func (r *SolveRequest) Options() ([]Option, error) {
+	var (
+		opts = []Option{WithMaxSolutions(r.MaxSolutions), WithParallelism(r.Parallelism), WithParDepth(r.ParDepth)}
+		errs []error
+	)
+	if r.MaxSolutions < 0 {
+		errs = append(errs, fmt.Errorf("invalid maximum number of solutions %d", r.MaxSolutions))
+	}
+	if r.Parallelism < 0 {
+		errs = append(errs, fmt.Errorf("invalid parallelism %d", r.Parallelism))
+	}
+	if r.ParDepth < ParDepthAuto {
+		errs = append(errs, fmt.Errorf("invalid parallel depth %d", r.ParDepth))
+	}
+	if r.MaxNodes < 0 {
+		errs = append(errs, fmt.Errorf("invalid maximum number of placements %d", r.MaxNodes))
+	} else if r.MaxNodes > 0 {
+		opts = append(opts, WithMaxNodes(r.MaxNodes))
+	}
+	if r.Timeout != "" {
+		d, err := time.ParseDuration(r.Timeout)
+		switch {
+		case err != nil:
+			errs = append(errs, fmt.Errorf("invalid timeout %q", r.Timeout))
+		case d < 0:
+			errs = append(errs, fmt.Errorf("invalid timeout %v", d))
+		default:
+			opts = append(opts, WithTimeout(d))
+		}
+	}
+	if r.Strategy != "" {
+		st, err := ParseStrategy(r.Strategy)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		opts = append(opts, WithStrategy(st))
+	}
+	if r.Engine != "" {
+		e, err := ParseEngine(r.Engine)
+		if err != nil {
+			errs = append(errs, err)
+		} else {
+			opts = append(opts, WithEngine(e))
+		}
+	}
+	if r.Paranoid {
+		opts = append(opts, WithParanoid())
+	}
+	if r.Shuffle {
+		opts = append(opts, WithRand(NewRand(r.Seed)))
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return opts, nil
+}
+
+// PieceSet returns the request's piece set.
+func (r *SolveRequest) PieceSet() (PieceSet, error) {
+	var name = r.Set
+	if name == "" {
+		p, err := r.preset()
+		if err != nil {
+			return PieceSet{}, err
+		}
+		name = p.Set
+	}
+	set, ok := LookupPieceSet(name)
+	if !ok {
+		return PieceSet{}, fmt.Errorf("unknown piece set %q, want one of %s", name, quoteList(PieceSetNames()))
+	}
+	return set, nil
+}
+
+// Registry returns a registry with the request's piece set.
+func (r *SolveRequest) Registry() (*Registry, error) {
+	set, err := r.PieceSet()
+	if err != nil {
+		return nil, err
+	}
+	return NewRegistry(set.Pieces...)
+}
+
+// Puzzle returns the board of the request and the pieces to place on it,
+// looking pieces up in reg.
+func (r *SolveRequest) Puzzle(reg *Registry) (*Board, []Piece, error) {
+	b, err := r.ParseBoard(reg)
+	if err != nil {
+		return nil, nil, err
+	}
+	ps, err := r.ParsePieces(reg, b)
+	if err != nil {
+		return nil, nil, err
+	}
+	return b, ps, nil
+}
+
+// ParseBoard returns the board of the request, with the letters of reg.
+func (r *SolveRequest) ParseBoard(reg *Registry) (*Board, error) {
+	p, err := r.preset()
+	if err != nil {
+		return nil, err
+	}
+	var s = r.Board
+	if s == "" {
+		s = p.Board()
+	}
+	b, err := reg.ParseBoard(s, p.Rows, p.Cols, r.Lenient)
+	if err != nil {
+		return nil, err
+	}
+	b = p.Block(b).WithWrap(r.Wrap)
+	if r.Region != "" {
+		min, max, err := b.ParseRegion(r.Region)
+		if err != nil {
+			return nil, err
+		}
+		b = b.Restrict(min, max)
+	}
+	return b, nil
+}
+
+// ParsePieces returns the pieces of reg to place on b.
+func (r *SolveRequest) ParsePieces(reg *Registry, b *Board) ([]Piece, error) {
+	if len(r.Pieces) == 0 {
+		return reg.Unplaced(b), nil
+	}
+	return reg.ParseAvailable(strings.Join(r.Pieces, ","))
+}
+
+// VerifyRequest asks to check a solution of the puzzle of the request with
+// VerifySolution.
+type VerifyRequest struct {
+	SolveRequest
+	Solution Solution `json:"solution"`
+}
+
+// GenerateRequest asks for a puzzle made from the board and the pieces of
+// the request, by Generate with the given settings and a random source
+// seeded with Seed.
+type GenerateRequest struct {
+	SolveRequest
+	Remove   int  `json:"remove"`
+	Unique   bool `json:"unique,omitempty"`
+	Attempts int  `json:"attempts,omitempty"`
+}
+
+// SolveResponse is the outcome of a SolveRequest.
+type SolveResponse struct {
+	Status    Status     `json:"status"`
+	Count     int        `json:"count"`
+	Complete  bool       `json:"complete"`
+	Solutions []Solution `json:"solutions,omitempty"`
+	Metrics   Metrics    `json:"metrics"`
+	// Error describes why the search failed or was aborted, if it did.
+	Error string `json:"error,omitempty"`
+}
+
+// NewSolveResponse returns the response for the result and error of Solve.
+func NewSolveResponse(res SolveResult, err error) SolveResponse {
+	var r = SolveResponse{
+		Status:    res.Status,
+		Count:     res.Count,
+		Complete:  res.Complete,
+		Solutions: res.Solutions,
+		Metrics:   res.Metrics,
+	}
+	if err != nil {
+		r.Error = err.Error()
+	}
+	return r
+}
@@ -0,0 +1,110 @@
+package iqpuzzler
+
+import (
+	"fmt"
+	"time"
+)
+
+// Status is the outcome of a search.
+type Status int
+
+const (
+	// Solved means at least one solution was found.
+	Solved Status = iota
+	// Unsolvable means the search ran to the end without finding a
+	// solution.
+	Unsolvable
+	// Aborted means the search was stopped before it found a solution.
+	Aborted
+)
+
+var statusNames = []string{"solved", "unsolvable", "aborted"}
+
+func (s Status) String() string {
+	if s < 0 || int(s) >= len(statusNames) {
+		return fmt.Sprintf("Status(%d)", int(s))
+	}
+	return statusNames[s]
+}
+
+// MarshalText encodes the status as its name.
+func (s Status) MarshalText() ([]byte, error) {
+	if s < 0 || int(s) >= len(statusNames) {
+		return nil, fmt.Errorf("invalid status %d", int(s))
+	}
+	return []byte(statusNames[s]), nil
+}
+
+// UnmarshalText decodes a status name.
+func (s *Status) UnmarshalText(b []byte) error {
+	for i, n := range statusNames {
+		if n == string(b) {
+			*s = Status(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown status %q, want one of %s", b, quoteList(statusNames))
+}
+
+// Prune kinds counted in Metrics.Prunes.
+const (
+	// PruneBlocked counts placements rejected because the piece left the
+	// board or overlapped an occupied cell.
+	PruneBlocked = "blocked"
+)
+
+// Metrics describes the work done by a search.
+type Metrics struct {
+	// Nodes is the number of placements tried.
+	Nodes int64 `json:"nodes"`
+	// Backtracks is the number of placements which were undone.
+	Backtracks int64 `json:"backtracks"`
+	// MaxDepth is the largest number of pieces placed at once.
+	MaxDepth int           `json:"max_depth"`
+	Duration time.Duration `json:"duration_ns"`
+	// Prunes counts the placements cut off, by kind.
+	Prunes map[string]int64 `json:"prunes,omitempty"`
+	// Placements is the number of placements of the pieces on the empty
+	// board the search precomputed, and TableBytes the memory their tables
+	// hold, the largest allocation of a search.
+	Placements int   `json:"placements,omitempty"`
+	TableBytes int64 `json:"table_bytes,omitempty"`
+	// Versions is the number of distinct orientations of the pieces the
+	// tables hold, of the Transforms, eight per piece, which would place
+	// some of them more than once.
+	Versions   int `json:"versions,omitempty"`
+	Transforms int `json:"transforms,omitempty"`
+	// MemoryBytes estimates the memory held by the large allocations of
+	// the search: its tables and those of the table cache it took them
+	// from. Callers add what they keep of the solutions, such as the sets
+	// they skip repeats with.
+	MemoryBytes int64 `json:"memory_bytes,omitempty"`
+}
+
+// SolveResult is the outcome of Solve. It owns its moves; neither the game
+// searched nor later searches share them.
+type SolveResult struct {
+	Status Status `json:"status"`
+	// Solution is the first solution found, or nil.
+	Solution Solution `json:"solution,omitempty"`
+	// Solutions holds every solution found, unless the solver has an
+	// OnSolution hook, which receives them instead.
+	Solutions []Solution `json:"solutions,omitempty"`
+	// Count is the number of solutions found.
+	Count int `json:"count"`
+	// Complete reports whether the search ran to the end, as opposed to
+	// being stopped by the solution limit, the timeout or the context.
+	Complete bool    `json:"complete"`
+	Metrics  Metrics `json:"metrics"`
+}
+
+// status derives the result's status from its count and completeness.
+func (r *SolveResult) status() Status {
+	switch {
+	case r.Count > 0:
+		return Solved
+	case r.Complete:
+		return Unsolvable
+	}
+	return Aborted
+}
@@ -0,0 +1,113 @@
+package iqpuzzler
+
+import (
+	"image/color"
+	"sort"
+)
+
+// PieceSet is a built-in set of pieces together with their default styles.
+type PieceSet struct {
+	Pieces  []Piece
+	Palette Palette
+}
+
+// pieceSets contains the built-in piece sets by name.
+var pieceSets = map[string]PieceSet{
+	"iq-puzzler": {
+		Pieces: []Piece{
+			{name: "blue", letter: 'A', pos: []Pos{{0, 0}, {0, 1}, {0, 2}, {1, 0}}},
+			{name: "green", letter: 'B', pos: []Pos{{0, 0}, {1, 0}, {2, 0}, {1, 1}}, sym: true},
+			{name: "lightblue", letter: 'C', pos: []Pos{{0, 0}, {1, 0}, {2, 0}, {2, 1}, {2, 2}}, sym: true},
+			{name: "maroon", letter: 'D', pos: []Pos{{0, 0}, {0, 1}, {1, 1}, {1, 2}}, sym: true},
+			{name: "mint", letter: 'E', pos: []Pos{{0, 0}, {0, 1}, {0, 2}, {1, 0}, {1, 1}}},
+			{name: "olive", letter: 'F', pos: []Pos{{0, 0}, {1, 0}, {2, 0}, {0, 1}, {2, 1}}, sym: true},
+			{name: "orange", letter: 'G', pos: []Pos{{0, 0}, {1, 0}, {1, 1}, {1, 2}, {2, 1}}},
+			{name: "pink", letter: 'H', pos: []Pos{{0, 0}, {0, 1}, {0, 2}, {1, 2}, {1, 3}}},
+			{name: "red", letter: 'I', pos: []Pos{{0, 0}, {0, 1}, {0, 2}, {0, 3}, {1, 0}}},
+			{name: "turquoise", letter: 'J', pos: []Pos{{0, 0}, {0, 1}, {1, 0}}},
+			{name: "violet", letter: 'K', pos: []Pos{{0, 0}, {1, 0}, {1, 1}, {2, 1}, {2, 2}}, sym: true},
+			{name: "yellow", letter: 'L', pos: []Pos{{0, 0}, {0, 1}, {0, 2}, {0, 3}, {1, 1}}},
+		},
+		Palette: Palette{
+			"blue":      {color.RGBA{0x1f, 0x5f, 0xd0, 0xff}, "38;5;27", 'A', "🟦"},
+			"green":     {color.RGBA{0x2e, 0x9e, 0x3a, 0xff}, "38;5;34", 'B', "🟩"},
+			"lightblue": {color.RGBA{0x6e, 0xc3, 0xf0, 0xff}, "38;5;117", 'C', "💠"},
+			"maroon":    {color.RGBA{0x8b, 0x1e, 0x3f, 0xff}, "38;5;88", 'D', "🟫"},
+			"mint":      {color.RGBA{0x98, 0xe0, 0xc0, 0xff}, "38;5;121", 'E', "🍏"},
+			"olive":     {color.RGBA{0x8a, 0x9a, 0x2b, 0xff}, "38;5;100", 'F', "🫒"},
+			"orange":    {color.RGBA{0xf0, 0x8c, 0x1e, 0xff}, "38;5;208", 'G', "🟧"},
+			"pink":      {color.RGBA{0xf0, 0x6e, 0xaa, 0xff}, "38;5;205", 'H', "🌸"},
+			"red":       {color.RGBA{0xd8, 0x26, 0x2c, 0xff}, "38;5;160", 'I', "🟥"},
+			"turquoise": {color.RGBA{0x1f, 0xb5, 0xb0, 0xff}, "38;5;37", 'J', "🐢"},
+			"violet":    {color.RGBA{0x8a, 0x4f, 0xc8, 0xff}, "38;5;98", 'K', "🟪"},
+			"yellow":    {color.RGBA{0xf5, 0xd5, 0x21, 0xff}, "38;5;220", 'L', "🟨"},
+		},
+	},
+	"kanoodle": {
+		Pieces: []Piece{
+			{name: "red", letter: 'A', pos: []Pos{{0, 0}, {0, 1}, {0, 2}, {1, 0}, {1, 1}}},
+			{name: "orange", letter: 'B', pos: []Pos{{0, 0}, {0, 1}, {0, 2}, {1, 2}, {1, 3}}},
+			{name: "blue", letter: 'C', pos: []Pos{{0, 0}, {0, 1}, {0, 2}, {0, 3}, {1, 0}}},
+			{name: "pink", letter: 'D', pos: []Pos{{0, 0}, {0, 1}, {0, 2}, {0, 3}, {1, 1}}},
+			{name: "green", letter: 'E', pos: []Pos{{0, 0}, {1, 0}, {2, 0}, {2, 1}, {2, 2}}},
+			{name: "white", letter: 'F', pos: []Pos{{0, 0}, {0, 1}, {1, 0}}},
+			{name: "lightblue", letter: 'G', pos: []Pos{{0, 0}, {1, 0}, {1, 1}, {2, 1}, {2, 2}}},
+			{name: "gray", letter: 'H', pos: []Pos{{0, 0}, {0, 1}, {1, 0}, {1, 1}}, sym: true},
+			{name: "purple", letter: 'I', pos: []Pos{{0, 0}, {0, 1}, {0, 2}, {1, 0}}},
+			{name: "yellow", letter: 'J', pos: []Pos{{0, 0}, {1, 0}, {2, 0}, {0, 1}, {2, 1}}, sym: true},
+			{name: "lime", letter: 'K', pos: []Pos{{0, 0}, {1, 0}, {2, 0}, {1, 1}}, sym: true},
+			{name: "darkgreen", letter: 'L', pos: []Pos{{0, 0}, {0, 1}, {0, 2}, {1, 1}, {2, 1}}},
+		},
+		Palette: Palette{
+			"red":       {color.RGBA{0xd0, 0x20, 0x20, 0xff}, "38;5;160", 'A', "🟥"},
+			"orange":    {color.RGBA{0xf0, 0x80, 0x10, 0xff}, "38;5;208", 'B', "🟧"},
+			"blue":      {color.RGBA{0x20, 0x40, 0xc0, 0xff}, "38;5;20", 'C', "🟦"},
+			"pink":      {color.RGBA{0xf0, 0x90, 0xc0, 0xff}, "38;5;211", 'D', "🌸"},
+			"green":     {color.RGBA{0x20, 0xa0, 0x40, 0xff}, "38;5;34", 'E', "🟩"},
+			"white":     {color.RGBA{0xfa, 0xfa, 0xfa, 0xff}, "38;5;231", 'F', "⬜"},
+			"lightblue": {color.RGBA{0x80, 0xc8, 0xf0, 0xff}, "38;5;117", 'G', "💠"},
+			"gray":      {color.RGBA{0x90, 0x90, 0x90, 0xff}, "38;5;246", 'H', "🩶"},
+			"purple":    {color.RGBA{0x80, 0x30, 0xa0, 0xff}, "38;5;91", 'I', "🟪"},
+			"yellow":    {color.RGBA{0xf0, 0xe0, 0x20, 0xff}, "38;5;226", 'J', "🟨"},
+			"lime":      {color.RGBA{0xa0, 0xe0, 0x40, 0xff}, "38;5;154", 'K', "🍏"},
+			"darkgreen": {color.RGBA{0x10, 0x60, 0x30, 0xff}, "38;5;22", 'L', "🌲"},
+		},
+	},
+	"pentomino": {
+		// The pentominoes are named by the letters they resemble, except
+		// for x, since X marks occupied cells on the board.
+		Pieces: []Piece{
+			{name: "f", letter: 'F', pos: []Pos{{0, 1}, {0, 2}, {1, 0}, {1, 1}, {2, 1}}},
+			{name: "i", letter: 'I', pos: []Pos{{0, 0}, {0, 1}, {0, 2}, {0, 3}, {0, 4}}, sym: true},
+			{name: "l", letter: 'L', pos: []Pos{{0, 0}, {1, 0}, {2, 0}, {3, 0}, {3, 1}}},
+			{name: "n", letter: 'N', pos: []Pos{{0, 0}, {0, 1}, {1, 1}, {1, 2}, {1, 3}}},
+			{name: "p", letter: 'P', pos: []Pos{{0, 0}, {0, 1}, {1, 0}, {1, 1}, {2, 0}}},
+			{name: "t", letter: 'T', pos: []Pos{{0, 0}, {0, 1}, {0, 2}, {1, 1}, {2, 1}}},
+			{name: "u", letter: 'U', pos: []Pos{{0, 0}, {0, 2}, {1, 0}, {1, 1}, {1, 2}}},
+			{name: "v", letter: 'V', pos: []Pos{{0, 0}, {1, 0}, {2, 0}, {2, 1}, {2, 2}}},
+			{name: "w", letter: 'W', pos: []Pos{{0, 0}, {1, 0}, {1, 1}, {2, 1}, {2, 2}}},
+			{name: "x", letter: 'C', pos: []Pos{{0, 1}, {1, 0}, {1, 1}, {1, 2}, {2, 1}}, sym: true},
+			{name: "y", letter: 'Y', pos: []Pos{{0, 0}, {0, 1}, {0, 2}, {0, 3}, {1, 1}}},
+			{name: "z", letter: 'Z', pos: []Pos{{0, 0}, {0, 1}, {1, 1}, {2, 1}, {2, 2}}, sym: true},
+		},
+		Palette: Palette{},
+	},
+}
+
+// LookupPieceSet returns the built-in piece set with the given name. The
+// pieces are copied, so the caller may modify them.
+func LookupPieceSet(name string) (PieceSet, bool) {
+	s, ok := pieceSets[name]
+	s.Pieces = append([]Piece(nil), s.Pieces...)
+	return s, ok
+}
+
+// PieceSetNames returns the names of the built-in piece sets in order.
+func PieceSetNames() []string {
+	var res []string
+	for n := range pieceSets {
+		res = append(res, n)
+	}
+	sort.Strings(res)
+	return res
+}
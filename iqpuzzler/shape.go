@@ -0,0 +1,114 @@
+package iqpuzzler
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+)
+
+// origin returns the piece translated such that its bounding box starts at
+// the origin. The order of the cells, and hence the orientation in which the
+// piece was drawn, is kept.
+func (p Piece) origin() Piece {
+	if len(p.pos) == 0 {
+		return p
+	}
+	p.pos = NormalizeToOrigin(p.pos)
+	return p
+}
+
+// Normalize returns the piece translated such that its bounding box starts
+// at the origin, with its cells sorted by row and then column. Two pieces
+// covering the same cells up to translation normalize to the same cells.
+func (p Piece) Normalize() Piece {
+	if len(p.pos) > 0 {
+		p.pos = normalize(p.pos)
+	}
+	return p
+}
+
+// CanonicalForm returns the canonical representative of the piece's shape:
+// the smallest normalized cells among all its transformations. Two pieces
+// have the same shape iff their canonical forms are equal.
+func (p Piece) CanonicalForm() []Pos {
+	return canonical(p.pos)
+}
+
+// Equal reports whether the pieces have the same shape, that is, whether
+// one can be turned and flipped into the other. Names and letters are
+// ignored.
+func (p Piece) Equal(o Piece) bool {
+	return len(p.pos) == len(o.pos) && shapeKey(p.CanonicalForm()) == shapeKey(o.CanonicalForm())
+}
+
+// Hash returns a hash of the piece's shape which does not change across
+// versions: pieces which are Equal have the same hash.
+func (p Piece) Hash() uint64 {
+	var h = fnv.New64a()
+	h.Write([]byte(shapeKey(p.CanonicalForm())))
+	return h.Sum64()
+}
+
+// Orient returns the piece transformed by o and normalized, as in
+// Orientations.
+func (p Piece) Orient(o Orientation) Piece {
+	var v = p.transform(o.Matrix()).Normalize()
+	v.orient = o
+	return v
+}
+
+// Oriented is a distinct orientation of a piece.
+type Oriented struct {
+	// Piece is the piece in this orientation, normalized.
+	Piece Piece
+	// Transforms are the transformations producing the orientation, in the
+	// order of Orientations.
+	Transforms []Orientation
+}
+
+// Orientations returns the distinct orientations of the piece, in the order
+// of the first transformation producing each. A piece without symmetries
+// has eight, one with a mirror symmetry four.
+func (p Piece) Orientations() []Oriented {
+	var (
+		res   []Oriented
+		index = make(map[string]int)
+	)
+	for t, m := range tx {
+		var v = p.transform(m).Normalize()
+		v.orient = Orientation(t)
+		var key = shapeKey(v.pos)
+		if i, ok := index[key]; ok {
+			res[i].Transforms = append(res[i].Transforms, v.orient)
+			continue
+		}
+		index[key] = len(res)
+		res = append(res, Oriented{v, []Orientation{v.orient}})
+	}
+	return res
+}
+
+// canonical returns the smallest normalized shape among all transformations
+// of the given cells, so that two shapes are congruent iff their canonical
+// forms are equal.
+func canonical(ps []Pos) []Pos {
+	var best []Pos
+	if len(ps) == 0 {
+		return nil
+	}
+	for _, m := range tx {
+		var t = normalize(m.Apply(ps))
+		if best == nil || shapeKey(t) < shapeKey(best) {
+			best = t
+		}
+	}
+	return best
+}
+
+func shapeKey(ps []Pos) string {
+	var sb strings.Builder
+	for _, p := range ps {
+		fmt.Fprintf(&sb, "%d,%d;", p[0], p[1])
+	}
+	return sb.String()
+}
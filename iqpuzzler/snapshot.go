@@ -0,0 +1,52 @@
+package iqpuzzler
+
+// Snapshot is a copy of the state of a game at one point in time. It is
+// never modified after Game.Snapshot returns it, so it may be read from any
+// goroutine once it has been handed over with the usual synchronization, for
+// example a channel send or an atomic store. The search hands Progress
+// snapshots over that way; reading the game itself while another goroutine
+// searches it is a data race.
+type Snapshot struct {
+	board *Board
+	occ   bitboard
+	moves []Move
+	count int
+}
+
+// Snapshot returns a copy of the game's current state. It takes time
+// proportional to the size of the board plus the number of moves.
+func (g *Game) Snapshot() *Snapshot {
+	var s = &Snapshot{
+		board: g.board,
+		occ:   append(bitboard(nil), g.occ...),
+		moves: g.Moves(),
+		count: g.count,
+	}
+	return s
+}
+
+// Board returns the board the game is played on.
+func (s *Snapshot) Board() *Board {
+	return s.board
+}
+
+// Moves returns a copy of the moves made.
+func (s *Snapshot) Moves() []Move {
+	return append([]Move(nil), s.moves...)
+}
+
+// Free returns the number of cells which are neither occupied nor blocked.
+func (s *Snapshot) Free() int {
+	return s.board.rows*s.board.cols - s.count
+}
+
+// Occupied reports whether the cell is pre-occupied or covered by a move.
+func (s *Snapshot) Occupied(p Pos) bool {
+	return s.board.inBounds(p) && s.occ.has(s.board.bit(p))
+}
+
+// String returns a board string of the state, marking the cells covered by
+// a move with the letter of its piece, or x if it has none.
+func (s *Snapshot) String() string {
+	return Solution(s.moves).Render(s.board, RenderStyle{})
+}
@@ -0,0 +1,109 @@
+package iqpuzzler
+
+import (
+	"context"
+	"maps"
+	"testing"
+	"time"
+)
+
+// TestSnapshotReader hands the snapshots of the progress reports of a
+// parallel search to a goroutine reading them while the workers go on
+// searching, so that the race detector catches a snapshot sharing memory
+// with the game it was taken of.
+func TestSnapshotReader(t *testing.T) {
+	var (
+		p      = presets["pentomino-3x20"]
+		b      = NewBoard(p.Rows, p.Cols)
+		ps     = pieceSets[p.Set].Pieces
+		snaps  = make(chan *Snapshot, 1)
+		done   = make(chan int)
+		maxLen = len(ps)
+	)
+	go func() {
+		var n int
+		for s := range snaps {
+			var occupied int
+			for x := range b.rows {
+				for y := range b.cols {
+					if s.Occupied(Pos{x, y}) {
+						occupied++
+					}
+				}
+			}
+			var covered int
+			for _, m := range s.Moves() {
+				covered += len(m.Image())
+			}
+			if occupied != b.rows*b.cols-s.Free() || covered != occupied || len(s.Moves()) > maxLen || s.String() == "" {
+				t.Errorf("snapshot %d has %d moves covering %d cells, %d occupied and %d free", n+1, len(s.Moves()), covered, occupied, s.Free())
+			}
+			n++
+		}
+		done <- n
+	}()
+	_, err := dfs{FirstEmptyCell}.Solve(context.Background(), b, ps, Options{
+		Parallelism: 4,
+		Progress: func(pr Progress) {
+			if pr.Snapshot == nil {
+				return
+			}
+			select {
+			case snaps <- pr.Snapshot:
+			default:
+			}
+		},
+		progressEvery: 100 * time.Microsecond,
+	})
+	close(snaps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := <-done; n == 0 {
+		t.Error("no snapshots were read")
+	}
+}
+
+// TestCounters counts the tilings of the pentomino 3x20 rectangle with one
+// worker and then with 16, reporting progress every 50µs while they search,
+// and checks that the placements reported never go back nor past the final
+// count, and that the metrics of the workers add up to those of one. Built
+// with -race, it checks that reading the counters of the workers while they
+// search is no data race.
+func TestCounters(t *testing.T) {
+	const workers = 16
+	var (
+		p       = presets["pentomino-3x20"]
+		b       = NewBoard(p.Rows, p.Cols)
+		ps      = pieceSets[p.Set].Pieces
+		reports []int64
+	)
+	one, err := dfs{FirstEmptyCell}.Solve(context.Background(), b, ps, Options{Parallelism: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	many, err := dfs{FirstEmptyCell}.Solve(context.Background(), b, ps, Options{
+		Parallelism:   workers,
+		Progress:      func(pr Progress) { reports = append(reports, pr.Nodes) },
+		progressEvery: 50 * time.Microsecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reports) == 0 {
+		t.Fatal("no progress reports")
+	}
+	for i, n := range reports {
+		switch {
+		case i > 0 && n < reports[i-1]:
+			t.Errorf("progress report %d has %d placements, after %d", i+1, n, reports[i-1])
+		case n > many.Metrics.Nodes:
+			t.Errorf("progress report %d has %d placements, more than the %d of the search", i+1, n, many.Metrics.Nodes)
+		}
+	}
+	var got, want = many.Metrics, one.Metrics
+	if got.Nodes != want.Nodes || got.Backtracks != want.Backtracks || got.MaxDepth != want.MaxDepth || !maps.Equal(got.Prunes, want.Prunes) {
+		t.Errorf("%d workers count %d placements, %d backtracks, depth %d and prunes %v, one %d, %d, %d and %v",
+			workers, got.Nodes, got.Backtracks, got.MaxDepth, got.Prunes, want.Nodes, want.Backtracks, want.MaxDepth, want.Prunes)
+	}
+}
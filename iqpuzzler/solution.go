@@ -0,0 +1,152 @@
+package iqpuzzler
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Solution is a sequence of moves completing a game.
+type Solution []Move
+
+// String lists the moves of the solution, one a line: the name of the
+// piece, padded to the longest one of the solution, the name of its
+// orientation, padded to five characters, and "at ROW,COL" with the
+// translation of the move, as Game.Add takes it, followed by " (wrapped)" if
+// the piece crosses the edge of a toroidal board. The format is meant to
+// stay as it is, for scripts reading it; Render draws the board.
+func (s Solution) String() string {
+	var width int
+	for _, m := range s {
+		width = max(width, len(m.Piece.name))
+	}
+	var sb strings.Builder
+	for i, m := range s {
+		if i > 0 {
+			sb.WriteByte('\n')
+		}
+		fmt.Fprintf(&sb, "%-*s %-5s at %d,%d", width, m.Piece.name, m.Piece.orient, m.Translate[0], m.Translate[1])
+		if m.wrapped() {
+			sb.WriteString(" (wrapped)")
+		}
+	}
+	return sb.String()
+}
+
+// Cells maps the cells covered by the solution to the names of the pieces
+// covering them.
+func (s Solution) Cells() map[Pos]string {
+	var res = make(map[Pos]string)
+	for _, m := range s {
+		for _, p := range m.Image() {
+			res[p] = m.Piece.name
+		}
+	}
+	return res
+}
+
+// Covers returns the name of the piece covering the cell, if any.
+func (s Solution) Covers(p Pos) (string, bool) {
+	for _, m := range s {
+		if slices.Contains(m.Image(), p) {
+			return m.Piece.name, true
+		}
+	}
+	return "", false
+}
+
+// Pieces returns the names of the pieces placed, in the order of the moves.
+func (s Solution) Pieces() []string {
+	var res = make([]string, 0, len(s))
+	for _, m := range s {
+		res = append(res, m.Piece.name)
+	}
+	return res
+}
+
+// Canonical returns a key identifying the cells covered by each piece. It
+// does not depend on the order of the moves, nor on which of several
+// transformations producing the same orientation a move names, so solutions
+// with the same key look the same on the board.
+func (s Solution) Canonical() string {
+	var parts = make([]string, 0, len(s))
+	for _, m := range s {
+		parts = append(parts, m.Piece.name+":"+shapeKey(sortedCells(m.Image())))
+	}
+	slices.Sort(parts)
+	return strings.Join(parts, " ")
+}
+
+// Validate checks that the moves cover the free cells of the board exactly,
+// without overlapping each other or the occupied cells. Unlike
+// VerifySolution it does not check the pieces used.
+func (s Solution) Validate(b *Board) error {
+	var g = NewGame(b)
+	for _, m := range s {
+		if err := g.Place(m.Piece, m.Translate); err != nil {
+			return fmt.Errorf("move %v: %w", m, err)
+		}
+	}
+	if g.Free() != 0 {
+		return fmt.Errorf("solution leaves %d cells free", g.Free())
+	}
+	return nil
+}
+
+// RenderStyle selects how Solution.Render draws a board.
+type RenderStyle struct {
+	// Lines puts every row on a line of its own. Otherwise rows are
+	// separated by commas, as in board strings.
+	Lines bool
+	// Palette, if set, colors the letters of the pieces with the ANSI codes
+	// of their styles.
+	Palette Palette
+	// Emoji draws the pieces with the emojis of their styles in Palette
+	// instead of colored letters, free cells as ⚪ and occupied ones as ⚫.
+	Emoji bool
+}
+
+// Render draws the board with the cells covered by a move marked with the
+// letter of its piece, or x if it has none. The moves need not complete the
+// game.
+func (s Solution) Render(b *Board, style RenderStyle) string {
+	var rows = make([][]string, b.rows)
+	for x := range rows {
+		rows[x] = make([]string, b.cols)
+		for y := range rows[x] {
+			switch c := cellSymbol(b, x, y); {
+			case !style.Emoji:
+				rows[x][y] = string(c)
+			case c == '.':
+				rows[x][y] = "⚪"
+			default:
+				rows[x][y] = "⚫"
+			}
+		}
+	}
+	for _, m := range s {
+		var l = string(m.Piece.letter)
+		if m.Piece.letter == 0 {
+			l = "x"
+		}
+		if st, ok := style.Palette[m.Piece.name]; ok && style.Emoji && st.Emoji != "" {
+			l = st.Emoji
+		} else if ok && !style.Emoji && st.ANSI != "" {
+			l = "\x1b[" + st.ANSI + "m" + l + "\x1b[0m"
+		}
+		for _, p := range m.Image() {
+			rows[p[0]][p[1]] = l
+		}
+	}
+	var (
+		res = make([]string, len(rows))
+		sep = ","
+	)
+	if style.Lines {
+		sep = "\n"
+	}
+	for x, r := range rows {
+		res[x] = strings.Join(r, "")
+	}
+	return strings.Join(res, sep)
+}
@@ -0,0 +1,183 @@
+package iqpuzzler
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"runtime/debug"
+	"time"
+)
+
+// SolutionFormatVersion is the version of the solution file format written
+// by SolutionWriter.
+const SolutionFormatVersion = 1
+
+// A solution file is a sequence of JSON values, one per line: the header,
+// followed by one solution per line as an array of moves. Readers ignore
+// fields they do not know, so that later versions may add them; a change
+// which older readers cannot ignore increments the format version.
+
+// SolutionHeader describes the puzzle the solutions in a file belong to.
+type SolutionHeader struct {
+	Version int    `json:"version"`
+	Board   *Board `json:"board"`
+	Wrap    bool   `json:"wrap,omitempty"`
+	// Set identifies the piece set, for example the name of a built-in
+	// set or the path of a piece file.
+	Set string `json:"set"`
+	// Solver identifies the program which found the solutions.
+	Solver  string    `json:"solver,omitempty"`
+	Created time.Time `json:"created"`
+}
+
+// NewSolutionHeader returns a header for solutions of the board with the
+// given piece set, created now by this version of the solver.
+func NewSolutionHeader(b *Board, set string) SolutionHeader {
+	return SolutionHeader{
+		Version: SolutionFormatVersion,
+		Board:   b,
+		Wrap:    b.Wrap(),
+		Set:     set,
+		Solver:  SolverVersion(),
+		Created: time.Now().UTC().Truncate(time.Second),
+	}
+}
+
+// SolverVersion returns the module path and version of the solver as
+// recorded in the build information, or "iqpuzzler" if there is none.
+func SolverVersion() string {
+	var bi, ok = debug.ReadBuildInfo()
+	if !ok {
+		return "iqpuzzler"
+	}
+	var m = bi.Main
+	for _, d := range bi.Deps {
+		if d.Path == "smaart" {
+			m = *d
+		}
+	}
+	return m.Path + " " + m.Version
+}
+
+// SolutionWriter writes a solution file.
+type SolutionWriter struct {
+	w   io.Writer
+	enc *json.Encoder
+}
+
+// NewSolutionWriter writes the header to w and returns a writer for the
+// solutions following it. A zero version in the header is replaced by
+// SolutionFormatVersion.
+func NewSolutionWriter(w io.Writer, h SolutionHeader) (*SolutionWriter, error) {
+	if h.Version == 0 {
+		h.Version = SolutionFormatVersion
+	}
+	if h.Version != SolutionFormatVersion {
+		return nil, fmt.Errorf("cannot write solution file version %d", h.Version)
+	}
+	if h.Board == nil {
+		return nil, errors.New("solution file without a board")
+	}
+	var sw = &SolutionWriter{w, json.NewEncoder(w)}
+	if err := sw.enc.Encode(h); err != nil {
+		return nil, err
+	}
+	return sw, nil
+}
+
+// Write appends the solution to the file.
+func (w *SolutionWriter) Write(s Solution) error {
+	return w.enc.Encode(s)
+}
+
+// EncodeSolution returns the line of a solution file holding the solution,
+// as Write writes it, so that solutions can be encoded on other goroutines
+// than the one writing them with WriteEncoded.
+func EncodeSolution(s Solution) ([]byte, error) {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+// WriteEncoded appends a line returned by EncodeSolution to the file.
+func (w *SolutionWriter) WriteEncoded(line []byte) error {
+	_, err := w.w.Write(line)
+	return err
+}
+
+// SolutionReader reads a solution file.
+type SolutionReader struct {
+	header SolutionHeader
+	dec    *json.Decoder
+}
+
+// NewSolutionReader reads the header of the solution file in r. It fails
+// with an error if the file has a version this package does not know.
+func NewSolutionReader(r io.Reader) (*SolutionReader, error) {
+	var (
+		dec = json.NewDecoder(bufio.NewReader(r))
+		raw struct {
+			Version int `json:"version"`
+		}
+		first json.RawMessage
+	)
+	if err := dec.Decode(&first); err != nil {
+		if err == io.EOF {
+			return nil, errors.New("empty solution file")
+		}
+		return nil, fmt.Errorf("solution file header: %v", err)
+	}
+	if err := json.Unmarshal(first, &raw); err != nil {
+		return nil, fmt.Errorf("solution file header: %v", err)
+	}
+	if raw.Version != SolutionFormatVersion {
+		return nil, fmt.Errorf("unsupported solution file version %d, want %d", raw.Version, SolutionFormatVersion)
+	}
+	var sr = &SolutionReader{dec: dec}
+	if err := json.Unmarshal(first, &sr.header); err != nil {
+		return nil, fmt.Errorf("solution file header: %v", err)
+	}
+	if sr.header.Board == nil {
+		return nil, errors.New("solution file header without a board")
+	}
+	sr.header.Board = sr.header.Board.WithWrap(sr.header.Wrap)
+	return sr, nil
+}
+
+// Header returns the header of the file.
+func (r *SolutionReader) Header() SolutionHeader {
+	return r.header
+}
+
+// Read returns the next solution in the file, or io.EOF at its end. The
+// pieces of the moves are only known by name and shape; Solution.Resolve
+// checks them against a piece set.
+func (r *SolutionReader) Read() (Solution, error) {
+	var s Solution
+	if err := r.dec.Decode(&s); err != nil {
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		return nil, fmt.Errorf("solution file: %v", err)
+	}
+	return s, nil
+}
+
+// ReadAll returns the remaining solutions in the file.
+func (r *SolutionReader) ReadAll() ([]Solution, error) {
+	var res []Solution
+	for {
+		s, err := r.Read()
+		if err == io.EOF {
+			return res, nil
+		}
+		if err != nil {
+			return res, err
+		}
+		res = append(res, s)
+	}
+}
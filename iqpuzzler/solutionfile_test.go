@@ -0,0 +1,148 @@
+package iqpuzzler
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files of the tests")
+
+// goldenHeader is the header of the golden solution file, which has a fixed
+// solver and time.
+func goldenHeader(b *Board) SolutionHeader {
+	var h = NewSolutionHeader(b, "iq-puzzler")
+	h.Solver, h.Created = "iqpuzzler test", time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	return h
+}
+
+// checkGolden compares got with the golden file, or rewrites it with -update.
+func checkGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+	var path = filepath.Join("testdata", name)
+	if *update {
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("%s differs, got\n%s\nwant\n%s", path, got, want)
+	}
+}
+
+func TestSolutionFileGolden(t *testing.T) {
+	var b, ps = miniPuzzle(t)
+	var sols = testSolutions(t, b, ps, 0)
+	var buf bytes.Buffer
+	w, err := NewSolutionWriter(&buf, goldenHeader(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, sol := range sols {
+		if err := w.Write(sol); err != nil {
+			t.Fatal(err)
+		}
+	}
+	checkGolden(t, "mini.solutions", buf.Bytes())
+
+	r, err := NewSolutionReader(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h := r.Header(); h.Board.String() != b.String() || h.Set != "iq-puzzler" || !h.Created.Equal(goldenHeader(b).Created) {
+		t.Errorf("read the header %+v", h)
+	}
+	got, err := r.ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(sols) {
+		t.Fatalf("read %d solutions, want %d", len(got), len(sols))
+	}
+	for i := range got {
+		if err := got[i].Resolve(standardPieces); err != nil {
+			t.Fatal(err)
+		}
+		if got[i].Canonical() != sols[i].Canonical() {
+			t.Errorf("solution %d reads back as %s, want %s", i+1, got[i].Render(b, RenderStyle{}), sols[i].Render(b, RenderStyle{}))
+		}
+	}
+}
+
+func TestSolutionFileVersions(t *testing.T) {
+	var tests = []struct {
+		name, file string
+		// want is a part of the error, empty if the file must be read.
+		want string
+	}{
+		{"unknown fields", `{"version":1,"board":".....","set":"x","created":"2024-01-02T03:04:05Z","comment":"new"}` + "\n" +
+			`[{"piece":"blue","transform":"I","position":[0,0],"shape":[[0,0],[0,1],[0,2],[1,0]],"colour":"blue"}]`, ""},
+		{"later version", `{"version":2,"board":"....."}`, "unsupported solution file version 2"},
+		{"no version", `{"board":"....."}`, "unsupported solution file version 0"},
+		{"no board", `{"version":1}`, "without a board"},
+		{"empty", ``, "empty solution file"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r, err := NewSolutionReader(strings.NewReader(test.file))
+			if err == nil {
+				_, err = r.ReadAll()
+			}
+			switch {
+			case test.want == "" && err != nil:
+				t.Errorf("got %v", err)
+			case test.want != "" && (err == nil || !strings.Contains(err.Error(), test.want)):
+				t.Errorf("got %v, want an error containing %q", err, test.want)
+			}
+		})
+	}
+}
+
+// TestSolutionFileUnnormalized reads the solutions of the golden file as
+// written before the orientations were moved to the origin, with shapes of
+// negative cells, and checks that they resolve to the same solutions, moved
+// to the top left corners of the pieces.
+func TestSolutionFileUnnormalized(t *testing.T) {
+	var read = func(name string) []Solution {
+		t.Helper()
+		f, err := os.Open(filepath.Join("testdata", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		r, err := NewSolutionReader(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sols, err := r.ReadAll()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, sol := range sols {
+			if err := sol.Resolve(standardPieces); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return sols
+	}
+	var old, want = read("mini-unnormalized.solutions"), read("mini.solutions")
+	if len(old) != len(want) {
+		t.Fatalf("read %d solutions, want %d", len(old), len(want))
+	}
+	for i := range old {
+		for j := range old[i] {
+			if old[i][j].Translate != want[i][j].Translate || !slices.Equal(old[i][j].Piece.pos, want[i][j].Piece.pos) {
+				t.Errorf("solution %d, move %d reads as %v, want %v", i+1, j+1, old[i][j], want[i][j])
+			}
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package iqpuzzler
+
+import (
+	"hash/fnv"
+	"math/bits"
+)
+
+// Hash returns a 64-bit hash of the Canonical key of the solution, which
+// solutions looking the same on the board share.
+func (s Solution) Hash() uint64 {
+	var h = fnv.New64a()
+	h.Write([]byte(s.Canonical()))
+	return h.Sum64()
+}
+
+// SolutionSet is a set of solutions by their Hash, so that enumerating
+// many solutions can skip repeats without keeping them. An exact set takes
+// 8 to 16 bytes a solution and only takes two solutions for the same if
+// their hashes collide, which for n solutions happens with a probability
+// of about n²/2⁶⁵. A Bloom filter takes a fixed amount of memory instead,
+// but takes a share of new solutions for ones it has seen, which grows with
+// their number: with b bits a solution, about 0.6185^b, 1% at 10 bits and
+// 0.01% at 20 bits. It is not safe for concurrent use.
+type SolutionSet struct {
+	// slots holds the hashes of an exact set in open addressing, 0 marking
+	// a free slot; a hash of 0 is stored as 1.
+	slots []uint64
+	// bloom, if not nil, are the bits of a Bloom filter, set by k probes.
+	bloom []uint64
+	k     int
+	n     int
+	// max, if not zero, is the size in bytes an exact set turns into a
+	// Bloom filter of rather than grow past.
+	max int
+}
+
+// NewSolutionSet returns an exact, empty set.
+func NewSolutionSet() *SolutionSet {
+	return &SolutionSet{slots: make([]uint64, 1024)}
+}
+
+// NewBloomSolutionSet returns an empty Bloom filter of the given size in
+// bytes, at least 8, with its number of probes chosen for the given number
+// of solutions.
+func NewBloomSolutionSet(size, solutions int) *SolutionSet {
+	var words = max(size/8, 1)
+	var k = 1
+	if solutions > 0 {
+		// k = m/n ln 2 minimizes the false positives of m bits and n
+		// entries.
+		k = int(float64(words*64) / float64(solutions) * 0.6931)
+	}
+	return &SolutionSet{bloom: make([]uint64, words), k: min(max(k, 1), 16)}
+}
+
+// Add adds the solution with the hash and reports whether it was not in the
+// set before.
+func (s *SolutionSet) Add(h uint64) bool {
+	if h == 0 {
+		h = 1
+	}
+	if s.bloom != nil {
+		return s.addBloom(h)
+	}
+	if 4*(s.n+1) > 3*len(s.slots) {
+		if s.max > 0 && 16*len(s.slots) > s.max {
+			s.toBloom(s.max, 4*s.n)
+			return s.addBloom(h)
+		}
+		s.grow()
+	}
+	var mask = uint64(len(s.slots) - 1)
+	for i := mix(h) & mask; ; i = (i + 1) & mask {
+		switch s.slots[i] {
+		case h:
+			return false
+		case 0:
+			s.slots[i] = h
+			s.n++
+			return true
+		}
+	}
+}
+
+// grow doubles the slots of an exact set.
+func (s *SolutionSet) grow() {
+	var old = s.slots
+	s.slots = make([]uint64, 2*len(old))
+	s.n = 0
+	for _, h := range old {
+		if h != 0 {
+			s.Add(h)
+		}
+	}
+}
+
+// Limit makes an exact set turn into a Bloom filter of size bytes, tuned
+// for four times the solutions it holds then, rather than grow to more than
+// size bytes, at once if it takes more already. It does nothing to a Bloom
+// filter.
+func (s *SolutionSet) Limit(size int) {
+	if s.bloom != nil {
+		return
+	}
+	s.max = size
+	if s.Bytes() > int64(size) {
+		s.toBloom(size, 4*s.n)
+	}
+}
+
+// toBloom turns an exact set into a Bloom filter of the given size, with
+// its number of probes chosen for the given number of solutions, holding
+// the solutions of the set.
+func (s *SolutionSet) toBloom(size, solutions int) {
+	var bloom = NewBloomSolutionSet(size, solutions)
+	for _, h := range s.slots {
+		if h != 0 {
+			bloom.addBloom(h)
+		}
+	}
+	bloom.n = s.n
+	*s = *bloom
+}
+
+// addBloom sets the bits of the hash in the filter, derived from its two
+// halves, and reports whether one of them was not set.
+func (s *SolutionSet) addBloom(h uint64) bool {
+	var (
+		m      = uint64(len(s.bloom) * 64)
+		h1, h2 = mix(h), bits.RotateLeft64(h, 32) | 1
+		added  bool
+	)
+	for i := 0; i < s.k; i++ {
+		var b = (h1 + uint64(i)*h2) % m
+		if s.bloom[b/64]&(1<<(b%64)) == 0 {
+			s.bloom[b/64] |= 1 << (b % 64)
+			added = true
+		}
+	}
+	if added {
+		s.n++
+	}
+	return added
+}
+
+// Len returns the number of solutions added, not counting those a Bloom
+// filter took for ones it had seen.
+func (s *SolutionSet) Len() int {
+	return s.n
+}
+
+// Bytes returns the memory held by the set.
+func (s *SolutionSet) Bytes() int64 {
+	return 8 * int64(len(s.slots)+len(s.bloom))
+}
+
+// Bloom reports whether the set is a Bloom filter.
+func (s *SolutionSet) Bloom() bool {
+	return s.bloom != nil
+}
+
+// mix scrambles the bits of the hash, so that slots and probes do not
+// depend on its low bits alone.
+func mix(h uint64) uint64 {
+	h ^= h >> 33
+	h *= 0xff51afd7ed558ccd
+	h ^= h >> 33
+	return h
+}
@@ -0,0 +1,106 @@
+package iqpuzzler
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"runtime"
+	"testing"
+)
+
+// TestStreamingMemory enumerates the first 10^5 solutions of the empty
+// standard board, writing each to a solution file which is thrown away and
+// adding it to a SolutionSet, and checks that the live heap grows by no
+// more than the set and a MiB: that neither the search nor the writer keeps
+// the solutions.
+func TestStreamingMemory(t *testing.T) {
+	if testing.Short() || raceEnabled {
+		t.Skip("a soak test")
+	}
+	const n = 100000
+	var (
+		p     = presets["standard"]
+		b     = NewBoard(p.Rows, p.Cols)
+		set   = NewSolutionSet()
+		every = n / 10
+		count int
+		err   error
+		first runtime.MemStats
+		grown int64
+		base  int64
+	)
+	w, err := NewSolutionWriter(io.Discard, NewSolutionHeader(b, p.Set))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := NewSolver(WithEngine(stackEngine{}), WithMaxSolutions(n), WithOnSolution(func(sol Solution) {
+		if err != nil {
+			return
+		}
+		if err = w.Write(sol); err == nil && !set.Add(sol.Hash()) {
+			err = fmt.Errorf("solution %d found twice", count+1)
+		}
+		if count++; count%every != 0 {
+			return
+		}
+		var ms runtime.MemStats
+		runtime.GC()
+		runtime.ReadMemStats(&ms)
+		if count == every {
+			first, base = ms, set.Bytes()
+			return
+		}
+		grown = max(grown, int64(ms.HeapAlloc)-int64(first.HeapAlloc))
+		if limit := set.Bytes() - base + 1<<20; grown > limit && err == nil {
+			err = fmt.Errorf("the heap grew by %d KiB in %d solutions, more than %d KiB", grown>>10, count-every, limit>>10)
+		}
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, serr := s.Solve(context.Background(), NewGame(b), pieceSets[p.Set].Pieces)
+	switch {
+	case serr != nil:
+		t.Fatal(serr)
+	case err != nil:
+		t.Fatal(err)
+	case res.Count != n:
+		t.Fatalf("found %d solutions, want %d", res.Count, n)
+	}
+	t.Logf("the heap grew by %d KiB", grown>>10)
+}
+
+// TestDedupLimit enumerates the 1708 solutions of the test puzzle into an
+// exact SolutionSet and one limited to 16 KiB, less than the exact one
+// grows to, and checks that the search counts all of them, that the
+// limited set turned into a Bloom filter within its limit rather than
+// grow, and that it still took at least 99% of the solutions for new ones.
+func TestDedupLimit(t *testing.T) {
+	const limit = 16 << 10
+	var (
+		b, ps   = testPuzzle(t)
+		exact   = NewSolutionSet()
+		limited = NewSolutionSet()
+		added   int
+	)
+	limited.Limit(limit)
+	res, err := dfs{FirstEmptyCell}.Solve(context.Background(), b, ps, Options{Hooks: Hooks{OnSolution: func(sol Solution) {
+		var h = sol.Hash()
+		exact.Add(h)
+		if limited.Add(h) {
+			added++
+		}
+	}}})
+	switch {
+	case err != nil:
+		t.Fatal(err)
+	case !res.Complete || res.Count != 1708 || exact.Len() != res.Count:
+		t.Fatalf("the search found %d solutions, %d distinct, complete %t, want 1708", res.Count, exact.Len(), res.Complete)
+	case exact.Bytes() <= limit:
+		t.Errorf("the exact set of %d solutions takes %d bytes, no more than the limit of %d", exact.Len(), exact.Bytes(), limit)
+	case !limited.Bloom() || limited.Bytes() > limit:
+		t.Errorf("the limited set takes %d bytes, Bloom filter %t, for a limit of %d", limited.Bytes(), limited.Bloom(), limit)
+	case added < res.Count-res.Count/100:
+		t.Errorf("the limited set took %d of %d solutions for new ones", added, res.Count)
+	}
+}
@@ -0,0 +1,33 @@
+package iqpuzzler
+
+import "context"
+
+// Search enumerates the ways to complete the game with the given pieces and
+// passes a copy of the moves made for each to fn. It stops as soon as fn returns
+// false and reports whether it did so. The game is left unchanged.
+func (g *Game) Search(ps []Piece, fn func([]Move) bool) (bool, error) {
+	return g.search(precompute(g.board, ps, PieceOrder), fn)
+}
+
+// SearchContext is like Search, but stops within a bounded number of
+// placements once ctx is done and returns an *AbortedError. The game is
+// restored to its state before the call in any case.
+func (g *Game) SearchContext(ctx context.Context, ps []Piece, fn func([]Move) bool) (bool, error) {
+	var s = &searcher{g: g, strategy: PieceOrder, fn: fn, ctx: ctx, base: g.numMoves()}
+	if err := ctx.Err(); err != nil {
+		return false, &AbortedError{err, 0}
+	}
+	var tables = precompute(g.board, ps, PieceOrder)
+	g.prepare(tables)
+	stop, err := s.search(tables)
+	if err == nil && s.aborted {
+		return false, &AbortedError{ctx.Err(), s.nodes}
+	}
+	return stop, err
+}
+
+func (g *Game) search(ps []pieceTable, fn func([]Move) bool) (bool, error) {
+	var s = &searcher{g: g, strategy: PieceOrder, fn: fn, base: g.numMoves()}
+	g.prepare(ps)
+	return s.search(ps)
+}
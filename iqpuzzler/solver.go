@@ -0,0 +1,923 @@
+package iqpuzzler
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Strategy selects which placements the search tries at each step.
+type Strategy int
+
+const (
+	// PieceOrder places the pieces one after the other, trying every
+	// position and orientation of each.
+	PieceOrder Strategy = iota
+	// FirstEmptyCell covers the first empty cell of the board, trying every
+	// remaining piece in every orientation that covers it. Cells are
+	// scanned along the shorter side of the board first.
+	FirstEmptyCell
+)
+
+var strategyNames = []string{"piece-order", "first-empty-cell"}
+
+func (s Strategy) String() string {
+	if s < 0 || int(s) >= len(strategyNames) {
+		return fmt.Sprintf("Strategy(%d)", int(s))
+	}
+	return strategyNames[s]
+}
+
+// StrategyNames returns the names of the strategies.
+func StrategyNames() []string {
+	return append([]string(nil), strategyNames...)
+}
+
+// ParseStrategy returns the strategy with the given name.
+func ParseStrategy(name string) (Strategy, error) {
+	for i, n := range strategyNames {
+		if n == name {
+			return Strategy(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown strategy %q, want one of %s", name, quoteList(strategyNames))
+}
+
+// Progress describes the state of a running search.
+type Progress struct {
+	// Nodes is the number of placements tried so far.
+	Nodes     int64
+	Solutions int
+	Elapsed   time.Duration
+	// Snapshot is the partial game of one of the searching goroutines, taken
+	// shortly after the previous report, or nil if there is none yet.
+	Snapshot *Snapshot
+}
+
+// progressInterval is the time between two progress reports.
+var progressInterval = time.Second
+
+// pollInterval is the number of placements a worker tries between two
+// checks for cancellation.
+const pollInterval = 1 << 12
+
+// Solver searches for the solutions of games. Its zero value is not usable;
+// use NewSolver.
+type Solver struct {
+	opts     Options
+	strategy Strategy
+	// engine, if not nil, replaces the depth-first search using strategy.
+	engine Engine
+}
+
+// Option configures a Solver.
+type Option func(*Solver) error
+
+// NewSolver returns a solver with the given options. Without options it
+// finds all solutions, placing the pieces in order and using one goroutine
+// per placement of the first piece.
+func NewSolver(opts ...Option) (*Solver, error) {
+	var s = &Solver{strategy: PieceOrder}
+	for _, o := range opts {
+		if err := o(s); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// WithMaxSolutions stops the search after n solutions. Zero means no limit.
+func WithMaxSolutions(n int) Option {
+	return func(s *Solver) error {
+		if n < 0 {
+			return fmt.Errorf("invalid maximum number of solutions %d", n)
+		}
+		s.opts.MaxSolutions = n
+		return nil
+	}
+}
+
+// WithTimeout stops the search after d. Zero means no limit.
+func WithTimeout(d time.Duration) Option {
+	return func(s *Solver) error {
+		if d < 0 {
+			return fmt.Errorf("invalid timeout %v", d)
+		}
+		s.opts.Timeout = d
+		return nil
+	}
+}
+
+// WithMaxNodes stops the search after about n placements, like a timeout
+// which does not depend on the speed of the machine. Zero means no limit.
+func WithMaxNodes(n int64) Option {
+	return func(s *Solver) error {
+		if n < 0 {
+			return fmt.Errorf("invalid maximum number of placements %d", n)
+		}
+		s.opts.MaxNodes = n
+		return nil
+	}
+}
+
+// WithYield calls fn from the searching goroutines every few thousand
+// placements. Hosts which cannot preempt the search, such as a browser
+// running the solver as WebAssembly, use it to handle pending events.
+func WithYield(fn func()) Option {
+	return func(s *Solver) error {
+		s.opts.Yield = fn
+		return nil
+	}
+}
+
+// WithParallelism limits the number of goroutines searching concurrently
+// to n. Zero means one goroutine per placement of the first piece.
+func WithParallelism(n int) Option {
+	return func(s *Solver) error {
+		if n < 0 {
+			return fmt.Errorf("invalid parallelism %d", n)
+		}
+		s.opts.Parallelism = n
+		return nil
+	}
+}
+
+// WithParDepth makes the workers of a parallel search hand placements up
+// to depth n to idle ones, and search the deeper ones themselves. Zero shares
+// those leaving at least three pieces and ParDepthAuto picks the depth for
+// the puzzle.
+func WithParDepth(n int) Option {
+	return func(s *Solver) error {
+		if n < ParDepthAuto {
+			return fmt.Errorf("invalid parallel depth %d", n)
+		}
+		s.opts.ParDepth = n
+		return nil
+	}
+}
+
+// WithStrategy selects the search strategy.
+func WithStrategy(st Strategy) Option {
+	return func(s *Solver) error {
+		if st < 0 || int(st) >= len(strategyNames) {
+			return fmt.Errorf("invalid strategy %v", st)
+		}
+		s.strategy = st
+		return nil
+	}
+}
+
+// WithProgress calls fn about once a second during the search and once when
+// it ends, always from the goroutine calling Solve.
+func WithProgress(fn func(Progress)) Option {
+	return func(s *Solver) error {
+		s.opts.Progress = fn
+		return nil
+	}
+}
+
+// WithLogger logs the phases of the search to l at level Info and every
+// pruned placement at level Debug. A nil logger disables logging.
+func WithLogger(l *slog.Logger) Option {
+	return func(s *Solver) error {
+		s.opts.Logger = l
+		return nil
+	}
+}
+
+// debugLogger returns the solver's logger if it logs at level Debug, and
+// nil otherwise, so that the search has a cheap test for it.
+func (s *Solver) debugLogger(ctx context.Context) *slog.Logger {
+	if s.opts.Logger == nil || !s.opts.Logger.Enabled(ctx, slog.LevelDebug) {
+		return nil
+	}
+	return s.opts.Logger
+}
+
+// logInfo logs msg at level Info if the solver has a logger.
+func (s *Solver) logInfo(ctx context.Context, msg string, attrs ...slog.Attr) {
+	if s.opts.Logger != nil {
+		s.opts.Logger.LogAttrs(ctx, slog.LevelInfo, msg, attrs...)
+	}
+}
+
+// WithParanoid validates the game after every placement of the search
+// and fails the search at the first violated invariant. It is slow and
+// meant for debugging.
+func WithParanoid() Option {
+	return func(s *Solver) error {
+		s.opts.Paranoid = true
+		return nil
+	}
+}
+
+// WithRand makes the search try the pieces and their placements in an
+// order drawn from r instead of the fixed one, so that the first solutions
+// found vary. With a parallelism of 1 the order of the solutions depends
+// only on r; otherwise it also depends on scheduling. r is only used by the
+// goroutine starting the search.
+func WithRand(r *rand.Rand) Option {
+	return func(s *Solver) error {
+		s.opts.Rand = r
+		return nil
+	}
+}
+
+// NewRand returns a random source for WithRand which produces the same
+// sequence for the same seed on every platform and Go version.
+func NewRand(seed uint64) *rand.Rand {
+	return rand.New(rand.NewPCG(seed, seed))
+}
+
+// WithPlacementScores makes the search try the placements of each piece
+// in the order of their scores for the class of the board, those part of
+// the most solutions recorded first, and then from the centre of the board
+// out, and records the solutions found in ps. Only the order of the
+// solutions changes. The order replaces that of the placements drawn by
+// WithRand.
+func WithPlacementScores(ps *PlacementScores) Option {
+	return func(s *Solver) error {
+		s.opts.Scores = ps
+		return nil
+	}
+}
+
+// memoryBytes returns the estimate of Metrics.MemoryBytes of a search
+// whose tables take the given bytes.
+func (s *Solver) memoryBytes(tables int64) int64 {
+	if s.opts.Tables != nil {
+		tables += s.opts.Tables.Stats().Bytes
+	}
+	return tables
+}
+
+// precompute returns the versions of the pieces, from the table cache if
+// the solver has one, shuffled if it has a random source and ordered if it
+// has placement scores.
+func (s *Solver) precompute(b *Board, ps []Piece) []pieceTable {
+	var res []pieceTable
+	if s.opts.Tables != nil {
+		res = s.opts.Tables.precompute(b, ps, s.strategy)
+	} else {
+		res = precompute(b, ps, s.strategy)
+	}
+	if r := s.opts.Rand; r != nil {
+		r.Shuffle(len(res), func(i, j int) { res[i], res[j] = res[j], res[i] })
+		for i := range res {
+			var vs = res[i].versions
+			r.Shuffle(len(vs), func(i, j int) { vs[i], vs[j] = vs[j], vs[i] })
+			res[i].list(b, s.strategy)
+		}
+	}
+	if s.opts.Scores != nil {
+		s.opts.Scores.order(b, res)
+	}
+	return res
+}
+
+// shuffleTasks shuffles the tasks if the solver has a random source.
+func (s *Solver) shuffleTasks(ts []task) []task {
+	if r := s.opts.Rand; r != nil {
+		r.Shuffle(len(ts), func(i, j int) { ts[i], ts[j] = ts[j], ts[i] })
+	}
+	return ts
+}
+
+// Hooks are callbacks invoked synchronously by the search. Nil hooks are
+// skipped. Hooks must not modify the game being searched; the solver panics
+// where it can detect that they did.
+type Hooks struct {
+	// OnSolution is called with every solution as soon as it is found,
+	// from the goroutine calling Solve. Solutions passed to it are counted
+	// but not kept in the result.
+	OnSolution func(Solution)
+	// OnPlace is called after a piece has been placed, with the number of
+	// pieces the search has placed so far. In a parallel search it is
+	// called concurrently from the searching goroutines.
+	OnPlace func(m Move, depth int)
+	// OnBacktrack is called after the piece placed at the given depth has
+	// been removed again. Like OnPlace, it may be called concurrently.
+	OnBacktrack func(depth int)
+	// OnPrune is called with a placement the search cut off, the depth it
+	// would have had and the kind of prune, one of the Prune constants.
+	// Like OnPlace, it may be called concurrently.
+	OnPrune func(m Move, depth int, kind string)
+}
+
+// WithHooks installs the hooks.
+func WithHooks(h Hooks) Option {
+	return func(s *Solver) error {
+		s.opts.Hooks = h
+		return nil
+	}
+}
+
+// WithOnSolution installs fn as the OnSolution hook.
+func WithOnSolution(fn func(Solution)) Option {
+	return func(s *Solver) error {
+		s.opts.Hooks.OnSolution = fn
+		return nil
+	}
+}
+
+// WithOnPrune installs fn as the OnPrune hook.
+func WithOnPrune(fn func(m Move, depth int, kind string)) Option {
+	return func(s *Solver) error {
+		s.opts.Hooks.OnPrune = fn
+		return nil
+	}
+}
+
+// Solve searches for the ways to complete the game with the given pieces,
+// using the solver's engine or, by default, its depth-first search. The
+// game is left unchanged. Cancelling ctx stops the search within a bounded
+// number of placements and returns an *AbortedError along with the
+// solutions found so far. Reaching the solver's own timeout is not an error.
+func (s *Solver) Solve(ctx context.Context, g *Game, ps []Piece) (SolveResult, error) {
+	var e = s.engine
+	if e == nil {
+		e = dfs{s.strategy}
+	}
+	var start = time.Now()
+	res, err := e.Solve(ctx, g.position(), ps, s.opts)
+	if res.Metrics.Duration == 0 {
+		res.Metrics.Duration = time.Since(start)
+	}
+	res.Status = res.status()
+	s.logInfo(ctx, "search finished", slog.String("status", res.Status.String()), slog.Int("solutions", res.Count), slog.Int64("nodes", res.Metrics.Nodes), slog.Duration("elapsed", res.Metrics.Duration))
+	return res, err
+}
+
+// solve is the parallel depth-first search behind the built-in engines.
+func (s *Solver) solve(ctx context.Context, g *Game, ps []Piece) (SolveResult, error) {
+	var (
+		start  = time.Now()
+		res    SolveResult
+		cache  = s.precompute(g.board, ps)
+		sctx   = ctx
+		cancel context.CancelFunc
+	)
+	s.logInfo(ctx, "precompute done", slog.Int("pieces", len(cache)), slog.Int("versions", countVersions(cache)), slog.Int("placements", countSpots(cache)), slog.Int64("bytes", tableBytes(cache)), slog.Duration("elapsed", time.Since(start)))
+	if s.opts.Scores != nil {
+		s.logInfo(ctx, "placements ordered by score", slog.String("class", BoardClass(g.board)), slog.Int64("solutions", s.opts.Scores.Solutions(g.board)))
+	}
+	if s.opts.Timeout > 0 {
+		sctx, cancel = context.WithTimeout(sctx, s.opts.Timeout)
+	} else {
+		sctx, cancel = context.WithCancel(sctx)
+	}
+	defer cancel()
+	g.prepare(cache)
+	var dead *deadSearch
+	if s.opts.DeadStates != nil {
+		// The tasks copy the numbers of the shapes of the pieces.
+		dead = s.opts.DeadStates.search(g.board, cache)
+	}
+	var (
+		tasks = s.shuffleTasks(g.firstMoves(cache, s.strategy))
+		ch    = make(chan Solution)
+		state = searchState{maxNodes: s.opts.MaxNodes, cancel: cancel, yield: s.opts.Yield, dead: dead}
+		wg    sync.WaitGroup
+		once  sync.Once
+		err   error
+	)
+	var workers = s.opts.Parallelism
+	if workers == 0 || len(tasks) == 0 {
+		workers = len(tasks)
+	}
+	state.counters = make(counters, workers)
+	var sched = newScheduler(workers, tasks)
+	sched.games.check = s.opts.Paranoid || raceEnabled
+	if workers > 1 {
+		sched.depth = s.parDepth(sctx, g, cache)
+	}
+	s.logInfo(ctx, "search started", slog.String("strategy", s.strategy.String()), slog.Int("tasks", len(tasks)), slog.Int("workers", workers), slog.Int("par_depth", sched.depth))
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for t, ok := sched.next(w); ok; t, ok = sched.next(w) {
+				if e := s.run(sctx, g, t, ch, &state, sched, w); e != nil {
+					once.Do(func() { err = e })
+					cancel()
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(ch)
+	}()
+
+	var tick <-chan time.Time
+	if s.opts.Progress != nil {
+		var t = time.NewTicker(cmp.Or(s.opts.progressEvery, progressInterval))
+		defer t.Stop()
+		tick = t.C
+		state.wantSnapshot.Store(true)
+	}
+	for done := false; !done; {
+		select {
+		case ms, ok := <-ch:
+			if !ok {
+				done = true
+				break
+			}
+			if s.opts.MaxSolutions > 0 && res.Count >= s.opts.MaxSolutions {
+				continue
+			}
+			res.Count++
+			if s.opts.Scores != nil {
+				s.opts.Scores.Record(g.board, ms)
+			}
+			s.logInfo(ctx, "solution found", slog.Int("count", res.Count), slog.Int64("nodes", state.counters.nodes()), slog.Duration("elapsed", time.Since(start)))
+			if res.Solution == nil {
+				res.Solution = ms
+			}
+			if s.opts.Hooks.OnSolution != nil {
+				s.opts.Hooks.OnSolution(ms)
+			} else {
+				res.Solutions = append(res.Solutions, ms)
+			}
+			if s.opts.MaxSolutions > 0 && res.Count >= s.opts.MaxSolutions {
+				cancel()
+			}
+		case <-tick:
+			s.opts.Progress(Progress{state.counters.nodes(), res.Count, time.Since(start), state.snapshot.Load()})
+			state.wantSnapshot.Store(true)
+		}
+	}
+	res.Metrics = state.counters.metrics()
+	res.Metrics.Duration = time.Since(start)
+	s.logInfo(ctx, "workers done", slog.Int("shared", sched.shared))
+	res.Metrics.Placements, res.Metrics.TableBytes = countSpots(cache), tableBytes(cache)
+	res.Metrics.MemoryBytes = s.memoryBytes(res.Metrics.TableBytes)
+	res.Metrics.Versions, res.Metrics.Transforms = countVersions(cache), len(tx)*len(cache)
+	res.Complete = err == nil && sctx.Err() == nil
+	res.Status = res.status()
+	if err != nil {
+		return res, err
+	}
+	if s.opts.Progress != nil {
+		s.opts.Progress(Progress{res.Metrics.Nodes, res.Count, res.Metrics.Duration, state.snapshot.Load()})
+	}
+	if err := ctx.Err(); err != nil {
+		return res, &AbortedError{err, res.Metrics.Nodes}
+	}
+	return res, nil
+}
+
+// AbortedError reports that a search was stopped by its context. It wraps
+// the context's error.
+type AbortedError struct {
+	Err error
+	// Nodes is the number of placements tried before the search stopped.
+	Nodes int64
+}
+
+func (e *AbortedError) Error() string {
+	return fmt.Sprintf("search aborted after %d placements: %v", e.Nodes, e.Err)
+}
+
+// Unwrap returns the context's error.
+func (e *AbortedError) Unwrap() error {
+	return e.Err
+}
+
+// searchState is shared by the goroutines of a parallel search.
+type searchState struct {
+	// counters are those of each worker.
+	counters counters
+	// wantSnapshot asks the next worker checking it to store a snapshot of
+	// its game in snapshot, so that snapshots are only taken when a
+	// progress report needs one.
+	wantSnapshot atomic.Bool
+	snapshot     atomic.Pointer[Snapshot]
+	// maxNodes, if not zero, cancels the search once nodes reaches it.
+	maxNodes int64
+	// dead, if not nil, holds the states without a solution.
+	dead   *deadSearch
+	cancel context.CancelFunc
+	yield  func()
+}
+
+// task is a placement searched by one worker: one of the first piece, or
+// one a worker shared, made on a copy of its game.
+type task struct {
+	// g is the game to make the placement on, owned by the task, or nil for
+	// a copy of the game searched.
+	g *Game
+	// id is the placement, covering the cells of mask.
+	id   int32
+	mask bitboard
+	rest []pieceTable
+}
+
+// firstMoves returns the placements the strategy tries first.
+func (g *Game) firstMoves(ps []pieceTable, st Strategy) []task {
+	var res []task
+	if len(ps) == 0 {
+		return nil
+	}
+	if st == FirstEmptyCell {
+		var first, ok = g.firstEmpty()
+		if !ok {
+			return nil
+		}
+		for i := len(ps) - 1; i >= 0; i-- {
+			var rest = without(ps, i)
+			for _, sp := range ps[i].covering(g.board.bit(first)) {
+				var _, mask = ps[i].spot(sp)
+				res = append(res, task{nil, ps[i].id(sp), mask, rest})
+			}
+		}
+		return res
+	}
+	var t = &ps[len(ps)-1]
+	for _, sp := range t.spots {
+		var _, mask = t.spot(sp)
+		res = append(res, task{nil, t.id(sp), mask, ps[:len(ps)-1]})
+	}
+	return res
+}
+
+// run searches the solutions starting with the task's placement on a copy
+// of g, or the game of the task, and sends them on ch. The worker w shares
+// placements with idle workers through sched, which takes the game back
+// once the search is done, and adds to its counters in state.
+func (s *Solver) run(ctx context.Context, g *Game, t task, ch chan<- Solution, state *searchState, sched *scheduler, w int) error {
+	var (
+		g2   = t.g
+		base = g.numMoves()
+		c    = &state.counters[w]
+	)
+	if ctx.Err() != nil {
+		if g2 != nil {
+			sched.games.put(g2)
+		}
+		return nil
+	}
+	if g2 == nil {
+		g2 = sched.games.get(g)
+	}
+	defer sched.games.put(g2)
+	var (
+		depth  = g2.numMoves() - base + 1
+		v, pos = g.ids.placement(t.id)
+	)
+	c.nodes.Add(1)
+	g2.reserve(len(t.rest) + 1)
+	if g2.addPlacement(t.id, len(v.piece.pos), t.mask) == blocked {
+		if s.opts.Hooks.OnPrune != nil {
+			s.opts.Hooks.OnPrune(Move{Piece: v.piece, Translate: pos}, depth, PruneBlocked)
+		}
+		c.blocked.Add(1)
+		return nil
+	}
+	if s.opts.Hooks.OnPlace != nil {
+		s.opts.Hooks.OnPlace(g2.moveAt(g2.numMoves()-1), depth)
+	}
+	if s.opts.Paranoid {
+		if err := g2.Validate(); err != nil {
+			return fmt.Errorf("invalid game at depth %d: %w", depth, err)
+		}
+	}
+	var sr = &searcher{g: g2, strategy: s.strategy, hooks: s.opts.Hooks, base: base, depth: depth, ctx: ctx, state: state, counters: c, log: s.debugLogger(ctx), paranoid: s.opts.Paranoid, sched: sched, worker: w, parDepth: sched.depth, slab: &sched.slabs[w], dead: state.dead, fn: func(ms []Move) bool {
+		select {
+		case ch <- ms:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}}
+	// The search reorders the pieces in place, and t.rest is shared.
+	_, err := sr.search(slices.Clone(t.rest))
+	if s.opts.Hooks.OnBacktrack != nil {
+		s.opts.Hooks.OnBacktrack(depth)
+	}
+	c.nodes.Add(sr.nodes - sr.reported)
+	c.backtracks.Add(sr.backtracks + 1)
+	c.blocked.Add(sr.blocked)
+	c.raiseDepth(max(sr.maxDepth, depth))
+	return err
+}
+
+// firstEmpty returns the first empty cell, scanning along the shorter side
+// of the board first so that the filled part keeps a short frontier.
+func (g *Game) firstEmpty() (Pos, bool) {
+	if g.board.cols > g.board.rows {
+		for y := 0; y < g.board.cols; y++ {
+			for x := 0; x < g.board.rows; x++ {
+				if !g.occupied(Pos{x, y}) {
+					return Pos{x, y}, true
+				}
+			}
+		}
+		return Pos{}, false
+	}
+	var n = g.board.rows * g.board.cols
+	if i := g.occ.first(n); i < n {
+		return Pos{i / g.board.cols, i % g.board.cols}, true
+	}
+	return Pos{}, false
+}
+
+// without returns a copy of ps without the element at index i.
+func without(ps []pieceTable, i int) []pieceTable {
+	var res = make([]pieceTable, 0, len(ps)-1)
+	res = append(res, ps[:i]...)
+	return append(res, ps[i+1:]...)
+}
+
+// searcher holds the state of a depth-first search on a game.
+type searcher struct {
+	g        *Game
+	strategy Strategy
+	fn       func([]Move) bool
+	hooks    Hooks
+	// base is the number of moves made before the search started.
+	base int
+	// ctx, if not nil, is polled every pollInterval placements.
+	ctx context.Context
+	// nodes counts the placements tried, and reported how many of them
+	// have been added to counters.
+	nodes, reported int64
+	state           *searchState
+	// counters, with state, are those of the worker searching.
+	counters *workerCounters
+	// aborted is set when the search stopped because ctx was done.
+	aborted bool
+	// backtracks, blocked and maxDepth feed the search's Metrics. depth
+	// is the depth already reached when the search started.
+	backtracks, blocked int64
+	depth, maxDepth     int
+	// log, if not nil, receives a Debug event for every pruned placement.
+	log *slog.Logger
+	// paranoid validates the game after every placement.
+	paranoid bool
+	// sched, if not nil, takes the placements up to depth parDepth the
+	// search shares with idle workers, as the worker with the index worker.
+	sched    *scheduler
+	worker   int
+	parDepth int
+	// slab, if not nil, holds the copies of the moves of the solutions.
+	slab *moveSlab
+	// dead, if not nil, holds the states without a solution, whose keys
+	// are built in deadKey using deadShapes. found counts the solutions
+	// and handed the placements shared, so that a state is only added if
+	// the search of all of it found none.
+	dead          *deadSearch
+	deadKey       []byte
+	deadShapes    []uint16
+	found, handed int64
+}
+
+// metrics returns the counters of the search.
+func (s *searcher) metrics() Metrics {
+	var m = Metrics{Nodes: s.nodes, Backtracks: s.backtracks, MaxDepth: max(s.maxDepth, s.depth)}
+	if s.blocked > 0 {
+		m.Prunes = map[string]int64{PruneBlocked: s.blocked}
+	}
+	return m
+}
+
+// search enumerates the ways to complete the game with the given pieces and
+// passes a copy of the moves made by the search for each to fn. It stops as soon as fn returns
+// false or the context is done, and reports whether it did so. The game is
+// left unchanged.
+func (s *searcher) search(ps []pieceTable) (bool, error) {
+	var g = s.g
+	if len(ps) == 0 {
+		if g.count != g.board.rows*g.board.cols {
+			// The pieces do not cover the free cells.
+			return false, nil
+		}
+		// The moves of the search are only made now.
+		var res []Move
+		if s.slab != nil {
+			res = s.slab.take(g.numMoves() - s.base)
+		} else {
+			res = make([]Move, g.numMoves()-s.base)
+		}
+		for i := range res {
+			res[i] = g.moveAt(s.base + i)
+		}
+		s.found++
+		return !s.fn(res), nil
+	}
+	if s.dead != nil && len(ps) >= deadStateMinPieces {
+		return s.searchDead(ps)
+	}
+	return s.place(ps)
+}
+
+// searchDead searches the state of the game with the pieces unless it is
+// one of the dead states, and adds it to them if the search of all of it
+// finds no solution.
+func (s *searcher) searchDead(ps []pieceTable) (bool, error) {
+	s.deadKey, s.deadShapes = s.dead.key(s.deadKey[:0], s.deadShapes, s.g.occ, ps)
+	if s.dead.has(s.deadKey) {
+		return false, nil
+	}
+	var found, handed = s.found, s.handed
+	stop, err := s.place(ps)
+	if !stop && err == nil && !s.aborted && s.found == found && s.handed == handed {
+		// The deeper states built their keys in the meantime.
+		s.deadKey, s.deadShapes = s.dead.key(s.deadKey[:0], s.deadShapes, s.g.occ, ps)
+		s.dead.add(s.deadKey)
+	}
+	return stop, err
+}
+
+// place tries the placements the strategy makes next with the pieces.
+func (s *searcher) place(ps []pieceTable) (bool, error) {
+	if s.strategy == FirstEmptyCell {
+		return s.coverFirst(ps)
+	}
+	var t = &ps[len(ps)-1]
+	for _, sp := range t.spots {
+		if s.share(t, sp, ps[:len(ps)-1]) {
+			continue
+		}
+		if stop, err := s.try(t, sp, ps[:len(ps)-1]); stop || err != nil {
+			return stop, err
+		}
+	}
+	return false, nil
+}
+
+// coverFirst tries every placement of the remaining pieces which covers the
+// first empty cell.
+func (s *searcher) coverFirst(ps []pieceTable) (bool, error) {
+	var first, ok = s.g.firstEmpty()
+	if !ok {
+		return false, nil
+	}
+	for i := len(ps) - 1; i >= 0; i-- {
+		toBack(ps, i)
+		stop, err := s.cover(s.g.board.bit(first), &ps[len(ps)-1], ps[:len(ps)-1])
+		fromBack(ps, i)
+		if stop || err != nil {
+			return stop, err
+		}
+	}
+	return false, nil
+}
+
+// cover tries every placement of the piece which covers the cell with bit
+// i.
+func (s *searcher) cover(i int, t *pieceTable, rest []pieceTable) (bool, error) {
+	for _, sp := range t.covering(i) {
+		if s.share(t, sp, rest) {
+			continue
+		}
+		if stop, err := s.try(t, sp, rest); stop || err != nil {
+			return stop, err
+		}
+	}
+	return false, nil
+}
+
+// toBack moves ps[i] to the end of ps, keeping the order of the others, so
+// that the search can go on with the others without copying them. fromBack
+// undoes it.
+func toBack(ps []pieceTable, i int) {
+	var t = ps[i]
+	copy(ps[i:], ps[i+1:])
+	ps[len(ps)-1] = t
+}
+
+func fromBack(ps []pieceTable, i int) {
+	var t = ps[len(ps)-1]
+	copy(ps[i+1:], ps[i:len(ps)-1])
+	ps[i] = t
+}
+
+// share hands the placement to an idle worker instead of trying it, if one
+// waits for work and the placement is no deeper than parDepth, so that the
+// subtree is worth the copy of the game. It reports whether it did.
+func (s *searcher) share(t *pieceTable, sp spot, rest []pieceTable) bool {
+	if s.sched == nil || s.g.numMoves()-s.base >= s.parDepth || !s.sched.hungry() {
+		return false
+	}
+	var _, mask = t.spot(sp)
+	s.sched.push(s.worker, task{s.sched.games.get(s.g), t.id(sp), mask, slices.Clone(rest)})
+	s.handed++
+	return true
+}
+
+// try makes the placement of the table and searches the completions with
+// the remaining pieces.
+func (s *searcher) try(t *pieceTable, sp spot, rest []pieceTable) (bool, error) {
+	s.nodes++
+	if s.ctx != nil && s.nodes%pollInterval == 0 {
+		if s.state != nil {
+			s.counters.nodes.Add(s.nodes - s.reported)
+			s.reported = s.nodes
+			if s.state.wantSnapshot.Load() && s.state.wantSnapshot.CompareAndSwap(true, false) {
+				s.state.snapshot.Store(s.g.Snapshot())
+			}
+			if s.state.maxNodes > 0 && s.state.counters.nodes() >= s.state.maxNodes {
+				s.state.cancel()
+			}
+			if s.state.yield != nil {
+				s.state.yield()
+			}
+		}
+		if s.ctx.Err() != nil {
+			s.aborted = true
+			return true, nil
+		}
+	}
+	var v, mask = t.spot(sp)
+	if s.g.addPlacement(t.id(sp), len(v.piece.pos), mask) == blocked {
+		s.blocked++
+		if s.log != nil {
+			s.logPrune(v.piece, sp.pos, PruneBlocked)
+		}
+		if s.hooks.OnPrune != nil {
+			s.hooks.OnPrune(Move{Piece: v.piece, Translate: sp.pos}, s.g.numMoves()-s.base+1, PruneBlocked)
+		}
+		return false, nil
+	}
+	var depth = s.g.numMoves() - s.base
+	s.maxDepth = max(s.maxDepth, depth)
+	if s.hooks.OnPlace != nil {
+		s.hooks.OnPlace(s.g.moveAt(s.g.numMoves()-1), depth)
+		if s.g.numMoves()-s.base != depth {
+			panic("iqpuzzler: OnPlace hook modified the game")
+		}
+	}
+	var (
+		stop bool
+		err  error
+	)
+	if s.paranoid {
+		if err = s.g.Validate(); err != nil {
+			err = fmt.Errorf("invalid game at depth %d: %w", depth, err)
+		}
+	}
+	if err == nil {
+		stop, err = s.search(rest)
+	}
+	if perr := s.g.Pop(); err == nil {
+		err = perr
+	}
+	s.backtracks++
+	if s.hooks.OnBacktrack != nil {
+		s.hooks.OnBacktrack(depth)
+		if s.g.numMoves()-s.base != depth-1 {
+			panic("iqpuzzler: OnBacktrack hook modified the game")
+		}
+	}
+	if err != nil {
+		return false, err
+	}
+	return stop, nil
+}
+
+// logPrune logs that the placement of piece at pos was cut off.
+func (s *searcher) logPrune(piece Piece, pos Pos, kind string) {
+	var ctx = s.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	s.log.LogAttrs(ctx, slog.LevelDebug, "placement pruned",
+		slog.String("piece", piece.name),
+		slog.Any("pos", pos),
+		slog.Int("depth", s.g.numMoves()-s.base+1),
+		slog.String("reason", kind))
+}
+
+// countSpots returns the number of placements in ps.
+func countSpots(ps []pieceTable) int {
+	var n int
+	for _, t := range ps {
+		n += t.n
+	}
+	return n
+}
+
+// tableBytes returns the memory held by the tables.
+func tableBytes(ps []pieceTable) int64 {
+	var n int64
+	for i := range ps {
+		n += ps[i].bytes()
+	}
+	return n
+}
+
+// countVersions returns the number of piece versions in ps.
+func countVersions(ps []pieceTable) int {
+	var n int
+	for _, t := range ps {
+		n += len(t.versions)
+	}
+	return n
+}
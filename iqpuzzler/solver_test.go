@@ -0,0 +1,212 @@
+package iqpuzzler
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync/atomic"
+	"testing"
+)
+
+// TestSeed checks that a shuffled search with one worker finds the same
+// solutions in the same order for the same seed, and in another order for
+// another seed.
+func TestSeed(t *testing.T) {
+	var b, ps = testPuzzle(t)
+	var first = func(seed uint64) []Solution {
+		t.Helper()
+		s, err := NewSolver(WithStrategy(FirstEmptyCell), WithParallelism(1), WithMaxSolutions(20), WithRand(NewRand(seed)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := s.Solve(context.Background(), NewGame(b), ps)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Solutions) != 20 {
+			t.Fatalf("seed %d: got %d solutions, want 20", seed, len(res.Solutions))
+		}
+		return res.Solutions
+	}
+	var a = first(42)
+	if b := first(42); !reflect.DeepEqual(a, b) {
+		t.Errorf("the seed 42 gave the solutions\n%v\nand then\n%v", a, b)
+	}
+	if c := first(43); reflect.DeepEqual(a, c) {
+		t.Error("the seeds 42 and 43 gave the same solutions")
+	}
+}
+
+// TestSolveAreaMismatch checks that pieces which do not cover the free cells
+// make a puzzle unsolvable rather than an error, whether they are too large
+// or too small.
+func TestSolveAreaMismatch(t *testing.T) {
+	var set, _ = LookupPieceSet("pentomino")
+	var tests = []struct {
+		name, pieces string
+	}{
+		// The pieces placed first fill the board, leaving x without room.
+		{"oversized", "x,l,p,v"},
+		{"undersized", "l,p"},
+	}
+	for _, test := range tests {
+		ps, err := ParseAvailable(test.pieces, set.Pieces)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := ParseBoard("00000,00000,00000", 3, 5, set.Pieces, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, name := range EngineNames() {
+			for _, par := range []int{1, 4} {
+				e, _ := LookupEngine(name)
+				s, err := NewSolver(WithEngine(e), WithParallelism(par))
+				if err != nil {
+					t.Fatal(err)
+				}
+				res, err := s.Solve(context.Background(), NewGame(b), ps)
+				if err != nil {
+					t.Errorf("%s/%s/parallelism=%d: %v", test.name, name, par, err)
+					continue
+				}
+				if res.Count != 0 || !res.Complete {
+					t.Errorf("%s/%s/parallelism=%d: got %d solutions, complete %v, want an unsolvable puzzle", test.name, name, par, res.Count, res.Complete)
+				}
+			}
+		}
+	}
+}
+
+// TestSolveCancel cancels an enumeration after a few solutions and checks
+// that the search stops within a poll interval of placements, returns an
+// *AbortedError and leaves the game as it was.
+func TestSolveCancel(t *testing.T) {
+	var (
+		b, ps       = testPuzzle(t)
+		g           = NewGame(b)
+		ctx, cancel = context.WithCancel(context.Background())
+		solutions   int
+		cancelled   atomic.Bool
+		after       atomic.Int64
+	)
+	defer cancel()
+	var moves, cells = g.Moves(), g.Cells()
+	s, err := NewSolver(WithStrategy(FirstEmptyCell), WithParallelism(1), WithHooks(Hooks{
+		OnSolution: func(Solution) {
+			if solutions++; solutions == 3 {
+				cancelled.Store(true)
+				cancel()
+			}
+		},
+		OnPlace: func(Move, int) {
+			if cancelled.Load() {
+				after.Add(1)
+			}
+		},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := s.Solve(ctx, g, ps)
+	var ae *AbortedError
+	if !errors.As(err, &ae) || !errors.Is(err, context.Canceled) {
+		t.Fatalf("Solve = %v, want an *AbortedError wrapping context.Canceled", err)
+	}
+	if res.Complete || res.Count != 3 {
+		t.Errorf("got %d solutions, complete %t, want 3 of an incomplete search", res.Count, res.Complete)
+	}
+	if n := after.Load(); n > pollInterval {
+		t.Errorf("%d placements after cancelling, more than %d", n, pollInterval)
+	}
+	if !reflect.DeepEqual(g.Moves(), moves) || !reflect.DeepEqual(g.Cells(), cells) {
+		t.Errorf("the game changed from %v to %v", moves, g.Moves())
+	}
+}
+
+// TestSearchContextCancel checks that the game is restored when
+// SearchContext is cancelled in the middle of the search.
+func TestSearchContextCancel(t *testing.T) {
+	var (
+		b, ps       = miniPuzzle(t)
+		g           = NewGame(b)
+		ctx, cancel = context.WithCancel(context.Background())
+		solutions   int
+	)
+	defer cancel()
+	var moves, cells = g.Moves(), g.Cells()
+	_, err := g.SearchContext(ctx, ps, func([]Move) bool {
+		if solutions++; solutions == 1 {
+			cancel()
+		}
+		return true
+	})
+	var ae *AbortedError
+	if !errors.As(err, &ae) || !errors.Is(err, context.Canceled) {
+		t.Fatalf("SearchContext = %v, want an *AbortedError wrapping context.Canceled", err)
+	}
+	if !reflect.DeepEqual(g.Moves(), moves) || !reflect.DeepEqual(g.Cells(), cells) {
+		t.Errorf("the game changed from %v to %v", moves, g.Moves())
+	}
+}
+
+func TestSearchAllocations(t *testing.T) {
+	if raceEnabled {
+		t.Skip("the invariant checks of race builds allocate")
+	}
+	var b, ps = miniPuzzle(t)
+	for _, st := range []Strategy{PieceOrder, FirstEmptyCell} {
+		t.Run(st.String(), func(t *testing.T) {
+			var (
+				g         = NewGame(b)
+				tables    = precompute(b, ps, st)
+				solutions int
+				s         = &searcher{g: g, strategy: st, fn: func([]Move) bool {
+					solutions++
+					return true
+				}}
+			)
+			g.prepare(tables)
+			// Placing and taking back the pieces allocates nothing, only the
+			// copies of the three solutions are, and the search leaves the
+			// game as it found it for the next run.
+			var allocs = testing.AllocsPerRun(10, func() {
+				if _, err := s.search(tables); err != nil {
+					t.Fatal(err)
+				}
+			})
+			if allocs > 3 {
+				t.Errorf("%v allocations in a search of %d placements, want at most 3", allocs, s.nodes/11)
+			}
+			if solutions != 3*11 {
+				t.Errorf("%d solutions in 11 searches, want %d", solutions, 3*11)
+			}
+		})
+	}
+}
+
+// testPuzzle returns the standard board with the yellow, violet and
+// turquoise pieces placed in the top left corner, which has 1708 solutions,
+// and the pieces left.
+func testPuzzle(t testing.TB) (*Board, []Piece) {
+	t.Helper()
+	b, err := ParseBoard("LLLL.......,KL.........,KK.........,JKK........,JJ.........", 5, 11, standardPieces, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b, Unplaced(b, standardPieces)
+}
+
+// miniPuzzle returns a puzzle on the 4x5 board with three solutions.
+func miniPuzzle(t testing.TB) (*Board, []Piece) {
+	t.Helper()
+	b, err := ParseBoard("4x5:x12.x6.", 4, 5, standardPieces, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ps, err := ParseAvailable("blue,green,mint,red", standardPieces)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b, ps
+}
@@ -0,0 +1,373 @@
+package iqpuzzler
+
+import (
+	"context"
+	"fmt"
+	"math/bits"
+	"time"
+	"unsafe"
+)
+
+// stackEngine is the first-empty-cell search kept in an explicit stack of
+// frames instead of the call stack. It numbers the cells in the order the
+// recursive search fills them, so that the first empty cell is the lowest
+// bit not set, and lists the placements covering each cell by piece in one
+// flat array, each with its mask, so that trying a placement is a load and
+// an AND. It searches in one goroutine, and covers boards of up to 64 cells
+// with up to 64 pieces; others fall back to the recursive search. Only the
+// OnSolution hook is called.
+type stackEngine struct{}
+
+// stackPlacement is a placement in the flat tables of the stack engine.
+type stackPlacement struct {
+	// mask holds the cells covered, numbered in the order they are filled.
+	mask uint64
+	// id is the index of the placement in stackTables.moves.
+	id int32
+}
+
+// stackMove is a placement as the moves of the solutions need it, with
+// its mask.
+type stackMove struct {
+	piece, v int32
+	pos      Pos
+	mask     uint64
+}
+
+// stackTables are the placements of the pieces on a board: those of piece
+// p covering the cell filled r-th are at[start[r*n+p]:start[r*n+p+1]], for
+// n pieces.
+type stackTables struct {
+	at    []stackPlacement
+	start []int32
+	moves []stackMove
+}
+
+// stackFrame is a cell of the search being covered: the cells occupied and
+// the pieces left before it, the piece whose placements are tried and the
+// range of them left to try. It holds no pointers, so that the stack could
+// be saved and resumed.
+type stackFrame struct {
+	occ, left uint64
+	cell      int32
+	piece     int32
+	next, end int32
+}
+
+func (stackEngine) Solve(ctx context.Context, b *Board, ps []Piece, opts Options) (SolveResult, error) {
+	var n = b.rows * b.cols
+	if n > 64 || len(ps) > 64 {
+		return dfs{FirstEmptyCell}.Solve(ctx, b, ps, opts)
+	}
+	var (
+		start  = time.Now()
+		s      = &Solver{opts: opts, strategy: FirstEmptyCell}
+		cache  = s.precompute(b, ps)
+		order  = fillOrder(b)
+		tables = newStackTables(b, cache, order)
+		occ    = ^uint64(0) << n
+	)
+	for c, r := range order {
+		if b.marks[c/b.cols][c%b.cols] != 0 {
+			occ |= 1 << r
+		}
+	}
+	var sctx, cancel = context.WithCancel(ctx)
+	if opts.Timeout > 0 {
+		sctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+	}
+	defer cancel()
+	var r = stackRun{b: b, cache: cache, tables: &tables, opts: opts, ctx: sctx, start: start}
+	err := r.search(occ, uint64(1)<<len(ps)-1)
+	var res = r.res
+	res.Metrics.Duration = time.Since(start)
+	if r.blocked > 0 {
+		res.Metrics.Prunes = map[string]int64{PruneBlocked: r.blocked}
+	}
+	res.Metrics.Placements, res.Metrics.TableBytes = countSpots(cache), tableBytes(cache)+tables.bytes()
+	res.Metrics.MemoryBytes = s.memoryBytes(res.Metrics.TableBytes)
+	res.Metrics.Versions, res.Metrics.Transforms = countVersions(cache), len(tx)*len(cache)
+	res.Complete = err == nil && !r.stopped
+	if err != nil {
+		return res, err
+	}
+	if opts.Progress != nil {
+		opts.Progress(Progress{res.Metrics.Nodes, res.Count, res.Metrics.Duration, nil})
+	}
+	if err := ctx.Err(); err != nil {
+		return res, &AbortedError{err, res.Metrics.Nodes}
+	}
+	return res, nil
+}
+
+// fillOrder returns the rank of every cell, by bit, in the order
+// firstEmpty finds them: along the shorter side of the board first.
+func fillOrder(b *Board) []int {
+	var (
+		res = make([]int, b.rows*b.cols)
+		r   int
+	)
+	if b.cols > b.rows {
+		for y := 0; y < b.cols; y++ {
+			for x := 0; x < b.rows; x++ {
+				res[b.bit(Pos{x, y})] = r
+				r++
+			}
+		}
+		return res
+	}
+	for i := range res {
+		res[i] = i
+	}
+	return res
+}
+
+// newStackTables lists the placements of the tables by the cells they
+// cover, in the order given, with their masks renumbered to it.
+func newStackTables(b *Board, ts []pieceTable, order []int) stackTables {
+	var (
+		n     = b.rows * b.cols
+		res   = stackTables{start: make([]int32, 1, n*len(ts)+1)}
+		cells = make([]int, n)
+		base  = make([][]int32, len(ts))
+		size  int
+		total int
+		buf   []int
+	)
+	for c, r := range order {
+		cells[r] = c
+	}
+	// The placements are numbered by table, version and translation, and
+	// their moves filled in as they are first met.
+	for p := range ts {
+		var t = &ts[p]
+		base[p] = make([]int32, len(t.versions))
+		for i := range t.versions {
+			base[p][i] = int32(size)
+			size += t.versions[i].n[0] * t.versions[i].n[1]
+		}
+		total += len(t.cover)
+	}
+	res.at = make([]stackPlacement, 0, total)
+	res.moves = make([]stackMove, size)
+	for _, c := range cells {
+		for p := range ts {
+			var t = &ts[p]
+			for _, sp := range t.covering(c) {
+				var (
+					id = base[p][sp.v] + sp.mask/int32(t.versions[sp.v].words)
+					mv = &res.moves[id]
+				)
+				if mv.mask == 0 {
+					var _, mask = t.spot(sp)
+					*mv = stackMove{int32(p), sp.v, sp.pos, 0}
+					buf = mask.bits(buf[:0])
+					for _, i := range buf {
+						mv.mask |= 1 << order[i]
+					}
+				}
+				res.at = append(res.at, stackPlacement{mv.mask, id})
+			}
+			res.start = append(res.start, int32(len(res.at)))
+		}
+	}
+	return res
+}
+
+// bytes returns the memory held by the tables.
+func (t *stackTables) bytes() int64 {
+	return int64(cap(t.at))*int64(unsafe.Sizeof(stackPlacement{})) +
+		int64(cap(t.start))*4 +
+		int64(cap(t.moves))*int64(unsafe.Sizeof(stackMove{}))
+}
+
+// stackRun is a search of the stack engine.
+type stackRun struct {
+	b      *Board
+	cache  []pieceTable
+	tables *stackTables
+	opts   Options
+	ctx    context.Context
+	start  time.Time
+	res    SolveResult
+	// stopped is set when a limit or the context ended the search early.
+	stopped bool
+	// blocked counts the placements overlapping the occupied cells.
+	blocked int64
+	// placed holds the placement made at each depth.
+	placed []int32
+	slab   moveSlab
+	// reported is the time of the last progress report.
+	reported time.Time
+}
+
+// search covers the cells left empty by occ with the pieces left, by the
+// bits of left.
+func (r *stackRun) search(occ, left uint64) error {
+	if left == 0 {
+		return nil
+	}
+	var (
+		np       = int32(len(r.cache))
+		at       = r.tables.at
+		start    = r.tables.start
+		stack    = make([]stackFrame, np)
+		placed   = make([]int32, np)
+		d        = 0
+		m        = &r.res.Metrics
+		paranoid = r.opts.Paranoid
+		// nodes counts the placements tried, and poll is the count at
+		// which the limits are checked next.
+		nodes, poll int64 = 0, pollInterval
+	)
+	defer func() { m.Nodes = nodes }()
+	r.placed, r.reported = placed, r.start
+	enterFrame(&stack[0], occ, left, start, np)
+	for {
+		var f = &stack[d]
+		// Skip the placements overlapping the occupied cells. The loop
+		// indexes the placements left rather than at, with k below their
+		// length, so that it has no bounds checks.
+		var (
+			cands = at[f.next:f.end]
+			k     = 0
+		)
+		for k < len(cands) && f.occ&cands[k].mask != 0 {
+			k++
+		}
+		nodes += int64(k)
+		r.blocked += int64(k)
+		if k == len(cands) {
+			f.next = f.end
+			// Go on with the next piece, or back to the previous cell.
+			var lower uint64
+			if f.piece > 0 {
+				lower = f.left & (uint64(1)<<uint(f.piece) - 1)
+			}
+			if lower == 0 {
+				if d == 0 {
+					return nil
+				}
+				d--
+				m.Backtracks++
+				continue
+			}
+			f.piece = int32(63 - bits.LeadingZeros64(lower))
+			f.next, f.end = start[f.cell*np+f.piece], start[f.cell*np+f.piece+1]
+			continue
+		}
+		var pl = cands[k]
+		f.next += int32(k) + 1
+		nodes++
+		if nodes >= poll {
+			poll = nodes + pollInterval
+			m.Nodes = nodes
+			if r.poll() {
+				r.stopped = true
+				return nil
+			}
+		}
+		placed[d] = pl.id
+		if paranoid {
+			if err := r.check(stack[:d+1]); err != nil {
+				return err
+			}
+		}
+		var occ, left = f.occ | pl.mask, f.left &^ (1 << uint(f.piece))
+		m.MaxDepth = max(m.MaxDepth, d+1)
+		if left == 0 {
+			m.Backtracks++
+			if occ != ^uint64(0) {
+				// The pieces do not cover the free cells.
+				continue
+			}
+			m.Nodes = nodes
+			if r.solution(d + 1) {
+				r.stopped = true
+				return nil
+			}
+			continue
+		}
+		d++
+		enterFrame(&stack[d], occ, left, start, np)
+	}
+}
+
+// enterFrame fills in the frame covering the first empty cell of occ with
+// the last of the pieces left, or without placements to try if the board is
+// full.
+func enterFrame(f *stackFrame, occ, left uint64, start []int32, np int32) {
+	var (
+		cell = int32(bits.TrailingZeros64(^occ))
+		p    = int32(63 - bits.LeadingZeros64(left))
+	)
+	*f = stackFrame{occ: occ, left: left, cell: cell, piece: p}
+	if cell < 64 {
+		f.next, f.end = start[cell*np+p], start[cell*np+p+1]
+	}
+}
+
+// solution reports the solution of the first n placements, and whether the
+// search should stop.
+func (r *stackRun) solution(n int) bool {
+	var sol = Solution(r.slab.take(n))
+	for i, id := range r.placed[:n] {
+		var (
+			mv = r.tables.moves[id]
+			m  = Move{Piece: r.cache[mv.piece].versions[mv.v].piece, Translate: mv.pos}
+		)
+		if r.b.wrap {
+			m.wrap = Pos{r.b.rows, r.b.cols}
+		}
+		sol[i] = m
+	}
+	r.res.Count++
+	if r.opts.Scores != nil {
+		r.opts.Scores.Record(r.b, sol)
+	}
+	if r.res.Solution == nil {
+		r.res.Solution = sol
+	}
+	if r.opts.Hooks.OnSolution != nil {
+		r.opts.Hooks.OnSolution(sol)
+	} else {
+		r.res.Solutions = append(r.res.Solutions, sol)
+	}
+	return r.opts.MaxSolutions > 0 && r.res.Count >= r.opts.MaxSolutions
+}
+
+// poll checks the limits of the search every pollInterval placements,
+// reporting progress, and reports whether the search should stop.
+func (r *stackRun) poll() bool {
+	if r.opts.Yield != nil {
+		r.opts.Yield()
+	}
+	if r.opts.Progress != nil {
+		if now := time.Now(); now.Sub(r.reported) >= progressInterval {
+			r.reported = now
+			r.opts.Progress(Progress{r.res.Metrics.Nodes, r.res.Count, now.Sub(r.start), nil})
+		}
+	}
+	if r.opts.MaxNodes > 0 && r.res.Metrics.Nodes >= r.opts.MaxNodes {
+		return true
+	}
+	return r.ctx.Err() != nil
+}
+
+// check checks that the cells occupied at each frame are those occupied at
+// the first and covered by the placements made before it, and that no two
+// placements overlap.
+func (r *stackRun) check(stack []stackFrame) error {
+	var occ = stack[0].occ
+	for d, f := range stack {
+		if f.occ != occ {
+			return fmt.Errorf("invalid game at depth %d: %d cells occupied, want %d", d+1, bits.OnesCount64(f.occ), bits.OnesCount64(occ))
+		}
+		var mask = r.tables.moves[r.placed[d]].mask
+		if occ&mask != 0 {
+			return fmt.Errorf("invalid game at depth %d: placement %d overlaps", d+1, r.placed[d])
+		}
+		occ |= mask
+	}
+	return nil
+}
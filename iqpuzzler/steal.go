@@ -0,0 +1,179 @@
+package iqpuzzler
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+// ParDepthAuto makes the search pick the deepest placement its workers
+// share from the branching of its first probeNodes placements: the deepest
+// one whose subtree is likely to hold minTaskNodes.
+const ParDepthAuto = -1
+
+// minShare is the fewest pieces a placement must leave for a worker to
+// hand it to an idle one by default, so that the tasks shared are worth the
+// copy of the game they take.
+const minShare = 3
+
+// probeNodes is the length of the probe of ParDepthAuto, and minTaskNodes
+// the placements it wants under a placement shared.
+const (
+	probeNodes   = 4096
+	minTaskNodes = 1024
+)
+
+// scheduler hands the tasks of a parallel search to its workers. Every
+// worker has a deque of tasks: it takes the last one of its own, the one
+// it shared most recently, and an idle worker steals the first, oldest and
+// so largest, one of another. Every task is taken exactly once, and the
+// workers stop once all are idle with no task left.
+type scheduler struct {
+	mu     sync.Mutex
+	cond   sync.Cond
+	deques [][]task
+	// idle counts the workers waiting for a task, and done is set once
+	// all of them are.
+	idle int
+	done bool
+	// shared counts the tasks pushed by the workers, which share the
+	// placements up to depth.
+	shared int
+	depth  int
+	// starved is the number of idle workers minus the tasks queued, read
+	// without the lock by the workers deciding whether to share.
+	starved atomic.Int64
+	// games holds the copies of the game the tasks are searched on, and
+	// slabs the solutions of each worker.
+	games gamePool
+	slabs []moveSlab
+}
+
+// newScheduler returns a scheduler splitting the tasks between the
+// workers in order, each taking its share from first to last.
+func newScheduler(workers int, tasks []task) *scheduler {
+	var q = &scheduler{deques: make([][]task, workers), slabs: make([]moveSlab, workers)}
+	q.cond.L = &q.mu
+	for w := range q.deques {
+		var share = tasks[w*len(tasks)/workers : (w+1)*len(tasks)/workers]
+		for i := len(share) - 1; i >= 0; i-- {
+			q.deques[w] = append(q.deques[w], share[i])
+		}
+	}
+	q.starved.Store(-int64(len(tasks)))
+	return q
+}
+
+// next returns the next task of the worker, waiting for one while others
+// are still searching. It returns false once the search is done.
+func (q *scheduler) next(w int) (task, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for !q.done {
+		if d := q.deques[w]; len(d) > 0 {
+			q.deques[w] = d[:len(d)-1]
+			q.starved.Add(1)
+			return d[len(d)-1], true
+		}
+		for i := 1; i < len(q.deques); i++ {
+			var v = (w + i) % len(q.deques)
+			if d := q.deques[v]; len(d) > 0 {
+				q.deques[v] = d[1:]
+				q.starved.Add(1)
+				return d[0], true
+			}
+		}
+		q.idle++
+		q.starved.Add(1)
+		if q.idle == len(q.deques) {
+			q.done = true
+			q.cond.Broadcast()
+			break
+		}
+		q.cond.Wait()
+		q.idle--
+		q.starved.Add(-1)
+	}
+	return task{}, false
+}
+
+// push adds a task shared by the worker to its deque.
+func (q *scheduler) push(w int, t task) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.deques[w] = append(q.deques[w], t)
+	q.shared++
+	q.starved.Add(-1)
+	q.cond.Signal()
+}
+
+// hungry reports whether a worker waits for a task none is queued for.
+func (q *scheduler) hungry() bool {
+	return q.starved.Load() > 0
+}
+
+// parDepth returns the deepest placement the workers searching the game
+// with the tables share, by the depth of the options, the last one leaving
+// minShare pieces without one or, with ParDepthAuto, by probing the search.
+func (s *Solver) parDepth(ctx context.Context, g *Game, ps []pieceTable) int {
+	switch s.opts.ParDepth {
+	case 0:
+		return len(ps) - minShare
+	case ParDepthAuto:
+		return s.probeParDepth(ctx, g, ps)
+	}
+	return s.opts.ParDepth
+}
+
+// probeParDepth searches a copy of the game for probeNodes placements,
+// counting those tried and made at every depth, and returns the deepest at
+// which a placement made leads to minTaskNodes more by their ratios, at
+// least 1. The depths near the first are undercounted, as the probe only
+// saw some of their subtrees, but those are the ones any threshold shares.
+func (s *Solver) probeParDepth(ctx context.Context, g *Game, ps []pieceTable) int {
+	var (
+		tried, made []int64
+		count       = func(n *[]int64, depth int) {
+			for len(*n) < depth {
+				*n = append(*n, 0)
+			}
+			(*n)[depth-1]++
+		}
+		pctx, cancel = context.WithCancel(ctx)
+		state        = searchState{maxNodes: probeNodes, cancel: cancel, counters: make(counters, 1)}
+	)
+	defer cancel()
+	var sr = &searcher{g: g.Clone(), strategy: s.strategy, base: g.numMoves(), ctx: pctx, state: &state, counters: &state.counters[0], fn: func([]Move) bool { return true }, hooks: Hooks{
+		OnPlace: func(_ Move, depth int) {
+			count(&tried, depth)
+			count(&made, depth)
+		},
+		OnPrune: func(_ Move, depth int, _ string) {
+			count(&tried, depth)
+		},
+	}}
+	sr.search(slices.Clone(ps))
+	// below is the estimate of the placements tried under one made at the
+	// depth, from the deepest up.
+	var (
+		below float64
+		at    = func(n []int64, i int) float64 {
+			if i < len(n) {
+				return float64(n[i])
+			}
+			return 0
+		}
+	)
+	for d := len(made); d >= 1; d-- {
+		var next, share = at(tried, d), 0.0
+		if next > 0 {
+			share = at(made, d) / next
+		}
+		below = next / at(made, d-1) * (1 + share*below)
+		if below >= minTaskNodes {
+			return d
+		}
+	}
+	return 1
+}
@@ -0,0 +1,132 @@
+package iqpuzzler
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sync"
+	"testing"
+)
+
+// countDistinct counts the solutions of the puzzle with the options,
+// returning the result and how often each distinct solution was found.
+func countDistinct(t *testing.T, b *Board, ps []Piece, opts Options) (SolveResult, map[string]int) {
+	t.Helper()
+	var (
+		mu       sync.Mutex
+		distinct = make(map[string]int)
+	)
+	opts.Hooks.OnSolution = func(sol Solution) {
+		if err := VerifySolution(b, ps, sol); err != nil {
+			t.Errorf("invalid solution: %v", err)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		distinct[sol.Canonical()]++
+	}
+	res, err := dfs{FirstEmptyCell}.Solve(context.Background(), b, ps, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Complete {
+		t.Fatalf("the search stopped early, status %s", res.Status)
+	}
+	return res, distinct
+}
+
+// TestWorkStealing counts the solutions with workers stealing each other's
+// work, sharing down to several depths, and checks that they find every
+// solution of the sequential search exactly as often, in as many
+// placements: that no branch is searched twice or missed.
+func TestWorkStealing(t *testing.T) {
+	// The maroon piece at the right edge leaves 68 solutions of the
+	// puzzle, in subtrees of very different sizes.
+	b, err := ParseBoard("LLLL......D,KL.......DD,KK.......D.,JKK........,JJ.........", 5, 11, standardPieces, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ps = Unplaced(b, standardPieces)
+	one, want := countDistinct(t, b, ps, Options{Parallelism: 1})
+	if one.Count != 68 || len(want) != 68 {
+		t.Fatalf("one worker found %d solutions, %d distinct, want 68", one.Count, len(want))
+	}
+	var runs = 3
+	if testing.Short() || raceEnabled {
+		runs = 1
+	}
+	for _, workers := range []int{2, 4, 16, 64} {
+		for _, depth := range []int{1, 0, 16, ParDepthAuto} {
+			t.Run(fmt.Sprintf("%d workers depth %d", workers, depth), func(t *testing.T) {
+				for range runs {
+					res, got := countDistinct(t, b, ps, Options{Parallelism: workers, ParDepth: depth})
+					if res.Count != one.Count || res.Metrics.Nodes != one.Metrics.Nodes {
+						t.Errorf("found %d solutions in %d placements, one worker %d in %d", res.Count, res.Metrics.Nodes, one.Count, one.Metrics.Nodes)
+					}
+					if !maps.Equal(got, want) {
+						t.Errorf("found %d distinct solutions, not those of one worker", len(got))
+					}
+				}
+			})
+		}
+	}
+}
+
+// TestWorkStealingFirst checks that the workers stop together once the
+// first solution is found.
+func TestWorkStealingFirst(t *testing.T) {
+	var b, ps = testPuzzle(t)
+	for _, workers := range []int{4, 64} {
+		var found int
+		res, err := dfs{FirstEmptyCell}.Solve(context.Background(), b, ps, Options{
+			Parallelism:  workers,
+			MaxSolutions: 1,
+			Hooks:        Hooks{OnSolution: func(Solution) { found++ }},
+		})
+		switch {
+		case err != nil:
+			t.Fatal(err)
+		case res.Status != Solved || res.Count != 1 || found != 1:
+			t.Errorf("%d workers: status %s with %d solutions, %d reported, want 1", workers, res.Status, res.Count, found)
+		}
+	}
+}
+
+// TestParallelCount counts the tilings of the pentomino 3x20 rectangle
+// repeatedly with 1, 4 and 16 workers and checks that every count is
+// complete and the same, in as many placements: the workers share the
+// subtrees in the order they run out of work, which changes from run to
+// run, but each subtree is searched by exactly one of them.
+func TestParallelCount(t *testing.T) {
+	var (
+		p    = presets["pentomino-3x20"]
+		b    = NewBoard(p.Rows, p.Cols)
+		ps   = pieceSets[p.Set].Pieces
+		runs = 5
+	)
+	if testing.Short() || raceEnabled {
+		runs = 2
+	}
+	var want SolveResult
+	for _, workers := range []int{1, 4, 16} {
+		for run := range runs {
+			res, err := dfs{FirstEmptyCell}.Solve(context.Background(), b, ps, Options{
+				Parallelism: workers,
+				Hooks:       Hooks{OnSolution: func(Solution) {}},
+			})
+			switch {
+			case err != nil:
+				t.Fatal(err)
+			case !res.Complete:
+				t.Fatalf("%d workers: run %d stopped early, status %s", workers, run+1, res.Status)
+			case workers == 1 && run == 0:
+				want = res
+			case res.Count != want.Count || res.Metrics.Nodes != want.Metrics.Nodes:
+				t.Errorf("%d workers: run %d found %d solutions in %d placements, one worker %d in %d",
+					workers, run+1, res.Count, res.Metrics.Nodes, want.Count, want.Metrics.Nodes)
+			}
+		}
+	}
+	if want.Count != 8 {
+		t.Errorf("found %d tilings, want 8", want.Count)
+	}
+}
@@ -0,0 +1,519 @@
+package iqpuzzler
+
+import (
+	"bytes"
+	"cmp"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"maps"
+	"math/rand/v2"
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
+// StoreFormatVersion is the version of the store file format. Version 1
+// stored solutions as JSON moves; version 2 stores them as the records of
+// a RecordCodec. Stores of version 1 are still read, and added to in their
+// format.
+const StoreFormatVersion = 2
+
+// A store file keeps the solutions of many puzzles. Like a solution file it
+// is a sequence of JSON values, one per line: a header with the version,
+// followed by records each holding either a board or a solution of a board
+// recorded before it. Records are only ever appended, each with a single
+// write, so that readers can read the file while a solve is writing it;
+// they ignore a last line without its newline, which is still being written
+// or was cut short by a crash. Records of kinds a reader does not know are
+// skipped, so that later versions may add them.
+//
+// The records of the solutions of a board are only meaningful with the
+// placements of its codec, which the board record holds the hash of, along
+// with the shapes of the pieces to rebuild it from; a store whose hash
+// differs from the one this solver computes can still be listed and
+// counted, but its solutions cannot be read or added to.
+
+// StoredBoard is a puzzle in a store.
+type StoredBoard struct {
+	// Hash identifies the puzzle, see BoardHash.
+	Hash  string `json:"hash"`
+	Board *Board `json:"board"`
+	Wrap  bool   `json:"wrap,omitempty"`
+	// Set identifies the piece set like the one of SolutionHeader.
+	Set     string    `json:"set"`
+	Pieces  []string  `json:"pieces"`
+	Solver  string    `json:"solver,omitempty"`
+	Created time.Time `json:"created"`
+	// Shapes are the pieces, in the order of their names, and Table the
+	// hash of the RecordCodec of the board and pieces encoding the
+	// solutions. Stores of version 1 have neither.
+	Shapes []StoredPiece `json:"shapes,omitempty"`
+	Table  string        `json:"table,omitempty"`
+}
+
+// StoredPiece is a piece of a stored board.
+type StoredPiece struct {
+	Name   string `json:"name"`
+	Letter string `json:"letter,omitempty"`
+	Cells  []Pos  `json:"cells"`
+}
+
+// StoredSolution is a solution of the board with the hash in a store.
+type StoredSolution struct {
+	Hash     string    `json:"hash"`
+	Solution Solution  `json:"solution"`
+	Found    time.Time `json:"found"`
+}
+
+// solutionRecord is a solution of the board with the hash in a store of
+// version 2: the record of its codec, and the time it was found in Unix
+// seconds.
+type solutionRecord struct {
+	Hash  string `json:"hash"`
+	Data  []byte `json:"data"`
+	Found int64  `json:"found"`
+}
+
+// storeRecord is a line of a store file. Exactly one field is set.
+type storeRecord struct {
+	Version  int             `json:"version,omitempty"`
+	Board    *StoredBoard    `json:"board,omitempty"`
+	Solution *StoredSolution `json:"solution,omitempty"`
+	Record   *solutionRecord `json:"record,omitempty"`
+}
+
+// boardRecords are the records of the solutions of a board read from a
+// store, one after another, and the times they were found. Keeping them
+// side by side takes a few bytes a solution, and lets Sample pick any by
+// its index.
+type boardRecords struct {
+	data  []byte
+	found []int64
+}
+
+// BoardHash returns the key of the puzzle of placing the pieces of the set
+// on the board: the first 16 hex digits of the SHA-256 of its cells, the
+// piece set and the names of the pieces in order. Which cells are free is
+// all that counts, not the piece letters on the occupied ones, nor the
+// order of the pieces. Mirror images and rotations of a board have a
+// different hash, since their solutions differ.
+func BoardHash(b *Board, set string, ps []Piece) string {
+	var names = make([]string, len(ps))
+	for i, p := range ps {
+		names[i] = p.name
+	}
+	slices.Sort(names)
+	var cells = make([]byte, 0, b.rows*b.cols)
+	for x := 0; x < b.rows; x++ {
+		for y := 0; y < b.cols; y++ {
+			switch c := cellSymbol(b, x, y); c {
+			case '.', '#':
+				cells = append(cells, c)
+			default:
+				cells = append(cells, 'x')
+			}
+		}
+	}
+	var sum = sha256.Sum256(fmt.Appendf(nil, "%dx%d:%s wrap=%t set=%s pieces=%s", b.rows, b.cols, cells, b.wrap, set, strings.Join(names, ",")))
+	return hex.EncodeToString(sum[:8])
+}
+
+// Store is a store file opened by OpenStore to add solutions, or by
+// ReadStore to query them. It is not safe for concurrent use, and only one
+// Store may add to a file at a time; the caller serializes writers, for
+// example with a lock file.
+type Store struct {
+	f *os.File
+	// version is the format of the file.
+	version int
+	boards  []StoredBoard
+	// seen holds the solutions stored, by storeKey, so that Add skips
+	// them.
+	seen   *SolutionSet
+	counts map[string]int
+	// solutions hold the solutions of stores of version 1, and records
+	// those of later ones.
+	solutions map[string][]StoredSolution
+	records   map[string]*boardRecords
+	// codecs hold the codecs of the boards built so far.
+	codecs map[string]*RecordCodec
+}
+
+// OpenStore opens the store file for adding solutions, creating it if it
+// does not exist and cutting off a last record which was not completed.
+func OpenStore(path string) (*Store, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+	var s = newStore(f)
+	data, err := io.ReadAll(f)
+	if err == nil {
+		err = s.load(path, data, false)
+	}
+	if err == nil && len(data) == 0 {
+		s.version = StoreFormatVersion
+		err = s.append(storeRecord{Version: StoreFormatVersion})
+	} else if end := bytes.LastIndexByte(data, '\n') + 1; err == nil && end < len(data) {
+		err = f.Truncate(int64(end))
+	}
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// ReadStore reads the store file for querying.
+func ReadStore(path string) (*Store, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var s = newStore(nil)
+	if err := s.load(path, data, true); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func newStore(f *os.File) *Store {
+	return &Store{
+		f:         f,
+		seen:      NewSolutionSet(),
+		counts:    make(map[string]int),
+		solutions: make(map[string][]StoredSolution),
+		records:   make(map[string]*boardRecords),
+		codecs:    make(map[string]*RecordCodec),
+	}
+}
+
+// load reads the complete records of data, keeping the solutions if keep
+// is set.
+func (s *Store) load(path string, data []byte, keep bool) error {
+	if i := bytes.LastIndexByte(data, '\n'); i >= 0 {
+		data = data[:i+1]
+	} else {
+		data = nil
+	}
+	for n := 1; len(data) > 0; n++ {
+		var line []byte
+		line, data, _ = bytes.Cut(data, []byte("\n"))
+		var r storeRecord
+		if err := json.Unmarshal(line, &r); err != nil {
+			return fmt.Errorf("%s:%d: %v", path, n, err)
+		}
+		switch {
+		case n == 1 && (r.Version < 1 || r.Version > StoreFormatVersion):
+			return fmt.Errorf("%s: unsupported store version %d, want at most %d", path, r.Version, StoreFormatVersion)
+		case n == 1:
+			s.version = r.Version
+		case r.Board != nil:
+			r.Board.Board = r.Board.Board.WithWrap(r.Board.Wrap)
+			s.boards = append(s.boards, *r.Board)
+		case r.Solution != nil:
+			var h = r.Solution.Hash
+			s.seen.Add(storeKey(h, r.Solution.Solution))
+			s.counts[h]++
+			if keep {
+				s.solutions[h] = append(s.solutions[h], *r.Solution)
+			}
+		case r.Record != nil:
+			var h = r.Record.Hash
+			s.seen.Add(recordKey(h, r.Record.Data))
+			s.counts[h]++
+			if keep {
+				var br = s.records[h]
+				if br == nil {
+					br = &boardRecords{}
+					s.records[h] = br
+				}
+				br.data = append(br.data, r.Record.Data...)
+				br.found = append(br.found, r.Record.Found)
+			}
+		}
+	}
+	return nil
+}
+
+// append writes the record as one line with a single write.
+func (s *Store) append(r storeRecord) error {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	_, err = s.f.Write(append(b, '\n'))
+	return err
+}
+
+// AddBoard records the puzzle unless it is stored already, and returns its
+// hash.
+func (s *Store) AddBoard(b *Board, set string, ps []Piece) (string, error) {
+	var h = BoardHash(b, set, ps)
+	if _, ok := s.Board(h); ok {
+		return h, nil
+	}
+	var sb = StoredBoard{Hash: h, Board: b, Wrap: b.wrap, Set: set, Solver: SolverVersion(), Created: time.Now().UTC().Truncate(time.Second)}
+	for _, p := range ps {
+		sb.Pieces = append(sb.Pieces, p.name)
+	}
+	slices.Sort(sb.Pieces)
+	if s.version > 1 {
+		c, err := NewRecordCodec(b, ps)
+		if err != nil {
+			return "", err
+		}
+		for _, p := range c.pieces {
+			var sp = StoredPiece{Name: p.name, Cells: p.Cells()}
+			if p.letter != 0 {
+				sp.Letter = string(p.letter)
+			}
+			sb.Shapes = append(sb.Shapes, sp)
+		}
+		sb.Table = c.Hash()
+		s.codecs[h] = c
+	}
+	if err := s.append(storeRecord{Board: &sb}); err != nil {
+		return "", err
+	}
+	s.boards = append(s.boards, sb)
+	return h, nil
+}
+
+// Add records the solution of the board with the hash. It reports false if
+// the same solution, by Solution.Hash, is stored already, or the Bloom
+// filter of DedupBloom takes it for one.
+func (s *Store) Add(hash string, sol Solution) (bool, error) {
+	if _, ok := s.Board(hash); !ok {
+		return false, fmt.Errorf("no board %s in the store", hash)
+	}
+	var found = time.Now().UTC().Truncate(time.Second)
+	if s.version == 1 {
+		if !s.seen.Add(storeKey(hash, sol)) {
+			return false, nil
+		}
+		var ss = StoredSolution{Hash: hash, Solution: sol, Found: found}
+		if err := s.append(storeRecord{Solution: &ss}); err != nil {
+			return false, err
+		}
+		s.counts[hash]++
+		return true, nil
+	}
+	c, err := s.codec(hash)
+	if err != nil {
+		return false, err
+	}
+	data, err := c.Append(nil, sol)
+	if err != nil {
+		return false, err
+	}
+	if !s.seen.Add(recordKey(hash, data)) {
+		return false, nil
+	}
+	if err := s.append(storeRecord{Record: &solutionRecord{Hash: hash, Data: data, Found: found.Unix()}}); err != nil {
+		return false, err
+	}
+	s.counts[hash]++
+	return true, nil
+}
+
+// codec returns the codec of the board with the hash, failing if it does
+// not list the placements the records of the store index.
+func (s *Store) codec(hash string) (*RecordCodec, error) {
+	if c, ok := s.codecs[hash]; ok {
+		return c, nil
+	}
+	b, ok := s.Board(hash)
+	switch {
+	case !ok:
+		return nil, fmt.Errorf("no board %s in the store", hash)
+	case b.Table == "":
+		return nil, fmt.Errorf("board %s has no placement table", hash)
+	}
+	var ps []Piece
+	for _, sp := range b.Shapes {
+		if len(sp.Letter) > 1 {
+			return nil, fmt.Errorf("board %s: letter %q of piece %s is not a single character", hash, sp.Letter, sp.Name)
+		}
+		var l byte
+		if sp.Letter != "" {
+			l = sp.Letter[0]
+		}
+		ps = append(ps, NewPiece(sp.Name, l, sp.Cells))
+	}
+	c, err := NewRecordCodec(b.Board, ps)
+	if err != nil {
+		return nil, fmt.Errorf("board %s: %w", hash, err)
+	}
+	if c.Hash() != b.Table {
+		return nil, fmt.Errorf("board %s: its solutions were stored by %s for placement table %s, this solver lists the placements as %s", hash, cmp.Or(b.Solver, "an unknown solver"), b.Table, c.Hash())
+	}
+	s.codecs[hash] = c
+	return c, nil
+}
+
+// DedupBloom makes Add skip the solutions stored already with a Bloom
+// filter of the given size in bytes, tuned for the given number of
+// solutions, instead of an exact set of their hashes, so that adding more
+// solutions takes no more memory. See SolutionSet for the solutions it
+// wrongly skips. It does nothing if the store uses one already.
+func (s *Store) DedupBloom(size, solutions int) {
+	if s.seen.Bloom() {
+		return
+	}
+	s.seen.toBloom(size, solutions)
+}
+
+// DedupLimit makes Add skip the solutions stored already with an exact set
+// of their hashes until it would take more than size bytes, and from then
+// on with a Bloom filter of that size, as DedupBloom does.
+func (s *Store) DedupLimit(size int) {
+	s.seen.Limit(size)
+}
+
+// Dedup returns the memory held by the set of the solutions stored, and
+// whether it is a Bloom filter.
+func (s *Store) Dedup() (int64, bool) {
+	return s.seen.Bytes(), s.seen.Bloom()
+}
+
+// storeKey returns the key of the solution of the board with the hash.
+func storeKey(hash string, sol Solution) uint64 {
+	var h = fnv.New64a()
+	h.Write([]byte(hash))
+	return mix(h.Sum64()) ^ sol.Hash()
+}
+
+// recordKey returns the key of the record of a solution of the board with
+// the hash. Records are the same if and only if their solutions are, so
+// unlike storeKey it need not decode them.
+func recordKey(hash string, rec []byte) uint64 {
+	var h = fnv.New64a()
+	h.Write([]byte(hash))
+	h.Write(rec)
+	return mix(h.Sum64())
+}
+
+// Close closes the file of a store opened by OpenStore.
+func (s *Store) Close() error {
+	if s.f == nil {
+		return nil
+	}
+	return s.f.Close()
+}
+
+// Boards returns the boards in the order they were stored.
+func (s *Store) Boards() []StoredBoard {
+	return s.boards
+}
+
+// Board returns the board with the hash.
+func (s *Store) Board(hash string) (StoredBoard, bool) {
+	var i = slices.IndexFunc(s.boards, func(b StoredBoard) bool { return b.Hash == hash })
+	if i < 0 {
+		return StoredBoard{}, false
+	}
+	return s.boards[i], true
+}
+
+// Lookup returns the board whose hash starts with prefix, which must be
+// unambiguous.
+func (s *Store) Lookup(prefix string) (StoredBoard, error) {
+	var res []StoredBoard
+	for _, b := range s.boards {
+		if strings.HasPrefix(b.Hash, prefix) {
+			res = append(res, b)
+		}
+	}
+	switch {
+	case prefix == "" || len(res) == 0:
+		return StoredBoard{}, fmt.Errorf("no board %q in the store", prefix)
+	case len(res) > 1:
+		return StoredBoard{}, fmt.Errorf("board %q is ambiguous, it starts %d hashes", prefix, len(res))
+	}
+	return res[0], nil
+}
+
+// Count returns the number of solutions of the board with the hash.
+func (s *Store) Count(hash string) int {
+	return s.counts[hash]
+}
+
+// Solutions returns the solutions of the board with the hash in the order
+// they were found. It fails for stores opened by OpenStore, which do not
+// keep them.
+func (s *Store) Solutions(hash string) ([]StoredSolution, error) {
+	if s.f != nil {
+		return nil, errors.New("the solutions of a store opened for adding are not kept, use ReadStore")
+	}
+	var br = s.records[hash]
+	if br == nil {
+		return s.solutions[hash], nil
+	}
+	var res = slices.Clip(s.solutions[hash])
+	for i := range br.found {
+		ss, err := s.solution(hash, br, i)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, ss)
+	}
+	return res, nil
+}
+
+// solution decodes the i-th record of the board with the hash.
+func (s *Store) solution(hash string, br *boardRecords, i int) (StoredSolution, error) {
+	c, err := s.codec(hash)
+	if err != nil {
+		return StoredSolution{}, err
+	}
+	sol, err := c.Decode(br.data[i*c.Size() : (i+1)*c.Size()])
+	if err != nil {
+		return StoredSolution{}, fmt.Errorf("board %s, solution %d: %w", hash, i+1, err)
+	}
+	return StoredSolution{Hash: hash, Solution: sol, Found: time.Unix(br.found[i], 0).UTC()}, nil
+}
+
+// Sample returns n solutions of the board with the hash chosen uniformly at
+// random from those stored, or all of them if there are no more, in the
+// order they were found. Only the solutions picked are decoded. It fails
+// for stores opened by OpenStore, like Solutions.
+func (s *Store) Sample(hash string, n int, r *rand.Rand) ([]StoredSolution, error) {
+	if s.f != nil {
+		return nil, errors.New("the solutions of a store opened for adding are not kept, use ReadStore")
+	}
+	var (
+		total = s.counts[hash]
+		pick  = make(map[int]bool, min(n, total))
+	)
+	// Floyd's algorithm picks n of total indices without listing them all.
+	for j := max(total-n, 0); j < total; j++ {
+		var i = r.IntN(j + 1)
+		if pick[i] {
+			i = j
+		}
+		pick[i] = true
+	}
+	var (
+		res = make([]StoredSolution, 0, len(pick))
+		old = s.solutions[hash]
+	)
+	for _, i := range slices.Sorted(maps.Keys(pick)) {
+		if i < len(old) {
+			res = append(res, old[i])
+			continue
+		}
+		ss, err := s.solution(hash, s.records[hash], i-len(old))
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, ss)
+	}
+	return res, nil
+}
@@ -0,0 +1,66 @@
+package iqpuzzler
+
+import (
+	"fmt"
+	"image/color"
+	"strings"
+)
+
+// svgCell is the size of a cell in SVG drawings, in pixels.
+const svgCell = 40
+
+// otherColor is the color of occupied cells whose piece is not known.
+var otherColor = color.RGBA{0x80, 0x80, 0x80, 0xff}
+
+// SVG draws the board as an SVG image, with a peg for every cell: the
+// cells covered by a move in the color of its piece in the palette, the
+// free ones as holes. Blocked cells are left out. Occupied cells marked
+// with a piece letter take the color of the piece with that letter.
+func (s Solution) SVG(b *Board, pal Palette) string {
+	var (
+		fills   = make([][]color.RGBA, b.rows)
+		letters = make(map[byte]color.RGBA)
+	)
+	for _, st := range pal {
+		if st.Letter != 0 && st.Color.A != 0 {
+			letters[st.Letter] = st.Color
+		}
+	}
+	for x := range fills {
+		fills[x] = make([]color.RGBA, b.cols)
+		for y := range fills[x] {
+			switch c := b.marks[x][y]; c {
+			case 0:
+				fills[x][y] = emptyColor
+			case '#':
+			default:
+				if fills[x][y] = otherColor; letters[c].A != 0 {
+					fills[x][y] = letters[c]
+				}
+			}
+		}
+	}
+	for _, m := range s {
+		var c = pal[m.Piece.name].Color
+		if c.A == 0 {
+			c = otherColor
+		}
+		for _, p := range m.Image() {
+			fills[p[0]][p[1]] = c
+		}
+	}
+	var sb strings.Builder
+	var w, h = b.cols * svgCell, b.rows * svgCell
+	fmt.Fprintf(&sb, "<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%d\" height=\"%d\" viewBox=\"0 0 %d %d\">\n", w+svgCell/2, h+svgCell/2, w+svgCell/2, h+svgCell/2)
+	fmt.Fprintf(&sb, "<rect width=\"%d\" height=\"%d\" rx=\"%d\" fill=\"#f8f8f8\" stroke=\"#b0b0b0\"/>\n", w+svgCell/2, h+svgCell/2, svgCell/4)
+	for x := range fills {
+		for y, c := range fills[x] {
+			if c.A == 0 {
+				continue
+			}
+			fmt.Fprintf(&sb, "<circle cx=\"%d\" cy=\"%d\" r=\"%d\" fill=\"#%02x%02x%02x\"/>\n", y*svgCell+svgCell*3/4, x*svgCell+svgCell*3/4, svgCell*9/20, c.R, c.G, c.B)
+		}
+	}
+	sb.WriteString("</svg>\n")
+	return sb.String()
+}
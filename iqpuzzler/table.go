@@ -0,0 +1,220 @@
+package iqpuzzler
+
+//go:generate go run ./internal/gentables -o tables_gen.go
+
+import (
+	"slices"
+	"unsafe"
+)
+
+// pieceTable holds the versions of a piece and its placements on the empty
+// board, listed for the strategy searching them.
+type pieceTable struct {
+	versions []version
+	// spots are, for PieceOrder, the placements of the versions which keep
+	// the piece on the board, ordered by translation, row by row, and then
+	// by version. The versions start at the origin, and so the translations
+	// are the top left corners of their bounding boxes.
+	spots []spot
+	// cover and start index the placements by the cells they cover, for
+	// FirstEmptyCell: those covering the cell with bit i are
+	// cover[start[i]:start[i+1]], ordered by version and then by the cell of
+	// the piece put on it.
+	cover []spot
+	start []int32
+	// n is the number of placements.
+	n int
+	// ids holds the id of the first placement of each version, see
+	// numberPlacements.
+	ids []int32
+	// shape is the number of the shape of the piece in the dead states of
+	// the search, if it has some.
+	shape uint16
+}
+
+// spot is a placement of a piece version: the translation, the index of
+// the version in the table and the offset of the mask of the cells it
+// covers in the masks of the version. It holds no pointers, so that the
+// tables cost the garbage collector nothing.
+type spot struct {
+	pos     Pos
+	v, mask int32
+}
+
+// spot returns the version and the mask of the placement.
+func (t *pieceTable) spot(sp spot) (*version, bitboard) {
+	var v = &t.versions[sp.v]
+	return v, v.at(int(sp.mask))
+}
+
+// id returns the id of the placement, once the table is numbered.
+func (t *pieceTable) id(sp spot) int32 {
+	return t.ids[sp.v] + sp.mask/int32(t.versions[sp.v].words)
+}
+
+// placementIDs resolves the ids numberPlacements gives the placements of
+// the tables of a search, so that a game can record the moves of the search
+// as an int32 and make the moves only when they are asked for.
+type placementIDs struct {
+	// versions holds the versions with placements, by the id of their
+	// first one in first, ascending.
+	versions []*version
+	first    []int32
+}
+
+// numberPlacements numbers the placements of the tables: those of a version
+// by translation, row by row, as its masks are laid out, after those of the
+// versions before it.
+func numberPlacements(ts []pieceTable) *placementIDs {
+	var (
+		res = &placementIDs{}
+		n   int32
+	)
+	for i := range ts {
+		var t = &ts[i]
+		t.ids = make([]int32, len(t.versions))
+		for j := range t.versions {
+			var v = &t.versions[j]
+			t.ids[j] = n
+			if size := int32(v.n[0] * v.n[1]); size > 0 {
+				res.versions = append(res.versions, v)
+				res.first = append(res.first, n)
+				n += size
+			}
+		}
+	}
+	return res
+}
+
+// placement returns the version and translation of the placement with the
+// id.
+func (p *placementIDs) placement(id int32) (*version, Pos) {
+	var i, found = slices.BinarySearch(p.first, id)
+	if !found {
+		i--
+	}
+	var (
+		v = p.versions[i]
+		k = int(id - p.first[i])
+	)
+	return v, v.lo.Add(Pos{k / v.n[1], k % v.n[1]})
+}
+
+// precompute returns the tables of the pieces on the board for the
+// strategy, taken from the generated tables where there is one.
+func precompute(b *Board, ps []Piece, st Strategy) []pieceTable {
+	var res []pieceTable
+	for _, piece := range ps {
+		var t, ok = lookupTable(b, piece, st)
+		if !ok {
+			t = newTable(b, piece, st)
+		}
+		res = append(res, t)
+	}
+	return res
+}
+
+// newTable builds the table of the piece on the board for the strategy.
+func newTable(b *Board, piece Piece, st Strategy) pieceTable {
+	var t pieceTable
+	for _, v := range piece.versions() {
+		t.versions = append(t.versions, newVersion(b, v))
+	}
+	t.list(b, st)
+	return t
+}
+
+// list lists the placements of the versions of the table for the strategy,
+// after they were added or reordered.
+func (t *pieceTable) list(b *Board, st Strategy) {
+	if st == FirstEmptyCell {
+		t.index(b)
+	} else {
+		t.place(b)
+	}
+}
+
+// place fills in the spots of the versions of the table on the board,
+// visiting only the translations which keep a version on it.
+func (t *pieceTable) place(b *Board) {
+	var (
+		n      int
+		lo, hi Pos
+	)
+	for _, v := range t.versions {
+		if v.n[0] <= 0 || v.n[1] <= 0 {
+			continue
+		}
+		var end = v.lo.Add(v.n)
+		if n == 0 {
+			lo, hi = v.lo, end
+		}
+		lo = Pos{min(lo[0], v.lo[0]), min(lo[1], v.lo[1])}
+		hi = Pos{max(hi[0], end[0]), max(hi[1], end[1])}
+		n += v.n[0] * v.n[1]
+	}
+	t.spots = slices.Grow(t.spots[:0], n)
+	for x := lo[0]; x < hi[0]; x++ {
+		for y := lo[1]; y < hi[1]; y++ {
+			for i := range t.versions {
+				var v = &t.versions[i]
+				if off := v.offset(Pos{x, y}); off >= 0 {
+					t.spots = append(t.spots, spot{Pos{x, y}, int32(i), int32(off)})
+				}
+			}
+		}
+	}
+	t.n = len(t.spots)
+}
+
+// index fills in the placements of the versions of the table by the cells
+// they cover. On a toroidal board the translations are taken modulo its
+// dimensions.
+func (t *pieceTable) index(b *Board) {
+	// Every placement is listed for each cell it covers, which makes n the
+	// size of the index but for placements a toroidal board drops.
+	var n int
+	for _, v := range t.versions {
+		n += v.n[0] * v.n[1] * len(v.piece.pos)
+	}
+	t.cover = slices.Grow(t.cover[:0], n)
+	t.start = slices.Grow(t.start[:0], b.rows*b.cols+1)
+	t.start = append(t.start, 0)
+	for x := 0; x < b.rows; x++ {
+		for y := 0; y < b.cols; y++ {
+			for i := range t.versions {
+				var v = &t.versions[i]
+				for _, c := range v.piece.pos {
+					var pos = Pos{x, y}.Sub(c)
+					if b.wrap {
+						pos = pos.Mod(Pos{b.rows, b.cols})
+					}
+					if off := v.offset(pos); off >= 0 {
+						t.cover = append(t.cover, spot{pos, int32(i), int32(off)})
+					}
+				}
+			}
+			t.start = append(t.start, int32(len(t.cover)))
+		}
+	}
+	t.n = 0
+	if len(t.versions) > 0 && len(t.versions[0].piece.pos) > 0 {
+		t.n = len(t.cover) / len(t.versions[0].piece.pos)
+	}
+}
+
+// covering returns the placements covering the cell with bit i.
+func (t *pieceTable) covering(i int) []spot {
+	return t.cover[t.start[i]:t.start[i+1]]
+}
+
+// bytes returns the memory held by the table.
+func (t *pieceTable) bytes() int64 {
+	var n = int64(cap(t.versions))*int64(unsafe.Sizeof(version{})) +
+		int64(cap(t.spots)+cap(t.cover))*int64(unsafe.Sizeof(spot{})) +
+		int64(cap(t.start))*4
+	for _, v := range t.versions {
+		n += int64(cap(v.masks)) * 8
+	}
+	return n
+}
@@ -0,0 +1,332 @@
+package iqpuzzler
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+)
+
+// tableCacheVersion is hashed into the keys of the tables a TableCache
+// keeps on disk. It must change whenever the tables built for the same key
+// would differ, so that no file of an older layout is read.
+const tableCacheVersion = 2
+
+// TableCache keeps the tables of the placements of pieces, so that searches
+// of boards of the same dimensions with the same pieces skip building them.
+// A table is kept for each piece by the dimensions of the board, whether it
+// wraps around, the strategy and the name, letter and cells of the piece:
+// the tables are those of the empty board, and do not depend on its
+// occupied cells, so that the puzzles of a board share them whatever pieces
+// are placed already. Searches get copies they may reorder, sharing the
+// masks of the placements. The tables are dropped, least recently used
+// first, once they hold more than the limit of bytes.
+//
+// With a directory, the tables built are also written to it, one file each
+// named after the hash of the key, and read back by later processes. Files
+// are never invalidated but by their key, which includes the version of
+// their layout; remove the directory to reclaim the space. A TableCache is
+// safe for concurrent use.
+type TableCache struct {
+	max int64
+	dir string
+
+	mu      sync.Mutex
+	entries map[string]*cachedTable
+	bytes   int64
+	// tick orders the uses of the entries.
+	tick  int64
+	stats TableCacheStats
+}
+
+// cachedTable is a table kept by a TableCache.
+type cachedTable struct {
+	t     pieceTable
+	bytes int64
+	used  int64
+}
+
+// TableCacheStats count the lookups of a TableCache.
+type TableCacheStats struct {
+	// Hits are the tables found in memory, Loads those read from the
+	// directory and Builds those built.
+	Hits, Loads, Builds int64
+	// Errors counts the files of the directory which could not be read or
+	// written; their tables were built instead.
+	Errors int64
+	// Tables and Bytes are the tables in memory and their size.
+	Tables int
+	Bytes  int64
+}
+
+// NewTableCache returns a cache keeping up to maxBytes of tables in memory
+// and, if dir is not empty, all of them in that directory, which is created
+// as needed.
+func NewTableCache(maxBytes int64, dir string) *TableCache {
+	return &TableCache{max: maxBytes, dir: dir, entries: make(map[string]*cachedTable)}
+}
+
+// WithTableCache makes the solver take the placement tables from c, and
+// add those it builds.
+func WithTableCache(c *TableCache) Option {
+	return func(s *Solver) error {
+		s.opts.Tables = c
+		return nil
+	}
+}
+
+// Stats returns the counts of the lookups so far.
+func (c *TableCache) Stats() TableCacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var st = c.stats
+	st.Tables, st.Bytes = len(c.entries), c.bytes
+	return st
+}
+
+// precompute returns copies of the tables of the pieces on the board for
+// the strategy, like precompute, building and keeping those it lacks.
+func (c *TableCache) precompute(b *Board, ps []Piece, st Strategy) []pieceTable {
+	var (
+		res = make([]pieceTable, len(ps))
+		key []byte
+	)
+	for i, piece := range ps {
+		key = appendTableKey(key[:0], b, piece, st)
+		c.mu.Lock()
+		var e, ok = c.entries[string(key)]
+		if ok {
+			c.tick++
+			e.used = c.tick
+			c.stats.Hits++
+			res[i] = e.t.clone()
+		}
+		c.mu.Unlock()
+		if ok {
+			continue
+		}
+		var t, err = c.load(key, b, piece, st)
+		switch {
+		case err == nil:
+			c.count(&c.stats.Loads)
+		default:
+			if !os.IsNotExist(err) {
+				c.count(&c.stats.Errors)
+			}
+			t = precompute(b, []Piece{piece}, st)[0]
+			c.count(&c.stats.Builds)
+			if err = c.save(key, t); err != nil {
+				c.count(&c.stats.Errors)
+			}
+		}
+		c.add(string(key), t)
+		res[i] = t.clone()
+	}
+	return res
+}
+
+// count increments a count of the stats.
+func (c *TableCache) count(n *int64) {
+	c.mu.Lock()
+	*n++
+	c.mu.Unlock()
+}
+
+// add keeps the table under the key, dropping the least recently used ones
+// while the tables take more than the limit. A table larger than the limit
+// is not kept.
+func (c *TableCache) add(key string, t pieceTable) {
+	var size = t.bytes()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[key]; ok || size > c.max {
+		return
+	}
+	for c.bytes+size > c.max {
+		var oldest string
+		for k, e := range c.entries {
+			if oldest == "" || e.used < c.entries[oldest].used {
+				oldest = k
+			}
+		}
+		c.bytes -= c.entries[oldest].bytes
+		delete(c.entries, oldest)
+	}
+	c.tick++
+	c.entries[key] = &cachedTable{t: t, bytes: size, used: c.tick}
+	c.bytes += size
+}
+
+// appendTableKey appends the key of the table of the piece on the board for
+// the strategy to dst.
+func appendTableKey(dst []byte, b *Board, piece Piece, st Strategy) []byte {
+	dst = binary.AppendUvarint(dst, tableCacheVersion)
+	dst = binary.AppendUvarint(dst, uint64(b.rows))
+	dst = binary.AppendUvarint(dst, uint64(b.cols))
+	var flags uint64
+	if b.wrap {
+		flags |= 1
+	}
+	if piece.sym {
+		flags |= 2
+	}
+	dst = binary.AppendUvarint(dst, flags)
+	dst = binary.AppendUvarint(dst, uint64(st))
+	dst = binary.AppendUvarint(dst, uint64(len(piece.name)))
+	dst = append(dst, piece.name...)
+	dst = append(dst, piece.letter)
+	for _, p := range piece.pos {
+		dst = binary.AppendVarint(dst, int64(p[0]))
+		dst = binary.AppendVarint(dst, int64(p[1]))
+	}
+	return dst
+}
+
+// clone returns a copy of the table which may be reordered without changing
+// it. The masks are shared.
+func (t *pieceTable) clone() pieceTable {
+	return pieceTable{
+		versions: slices.Clone(t.versions),
+		spots:    slices.Clone(t.spots),
+		cover:    slices.Clone(t.cover),
+		start:    slices.Clone(t.start),
+		n:        t.n,
+	}
+}
+
+// diskTable is the form of a table in the directory of a TableCache. The
+// placements are four int32 each: the translation, the version and the
+// offset of the mask.
+type diskTable struct {
+	Versions     []diskVersion
+	Spots, Cover []int32
+	Start        []int32
+	N            int
+}
+
+// diskVersion holds the translations and masks of a version.
+type diskVersion struct {
+	Lo, N Pos
+	Masks []uint64
+}
+
+// path returns the file of the table with the key in the directory.
+func (c *TableCache) path(key []byte) string {
+	var sum = sha256.Sum256(key)
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:16])+".table")
+}
+
+// load reads the table of the piece on the board for the strategy with the
+// key from the directory, failing with an error satisfying os.IsNotExist
+// if it has none.
+func (c *TableCache) load(key []byte, b *Board, piece Piece, st Strategy) (pieceTable, error) {
+	if c.dir == "" {
+		return pieceTable{}, os.ErrNotExist
+	}
+	data, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return pieceTable{}, err
+	}
+	var d diskTable
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&d); err != nil {
+		return pieceTable{}, err
+	}
+	var vs = piece.versions()
+	if len(d.Versions) != len(vs) {
+		return pieceTable{}, fmt.Errorf("%d versions, want %d", len(d.Versions), len(vs))
+	}
+	var t = pieceTable{versions: make([]version, len(vs)), start: d.Start, n: d.N}
+	for i, v := range vs {
+		var dv = d.Versions[i]
+		if dv.N[0] < 0 || dv.N[1] < 0 || len(dv.Masks) != dv.N[0]*dv.N[1]*b.words() {
+			return pieceTable{}, fmt.Errorf("version %d has %d masks for %v translations", i, len(dv.Masks), dv.N)
+		}
+		t.versions[i] = version{piece: v, lo: dv.Lo, n: dv.N, masks: dv.Masks, words: b.words(), wrap: b.wrap}
+	}
+	if t.spots, err = t.unpackDisk(d.Spots); err == nil {
+		t.cover, err = t.unpackDisk(d.Cover)
+	}
+	if err != nil {
+		return pieceTable{}, err
+	}
+	if st == FirstEmptyCell {
+		if len(t.start) != b.rows*b.cols+1 || t.start[0] != 0 || int(t.start[len(t.start)-1]) != len(t.cover) {
+			return pieceTable{}, fmt.Errorf("the index of %d cells does not cover %d placements", len(t.start)-1, len(t.cover))
+		}
+		for i := 1; i < len(t.start); i++ {
+			if t.start[i] < t.start[i-1] {
+				return pieceTable{}, fmt.Errorf("the placements of cell %d start before those of the cell before", i-1)
+			}
+		}
+	}
+	return t, nil
+}
+
+// save writes the table with the key to the directory, if there is one,
+// replacing the file at once so that no process reads half of it.
+func (c *TableCache) save(key []byte, t pieceTable) error {
+	if c.dir == "" {
+		return nil
+	}
+	var d = diskTable{Spots: packDisk(t.spots), Cover: packDisk(t.cover), Start: t.start, N: t.n}
+	for _, v := range t.versions {
+		d.Versions = append(d.Versions, diskVersion{Lo: v.lo, N: v.n, Masks: v.masks})
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(d); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(c.dir, 0755); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(c.dir, "*.tmp")
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(buf.Bytes())
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), c.path(key))
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+	return err
+}
+
+// packDisk packs the placements into four int32 each.
+func packDisk(sps []spot) []int32 {
+	var res = make([]int32, 0, 4*len(sps))
+	for _, sp := range sps {
+		res = append(res, int32(sp.pos[0]), int32(sp.pos[1]), sp.v, sp.mask)
+	}
+	return res
+}
+
+// unpackDisk returns the placements packed by packDisk, checking that they
+// lie in the masks of the versions of the table.
+func (t *pieceTable) unpackDisk(data []int32) ([]spot, error) {
+	if len(data)%4 != 0 {
+		return nil, fmt.Errorf("%d numbers for the placements, not a multiple of 4", len(data))
+	}
+	var res = make([]spot, len(data)/4)
+	for i := range res {
+		var (
+			d  = data[4*i:][:4]
+			sp = spot{Pos{int(d[0]), int(d[1])}, d[2], d[3]}
+		)
+		if sp.v < 0 || int(sp.v) >= len(t.versions) || sp.mask < 0 || int(sp.mask)+t.versions[sp.v].words > len(t.versions[sp.v].masks) {
+			return nil, fmt.Errorf("placement %d of version %d at offset %d is outside the table", i, sp.v, sp.mask)
+		}
+		res[i] = sp
+	}
+	return res, nil
+}
@@ -0,0 +1,94 @@
+package iqpuzzler
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+// TestTableCache takes the tables of the standard set on the standard board,
+// of the pentomino set on the 6x10 rectangle wrapped around and of the
+// standard set on a 3x4 board most pieces do not fit, for both strategies,
+// from a TableCache keeping them in an empty directory, and checks that they
+// are those built. It takes them a second time after a shuffled search
+// reordered the copies it was given, and then from a second cache reading
+// them from the directory, and checks that the first cache found them in
+// memory and the second on disk, and that a cache of a third of their size
+// keeps no more.
+func TestTableCache(t *testing.T) {
+	type puzzle struct {
+		b  *Board
+		ps []Piece
+	}
+	var (
+		std = presets["standard"]
+		pen = presets["pentomino-6x10"]
+		ps  = []puzzle{
+			{NewBoard(std.Rows, std.Cols), pieceSets[std.Set].Pieces},
+			{NewBoard(pen.Rows, pen.Cols).WithWrap(true), pieceSets[pen.Set].Pieces},
+			{NewBoard(3, 4), pieceSets[std.Set].Pieces},
+		}
+		dir     = t.TempDir()
+		first   = NewTableCache(64<<20, dir)
+		second  = NewTableCache(64<<20, dir)
+		tables  int64
+		size    int64
+		counted bool
+	)
+	var check = func(c *TableCache) error {
+		defer func() { counted = true }()
+		for _, p := range ps {
+			for _, st := range []Strategy{PieceOrder, FirstEmptyCell} {
+				var got = c.precompute(p.b, p.ps, st)
+				for i, piece := range p.ps {
+					var want = newTable(p.b, piece, st)
+					if err := equalTables(got[i], want); err != nil {
+						return fmt.Errorf("%dx%d, %s, piece %q: %v", p.b.rows, p.b.cols, st, piece.name, err)
+					}
+					for j, v := range got[i].versions {
+						if w := want.versions[j]; v.piece.name != w.piece.name || v.piece.orient != w.piece.orient || v.wrap != w.wrap {
+							return fmt.Errorf("%dx%d, %s, piece %q: version %d is %s %s, want %s %s", p.b.rows, p.b.cols, st, piece.name, j, v.piece.name, v.piece.orient, w.piece.name, w.piece.orient)
+						}
+					}
+					if !counted {
+						tables++
+						size += want.bytes()
+					}
+				}
+			}
+		}
+		return nil
+	}
+	if err := check(first); err != nil {
+		t.Fatal(err)
+	}
+	var b = ps[0].b
+	s, err := NewSolver(WithTableCache(first), WithStrategy(FirstEmptyCell), WithRand(NewRand(1)), WithMaxSolutions(1), WithParallelism(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Solve(context.Background(), NewGame(b), ps[0].ps); err != nil {
+		t.Fatal(err)
+	}
+	if err := check(first); err != nil {
+		t.Fatalf("after a shuffled search: %v", err)
+	}
+	if err := check(second); err != nil {
+		t.Fatalf("read back: %v", err)
+	}
+	var one, two = first.Stats(), second.Stats()
+	switch {
+	case one.Builds != tables || one.Hits < tables || one.Errors != 0:
+		t.Errorf("the first cache built %d tables and found %d of %d, with %d errors", one.Builds, one.Hits, tables, one.Errors)
+	case two.Loads != tables || two.Builds != 0 || two.Errors != 0:
+		t.Errorf("the second cache read %d tables of %d and built %d, with %d errors", two.Loads, tables, two.Builds, two.Errors)
+	}
+	var small = NewTableCache(size/3, "")
+	for _, p := range ps {
+		small.precompute(p.b, p.ps, FirstEmptyCell)
+		small.precompute(p.b, p.ps, PieceOrder)
+	}
+	if st := small.Stats(); st.Bytes > size/3 || st.Tables == 0 {
+		t.Errorf("a cache of %d bytes keeps %d tables of %d bytes", size/3, st.Tables, st.Bytes)
+	}
+}
@@ -0,0 +1,178 @@
+package iqpuzzler
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+)
+
+// The tables of the pieces of the built-in sets on the standard board are
+// generated into tables_gen.go, so that solving on it, as most puzzles
+// and the web pages do, does not build them at every start. Other boards
+// and pieces fall back to building them.
+
+// generatedTable is the table of a piece on a board of the given size, for
+// both strategies, told apart by the cells of the piece as defined. The
+// placements are packed into four bytes each, see packSpots.
+type generatedTable struct {
+	rows, cols int
+	cells      string
+	sym        bool
+	versions   []generatedVersion
+	spots      string
+	cover      string
+	start      []int32
+	n          int
+}
+
+// generatedVersion holds the translations and masks of a version.
+type generatedVersion struct {
+	lo, n Pos
+	masks []uint64
+}
+
+// tableKey identifies a generated table.
+type tableKey struct {
+	rows, cols int
+	cells      string
+	sym        bool
+}
+
+var generatedIndex = indexTables(generatedTables)
+
+func indexTables(ts []generatedTable) map[tableKey]*generatedTable {
+	var res = make(map[tableKey]*generatedTable, len(ts))
+	for i := range ts {
+		var t = &ts[i]
+		res[tableKey{t.rows, t.cols, t.cells, t.sym}] = t
+	}
+	return res
+}
+
+// lookupTable returns the table of the piece on the board for the strategy
+// from the generated ones, if there is one. The masks are shared with the
+// generated table and never modified.
+func lookupTable(b *Board, piece Piece, st Strategy) (pieceTable, bool) {
+	if b.wrap {
+		return pieceTable{}, false
+	}
+	var g, ok = generatedIndex[tableKey{b.rows, b.cols, shapeKey(piece.pos), piece.sym}]
+	if !ok {
+		return pieceTable{}, false
+	}
+	var (
+		vs = piece.versions()
+		t  = pieceTable{versions: make([]version, len(vs)), n: g.n}
+	)
+	for i, v := range vs {
+		var gv = &g.versions[i]
+		t.versions[i] = version{piece: v, lo: gv.lo, n: gv.n, masks: gv.masks, words: b.words()}
+	}
+	if st == FirstEmptyCell {
+		t.cover, t.start = unpackSpots(g.cover), slices.Clone(g.start)
+	} else {
+		t.spots = unpackSpots(g.spots)
+	}
+	return t, true
+}
+
+// generatedPieces returns the distinct pieces of the built-in sets, by the
+// cells they are defined with, in the order of their keys.
+func generatedPieces(b *Board) []Piece {
+	var (
+		res  []Piece
+		seen = make(map[tableKey]bool)
+	)
+	for _, name := range PieceSetNames() {
+		for _, p := range pieceSets[name].Pieces {
+			var k = tableKey{b.rows, b.cols, shapeKey(p.pos), p.sym}
+			if !seen[k] {
+				seen[k] = true
+				res = append(res, p)
+			}
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if k1, k2 := shapeKey(res[i].pos), shapeKey(res[j].pos); k1 != k2 {
+			return k1 < k2
+		}
+		return !res[i].sym && res[j].sym
+	})
+	return res
+}
+
+// tableBoard returns the board the tables are generated for.
+func tableBoard() *Board {
+	var p = presets["standard"]
+	return &Board{rows: p.Rows, cols: p.Cols}
+}
+
+// WriteTables writes the Go source of the placement tables built into the
+// package, the file go generate writes to tables_gen.go. The source is not
+// formatted.
+func WriteTables(w io.Writer) error {
+	var (
+		bw = bufio.NewWriter(w)
+		b  = tableBoard()
+	)
+	fmt.Fprintf(bw, "// Code generated by gentables; DO NOT EDIT.\n\npackage iqpuzzler\n\n")
+	fmt.Fprintf(bw, "var generatedTables = []generatedTable{\n")
+	for _, p := range generatedPieces(b) {
+		var (
+			po = newTable(b, p, PieceOrder)
+			fe = newTable(b, p, FirstEmptyCell)
+		)
+		fmt.Fprintf(bw, "{rows: %d, cols: %d, cells: %q, sym: %t, n: %d,\n", b.rows, b.cols, shapeKey(p.pos), p.sym, po.n)
+		fmt.Fprintf(bw, "versions: []generatedVersion{\n")
+		for _, v := range po.versions {
+			fmt.Fprintf(bw, "{lo: Pos{%d, %d}, n: Pos{%d, %d}, masks: []uint64{", v.lo[0], v.lo[1], v.n[0], v.n[1])
+			for _, m := range v.masks {
+				fmt.Fprintf(bw, "%#x,", m)
+			}
+			fmt.Fprintf(bw, "}},\n")
+		}
+		fmt.Fprintf(bw, "},\n")
+		spots, err := packSpots(po.spots)
+		if err != nil {
+			return err
+		}
+		cover, err := packSpots(fe.cover)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(bw, "spots: %q,\ncover: %q,\n", spots, cover)
+		fmt.Fprintf(bw, "start: []int32{")
+		for _, s := range fe.start {
+			fmt.Fprintf(bw, "%d,", s)
+		}
+		fmt.Fprintf(bw, "},\n},\n")
+	}
+	fmt.Fprintf(bw, "}\n")
+	return bw.Flush()
+}
+
+// packSpots packs the placements into four bytes each: the translation as
+// two signed bytes, the version and the offset of the mask. It fails for
+// boards too large for them.
+func packSpots(sps []spot) (string, error) {
+	var buf = make([]byte, 0, 4*len(sps))
+	for _, sp := range sps {
+		if sp.pos[0] != int(int8(sp.pos[0])) || sp.pos[1] != int(int8(sp.pos[1])) || sp.v != int32(uint8(sp.v)) || sp.mask != int32(uint8(sp.mask)) {
+			return "", fmt.Errorf("placement %v of version %d at offset %d does not fit in four bytes", sp.pos, sp.v, sp.mask)
+		}
+		buf = append(buf, byte(int8(sp.pos[0])), byte(int8(sp.pos[1])), byte(sp.v), byte(sp.mask))
+	}
+	return string(buf), nil
+}
+
+// unpackSpots returns the placements packed by packSpots.
+func unpackSpots(s string) []spot {
+	var res = make([]spot, len(s)/4)
+	for i := range res {
+		var b = s[4*i:][:4]
+		res[i] = spot{Pos{int(int8(b[0])), int(int8(b[1]))}, int32(b[2]), int32(b[3])}
+	}
+	return res
+}
@@ -0,0 +1,67 @@
+package iqpuzzler
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+// TestGeneratedTables checks the generated placement tables against the
+// tables built at runtime: that there is one for every piece of the
+// built-in sets on the standard board, and that its versions, masks and
+// placements are those built for either strategy.
+func TestGeneratedTables(t *testing.T) {
+	var (
+		b  = tableBoard()
+		ps = generatedPieces(b)
+	)
+	if len(generatedTables) != len(ps) {
+		t.Fatalf("%d generated tables for %d pieces, run go generate", len(generatedTables), len(ps))
+	}
+	for _, p := range ps {
+		for _, st := range []Strategy{PieceOrder, FirstEmptyCell} {
+			var got, ok = lookupTable(b, p, st)
+			if !ok {
+				t.Errorf("piece %q: no generated table, run go generate", p.name)
+				continue
+			}
+			if err := equalTables(got, newTable(b, p, st)); err != nil {
+				t.Errorf("piece %q, %s: %v", p.name, st, err)
+			}
+		}
+	}
+}
+
+// TestGeneratedTablesFallback checks that boards other than the standard
+// one get no generated tables, and so build theirs at runtime.
+func TestGeneratedTablesFallback(t *testing.T) {
+	for _, b := range []*Board{NewBoard(4, 5), NewBoard(5, 11).WithWrap(true)} {
+		for _, p := range standardPieces {
+			if _, ok := lookupTable(b, p, PieceOrder); ok {
+				t.Errorf("%dx%d board: a generated table for piece %q", b.rows, b.cols, p.name)
+			}
+		}
+	}
+}
+
+// equalTables describes the first difference between the tables.
+func equalTables(got, want pieceTable) error {
+	if len(got.versions) != len(want.versions) {
+		return fmt.Errorf("%d versions, want %d", len(got.versions), len(want.versions))
+	}
+	for i, v := range got.versions {
+		var w = want.versions[i]
+		if v.lo != w.lo || v.n != w.n || v.words != w.words || !slices.Equal(v.masks, w.masks) {
+			return fmt.Errorf("version %d differs", i)
+		}
+	}
+	switch {
+	case !slices.Equal(got.spots, want.spots):
+		return fmt.Errorf("placements differ")
+	case !slices.Equal(got.cover, want.cover) || !slices.Equal(got.start, want.start):
+		return fmt.Errorf("placements by cell differ")
+	case got.n != want.n:
+		return fmt.Errorf("%d placements, want %d", got.n, want.n)
+	}
+	return nil
+}
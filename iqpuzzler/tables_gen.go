@@ -0,0 +1,302 @@
+// Code generated by gentables; DO NOT EDIT.
+
+package iqpuzzler
+
+var generatedTables = []generatedTable{
+	{rows: 5, cols: 11, cells: "0,0;0,1;0,2;0,3;0,4;", sym: true, n: 46,
+		versions: []generatedVersion{
+			{lo: Pos{0, 0}, n: Pos{5, 7}, masks: []uint64{0x1f, 0x3e, 0x7c, 0xf8, 0x1f0, 0x3e0, 0x7c0, 0xf800, 0x1f000, 0x3e000, 0x7c000, 0xf8000, 0x1f0000, 0x3e0000, 0x7c00000, 0xf800000, 0x1f000000, 0x3e000000, 0x7c000000, 0xf8000000, 0x1f0000000, 0x3e00000000, 0x7c00000000, 0xf800000000, 0x1f000000000, 0x3e000000000, 0x7c000000000, 0xf8000000000, 0x1f00000000000, 0x3e00000000000, 0x7c00000000000, 0xf800000000000, 0x1f000000000000, 0x3e000000000000, 0x7c000000000000}},
+			{lo: Pos{0, 0}, n: Pos{1, 11}, masks: []uint64{0x100200400801, 0x200400801002, 0x400801002004, 0x801002004008, 0x1002004008010, 0x2004008010020, 0x4008010020040, 0x8010020040080, 0x10020040080100, 0x20040080100200, 0x40080100200400}},
+		},
+		spots: "\x00\x00\x00\x00\x00\x00\x01\x00\x00\x01\x00\x01\x00\x01\x01\x01\x00\x02\x00\x02\x00\x02\x01\x02\x00\x03\x00\x03\x00\x03\x01\x03\x00\x04\x00\x04\x00\x04\x01\x04\x00\x05\x00\x05\x00\x05\x01\x05\x00\x06\x00\x06\x00\x06\x01\x06\x00\a\x01\a\x00\b\x01\b\x00\t\x01\t\x00\n\x01\n\x01\x00\x00\a\x01\x01\x00\b\x01\x02\x00\t\x01\x03\x00\n\x01\x04\x00\v\x01\x05\x00\f\x01\x06\x00\r\x02\x00\x00\x0e\x02\x01\x00\x0f\x02\x02\x00\x10\x02\x03\x00\x11\x02\x04\x00\x12\x02\x05\x00\x13\x02\x06\x00\x14\x03\x00\x00\x15\x03\x01\x00\x16\x03\x02\x00\x17\x03\x03\x00\x18\x03\x04\x00\x19\x03\x05\x00\x1a\x03\x06\x00\x1b\x04\x00\x00\x1c\x04\x01\x00\x1d\x04\x02\x00\x1e\x04\x03\x00\x1f\x04\x04\x00 \x04\x05\x00!\x04\x06\x00\"",
+		cover: "\x00\x00\x00\x00\x00\x00\x01\x00\x00\x01\x00\x01\x00\x00\x00\x00\x00\x01\x01\x01\x00\x02\x00\x02\x00\x01\x00\x01\x00\x00\x00\x00\x00\x02\x01\x02\x00\x03\x00\x03\x00\x02\x00\x02\x00\x01\x00\x01\x00\x00\x00\x00\x00\x03\x01\x03\x00\x04\x00\x04\x00\x03\x00\x03\x00\x02\x00\x02\x00\x01\x00\x01\x00\x00\x00\x00\x00\x04\x01\x04\x00\x05\x00\x05\x00\x04\x00\x04\x00\x03\x00\x03\x00\x02\x00\x02\x00\x01\x00\x01\x00\x05\x01\x05\x00\x06\x00\x06\x00\x05\x00\x05\x00\x04\x00\x04\x00\x03\x00\x03\x00\x02\x00\x02\x00\x06\x01\x06\x00\x06\x00\x06\x00\x05\x00\x05\x00\x04\x00\x04\x00\x03\x00\x03\x00\a\x01\a\x00\x06\x00\x06\x00\x05\x00\x05\x00\x04\x00\x04\x00\b\x01\b\x00\x06\x00\x06\x00\x05\x00\x05\x00\t\x01\t\x00\x06\x00\x06\x00\n\x01\n\x01\x00\x00\a\x00\x00\x01\x00\x01\x01\x00\b\x01\x00\x00\a\x00\x01\x01\x01\x01\x02\x00\t\x01\x01\x00\b\x01\x00\x00\a\x00\x02\x01\x02\x01\x03\x00\n\x01\x02\x00\t\x01\x01\x00\b\x01\x00\x00\a\x00\x03\x01\x03\x01\x04\x00\v\x01\x03\x00\n\x01\x02\x00\t\x01\x01\x00\b\x01\x00\x00\a\x00\x04\x01\x04\x01\x05\x00\f\x01\x04\x00\v\x01\x03\x00\n\x01\x02\x00\t\x01\x01\x00\b\x00\x05\x01\x05\x01\x06\x00\r\x01\x05\x00\f\x01\x04\x00\v\x01\x03\x00\n\x01\x02\x00\t\x00\x06\x01\x06\x01\x06\x00\r\x01\x05\x00\f\x01\x04\x00\v\x01\x03\x00\n\x00\a\x01\a\x01\x06\x00\r\x01\x05\x00\f\x01\x04\x00\v\x00\b\x01\b\x01\x06\x00\r\x01\x05\x00\f\x00\t\x01\t\x01\x06\x00\r\x00\n\x01\n\x02\x00\x00\x0e\x00\x00\x01\x00\x02\x01\x00\x0f\x02\x00\x00\x0e\x00\x01\x01\x01\x02\x02\x00\x10\x02\x01\x00\x0f\x02\x00\x00\x0e\x00\x02\x01\x02\x02\x03\x00\x11\x02\x02\x00\x10\x02\x01\x00\x0f\x02\x00\x00\x0e\x00\x03\x01\x03\x02\x04\x00\x12\x02\x03\x00\x11\x02\x02\x00\x10\x02\x01\x00\x0f\x02\x00\x00\x0e\x00\x04\x01\x04\x02\x05\x00\x13\x02\x04\x00\x12\x02\x03\x00\x11\x02\x02\x00\x10\x02\x01\x00\x0f\x00\x05\x01\x05\x02\x06\x00\x14\x02\x05\x00\x13\x02\x04\x00\x12\x02\x03\x00\x11\x02\x02\x00\x10\x00\x06\x01\x06\x02\x06\x00\x14\x02\x05\x00\x13\x02\x04\x00\x12\x02\x03\x00\x11\x00\a\x01\a\x02\x06\x00\x14\x02\x05\x00\x13\x02\x04\x00\x12\x00\b\x01\b\x02\x06\x00\x14\x02\x05\x00\x13\x00\t\x01\t\x02\x06\x00\x14\x00\n\x01\n\x03\x00\x00\x15\x00\x00\x01\x00\x03\x01\x00\x16\x03\x00\x00\x15\x00\x01\x01\x01\x03\x02\x00\x17\x03\x01\x00\x16\x03\x00\x00\x15\x00\x02\x01\x02\x03\x03\x00\x18\x03\x02\x00\x17\x03\x01\x00\x16\x03\x00\x00\x15\x00\x03\x01\x03\x03\x04\x00\x19\x03\x03\x00\x18\x03\x02\x00\x17\x03\x01\x00\x16\x03\x00\x00\x15\x00\x04\x01\x04\x03\x05\x00\x1a\x03\x04\x00\x19\x03\x03\x00\x18\x03\x02\x00\x17\x03\x01\x00\x16\x00\x05\x01\x05\x03\x06\x00\x1b\x03\x05\x00\x1a\x03\x04\x00\x19\x03\x03\x00\x18\x03\x02\x00\x17\x00\x06\x01\x06\x03\x06\x00\x1b\x03\x05\x00\x1a\x03\x04\x00\x19\x03\x03\x00\x18\x00\a\x01\a\x03\x06\x00\x1b\x03\x05\x00\x1a\x03\x04\x00\x19\x00\b\x01\b\x03\x06\x00\x1b\x03\x05\x00\x1a\x00\t\x01\t\x03\x06\x00\x1b\x00\n\x01\n\x04\x00\x00\x1c\x00\x00\x01\x00\x04\x01\x00\x1d\x04\x00\x00\x1c\x00\x01\x01\x01\x04\x02\x00\x1e\x04\x01\x00\x1d\x04\x00\x00\x1c\x00\x02\x01\x02\x04\x03\x00\x1f\x04\x02\x00\x1e\x04\x01\x00\x1d\x04\x00\x00\x1c\x00\x03\x01\x03\x04\x04\x00 \x04\x03\x00\x1f\x04\x02\x00\x1e\x04\x01\x00\x1d\x04\x00\x00\x1c\x00\x04\x01\x04\x04\x05\x00!\x04\x04\x00 \x04\x03\x00\x1f\x04\x02\x00\x1e\x04\x01\x00\x1d\x00\x05\x01\x05\x04\x06\x00\"\x04\x05\x00!\x04\x04\x00 \x04\x03\x00\x1f\x04\x02\x00\x1e\x00\x06\x01\x06\x04\x06\x00\"\x04\x05\x00!\x04\x04\x00 \x04\x03\x00\x1f\x00\a\x01\a\x04\x06\x00\"\x04\x05\x00!\x04\x04\x00 \x00\b\x01\b\x04\x06\x00\"\x04\x05\x00!\x00\t\x01\t\x04\x06\x00\"\x00\n\x01\n",
+		start: []int32{0, 2, 5, 9, 14, 20, 26, 32, 37, 41, 44, 46, 48, 51, 55, 60, 66, 72, 78, 83, 87, 90, 92, 94, 97, 101, 106, 112, 118, 124, 129, 133, 136, 138, 140, 143, 147, 152, 158, 164, 170, 175, 179, 182, 184, 186, 189, 193, 198, 204, 210, 216, 221, 225, 228, 230},
+	},
+	{rows: 5, cols: 11, cells: "0,0;0,1;0,2;0,3;1,0;", sym: false, n: 208,
+		versions: []generatedVersion{
+			{lo: Pos{0, 0}, n: Pos{4, 8}, masks: []uint64{0x80f, 0x101e, 0x203c, 0x4078, 0x80f0, 0x101e0, 0x203c0, 0x40780, 0x407800, 0x80f000, 0x101e000, 0x203c000, 0x4078000, 0x80f0000, 0x101e0000, 0x203c0000, 0x203c00000, 0x407800000, 0x80f000000, 0x101e000000, 0x203c000000, 0x4078000000, 0x80f0000000, 0x101e0000000, 0x101e00000000, 0x203c00000000, 0x407800000000, 0x80f000000000, 0x101e000000000, 0x203c000000000, 0x4078000000000, 0x80f0000000000}},
+			{lo: Pos{0, 0}, n: Pos{4, 8}, masks: []uint64{0x400f, 0x801e, 0x1003c, 0x20078, 0x400f0, 0x801e0, 0x1003c0, 0x200780, 0x2007800, 0x400f000, 0x801e000, 0x1003c000, 0x20078000, 0x400f0000, 0x801e0000, 0x1003c0000, 0x1003c00000, 0x2007800000, 0x400f000000, 0x801e000000, 0x1003c000000, 0x20078000000, 0x400f0000000, 0x801e0000000, 0x801e00000000, 0x1003c00000000, 0x2007800000000, 0x400f000000000, 0x801e000000000, 0x1003c000000000, 0x20078000000000, 0x400f0000000000}},
+			{lo: Pos{0, 0}, n: Pos{2, 10}, masks: []uint64{0x400801003, 0x801002006, 0x100200400c, 0x2004008018, 0x4008010030, 0x8010020060, 0x100200400c0, 0x20040080180, 0x40080100300, 0x80100200600, 0x200400801800, 0x400801003000, 0x801002006000, 0x100200400c000, 0x2004008018000, 0x4008010030000, 0x8010020060000, 0x100200400c0000, 0x20040080180000, 0x40080100300000}},
+			{lo: Pos{0, 0}, n: Pos{2, 10}, masks: []uint64{0x600801002, 0xc01002004, 0x1802004008, 0x3004008010, 0x6008010020, 0xc010020040, 0x18020040080, 0x30040080100, 0x60080100200, 0xc0100200400, 0x300400801000, 0x600801002000, 0xc01002004000, 0x1802004008000, 0x3004008010000, 0x6008010020000, 0xc010020040000, 0x18020040080000, 0x30040080100000, 0x60080100200000}},
+			{lo: Pos{0, 0}, n: Pos{4, 8}, masks: []uint64{0x7808, 0xf010, 0x1e020, 0x3c040, 0x78080, 0xf0100, 0x1e0200, 0x3c0400, 0x3c04000, 0x7808000, 0xf010000, 0x1e020000, 0x3c040000, 0x78080000, 0xf0100000, 0x1e0200000, 0x1e02000000, 0x3c04000000, 0x7808000000, 0xf010000000, 0x1e020000000, 0x3c040000000, 0x78080000000, 0xf0100000000, 0xf01000000000, 0x1e02000000000, 0x3c04000000000, 0x7808000000000, 0xf010000000000, 0x1e020000000000, 0x3c040000000000, 0x78080000000000}},
+			{lo: Pos{0, 0}, n: Pos{2, 10}, masks: []uint64{0x600400801, 0xc00801002, 0x1801002004, 0x3002004008, 0x6004008010, 0xc008010020, 0x18010020040, 0x30020040080, 0x60040080100, 0xc0080100200, 0x300200400800, 0x600400801000, 0xc00801002000, 0x1801002004000, 0x3002004008000, 0x6004008010000, 0xc008010020000, 0x18010020040000, 0x30020040080000, 0x60040080100000}},
+			{lo: Pos{0, 0}, n: Pos{4, 8}, masks: []uint64{0x7801, 0xf002, 0x1e004, 0x3c008, 0x78010, 0xf0020, 0x1e0040, 0x3c0080, 0x3c00800, 0x7801000, 0xf002000, 0x1e004000, 0x3c008000, 0x78010000, 0xf0020000, 0x1e0040000, 0x1e00400000, 0x3c00800000, 0x7801000000, 0xf002000000, 0x1e004000000, 0x3c008000000, 0x78010000000, 0xf0020000000, 0xf00200000000, 0x1e00400000000, 0x3c00800000000, 0x7801000000000, 0xf002000000000, 0x1e004000000000, 0x3c008000000000, 0x78010000000000}},
+			{lo: Pos{0, 0}, n: Pos{2, 10}, masks: []uint64{0x200400803, 0x400801006, 0x80100200c, 0x1002004018, 0x2004008030, 0x4008010060, 0x80100200c0, 0x10020040180, 0x20040080300, 0x40080100600, 0x100200401800, 0x200400803000, 0x400801006000, 0x80100200c000, 0x1002004018000, 0x2004008030000, 0x4008010060000, 0x80100200c0000, 0x10020040180000, 0x20040080300000}},
+		},
+		spots: "\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x02\x00\x00\x00\x03\x00\x00\x00\x04\x00\x00\x00\x05\x00\x00\x00\x06\x00\x00\x00\a\x00\x00\x01\x00\x01\x00\x01\x01\x01\x00\x01\x02\x01\x00\x01\x03\x01\x00\x01\x04\x01\x00\x01\x05\x01\x00\x01\x06\x01\x00\x01\a\x01\x00\x02\x00\x02\x00\x02\x01\x02\x00\x02\x02\x02\x00\x02\x03\x02\x00\x02\x04\x02\x00\x02\x05\x02\x00\x02\x06\x02\x00\x02\a\x02\x00\x03\x00\x03\x00\x03\x01\x03\x00\x03\x02\x03\x00\x03\x03\x03\x00\x03\x04\x03\x00\x03\x05\x03\x00\x03\x06\x03\x00\x03\a\x03\x00\x04\x00\x04\x00\x04\x01\x04\x00\x04\x02\x04\x00\x04\x03\x04\x00\x04\x04\x04\x00\x04\x05\x04\x00\x04\x06\x04\x00\x04\a\x04\x00\x05\x00\x05\x00\x05\x01\x05\x00\x05\x02\x05\x00\x05\x03\x05\x00\x05\x04\x05\x00\x05\x05\x05\x00\x05\x06\x05\x00\x05\a\x05\x00\x06\x00\x06\x00\x06\x01\x06\x00\x06\x02\x06\x00\x06\x03\x06\x00\x06\x04\x06\x00\x06\x05\x06\x00\x06\x06\x06\x00\x06\a\x06\x00\a\x00\a\x00\a\x01\a\x00\a\x02\a\x00\a\x03\a\x00\a\x04\a\x00\a\x05\a\x00\a\x06\a\x00\a\a\a\x00\b\x02\b\x00\b\x03\b\x00\b\x05\b\x00\b\a\b\x00\t\x02\t\x00\t\x03\t\x00\t\x05\t\x00\t\a\t\x01\x00\x00\b\x01\x00\x01\b\x01\x00\x02\n\x01\x00\x03\n\x01\x00\x04\b\x01\x00\x05\n\x01\x00\x06\b\x01\x00\a\n\x01\x01\x00\t\x01\x01\x01\t\x01\x01\x02\v\x01\x01\x03\v\x01\x01\x04\t\x01\x01\x05\v\x01\x01\x06\t\x01\x01\a\v\x01\x02\x00\n\x01\x02\x01\n\x01\x02\x02\f\x01\x02\x03\f\x01\x02\x04\n\x01\x02\x05\f\x01\x02\x06\n\x01\x02\a\f\x01\x03\x00\v\x01\x03\x01\v\x01\x03\x02\r\x01\x03\x03\r\x01\x03\x04\v\x01\x03\x05\r\x01\x03\x06\v\x01\x03\a\r\x01\x04\x00\f\x01\x04\x01\f\x01\x04\x02\x0e\x01\x04\x03\x0e\x01\x04\x04\f\x01\x04\x05\x0e\x01\x04\x06\f\x01\x04\a\x0e\x01\x05\x00\r\x01\x05\x01\r\x01\x05\x02\x0f\x01\x05\x03\x0f\x01\x05\x04\r\x01\x05\x05\x0f\x01\x05\x06\r\x01\x05\a\x0f\x01\x06\x00\x0e\x01\x06\x01\x0e\x01\x06\x02\x10\x01\x06\x03\x10\x01\x06\x04\x0e\x01\x06\x05\x10\x01\x06\x06\x0e\x01\x06\a\x10\x01\a\x00\x0f\x01\a\x01\x0f\x01\a\x02\x11\x01\a\x03\x11\x01\a\x04\x0f\x01\a\x05\x11\x01\a\x06\x0f\x01\a\a\x11\x01\b\x02\x12\x01\b\x03\x12\x01\b\x05\x12\x01\b\a\x12\x01\t\x02\x13\x01\t\x03\x13\x01\t\x05\x13\x01\t\a\x13\x02\x00\x00\x10\x02\x00\x01\x10\x02\x00\x04\x10\x02\x00\x06\x10\x02\x01\x00\x11\x02\x01\x01\x11\x02\x01\x04\x11\x02\x01\x06\x11\x02\x02\x00\x12\x02\x02\x01\x12\x02\x02\x04\x12\x02\x02\x06\x12\x02\x03\x00\x13\x02\x03\x01\x13\x02\x03\x04\x13\x02\x03\x06\x13\x02\x04\x00\x14\x02\x04\x01\x14\x02\x04\x04\x14\x02\x04\x06\x14\x02\x05\x00\x15\x02\x05\x01\x15\x02\x05\x04\x15\x02\x05\x06\x15\x02\x06\x00\x16\x02\x06\x01\x16\x02\x06\x04\x16\x02\x06\x06\x16\x02\a\x00\x17\x02\a\x01\x17\x02\a\x04\x17\x02\a\x06\x17\x03\x00\x00\x18\x03\x00\x01\x18\x03\x00\x04\x18\x03\x00\x06\x18\x03\x01\x00\x19\x03\x01\x01\x19\x03\x01\x04\x19\x03\x01\x06\x19\x03\x02\x00\x1a\x03\x02\x01\x1a\x03\x02\x04\x1a\x03\x02\x06\x1a\x03\x03\x00\x1b\x03\x03\x01\x1b\x03\x03\x04\x1b\x03\x03\x06\x1b\x03\x04\x00\x1c\x03\x04\x01\x1c\x03\x04\x04\x1c\x03\x04\x06\x1c\x03\x05\x00\x1d\x03\x05\x01\x1d\x03\x05\x04\x1d\x03\x05\x06\x1d\x03\x06\x00\x1e\x03\x06\x01\x1e\x03\x06\x04\x1e\x03\x06\x06\x1e\x03\a\x00\x1f\x03\a\x01\x1f\x03\a\x04\x1f\x03\a\x06\x1f",
+		cover: "\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x02\x00\x00\x00\x05\x00\x00\x00\x06\x00\x00\x00\a\x00\x00\x01\x00\x01\x00\x00\x00\x00\x00\x00\x01\x00\x00\x01\x01\x01\x00\x00\x02\x00\x00\x01\x02\x01\x00\x00\x03\x00\x00\x01\x05\x01\x00\x01\x06\x01\x00\x01\a\x01\x00\x00\a\x00\x00\x02\x00\x02\x00\x01\x00\x01\x00\x00\x00\x00\x00\x00\x01\x00\x00\x01\x01\x01\x00\x02\x01\x02\x00\x01\x02\x01\x00\x02\x02\x02\x00\x01\x03\x01\x00\x02\x05\x02\x00\x02\x06\x02\x00\x02\a\x02\x00\x01\a\x01\x00\x03\x00\x03\x00\x02\x00\x02\x00\x01\x00\x01\x00\x00\x00\x00\x00\x00\x01\x00\x00\x01\x01\x01\x00\x02\x01\x02\x00\x03\x01\x03\x00\x02\x02\x02\x00\x03\x02\x03\x00\x02\x03\x02\x00\x00\x04\x00\x00\x03\x05\x03\x00\x03\x06\x03\x00\x03\a\x03\x00\x02\a\x02\x00\x04\x00\x04\x00\x03\x00\x03\x00\x02\x00\x02\x00\x01\x00\x01\x00\x01\x01\x01\x00\x02\x01\x02\x00\x03\x01\x03\x00\x04\x01\x04\x00\x03\x02\x03\x00\x04\x02\x04\x00\x03\x03\x03\x00\x01\x04\x01\x00\x04\x05\x04\x00\x04\x06\x04\x00\x04\a\x04\x00\x03\a\x03\x00\x05\x00\x05\x00\x04\x00\x04\x00\x03\x00\x03\x00\x02\x00\x02\x00\x02\x01\x02\x00\x03\x01\x03\x00\x04\x01\x04\x00\x05\x01\x05\x00\x04\x02\x04\x00\x05\x02\x05\x00\x04\x03\x04\x00\x02\x04\x02\x00\x05\x05\x05\x00\x05\x06\x05\x00\x05\a\x05\x00\x04\a\x04\x00\x06\x00\x06\x00\x05\x00\x05\x00\x04\x00\x04\x00\x03\x00\x03\x00\x03\x01\x03\x00\x04\x01\x04\x00\x05\x01\x05\x00\x06\x01\x06\x00\x05\x02\x05\x00\x06\x02\x06\x00\x05\x03\x05\x00\x03\x04\x03\x00\x06\x05\x06\x00\x06\x06\x06\x00\x06\a\x06\x00\x05\a\x05\x00\a\x00\a\x00\x06\x00\x06\x00\x05\x00\x05\x00\x04\x00\x04\x00\x04\x01\x04\x00\x05\x01\x05\x00\x06\x01\x06\x00\a\x01\a\x00\x06\x02\x06\x00\a\x02\a\x00\x06\x03\x06\x00\x04\x04\x04\x00\a\x05\a\x00\a\x06\a\x00\a\a\a\x00\x06\a\x06\x00\a\x00\a\x00\x06\x00\x06\x00\x05\x00\x05\x00\x05\x01\x05\x00\x06\x01\x06\x00\a\x01\a\x00\a\x02\a\x00\b\x02\b\x00\a\x03\a\x00\x05\x04\x05\x00\b\x05\b\x00\b\a\b\x00\a\a\a\x00\a\x00\a\x00\x06\x00\x06\x00\x06\x01\x06\x00\a\x01\a\x00\b\x02\b\x00\t\x02\t\x00\b\x03\b\x00\x06\x04\x06\x00\t\x05\t\x00\t\a\t\x00\b\a\b\x00\a\x00\a\x00\a\x01\a\x00\t\x02\t\x00\t\x03\t\x00\a\x04\a\x00\t\a\t\x01\x00\x00\b\x00\x00\x00\x00\x01\x00\x01\b\x01\x00\x02\n\x00\x00\x04\x00\x00\x00\x05\x00\x01\x00\x05\n\x00\x00\x06\x00\x01\x00\x06\b\x01\x00\a\n\x00\x00\a\x00\x01\x01\x00\t\x01\x00\x00\b\x00\x01\x00\x01\x01\x00\x01\b\x01\x01\x01\t\x01\x00\x02\n\x00\x00\x02\x00\x01\x01\x02\v\x00\x00\x03\x00\x01\x00\x03\n\x00\x00\x04\x00\x00\x01\x04\x01\x00\x01\x05\x01\x01\x01\x05\v\x00\x01\x06\x01\x00\x00\x06\x00\x01\x01\x06\t\x01\x01\a\v\x00\x01\a\x01\x01\x00\a\n\x01\x02\x00\n\x01\x01\x00\t\x01\x00\x00\b\x00\x02\x00\x02\x01\x00\x01\b\x01\x01\x01\t\x01\x02\x01\n\x01\x01\x02\v\x00\x01\x02\x01\x01\x02\x02\f\x00\x01\x03\x01\x01\x01\x03\v\x00\x00\x04\x00\x00\x01\x04\x01\x00\x02\x04\x02\x00\x02\x05\x02\x01\x02\x05\f\x00\x02\x06\x02\x00\x01\x06\x01\x00\x00\x06\x00\x01\x02\x06\n\x01\x02\a\f\x00\x02\a\x02\x01\x01\a\v\x01\x03\x00\v\x01\x02\x00\n\x01\x01\x00\t\x01\x00\x00\b\x00\x03\x00\x03\x01\x00\x01\b\x01\x01\x01\t\x01\x02\x01\n\x01\x03\x01\v\x00\x00\x01\x00\x01\x02\x02\f\x00\x02\x02\x02\x01\x03\x02\r\x00\x02\x03\x02\x01\x02\x03\f\x00\x00\x04\x00\x00\x01\x04\x01\x00\x02\x04\x02\x00\x03\x04\x03\x01\x00\x04\b\x00\x03\x05\x03\x01\x03\x05\r\x00\x03\x06\x03\x00\x02\x06\x02\x00\x01\x06\x01\x00\x00\x06\x00\x01\x03\x06\v\x01\x03\a\r\x00\x03\a\x03\x01\x02\a\f\x01\x04\x00\f\x01\x03\x00\v\x01\x02\x00\n\x01\x01\x00\t\x00\x04\x00\x04\x01\x01\x01\t\x01\x02\x01\n\x01\x03\x01\v\x01\x04\x01\f\x00\x01\x01\x01\x01\x03\x02\r\x00\x03\x02\x03\x01\x04\x02\x0e\x00\x03\x03\x03\x01\x03\x03\r\x00\x01\x04\x01\x00\x02\x04\x02\x00\x03\x04\x03\x00\x04\x04\x04\x01\x01\x04\t\x00\x04\x05\x04\x01\x04\x05\x0e\x00\x04\x06\x04\x00\x03\x06\x03\x00\x02\x06\x02\x00\x01\x06\x01\x01\x04\x06\f\x01\x04\a\x0e\x00\x04\a\x04\x01\x03\a\r\x01\x05\x00\r\x01\x04\x00\f\x01\x03\x00\v\x01\x02\x00\n\x00\x05\x00\x05\x01\x02\x01\n\x01\x03\x01\v\x01\x04\x01\f\x01\x05\x01\r\x00\x02\x01\x02\x01\x04\x02\x0e\x00\x04\x02\x04\x01\x05\x02\x0f\x00\x04\x03\x04\x01\x04\x03\x0e\x00\x02\x04\x02\x00\x03\x04\x03\x00\x04\x04\x04\x00\x05\x04\x05\x01\x02\x04\n\x00\x05\x05\x05\x01\x05\x05\x0f\x00\x05\x06\x05\x00\x04\x06\x04\x00\x03\x06\x03\x00\x02\x06\x02\x01\x05\x06\r\x01\x05\a\x0f\x00\x05\a\x05\x01\x04\a\x0e\x01\x06\x00\x0e\x01\x05\x00\r\x01\x04\x00\f\x01\x03\x00\v\x00\x06\x00\x06\x01\x03\x01\v\x01\x04\x01\f\x01\x05\x01\r\x01\x06\x01\x0e\x00\x03\x01\x03\x01\x05\x02\x0f\x00\x05\x02\x05\x01\x06\x02\x10\x00\x05\x03\x05\x01\x05\x03\x0f\x00\x03\x04\x03\x00\x04\x04\x04\x00\x05\x04\x05\x00\x06\x04\x06\x01\x03\x04\v\x00\x06\x05\x06\x01\x06\x05\x10\x00\x06\x06\x06\x00\x05\x06\x05\x00\x04\x06\x04\x00\x03\x06\x03\x01\x06\x06\x0e\x01\x06\a\x10\x00\x06\a\x06\x01\x05\a\x0f\x01\a\x00\x0f\x01\x06\x00\x0e\x01\x05\x00\r\x01\x04\x00\f\x00\a\x00\a\x01\x04\x01\f\x01\x05\x01\r\x01\x06\x01\x0e\x01\a\x01\x0f\x00\x04\x01\x04\x01\x06\x02\x10\x00\x06\x02\x06\x01\a\x02\x11\x00\x06\x03\x06\x01\x06\x03\x10\x00\x04\x04\x04\x00\x05\x04\x05\x00\x06\x04\x06\x00\a\x04\a\x01\x04\x04\f\x00\a\x05\a\x01\a\x05\x11\x00\a\x06\a\x00\x06\x06\x06\x00\x05\x06\x05\x00\x04\x06\x04\x01\a\x06\x0f\x01\a\a\x11\x00\a\a\a\x01\x06\a\x10\x01\a\x00\x0f\x01\x06\x00\x0e\x01\x05\x00\r\x01\x05\x01\r\x01\x06\x01\x0e\x01\a\x01\x0f\x00\x05\x01\x05\x01\a\x02\x11\x00\a\x02\a\x01\b\x02\x12\x00\a\x03\a\x01\a\x03\x11\x00\x05\x04\x05\x00\x06\x04\x06\x00\a\x04\a\x01\x05\x04\r\x00\b\x05\b\x01\b\x05\x12\x00\a\x06\a\x00\x06\x06\x06\x00\x05\x06\x05\x01\b\a\x12\x00\b\a\b\x01\a\a\x11\x01\a\x00\x0f\x01\x06\x00\x0e\x01\x06\x01\x0e\x01\a\x01\x0f\x00\x06\x01\x06\x01\b\x02\x12\x00\b\x02\b\x01\t\x02\x13\x00\b\x03\b\x01\b\x03\x12\x00\x06\x04\x06\x00\a\x04\a\x01\x06\x04\x0e\x00\t\x05\t\x01\t\x05\x13\x00\a\x06\a\x00\x06\x06\x06\x01\t\a\x13\x00\t\a\t\x01\b\a\x12\x01\a\x00\x0f\x01\a\x01\x0f\x00\a\x01\a\x01\t\x02\x13\x00\t\x02\t\x00\t\x03\t\x01\t\x03\x13\x00\a\x04\a\x01\a\x04\x0f\x00\a\x06\a\x01\t\a\x13\x02\x00\x00\x10\x01\x00\x00\b\x02\x00\x01\x10\x01\x00\x04\b\x00\x00\x05\x00\x01\x00\x05\n\x01\x00\x06\b\x02\x00\x06\x10\x01\x00\a\n\x00\x00\a\x00\x02\x01\x00\x11\x02\x00\x00\x10\x01\x01\x00\t\x02\x00\x01\x10\x02\x01\x01\x11\x01\x00\x02\n\x00\x00\x02\x00\x00\x00\x03\x00\x01\x00\x03\n\x01\x00\x04\b\x01\x01\x04\t\x00\x01\x05\x01\x01\x01\x05\v\x01\x01\x06\t\x01\x00\x06\b\x02\x01\x06\x11\x01\x01\a\v\x00\x01\a\x01\x02\x02\x00\x12\x02\x01\x00\x11\x02\x00\x00\x10\x01\x02\x00\n\x02\x00\x01\x10\x02\x01\x01\x11\x02\x02\x01\x12\x01\x01\x02\v\x00\x01\x02\x01\x00\x01\x03\x01\x01\x01\x03\v\x01\x00\x04\b\x01\x01\x04\t\x01\x02\x04\n\x00\x02\x05\x02\x01\x02\x05\f\x01\x02\x06\n\x01\x01\x06\t\x01\x00\x06\b\x02\x02\x06\x12\x01\x02\a\f\x00\x02\a\x02\x02\x03\x00\x13\x02\x02\x00\x12\x02\x01\x00\x11\x02\x00\x00\x10\x01\x03\x00\v\x02\x00\x01\x10\x02\x01\x01\x11\x02\x02\x01\x12\x02\x03\x01\x13\x01\x00\x01\b\x01\x02\x02\f\x00\x02\x02\x02\x00\x02\x03\x02\x01\x02\x03\f\x01\x00\x04\b\x01\x01\x04\t\x01\x02\x04\n\x01\x03\x04\v\x02\x00\x04\x10\x00\x03\x05\x03\x01\x03\x05\r\x01\x03\x06\v\x01\x02\x06\n\x01\x01\x06\t\x01\x00\x06\b\x02\x03\x06\x13\x01\x03\a\r\x00\x03\a\x03\x02\x04\x00\x14\x02\x03\x00\x13\x02\x02\x00\x12\x02\x01\x00\x11\x01\x04\x00\f\x02\x01\x01\x11\x02\x02\x01\x12\x02\x03\x01\x13\x02\x04\x01\x14\x01\x01\x01\t\x01\x03\x02\r\x00\x03\x02\x03\x00\x03\x03\x03\x01\x03\x03\r\x01\x01\x04\t\x01\x02\x04\n\x01\x03\x04\v\x01\x04\x04\f\x02\x01\x04\x11\x00\x04\x05\x04\x01\x04\x05\x0e\x01\x04\x06\f\x01\x03\x06\v\x01\x02\x06\n\x01\x01\x06\t\x02\x04\x06\x14\x01\x04\a\x0e\x00\x04\a\x04\x02\x05\x00\x15\x02\x04\x00\x14\x02\x03\x00\x13\x02\x02\x00\x12\x01\x05\x00\r\x02\x02\x01\x12\x02\x03\x01\x13\x02\x04\x01\x14\x02\x05\x01\x15\x01\x02\x01\n\x01\x04\x02\x0e\x00\x04\x02\x04\x00\x04\x03\x04\x01\x04\x03\x0e\x01\x02\x04\n\x01\x03\x04\v\x01\x04\x04\f\x01\x05\x04\r\x02\x02\x04\x12\x00\x05\x05\x05\x01\x05\x05\x0f\x01\x05\x06\r\x01\x04\x06\f\x01\x03\x06\v\x01\x02\x06\n\x02\x05\x06\x15\x01\x05\a\x0f\x00\x05\a\x05\x02\x06\x00\x16\x02\x05\x00\x15\x02\x04\x00\x14\x02\x03\x00\x13\x01\x06\x00\x0e\x02\x03\x01\x13\x02\x04\x01\x14\x02\x05\x01\x15\x02\x06\x01\x16\x01\x03\x01\v\x01\x05\x02\x0f\x00\x05\x02\x05\x00\x05\x03\x05\x01\x05\x03\x0f\x01\x03\x04\v\x01\x04\x04\f\x01\x05\x04\r\x01\x06\x04\x0e\x02\x03\x04\x13\x00\x06\x05\x06\x01\x06\x05\x10\x01\x06\x06\x0e\x01\x05\x06\r\x01\x04\x06\f\x01\x03\x06\v\x02\x06\x06\x16\x01\x06\a\x10\x00\x06\a\x06\x02\a\x00\x17\x02\x06\x00\x16\x02\x05\x00\x15\x02\x04\x00\x14\x01\a\x00\x0f\x02\x04\x01\x14\x02\x05\x01\x15\x02\x06\x01\x16\x02\a\x01\x17\x01\x04\x01\f\x01\x06\x02\x10\x00\x06\x02\x06\x00\x06\x03\x06\x01\x06\x03\x10\x01\x04\x04\f\x01\x05\x04\r\x01\x06\x04\x0e\x01\a\x04\x0f\x02\x04\x04\x14\x00\a\x05\a\x01\a\x05\x11\x01\a\x06\x0f\x01\x06\x06\x0e\x01\x05\x06\r\x01\x04\x06\f\x02\a\x06\x17\x01\a\a\x11\x00\a\a\a\x02\a\x00\x17\x02\x06\x00\x16\x02\x05\x00\x15\x02\x05\x01\x15\x02\x06\x01\x16\x02\a\x01\x17\x01\x05\x01\r\x01\a\x02\x11\x00\a\x02\a\x00\a\x03\a\x01\a\x03\x11\x01\x05\x04\r\x01\x06\x04\x0e\x01\a\x04\x0f\x02\x05\x04\x15\x00\b\x05\b\x01\b\x05\x12\x01\a\x06\x0f\x01\x06\x06\x0e\x01\x05\x06\r\x01\b\a\x12\x00\b\a\b\x02\a\x00\x17\x02\x06\x00\x16\x02\x06\x01\x16\x02\a\x01\x17\x01\x06\x01\x0e\x01\b\x02\x12\x00\b\x02\b\x00\b\x03\b\x01\b\x03\x12\x01\x06\x04\x0e\x01\a\x04\x0f\x02\x06\x04\x16\x00\t\x05\t\x01\t\x05\x13\x01\a\x06\x0f\x01\x06\x06\x0e\x01\t\a\x13\x00\t\a\t\x02\a\x00\x17\x02\a\x01\x17\x01\a\x01\x0f\x01\t\x02\x13\x00\t\x02\t\x00\t\x03\t\x01\t\x03\x13\x01\a\x04\x0f\x02\a\x04\x17\x01\a\x06\x0f\x03\x00\x00\x18\x02\x00\x00\x10\x03\x00\x01\x18\x00\x00\x03\x00\x02\x00\x04\x10\x00\x00\x05\x00\x01\x00\x05\n\x02\x00\x06\x10\x03\x00\x06\x18\x01\x00\a\n\x00\x00\a\x00\x03\x01\x00\x19\x03\x00\x00\x18\x02\x01\x00\x11\x03\x00\x01\x18\x03\x01\x01\x19\x01\x00\x02\n\x00\x00\x02\x00\x00\x00\x03\x00\x01\x00\x03\n\x00\x01\x03\x01\x02\x00\x04\x10\x02\x01\x04\x11\x00\x01\x05\x01\x01\x01\x05\v\x00\x00\x05\x00\x02\x01\x06\x11\x02\x00\x06\x10\x03\x01\x06\x19\x01\x01\a\v\x00\x01\a\x01\x03\x02\x00\x1a\x03\x01\x00\x19\x03\x00\x00\x18\x02\x02\x00\x12\x03\x00\x01\x18\x03\x01\x01\x19\x03\x02\x01\x1a\x01\x01\x02\v\x00\x01\x02\x01\x00\x01\x03\x01\x01\x01\x03\v\x00\x02\x03\x02\x02\x00\x04\x10\x02\x01\x04\x11\x02\x02\x04\x12\x00\x02\x05\x02\x01\x02\x05\f\x00\x01\x05\x01\x02\x02\x06\x12\x02\x01\x06\x11\x02\x00\x06\x10\x03\x02\x06\x1a\x01\x02\a\f\x00\x02\a\x02\x03\x03\x00\x1b\x03\x02\x00\x1a\x03\x01\x00\x19\x03\x00\x00\x18\x02\x03\x00\x13\x03\x00\x01\x18\x03\x01\x01\x19\x03\x02\x01\x1a\x03\x03\x01\x1b\x02\x00\x01\x10\x01\x02\x02\f\x00\x02\x02\x02\x00\x02\x03\x02\x01\x02\x03\f\x00\x03\x03\x03\x02\x00\x04\x10\x02\x01\x04\x11\x02\x02\x04\x12\x02\x03\x04\x13\x03\x00\x04\x18\x00\x03\x05\x03\x01\x03\x05\r\x00\x02\x05\x02\x02\x03\x06\x13\x02\x02\x06\x12\x02\x01\x06\x11\x02\x00\x06\x10\x03\x03\x06\x1b\x01\x03\a\r\x00\x03\a\x03\x03\x04\x00\x1c\x03\x03\x00\x1b\x03\x02\x00\x1a\x03\x01\x00\x19\x02\x04\x00\x14\x03\x01\x01\x19\x03\x02\x01\x1a\x03\x03\x01\x1b\x03\x04\x01\x1c\x02\x01\x01\x11\x01\x03\x02\r\x00\x03\x02\x03\x00\x03\x03\x03\x01\x03\x03\r\x00\x04\x03\x04\x02\x01\x04\x11\x02\x02\x04\x12\x02\x03\x04\x13\x02\x04\x04\x14\x03\x01\x04\x19\x00\x04\x05\x04\x01\x04\x05\x0e\x00\x03\x05\x03\x02\x04\x06\x14\x02\x03\x06\x13\x02\x02\x06\x12\x02\x01\x06\x11\x03\x04\x06\x1c\x01\x04\a\x0e\x00\x04\a\x04\x03\x05\x00\x1d\x03\x04\x00\x1c\x03\x03\x00\x1b\x03\x02\x00\x1a\x02\x05\x00\x15\x03\x02\x01\x1a\x03\x03\x01\x1b\x03\x04\x01\x1c\x03\x05\x01\x1d\x02\x02\x01\x12\x01\x04\x02\x0e\x00\x04\x02\x04\x00\x04\x03\x04\x01\x04\x03\x0e\x00\x05\x03\x05\x02\x02\x04\x12\x02\x03\x04\x13\x02\x04\x04\x14\x02\x05\x04\x15\x03\x02\x04\x1a\x00\x05\x05\x05\x01\x05\x05\x0f\x00\x04\x05\x04\x02\x05\x06\x15\x02\x04\x06\x14\x02\x03\x06\x13\x02\x02\x06\x12\x03\x05\x06\x1d\x01\x05\a\x0f\x00\x05\a\x05\x03\x06\x00\x1e\x03\x05\x00\x1d\x03\x04\x00\x1c\x03\x03\x00\x1b\x02\x06\x00\x16\x03\x03\x01\x1b\x03\x04\x01\x1c\x03\x05\x01\x1d\x03\x06\x01\x1e\x02\x03\x01\x13\x01\x05\x02\x0f\x00\x05\x02\x05\x00\x05\x03\x05\x01\x05\x03\x0f\x00\x06\x03\x06\x02\x03\x04\x13\x02\x04\x04\x14\x02\x05\x04\x15\x02\x06\x04\x16\x03\x03\x04\x1b\x00\x06\x05\x06\x01\x06\x05\x10\x00\x05\x05\x05\x02\x06\x06\x16\x02\x05\x06\x15\x02\x04\x06\x14\x02\x03\x06\x13\x03\x06\x06\x1e\x01\x06\a\x10\x00\x06\a\x06\x03\a\x00\x1f\x03\x06\x00\x1e\x03\x05\x00\x1d\x03\x04\x00\x1c\x02\a\x00\x17\x03\x04\x01\x1c\x03\x05\x01\x1d\x03\x06\x01\x1e\x03\a\x01\x1f\x02\x04\x01\x14\x01\x06\x02\x10\x00\x06\x02\x06\x00\x06\x03\x06\x01\x06\x03\x10\x00\a\x03\a\x02\x04\x04\x14\x02\x05\x04\x15\x02\x06\x04\x16\x02\a\x04\x17\x03\x04\x04\x1c\x00\a\x05\a\x01\a\x05\x11\x00\x06\x05\x06\x02\a\x06\x17\x02\x06\x06\x16\x02\x05\x06\x15\x02\x04\x06\x14\x03\a\x06\x1f\x01\a\a\x11\x00\a\a\a\x03\a\x00\x1f\x03\x06\x00\x1e\x03\x05\x00\x1d\x03\x05\x01\x1d\x03\x06\x01\x1e\x03\a\x01\x1f\x02\x05\x01\x15\x01\a\x02\x11\x00\a\x02\a\x00\a\x03\a\x01\a\x03\x11\x00\b\x03\b\x02\x05\x04\x15\x02\x06\x04\x16\x02\a\x04\x17\x03\x05\x04\x1d\x00\b\x05\b\x01\b\x05\x12\x00\a\x05\a\x02\a\x06\x17\x02\x06\x06\x16\x02\x05\x06\x15\x01\b\a\x12\x00\b\a\b\x03\a\x00\x1f\x03\x06\x00\x1e\x03\x06\x01\x1e\x03\a\x01\x1f\x02\x06\x01\x16\x01\b\x02\x12\x00\b\x02\b\x00\b\x03\b\x01\b\x03\x12\x00\t\x03\t\x02\x06\x04\x16\x02\a\x04\x17\x03\x06\x04\x1e\x00\t\x05\t\x01\t\x05\x13\x00\b\x05\b\x02\a\x06\x17\x02\x06\x06\x16\x01\t\a\x13\x00\t\a\t\x03\a\x00\x1f\x03\a\x01\x1f\x02\a\x01\x17\x01\t\x02\x13\x00\t\x02\t\x00\t\x03\t\x01\t\x03\x13\x02\a\x04\x17\x03\a\x04\x1f\x00\t\x05\t\x02\a\x06\x17\x03\x00\x00\x18\x01\x00\x03\n\x03\x00\x04\x18\x01\x00\x05\n\x03\x00\x06\x18\x01\x00\a\n\x03\x01\x00\x19\x01\x00\x02\n\x01\x00\x03\n\x01\x01\x03\v\x03\x00\x04\x18\x03\x01\x04\x19\x01\x01\x05\v\x01\x00\x05\n\x03\x01\x06\x19\x03\x00\x06\x18\x01\x01\a\v\x03\x02\x00\x1a\x01\x01\x02\v\x01\x01\x03\v\x01\x02\x03\f\x03\x00\x04\x18\x03\x01\x04\x19\x03\x02\x04\x1a\x01\x02\x05\f\x01\x01\x05\v\x03\x02\x06\x1a\x03\x01\x06\x19\x03\x00\x06\x18\x01\x02\a\f\x03\x03\x00\x1b\x03\x00\x01\x18\x01\x02\x02\f\x01\x02\x03\f\x01\x03\x03\r\x03\x00\x04\x18\x03\x01\x04\x19\x03\x02\x04\x1a\x03\x03\x04\x1b\x01\x03\x05\r\x01\x02\x05\f\x03\x03\x06\x1b\x03\x02\x06\x1a\x03\x01\x06\x19\x03\x00\x06\x18\x01\x03\a\r\x03\x04\x00\x1c\x03\x01\x01\x19\x01\x03\x02\r\x01\x03\x03\r\x01\x04\x03\x0e\x03\x01\x04\x19\x03\x02\x04\x1a\x03\x03\x04\x1b\x03\x04\x04\x1c\x01\x04\x05\x0e\x01\x03\x05\r\x03\x04\x06\x1c\x03\x03\x06\x1b\x03\x02\x06\x1a\x03\x01\x06\x19\x01\x04\a\x0e\x03\x05\x00\x1d\x03\x02\x01\x1a\x01\x04\x02\x0e\x01\x04\x03\x0e\x01\x05\x03\x0f\x03\x02\x04\x1a\x03\x03\x04\x1b\x03\x04\x04\x1c\x03\x05\x04\x1d\x01\x05\x05\x0f\x01\x04\x05\x0e\x03\x05\x06\x1d\x03\x04\x06\x1c\x03\x03\x06\x1b\x03\x02\x06\x1a\x01\x05\a\x0f\x03\x06\x00\x1e\x03\x03\x01\x1b\x01\x05\x02\x0f\x01\x05\x03\x0f\x01\x06\x03\x10\x03\x03\x04\x1b\x03\x04\x04\x1c\x03\x05\x04\x1d\x03\x06\x04\x1e\x01\x06\x05\x10\x01\x05\x05\x0f\x03\x06\x06\x1e\x03\x05\x06\x1d\x03\x04\x06\x1c\x03\x03\x06\x1b\x01\x06\a\x10\x03\a\x00\x1f\x03\x04\x01\x1c\x01\x06\x02\x10\x01\x06\x03\x10\x01\a\x03\x11\x03\x04\x04\x1c\x03\x05\x04\x1d\x03\x06\x04\x1e\x03\a\x04\x1f\x01\a\x05\x11\x01\x06\x05\x10\x03\a\x06\x1f\x03\x06\x06\x1e\x03\x05\x06\x1d\x03\x04\x06\x1c\x01\a\a\x11\x03\x05\x01\x1d\x01\a\x02\x11\x01\a\x03\x11\x01\b\x03\x12\x03\x05\x04\x1d\x03\x06\x04\x1e\x03\a\x04\x1f\x01\b\x05\x12\x01\a\x05\x11\x03\a\x06\x1f\x03\x06\x06\x1e\x03\x05\x06\x1d\x01\b\a\x12\x03\x06\x01\x1e\x01\b\x02\x12\x01\b\x03\x12\x01\t\x03\x13\x03\x06\x04\x1e\x03\a\x04\x1f\x01\t\x05\x13\x01\b\x05\x12\x03\a\x06\x1f\x03\x06\x06\x1e\x01\t\a\x13\x03\a\x01\x1f\x01\t\x02\x13\x01\t\x03\x13\x03\a\x04\x1f\x01\t\x05\x13\x03\a\x06\x1f",
+		start: []int32{0, 6, 17, 30, 46, 62, 78, 94, 110, 123, 134, 140, 151, 171, 195, 225, 255, 285, 315, 345, 369, 389, 400, 410, 428, 450, 478, 506, 534, 562, 590, 612, 630, 640, 651, 671, 695, 725, 755, 785, 815, 845, 869, 889, 900, 906, 917, 930, 946, 962, 978, 994, 1010, 1023, 1034, 1040},
+	},
+	{rows: 5, cols: 11, cells: "0,0;0,1;0,2;0,3;1,1;", sym: false, n: 208,
+		versions: []generatedVersion{
+			{lo: Pos{0, 0}, n: Pos{4, 8}, masks: []uint64{0x100f, 0x201e, 0x403c, 0x8078, 0x100f0, 0x201e0, 0x403c0, 0x80780, 0x807800, 0x100f000, 0x201e000, 0x403c000, 0x8078000, 0x100f0000, 0x201e0000, 0x403c0000, 0x403c00000, 0x807800000, 0x100f000000, 0x201e000000, 0x403c000000, 0x8078000000, 0x100f0000000, 0x201e0000000, 0x201e00000000, 0x403c00000000, 0x807800000000, 0x100f000000000, 0x201e000000000, 0x403c000000000, 0x8078000000000, 0x100f0000000000}},
+			{lo: Pos{0, 0}, n: Pos{4, 8}, masks: []uint64{0x200f, 0x401e, 0x803c, 0x10078, 0x200f0, 0x401e0, 0x803c0, 0x100780, 0x1007800, 0x200f000, 0x401e000, 0x803c000, 0x10078000, 0x200f0000, 0x401e0000, 0x803c0000, 0x803c00000, 0x1007800000, 0x200f000000, 0x401e000000, 0x803c000000, 0x10078000000, 0x200f0000000, 0x401e0000000, 0x401e00000000, 0x803c00000000, 0x1007800000000, 0x200f000000000, 0x401e000000000, 0x803c000000000, 0x10078000000000, 0x200f0000000000}},
+			{lo: Pos{0, 0}, n: Pos{2, 10}, masks: []uint64{0x400801802, 0x801003004, 0x1002006008, 0x200400c010, 0x4008018020, 0x8010030040, 0x10020060080, 0x200400c0100, 0x40080180200, 0x80100300400, 0x200400c01000, 0x400801802000, 0x801003004000, 0x1002006008000, 0x200400c010000, 0x4008018020000, 0x8010030040000, 0x10020060080000, 0x200400c0100000, 0x40080180200000}},
+			{lo: Pos{0, 0}, n: Pos{2, 10}, masks: []uint64{0x400c01002, 0x801802004, 0x1003004008, 0x2006008010, 0x400c010020, 0x8018020040, 0x10030040080, 0x20060080100, 0x400c0100200, 0x80180200400, 0x200600801000, 0x400c01002000, 0x801802004000, 0x1003004008000, 0x2006008010000, 0x400c010020000, 0x8018020040000, 0x10030040080000, 0x20060080100000, 0x400c0100200000}},
+			{lo: Pos{0, 0}, n: Pos{4, 8}, masks: []uint64{0x7804, 0xf008, 0x1e010, 0x3c020, 0x78040, 0xf0080, 0x1e0100, 0x3c0200, 0x3c02000, 0x7804000, 0xf008000, 0x1e010000, 0x3c020000, 0x78040000, 0xf0080000, 0x1e0100000, 0x1e01000000, 0x3c02000000, 0x7804000000, 0xf008000000, 0x1e010000000, 0x3c020000000, 0x78040000000, 0xf0080000000, 0xf00800000000, 0x1e01000000000, 0x3c02000000000, 0x7804000000000, 0xf008000000000, 0x1e010000000000, 0x3c020000000000, 0x78040000000000}},
+			{lo: Pos{0, 0}, n: Pos{2, 10}, masks: []uint64{0x200c00801, 0x401801002, 0x803002004, 0x1006004008, 0x200c008010, 0x4018010020, 0x8030020040, 0x10060040080, 0x200c0080100, 0x40180100200, 0x100600400800, 0x200c00801000, 0x401801002000, 0x803002004000, 0x1006004008000, 0x200c008010000, 0x4018010020000, 0x8030020040000, 0x10060040080000, 0x200c0080100000}},
+			{lo: Pos{0, 0}, n: Pos{4, 8}, masks: []uint64{0x7802, 0xf004, 0x1e008, 0x3c010, 0x78020, 0xf0040, 0x1e0080, 0x3c0100, 0x3c01000, 0x7802000, 0xf004000, 0x1e008000, 0x3c010000, 0x78020000, 0xf0040000, 0x1e0080000, 0x1e00800000, 0x3c01000000, 0x7802000000, 0xf004000000, 0x1e008000000, 0x3c010000000, 0x78020000000, 0xf0040000000, 0xf00400000000, 0x1e00800000000, 0x3c01000000000, 0x7802000000000, 0xf004000000000, 0x1e008000000000, 0x3c010000000000, 0x78020000000000}},
+			{lo: Pos{0, 0}, n: Pos{2, 10}, masks: []uint64{0x200401801, 0x400803002, 0x801006004, 0x100200c008, 0x2004018010, 0x4008030020, 0x8010060040, 0x100200c0080, 0x20040180100, 0x40080300200, 0x100200c00800, 0x200401801000, 0x400803002000, 0x801006004000, 0x100200c008000, 0x2004018010000, 0x4008030020000, 0x8010060040000, 0x100200c0080000, 0x20040180100000}},
+		},
+		spots: "\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x02\x00\x00\x00\x03\x00\x00\x00\x04\x00\x00\x00\x05\x00\x00\x00\x06\x00\x00\x00\a\x00\x00\x01\x00\x01\x00\x01\x01\x01\x00\x01\x02\x01\x00\x01\x03\x01\x00\x01\x04\x01\x00\x01\x05\x01\x00\x01\x06\x01\x00\x01\a\x01\x00\x02\x00\x02\x00\x02\x01\x02\x00\x02\x02\x02\x00\x02\x03\x02\x00\x02\x04\x02\x00\x02\x05\x02\x00\x02\x06\x02\x00\x02\a\x02\x00\x03\x00\x03\x00\x03\x01\x03\x00\x03\x02\x03\x00\x03\x03\x03\x00\x03\x04\x03\x00\x03\x05\x03\x00\x03\x06\x03\x00\x03\a\x03\x00\x04\x00\x04\x00\x04\x01\x04\x00\x04\x02\x04\x00\x04\x03\x04\x00\x04\x04\x04\x00\x04\x05\x04\x00\x04\x06\x04\x00\x04\a\x04\x00\x05\x00\x05\x00\x05\x01\x05\x00\x05\x02\x05\x00\x05\x03\x05\x00\x05\x04\x05\x00\x05\x05\x05\x00\x05\x06\x05\x00\x05\a\x05\x00\x06\x00\x06\x00\x06\x01\x06\x00\x06\x02\x06\x00\x06\x03\x06\x00\x06\x04\x06\x00\x06\x05\x06\x00\x06\x06\x06\x00\x06\a\x06\x00\a\x00\a\x00\a\x01\a\x00\a\x02\a\x00\a\x03\a\x00\a\x04\a\x00\a\x05\a\x00\a\x06\a\x00\a\a\a\x00\b\x02\b\x00\b\x03\b\x00\b\x05\b\x00\b\a\b\x00\t\x02\t\x00\t\x03\t\x00\t\x05\t\x00\t\a\t\x01\x00\x00\b\x01\x00\x01\b\x01\x00\x02\n\x01\x00\x03\n\x01\x00\x04\b\x01\x00\x05\n\x01\x00\x06\b\x01\x00\a\n\x01\x01\x00\t\x01\x01\x01\t\x01\x01\x02\v\x01\x01\x03\v\x01\x01\x04\t\x01\x01\x05\v\x01\x01\x06\t\x01\x01\a\v\x01\x02\x00\n\x01\x02\x01\n\x01\x02\x02\f\x01\x02\x03\f\x01\x02\x04\n\x01\x02\x05\f\x01\x02\x06\n\x01\x02\a\f\x01\x03\x00\v\x01\x03\x01\v\x01\x03\x02\r\x01\x03\x03\r\x01\x03\x04\v\x01\x03\x05\r\x01\x03\x06\v\x01\x03\a\r\x01\x04\x00\f\x01\x04\x01\f\x01\x04\x02\x0e\x01\x04\x03\x0e\x01\x04\x04\f\x01\x04\x05\x0e\x01\x04\x06\f\x01\x04\a\x0e\x01\x05\x00\r\x01\x05\x01\r\x01\x05\x02\x0f\x01\x05\x03\x0f\x01\x05\x04\r\x01\x05\x05\x0f\x01\x05\x06\r\x01\x05\a\x0f\x01\x06\x00\x0e\x01\x06\x01\x0e\x01\x06\x02\x10\x01\x06\x03\x10\x01\x06\x04\x0e\x01\x06\x05\x10\x01\x06\x06\x0e\x01\x06\a\x10\x01\a\x00\x0f\x01\a\x01\x0f\x01\a\x02\x11\x01\a\x03\x11\x01\a\x04\x0f\x01\a\x05\x11\x01\a\x06\x0f\x01\a\a\x11\x01\b\x02\x12\x01\b\x03\x12\x01\b\x05\x12\x01\b\a\x12\x01\t\x02\x13\x01\t\x03\x13\x01\t\x05\x13\x01\t\a\x13\x02\x00\x00\x10\x02\x00\x01\x10\x02\x00\x04\x10\x02\x00\x06\x10\x02\x01\x00\x11\x02\x01\x01\x11\x02\x01\x04\x11\x02\x01\x06\x11\x02\x02\x00\x12\x02\x02\x01\x12\x02\x02\x04\x12\x02\x02\x06\x12\x02\x03\x00\x13\x02\x03\x01\x13\x02\x03\x04\x13\x02\x03\x06\x13\x02\x04\x00\x14\x02\x04\x01\x14\x02\x04\x04\x14\x02\x04\x06\x14\x02\x05\x00\x15\x02\x05\x01\x15\x02\x05\x04\x15\x02\x05\x06\x15\x02\x06\x00\x16\x02\x06\x01\x16\x02\x06\x04\x16\x02\x06\x06\x16\x02\a\x00\x17\x02\a\x01\x17\x02\a\x04\x17\x02\a\x06\x17\x03\x00\x00\x18\x03\x00\x01\x18\x03\x00\x04\x18\x03\x00\x06\x18\x03\x01\x00\x19\x03\x01\x01\x19\x03\x01\x04\x19\x03\x01\x06\x19\x03\x02\x00\x1a\x03\x02\x01\x1a\x03\x02\x04\x1a\x03\x02\x06\x1a\x03\x03\x00\x1b\x03\x03\x01\x1b\x03\x03\x04\x1b\x03\x03\x06\x1b\x03\x04\x00\x1c\x03\x04\x01\x1c\x03\x04\x04\x1c\x03\x04\x06\x1c\x03\x05\x00\x1d\x03\x05\x01\x1d\x03\x05\x04\x1d\x03\x05\x06\x1d\x03\x06\x00\x1e\x03\x06\x01\x1e\x03\x06\x04\x1e\x03\x06\x06\x1e\x03\a\x00\x1f\x03\a\x01\x1f\x03\a\x04\x1f\x03\a\x06\x1f",
+		cover: "\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x05\x00\x00\x00\a\x00\x00\x01\x00\x01\x00\x00\x00\x00\x00\x00\x01\x00\x00\x01\x01\x01\x00\x00\x02\x00\x00\x00\x03\x00\x00\x01\x05\x01\x00\x00\x06\x00\x00\x01\a\x01\x00\x02\x00\x02\x00\x01\x00\x01\x00\x00\x00\x00\x00\x00\x01\x00\x00\x01\x01\x01\x00\x02\x01\x02\x00\x01\x02\x01\x00\x01\x03\x01\x00\x00\x04\x00\x00\x02\x05\x02\x00\x01\x06\x01\x00\x02\a\x02\x00\x03\x00\x03\x00\x02\x00\x02\x00\x01\x00\x01\x00\x00\x00\x00\x00\x00\x01\x00\x00\x01\x01\x01\x00\x02\x01\x02\x00\x03\x01\x03\x00\x02\x02\x02\x00\x02\x03\x02\x00\x01\x04\x01\x00\x03\x05\x03\x00\x02\x06\x02\x00\x03\a\x03\x00\x04\x00\x04\x00\x03\x00\x03\x00\x02\x00\x02\x00\x01\x00\x01\x00\x01\x01\x01\x00\x02\x01\x02\x00\x03\x01\x03\x00\x04\x01\x04\x00\x03\x02\x03\x00\x03\x03\x03\x00\x02\x04\x02\x00\x04\x05\x04\x00\x03\x06\x03\x00\x04\a\x04\x00\x05\x00\x05\x00\x04\x00\x04\x00\x03\x00\x03\x00\x02\x00\x02\x00\x02\x01\x02\x00\x03\x01\x03\x00\x04\x01\x04\x00\x05\x01\x05\x00\x04\x02\x04\x00\x04\x03\x04\x00\x03\x04\x03\x00\x05\x05\x05\x00\x04\x06\x04\x00\x05\a\x05\x00\x06\x00\x06\x00\x05\x00\x05\x00\x04\x00\x04\x00\x03\x00\x03\x00\x03\x01\x03\x00\x04\x01\x04\x00\x05\x01\x05\x00\x06\x01\x06\x00\x05\x02\x05\x00\x05\x03\x05\x00\x04\x04\x04\x00\x06\x05\x06\x00\x05\x06\x05\x00\x06\a\x06\x00\a\x00\a\x00\x06\x00\x06\x00\x05\x00\x05\x00\x04\x00\x04\x00\x04\x01\x04\x00\x05\x01\x05\x00\x06\x01\x06\x00\a\x01\a\x00\x06\x02\x06\x00\x06\x03\x06\x00\x05\x04\x05\x00\a\x05\a\x00\x06\x06\x06\x00\a\a\a\x00\a\x00\a\x00\x06\x00\x06\x00\x05\x00\x05\x00\x05\x01\x05\x00\x06\x01\x06\x00\a\x01\a\x00\a\x02\a\x00\a\x03\a\x00\x06\x04\x06\x00\b\x05\b\x00\a\x06\a\x00\b\a\b\x00\a\x00\a\x00\x06\x00\x06\x00\x06\x01\x06\x00\a\x01\a\x00\b\x02\b\x00\b\x03\b\x00\a\x04\a\x00\t\x05\t\x00\t\a\t\x00\a\x00\a\x00\a\x01\a\x00\t\x02\t\x00\t\x03\t\x01\x00\x00\b\x01\x00\x01\b\x00\x00\x02\x00\x00\x00\x04\x00\x00\x00\x05\x00\x01\x00\x05\n\x00\x00\x06\x00\x01\x00\a\n\x00\x00\a\x00\x01\x01\x00\t\x01\x00\x00\b\x00\x00\x00\x00\x01\x00\x01\b\x01\x01\x01\t\x01\x00\x02\n\x00\x00\x02\x00\x00\x01\x02\x01\x00\x00\x03\x00\x01\x00\x03\n\x00\x00\x04\x00\x00\x01\x04\x01\x00\x01\x05\x01\x01\x01\x05\v\x00\x01\x06\x01\x00\x00\x06\x00\x01\x00\x06\b\x01\x01\a\v\x00\x01\a\x01\x00\x00\a\x00\x01\x02\x00\n\x01\x01\x00\t\x01\x00\x00\b\x00\x01\x00\x01\x01\x00\x01\b\x01\x01\x01\t\x01\x02\x01\n\x00\x00\x01\x00\x01\x01\x02\v\x00\x01\x02\x01\x00\x02\x02\x02\x00\x01\x03\x01\x01\x01\x03\v\x00\x00\x04\x00\x00\x01\x04\x01\x00\x02\x04\x02\x01\x00\x04\b\x00\x02\x05\x02\x01\x02\x05\f\x00\x02\x06\x02\x00\x01\x06\x01\x00\x00\x06\x00\x01\x01\x06\t\x01\x02\a\f\x00\x02\a\x02\x00\x01\a\x01\x01\x03\x00\v\x01\x02\x00\n\x01\x01\x00\t\x01\x00\x00\b\x00\x02\x00\x02\x01\x00\x01\b\x01\x01\x01\t\x01\x02\x01\n\x01\x03\x01\v\x00\x01\x01\x01\x01\x02\x02\f\x00\x02\x02\x02\x00\x03\x02\x03\x00\x02\x03\x02\x01\x02\x03\f\x00\x00\x04\x00\x00\x01\x04\x01\x00\x02\x04\x02\x00\x03\x04\x03\x01\x01\x04\t\x00\x03\x05\x03\x01\x03\x05\r\x00\x03\x06\x03\x00\x02\x06\x02\x00\x01\x06\x01\x00\x00\x06\x00\x01\x02\x06\n\x01\x03\a\r\x00\x03\a\x03\x00\x02\a\x02\x01\x04\x00\f\x01\x03\x00\v\x01\x02\x00\n\x01\x01\x00\t\x00\x03\x00\x03\x01\x01\x01\t\x01\x02\x01\n\x01\x03\x01\v\x01\x04\x01\f\x00\x02\x01\x02\x01\x03\x02\r\x00\x03\x02\x03\x00\x04\x02\x04\x00\x03\x03\x03\x01\x03\x03\r\x00\x01\x04\x01\x00\x02\x04\x02\x00\x03\x04\x03\x00\x04\x04\x04\x01\x02\x04\n\x00\x04\x05\x04\x01\x04\x05\x0e\x00\x04\x06\x04\x00\x03\x06\x03\x00\x02\x06\x02\x00\x01\x06\x01\x01\x03\x06\v\x01\x04\a\x0e\x00\x04\a\x04\x00\x03\a\x03\x01\x05\x00\r\x01\x04\x00\f\x01\x03\x00\v\x01\x02\x00\n\x00\x04\x00\x04\x01\x02\x01\n\x01\x03\x01\v\x01\x04\x01\f\x01\x05\x01\r\x00\x03\x01\x03\x01\x04\x02\x0e\x00\x04\x02\x04\x00\x05\x02\x05\x00\x04\x03\x04\x01\x04\x03\x0e\x00\x02\x04\x02\x00\x03\x04\x03\x00\x04\x04\x04\x00\x05\x04\x05\x01\x03\x04\v\x00\x05\x05\x05\x01\x05\x05\x0f\x00\x05\x06\x05\x00\x04\x06\x04\x00\x03\x06\x03\x00\x02\x06\x02\x01\x04\x06\f\x01\x05\a\x0f\x00\x05\a\x05\x00\x04\a\x04\x01\x06\x00\x0e\x01\x05\x00\r\x01\x04\x00\f\x01\x03\x00\v\x00\x05\x00\x05\x01\x03\x01\v\x01\x04\x01\f\x01\x05\x01\r\x01\x06\x01\x0e\x00\x04\x01\x04\x01\x05\x02\x0f\x00\x05\x02\x05\x00\x06\x02\x06\x00\x05\x03\x05\x01\x05\x03\x0f\x00\x03\x04\x03\x00\x04\x04\x04\x00\x05\x04\x05\x00\x06\x04\x06\x01\x04\x04\f\x00\x06\x05\x06\x01\x06\x05\x10\x00\x06\x06\x06\x00\x05\x06\x05\x00\x04\x06\x04\x00\x03\x06\x03\x01\x05\x06\r\x01\x06\a\x10\x00\x06\a\x06\x00\x05\a\x05\x01\a\x00\x0f\x01\x06\x00\x0e\x01\x05\x00\r\x01\x04\x00\f\x00\x06\x00\x06\x01\x04\x01\f\x01\x05\x01\r\x01\x06\x01\x0e\x01\a\x01\x0f\x00\x05\x01\x05\x01\x06\x02\x10\x00\x06\x02\x06\x00\a\x02\a\x00\x06\x03\x06\x01\x06\x03\x10\x00\x04\x04\x04\x00\x05\x04\x05\x00\x06\x04\x06\x00\a\x04\a\x01\x05\x04\r\x00\a\x05\a\x01\a\x05\x11\x00\a\x06\a\x00\x06\x06\x06\x00\x05\x06\x05\x00\x04\x06\x04\x01\x06\x06\x0e\x01\a\a\x11\x00\a\a\a\x00\x06\a\x06\x01\a\x00\x0f\x01\x06\x00\x0e\x01\x05\x00\r\x00\a\x00\a\x01\x05\x01\r\x01\x06\x01\x0e\x01\a\x01\x0f\x00\x06\x01\x06\x01\a\x02\x11\x00\a\x02\a\x00\b\x02\b\x00\a\x03\a\x01\a\x03\x11\x00\x05\x04\x05\x00\x06\x04\x06\x00\a\x04\a\x01\x06\x04\x0e\x00\b\x05\b\x01\b\x05\x12\x00\a\x06\a\x00\x06\x06\x06\x00\x05\x06\x05\x01\a\x06\x0f\x01\b\a\x12\x00\b\a\b\x00\a\a\a\x01\a\x00\x0f\x01\x06\x00\x0e\x01\x06\x01\x0e\x01\a\x01\x0f\x00\a\x01\a\x01\b\x02\x12\x00\b\x02\b\x00\t\x02\t\x00\b\x03\b\x01\b\x03\x12\x00\x06\x04\x06\x00\a\x04\a\x01\a\x04\x0f\x00\t\x05\t\x01\t\x05\x13\x00\a\x06\a\x00\x06\x06\x06\x01\t\a\x13\x00\t\a\t\x00\b\a\b\x01\a\x00\x0f\x01\a\x01\x0f\x01\t\x02\x13\x00\t\x02\t\x00\t\x03\t\x01\t\x03\x13\x00\a\x04\a\x00\a\x06\a\x00\t\a\t\x02\x00\x00\x10\x02\x00\x01\x10\x01\x00\x02\n\x00\x00\x03\x00\x01\x00\x04\b\x00\x00\x05\x00\x01\x00\x05\n\x01\x00\x06\b\x01\x00\a\n\x00\x00\a\x00\x02\x01\x00\x11\x02\x00\x00\x10\x01\x00\x00\b\x02\x00\x01\x10\x02\x01\x01\x11\x01\x00\x02\n\x00\x00\x02\x00\x01\x01\x02\v\x00\x00\x03\x00\x01\x00\x03\n\x00\x01\x03\x01\x01\x00\x04\b\x01\x01\x04\t\x00\x01\x05\x01\x01\x01\x05\v\x00\x00\x05\x00\x01\x01\x06\t\x01\x00\x06\b\x02\x00\x06\x10\x01\x01\a\v\x00\x01\a\x01\x01\x00\a\n\x02\x02\x00\x12\x02\x01\x00\x11\x02\x00\x00\x10\x01\x01\x00\t\x02\x00\x01\x10\x02\x01\x01\x11\x02\x02\x01\x12\x01\x00\x01\b\x01\x01\x02\v\x00\x01\x02\x01\x01\x02\x02\f\x00\x01\x03\x01\x01\x01\x03\v\x00\x02\x03\x02\x01\x00\x04\b\x01\x01\x04\t\x01\x02\x04\n\x02\x00\x04\x10\x00\x02\x05\x02\x01\x02\x05\f\x00\x01\x05\x01\x01\x02\x06\n\x01\x01\x06\t\x01\x00\x06\b\x02\x01\x06\x11\x01\x02\a\f\x00\x02\a\x02\x01\x01\a\v\x02\x03\x00\x13\x02\x02\x00\x12\x02\x01\x00\x11\x02\x00\x00\x10\x01\x02\x00\n\x02\x00\x01\x10\x02\x01\x01\x11\x02\x02\x01\x12\x02\x03\x01\x13\x01\x01\x01\t\x01\x02\x02\f\x00\x02\x02\x02\x01\x03\x02\r\x00\x02\x03\x02\x01\x02\x03\f\x00\x03\x03\x03\x01\x00\x04\b\x01\x01\x04\t\x01\x02\x04\n\x01\x03\x04\v\x02\x01\x04\x11\x00\x03\x05\x03\x01\x03\x05\r\x00\x02\x05\x02\x01\x03\x06\v\x01\x02\x06\n\x01\x01\x06\t\x01\x00\x06\b\x02\x02\x06\x12\x01\x03\a\r\x00\x03\a\x03\x01\x02\a\f\x02\x04\x00\x14\x02\x03\x00\x13\x02\x02\x00\x12\x02\x01\x00\x11\x01\x03\x00\v\x02\x01\x01\x11\x02\x02\x01\x12\x02\x03\x01\x13\x02\x04\x01\x14\x01\x02\x01\n\x01\x03\x02\r\x00\x03\x02\x03\x01\x04\x02\x0e\x00\x03\x03\x03\x01\x03\x03\r\x00\x04\x03\x04\x01\x01\x04\t\x01\x02\x04\n\x01\x03\x04\v\x01\x04\x04\f\x02\x02\x04\x12\x00\x04\x05\x04\x01\x04\x05\x0e\x00\x03\x05\x03\x01\x04\x06\f\x01\x03\x06\v\x01\x02\x06\n\x01\x01\x06\t\x02\x03\x06\x13\x01\x04\a\x0e\x00\x04\a\x04\x01\x03\a\r\x02\x05\x00\x15\x02\x04\x00\x14\x02\x03\x00\x13\x02\x02\x00\x12\x01\x04\x00\f\x02\x02\x01\x12\x02\x03\x01\x13\x02\x04\x01\x14\x02\x05\x01\x15\x01\x03\x01\v\x01\x04\x02\x0e\x00\x04\x02\x04\x01\x05\x02\x0f\x00\x04\x03\x04\x01\x04\x03\x0e\x00\x05\x03\x05\x01\x02\x04\n\x01\x03\x04\v\x01\x04\x04\f\x01\x05\x04\r\x02\x03\x04\x13\x00\x05\x05\x05\x01\x05\x05\x0f\x00\x04\x05\x04\x01\x05\x06\r\x01\x04\x06\f\x01\x03\x06\v\x01\x02\x06\n\x02\x04\x06\x14\x01\x05\a\x0f\x00\x05\a\x05\x01\x04\a\x0e\x02\x06\x00\x16\x02\x05\x00\x15\x02\x04\x00\x14\x02\x03\x00\x13\x01\x05\x00\r\x02\x03\x01\x13\x02\x04\x01\x14\x02\x05\x01\x15\x02\x06\x01\x16\x01\x04\x01\f\x01\x05\x02\x0f\x00\x05\x02\x05\x01\x06\x02\x10\x00\x05\x03\x05\x01\x05\x03\x0f\x00\x06\x03\x06\x01\x03\x04\v\x01\x04\x04\f\x01\x05\x04\r\x01\x06\x04\x0e\x02\x04\x04\x14\x00\x06\x05\x06\x01\x06\x05\x10\x00\x05\x05\x05\x01\x06\x06\x0e\x01\x05\x06\r\x01\x04\x06\f\x01\x03\x06\v\x02\x05\x06\x15\x01\x06\a\x10\x00\x06\a\x06\x01\x05\a\x0f\x02\a\x00\x17\x02\x06\x00\x16\x02\x05\x00\x15\x02\x04\x00\x14\x01\x06\x00\x0e\x02\x04\x01\x14\x02\x05\x01\x15\x02\x06\x01\x16\x02\a\x01\x17\x01\x05\x01\r\x01\x06\x02\x10\x00\x06\x02\x06\x01\a\x02\x11\x00\x06\x03\x06\x01\x06\x03\x10\x00\a\x03\a\x01\x04\x04\f\x01\x05\x04\r\x01\x06\x04\x0e\x01\a\x04\x0f\x02\x05\x04\x15\x00\a\x05\a\x01\a\x05\x11\x00\x06\x05\x06\x01\a\x06\x0f\x01\x06\x06\x0e\x01\x05\x06\r\x01\x04\x06\f\x02\x06\x06\x16\x01\a\a\x11\x00\a\a\a\x01\x06\a\x10\x02\a\x00\x17\x02\x06\x00\x16\x02\x05\x00\x15\x01\a\x00\x0f\x02\x05\x01\x15\x02\x06\x01\x16\x02\a\x01\x17\x01\x06\x01\x0e\x01\a\x02\x11\x00\a\x02\a\x01\b\x02\x12\x00\a\x03\a\x01\a\x03\x11\x00\b\x03\b\x01\x05\x04\r\x01\x06\x04\x0e\x01\a\x04\x0f\x02\x06\x04\x16\x00\b\x05\b\x01\b\x05\x12\x00\a\x05\a\x01\a\x06\x0f\x01\x06\x06\x0e\x01\x05\x06\r\x02\a\x06\x17\x01\b\a\x12\x00\b\a\b\x01\a\a\x11\x02\a\x00\x17\x02\x06\x00\x16\x02\x06\x01\x16\x02\a\x01\x17\x01\a\x01\x0f\x01\b\x02\x12\x00\b\x02\b\x01\t\x02\x13\x00\b\x03\b\x01\b\x03\x12\x00\t\x03\t\x01\x06\x04\x0e\x01\a\x04\x0f\x02\a\x04\x17\x00\t\x05\t\x01\t\x05\x13\x00\b\x05\b\x01\a\x06\x0f\x01\x06\x06\x0e\x01\t\a\x13\x00\t\a\t\x01\b\a\x12\x02\a\x00\x17\x02\a\x01\x17\x01\t\x02\x13\x00\t\x02\t\x00\t\x03\t\x01\t\x03\x13\x01\a\x04\x0f\x00\t\x05\t\x01\a\x06\x0f\x01\t\a\x13\x03\x00\x00\x18\x03\x00\x01\x18\x01\x00\x03\n\x02\x00\x04\x10\x00\x00\x05\x00\x01\x00\x05\n\x02\x00\x06\x10\x01\x00\a\n\x00\x00\a\x00\x03\x01\x00\x19\x03\x00\x00\x18\x02\x00\x00\x10\x03\x00\x01\x18\x03\x01\x01\x19\x01\x00\x02\n\x00\x00\x02\x00\x00\x00\x03\x00\x01\x00\x03\n\x01\x01\x03\v\x02\x00\x04\x10\x02\x01\x04\x11\x00\x01\x05\x01\x01\x01\x05\v\x01\x00\x05\n\x02\x01\x06\x11\x02\x00\x06\x10\x03\x00\x06\x18\x01\x01\a\v\x00\x01\a\x01\x03\x02\x00\x1a\x03\x01\x00\x19\x03\x00\x00\x18\x02\x01\x00\x11\x03\x00\x01\x18\x03\x01\x01\x19\x03\x02\x01\x1a\x02\x00\x01\x10\x01\x01\x02\v\x00\x01\x02\x01\x00\x01\x03\x01\x01\x01\x03\v\x01\x02\x03\f\x02\x00\x04\x10\x02\x01\x04\x11\x02\x02\x04\x12\x03\x00\x04\x18\x00\x02\x05\x02\x01\x02\x05\f\x01\x01\x05\v\x02\x02\x06\x12\x02\x01\x06\x11\x02\x00\x06\x10\x03\x01\x06\x19\x01\x02\a\f\x00\x02\a\x02\x03\x03\x00\x1b\x03\x02\x00\x1a\x03\x01\x00\x19\x03\x00\x00\x18\x02\x02\x00\x12\x03\x00\x01\x18\x03\x01\x01\x19\x03\x02\x01\x1a\x03\x03\x01\x1b\x02\x01\x01\x11\x01\x02\x02\f\x00\x02\x02\x02\x00\x02\x03\x02\x01\x02\x03\f\x01\x03\x03\r\x02\x00\x04\x10\x02\x01\x04\x11\x02\x02\x04\x12\x02\x03\x04\x13\x03\x01\x04\x19\x00\x03\x05\x03\x01\x03\x05\r\x01\x02\x05\f\x02\x03\x06\x13\x02\x02\x06\x12\x02\x01\x06\x11\x02\x00\x06\x10\x03\x02\x06\x1a\x01\x03\a\r\x00\x03\a\x03\x03\x04\x00\x1c\x03\x03\x00\x1b\x03\x02\x00\x1a\x03\x01\x00\x19\x02\x03\x00\x13\x03\x01\x01\x19\x03\x02\x01\x1a\x03\x03\x01\x1b\x03\x04\x01\x1c\x02\x02\x01\x12\x01\x03\x02\r\x00\x03\x02\x03\x00\x03\x03\x03\x01\x03\x03\r\x01\x04\x03\x0e\x02\x01\x04\x11\x02\x02\x04\x12\x02\x03\x04\x13\x02\x04\x04\x14\x03\x02\x04\x1a\x00\x04\x05\x04\x01\x04\x05\x0e\x01\x03\x05\r\x02\x04\x06\x14\x02\x03\x06\x13\x02\x02\x06\x12\x02\x01\x06\x11\x03\x03\x06\x1b\x01\x04\a\x0e\x00\x04\a\x04\x03\x05\x00\x1d\x03\x04\x00\x1c\x03\x03\x00\x1b\x03\x02\x00\x1a\x02\x04\x00\x14\x03\x02\x01\x1a\x03\x03\x01\x1b\x03\x04\x01\x1c\x03\x05\x01\x1d\x02\x03\x01\x13\x01\x04\x02\x0e\x00\x04\x02\x04\x00\x04\x03\x04\x01\x04\x03\x0e\x01\x05\x03\x0f\x02\x02\x04\x12\x02\x03\x04\x13\x02\x04\x04\x14\x02\x05\x04\x15\x03\x03\x04\x1b\x00\x05\x05\x05\x01\x05\x05\x0f\x01\x04\x05\x0e\x02\x05\x06\x15\x02\x04\x06\x14\x02\x03\x06\x13\x02\x02\x06\x12\x03\x04\x06\x1c\x01\x05\a\x0f\x00\x05\a\x05\x03\x06\x00\x1e\x03\x05\x00\x1d\x03\x04\x00\x1c\x03\x03\x00\x1b\x02\x05\x00\x15\x03\x03\x01\x1b\x03\x04\x01\x1c\x03\x05\x01\x1d\x03\x06\x01\x1e\x02\x04\x01\x14\x01\x05\x02\x0f\x00\x05\x02\x05\x00\x05\x03\x05\x01\x05\x03\x0f\x01\x06\x03\x10\x02\x03\x04\x13\x02\x04\x04\x14\x02\x05\x04\x15\x02\x06\x04\x16\x03\x04\x04\x1c\x00\x06\x05\x06\x01\x06\x05\x10\x01\x05\x05\x0f\x02\x06\x06\x16\x02\x05\x06\x15\x02\x04\x06\x14\x02\x03\x06\x13\x03\x05\x06\x1d\x01\x06\a\x10\x00\x06\a\x06\x03\a\x00\x1f\x03\x06\x00\x1e\x03\x05\x00\x1d\x03\x04\x00\x1c\x02\x06\x00\x16\x03\x04\x01\x1c\x03\x05\x01\x1d\x03\x06\x01\x1e\x03\a\x01\x1f\x02\x05\x01\x15\x01\x06\x02\x10\x00\x06\x02\x06\x00\x06\x03\x06\x01\x06\x03\x10\x01\a\x03\x11\x02\x04\x04\x14\x02\x05\x04\x15\x02\x06\x04\x16\x02\a\x04\x17\x03\x05\x04\x1d\x00\a\x05\a\x01\a\x05\x11\x01\x06\x05\x10\x02\a\x06\x17\x02\x06\x06\x16\x02\x05\x06\x15\x02\x04\x06\x14\x03\x06\x06\x1e\x01\a\a\x11\x00\a\a\a\x03\a\x00\x1f\x03\x06\x00\x1e\x03\x05\x00\x1d\x02\a\x00\x17\x03\x05\x01\x1d\x03\x06\x01\x1e\x03\a\x01\x1f\x02\x06\x01\x16\x01\a\x02\x11\x00\a\x02\a\x00\a\x03\a\x01\a\x03\x11\x01\b\x03\x12\x02\x05\x04\x15\x02\x06\x04\x16\x02\a\x04\x17\x03\x06\x04\x1e\x00\b\x05\b\x01\b\x05\x12\x01\a\x05\x11\x02\a\x06\x17\x02\x06\x06\x16\x02\x05\x06\x15\x03\a\x06\x1f\x01\b\a\x12\x00\b\a\b\x03\a\x00\x1f\x03\x06\x00\x1e\x03\x06\x01\x1e\x03\a\x01\x1f\x02\a\x01\x17\x01\b\x02\x12\x00\b\x02\b\x00\b\x03\b\x01\b\x03\x12\x01\t\x03\x13\x02\x06\x04\x16\x02\a\x04\x17\x03\a\x04\x1f\x00\t\x05\t\x01\t\x05\x13\x01\b\x05\x12\x02\a\x06\x17\x02\x06\x06\x16\x01\t\a\x13\x00\t\a\t\x03\a\x00\x1f\x03\a\x01\x1f\x01\t\x02\x13\x00\t\x02\t\x00\t\x03\t\x01\t\x03\x13\x02\a\x04\x17\x01\t\x05\x13\x02\a\x06\x17\x03\x00\x04\x18\x01\x00\x05\n\x03\x00\x06\x18\x01\x00\a\n\x03\x00\x00\x18\x01\x00\x02\n\x01\x00\x03\n\x03\x00\x04\x18\x03\x01\x04\x19\x01\x01\x05\v\x03\x01\x06\x19\x03\x00\x06\x18\x01\x01\a\v\x03\x01\x00\x19\x03\x00\x01\x18\x01\x01\x02\v\x01\x01\x03\v\x03\x00\x04\x18\x03\x01\x04\x19\x03\x02\x04\x1a\x01\x02\x05\f\x03\x02\x06\x1a\x03\x01\x06\x19\x03\x00\x06\x18\x01\x02\a\f\x03\x02\x00\x1a\x03\x01\x01\x19\x01\x02\x02\f\x01\x02\x03\f\x03\x00\x04\x18\x03\x01\x04\x19\x03\x02\x04\x1a\x03\x03\x04\x1b\x01\x03\x05\r\x03\x03\x06\x1b\x03\x02\x06\x1a\x03\x01\x06\x19\x03\x00\x06\x18\x01\x03\a\r\x03\x03\x00\x1b\x03\x02\x01\x1a\x01\x03\x02\r\x01\x03\x03\r\x03\x01\x04\x19\x03\x02\x04\x1a\x03\x03\x04\x1b\x03\x04\x04\x1c\x01\x04\x05\x0e\x03\x04\x06\x1c\x03\x03\x06\x1b\x03\x02\x06\x1a\x03\x01\x06\x19\x01\x04\a\x0e\x03\x04\x00\x1c\x03\x03\x01\x1b\x01\x04\x02\x0e\x01\x04\x03\x0e\x03\x02\x04\x1a\x03\x03\x04\x1b\x03\x04\x04\x1c\x03\x05\x04\x1d\x01\x05\x05\x0f\x03\x05\x06\x1d\x03\x04\x06\x1c\x03\x03\x06\x1b\x03\x02\x06\x1a\x01\x05\a\x0f\x03\x05\x00\x1d\x03\x04\x01\x1c\x01\x05\x02\x0f\x01\x05\x03\x0f\x03\x03\x04\x1b\x03\x04\x04\x1c\x03\x05\x04\x1d\x03\x06\x04\x1e\x01\x06\x05\x10\x03\x06\x06\x1e\x03\x05\x06\x1d\x03\x04\x06\x1c\x03\x03\x06\x1b\x01\x06\a\x10\x03\x06\x00\x1e\x03\x05\x01\x1d\x01\x06\x02\x10\x01\x06\x03\x10\x03\x04\x04\x1c\x03\x05\x04\x1d\x03\x06\x04\x1e\x03\a\x04\x1f\x01\a\x05\x11\x03\a\x06\x1f\x03\x06\x06\x1e\x03\x05\x06\x1d\x03\x04\x06\x1c\x01\a\a\x11\x03\a\x00\x1f\x03\x06\x01\x1e\x01\a\x02\x11\x01\a\x03\x11\x03\x05\x04\x1d\x03\x06\x04\x1e\x03\a\x04\x1f\x01\b\x05\x12\x03\a\x06\x1f\x03\x06\x06\x1e\x03\x05\x06\x1d\x01\b\a\x12\x03\a\x01\x1f\x01\b\x02\x12\x01\b\x03\x12\x03\x06\x04\x1e\x03\a\x04\x1f\x01\t\x05\x13\x03\a\x06\x1f\x03\x06\x06\x1e\x01\t\a\x13\x01\t\x02\x13\x01\t\x03\x13\x03\a\x04\x1f\x03\a\x06\x1f",
+		start: []int32{0, 4, 13, 25, 39, 53, 67, 81, 95, 107, 116, 120, 129, 149, 175, 205, 235, 265, 295, 325, 351, 371, 380, 390, 412, 440, 472, 504, 536, 568, 600, 628, 650, 660, 669, 689, 715, 745, 775, 805, 835, 865, 891, 911, 920, 924, 933, 945, 959, 973, 987, 1001, 1015, 1027, 1036, 1040},
+	},
+	{rows: 5, cols: 11, cells: "0,0;0,1;0,2;1,0;", sym: false, n: 264,
+		versions: []generatedVersion{
+			{lo: Pos{0, 0}, n: Pos{4, 9}, masks: []uint64{0x807, 0x100e, 0x201c, 0x4038, 0x8070, 0x100e0, 0x201c0, 0x40380, 0x80700, 0x403800, 0x807000, 0x100e000, 0x201c000, 0x4038000, 0x8070000, 0x100e0000, 0x201c0000, 0x40380000, 0x201c00000, 0x403800000, 0x807000000, 0x100e000000, 0x201c000000, 0x4038000000, 0x8070000000, 0x100e0000000, 0x201c0000000, 0x100e00000000, 0x201c00000000, 0x403800000000, 0x807000000000, 0x100e000000000, 0x201c000000000, 0x4038000000000, 0x8070000000000, 0x100e0000000000}},
+			{lo: Pos{0, 0}, n: Pos{4, 9}, masks: []uint64{0x2007, 0x400e, 0x801c, 0x10038, 0x20070, 0x400e0, 0x801c0, 0x100380, 0x200700, 0x1003800, 0x2007000, 0x400e000, 0x801c000, 0x10038000, 0x20070000, 0x400e0000, 0x801c0000, 0x100380000, 0x801c00000, 0x1003800000, 0x2007000000, 0x400e000000, 0x801c000000, 0x10038000000, 0x20070000000, 0x400e0000000, 0x801c0000000, 0x400e00000000, 0x801c00000000, 0x1003800000000, 0x2007000000000, 0x400e000000000, 0x801c000000000, 0x10038000000000, 0x20070000000000, 0x400e0000000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 10}, masks: []uint64{0x801003, 0x1002006, 0x200400c, 0x4008018, 0x8010030, 0x10020060, 0x200400c0, 0x40080180, 0x80100300, 0x100200600, 0x400801800, 0x801003000, 0x1002006000, 0x200400c000, 0x4008018000, 0x8010030000, 0x10020060000, 0x200400c0000, 0x40080180000, 0x80100300000, 0x200400c00000, 0x400801800000, 0x801003000000, 0x1002006000000, 0x200400c000000, 0x4008018000000, 0x8010030000000, 0x10020060000000, 0x200400c0000000, 0x40080180000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 10}, masks: []uint64{0xc01002, 0x1802004, 0x3004008, 0x6008010, 0xc010020, 0x18020040, 0x30040080, 0x60080100, 0xc0100200, 0x180200400, 0x600801000, 0xc01002000, 0x1802004000, 0x3004008000, 0x6008010000, 0xc010020000, 0x18020040000, 0x30040080000, 0x60080100000, 0xc0100200000, 0x300400800000, 0x600801000000, 0xc01002000000, 0x1802004000000, 0x3004008000000, 0x6008010000000, 0xc010020000000, 0x18020040000000, 0x30040080000000, 0x60080100000000}},
+			{lo: Pos{0, 0}, n: Pos{4, 9}, masks: []uint64{0x3804, 0x7008, 0xe010, 0x1c020, 0x38040, 0x70080, 0xe0100, 0x1c0200, 0x380400, 0x1c02000, 0x3804000, 0x7008000, 0xe010000, 0x1c020000, 0x38040000, 0x70080000, 0xe0100000, 0x1c0200000, 0xe01000000, 0x1c02000000, 0x3804000000, 0x7008000000, 0xe010000000, 0x1c020000000, 0x38040000000, 0x70080000000, 0xe0100000000, 0x700800000000, 0xe01000000000, 0x1c02000000000, 0x3804000000000, 0x7008000000000, 0xe010000000000, 0x1c020000000000, 0x38040000000000, 0x70080000000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 10}, masks: []uint64{0xc00801, 0x1801002, 0x3002004, 0x6004008, 0xc008010, 0x18010020, 0x30020040, 0x60040080, 0xc0080100, 0x180100200, 0x600400800, 0xc00801000, 0x1801002000, 0x3002004000, 0x6004008000, 0xc008010000, 0x18010020000, 0x30020040000, 0x60040080000, 0xc0080100000, 0x300200400000, 0x600400800000, 0xc00801000000, 0x1801002000000, 0x3002004000000, 0x6004008000000, 0xc008010000000, 0x18010020000000, 0x30020040000000, 0x60040080000000}},
+			{lo: Pos{0, 0}, n: Pos{4, 9}, masks: []uint64{0x3801, 0x7002, 0xe004, 0x1c008, 0x38010, 0x70020, 0xe0040, 0x1c0080, 0x380100, 0x1c00800, 0x3801000, 0x7002000, 0xe004000, 0x1c008000, 0x38010000, 0x70020000, 0xe0040000, 0x1c0080000, 0xe00400000, 0x1c00800000, 0x3801000000, 0x7002000000, 0xe004000000, 0x1c008000000, 0x38010000000, 0x70020000000, 0xe0040000000, 0x700200000000, 0xe00400000000, 0x1c00800000000, 0x3801000000000, 0x7002000000000, 0xe004000000000, 0x1c008000000000, 0x38010000000000, 0x70020000000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 10}, masks: []uint64{0x400803, 0x801006, 0x100200c, 0x2004018, 0x4008030, 0x8010060, 0x100200c0, 0x20040180, 0x40080300, 0x80100600, 0x200401800, 0x400803000, 0x801006000, 0x100200c000, 0x2004018000, 0x4008030000, 0x8010060000, 0x100200c0000, 0x20040180000, 0x40080300000, 0x100200c00000, 0x200401800000, 0x400803000000, 0x801006000000, 0x100200c000000, 0x2004018000000, 0x4008030000000, 0x8010060000000, 0x100200c0000000, 0x20040180000000}},
+		},
+		spots: "\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x02\x00\x00\x00\x03\x00\x00\x00\x04\x00\x00\x00\x05\x00\x00\x00\x06\x00\x00\x00\a\x00\x00\x01\x00\x01\x00\x01\x01\x01\x00\x01\x02\x01\x00\x01\x03\x01\x00\x01\x04\x01\x00\x01\x05\x01\x00\x01\x06\x01\x00\x01\a\x01\x00\x02\x00\x02\x00\x02\x01\x02\x00\x02\x02\x02\x00\x02\x03\x02\x00\x02\x04\x02\x00\x02\x05\x02\x00\x02\x06\x02\x00\x02\a\x02\x00\x03\x00\x03\x00\x03\x01\x03\x00\x03\x02\x03\x00\x03\x03\x03\x00\x03\x04\x03\x00\x03\x05\x03\x00\x03\x06\x03\x00\x03\a\x03\x00\x04\x00\x04\x00\x04\x01\x04\x00\x04\x02\x04\x00\x04\x03\x04\x00\x04\x04\x04\x00\x04\x05\x04\x00\x04\x06\x04\x00\x04\a\x04\x00\x05\x00\x05\x00\x05\x01\x05\x00\x05\x02\x05\x00\x05\x03\x05\x00\x05\x04\x05\x00\x05\x05\x05\x00\x05\x06\x05\x00\x05\a\x05\x00\x06\x00\x06\x00\x06\x01\x06\x00\x06\x02\x06\x00\x06\x03\x06\x00\x06\x04\x06\x00\x06\x05\x06\x00\x06\x06\x06\x00\x06\a\x06\x00\a\x00\a\x00\a\x01\a\x00\a\x02\a\x00\a\x03\a\x00\a\x04\a\x00\a\x05\a\x00\a\x06\a\x00\a\a\a\x00\b\x00\b\x00\b\x01\b\x00\b\x02\b\x00\b\x03\b\x00\b\x04\b\x00\b\x05\b\x00\b\x06\b\x00\b\a\b\x00\t\x02\t\x00\t\x03\t\x00\t\x05\t\x00\t\a\t\x01\x00\x00\t\x01\x00\x01\t\x01\x00\x02\n\x01\x00\x03\n\x01\x00\x04\t\x01\x00\x05\n\x01\x00\x06\t\x01\x00\a\n\x01\x01\x00\n\x01\x01\x01\n\x01\x01\x02\v\x01\x01\x03\v\x01\x01\x04\n\x01\x01\x05\v\x01\x01\x06\n\x01\x01\a\v\x01\x02\x00\v\x01\x02\x01\v\x01\x02\x02\f\x01\x02\x03\f\x01\x02\x04\v\x01\x02\x05\f\x01\x02\x06\v\x01\x02\a\f\x01\x03\x00\f\x01\x03\x01\f\x01\x03\x02\r\x01\x03\x03\r\x01\x03\x04\f\x01\x03\x05\r\x01\x03\x06\f\x01\x03\a\r\x01\x04\x00\r\x01\x04\x01\r\x01\x04\x02\x0e\x01\x04\x03\x0e\x01\x04\x04\r\x01\x04\x05\x0e\x01\x04\x06\r\x01\x04\a\x0e\x01\x05\x00\x0e\x01\x05\x01\x0e\x01\x05\x02\x0f\x01\x05\x03\x0f\x01\x05\x04\x0e\x01\x05\x05\x0f\x01\x05\x06\x0e\x01\x05\a\x0f\x01\x06\x00\x0f\x01\x06\x01\x0f\x01\x06\x02\x10\x01\x06\x03\x10\x01\x06\x04\x0f\x01\x06\x05\x10\x01\x06\x06\x0f\x01\x06\a\x10\x01\a\x00\x10\x01\a\x01\x10\x01\a\x02\x11\x01\a\x03\x11\x01\a\x04\x10\x01\a\x05\x11\x01\a\x06\x10\x01\a\a\x11\x01\b\x00\x11\x01\b\x01\x11\x01\b\x02\x12\x01\b\x03\x12\x01\b\x04\x11\x01\b\x05\x12\x01\b\x06\x11\x01\b\a\x12\x01\t\x02\x13\x01\t\x03\x13\x01\t\x05\x13\x01\t\a\x13\x02\x00\x00\x12\x02\x00\x01\x12\x02\x00\x02\x14\x02\x00\x03\x14\x02\x00\x04\x12\x02\x00\x05\x14\x02\x00\x06\x12\x02\x00\a\x14\x02\x01\x00\x13\x02\x01\x01\x13\x02\x01\x02\x15\x02\x01\x03\x15\x02\x01\x04\x13\x02\x01\x05\x15\x02\x01\x06\x13\x02\x01\a\x15\x02\x02\x00\x14\x02\x02\x01\x14\x02\x02\x02\x16\x02\x02\x03\x16\x02\x02\x04\x14\x02\x02\x05\x16\x02\x02\x06\x14\x02\x02\a\x16\x02\x03\x00\x15\x02\x03\x01\x15\x02\x03\x02\x17\x02\x03\x03\x17\x02\x03\x04\x15\x02\x03\x05\x17\x02\x03\x06\x15\x02\x03\a\x17\x02\x04\x00\x16\x02\x04\x01\x16\x02\x04\x02\x18\x02\x04\x03\x18\x02\x04\x04\x16\x02\x04\x05\x18\x02\x04\x06\x16\x02\x04\a\x18\x02\x05\x00\x17\x02\x05\x01\x17\x02\x05\x02\x19\x02\x05\x03\x19\x02\x05\x04\x17\x02\x05\x05\x19\x02\x05\x06\x17\x02\x05\a\x19\x02\x06\x00\x18\x02\x06\x01\x18\x02\x06\x02\x1a\x02\x06\x03\x1a\x02\x06\x04\x18\x02\x06\x05\x1a\x02\x06\x06\x18\x02\x06\a\x1a\x02\a\x00\x19\x02\a\x01\x19\x02\a\x02\x1b\x02\a\x03\x1b\x02\a\x04\x19\x02\a\x05\x1b\x02\a\x06\x19\x02\a\a\x1b\x02\b\x00\x1a\x02\b\x01\x1a\x02\b\x02\x1c\x02\b\x03\x1c\x02\b\x04\x1a\x02\b\x05\x1c\x02\b\x06\x1a\x02\b\a\x1c\x02\t\x02\x1d\x02\t\x03\x1d\x02\t\x05\x1d\x02\t\a\x1d\x03\x00\x00\x1b\x03\x00\x01\x1b\x03\x00\x04\x1b\x03\x00\x06\x1b\x03\x01\x00\x1c\x03\x01\x01\x1c\x03\x01\x04\x1c\x03\x01\x06\x1c\x03\x02\x00\x1d\x03\x02\x01\x1d\x03\x02\x04\x1d\x03\x02\x06\x1d\x03\x03\x00\x1e\x03\x03\x01\x1e\x03\x03\x04\x1e\x03\x03\x06\x1e\x03\x04\x00\x1f\x03\x04\x01\x1f\x03\x04\x04\x1f\x03\x04\x06\x1f\x03\x05\x00 \x03\x05\x01 \x03\x05\x04 \x03\x05\x06 \x03\x06\x00!\x03\x06\x01!\x03\x06\x04!\x03\x06\x06!\x03\a\x00\"\x03\a\x01\"\x03\a\x04\"\x03\a\x06\"\x03\b\x00#\x03\b\x01#\x03\b\x04#\x03\b\x06#",
+		cover: "\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x02\x00\x00\x00\x05\x00\x00\x00\x06\x00\x00\x00\a\x00\x00\x01\x00\x01\x00\x00\x00\x00\x00\x00\x01\x00\x00\x01\x01\x01\x00\x00\x02\x00\x00\x01\x02\x01\x00\x00\x03\x00\x00\x01\x05\x01\x00\x01\x06\x01\x00\x01\a\x01\x00\x00\a\x00\x00\x02\x00\x02\x00\x01\x00\x01\x00\x00\x00\x00\x00\x00\x01\x00\x00\x01\x01\x01\x00\x02\x01\x02\x00\x01\x02\x01\x00\x02\x02\x02\x00\x01\x03\x01\x00\x00\x04\x00\x00\x02\x05\x02\x00\x02\x06\x02\x00\x02\a\x02\x00\x01\a\x01\x00\x03\x00\x03\x00\x02\x00\x02\x00\x01\x00\x01\x00\x01\x01\x01\x00\x02\x01\x02\x00\x03\x01\x03\x00\x02\x02\x02\x00\x03\x02\x03\x00\x02\x03\x02\x00\x01\x04\x01\x00\x03\x05\x03\x00\x03\x06\x03\x00\x03\a\x03\x00\x02\a\x02\x00\x04\x00\x04\x00\x03\x00\x03\x00\x02\x00\x02\x00\x02\x01\x02\x00\x03\x01\x03\x00\x04\x01\x04\x00\x03\x02\x03\x00\x04\x02\x04\x00\x03\x03\x03\x00\x02\x04\x02\x00\x04\x05\x04\x00\x04\x06\x04\x00\x04\a\x04\x00\x03\a\x03\x00\x05\x00\x05\x00\x04\x00\x04\x00\x03\x00\x03\x00\x03\x01\x03\x00\x04\x01\x04\x00\x05\x01\x05\x00\x04\x02\x04\x00\x05\x02\x05\x00\x04\x03\x04\x00\x03\x04\x03\x00\x05\x05\x05\x00\x05\x06\x05\x00\x05\a\x05\x00\x04\a\x04\x00\x06\x00\x06\x00\x05\x00\x05\x00\x04\x00\x04\x00\x04\x01\x04\x00\x05\x01\x05\x00\x06\x01\x06\x00\x05\x02\x05\x00\x06\x02\x06\x00\x05\x03\x05\x00\x04\x04\x04\x00\x06\x05\x06\x00\x06\x06\x06\x00\x06\a\x06\x00\x05\a\x05\x00\a\x00\a\x00\x06\x00\x06\x00\x05\x00\x05\x00\x05\x01\x05\x00\x06\x01\x06\x00\a\x01\a\x00\x06\x02\x06\x00\a\x02\a\x00\x06\x03\x06\x00\x05\x04\x05\x00\a\x05\a\x00\a\x06\a\x00\a\a\a\x00\x06\a\x06\x00\b\x00\b\x00\a\x00\a\x00\x06\x00\x06\x00\x06\x01\x06\x00\a\x01\a\x00\b\x01\b\x00\a\x02\a\x00\b\x02\b\x00\a\x03\a\x00\x06\x04\x06\x00\b\x05\b\x00\b\x06\b\x00\b\a\b\x00\a\a\a\x00\b\x00\b\x00\a\x00\a\x00\a\x01\a\x00\b\x01\b\x00\b\x02\b\x00\t\x02\t\x00\b\x03\b\x00\a\x04\a\x00\t\x05\t\x00\t\a\t\x00\b\a\b\x00\b\x00\b\x00\b\x01\b\x00\t\x02\t\x00\t\x03\t\x00\b\x04\b\x00\t\a\t\x01\x00\x00\t\x00\x00\x00\x00\x01\x00\x01\t\x01\x00\x02\n\x00\x00\x04\x00\x00\x00\x05\x00\x01\x00\x05\n\x00\x00\x06\x00\x01\x00\x06\t\x01\x00\a\n\x00\x00\a\x00\x01\x01\x00\n\x01\x00\x00\t\x00\x01\x00\x01\x01\x00\x01\t\x01\x01\x01\n\x01\x00\x02\n\x00\x00\x02\x00\x01\x01\x02\v\x00\x00\x03\x00\x01\x00\x03\n\x00\x00\x04\x00\x00\x01\x04\x01\x00\x01\x05\x01\x01\x01\x05\v\x00\x01\x06\x01\x00\x00\x06\x00\x01\x01\x06\n\x01\x01\a\v\x00\x01\a\x01\x01\x00\a\n\x01\x02\x00\v\x01\x01\x00\n\x01\x00\x00\t\x00\x02\x00\x02\x01\x00\x01\t\x01\x01\x01\n\x01\x02\x01\v\x00\x00\x01\x00\x01\x01\x02\v\x00\x01\x02\x01\x01\x02\x02\f\x00\x01\x03\x01\x01\x01\x03\v\x00\x00\x04\x00\x00\x01\x04\x01\x00\x02\x04\x02\x01\x00\x04\t\x00\x02\x05\x02\x01\x02\x05\f\x00\x02\x06\x02\x00\x01\x06\x01\x00\x00\x06\x00\x01\x02\x06\v\x01\x02\a\f\x00\x02\a\x02\x01\x01\a\v\x01\x03\x00\f\x01\x02\x00\v\x01\x01\x00\n\x00\x03\x00\x03\x01\x01\x01\n\x01\x02\x01\v\x01\x03\x01\f\x00\x01\x01\x01\x01\x02\x02\f\x00\x02\x02\x02\x01\x03\x02\r\x00\x02\x03\x02\x01\x02\x03\f\x00\x01\x04\x01\x00\x02\x04\x02\x00\x03\x04\x03\x01\x01\x04\n\x00\x03\x05\x03\x01\x03\x05\r\x00\x03\x06\x03\x00\x02\x06\x02\x00\x01\x06\x01\x01\x03\x06\f\x01\x03\a\r\x00\x03\a\x03\x01\x02\a\f\x01\x04\x00\r\x01\x03\x00\f\x01\x02\x00\v\x00\x04\x00\x04\x01\x02\x01\v\x01\x03\x01\f\x01\x04\x01\r\x00\x02\x01\x02\x01\x03\x02\r\x00\x03\x02\x03\x01\x04\x02\x0e\x00\x03\x03\x03\x01\x03\x03\r\x00\x02\x04\x02\x00\x03\x04\x03\x00\x04\x04\x04\x01\x02\x04\v\x00\x04\x05\x04\x01\x04\x05\x0e\x00\x04\x06\x04\x00\x03\x06\x03\x00\x02\x06\x02\x01\x04\x06\r\x01\x04\a\x0e\x00\x04\a\x04\x01\x03\a\r\x01\x05\x00\x0e\x01\x04\x00\r\x01\x03\x00\f\x00\x05\x00\x05\x01\x03\x01\f\x01\x04\x01\r\x01\x05\x01\x0e\x00\x03\x01\x03\x01\x04\x02\x0e\x00\x04\x02\x04\x01\x05\x02\x0f\x00\x04\x03\x04\x01\x04\x03\x0e\x00\x03\x04\x03\x00\x04\x04\x04\x00\x05\x04\x05\x01\x03\x04\f\x00\x05\x05\x05\x01\x05\x05\x0f\x00\x05\x06\x05\x00\x04\x06\x04\x00\x03\x06\x03\x01\x05\x06\x0e\x01\x05\a\x0f\x00\x05\a\x05\x01\x04\a\x0e\x01\x06\x00\x0f\x01\x05\x00\x0e\x01\x04\x00\r\x00\x06\x00\x06\x01\x04\x01\r\x01\x05\x01\x0e\x01\x06\x01\x0f\x00\x04\x01\x04\x01\x05\x02\x0f\x00\x05\x02\x05\x01\x06\x02\x10\x00\x05\x03\x05\x01\x05\x03\x0f\x00\x04\x04\x04\x00\x05\x04\x05\x00\x06\x04\x06\x01\x04\x04\r\x00\x06\x05\x06\x01\x06\x05\x10\x00\x06\x06\x06\x00\x05\x06\x05\x00\x04\x06\x04\x01\x06\x06\x0f\x01\x06\a\x10\x00\x06\a\x06\x01\x05\a\x0f\x01\a\x00\x10\x01\x06\x00\x0f\x01\x05\x00\x0e\x00\a\x00\a\x01\x05\x01\x0e\x01\x06\x01\x0f\x01\a\x01\x10\x00\x05\x01\x05\x01\x06\x02\x10\x00\x06\x02\x06\x01\a\x02\x11\x00\x06\x03\x06\x01\x06\x03\x10\x00\x05\x04\x05\x00\x06\x04\x06\x00\a\x04\a\x01\x05\x04\x0e\x00\a\x05\a\x01\a\x05\x11\x00\a\x06\a\x00\x06\x06\x06\x00\x05\x06\x05\x01\a\x06\x10\x01\a\a\x11\x00\a\a\a\x01\x06\a\x10\x01\b\x00\x11\x01\a\x00\x10\x01\x06\x00\x0f\x00\b\x00\b\x01\x06\x01\x0f\x01\a\x01\x10\x01\b\x01\x11\x00\x06\x01\x06\x01\a\x02\x11\x00\a\x02\a\x01\b\x02\x12\x00\a\x03\a\x01\a\x03\x11\x00\x06\x04\x06\x00\a\x04\a\x00\b\x04\b\x01\x06\x04\x0f\x00\b\x05\b\x01\b\x05\x12\x00\b\x06\b\x00\a\x06\a\x00\x06\x06\x06\x01\b\x06\x11\x01\b\a\x12\x00\b\a\b\x01\a\a\x11\x01\b\x00\x11\x01\a\x00\x10\x01\a\x01\x10\x01\b\x01\x11\x00\a\x01\a\x01\b\x02\x12\x00\b\x02\b\x01\t\x02\x13\x00\b\x03\b\x01\b\x03\x12\x00\a\x04\a\x00\b\x04\b\x01\a\x04\x10\x00\t\x05\t\x01\t\x05\x13\x00\b\x06\b\x00\a\x06\a\x01\t\a\x13\x00\t\a\t\x01\b\a\x12\x01\b\x00\x11\x01\b\x01\x11\x00\b\x01\b\x01\t\x02\x13\x00\t\x02\t\x00\t\x03\t\x01\t\x03\x13\x00\b\x04\b\x01\b\x04\x11\x00\b\x06\b\x01\t\a\x13\x02\x00\x00\x12\x01\x00\x00\t\x02\x00\x01\x12\x02\x00\x02\x14\x00\x00\x03\x00\x01\x00\x04\t\x00\x00\x05\x00\x01\x00\x05\n\x02\x00\x05\x14\x01\x00\x06\t\x02\x00\x06\x12\x02\x00\a\x14\x01\x00\a\n\x00\x00\a\x00\x02\x01\x00\x13\x02\x00\x00\x12\x01\x01\x00\n\x02\x00\x01\x12\x02\x01\x01\x13\x02\x00\x02\x14\x01\x00\x02\n\x00\x00\x02\x00\x02\x01\x02\x15\x00\x00\x03\x00\x01\x00\x03\n\x02\x00\x03\x14\x00\x01\x03\x01\x01\x00\x04\t\x01\x01\x04\n\x00\x01\x05\x01\x01\x01\x05\v\x02\x01\x05\x15\x00\x00\x05\x00\x01\x01\x06\n\x01\x00\x06\t\x02\x01\x06\x13\x02\x01\a\x15\x01\x01\a\v\x00\x01\a\x01\x02\x00\a\x14\x02\x02\x00\x14\x02\x01\x00\x13\x02\x00\x00\x12\x01\x02\x00\v\x02\x00\x01\x12\x02\x01\x01\x13\x02\x02\x01\x14\x01\x00\x01\t\x02\x01\x02\x15\x01\x01\x02\v\x00\x01\x02\x01\x02\x02\x02\x16\x00\x01\x03\x01\x01\x01\x03\v\x02\x01\x03\x15\x00\x02\x03\x02\x01\x00\x04\t\x01\x01\x04\n\x01\x02\x04\v\x02\x00\x04\x12\x00\x02\x05\x02\x01\x02\x05\f\x02\x02\x05\x16\x00\x01\x05\x01\x01\x02\x06\v\x01\x01\x06\n\x01\x00\x06\t\x02\x02\x06\x14\x02\x02\a\x16\x01\x02\a\f\x00\x02\a\x02\x02\x01\a\x15\x02\x03\x00\x15\x02\x02\x00\x14\x02\x01\x00\x13\x01\x03\x00\f\x02\x01\x01\x13\x02\x02\x01\x14\x02\x03\x01\x15\x01\x01\x01\n\x02\x02\x02\x16\x01\x02\x02\f\x00\x02\x02\x02\x02\x03\x02\x17\x00\x02\x03\x02\x01\x02\x03\f\x02\x02\x03\x16\x00\x03\x03\x03\x01\x01\x04\n\x01\x02\x04\v\x01\x03\x04\f\x02\x01\x04\x13\x00\x03\x05\x03\x01\x03\x05\r\x02\x03\x05\x17\x00\x02\x05\x02\x01\x03\x06\f\x01\x02\x06\v\x01\x01\x06\n\x02\x03\x06\x15\x02\x03\a\x17\x01\x03\a\r\x00\x03\a\x03\x02\x02\a\x16\x02\x04\x00\x16\x02\x03\x00\x15\x02\x02\x00\x14\x01\x04\x00\r\x02\x02\x01\x14\x02\x03\x01\x15\x02\x04\x01\x16\x01\x02\x01\v\x02\x03\x02\x17\x01\x03\x02\r\x00\x03\x02\x03\x02\x04\x02\x18\x00\x03\x03\x03\x01\x03\x03\r\x02\x03\x03\x17\x00\x04\x03\x04\x01\x02\x04\v\x01\x03\x04\f\x01\x04\x04\r\x02\x02\x04\x14\x00\x04\x05\x04\x01\x04\x05\x0e\x02\x04\x05\x18\x00\x03\x05\x03\x01\x04\x06\r\x01\x03\x06\f\x01\x02\x06\v\x02\x04\x06\x16\x02\x04\a\x18\x01\x04\a\x0e\x00\x04\a\x04\x02\x03\a\x17\x02\x05\x00\x17\x02\x04\x00\x16\x02\x03\x00\x15\x01\x05\x00\x0e\x02\x03\x01\x15\x02\x04\x01\x16\x02\x05\x01\x17\x01\x03\x01\f\x02\x04\x02\x18\x01\x04\x02\x0e\x00\x04\x02\x04\x02\x05\x02\x19\x00\x04\x03\x04\x01\x04\x03\x0e\x02\x04\x03\x18\x00\x05\x03\x05\x01\x03\x04\f\x01\x04\x04\r\x01\x05\x04\x0e\x02\x03\x04\x15\x00\x05\x05\x05\x01\x05\x05\x0f\x02\x05\x05\x19\x00\x04\x05\x04\x01\x05\x06\x0e\x01\x04\x06\r\x01\x03\x06\f\x02\x05\x06\x17\x02\x05\a\x19\x01\x05\a\x0f\x00\x05\a\x05\x02\x04\a\x18\x02\x06\x00\x18\x02\x05\x00\x17\x02\x04\x00\x16\x01\x06\x00\x0f\x02\x04\x01\x16\x02\x05\x01\x17\x02\x06\x01\x18\x01\x04\x01\r\x02\x05\x02\x19\x01\x05\x02\x0f\x00\x05\x02\x05\x02\x06\x02\x1a\x00\x05\x03\x05\x01\x05\x03\x0f\x02\x05\x03\x19\x00\x06\x03\x06\x01\x04\x04\r\x01\x05\x04\x0e\x01\x06\x04\x0f\x02\x04\x04\x16\x00\x06\x05\x06\x01\x06\x05\x10\x02\x06\x05\x1a\x00\x05\x05\x05\x01\x06\x06\x0f\x01\x05\x06\x0e\x01\x04\x06\r\x02\x06\x06\x18\x02\x06\a\x1a\x01\x06\a\x10\x00\x06\a\x06\x02\x05\a\x19\x02\a\x00\x19\x02\x06\x00\x18\x02\x05\x00\x17\x01\a\x00\x10\x02\x05\x01\x17\x02\x06\x01\x18\x02\a\x01\x19\x01\x05\x01\x0e\x02\x06\x02\x1a\x01\x06\x02\x10\x00\x06\x02\x06\x02\a\x02\x1b\x00\x06\x03\x06\x01\x06\x03\x10\x02\x06\x03\x1a\x00\a\x03\a\x01\x05\x04\x0e\x01\x06\x04\x0f\x01\a\x04\x10\x02\x05\x04\x17\x00\a\x05\a\x01\a\x05\x11\x02\a\x05\x1b\x00\x06\x05\x06\x01\a\x06\x10\x01\x06\x06\x0f\x01\x05\x06\x0e\x02\a\x06\x19\x02\a\a\x1b\x01\a\a\x11\x00\a\a\a\x02\x06\a\x1a\x02\b\x00\x1a\x02\a\x00\x19\x02\x06\x00\x18\x01\b\x00\x11\x02\x06\x01\x18\x02\a\x01\x19\x02\b\x01\x1a\x01\x06\x01\x0f\x02\a\x02\x1b\x01\a\x02\x11\x00\a\x02\a\x02\b\x02\x1c\x00\a\x03\a\x01\a\x03\x11\x02\a\x03\x1b\x00\b\x03\b\x01\x06\x04\x0f\x01\a\x04\x10\x01\b\x04\x11\x02\x06\x04\x18\x00\b\x05\b\x01\b\x05\x12\x02\b\x05\x1c\x00\a\x05\a\x01\b\x06\x11\x01\a\x06\x10\x01\x06\x06\x0f\x02\b\x06\x1a\x02\b\a\x1c\x01\b\a\x12\x00\b\a\b\x02\a\a\x1b\x02\b\x00\x1a\x02\a\x00\x19\x02\a\x01\x19\x02\b\x01\x1a\x01\a\x01\x10\x02\b\x02\x1c\x01\b\x02\x12\x00\b\x02\b\x02\t\x02\x1d\x00\b\x03\b\x01\b\x03\x12\x02\b\x03\x1c\x00\t\x03\t\x01\a\x04\x10\x01\b\x04\x11\x02\a\x04\x19\x00\t\x05\t\x01\t\x05\x13\x02\t\x05\x1d\x00\b\x05\b\x01\b\x06\x11\x01\a\x06\x10\x02\t\a\x1d\x01\t\a\x13\x00\t\a\t\x02\b\a\x1c\x02\b\x00\x1a\x02\b\x01\x1a\x01\b\x01\x11\x02\t\x02\x1d\x01\t\x02\x13\x00\t\x02\t\x00\t\x03\t\x01\t\x03\x13\x02\t\x03\x1d\x01\b\x04\x11\x02\b\x04\x1a\x00\t\x05\t\x01\b\x06\x11\x02\t\a\x1d\x03\x00\x00\x1b\x02\x00\x00\x12\x03\x00\x01\x1b\x01\x00\x03\n\x02\x00\x04\x12\x01\x00\x05\n\x02\x00\x05\x14\x02\x00\x06\x12\x03\x00\x06\x1b\x02\x00\a\x14\x01\x00\a\n\x03\x01\x00\x1c\x03\x00\x00\x1b\x02\x01\x00\x13\x03\x00\x01\x1b\x03\x01\x01\x1c\x02\x00\x02\x14\x01\x00\x02\n\x01\x00\x03\n\x02\x00\x03\x14\x01\x01\x03\v\x02\x00\x04\x12\x02\x01\x04\x13\x01\x01\x05\v\x02\x01\x05\x15\x01\x00\x05\n\x02\x01\x06\x13\x02\x00\x06\x12\x03\x01\x06\x1c\x02\x01\a\x15\x01\x01\a\v\x03\x02\x00\x1d\x03\x01\x00\x1c\x03\x00\x00\x1b\x02\x02\x00\x14\x03\x00\x01\x1b\x03\x01\x01\x1c\x03\x02\x01\x1d\x02\x00\x01\x12\x02\x01\x02\x15\x01\x01\x02\v\x01\x01\x03\v\x02\x01\x03\x15\x01\x02\x03\f\x02\x00\x04\x12\x02\x01\x04\x13\x02\x02\x04\x14\x03\x00\x04\x1b\x01\x02\x05\f\x02\x02\x05\x16\x01\x01\x05\v\x02\x02\x06\x14\x02\x01\x06\x13\x02\x00\x06\x12\x03\x02\x06\x1d\x02\x02\a\x16\x01\x02\a\f\x03\x03\x00\x1e\x03\x02\x00\x1d\x03\x01\x00\x1c\x02\x03\x00\x15\x03\x01\x01\x1c\x03\x02\x01\x1d\x03\x03\x01\x1e\x02\x01\x01\x13\x02\x02\x02\x16\x01\x02\x02\f\x01\x02\x03\f\x02\x02\x03\x16\x01\x03\x03\r\x02\x01\x04\x13\x02\x02\x04\x14\x02\x03\x04\x15\x03\x01\x04\x1c\x01\x03\x05\r\x02\x03\x05\x17\x01\x02\x05\f\x02\x03\x06\x15\x02\x02\x06\x14\x02\x01\x06\x13\x03\x03\x06\x1e\x02\x03\a\x17\x01\x03\a\r\x03\x04\x00\x1f\x03\x03\x00\x1e\x03\x02\x00\x1d\x02\x04\x00\x16\x03\x02\x01\x1d\x03\x03\x01\x1e\x03\x04\x01\x1f\x02\x02\x01\x14\x02\x03\x02\x17\x01\x03\x02\r\x01\x03\x03\r\x02\x03\x03\x17\x01\x04\x03\x0e\x02\x02\x04\x14\x02\x03\x04\x15\x02\x04\x04\x16\x03\x02\x04\x1d\x01\x04\x05\x0e\x02\x04\x05\x18\x01\x03\x05\r\x02\x04\x06\x16\x02\x03\x06\x15\x02\x02\x06\x14\x03\x04\x06\x1f\x02\x04\a\x18\x01\x04\a\x0e\x03\x05\x00 \x03\x04\x00\x1f\x03\x03\x00\x1e\x02\x05\x00\x17\x03\x03\x01\x1e\x03\x04\x01\x1f\x03\x05\x01 \x02\x03\x01\x15\x02\x04\x02\x18\x01\x04\x02\x0e\x01\x04\x03\x0e\x02\x04\x03\x18\x01\x05\x03\x0f\x02\x03\x04\x15\x02\x04\x04\x16\x02\x05\x04\x17\x03\x03\x04\x1e\x01\x05\x05\x0f\x02\x05\x05\x19\x01\x04\x05\x0e\x02\x05\x06\x17\x02\x04\x06\x16\x02\x03\x06\x15\x03\x05\x06 \x02\x05\a\x19\x01\x05\a\x0f\x03\x06\x00!\x03\x05\x00 \x03\x04\x00\x1f\x02\x06\x00\x18\x03\x04\x01\x1f\x03\x05\x01 \x03\x06\x01!\x02\x04\x01\x16\x02\x05\x02\x19\x01\x05\x02\x0f\x01\x05\x03\x0f\x02\x05\x03\x19\x01\x06\x03\x10\x02\x04\x04\x16\x02\x05\x04\x17\x02\x06\x04\x18\x03\x04\x04\x1f\x01\x06\x05\x10\x02\x06\x05\x1a\x01\x05\x05\x0f\x02\x06\x06\x18\x02\x05\x06\x17\x02\x04\x06\x16\x03\x06\x06!\x02\x06\a\x1a\x01\x06\a\x10\x03\a\x00\"\x03\x06\x00!\x03\x05\x00 \x02\a\x00\x19\x03\x05\x01 \x03\x06\x01!\x03\a\x01\"\x02\x05\x01\x17\x02\x06\x02\x1a\x01\x06\x02\x10\x01\x06\x03\x10\x02\x06\x03\x1a\x01\a\x03\x11\x02\x05\x04\x17\x02\x06\x04\x18\x02\a\x04\x19\x03\x05\x04 \x01\a\x05\x11\x02\a\x05\x1b\x01\x06\x05\x10\x02\a\x06\x19\x02\x06\x06\x18\x02\x05\x06\x17\x03\a\x06\"\x02\a\a\x1b\x01\a\a\x11\x03\b\x00#\x03\a\x00\"\x03\x06\x00!\x02\b\x00\x1a\x03\x06\x01!\x03\a\x01\"\x03\b\x01#\x02\x06\x01\x18\x02\a\x02\x1b\x01\a\x02\x11\x01\a\x03\x11\x02\a\x03\x1b\x01\b\x03\x12\x02\x06\x04\x18\x02\a\x04\x19\x02\b\x04\x1a\x03\x06\x04!\x01\b\x05\x12\x02\b\x05\x1c\x01\a\x05\x11\x02\b\x06\x1a\x02\a\x06\x19\x02\x06\x06\x18\x03\b\x06#\x02\b\a\x1c\x01\b\a\x12\x03\b\x00#\x03\a\x00\"\x03\a\x01\"\x03\b\x01#\x02\a\x01\x19\x02\b\x02\x1c\x01\b\x02\x12\x01\b\x03\x12\x02\b\x03\x1c\x01\t\x03\x13\x02\a\x04\x19\x02\b\x04\x1a\x03\a\x04\"\x01\t\x05\x13\x02\t\x05\x1d\x01\b\x05\x12\x02\b\x06\x1a\x02\a\x06\x19\x02\t\a\x1d\x01\t\a\x13\x03\b\x00#\x03\b\x01#\x02\b\x01\x1a\x02\t\x02\x1d\x01\t\x02\x13\x01\t\x03\x13\x02\t\x03\x1d\x02\b\x04\x1a\x03\b\x04#\x01\t\x05\x13\x02\b\x06\x1a\x03\x00\x00\x1b\x02\x00\x03\x14\x03\x00\x04\x1b\x02\x00\x05\x14\x03\x00\x06\x1b\x02\x00\a\x14\x03\x01\x00\x1c\x02\x00\x02\x14\x02\x00\x03\x14\x02\x01\x03\x15\x03\x00\x04\x1b\x03\x01\x04\x1c\x02\x01\x05\x15\x02\x00\x05\x14\x03\x01\x06\x1c\x03\x00\x06\x1b\x02\x01\a\x15\x03\x02\x00\x1d\x03\x00\x01\x1b\x02\x01\x02\x15\x02\x01\x03\x15\x02\x02\x03\x16\x03\x00\x04\x1b\x03\x01\x04\x1c\x03\x02\x04\x1d\x02\x02\x05\x16\x02\x01\x05\x15\x03\x02\x06\x1d\x03\x01\x06\x1c\x03\x00\x06\x1b\x02\x02\a\x16\x03\x03\x00\x1e\x03\x01\x01\x1c\x02\x02\x02\x16\x02\x02\x03\x16\x02\x03\x03\x17\x03\x01\x04\x1c\x03\x02\x04\x1d\x03\x03\x04\x1e\x02\x03\x05\x17\x02\x02\x05\x16\x03\x03\x06\x1e\x03\x02\x06\x1d\x03\x01\x06\x1c\x02\x03\a\x17\x03\x04\x00\x1f\x03\x02\x01\x1d\x02\x03\x02\x17\x02\x03\x03\x17\x02\x04\x03\x18\x03\x02\x04\x1d\x03\x03\x04\x1e\x03\x04\x04\x1f\x02\x04\x05\x18\x02\x03\x05\x17\x03\x04\x06\x1f\x03\x03\x06\x1e\x03\x02\x06\x1d\x02\x04\a\x18\x03\x05\x00 \x03\x03\x01\x1e\x02\x04\x02\x18\x02\x04\x03\x18\x02\x05\x03\x19\x03\x03\x04\x1e\x03\x04\x04\x1f\x03\x05\x04 \x02\x05\x05\x19\x02\x04\x05\x18\x03\x05\x06 \x03\x04\x06\x1f\x03\x03\x06\x1e\x02\x05\a\x19\x03\x06\x00!\x03\x04\x01\x1f\x02\x05\x02\x19\x02\x05\x03\x19\x02\x06\x03\x1a\x03\x04\x04\x1f\x03\x05\x04 \x03\x06\x04!\x02\x06\x05\x1a\x02\x05\x05\x19\x03\x06\x06!\x03\x05\x06 \x03\x04\x06\x1f\x02\x06\a\x1a\x03\a\x00\"\x03\x05\x01 \x02\x06\x02\x1a\x02\x06\x03\x1a\x02\a\x03\x1b\x03\x05\x04 \x03\x06\x04!\x03\a\x04\"\x02\a\x05\x1b\x02\x06\x05\x1a\x03\a\x06\"\x03\x06\x06!\x03\x05\x06 \x02\a\a\x1b\x03\b\x00#\x03\x06\x01!\x02\a\x02\x1b\x02\a\x03\x1b\x02\b\x03\x1c\x03\x06\x04!\x03\a\x04\"\x03\b\x04#\x02\b\x05\x1c\x02\a\x05\x1b\x03\b\x06#\x03\a\x06\"\x03\x06\x06!\x02\b\a\x1c\x03\a\x01\"\x02\b\x02\x1c\x02\b\x03\x1c\x02\t\x03\x1d\x03\a\x04\"\x03\b\x04#\x02\t\x05\x1d\x02\b\x05\x1c\x03\b\x06#\x03\a\x06\"\x02\t\a\x1d\x03\b\x01#\x02\t\x02\x1d\x02\t\x03\x1d\x03\b\x04#\x02\t\x05\x1d\x03\b\x06#",
+		start: []int32{0, 6, 17, 31, 45, 59, 73, 87, 101, 115, 126, 132, 143, 163, 189, 215, 241, 267, 293, 319, 345, 365, 376, 390, 416, 448, 480, 512, 544, 576, 608, 640, 666, 680, 691, 711, 737, 763, 789, 815, 841, 867, 893, 913, 924, 930, 941, 955, 969, 983, 997, 1011, 1025, 1039, 1050, 1056},
+	},
+	{rows: 5, cols: 11, cells: "0,0;0,1;0,2;1,0;1,1;", sym: false, n: 264,
+		versions: []generatedVersion{
+			{lo: Pos{0, 0}, n: Pos{4, 9}, masks: []uint64{0x1807, 0x300e, 0x601c, 0xc038, 0x18070, 0x300e0, 0x601c0, 0xc0380, 0x180700, 0xc03800, 0x1807000, 0x300e000, 0x601c000, 0xc038000, 0x18070000, 0x300e0000, 0x601c0000, 0xc0380000, 0x601c00000, 0xc03800000, 0x1807000000, 0x300e000000, 0x601c000000, 0xc038000000, 0x18070000000, 0x300e0000000, 0x601c0000000, 0x300e00000000, 0x601c00000000, 0xc03800000000, 0x1807000000000, 0x300e000000000, 0x601c000000000, 0xc038000000000, 0x18070000000000, 0x300e0000000000}},
+			{lo: Pos{0, 0}, n: Pos{4, 9}, masks: []uint64{0x3007, 0x600e, 0xc01c, 0x18038, 0x30070, 0x600e0, 0xc01c0, 0x180380, 0x300700, 0x1803800, 0x3007000, 0x600e000, 0xc01c000, 0x18038000, 0x30070000, 0x600e0000, 0xc01c0000, 0x180380000, 0xc01c00000, 0x1803800000, 0x3007000000, 0x600e000000, 0xc01c000000, 0x18038000000, 0x30070000000, 0x600e0000000, 0xc01c0000000, 0x600e00000000, 0xc01c00000000, 0x1803800000000, 0x3007000000000, 0x600e000000000, 0xc01c000000000, 0x18038000000000, 0x30070000000000, 0x600e0000000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 10}, masks: []uint64{0x801803, 0x1003006, 0x200600c, 0x400c018, 0x8018030, 0x10030060, 0x200600c0, 0x400c0180, 0x80180300, 0x100300600, 0x400c01800, 0x801803000, 0x1003006000, 0x200600c000, 0x400c018000, 0x8018030000, 0x10030060000, 0x200600c0000, 0x400c0180000, 0x80180300000, 0x200600c00000, 0x400c01800000, 0x801803000000, 0x1003006000000, 0x200600c000000, 0x400c018000000, 0x8018030000000, 0x10030060000000, 0x200600c0000000, 0x400c0180000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 10}, masks: []uint64{0xc01802, 0x1803004, 0x3006008, 0x600c010, 0xc018020, 0x18030040, 0x30060080, 0x600c0100, 0xc0180200, 0x180300400, 0x600c01000, 0xc01802000, 0x1803004000, 0x3006008000, 0x600c010000, 0xc018020000, 0x18030040000, 0x30060080000, 0x600c0100000, 0xc0180200000, 0x300600800000, 0x600c01000000, 0xc01802000000, 0x1803004000000, 0x3006008000000, 0x600c010000000, 0xc018020000000, 0x18030040000000, 0x30060080000000, 0x600c0100000000}},
+			{lo: Pos{0, 0}, n: Pos{4, 9}, masks: []uint64{0x3806, 0x700c, 0xe018, 0x1c030, 0x38060, 0x700c0, 0xe0180, 0x1c0300, 0x380600, 0x1c03000, 0x3806000, 0x700c000, 0xe018000, 0x1c030000, 0x38060000, 0x700c0000, 0xe0180000, 0x1c0300000, 0xe01800000, 0x1c03000000, 0x3806000000, 0x700c000000, 0xe018000000, 0x1c030000000, 0x38060000000, 0x700c0000000, 0xe0180000000, 0x700c00000000, 0xe01800000000, 0x1c03000000000, 0x3806000000000, 0x700c000000000, 0xe018000000000, 0x1c030000000000, 0x38060000000000, 0x700c0000000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 10}, masks: []uint64{0xc01801, 0x1803002, 0x3006004, 0x600c008, 0xc018010, 0x18030020, 0x30060040, 0x600c0080, 0xc0180100, 0x180300200, 0x600c00800, 0xc01801000, 0x1803002000, 0x3006004000, 0x600c008000, 0xc018010000, 0x18030020000, 0x30060040000, 0x600c0080000, 0xc0180100000, 0x300600400000, 0x600c00800000, 0xc01801000000, 0x1803002000000, 0x3006004000000, 0x600c008000000, 0xc018010000000, 0x18030020000000, 0x30060040000000, 0x600c0080000000}},
+			{lo: Pos{0, 0}, n: Pos{4, 9}, masks: []uint64{0x3803, 0x7006, 0xe00c, 0x1c018, 0x38030, 0x70060, 0xe00c0, 0x1c0180, 0x380300, 0x1c01800, 0x3803000, 0x7006000, 0xe00c000, 0x1c018000, 0x38030000, 0x70060000, 0xe00c0000, 0x1c0180000, 0xe00c00000, 0x1c01800000, 0x3803000000, 0x7006000000, 0xe00c000000, 0x1c018000000, 0x38030000000, 0x70060000000, 0xe00c0000000, 0x700600000000, 0xe00c00000000, 0x1c01800000000, 0x3803000000000, 0x7006000000000, 0xe00c000000000, 0x1c018000000000, 0x38030000000000, 0x70060000000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 10}, masks: []uint64{0x401803, 0x803006, 0x100600c, 0x200c018, 0x4018030, 0x8030060, 0x100600c0, 0x200c0180, 0x40180300, 0x80300600, 0x200c01800, 0x401803000, 0x803006000, 0x100600c000, 0x200c018000, 0x4018030000, 0x8030060000, 0x100600c0000, 0x200c0180000, 0x40180300000, 0x100600c00000, 0x200c01800000, 0x401803000000, 0x803006000000, 0x100600c000000, 0x200c018000000, 0x4018030000000, 0x8030060000000, 0x100600c0000000, 0x200c0180000000}},
+		},
+		spots: "\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x02\x00\x00\x00\x03\x00\x00\x00\x04\x00\x00\x00\x05\x00\x00\x00\x06\x00\x00\x00\a\x00\x00\x01\x00\x01\x00\x01\x01\x01\x00\x01\x02\x01\x00\x01\x03\x01\x00\x01\x04\x01\x00\x01\x05\x01\x00\x01\x06\x01\x00\x01\a\x01\x00\x02\x00\x02\x00\x02\x01\x02\x00\x02\x02\x02\x00\x02\x03\x02\x00\x02\x04\x02\x00\x02\x05\x02\x00\x02\x06\x02\x00\x02\a\x02\x00\x03\x00\x03\x00\x03\x01\x03\x00\x03\x02\x03\x00\x03\x03\x03\x00\x03\x04\x03\x00\x03\x05\x03\x00\x03\x06\x03\x00\x03\a\x03\x00\x04\x00\x04\x00\x04\x01\x04\x00\x04\x02\x04\x00\x04\x03\x04\x00\x04\x04\x04\x00\x04\x05\x04\x00\x04\x06\x04\x00\x04\a\x04\x00\x05\x00\x05\x00\x05\x01\x05\x00\x05\x02\x05\x00\x05\x03\x05\x00\x05\x04\x05\x00\x05\x05\x05\x00\x05\x06\x05\x00\x05\a\x05\x00\x06\x00\x06\x00\x06\x01\x06\x00\x06\x02\x06\x00\x06\x03\x06\x00\x06\x04\x06\x00\x06\x05\x06\x00\x06\x06\x06\x00\x06\a\x06\x00\a\x00\a\x00\a\x01\a\x00\a\x02\a\x00\a\x03\a\x00\a\x04\a\x00\a\x05\a\x00\a\x06\a\x00\a\a\a\x00\b\x00\b\x00\b\x01\b\x00\b\x02\b\x00\b\x03\b\x00\b\x04\b\x00\b\x05\b\x00\b\x06\b\x00\b\a\b\x00\t\x02\t\x00\t\x03\t\x00\t\x05\t\x00\t\a\t\x01\x00\x00\t\x01\x00\x01\t\x01\x00\x02\n\x01\x00\x03\n\x01\x00\x04\t\x01\x00\x05\n\x01\x00\x06\t\x01\x00\a\n\x01\x01\x00\n\x01\x01\x01\n\x01\x01\x02\v\x01\x01\x03\v\x01\x01\x04\n\x01\x01\x05\v\x01\x01\x06\n\x01\x01\a\v\x01\x02\x00\v\x01\x02\x01\v\x01\x02\x02\f\x01\x02\x03\f\x01\x02\x04\v\x01\x02\x05\f\x01\x02\x06\v\x01\x02\a\f\x01\x03\x00\f\x01\x03\x01\f\x01\x03\x02\r\x01\x03\x03\r\x01\x03\x04\f\x01\x03\x05\r\x01\x03\x06\f\x01\x03\a\r\x01\x04\x00\r\x01\x04\x01\r\x01\x04\x02\x0e\x01\x04\x03\x0e\x01\x04\x04\r\x01\x04\x05\x0e\x01\x04\x06\r\x01\x04\a\x0e\x01\x05\x00\x0e\x01\x05\x01\x0e\x01\x05\x02\x0f\x01\x05\x03\x0f\x01\x05\x04\x0e\x01\x05\x05\x0f\x01\x05\x06\x0e\x01\x05\a\x0f\x01\x06\x00\x0f\x01\x06\x01\x0f\x01\x06\x02\x10\x01\x06\x03\x10\x01\x06\x04\x0f\x01\x06\x05\x10\x01\x06\x06\x0f\x01\x06\a\x10\x01\a\x00\x10\x01\a\x01\x10\x01\a\x02\x11\x01\a\x03\x11\x01\a\x04\x10\x01\a\x05\x11\x01\a\x06\x10\x01\a\a\x11\x01\b\x00\x11\x01\b\x01\x11\x01\b\x02\x12\x01\b\x03\x12\x01\b\x04\x11\x01\b\x05\x12\x01\b\x06\x11\x01\b\a\x12\x01\t\x02\x13\x01\t\x03\x13\x01\t\x05\x13\x01\t\a\x13\x02\x00\x00\x12\x02\x00\x01\x12\x02\x00\x02\x14\x02\x00\x03\x14\x02\x00\x04\x12\x02\x00\x05\x14\x02\x00\x06\x12\x02\x00\a\x14\x02\x01\x00\x13\x02\x01\x01\x13\x02\x01\x02\x15\x02\x01\x03\x15\x02\x01\x04\x13\x02\x01\x05\x15\x02\x01\x06\x13\x02\x01\a\x15\x02\x02\x00\x14\x02\x02\x01\x14\x02\x02\x02\x16\x02\x02\x03\x16\x02\x02\x04\x14\x02\x02\x05\x16\x02\x02\x06\x14\x02\x02\a\x16\x02\x03\x00\x15\x02\x03\x01\x15\x02\x03\x02\x17\x02\x03\x03\x17\x02\x03\x04\x15\x02\x03\x05\x17\x02\x03\x06\x15\x02\x03\a\x17\x02\x04\x00\x16\x02\x04\x01\x16\x02\x04\x02\x18\x02\x04\x03\x18\x02\x04\x04\x16\x02\x04\x05\x18\x02\x04\x06\x16\x02\x04\a\x18\x02\x05\x00\x17\x02\x05\x01\x17\x02\x05\x02\x19\x02\x05\x03\x19\x02\x05\x04\x17\x02\x05\x05\x19\x02\x05\x06\x17\x02\x05\a\x19\x02\x06\x00\x18\x02\x06\x01\x18\x02\x06\x02\x1a\x02\x06\x03\x1a\x02\x06\x04\x18\x02\x06\x05\x1a\x02\x06\x06\x18\x02\x06\a\x1a\x02\a\x00\x19\x02\a\x01\x19\x02\a\x02\x1b\x02\a\x03\x1b\x02\a\x04\x19\x02\a\x05\x1b\x02\a\x06\x19\x02\a\a\x1b\x02\b\x00\x1a\x02\b\x01\x1a\x02\b\x02\x1c\x02\b\x03\x1c\x02\b\x04\x1a\x02\b\x05\x1c\x02\b\x06\x1a\x02\b\a\x1c\x02\t\x02\x1d\x02\t\x03\x1d\x02\t\x05\x1d\x02\t\a\x1d\x03\x00\x00\x1b\x03\x00\x01\x1b\x03\x00\x04\x1b\x03\x00\x06\x1b\x03\x01\x00\x1c\x03\x01\x01\x1c\x03\x01\x04\x1c\x03\x01\x06\x1c\x03\x02\x00\x1d\x03\x02\x01\x1d\x03\x02\x04\x1d\x03\x02\x06\x1d\x03\x03\x00\x1e\x03\x03\x01\x1e\x03\x03\x04\x1e\x03\x03\x06\x1e\x03\x04\x00\x1f\x03\x04\x01\x1f\x03\x04\x04\x1f\x03\x04\x06\x1f\x03\x05\x00 \x03\x05\x01 \x03\x05\x04 \x03\x05\x06 \x03\x06\x00!\x03\x06\x01!\x03\x06\x04!\x03\x06\x06!\x03\a\x00\"\x03\a\x01\"\x03\a\x04\"\x03\a\x06\"\x03\b\x00#\x03\b\x01#\x03\b\x04#\x03\b\x06#",
+		cover: "\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x02\x00\x00\x00\x05\x00\x00\x00\x06\x00\x00\x00\a\x00\x00\x01\x00\x01\x00\x00\x00\x00\x00\x00\x01\x00\x00\x01\x01\x01\x00\x00\x02\x00\x00\x01\x02\x01\x00\x00\x03\x00\x00\x00\x04\x00\x00\x01\x05\x01\x00\x01\x06\x01\x00\x00\x06\x00\x00\x01\a\x01\x00\x00\a\x00\x00\x02\x00\x02\x00\x01\x00\x01\x00\x00\x00\x00\x00\x00\x01\x00\x00\x01\x01\x01\x00\x02\x01\x02\x00\x01\x02\x01\x00\x02\x02\x02\x00\x01\x03\x01\x00\x00\x04\x00\x00\x01\x04\x01\x00\x02\x05\x02\x00\x02\x06\x02\x00\x01\x06\x01\x00\x02\a\x02\x00\x01\a\x01\x00\x03\x00\x03\x00\x02\x00\x02\x00\x01\x00\x01\x00\x01\x01\x01\x00\x02\x01\x02\x00\x03\x01\x03\x00\x02\x02\x02\x00\x03\x02\x03\x00\x02\x03\x02\x00\x01\x04\x01\x00\x02\x04\x02\x00\x03\x05\x03\x00\x03\x06\x03\x00\x02\x06\x02\x00\x03\a\x03\x00\x02\a\x02\x00\x04\x00\x04\x00\x03\x00\x03\x00\x02\x00\x02\x00\x02\x01\x02\x00\x03\x01\x03\x00\x04\x01\x04\x00\x03\x02\x03\x00\x04\x02\x04\x00\x03\x03\x03\x00\x02\x04\x02\x00\x03\x04\x03\x00\x04\x05\x04\x00\x04\x06\x04\x00\x03\x06\x03\x00\x04\a\x04\x00\x03\a\x03\x00\x05\x00\x05\x00\x04\x00\x04\x00\x03\x00\x03\x00\x03\x01\x03\x00\x04\x01\x04\x00\x05\x01\x05\x00\x04\x02\x04\x00\x05\x02\x05\x00\x04\x03\x04\x00\x03\x04\x03\x00\x04\x04\x04\x00\x05\x05\x05\x00\x05\x06\x05\x00\x04\x06\x04\x00\x05\a\x05\x00\x04\a\x04\x00\x06\x00\x06\x00\x05\x00\x05\x00\x04\x00\x04\x00\x04\x01\x04\x00\x05\x01\x05\x00\x06\x01\x06\x00\x05\x02\x05\x00\x06\x02\x06\x00\x05\x03\x05\x00\x04\x04\x04\x00\x05\x04\x05\x00\x06\x05\x06\x00\x06\x06\x06\x00\x05\x06\x05\x00\x06\a\x06\x00\x05\a\x05\x00\a\x00\a\x00\x06\x00\x06\x00\x05\x00\x05\x00\x05\x01\x05\x00\x06\x01\x06\x00\a\x01\a\x00\x06\x02\x06\x00\a\x02\a\x00\x06\x03\x06\x00\x05\x04\x05\x00\x06\x04\x06\x00\a\x05\a\x00\a\x06\a\x00\x06\x06\x06\x00\a\a\a\x00\x06\a\x06\x00\b\x00\b\x00\a\x00\a\x00\x06\x00\x06\x00\x06\x01\x06\x00\a\x01\a\x00\b\x01\b\x00\a\x02\a\x00\b\x02\b\x00\a\x03\a\x00\x06\x04\x06\x00\a\x04\a\x00\b\x05\b\x00\b\x06\b\x00\a\x06\a\x00\b\a\b\x00\a\a\a\x00\b\x00\b\x00\a\x00\a\x00\a\x01\a\x00\b\x01\b\x00\b\x02\b\x00\t\x02\t\x00\b\x03\b\x00\a\x04\a\x00\b\x04\b\x00\t\x05\t\x00\b\x06\b\x00\t\a\t\x00\b\a\b\x00\b\x00\b\x00\b\x01\b\x00\t\x02\t\x00\t\x03\t\x00\b\x04\b\x00\t\a\t\x01\x00\x00\t\x00\x00\x00\x00\x01\x00\x01\t\x01\x00\x02\n\x00\x00\x02\x00\x00\x00\x03\x00\x00\x00\x04\x00\x00\x00\x05\x00\x01\x00\x05\n\x00\x00\x06\x00\x01\x00\x06\t\x01\x00\a\n\x00\x00\a\x00\x01\x01\x00\n\x01\x00\x00\t\x00\x01\x00\x01\x00\x00\x00\x00\x01\x00\x01\t\x01\x01\x01\n\x00\x00\x01\x00\x01\x00\x02\n\x00\x00\x02\x00\x01\x01\x02\v\x00\x01\x02\x01\x00\x00\x03\x00\x01\x00\x03\n\x00\x01\x03\x01\x00\x00\x04\x00\x00\x01\x04\x01\x01\x00\x04\t\x00\x01\x05\x01\x01\x01\x05\v\x00\x00\x05\x00\x00\x01\x06\x01\x00\x00\x06\x00\x01\x01\x06\n\x01\x00\x06\t\x01\x01\a\v\x00\x01\a\x01\x01\x00\a\n\x00\x00\a\x00\x01\x02\x00\v\x01\x01\x00\n\x01\x00\x00\t\x00\x02\x00\x02\x00\x01\x00\x01\x01\x00\x01\t\x01\x01\x01\n\x01\x02\x01\v\x00\x00\x01\x00\x00\x01\x01\x01\x01\x01\x02\v\x00\x01\x02\x01\x01\x02\x02\f\x00\x02\x02\x02\x00\x01\x03\x01\x01\x01\x03\v\x00\x02\x03\x02\x00\x00\x04\x00\x00\x01\x04\x01\x00\x02\x04\x02\x01\x00\x04\t\x01\x01\x04\n\x00\x02\x05\x02\x01\x02\x05\f\x00\x01\x05\x01\x00\x02\x06\x02\x00\x01\x06\x01\x00\x00\x06\x00\x01\x02\x06\v\x01\x01\x06\n\x01\x02\a\f\x00\x02\a\x02\x01\x01\a\v\x00\x01\a\x01\x01\x03\x00\f\x01\x02\x00\v\x01\x01\x00\n\x00\x03\x00\x03\x00\x02\x00\x02\x01\x01\x01\n\x01\x02\x01\v\x01\x03\x01\f\x00\x01\x01\x01\x00\x02\x01\x02\x01\x02\x02\f\x00\x02\x02\x02\x01\x03\x02\r\x00\x03\x02\x03\x00\x02\x03\x02\x01\x02\x03\f\x00\x03\x03\x03\x00\x01\x04\x01\x00\x02\x04\x02\x00\x03\x04\x03\x01\x01\x04\n\x01\x02\x04\v\x00\x03\x05\x03\x01\x03\x05\r\x00\x02\x05\x02\x00\x03\x06\x03\x00\x02\x06\x02\x00\x01\x06\x01\x01\x03\x06\f\x01\x02\x06\v\x01\x03\a\r\x00\x03\a\x03\x01\x02\a\f\x00\x02\a\x02\x01\x04\x00\r\x01\x03\x00\f\x01\x02\x00\v\x00\x04\x00\x04\x00\x03\x00\x03\x01\x02\x01\v\x01\x03\x01\f\x01\x04\x01\r\x00\x02\x01\x02\x00\x03\x01\x03\x01\x03\x02\r\x00\x03\x02\x03\x01\x04\x02\x0e\x00\x04\x02\x04\x00\x03\x03\x03\x01\x03\x03\r\x00\x04\x03\x04\x00\x02\x04\x02\x00\x03\x04\x03\x00\x04\x04\x04\x01\x02\x04\v\x01\x03\x04\f\x00\x04\x05\x04\x01\x04\x05\x0e\x00\x03\x05\x03\x00\x04\x06\x04\x00\x03\x06\x03\x00\x02\x06\x02\x01\x04\x06\r\x01\x03\x06\f\x01\x04\a\x0e\x00\x04\a\x04\x01\x03\a\r\x00\x03\a\x03\x01\x05\x00\x0e\x01\x04\x00\r\x01\x03\x00\f\x00\x05\x00\x05\x00\x04\x00\x04\x01\x03\x01\f\x01\x04\x01\r\x01\x05\x01\x0e\x00\x03\x01\x03\x00\x04\x01\x04\x01\x04\x02\x0e\x00\x04\x02\x04\x01\x05\x02\x0f\x00\x05\x02\x05\x00\x04\x03\x04\x01\x04\x03\x0e\x00\x05\x03\x05\x00\x03\x04\x03\x00\x04\x04\x04\x00\x05\x04\x05\x01\x03\x04\f\x01\x04\x04\r\x00\x05\x05\x05\x01\x05\x05\x0f\x00\x04\x05\x04\x00\x05\x06\x05\x00\x04\x06\x04\x00\x03\x06\x03\x01\x05\x06\x0e\x01\x04\x06\r\x01\x05\a\x0f\x00\x05\a\x05\x01\x04\a\x0e\x00\x04\a\x04\x01\x06\x00\x0f\x01\x05\x00\x0e\x01\x04\x00\r\x00\x06\x00\x06\x00\x05\x00\x05\x01\x04\x01\r\x01\x05\x01\x0e\x01\x06\x01\x0f\x00\x04\x01\x04\x00\x05\x01\x05\x01\x05\x02\x0f\x00\x05\x02\x05\x01\x06\x02\x10\x00\x06\x02\x06\x00\x05\x03\x05\x01\x05\x03\x0f\x00\x06\x03\x06\x00\x04\x04\x04\x00\x05\x04\x05\x00\x06\x04\x06\x01\x04\x04\r\x01\x05\x04\x0e\x00\x06\x05\x06\x01\x06\x05\x10\x00\x05\x05\x05\x00\x06\x06\x06\x00\x05\x06\x05\x00\x04\x06\x04\x01\x06\x06\x0f\x01\x05\x06\x0e\x01\x06\a\x10\x00\x06\a\x06\x01\x05\a\x0f\x00\x05\a\x05\x01\a\x00\x10\x01\x06\x00\x0f\x01\x05\x00\x0e\x00\a\x00\a\x00\x06\x00\x06\x01\x05\x01\x0e\x01\x06\x01\x0f\x01\a\x01\x10\x00\x05\x01\x05\x00\x06\x01\x06\x01\x06\x02\x10\x00\x06\x02\x06\x01\a\x02\x11\x00\a\x02\a\x00\x06\x03\x06\x01\x06\x03\x10\x00\a\x03\a\x00\x05\x04\x05\x00\x06\x04\x06\x00\a\x04\a\x01\x05\x04\x0e\x01\x06\x04\x0f\x00\a\x05\a\x01\a\x05\x11\x00\x06\x05\x06\x00\a\x06\a\x00\x06\x06\x06\x00\x05\x06\x05\x01\a\x06\x10\x01\x06\x06\x0f\x01\a\a\x11\x00\a\a\a\x01\x06\a\x10\x00\x06\a\x06\x01\b\x00\x11\x01\a\x00\x10\x01\x06\x00\x0f\x00\b\x00\b\x00\a\x00\a\x01\x06\x01\x0f\x01\a\x01\x10\x01\b\x01\x11\x00\x06\x01\x06\x00\a\x01\a\x01\a\x02\x11\x00\a\x02\a\x01\b\x02\x12\x00\b\x02\b\x00\a\x03\a\x01\a\x03\x11\x00\b\x03\b\x00\x06\x04\x06\x00\a\x04\a\x00\b\x04\b\x01\x06\x04\x0f\x01\a\x04\x10\x00\b\x05\b\x01\b\x05\x12\x00\a\x05\a\x00\b\x06\b\x00\a\x06\a\x00\x06\x06\x06\x01\b\x06\x11\x01\a\x06\x10\x01\b\a\x12\x00\b\a\b\x01\a\a\x11\x00\a\a\a\x01\b\x00\x11\x01\a\x00\x10\x00\b\x00\b\x01\a\x01\x10\x01\b\x01\x11\x00\a\x01\a\x00\b\x01\b\x01\b\x02\x12\x00\b\x02\b\x01\t\x02\x13\x00\t\x02\t\x00\b\x03\b\x01\b\x03\x12\x00\t\x03\t\x00\a\x04\a\x00\b\x04\b\x01\a\x04\x10\x01\b\x04\x11\x00\t\x05\t\x01\t\x05\x13\x00\b\x05\b\x00\b\x06\b\x00\a\x06\a\x01\b\x06\x11\x01\t\a\x13\x00\t\a\t\x01\b\a\x12\x00\b\a\b\x01\b\x00\x11\x01\b\x01\x11\x00\b\x01\b\x01\t\x02\x13\x00\t\x02\t\x00\t\x03\t\x01\t\x03\x13\x00\b\x04\b\x01\b\x04\x11\x00\t\x05\t\x00\b\x06\b\x01\t\a\x13\x00\t\a\t\x02\x00\x00\x12\x01\x00\x00\t\x02\x00\x01\x12\x02\x00\x02\x14\x01\x00\x02\n\x00\x00\x03\x00\x01\x00\x03\n\x01\x00\x04\t\x00\x00\x05\x00\x01\x00\x05\n\x02\x00\x05\x14\x01\x00\x06\t\x02\x00\x06\x12\x02\x00\a\x14\x01\x00\a\n\x00\x00\a\x00\x02\x01\x00\x13\x02\x00\x00\x12\x01\x01\x00\n\x01\x00\x00\t\x02\x00\x01\x12\x02\x01\x01\x13\x01\x00\x01\t\x02\x00\x02\x14\x01\x00\x02\n\x00\x00\x02\x00\x02\x01\x02\x15\x01\x01\x02\v\x00\x00\x03\x00\x01\x00\x03\n\x02\x00\x03\x14\x00\x01\x03\x01\x01\x01\x03\v\x01\x00\x04\t\x01\x01\x04\n\x02\x00\x04\x12\x00\x01\x05\x01\x01\x01\x05\v\x02\x01\x05\x15\x00\x00\x05\x00\x01\x00\x05\n\x01\x01\x06\n\x01\x00\x06\t\x02\x01\x06\x13\x02\x00\x06\x12\x02\x01\a\x15\x01\x01\a\v\x00\x01\a\x01\x02\x00\a\x14\x01\x00\a\n\x02\x02\x00\x14\x02\x01\x00\x13\x02\x00\x00\x12\x01\x02\x00\v\x01\x01\x00\n\x02\x00\x01\x12\x02\x01\x01\x13\x02\x02\x01\x14\x01\x00\x01\t\x01\x01\x01\n\x02\x01\x02\x15\x01\x01\x02\v\x00\x01\x02\x01\x02\x02\x02\x16\x01\x02\x02\f\x00\x01\x03\x01\x01\x01\x03\v\x02\x01\x03\x15\x00\x02\x03\x02\x01\x02\x03\f\x01\x00\x04\t\x01\x01\x04\n\x01\x02\x04\v\x02\x00\x04\x12\x02\x01\x04\x13\x00\x02\x05\x02\x01\x02\x05\f\x02\x02\x05\x16\x00\x01\x05\x01\x01\x01\x05\v\x01\x02\x06\v\x01\x01\x06\n\x01\x00\x06\t\x02\x02\x06\x14\x02\x01\x06\x13\x02\x02\a\x16\x01\x02\a\f\x00\x02\a\x02\x02\x01\a\x15\x01\x01\a\v\x02\x03\x00\x15\x02\x02\x00\x14\x02\x01\x00\x13\x01\x03\x00\f\x01\x02\x00\v\x02\x01\x01\x13\x02\x02\x01\x14\x02\x03\x01\x15\x01\x01\x01\n\x01\x02\x01\v\x02\x02\x02\x16\x01\x02\x02\f\x00\x02\x02\x02\x02\x03\x02\x17\x01\x03\x02\r\x00\x02\x03\x02\x01\x02\x03\f\x02\x02\x03\x16\x00\x03\x03\x03\x01\x03\x03\r\x01\x01\x04\n\x01\x02\x04\v\x01\x03\x04\f\x02\x01\x04\x13\x02\x02\x04\x14\x00\x03\x05\x03\x01\x03\x05\r\x02\x03\x05\x17\x00\x02\x05\x02\x01\x02\x05\f\x01\x03\x06\f\x01\x02\x06\v\x01\x01\x06\n\x02\x03\x06\x15\x02\x02\x06\x14\x02\x03\a\x17\x01\x03\a\r\x00\x03\a\x03\x02\x02\a\x16\x01\x02\a\f\x02\x04\x00\x16\x02\x03\x00\x15\x02\x02\x00\x14\x01\x04\x00\r\x01\x03\x00\f\x02\x02\x01\x14\x02\x03\x01\x15\x02\x04\x01\x16\x01\x02\x01\v\x01\x03\x01\f\x02\x03\x02\x17\x01\x03\x02\r\x00\x03\x02\x03\x02\x04\x02\x18\x01\x04\x02\x0e\x00\x03\x03\x03\x01\x03\x03\r\x02\x03\x03\x17\x00\x04\x03\x04\x01\x04\x03\x0e\x01\x02\x04\v\x01\x03\x04\f\x01\x04\x04\r\x02\x02\x04\x14\x02\x03\x04\x15\x00\x04\x05\x04\x01\x04\x05\x0e\x02\x04\x05\x18\x00\x03\x05\x03\x01\x03\x05\r\x01\x04\x06\r\x01\x03\x06\f\x01\x02\x06\v\x02\x04\x06\x16\x02\x03\x06\x15\x02\x04\a\x18\x01\x04\a\x0e\x00\x04\a\x04\x02\x03\a\x17\x01\x03\a\r\x02\x05\x00\x17\x02\x04\x00\x16\x02\x03\x00\x15\x01\x05\x00\x0e\x01\x04\x00\r\x02\x03\x01\x15\x02\x04\x01\x16\x02\x05\x01\x17\x01\x03\x01\f\x01\x04\x01\r\x02\x04\x02\x18\x01\x04\x02\x0e\x00\x04\x02\x04\x02\x05\x02\x19\x01\x05\x02\x0f\x00\x04\x03\x04\x01\x04\x03\x0e\x02\x04\x03\x18\x00\x05\x03\x05\x01\x05\x03\x0f\x01\x03\x04\f\x01\x04\x04\r\x01\x05\x04\x0e\x02\x03\x04\x15\x02\x04\x04\x16\x00\x05\x05\x05\x01\x05\x05\x0f\x02\x05\x05\x19\x00\x04\x05\x04\x01\x04\x05\x0e\x01\x05\x06\x0e\x01\x04\x06\r\x01\x03\x06\f\x02\x05\x06\x17\x02\x04\x06\x16\x02\x05\a\x19\x01\x05\a\x0f\x00\x05\a\x05\x02\x04\a\x18\x01\x04\a\x0e\x02\x06\x00\x18\x02\x05\x00\x17\x02\x04\x00\x16\x01\x06\x00\x0f\x01\x05\x00\x0e\x02\x04\x01\x16\x02\x05\x01\x17\x02\x06\x01\x18\x01\x04\x01\r\x01\x05\x01\x0e\x02\x05\x02\x19\x01\x05\x02\x0f\x00\x05\x02\x05\x02\x06\x02\x1a\x01\x06\x02\x10\x00\x05\x03\x05\x01\x05\x03\x0f\x02\x05\x03\x19\x00\x06\x03\x06\x01\x06\x03\x10\x01\x04\x04\r\x01\x05\x04\x0e\x01\x06\x04\x0f\x02\x04\x04\x16\x02\x05\x04\x17\x00\x06\x05\x06\x01\x06\x05\x10\x02\x06\x05\x1a\x00\x05\x05\x05\x01\x05\x05\x0f\x01\x06\x06\x0f\x01\x05\x06\x0e\x01\x04\x06\r\x02\x06\x06\x18\x02\x05\x06\x17\x02\x06\a\x1a\x01\x06\a\x10\x00\x06\a\x06\x02\x05\a\x19\x01\x05\a\x0f\x02\a\x00\x19\x02\x06\x00\x18\x02\x05\x00\x17\x01\a\x00\x10\x01\x06\x00\x0f\x02\x05\x01\x17\x02\x06\x01\x18\x02\a\x01\x19\x01\x05\x01\x0e\x01\x06\x01\x0f\x02\x06\x02\x1a\x01\x06\x02\x10\x00\x06\x02\x06\x02\a\x02\x1b\x01\a\x02\x11\x00\x06\x03\x06\x01\x06\x03\x10\x02\x06\x03\x1a\x00\a\x03\a\x01\a\x03\x11\x01\x05\x04\x0e\x01\x06\x04\x0f\x01\a\x04\x10\x02\x05\x04\x17\x02\x06\x04\x18\x00\a\x05\a\x01\a\x05\x11\x02\a\x05\x1b\x00\x06\x05\x06\x01\x06\x05\x10\x01\a\x06\x10\x01\x06\x06\x0f\x01\x05\x06\x0e\x02\a\x06\x19\x02\x06\x06\x18\x02\a\a\x1b\x01\a\a\x11\x00\a\a\a\x02\x06\a\x1a\x01\x06\a\x10\x02\b\x00\x1a\x02\a\x00\x19\x02\x06\x00\x18\x01\b\x00\x11\x01\a\x00\x10\x02\x06\x01\x18\x02\a\x01\x19\x02\b\x01\x1a\x01\x06\x01\x0f\x01\a\x01\x10\x02\a\x02\x1b\x01\a\x02\x11\x00\a\x02\a\x02\b\x02\x1c\x01\b\x02\x12\x00\a\x03\a\x01\a\x03\x11\x02\a\x03\x1b\x00\b\x03\b\x01\b\x03\x12\x01\x06\x04\x0f\x01\a\x04\x10\x01\b\x04\x11\x02\x06\x04\x18\x02\a\x04\x19\x00\b\x05\b\x01\b\x05\x12\x02\b\x05\x1c\x00\a\x05\a\x01\a\x05\x11\x01\b\x06\x11\x01\a\x06\x10\x01\x06\x06\x0f\x02\b\x06\x1a\x02\a\x06\x19\x02\b\a\x1c\x01\b\a\x12\x00\b\a\b\x02\a\a\x1b\x01\a\a\x11\x02\b\x00\x1a\x02\a\x00\x19\x01\b\x00\x11\x02\a\x01\x19\x02\b\x01\x1a\x01\a\x01\x10\x01\b\x01\x11\x02\b\x02\x1c\x01\b\x02\x12\x00\b\x02\b\x02\t\x02\x1d\x01\t\x02\x13\x00\b\x03\b\x01\b\x03\x12\x02\b\x03\x1c\x00\t\x03\t\x01\t\x03\x13\x01\a\x04\x10\x01\b\x04\x11\x02\a\x04\x19\x02\b\x04\x1a\x00\t\x05\t\x01\t\x05\x13\x02\t\x05\x1d\x00\b\x05\b\x01\b\x05\x12\x01\b\x06\x11\x01\a\x06\x10\x02\b\x06\x1a\x02\t\a\x1d\x01\t\a\x13\x00\t\a\t\x02\b\a\x1c\x01\b\a\x12\x02\b\x00\x1a\x02\b\x01\x1a\x01\b\x01\x11\x02\t\x02\x1d\x01\t\x02\x13\x00\t\x02\t\x00\t\x03\t\x01\t\x03\x13\x02\t\x03\x1d\x01\b\x04\x11\x02\b\x04\x1a\x00\t\x05\t\x01\t\x05\x13\x01\b\x06\x11\x02\t\a\x1d\x01\t\a\x13\x03\x00\x00\x1b\x02\x00\x00\x12\x03\x00\x01\x1b\x02\x00\x02\x14\x01\x00\x03\n\x02\x00\x03\x14\x02\x00\x04\x12\x01\x00\x05\n\x02\x00\x05\x14\x02\x00\x06\x12\x03\x00\x06\x1b\x02\x00\a\x14\x01\x00\a\n\x03\x01\x00\x1c\x03\x00\x00\x1b\x02\x01\x00\x13\x02\x00\x00\x12\x03\x00\x01\x1b\x03\x01\x01\x1c\x02\x00\x01\x12\x02\x00\x02\x14\x01\x00\x02\n\x02\x01\x02\x15\x01\x00\x03\n\x02\x00\x03\x14\x01\x01\x03\v\x02\x01\x03\x15\x02\x00\x04\x12\x02\x01\x04\x13\x03\x00\x04\x1b\x01\x01\x05\v\x02\x01\x05\x15\x01\x00\x05\n\x02\x00\x05\x14\x02\x01\x06\x13\x02\x00\x06\x12\x03\x01\x06\x1c\x03\x00\x06\x1b\x02\x01\a\x15\x01\x01\a\v\x02\x00\a\x14\x03\x02\x00\x1d\x03\x01\x00\x1c\x03\x00\x00\x1b\x02\x02\x00\x14\x02\x01\x00\x13\x03\x00\x01\x1b\x03\x01\x01\x1c\x03\x02\x01\x1d\x02\x00\x01\x12\x02\x01\x01\x13\x02\x01\x02\x15\x01\x01\x02\v\x02\x02\x02\x16\x01\x01\x03\v\x02\x01\x03\x15\x01\x02\x03\f\x02\x02\x03\x16\x02\x00\x04\x12\x02\x01\x04\x13\x02\x02\x04\x14\x03\x00\x04\x1b\x03\x01\x04\x1c\x01\x02\x05\f\x02\x02\x05\x16\x01\x01\x05\v\x02\x01\x05\x15\x02\x02\x06\x14\x02\x01\x06\x13\x02\x00\x06\x12\x03\x02\x06\x1d\x03\x01\x06\x1c\x02\x02\a\x16\x01\x02\a\f\x02\x01\a\x15\x03\x03\x00\x1e\x03\x02\x00\x1d\x03\x01\x00\x1c\x02\x03\x00\x15\x02\x02\x00\x14\x03\x01\x01\x1c\x03\x02\x01\x1d\x03\x03\x01\x1e\x02\x01\x01\x13\x02\x02\x01\x14\x02\x02\x02\x16\x01\x02\x02\f\x02\x03\x02\x17\x01\x02\x03\f\x02\x02\x03\x16\x01\x03\x03\r\x02\x03\x03\x17\x02\x01\x04\x13\x02\x02\x04\x14\x02\x03\x04\x15\x03\x01\x04\x1c\x03\x02\x04\x1d\x01\x03\x05\r\x02\x03\x05\x17\x01\x02\x05\f\x02\x02\x05\x16\x02\x03\x06\x15\x02\x02\x06\x14\x02\x01\x06\x13\x03\x03\x06\x1e\x03\x02\x06\x1d\x02\x03\a\x17\x01\x03\a\r\x02\x02\a\x16\x03\x04\x00\x1f\x03\x03\x00\x1e\x03\x02\x00\x1d\x02\x04\x00\x16\x02\x03\x00\x15\x03\x02\x01\x1d\x03\x03\x01\x1e\x03\x04\x01\x1f\x02\x02\x01\x14\x02\x03\x01\x15\x02\x03\x02\x17\x01\x03\x02\r\x02\x04\x02\x18\x01\x03\x03\r\x02\x03\x03\x17\x01\x04\x03\x0e\x02\x04\x03\x18\x02\x02\x04\x14\x02\x03\x04\x15\x02\x04\x04\x16\x03\x02\x04\x1d\x03\x03\x04\x1e\x01\x04\x05\x0e\x02\x04\x05\x18\x01\x03\x05\r\x02\x03\x05\x17\x02\x04\x06\x16\x02\x03\x06\x15\x02\x02\x06\x14\x03\x04\x06\x1f\x03\x03\x06\x1e\x02\x04\a\x18\x01\x04\a\x0e\x02\x03\a\x17\x03\x05\x00 \x03\x04\x00\x1f\x03\x03\x00\x1e\x02\x05\x00\x17\x02\x04\x00\x16\x03\x03\x01\x1e\x03\x04\x01\x1f\x03\x05\x01 \x02\x03\x01\x15\x02\x04\x01\x16\x02\x04\x02\x18\x01\x04\x02\x0e\x02\x05\x02\x19\x01\x04\x03\x0e\x02\x04\x03\x18\x01\x05\x03\x0f\x02\x05\x03\x19\x02\x03\x04\x15\x02\x04\x04\x16\x02\x05\x04\x17\x03\x03\x04\x1e\x03\x04\x04\x1f\x01\x05\x05\x0f\x02\x05\x05\x19\x01\x04\x05\x0e\x02\x04\x05\x18\x02\x05\x06\x17\x02\x04\x06\x16\x02\x03\x06\x15\x03\x05\x06 \x03\x04\x06\x1f\x02\x05\a\x19\x01\x05\a\x0f\x02\x04\a\x18\x03\x06\x00!\x03\x05\x00 \x03\x04\x00\x1f\x02\x06\x00\x18\x02\x05\x00\x17\x03\x04\x01\x1f\x03\x05\x01 \x03\x06\x01!\x02\x04\x01\x16\x02\x05\x01\x17\x02\x05\x02\x19\x01\x05\x02\x0f\x02\x06\x02\x1a\x01\x05\x03\x0f\x02\x05\x03\x19\x01\x06\x03\x10\x02\x06\x03\x1a\x02\x04\x04\x16\x02\x05\x04\x17\x02\x06\x04\x18\x03\x04\x04\x1f\x03\x05\x04 \x01\x06\x05\x10\x02\x06\x05\x1a\x01\x05\x05\x0f\x02\x05\x05\x19\x02\x06\x06\x18\x02\x05\x06\x17\x02\x04\x06\x16\x03\x06\x06!\x03\x05\x06 \x02\x06\a\x1a\x01\x06\a\x10\x02\x05\a\x19\x03\a\x00\"\x03\x06\x00!\x03\x05\x00 \x02\a\x00\x19\x02\x06\x00\x18\x03\x05\x01 \x03\x06\x01!\x03\a\x01\"\x02\x05\x01\x17\x02\x06\x01\x18\x02\x06\x02\x1a\x01\x06\x02\x10\x02\a\x02\x1b\x01\x06\x03\x10\x02\x06\x03\x1a\x01\a\x03\x11\x02\a\x03\x1b\x02\x05\x04\x17\x02\x06\x04\x18\x02\a\x04\x19\x03\x05\x04 \x03\x06\x04!\x01\a\x05\x11\x02\a\x05\x1b\x01\x06\x05\x10\x02\x06\x05\x1a\x02\a\x06\x19\x02\x06\x06\x18\x02\x05\x06\x17\x03\a\x06\"\x03\x06\x06!\x02\a\a\x1b\x01\a\a\x11\x02\x06\a\x1a\x03\b\x00#\x03\a\x00\"\x03\x06\x00!\x02\b\x00\x1a\x02\a\x00\x19\x03\x06\x01!\x03\a\x01\"\x03\b\x01#\x02\x06\x01\x18\x02\a\x01\x19\x02\a\x02\x1b\x01\a\x02\x11\x02\b\x02\x1c\x01\a\x03\x11\x02\a\x03\x1b\x01\b\x03\x12\x02\b\x03\x1c\x02\x06\x04\x18\x02\a\x04\x19\x02\b\x04\x1a\x03\x06\x04!\x03\a\x04\"\x01\b\x05\x12\x02\b\x05\x1c\x01\a\x05\x11\x02\a\x05\x1b\x02\b\x06\x1a\x02\a\x06\x19\x02\x06\x06\x18\x03\b\x06#\x03\a\x06\"\x02\b\a\x1c\x01\b\a\x12\x02\a\a\x1b\x03\b\x00#\x03\a\x00\"\x02\b\x00\x1a\x03\a\x01\"\x03\b\x01#\x02\a\x01\x19\x02\b\x01\x1a\x02\b\x02\x1c\x01\b\x02\x12\x02\t\x02\x1d\x01\b\x03\x12\x02\b\x03\x1c\x01\t\x03\x13\x02\t\x03\x1d\x02\a\x04\x19\x02\b\x04\x1a\x03\a\x04\"\x03\b\x04#\x01\t\x05\x13\x02\t\x05\x1d\x01\b\x05\x12\x02\b\x05\x1c\x02\b\x06\x1a\x02\a\x06\x19\x03\b\x06#\x02\t\a\x1d\x01\t\a\x13\x02\b\a\x1c\x03\b\x00#\x03\b\x01#\x02\b\x01\x1a\x02\t\x02\x1d\x01\t\x02\x13\x01\t\x03\x13\x02\t\x03\x1d\x02\b\x04\x1a\x03\b\x04#\x01\t\x05\x13\x02\t\x05\x1d\x02\b\x06\x1a\x02\t\a\x1d\x03\x00\x00\x1b\x02\x00\x03\x14\x03\x00\x04\x1b\x02\x00\x05\x14\x03\x00\x06\x1b\x02\x00\a\x14\x03\x01\x00\x1c\x03\x00\x00\x1b\x03\x00\x01\x1b\x02\x00\x02\x14\x02\x00\x03\x14\x02\x01\x03\x15\x03\x00\x04\x1b\x03\x01\x04\x1c\x02\x01\x05\x15\x02\x00\x05\x14\x03\x01\x06\x1c\x03\x00\x06\x1b\x02\x01\a\x15\x03\x02\x00\x1d\x03\x01\x00\x1c\x03\x00\x01\x1b\x03\x01\x01\x1c\x02\x01\x02\x15\x02\x01\x03\x15\x02\x02\x03\x16\x03\x00\x04\x1b\x03\x01\x04\x1c\x03\x02\x04\x1d\x02\x02\x05\x16\x02\x01\x05\x15\x03\x02\x06\x1d\x03\x01\x06\x1c\x03\x00\x06\x1b\x02\x02\a\x16\x03\x03\x00\x1e\x03\x02\x00\x1d\x03\x01\x01\x1c\x03\x02\x01\x1d\x02\x02\x02\x16\x02\x02\x03\x16\x02\x03\x03\x17\x03\x01\x04\x1c\x03\x02\x04\x1d\x03\x03\x04\x1e\x02\x03\x05\x17\x02\x02\x05\x16\x03\x03\x06\x1e\x03\x02\x06\x1d\x03\x01\x06\x1c\x02\x03\a\x17\x03\x04\x00\x1f\x03\x03\x00\x1e\x03\x02\x01\x1d\x03\x03\x01\x1e\x02\x03\x02\x17\x02\x03\x03\x17\x02\x04\x03\x18\x03\x02\x04\x1d\x03\x03\x04\x1e\x03\x04\x04\x1f\x02\x04\x05\x18\x02\x03\x05\x17\x03\x04\x06\x1f\x03\x03\x06\x1e\x03\x02\x06\x1d\x02\x04\a\x18\x03\x05\x00 \x03\x04\x00\x1f\x03\x03\x01\x1e\x03\x04\x01\x1f\x02\x04\x02\x18\x02\x04\x03\x18\x02\x05\x03\x19\x03\x03\x04\x1e\x03\x04\x04\x1f\x03\x05\x04 \x02\x05\x05\x19\x02\x04\x05\x18\x03\x05\x06 \x03\x04\x06\x1f\x03\x03\x06\x1e\x02\x05\a\x19\x03\x06\x00!\x03\x05\x00 \x03\x04\x01\x1f\x03\x05\x01 \x02\x05\x02\x19\x02\x05\x03\x19\x02\x06\x03\x1a\x03\x04\x04\x1f\x03\x05\x04 \x03\x06\x04!\x02\x06\x05\x1a\x02\x05\x05\x19\x03\x06\x06!\x03\x05\x06 \x03\x04\x06\x1f\x02\x06\a\x1a\x03\a\x00\"\x03\x06\x00!\x03\x05\x01 \x03\x06\x01!\x02\x06\x02\x1a\x02\x06\x03\x1a\x02\a\x03\x1b\x03\x05\x04 \x03\x06\x04!\x03\a\x04\"\x02\a\x05\x1b\x02\x06\x05\x1a\x03\a\x06\"\x03\x06\x06!\x03\x05\x06 \x02\a\a\x1b\x03\b\x00#\x03\a\x00\"\x03\x06\x01!\x03\a\x01\"\x02\a\x02\x1b\x02\a\x03\x1b\x02\b\x03\x1c\x03\x06\x04!\x03\a\x04\"\x03\b\x04#\x02\b\x05\x1c\x02\a\x05\x1b\x03\b\x06#\x03\a\x06\"\x03\x06\x06!\x02\b\a\x1c\x03\b\x00#\x03\a\x01\"\x03\b\x01#\x02\b\x02\x1c\x02\b\x03\x1c\x02\t\x03\x1d\x03\a\x04\"\x03\b\x04#\x02\t\x05\x1d\x02\b\x05\x1c\x03\b\x06#\x03\a\x06\"\x02\t\a\x1d\x03\b\x01#\x02\t\x02\x1d\x02\t\x03\x1d\x03\b\x04#\x02\t\x05\x1d\x03\b\x06#",
+		start: []int32{0, 6, 19, 35, 51, 67, 83, 99, 115, 131, 144, 150, 163, 191, 225, 259, 293, 327, 361, 395, 429, 457, 470, 486, 520, 560, 600, 640, 680, 720, 760, 800, 834, 850, 863, 891, 925, 959, 993, 1027, 1061, 1095, 1129, 1157, 1170, 1176, 1189, 1205, 1221, 1237, 1253, 1269, 1285, 1301, 1314, 1320},
+	},
+	{rows: 5, cols: 11, cells: "0,0;0,1;0,2;1,1;2,1;", sym: false, n: 108,
+		versions: []generatedVersion{
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0x801007, 0x100200e, 0x200401c, 0x4008038, 0x8010070, 0x100200e0, 0x200401c0, 0x40080380, 0x80100700, 0x400803800, 0x801007000, 0x100200e000, 0x200401c000, 0x4008038000, 0x8010070000, 0x100200e0000, 0x200401c0000, 0x40080380000, 0x200401c00000, 0x400803800000, 0x801007000000, 0x100200e000000, 0x200401c000000, 0x4008038000000, 0x8010070000000, 0x100200e0000000, 0x200401c0000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0x1003804, 0x2007008, 0x400e010, 0x801c020, 0x10038040, 0x20070080, 0x400e0100, 0x801c0200, 0x100380400, 0x801c02000, 0x1003804000, 0x2007008000, 0x400e010000, 0x801c020000, 0x10038040000, 0x20070080000, 0x400e0100000, 0x801c0200000, 0x400e01000000, 0x801c02000000, 0x1003804000000, 0x2007008000000, 0x400e010000000, 0x801c020000000, 0x10038040000000, 0x20070080000000, 0x400e0100000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0x1c01002, 0x3802004, 0x7004008, 0xe008010, 0x1c010020, 0x38020040, 0x70040080, 0xe0080100, 0x1c0100200, 0xe00801000, 0x1c01002000, 0x3802004000, 0x7004008000, 0xe008010000, 0x1c010020000, 0x38020040000, 0x70040080000, 0xe0080100000, 0x700400800000, 0xe00801000000, 0x1c01002000000, 0x3802004000000, 0x7004008000000, 0xe008010000000, 0x1c010020000000, 0x38020040000000, 0x70040080000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0x403801, 0x807002, 0x100e004, 0x201c008, 0x4038010, 0x8070020, 0x100e0040, 0x201c0080, 0x40380100, 0x201c00800, 0x403801000, 0x807002000, 0x100e004000, 0x201c008000, 0x4038010000, 0x8070020000, 0x100e0040000, 0x201c0080000, 0x100e00400000, 0x201c00800000, 0x403801000000, 0x807002000000, 0x100e004000000, 0x201c008000000, 0x4038010000000, 0x8070020000000, 0x100e0040000000}},
+		},
+		spots: "\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x02\x00\x00\x00\x03\x00\x00\x01\x00\x01\x00\x01\x01\x01\x00\x01\x02\x01\x00\x01\x03\x01\x00\x02\x00\x02\x00\x02\x01\x02\x00\x02\x02\x02\x00\x02\x03\x02\x00\x03\x00\x03\x00\x03\x01\x03\x00\x03\x02\x03\x00\x03\x03\x03\x00\x04\x00\x04\x00\x04\x01\x04\x00\x04\x02\x04\x00\x04\x03\x04\x00\x05\x00\x05\x00\x05\x01\x05\x00\x05\x02\x05\x00\x05\x03\x05\x00\x06\x00\x06\x00\x06\x01\x06\x00\x06\x02\x06\x00\x06\x03\x06\x00\a\x00\a\x00\a\x01\a\x00\a\x02\a\x00\a\x03\a\x00\b\x00\b\x00\b\x01\b\x00\b\x02\b\x00\b\x03\b\x01\x00\x00\t\x01\x00\x01\t\x01\x00\x02\t\x01\x00\x03\t\x01\x01\x00\n\x01\x01\x01\n\x01\x01\x02\n\x01\x01\x03\n\x01\x02\x00\v\x01\x02\x01\v\x01\x02\x02\v\x01\x02\x03\v\x01\x03\x00\f\x01\x03\x01\f\x01\x03\x02\f\x01\x03\x03\f\x01\x04\x00\r\x01\x04\x01\r\x01\x04\x02\r\x01\x04\x03\r\x01\x05\x00\x0e\x01\x05\x01\x0e\x01\x05\x02\x0e\x01\x05\x03\x0e\x01\x06\x00\x0f\x01\x06\x01\x0f\x01\x06\x02\x0f\x01\x06\x03\x0f\x01\a\x00\x10\x01\a\x01\x10\x01\a\x02\x10\x01\a\x03\x10\x01\b\x00\x11\x01\b\x01\x11\x01\b\x02\x11\x01\b\x03\x11\x02\x00\x00\x12\x02\x00\x01\x12\x02\x00\x02\x12\x02\x00\x03\x12\x02\x01\x00\x13\x02\x01\x01\x13\x02\x01\x02\x13\x02\x01\x03\x13\x02\x02\x00\x14\x02\x02\x01\x14\x02\x02\x02\x14\x02\x02\x03\x14\x02\x03\x00\x15\x02\x03\x01\x15\x02\x03\x02\x15\x02\x03\x03\x15\x02\x04\x00\x16\x02\x04\x01\x16\x02\x04\x02\x16\x02\x04\x03\x16\x02\x05\x00\x17\x02\x05\x01\x17\x02\x05\x02\x17\x02\x05\x03\x17\x02\x06\x00\x18\x02\x06\x01\x18\x02\x06\x02\x18\x02\x06\x03\x18\x02\a\x00\x19\x02\a\x01\x19\x02\a\x02\x19\x02\a\x03\x19\x02\b\x00\x1a\x02\b\x01\x1a\x02\b\x02\x1a\x02\b\x03\x1a",
+		cover: "\x00\x00\x00\x00\x00\x00\x03\x00\x00\x01\x00\x01\x00\x00\x00\x00\x00\x00\x02\x00\x00\x01\x03\x01\x00\x02\x00\x02\x00\x01\x00\x01\x00\x00\x00\x00\x00\x00\x01\x00\x00\x01\x02\x01\x00\x02\x03\x02\x00\x03\x00\x03\x00\x02\x00\x02\x00\x01\x00\x01\x00\x01\x01\x01\x00\x02\x02\x02\x00\x03\x03\x03\x00\x04\x00\x04\x00\x03\x00\x03\x00\x02\x00\x02\x00\x02\x01\x02\x00\x03\x02\x03\x00\x04\x03\x04\x00\x05\x00\x05\x00\x04\x00\x04\x00\x03\x00\x03\x00\x03\x01\x03\x00\x04\x02\x04\x00\x05\x03\x05\x00\x06\x00\x06\x00\x05\x00\x05\x00\x04\x00\x04\x00\x04\x01\x04\x00\x05\x02\x05\x00\x06\x03\x06\x00\a\x00\a\x00\x06\x00\x06\x00\x05\x00\x05\x00\x05\x01\x05\x00\x06\x02\x06\x00\a\x03\a\x00\b\x00\b\x00\a\x00\a\x00\x06\x00\x06\x00\x06\x01\x06\x00\a\x02\a\x00\b\x03\b\x00\b\x00\b\x00\a\x00\a\x00\a\x01\a\x00\b\x02\b\x00\b\x00\b\x00\b\x01\b\x01\x00\x00\t\x00\x00\x01\x00\x00\x00\x03\x00\x01\x00\x03\t\x01\x01\x00\n\x01\x00\x00\t\x00\x00\x00\x00\x00\x00\x01\x00\x00\x01\x01\x01\x00\x00\x02\x00\x01\x00\x02\t\x00\x01\x03\x01\x01\x01\x03\n\x00\x00\x03\x00\x01\x02\x00\v\x01\x01\x00\n\x01\x00\x00\t\x00\x01\x00\x01\x01\x00\x01\t\x00\x00\x01\x00\x00\x01\x01\x01\x00\x02\x01\x02\x00\x01\x02\x01\x01\x01\x02\n\x00\x02\x03\x02\x01\x02\x03\v\x00\x01\x03\x01\x00\x00\x03\x00\x01\x03\x00\f\x01\x02\x00\v\x01\x01\x00\n\x00\x02\x00\x02\x01\x01\x01\n\x00\x01\x01\x01\x00\x02\x01\x02\x00\x03\x01\x03\x00\x02\x02\x02\x01\x02\x02\v\x00\x03\x03\x03\x01\x03\x03\f\x00\x02\x03\x02\x00\x01\x03\x01\x01\x04\x00\r\x01\x03\x00\f\x01\x02\x00\v\x00\x03\x00\x03\x01\x02\x01\v\x00\x02\x01\x02\x00\x03\x01\x03\x00\x04\x01\x04\x00\x03\x02\x03\x01\x03\x02\f\x00\x04\x03\x04\x01\x04\x03\r\x00\x03\x03\x03\x00\x02\x03\x02\x01\x05\x00\x0e\x01\x04\x00\r\x01\x03\x00\f\x00\x04\x00\x04\x01\x03\x01\f\x00\x03\x01\x03\x00\x04\x01\x04\x00\x05\x01\x05\x00\x04\x02\x04\x01\x04\x02\r\x00\x05\x03\x05\x01\x05\x03\x0e\x00\x04\x03\x04\x00\x03\x03\x03\x01\x06\x00\x0f\x01\x05\x00\x0e\x01\x04\x00\r\x00\x05\x00\x05\x01\x04\x01\r\x00\x04\x01\x04\x00\x05\x01\x05\x00\x06\x01\x06\x00\x05\x02\x05\x01\x05\x02\x0e\x00\x06\x03\x06\x01\x06\x03\x0f\x00\x05\x03\x05\x00\x04\x03\x04\x01\a\x00\x10\x01\x06\x00\x0f\x01\x05\x00\x0e\x00\x06\x00\x06\x01\x05\x01\x0e\x00\x05\x01\x05\x00\x06\x01\x06\x00\a\x01\a\x00\x06\x02\x06\x01\x06\x02\x0f\x00\a\x03\a\x01\a\x03\x10\x00\x06\x03\x06\x00\x05\x03\x05\x01\b\x00\x11\x01\a\x00\x10\x01\x06\x00\x0f\x00\a\x00\a\x01\x06\x01\x0f\x00\x06\x01\x06\x00\a\x01\a\x00\b\x01\b\x00\a\x02\a\x01\a\x02\x10\x00\b\x03\b\x01\b\x03\x11\x00\a\x03\a\x00\x06\x03\x06\x01\b\x00\x11\x01\a\x00\x10\x00\b\x00\b\x01\a\x01\x10\x00\a\x01\a\x00\b\x01\b\x00\b\x02\b\x01\b\x02\x11\x00\b\x03\b\x00\a\x03\a\x01\b\x00\x11\x01\b\x01\x11\x00\b\x01\b\x00\b\x03\b\x02\x00\x00\x12\x01\x00\x01\t\x00\x00\x02\x00\x00\x00\x03\x00\x01\x00\x03\t\x02\x00\x03\x12\x02\x01\x00\x13\x02\x00\x00\x12\x01\x00\x00\t\x00\x00\x00\x00\x01\x00\x01\t\x01\x01\x01\n\x00\x00\x02\x00\x00\x01\x02\x01\x01\x00\x02\t\x02\x00\x02\x12\x00\x01\x03\x01\x01\x01\x03\n\x02\x01\x03\x13\x01\x00\x03\t\x02\x02\x00\x14\x02\x01\x00\x13\x02\x00\x00\x12\x01\x01\x00\n\x00\x01\x00\x01\x02\x00\x01\x12\x01\x00\x01\t\x00\x00\x01\x00\x01\x01\x01\n\x01\x02\x01\v\x00\x00\x02\x00\x00\x01\x02\x01\x00\x02\x02\x02\x01\x01\x02\n\x02\x01\x02\x13\x00\x02\x03\x02\x01\x02\x03\v\x02\x02\x03\x14\x01\x01\x03\n\x01\x00\x03\t\x02\x03\x00\x15\x02\x02\x00\x14\x02\x01\x00\x13\x01\x02\x00\v\x00\x02\x00\x02\x02\x01\x01\x13\x01\x01\x01\n\x00\x01\x01\x01\x01\x02\x01\v\x01\x03\x01\f\x00\x01\x02\x01\x00\x02\x02\x02\x00\x03\x02\x03\x01\x02\x02\v\x02\x02\x02\x14\x00\x03\x03\x03\x01\x03\x03\f\x02\x03\x03\x15\x01\x02\x03\v\x01\x01\x03\n\x02\x04\x00\x16\x02\x03\x00\x15\x02\x02\x00\x14\x01\x03\x00\f\x00\x03\x00\x03\x02\x02\x01\x14\x01\x02\x01\v\x00\x02\x01\x02\x01\x03\x01\f\x01\x04\x01\r\x00\x02\x02\x02\x00\x03\x02\x03\x00\x04\x02\x04\x01\x03\x02\f\x02\x03\x02\x15\x00\x04\x03\x04\x01\x04\x03\r\x02\x04\x03\x16\x01\x03\x03\f\x01\x02\x03\v\x02\x05\x00\x17\x02\x04\x00\x16\x02\x03\x00\x15\x01\x04\x00\r\x00\x04\x00\x04\x02\x03\x01\x15\x01\x03\x01\f\x00\x03\x01\x03\x01\x04\x01\r\x01\x05\x01\x0e\x00\x03\x02\x03\x00\x04\x02\x04\x00\x05\x02\x05\x01\x04\x02\r\x02\x04\x02\x16\x00\x05\x03\x05\x01\x05\x03\x0e\x02\x05\x03\x17\x01\x04\x03\r\x01\x03\x03\f\x02\x06\x00\x18\x02\x05\x00\x17\x02\x04\x00\x16\x01\x05\x00\x0e\x00\x05\x00\x05\x02\x04\x01\x16\x01\x04\x01\r\x00\x04\x01\x04\x01\x05\x01\x0e\x01\x06\x01\x0f\x00\x04\x02\x04\x00\x05\x02\x05\x00\x06\x02\x06\x01\x05\x02\x0e\x02\x05\x02\x17\x00\x06\x03\x06\x01\x06\x03\x0f\x02\x06\x03\x18\x01\x05\x03\x0e\x01\x04\x03\r\x02\a\x00\x19\x02\x06\x00\x18\x02\x05\x00\x17\x01\x06\x00\x0f\x00\x06\x00\x06\x02\x05\x01\x17\x01\x05\x01\x0e\x00\x05\x01\x05\x01\x06\x01\x0f\x01\a\x01\x10\x00\x05\x02\x05\x00\x06\x02\x06\x00\a\x02\a\x01\x06\x02\x0f\x02\x06\x02\x18\x00\a\x03\a\x01\a\x03\x10\x02\a\x03\x19\x01\x06\x03\x0f\x01\x05\x03\x0e\x02\b\x00\x1a\x02\a\x00\x19\x02\x06\x00\x18\x01\a\x00\x10\x00\a\x00\a\x02\x06\x01\x18\x01\x06\x01\x0f\x00\x06\x01\x06\x01\a\x01\x10\x01\b\x01\x11\x00\x06\x02\x06\x00\a\x02\a\x00\b\x02\b\x01\a\x02\x10\x02\a\x02\x19\x00\b\x03\b\x01\b\x03\x11\x02\b\x03\x1a\x01\a\x03\x10\x01\x06\x03\x0f\x02\b\x00\x1a\x02\a\x00\x19\x01\b\x00\x11\x00\b\x00\b\x02\a\x01\x19\x01\a\x01\x10\x00\a\x01\a\x01\b\x01\x11\x00\a\x02\a\x00\b\x02\b\x01\b\x02\x11\x02\b\x02\x1a\x01\b\x03\x11\x01\a\x03\x10\x02\b\x00\x1a\x02\b\x01\x1a\x01\b\x01\x11\x00\b\x01\b\x00\b\x02\b\x01\b\x03\x11\x02\x00\x01\x12\x01\x00\x02\t\x01\x00\x03\t\x02\x00\x03\x12\x02\x00\x00\x12\x01\x00\x00\t\x02\x00\x01\x12\x02\x01\x01\x13\x01\x00\x02\t\x01\x01\x02\n\x02\x00\x02\x12\x01\x01\x03\n\x02\x01\x03\x13\x02\x00\x03\x12\x02\x01\x00\x13\x01\x01\x00\n\x02\x00\x01\x12\x01\x00\x01\t\x02\x01\x01\x13\x02\x02\x01\x14\x01\x00\x02\t\x01\x01\x02\n\x01\x02\x02\v\x02\x01\x02\x13\x01\x02\x03\v\x02\x02\x03\x14\x02\x01\x03\x13\x02\x00\x03\x12\x02\x02\x00\x14\x01\x02\x00\v\x02\x01\x01\x13\x01\x01\x01\n\x02\x02\x01\x14\x02\x03\x01\x15\x01\x01\x02\n\x01\x02\x02\v\x01\x03\x02\f\x02\x02\x02\x14\x01\x03\x03\f\x02\x03\x03\x15\x02\x02\x03\x14\x02\x01\x03\x13\x02\x03\x00\x15\x01\x03\x00\f\x02\x02\x01\x14\x01\x02\x01\v\x02\x03\x01\x15\x02\x04\x01\x16\x01\x02\x02\v\x01\x03\x02\f\x01\x04\x02\r\x02\x03\x02\x15\x01\x04\x03\r\x02\x04\x03\x16\x02\x03\x03\x15\x02\x02\x03\x14\x02\x04\x00\x16\x01\x04\x00\r\x02\x03\x01\x15\x01\x03\x01\f\x02\x04\x01\x16\x02\x05\x01\x17\x01\x03\x02\f\x01\x04\x02\r\x01\x05\x02\x0e\x02\x04\x02\x16\x01\x05\x03\x0e\x02\x05\x03\x17\x02\x04\x03\x16\x02\x03\x03\x15\x02\x05\x00\x17\x01\x05\x00\x0e\x02\x04\x01\x16\x01\x04\x01\r\x02\x05\x01\x17\x02\x06\x01\x18\x01\x04\x02\r\x01\x05\x02\x0e\x01\x06\x02\x0f\x02\x05\x02\x17\x01\x06\x03\x0f\x02\x06\x03\x18\x02\x05\x03\x17\x02\x04\x03\x16\x02\x06\x00\x18\x01\x06\x00\x0f\x02\x05\x01\x17\x01\x05\x01\x0e\x02\x06\x01\x18\x02\a\x01\x19\x01\x05\x02\x0e\x01\x06\x02\x0f\x01\a\x02\x10\x02\x06\x02\x18\x01\a\x03\x10\x02\a\x03\x19\x02\x06\x03\x18\x02\x05\x03\x17\x02\a\x00\x19\x01\a\x00\x10\x02\x06\x01\x18\x01\x06\x01\x0f\x02\a\x01\x19\x02\b\x01\x1a\x01\x06\x02\x0f\x01\a\x02\x10\x01\b\x02\x11\x02\a\x02\x19\x01\b\x03\x11\x02\b\x03\x1a\x02\a\x03\x19\x02\x06\x03\x18\x02\b\x00\x1a\x01\b\x00\x11\x02\a\x01\x19\x01\a\x01\x10\x02\b\x01\x1a\x01\a\x02\x10\x01\b\x02\x11\x02\b\x02\x1a\x02\b\x03\x1a\x02\a\x03\x19\x02\b\x01\x1a\x01\b\x01\x11\x01\b\x02\x11\x02\b\x03\x1a\x02\x00\x02\x12\x02\x00\x03\x12\x02\x00\x00\x12\x02\x00\x02\x12\x02\x01\x02\x13\x02\x01\x03\x13\x02\x01\x00\x13\x02\x00\x01\x12\x02\x00\x02\x12\x02\x01\x02\x13\x02\x02\x02\x14\x02\x02\x03\x14\x02\x02\x00\x14\x02\x01\x01\x13\x02\x01\x02\x13\x02\x02\x02\x14\x02\x03\x02\x15\x02\x03\x03\x15\x02\x03\x00\x15\x02\x02\x01\x14\x02\x02\x02\x14\x02\x03\x02\x15\x02\x04\x02\x16\x02\x04\x03\x16\x02\x04\x00\x16\x02\x03\x01\x15\x02\x03\x02\x15\x02\x04\x02\x16\x02\x05\x02\x17\x02\x05\x03\x17\x02\x05\x00\x17\x02\x04\x01\x16\x02\x04\x02\x16\x02\x05\x02\x17\x02\x06\x02\x18\x02\x06\x03\x18\x02\x06\x00\x18\x02\x05\x01\x17\x02\x05\x02\x17\x02\x06\x02\x18\x02\a\x02\x19\x02\a\x03\x19\x02\a\x00\x19\x02\x06\x01\x18\x02\x06\x02\x18\x02\a\x02\x19\x02\b\x02\x1a\x02\b\x03\x1a\x02\b\x00\x1a\x02\a\x01\x19\x02\a\x02\x19\x02\b\x02\x1a\x02\b\x01\x1a\x02\b\x02\x1a",
+		start: []int32{0, 2, 6, 12, 18, 24, 30, 36, 42, 48, 52, 54, 58, 68, 82, 96, 110, 124, 138, 152, 166, 176, 180, 186, 200, 220, 240, 260, 280, 300, 320, 340, 354, 360, 364, 374, 388, 402, 416, 430, 444, 458, 472, 482, 486, 488, 492, 498, 504, 510, 516, 522, 528, 534, 538, 540},
+	},
+	{rows: 5, cols: 11, cells: "0,0;0,1;0,2;1,2;1,3;", sym: false, n: 208,
+		versions: []generatedVersion{
+			{lo: Pos{0, 0}, n: Pos{4, 8}, masks: []uint64{0x6007, 0xc00e, 0x1801c, 0x30038, 0x60070, 0xc00e0, 0x1801c0, 0x300380, 0x3003800, 0x6007000, 0xc00e000, 0x1801c000, 0x30038000, 0x60070000, 0xc00e0000, 0x1801c0000, 0x1801c00000, 0x3003800000, 0x6007000000, 0xc00e000000, 0x1801c000000, 0x30038000000, 0x60070000000, 0xc00e0000000, 0xc00e00000000, 0x1801c00000000, 0x3003800000000, 0x6007000000000, 0xc00e000000000, 0x1801c000000000, 0x30038000000000, 0x60070000000000}},
+			{lo: Pos{0, 0}, n: Pos{4, 8}, masks: []uint64{0x180e, 0x301c, 0x6038, 0xc070, 0x180e0, 0x301c0, 0x60380, 0xc0700, 0xc07000, 0x180e000, 0x301c000, 0x6038000, 0xc070000, 0x180e0000, 0x301c0000, 0x60380000, 0x603800000, 0xc07000000, 0x180e000000, 0x301c000000, 0x6038000000, 0xc070000000, 0x180e0000000, 0x301c0000000, 0x301c00000000, 0x603800000000, 0xc07000000000, 0x180e000000000, 0x301c000000000, 0x6038000000000, 0xc070000000000, 0x180e0000000000}},
+			{lo: Pos{0, 0}, n: Pos{2, 10}, masks: []uint64{0x200c01002, 0x401802004, 0x803004008, 0x1006008010, 0x200c010020, 0x4018020040, 0x8030040080, 0x10060080100, 0x200c0100200, 0x40180200400, 0x100600801000, 0x200c01002000, 0x401802004000, 0x803004008000, 0x1006008010000, 0x200c010020000, 0x4018020040000, 0x8030040080000, 0x10060080100000, 0x200c0100200000}},
+			{lo: Pos{0, 0}, n: Pos{2, 10}, masks: []uint64{0x400801801, 0x801003002, 0x1002006004, 0x200400c008, 0x4008018010, 0x8010030020, 0x10020060040, 0x200400c0080, 0x40080180100, 0x80100300200, 0x200400c00800, 0x400801801000, 0x801003002000, 0x1002006004000, 0x200400c008000, 0x4008018010000, 0x8010030020000, 0x10020060040000, 0x200400c0080000, 0x40080180100000}},
+			{lo: Pos{0, 0}, n: Pos{4, 8}, masks: []uint64{0x7003, 0xe006, 0x1c00c, 0x38018, 0x70030, 0xe0060, 0x1c00c0, 0x380180, 0x3801800, 0x7003000, 0xe006000, 0x1c00c000, 0x38018000, 0x70030000, 0xe0060000, 0x1c00c0000, 0x1c00c00000, 0x3801800000, 0x7003000000, 0xe006000000, 0x1c00c000000, 0x38018000000, 0x70030000000, 0xe0060000000, 0xe00600000000, 0x1c00c00000000, 0x3801800000000, 0x7003000000000, 0xe006000000000, 0x1c00c000000000, 0x38018000000000, 0x70030000000000}},
+			{lo: Pos{0, 0}, n: Pos{2, 10}, masks: []uint64{0x200401802, 0x400803004, 0x801006008, 0x100200c010, 0x2004018020, 0x4008030040, 0x8010060080, 0x100200c0100, 0x20040180200, 0x40080300400, 0x100200c01000, 0x200401802000, 0x400803004000, 0x801006008000, 0x100200c010000, 0x2004018020000, 0x4008030040000, 0x8010060080000, 0x100200c0100000, 0x20040180200000}},
+			{lo: Pos{0, 0}, n: Pos{4, 8}, masks: []uint64{0x380c, 0x7018, 0xe030, 0x1c060, 0x380c0, 0x70180, 0xe0300, 0x1c0600, 0x1c06000, 0x380c000, 0x7018000, 0xe030000, 0x1c060000, 0x380c0000, 0x70180000, 0xe0300000, 0xe03000000, 0x1c06000000, 0x380c000000, 0x7018000000, 0xe030000000, 0x1c060000000, 0x380c0000000, 0x70180000000, 0x701800000000, 0xe03000000000, 0x1c06000000000, 0x380c000000000, 0x7018000000000, 0xe030000000000, 0x1c060000000000, 0x380c0000000000}},
+			{lo: Pos{0, 0}, n: Pos{2, 10}, masks: []uint64{0x400c00801, 0x801801002, 0x1003002004, 0x2006004008, 0x400c008010, 0x8018010020, 0x10030020040, 0x20060040080, 0x400c0080100, 0x80180100200, 0x200600400800, 0x400c00801000, 0x801801002000, 0x1003002004000, 0x2006004008000, 0x400c008010000, 0x8018010020000, 0x10030020040000, 0x20060040080000, 0x400c0080100000}},
+		},
+		spots: "\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x02\x00\x00\x00\x03\x00\x00\x00\x04\x00\x00\x00\x05\x00\x00\x00\x06\x00\x00\x00\a\x00\x00\x01\x00\x01\x00\x01\x01\x01\x00\x01\x02\x01\x00\x01\x03\x01\x00\x01\x04\x01\x00\x01\x05\x01\x00\x01\x06\x01\x00\x01\a\x01\x00\x02\x00\x02\x00\x02\x01\x02\x00\x02\x02\x02\x00\x02\x03\x02\x00\x02\x04\x02\x00\x02\x05\x02\x00\x02\x06\x02\x00\x02\a\x02\x00\x03\x00\x03\x00\x03\x01\x03\x00\x03\x02\x03\x00\x03\x03\x03\x00\x03\x04\x03\x00\x03\x05\x03\x00\x03\x06\x03\x00\x03\a\x03\x00\x04\x00\x04\x00\x04\x01\x04\x00\x04\x02\x04\x00\x04\x03\x04\x00\x04\x04\x04\x00\x04\x05\x04\x00\x04\x06\x04\x00\x04\a\x04\x00\x05\x00\x05\x00\x05\x01\x05\x00\x05\x02\x05\x00\x05\x03\x05\x00\x05\x04\x05\x00\x05\x05\x05\x00\x05\x06\x05\x00\x05\a\x05\x00\x06\x00\x06\x00\x06\x01\x06\x00\x06\x02\x06\x00\x06\x03\x06\x00\x06\x04\x06\x00\x06\x05\x06\x00\x06\x06\x06\x00\x06\a\x06\x00\a\x00\a\x00\a\x01\a\x00\a\x02\a\x00\a\x03\a\x00\a\x04\a\x00\a\x05\a\x00\a\x06\a\x00\a\a\a\x00\b\x02\b\x00\b\x03\b\x00\b\x05\b\x00\b\a\b\x00\t\x02\t\x00\t\x03\t\x00\t\x05\t\x00\t\a\t\x01\x00\x00\b\x01\x00\x01\b\x01\x00\x02\n\x01\x00\x03\n\x01\x00\x04\b\x01\x00\x05\n\x01\x00\x06\b\x01\x00\a\n\x01\x01\x00\t\x01\x01\x01\t\x01\x01\x02\v\x01\x01\x03\v\x01\x01\x04\t\x01\x01\x05\v\x01\x01\x06\t\x01\x01\a\v\x01\x02\x00\n\x01\x02\x01\n\x01\x02\x02\f\x01\x02\x03\f\x01\x02\x04\n\x01\x02\x05\f\x01\x02\x06\n\x01\x02\a\f\x01\x03\x00\v\x01\x03\x01\v\x01\x03\x02\r\x01\x03\x03\r\x01\x03\x04\v\x01\x03\x05\r\x01\x03\x06\v\x01\x03\a\r\x01\x04\x00\f\x01\x04\x01\f\x01\x04\x02\x0e\x01\x04\x03\x0e\x01\x04\x04\f\x01\x04\x05\x0e\x01\x04\x06\f\x01\x04\a\x0e\x01\x05\x00\r\x01\x05\x01\r\x01\x05\x02\x0f\x01\x05\x03\x0f\x01\x05\x04\r\x01\x05\x05\x0f\x01\x05\x06\r\x01\x05\a\x0f\x01\x06\x00\x0e\x01\x06\x01\x0e\x01\x06\x02\x10\x01\x06\x03\x10\x01\x06\x04\x0e\x01\x06\x05\x10\x01\x06\x06\x0e\x01\x06\a\x10\x01\a\x00\x0f\x01\a\x01\x0f\x01\a\x02\x11\x01\a\x03\x11\x01\a\x04\x0f\x01\a\x05\x11\x01\a\x06\x0f\x01\a\a\x11\x01\b\x02\x12\x01\b\x03\x12\x01\b\x05\x12\x01\b\a\x12\x01\t\x02\x13\x01\t\x03\x13\x01\t\x05\x13\x01\t\a\x13\x02\x00\x00\x10\x02\x00\x01\x10\x02\x00\x04\x10\x02\x00\x06\x10\x02\x01\x00\x11\x02\x01\x01\x11\x02\x01\x04\x11\x02\x01\x06\x11\x02\x02\x00\x12\x02\x02\x01\x12\x02\x02\x04\x12\x02\x02\x06\x12\x02\x03\x00\x13\x02\x03\x01\x13\x02\x03\x04\x13\x02\x03\x06\x13\x02\x04\x00\x14\x02\x04\x01\x14\x02\x04\x04\x14\x02\x04\x06\x14\x02\x05\x00\x15\x02\x05\x01\x15\x02\x05\x04\x15\x02\x05\x06\x15\x02\x06\x00\x16\x02\x06\x01\x16\x02\x06\x04\x16\x02\x06\x06\x16\x02\a\x00\x17\x02\a\x01\x17\x02\a\x04\x17\x02\a\x06\x17\x03\x00\x00\x18\x03\x00\x01\x18\x03\x00\x04\x18\x03\x00\x06\x18\x03\x01\x00\x19\x03\x01\x01\x19\x03\x01\x04\x19\x03\x01\x06\x19\x03\x02\x00\x1a\x03\x02\x01\x1a\x03\x02\x04\x1a\x03\x02\x06\x1a\x03\x03\x00\x1b\x03\x03\x01\x1b\x03\x03\x04\x1b\x03\x03\x06\x1b\x03\x04\x00\x1c\x03\x04\x01\x1c\x03\x04\x04\x1c\x03\x04\x06\x1c\x03\x05\x00\x1d\x03\x05\x01\x1d\x03\x05\x04\x1d\x03\x05\x06\x1d\x03\x06\x00\x1e\x03\x06\x01\x1e\x03\x06\x04\x1e\x03\x06\x06\x1e\x03\a\x00\x1f\x03\a\x01\x1f\x03\a\x04\x1f\x03\a\x06\x1f",
+		cover: "\x00\x00\x00\x00\x00\x00\x03\x00\x00\x00\x04\x00\x00\x00\a\x00\x00\x01\x00\x01\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x02\x00\x00\x01\x03\x01\x00\x00\x04\x00\x00\x01\x04\x01\x00\x00\x05\x00\x00\x01\a\x01\x00\x02\x00\x02\x00\x01\x00\x01\x00\x00\x00\x00\x00\x00\x01\x00\x00\x01\x01\x01\x00\x01\x02\x01\x00\x02\x03\x02\x00\x01\x04\x01\x00\x02\x04\x02\x00\x01\x05\x01\x00\x00\x06\x00\x00\x02\a\x02\x00\x03\x00\x03\x00\x02\x00\x02\x00\x01\x00\x01\x00\x00\x01\x00\x00\x01\x01\x01\x00\x02\x01\x02\x00\x02\x02\x02\x00\x03\x03\x03\x00\x02\x04\x02\x00\x03\x04\x03\x00\x02\x05\x02\x00\x01\x06\x01\x00\x00\x06\x00\x00\x03\a\x03\x00\x04\x00\x04\x00\x03\x00\x03\x00\x02\x00\x02\x00\x01\x01\x01\x00\x02\x01\x02\x00\x03\x01\x03\x00\x03\x02\x03\x00\x04\x03\x04\x00\x03\x04\x03\x00\x04\x04\x04\x00\x03\x05\x03\x00\x02\x06\x02\x00\x01\x06\x01\x00\x04\a\x04\x00\x05\x00\x05\x00\x04\x00\x04\x00\x03\x00\x03\x00\x02\x01\x02\x00\x03\x01\x03\x00\x04\x01\x04\x00\x04\x02\x04\x00\x05\x03\x05\x00\x04\x04\x04\x00\x05\x04\x05\x00\x04\x05\x04\x00\x03\x06\x03\x00\x02\x06\x02\x00\x05\a\x05\x00\x06\x00\x06\x00\x05\x00\x05\x00\x04\x00\x04\x00\x03\x01\x03\x00\x04\x01\x04\x00\x05\x01\x05\x00\x05\x02\x05\x00\x06\x03\x06\x00\x05\x04\x05\x00\x06\x04\x06\x00\x05\x05\x05\x00\x04\x06\x04\x00\x03\x06\x03\x00\x06\a\x06\x00\a\x00\a\x00\x06\x00\x06\x00\x05\x00\x05\x00\x04\x01\x04\x00\x05\x01\x05\x00\x06\x01\x06\x00\x06\x02\x06\x00\a\x03\a\x00\x06\x04\x06\x00\a\x04\a\x00\x06\x05\x06\x00\x05\x06\x05\x00\x04\x06\x04\x00\a\a\a\x00\a\x00\a\x00\x06\x00\x06\x00\x05\x01\x05\x00\x06\x01\x06\x00\a\x01\a\x00\a\x02\a\x00\b\x03\b\x00\a\x04\a\x00\a\x05\a\x00\x06\x06\x06\x00\x05\x06\x05\x00\b\a\b\x00\a\x00\a\x00\x06\x01\x06\x00\a\x01\a\x00\b\x02\b\x00\t\x03\t\x00\b\x05\b\x00\a\x06\a\x00\x06\x06\x06\x00\t\a\t\x00\a\x01\a\x00\t\x02\t\x00\t\x05\t\x00\a\x06\a\x01\x00\x00\b\x00\x00\x01\x00\x00\x00\x03\x00\x01\x00\x03\n\x01\x00\x04\b\x00\x00\x05\x00\x00\x00\x06\x00\x01\x00\a\n\x00\x00\a\x00\x01\x01\x00\t\x01\x00\x00\b\x01\x00\x01\b\x00\x00\x01\x00\x00\x01\x01\x01\x01\x00\x02\n\x00\x00\x02\x00\x00\x00\x03\x00\x00\x01\x03\x01\x01\x01\x03\v\x00\x00\x04\x00\x01\x00\x04\b\x01\x01\x04\t\x00\x01\x05\x01\x00\x00\x05\x00\x01\x00\x05\n\x00\x01\x06\x01\x00\x00\x06\x00\x01\x01\a\v\x00\x01\a\x01\x01\x02\x00\n\x01\x01\x00\t\x01\x00\x00\b\x00\x00\x00\x00\x01\x00\x01\b\x01\x01\x01\t\x00\x01\x01\x01\x00\x02\x01\x02\x01\x01\x02\v\x00\x01\x02\x01\x00\x01\x03\x01\x00\x02\x03\x02\x01\x02\x03\f\x00\x00\x04\x00\x00\x01\x04\x01\x01\x01\x04\t\x01\x02\x04\n\x00\x02\x05\x02\x00\x01\x05\x01\x01\x01\x05\v\x00\x02\x06\x02\x00\x01\x06\x01\x00\x00\x06\x00\x01\x00\x06\b\x01\x02\a\f\x00\x02\a\x02\x01\x03\x00\v\x01\x02\x00\n\x01\x01\x00\t\x00\x01\x00\x01\x00\x00\x00\x00\x01\x00\x01\b\x01\x01\x01\t\x01\x02\x01\n\x00\x02\x01\x02\x00\x03\x01\x03\x01\x02\x02\f\x00\x02\x02\x02\x00\x02\x03\x02\x00\x03\x03\x03\x01\x03\x03\r\x00\x00\x04\x00\x00\x01\x04\x01\x00\x02\x04\x02\x01\x02\x04\n\x01\x03\x04\v\x00\x03\x05\x03\x00\x02\x05\x02\x01\x02\x05\f\x00\x03\x06\x03\x00\x02\x06\x02\x00\x01\x06\x01\x01\x01\x06\t\x01\x00\x06\b\x01\x03\a\r\x00\x03\a\x03\x01\x04\x00\f\x01\x03\x00\v\x01\x02\x00\n\x00\x02\x00\x02\x00\x01\x00\x01\x01\x01\x01\t\x01\x02\x01\n\x01\x03\x01\v\x00\x03\x01\x03\x00\x04\x01\x04\x01\x03\x02\r\x00\x03\x02\x03\x00\x03\x03\x03\x00\x04\x03\x04\x01\x04\x03\x0e\x00\x01\x04\x01\x00\x02\x04\x02\x00\x03\x04\x03\x01\x03\x04\v\x01\x04\x04\f\x00\x04\x05\x04\x00\x03\x05\x03\x01\x03\x05\r\x00\x04\x06\x04\x00\x03\x06\x03\x00\x02\x06\x02\x01\x02\x06\n\x01\x01\x06\t\x01\x04\a\x0e\x00\x04\a\x04\x01\x05\x00\r\x01\x04\x00\f\x01\x03\x00\v\x00\x03\x00\x03\x00\x02\x00\x02\x01\x02\x01\n\x01\x03\x01\v\x01\x04\x01\f\x00\x04\x01\x04\x00\x05\x01\x05\x01\x04\x02\x0e\x00\x04\x02\x04\x00\x04\x03\x04\x00\x05\x03\x05\x01\x05\x03\x0f\x00\x02\x04\x02\x00\x03\x04\x03\x00\x04\x04\x04\x01\x04\x04\f\x01\x05\x04\r\x00\x05\x05\x05\x00\x04\x05\x04\x01\x04\x05\x0e\x00\x05\x06\x05\x00\x04\x06\x04\x00\x03\x06\x03\x01\x03\x06\v\x01\x02\x06\n\x01\x05\a\x0f\x00\x05\a\x05\x01\x06\x00\x0e\x01\x05\x00\r\x01\x04\x00\f\x00\x04\x00\x04\x00\x03\x00\x03\x01\x03\x01\v\x01\x04\x01\f\x01\x05\x01\r\x00\x05\x01\x05\x00\x06\x01\x06\x01\x05\x02\x0f\x00\x05\x02\x05\x00\x05\x03\x05\x00\x06\x03\x06\x01\x06\x03\x10\x00\x03\x04\x03\x00\x04\x04\x04\x00\x05\x04\x05\x01\x05\x04\r\x01\x06\x04\x0e\x00\x06\x05\x06\x00\x05\x05\x05\x01\x05\x05\x0f\x00\x06\x06\x06\x00\x05\x06\x05\x00\x04\x06\x04\x01\x04\x06\f\x01\x03\x06\v\x01\x06\a\x10\x00\x06\a\x06\x01\a\x00\x0f\x01\x06\x00\x0e\x01\x05\x00\r\x00\x05\x00\x05\x00\x04\x00\x04\x01\x04\x01\f\x01\x05\x01\r\x01\x06\x01\x0e\x00\x06\x01\x06\x00\a\x01\a\x01\x06\x02\x10\x00\x06\x02\x06\x00\x06\x03\x06\x00\a\x03\a\x01\a\x03\x11\x00\x04\x04\x04\x00\x05\x04\x05\x00\x06\x04\x06\x01\x06\x04\x0e\x01\a\x04\x0f\x00\a\x05\a\x00\x06\x05\x06\x01\x06\x05\x10\x00\a\x06\a\x00\x06\x06\x06\x00\x05\x06\x05\x01\x05\x06\r\x01\x04\x06\f\x01\a\a\x11\x00\a\a\a\x01\a\x00\x0f\x01\x06\x00\x0e\x00\x06\x00\x06\x00\x05\x00\x05\x01\x05\x01\r\x01\x06\x01\x0e\x01\a\x01\x0f\x00\a\x01\a\x01\a\x02\x11\x00\a\x02\a\x00\a\x03\a\x00\b\x03\b\x01\b\x03\x12\x00\x05\x04\x05\x00\x06\x04\x06\x00\a\x04\a\x01\a\x04\x0f\x00\b\x05\b\x00\a\x05\a\x01\a\x05\x11\x00\a\x06\a\x00\x06\x06\x06\x01\x06\x06\x0e\x01\x05\x06\r\x01\b\a\x12\x00\b\a\b\x01\a\x00\x0f\x00\a\x00\a\x00\x06\x00\x06\x01\x06\x01\x0e\x01\a\x01\x0f\x01\b\x02\x12\x00\b\x02\b\x00\b\x03\b\x00\t\x03\t\x01\t\x03\x13\x00\x06\x04\x06\x00\a\x04\a\x00\t\x05\t\x00\b\x05\b\x01\b\x05\x12\x00\a\x06\a\x01\a\x06\x0f\x01\x06\x06\x0e\x01\t\a\x13\x00\t\a\t\x00\a\x00\a\x01\a\x01\x0f\x01\t\x02\x13\x00\t\x02\t\x00\t\x03\t\x00\a\x04\a\x00\t\x05\t\x01\t\x05\x13\x01\a\x06\x0f\x02\x00\x00\x10\x01\x00\x01\b\x00\x00\x02\x00\x01\x00\x03\n\x02\x00\x04\x10\x00\x00\x05\x00\x01\x00\x05\n\x01\x00\x06\b\x01\x00\a\n\x00\x00\a\x00\x02\x01\x00\x11\x02\x00\x00\x10\x02\x00\x01\x10\x01\x00\x01\b\x01\x01\x01\t\x01\x00\x02\n\x00\x00\x02\x00\x00\x01\x02\x01\x00\x00\x03\x00\x01\x00\x03\n\x01\x01\x03\v\x01\x00\x04\b\x02\x00\x04\x10\x02\x01\x04\x11\x00\x01\x05\x01\x01\x01\x05\v\x01\x00\x05\n\x01\x01\x06\t\x01\x00\x06\b\x01\x01\a\v\x00\x01\a\x01\x00\x00\a\x00\x02\x02\x00\x12\x02\x01\x00\x11\x02\x00\x00\x10\x01\x00\x00\b\x02\x00\x01\x10\x02\x01\x01\x11\x01\x01\x01\t\x01\x02\x01\n\x01\x01\x02\v\x00\x01\x02\x01\x00\x02\x02\x02\x00\x01\x03\x01\x01\x01\x03\v\x01\x02\x03\f\x01\x00\x04\b\x01\x01\x04\t\x02\x01\x04\x11\x02\x02\x04\x12\x00\x02\x05\x02\x01\x02\x05\f\x01\x01\x05\v\x01\x02\x06\n\x01\x01\x06\t\x01\x00\x06\b\x02\x00\x06\x10\x01\x02\a\f\x00\x02\a\x02\x00\x01\a\x01\x02\x03\x00\x13\x02\x02\x00\x12\x02\x01\x00\x11\x01\x01\x00\t\x01\x00\x00\b\x02\x00\x01\x10\x02\x01\x01\x11\x02\x02\x01\x12\x01\x02\x01\n\x01\x03\x01\v\x01\x02\x02\f\x00\x02\x02\x02\x00\x03\x02\x03\x00\x02\x03\x02\x01\x02\x03\f\x01\x03\x03\r\x01\x00\x04\b\x01\x01\x04\t\x01\x02\x04\n\x02\x02\x04\x12\x02\x03\x04\x13\x00\x03\x05\x03\x01\x03\x05\r\x01\x02\x05\f\x01\x03\x06\v\x01\x02\x06\n\x01\x01\x06\t\x02\x01\x06\x11\x02\x00\x06\x10\x01\x03\a\r\x00\x03\a\x03\x00\x02\a\x02\x02\x04\x00\x14\x02\x03\x00\x13\x02\x02\x00\x12\x01\x02\x00\n\x01\x01\x00\t\x02\x01\x01\x11\x02\x02\x01\x12\x02\x03\x01\x13\x01\x03\x01\v\x01\x04\x01\f\x01\x03\x02\r\x00\x03\x02\x03\x00\x04\x02\x04\x00\x03\x03\x03\x01\x03\x03\r\x01\x04\x03\x0e\x01\x01\x04\t\x01\x02\x04\n\x01\x03\x04\v\x02\x03\x04\x13\x02\x04\x04\x14\x00\x04\x05\x04\x01\x04\x05\x0e\x01\x03\x05\r\x01\x04\x06\f\x01\x03\x06\v\x01\x02\x06\n\x02\x02\x06\x12\x02\x01\x06\x11\x01\x04\a\x0e\x00\x04\a\x04\x00\x03\a\x03\x02\x05\x00\x15\x02\x04\x00\x14\x02\x03\x00\x13\x01\x03\x00\v\x01\x02\x00\n\x02\x02\x01\x12\x02\x03\x01\x13\x02\x04\x01\x14\x01\x04\x01\f\x01\x05\x01\r\x01\x04\x02\x0e\x00\x04\x02\x04\x00\x05\x02\x05\x00\x04\x03\x04\x01\x04\x03\x0e\x01\x05\x03\x0f\x01\x02\x04\n\x01\x03\x04\v\x01\x04\x04\f\x02\x04\x04\x14\x02\x05\x04\x15\x00\x05\x05\x05\x01\x05\x05\x0f\x01\x04\x05\x0e\x01\x05\x06\r\x01\x04\x06\f\x01\x03\x06\v\x02\x03\x06\x13\x02\x02\x06\x12\x01\x05\a\x0f\x00\x05\a\x05\x00\x04\a\x04\x02\x06\x00\x16\x02\x05\x00\x15\x02\x04\x00\x14\x01\x04\x00\f\x01\x03\x00\v\x02\x03\x01\x13\x02\x04\x01\x14\x02\x05\x01\x15\x01\x05\x01\r\x01\x06\x01\x0e\x01\x05\x02\x0f\x00\x05\x02\x05\x00\x06\x02\x06\x00\x05\x03\x05\x01\x05\x03\x0f\x01\x06\x03\x10\x01\x03\x04\v\x01\x04\x04\f\x01\x05\x04\r\x02\x05\x04\x15\x02\x06\x04\x16\x00\x06\x05\x06\x01\x06\x05\x10\x01\x05\x05\x0f\x01\x06\x06\x0e\x01\x05\x06\r\x01\x04\x06\f\x02\x04\x06\x14\x02\x03\x06\x13\x01\x06\a\x10\x00\x06\a\x06\x00\x05\a\x05\x02\a\x00\x17\x02\x06\x00\x16\x02\x05\x00\x15\x01\x05\x00\r\x01\x04\x00\f\x02\x04\x01\x14\x02\x05\x01\x15\x02\x06\x01\x16\x01\x06\x01\x0e\x01\a\x01\x0f\x01\x06\x02\x10\x00\x06\x02\x06\x00\a\x02\a\x00\x06\x03\x06\x01\x06\x03\x10\x01\a\x03\x11\x01\x04\x04\f\x01\x05\x04\r\x01\x06\x04\x0e\x02\x06\x04\x16\x02\a\x04\x17\x00\a\x05\a\x01\a\x05\x11\x01\x06\x05\x10\x01\a\x06\x0f\x01\x06\x06\x0e\x01\x05\x06\r\x02\x05\x06\x15\x02\x04\x06\x14\x01\a\a\x11\x00\a\a\a\x00\x06\a\x06\x02\a\x00\x17\x02\x06\x00\x16\x01\x06\x00\x0e\x01\x05\x00\r\x02\x05\x01\x15\x02\x06\x01\x16\x02\a\x01\x17\x01\a\x01\x0f\x01\a\x02\x11\x00\a\x02\a\x00\b\x02\b\x00\a\x03\a\x01\a\x03\x11\x01\b\x03\x12\x01\x05\x04\r\x01\x06\x04\x0e\x01\a\x04\x0f\x02\a\x04\x17\x00\b\x05\b\x01\b\x05\x12\x01\a\x05\x11\x01\a\x06\x0f\x01\x06\x06\x0e\x02\x06\x06\x16\x02\x05\x06\x15\x01\b\a\x12\x00\b\a\b\x00\a\a\a\x02\a\x00\x17\x01\a\x00\x0f\x01\x06\x00\x0e\x02\x06\x01\x16\x02\a\x01\x17\x01\b\x02\x12\x00\b\x02\b\x00\t\x02\t\x00\b\x03\b\x01\b\x03\x12\x01\t\x03\x13\x01\x06\x04\x0e\x01\a\x04\x0f\x00\t\x05\t\x01\t\x05\x13\x01\b\x05\x12\x01\a\x06\x0f\x02\a\x06\x17\x02\x06\x06\x16\x01\t\a\x13\x00\t\a\t\x00\b\a\b\x01\a\x00\x0f\x02\a\x01\x17\x01\t\x02\x13\x00\t\x02\t\x00\t\x03\t\x01\t\x03\x13\x01\a\x04\x0f\x01\t\x05\x13\x02\a\x06\x17\x00\t\a\t\x03\x00\x00\x18\x02\x00\x01\x10\x01\x00\x02\n\x00\x00\x02\x00\x03\x00\x04\x18\x00\x00\x05\x00\x01\x00\x05\n\x02\x00\x06\x10\x01\x00\a\n\x03\x01\x00\x19\x03\x00\x00\x18\x03\x00\x01\x18\x02\x00\x01\x10\x02\x01\x01\x11\x01\x00\x02\n\x01\x01\x02\v\x00\x01\x02\x01\x00\x00\x03\x00\x01\x00\x03\n\x02\x00\x04\x10\x03\x00\x04\x18\x03\x01\x04\x19\x00\x01\x05\x01\x01\x01\x05\v\x02\x01\x06\x11\x02\x00\x06\x10\x01\x01\a\v\x01\x00\a\n\x00\x00\a\x00\x03\x02\x00\x1a\x03\x01\x00\x19\x03\x00\x00\x18\x02\x00\x00\x10\x03\x00\x01\x18\x03\x01\x01\x19\x02\x01\x01\x11\x02\x02\x01\x12\x01\x01\x02\v\x01\x02\x02\f\x00\x02\x02\x02\x00\x01\x03\x01\x01\x01\x03\v\x02\x00\x04\x10\x02\x01\x04\x11\x03\x01\x04\x19\x03\x02\x04\x1a\x00\x02\x05\x02\x01\x02\x05\f\x02\x02\x06\x12\x02\x01\x06\x11\x02\x00\x06\x10\x03\x00\x06\x18\x01\x02\a\f\x01\x01\a\v\x00\x01\a\x01\x03\x03\x00\x1b\x03\x02\x00\x1a\x03\x01\x00\x19\x02\x01\x00\x11\x02\x00\x00\x10\x03\x00\x01\x18\x03\x01\x01\x19\x03\x02\x01\x1a\x02\x02\x01\x12\x02\x03\x01\x13\x01\x02\x02\f\x01\x03\x02\r\x00\x03\x02\x03\x00\x02\x03\x02\x01\x02\x03\f\x02\x00\x04\x10\x02\x01\x04\x11\x02\x02\x04\x12\x03\x02\x04\x1a\x03\x03\x04\x1b\x00\x03\x05\x03\x01\x03\x05\r\x02\x03\x06\x13\x02\x02\x06\x12\x02\x01\x06\x11\x03\x01\x06\x19\x03\x00\x06\x18\x01\x03\a\r\x01\x02\a\f\x00\x02\a\x02\x03\x04\x00\x1c\x03\x03\x00\x1b\x03\x02\x00\x1a\x02\x02\x00\x12\x02\x01\x00\x11\x03\x01\x01\x19\x03\x02\x01\x1a\x03\x03\x01\x1b\x02\x03\x01\x13\x02\x04\x01\x14\x01\x03\x02\r\x01\x04\x02\x0e\x00\x04\x02\x04\x00\x03\x03\x03\x01\x03\x03\r\x02\x01\x04\x11\x02\x02\x04\x12\x02\x03\x04\x13\x03\x03\x04\x1b\x03\x04\x04\x1c\x00\x04\x05\x04\x01\x04\x05\x0e\x02\x04\x06\x14\x02\x03\x06\x13\x02\x02\x06\x12\x03\x02\x06\x1a\x03\x01\x06\x19\x01\x04\a\x0e\x01\x03\a\r\x00\x03\a\x03\x03\x05\x00\x1d\x03\x04\x00\x1c\x03\x03\x00\x1b\x02\x03\x00\x13\x02\x02\x00\x12\x03\x02\x01\x1a\x03\x03\x01\x1b\x03\x04\x01\x1c\x02\x04\x01\x14\x02\x05\x01\x15\x01\x04\x02\x0e\x01\x05\x02\x0f\x00\x05\x02\x05\x00\x04\x03\x04\x01\x04\x03\x0e\x02\x02\x04\x12\x02\x03\x04\x13\x02\x04\x04\x14\x03\x04\x04\x1c\x03\x05\x04\x1d\x00\x05\x05\x05\x01\x05\x05\x0f\x02\x05\x06\x15\x02\x04\x06\x14\x02\x03\x06\x13\x03\x03\x06\x1b\x03\x02\x06\x1a\x01\x05\a\x0f\x01\x04\a\x0e\x00\x04\a\x04\x03\x06\x00\x1e\x03\x05\x00\x1d\x03\x04\x00\x1c\x02\x04\x00\x14\x02\x03\x00\x13\x03\x03\x01\x1b\x03\x04\x01\x1c\x03\x05\x01\x1d\x02\x05\x01\x15\x02\x06\x01\x16\x01\x05\x02\x0f\x01\x06\x02\x10\x00\x06\x02\x06\x00\x05\x03\x05\x01\x05\x03\x0f\x02\x03\x04\x13\x02\x04\x04\x14\x02\x05\x04\x15\x03\x05\x04\x1d\x03\x06\x04\x1e\x00\x06\x05\x06\x01\x06\x05\x10\x02\x06\x06\x16\x02\x05\x06\x15\x02\x04\x06\x14\x03\x04\x06\x1c\x03\x03\x06\x1b\x01\x06\a\x10\x01\x05\a\x0f\x00\x05\a\x05\x03\a\x00\x1f\x03\x06\x00\x1e\x03\x05\x00\x1d\x02\x05\x00\x15\x02\x04\x00\x14\x03\x04\x01\x1c\x03\x05\x01\x1d\x03\x06\x01\x1e\x02\x06\x01\x16\x02\a\x01\x17\x01\x06\x02\x10\x01\a\x02\x11\x00\a\x02\a\x00\x06\x03\x06\x01\x06\x03\x10\x02\x04\x04\x14\x02\x05\x04\x15\x02\x06\x04\x16\x03\x06\x04\x1e\x03\a\x04\x1f\x00\a\x05\a\x01\a\x05\x11\x02\a\x06\x17\x02\x06\x06\x16\x02\x05\x06\x15\x03\x05\x06\x1d\x03\x04\x06\x1c\x01\a\a\x11\x01\x06\a\x10\x00\x06\a\x06\x03\a\x00\x1f\x03\x06\x00\x1e\x02\x06\x00\x16\x02\x05\x00\x15\x03\x05\x01\x1d\x03\x06\x01\x1e\x03\a\x01\x1f\x02\a\x01\x17\x01\a\x02\x11\x01\b\x02\x12\x00\b\x02\b\x00\a\x03\a\x01\a\x03\x11\x02\x05\x04\x15\x02\x06\x04\x16\x02\a\x04\x17\x03\a\x04\x1f\x00\b\x05\b\x01\b\x05\x12\x02\a\x06\x17\x02\x06\x06\x16\x03\x06\x06\x1e\x03\x05\x06\x1d\x01\b\a\x12\x01\a\a\x11\x00\a\a\a\x03\a\x00\x1f\x02\a\x00\x17\x02\x06\x00\x16\x03\x06\x01\x1e\x03\a\x01\x1f\x01\b\x02\x12\x01\t\x02\x13\x00\t\x02\t\x00\b\x03\b\x01\b\x03\x12\x02\x06\x04\x16\x02\a\x04\x17\x00\t\x05\t\x01\t\x05\x13\x02\a\x06\x17\x03\a\x06\x1f\x03\x06\x06\x1e\x01\t\a\x13\x01\b\a\x12\x00\b\a\b\x02\a\x00\x17\x03\a\x01\x1f\x01\t\x02\x13\x00\t\x03\t\x01\t\x03\x13\x02\a\x04\x17\x03\a\x06\x1f\x01\t\a\x13\x00\t\a\t\x03\x00\x01\x18\x01\x00\x02\n\x01\x00\x05\n\x03\x00\x06\x18\x03\x00\x01\x18\x03\x01\x01\x19\x01\x01\x02\v\x01\x00\x03\n\x03\x00\x04\x18\x01\x01\x05\v\x03\x01\x06\x19\x03\x00\x06\x18\x01\x00\a\n\x03\x00\x00\x18\x03\x01\x01\x19\x03\x02\x01\x1a\x01\x02\x02\f\x01\x01\x03\v\x03\x00\x04\x18\x03\x01\x04\x19\x01\x02\x05\f\x03\x02\x06\x1a\x03\x01\x06\x19\x03\x00\x06\x18\x01\x01\a\v\x03\x01\x00\x19\x03\x00\x00\x18\x03\x02\x01\x1a\x03\x03\x01\x1b\x01\x03\x02\r\x01\x02\x03\f\x03\x00\x04\x18\x03\x01\x04\x19\x03\x02\x04\x1a\x01\x03\x05\r\x03\x03\x06\x1b\x03\x02\x06\x1a\x03\x01\x06\x19\x01\x02\a\f\x03\x02\x00\x1a\x03\x01\x00\x19\x03\x03\x01\x1b\x03\x04\x01\x1c\x01\x04\x02\x0e\x01\x03\x03\r\x03\x01\x04\x19\x03\x02\x04\x1a\x03\x03\x04\x1b\x01\x04\x05\x0e\x03\x04\x06\x1c\x03\x03\x06\x1b\x03\x02\x06\x1a\x01\x03\a\r\x03\x03\x00\x1b\x03\x02\x00\x1a\x03\x04\x01\x1c\x03\x05\x01\x1d\x01\x05\x02\x0f\x01\x04\x03\x0e\x03\x02\x04\x1a\x03\x03\x04\x1b\x03\x04\x04\x1c\x01\x05\x05\x0f\x03\x05\x06\x1d\x03\x04\x06\x1c\x03\x03\x06\x1b\x01\x04\a\x0e\x03\x04\x00\x1c\x03\x03\x00\x1b\x03\x05\x01\x1d\x03\x06\x01\x1e\x01\x06\x02\x10\x01\x05\x03\x0f\x03\x03\x04\x1b\x03\x04\x04\x1c\x03\x05\x04\x1d\x01\x06\x05\x10\x03\x06\x06\x1e\x03\x05\x06\x1d\x03\x04\x06\x1c\x01\x05\a\x0f\x03\x05\x00\x1d\x03\x04\x00\x1c\x03\x06\x01\x1e\x03\a\x01\x1f\x01\a\x02\x11\x01\x06\x03\x10\x03\x04\x04\x1c\x03\x05\x04\x1d\x03\x06\x04\x1e\x01\a\x05\x11\x03\a\x06\x1f\x03\x06\x06\x1e\x03\x05\x06\x1d\x01\x06\a\x10\x03\x06\x00\x1e\x03\x05\x00\x1d\x03\a\x01\x1f\x01\b\x02\x12\x01\a\x03\x11\x03\x05\x04\x1d\x03\x06\x04\x1e\x03\a\x04\x1f\x01\b\x05\x12\x03\a\x06\x1f\x03\x06\x06\x1e\x01\a\a\x11\x03\a\x00\x1f\x03\x06\x00\x1e\x01\t\x02\x13\x01\b\x03\x12\x03\x06\x04\x1e\x03\a\x04\x1f\x01\t\x05\x13\x03\a\x06\x1f\x01\b\a\x12\x03\a\x00\x1f\x01\t\x03\x13\x03\a\x04\x1f\x01\t\a\x13",
+		start: []int32{0, 4, 13, 25, 39, 53, 67, 81, 95, 107, 116, 120, 129, 149, 175, 205, 235, 265, 295, 325, 351, 371, 380, 390, 412, 440, 472, 504, 536, 568, 600, 628, 650, 660, 669, 689, 715, 745, 775, 805, 835, 865, 891, 911, 920, 924, 933, 945, 959, 973, 987, 1001, 1015, 1027, 1036, 1040},
+	},
+	{rows: 5, cols: 11, cells: "0,0;0,1;1,0;", sym: false, n: 160,
+		versions: []generatedVersion{
+			{lo: Pos{0, 0}, n: Pos{4, 10}, masks: []uint64{0x803, 0x1006, 0x200c, 0x4018, 0x8030, 0x10060, 0x200c0, 0x40180, 0x80300, 0x100600, 0x401800, 0x803000, 0x1006000, 0x200c000, 0x4018000, 0x8030000, 0x10060000, 0x200c0000, 0x40180000, 0x80300000, 0x200c00000, 0x401800000, 0x803000000, 0x1006000000, 0x200c000000, 0x4018000000, 0x8030000000, 0x10060000000, 0x200c0000000, 0x40180000000, 0x100600000000, 0x200c00000000, 0x401800000000, 0x803000000000, 0x1006000000000, 0x200c000000000, 0x4018000000000, 0x8030000000000, 0x10060000000000, 0x200c0000000000}},
+			{lo: Pos{0, 0}, n: Pos{4, 10}, masks: []uint64{0x1003, 0x2006, 0x400c, 0x8018, 0x10030, 0x20060, 0x400c0, 0x80180, 0x100300, 0x200600, 0x801800, 0x1003000, 0x2006000, 0x400c000, 0x8018000, 0x10030000, 0x20060000, 0x400c0000, 0x80180000, 0x100300000, 0x400c00000, 0x801800000, 0x1003000000, 0x2006000000, 0x400c000000, 0x8018000000, 0x10030000000, 0x20060000000, 0x400c0000000, 0x80180000000, 0x200600000000, 0x400c00000000, 0x801800000000, 0x1003000000000, 0x2006000000000, 0x400c000000000, 0x8018000000000, 0x10030000000000, 0x20060000000000, 0x400c0000000000}},
+			{lo: Pos{0, 0}, n: Pos{4, 10}, masks: []uint64{0x1802, 0x3004, 0x6008, 0xc010, 0x18020, 0x30040, 0x60080, 0xc0100, 0x180200, 0x300400, 0xc01000, 0x1802000, 0x3004000, 0x6008000, 0xc010000, 0x18020000, 0x30040000, 0x60080000, 0xc0100000, 0x180200000, 0x600800000, 0xc01000000, 0x1802000000, 0x3004000000, 0x6008000000, 0xc010000000, 0x18020000000, 0x30040000000, 0x60080000000, 0xc0100000000, 0x300400000000, 0x600800000000, 0xc01000000000, 0x1802000000000, 0x3004000000000, 0x6008000000000, 0xc010000000000, 0x18020000000000, 0x30040000000000, 0x60080000000000}},
+			{lo: Pos{0, 0}, n: Pos{4, 10}, masks: []uint64{0x1801, 0x3002, 0x6004, 0xc008, 0x18010, 0x30020, 0x60040, 0xc0080, 0x180100, 0x300200, 0xc00800, 0x1801000, 0x3002000, 0x6004000, 0xc008000, 0x18010000, 0x30020000, 0x60040000, 0xc0080000, 0x180100000, 0x600400000, 0xc00800000, 0x1801000000, 0x3002000000, 0x6004000000, 0xc008000000, 0x18010000000, 0x30020000000, 0x60040000000, 0xc0080000000, 0x300200000000, 0x600400000000, 0xc00800000000, 0x1801000000000, 0x3002000000000, 0x6004000000000, 0xc008000000000, 0x18010000000000, 0x30020000000000, 0x60040000000000}},
+		},
+		spots: "\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x02\x00\x00\x00\x03\x00\x00\x01\x00\x01\x00\x01\x01\x01\x00\x01\x02\x01\x00\x01\x03\x01\x00\x02\x00\x02\x00\x02\x01\x02\x00\x02\x02\x02\x00\x02\x03\x02\x00\x03\x00\x03\x00\x03\x01\x03\x00\x03\x02\x03\x00\x03\x03\x03\x00\x04\x00\x04\x00\x04\x01\x04\x00\x04\x02\x04\x00\x04\x03\x04\x00\x05\x00\x05\x00\x05\x01\x05\x00\x05\x02\x05\x00\x05\x03\x05\x00\x06\x00\x06\x00\x06\x01\x06\x00\x06\x02\x06\x00\x06\x03\x06\x00\a\x00\a\x00\a\x01\a\x00\a\x02\a\x00\a\x03\a\x00\b\x00\b\x00\b\x01\b\x00\b\x02\b\x00\b\x03\b\x00\t\x00\t\x00\t\x01\t\x00\t\x02\t\x00\t\x03\t\x01\x00\x00\n\x01\x00\x01\n\x01\x00\x02\n\x01\x00\x03\n\x01\x01\x00\v\x01\x01\x01\v\x01\x01\x02\v\x01\x01\x03\v\x01\x02\x00\f\x01\x02\x01\f\x01\x02\x02\f\x01\x02\x03\f\x01\x03\x00\r\x01\x03\x01\r\x01\x03\x02\r\x01\x03\x03\r\x01\x04\x00\x0e\x01\x04\x01\x0e\x01\x04\x02\x0e\x01\x04\x03\x0e\x01\x05\x00\x0f\x01\x05\x01\x0f\x01\x05\x02\x0f\x01\x05\x03\x0f\x01\x06\x00\x10\x01\x06\x01\x10\x01\x06\x02\x10\x01\x06\x03\x10\x01\a\x00\x11\x01\a\x01\x11\x01\a\x02\x11\x01\a\x03\x11\x01\b\x00\x12\x01\b\x01\x12\x01\b\x02\x12\x01\b\x03\x12\x01\t\x00\x13\x01\t\x01\x13\x01\t\x02\x13\x01\t\x03\x13\x02\x00\x00\x14\x02\x00\x01\x14\x02\x00\x02\x14\x02\x00\x03\x14\x02\x01\x00\x15\x02\x01\x01\x15\x02\x01\x02\x15\x02\x01\x03\x15\x02\x02\x00\x16\x02\x02\x01\x16\x02\x02\x02\x16\x02\x02\x03\x16\x02\x03\x00\x17\x02\x03\x01\x17\x02\x03\x02\x17\x02\x03\x03\x17\x02\x04\x00\x18\x02\x04\x01\x18\x02\x04\x02\x18\x02\x04\x03\x18\x02\x05\x00\x19\x02\x05\x01\x19\x02\x05\x02\x19\x02\x05\x03\x19\x02\x06\x00\x1a\x02\x06\x01\x1a\x02\x06\x02\x1a\x02\x06\x03\x1a\x02\a\x00\x1b\x02\a\x01\x1b\x02\a\x02\x1b\x02\a\x03\x1b\x02\b\x00\x1c\x02\b\x01\x1c\x02\b\x02\x1c\x02\b\x03\x1c\x02\t\x00\x1d\x02\t\x01\x1d\x02\t\x02\x1d\x02\t\x03\x1d\x03\x00\x00\x1e\x03\x00\x01\x1e\x03\x00\x02\x1e\x03\x00\x03\x1e\x03\x01\x00\x1f\x03\x01\x01\x1f\x03\x01\x02\x1f\x03\x01\x03\x1f\x03\x02\x00 \x03\x02\x01 \x03\x02\x02 \x03\x02\x03 \x03\x03\x00!\x03\x03\x01!\x03\x03\x02!\x03\x03\x03!\x03\x04\x00\"\x03\x04\x01\"\x03\x04\x02\"\x03\x04\x03\"\x03\x05\x00#\x03\x05\x01#\x03\x05\x02#\x03\x05\x03#\x03\x06\x00$\x03\x06\x01$\x03\x06\x02$\x03\x06\x03$\x03\a\x00%\x03\a\x01%\x03\a\x02%\x03\a\x03%\x03\b\x00&\x03\b\x01&\x03\b\x02&\x03\b\x03&\x03\t\x00'\x03\t\x01'\x03\t\x02'\x03\t\x03'",
+		cover: "\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x03\x00\x00\x01\x00\x01\x00\x00\x00\x00\x00\x00\x01\x00\x00\x01\x01\x01\x00\x00\x02\x00\x00\x01\x03\x01\x00\x02\x00\x02\x00\x01\x00\x01\x00\x01\x01\x01\x00\x02\x01\x02\x00\x01\x02\x01\x00\x02\x03\x02\x00\x03\x00\x03\x00\x02\x00\x02\x00\x02\x01\x02\x00\x03\x01\x03\x00\x02\x02\x02\x00\x03\x03\x03\x00\x04\x00\x04\x00\x03\x00\x03\x00\x03\x01\x03\x00\x04\x01\x04\x00\x03\x02\x03\x00\x04\x03\x04\x00\x05\x00\x05\x00\x04\x00\x04\x00\x04\x01\x04\x00\x05\x01\x05\x00\x04\x02\x04\x00\x05\x03\x05\x00\x06\x00\x06\x00\x05\x00\x05\x00\x05\x01\x05\x00\x06\x01\x06\x00\x05\x02\x05\x00\x06\x03\x06\x00\a\x00\a\x00\x06\x00\x06\x00\x06\x01\x06\x00\a\x01\a\x00\x06\x02\x06\x00\a\x03\a\x00\b\x00\b\x00\a\x00\a\x00\a\x01\a\x00\b\x01\b\x00\a\x02\a\x00\b\x03\b\x00\t\x00\t\x00\b\x00\b\x00\b\x01\b\x00\t\x01\t\x00\b\x02\b\x00\t\x03\t\x00\t\x00\t\x00\t\x01\t\x00\t\x02\t\x01\x00\x00\n\x00\x00\x00\x00\x01\x00\x01\n\x00\x00\x02\x00\x00\x00\x03\x00\x01\x00\x03\n\x01\x01\x00\v\x01\x00\x00\n\x00\x01\x00\x01\x01\x00\x01\n\x01\x01\x01\v\x00\x00\x01\x00\x00\x00\x02\x00\x01\x00\x02\n\x00\x01\x02\x01\x00\x01\x03\x01\x01\x01\x03\v\x00\x00\x03\x00\x01\x02\x00\f\x01\x01\x00\v\x00\x02\x00\x02\x01\x01\x01\v\x01\x02\x01\f\x00\x01\x01\x01\x00\x01\x02\x01\x01\x01\x02\v\x00\x02\x02\x02\x00\x02\x03\x02\x01\x02\x03\f\x00\x01\x03\x01\x01\x03\x00\r\x01\x02\x00\f\x00\x03\x00\x03\x01\x02\x01\f\x01\x03\x01\r\x00\x02\x01\x02\x00\x02\x02\x02\x01\x02\x02\f\x00\x03\x02\x03\x00\x03\x03\x03\x01\x03\x03\r\x00\x02\x03\x02\x01\x04\x00\x0e\x01\x03\x00\r\x00\x04\x00\x04\x01\x03\x01\r\x01\x04\x01\x0e\x00\x03\x01\x03\x00\x03\x02\x03\x01\x03\x02\r\x00\x04\x02\x04\x00\x04\x03\x04\x01\x04\x03\x0e\x00\x03\x03\x03\x01\x05\x00\x0f\x01\x04\x00\x0e\x00\x05\x00\x05\x01\x04\x01\x0e\x01\x05\x01\x0f\x00\x04\x01\x04\x00\x04\x02\x04\x01\x04\x02\x0e\x00\x05\x02\x05\x00\x05\x03\x05\x01\x05\x03\x0f\x00\x04\x03\x04\x01\x06\x00\x10\x01\x05\x00\x0f\x00\x06\x00\x06\x01\x05\x01\x0f\x01\x06\x01\x10\x00\x05\x01\x05\x00\x05\x02\x05\x01\x05\x02\x0f\x00\x06\x02\x06\x00\x06\x03\x06\x01\x06\x03\x10\x00\x05\x03\x05\x01\a\x00\x11\x01\x06\x00\x10\x00\a\x00\a\x01\x06\x01\x10\x01\a\x01\x11\x00\x06\x01\x06\x00\x06\x02\x06\x01\x06\x02\x10\x00\a\x02\a\x00\a\x03\a\x01\a\x03\x11\x00\x06\x03\x06\x01\b\x00\x12\x01\a\x00\x11\x00\b\x00\b\x01\a\x01\x11\x01\b\x01\x12\x00\a\x01\a\x00\a\x02\a\x01\a\x02\x11\x00\b\x02\b\x00\b\x03\b\x01\b\x03\x12\x00\a\x03\a\x01\t\x00\x13\x01\b\x00\x12\x00\t\x00\t\x01\b\x01\x12\x01\t\x01\x13\x00\b\x01\b\x00\b\x02\b\x01\b\x02\x12\x00\t\x02\t\x00\t\x03\t\x01\t\x03\x13\x00\b\x03\b\x01\t\x00\x13\x01\t\x01\x13\x00\t\x01\t\x00\t\x02\t\x01\t\x02\x13\x00\t\x03\t\x02\x00\x00\x14\x01\x00\x00\n\x02\x00\x01\x14\x01\x00\x02\n\x01\x00\x03\n\x02\x00\x03\x14\x02\x01\x00\x15\x02\x00\x00\x14\x01\x01\x00\v\x02\x00\x01\x14\x02\x01\x01\x15\x01\x00\x01\n\x01\x00\x02\n\x02\x00\x02\x14\x01\x01\x02\v\x01\x01\x03\v\x02\x01\x03\x15\x01\x00\x03\n\x02\x02\x00\x16\x02\x01\x00\x15\x01\x02\x00\f\x02\x01\x01\x15\x02\x02\x01\x16\x01\x01\x01\v\x01\x01\x02\v\x02\x01\x02\x15\x01\x02\x02\f\x01\x02\x03\f\x02\x02\x03\x16\x01\x01\x03\v\x02\x03\x00\x17\x02\x02\x00\x16\x01\x03\x00\r\x02\x02\x01\x16\x02\x03\x01\x17\x01\x02\x01\f\x01\x02\x02\f\x02\x02\x02\x16\x01\x03\x02\r\x01\x03\x03\r\x02\x03\x03\x17\x01\x02\x03\f\x02\x04\x00\x18\x02\x03\x00\x17\x01\x04\x00\x0e\x02\x03\x01\x17\x02\x04\x01\x18\x01\x03\x01\r\x01\x03\x02\r\x02\x03\x02\x17\x01\x04\x02\x0e\x01\x04\x03\x0e\x02\x04\x03\x18\x01\x03\x03\r\x02\x05\x00\x19\x02\x04\x00\x18\x01\x05\x00\x0f\x02\x04\x01\x18\x02\x05\x01\x19\x01\x04\x01\x0e\x01\x04\x02\x0e\x02\x04\x02\x18\x01\x05\x02\x0f\x01\x05\x03\x0f\x02\x05\x03\x19\x01\x04\x03\x0e\x02\x06\x00\x1a\x02\x05\x00\x19\x01\x06\x00\x10\x02\x05\x01\x19\x02\x06\x01\x1a\x01\x05\x01\x0f\x01\x05\x02\x0f\x02\x05\x02\x19\x01\x06\x02\x10\x01\x06\x03\x10\x02\x06\x03\x1a\x01\x05\x03\x0f\x02\a\x00\x1b\x02\x06\x00\x1a\x01\a\x00\x11\x02\x06\x01\x1a\x02\a\x01\x1b\x01\x06\x01\x10\x01\x06\x02\x10\x02\x06\x02\x1a\x01\a\x02\x11\x01\a\x03\x11\x02\a\x03\x1b\x01\x06\x03\x10\x02\b\x00\x1c\x02\a\x00\x1b\x01\b\x00\x12\x02\a\x01\x1b\x02\b\x01\x1c\x01\a\x01\x11\x01\a\x02\x11\x02\a\x02\x1b\x01\b\x02\x12\x01\b\x03\x12\x02\b\x03\x1c\x01\a\x03\x11\x02\t\x00\x1d\x02\b\x00\x1c\x01\t\x00\x13\x02\b\x01\x1c\x02\t\x01\x1d\x01\b\x01\x12\x01\b\x02\x12\x02\b\x02\x1c\x01\t\x02\x13\x01\t\x03\x13\x02\t\x03\x1d\x01\b\x03\x12\x02\t\x00\x1d\x02\t\x01\x1d\x01\t\x01\x13\x01\t\x02\x13\x02\t\x02\x1d\x01\t\x03\x13\x03\x00\x00\x1e\x02\x00\x00\x14\x03\x00\x01\x1e\x02\x00\x02\x14\x02\x00\x03\x14\x03\x00\x03\x1e\x03\x01\x00\x1f\x03\x00\x00\x1e\x02\x01\x00\x15\x03\x00\x01\x1e\x03\x01\x01\x1f\x02\x00\x01\x14\x02\x00\x02\x14\x03\x00\x02\x1e\x02\x01\x02\x15\x02\x01\x03\x15\x03\x01\x03\x1f\x02\x00\x03\x14\x03\x02\x00 \x03\x01\x00\x1f\x02\x02\x00\x16\x03\x01\x01\x1f\x03\x02\x01 \x02\x01\x01\x15\x02\x01\x02\x15\x03\x01\x02\x1f\x02\x02\x02\x16\x02\x02\x03\x16\x03\x02\x03 \x02\x01\x03\x15\x03\x03\x00!\x03\x02\x00 \x02\x03\x00\x17\x03\x02\x01 \x03\x03\x01!\x02\x02\x01\x16\x02\x02\x02\x16\x03\x02\x02 \x02\x03\x02\x17\x02\x03\x03\x17\x03\x03\x03!\x02\x02\x03\x16\x03\x04\x00\"\x03\x03\x00!\x02\x04\x00\x18\x03\x03\x01!\x03\x04\x01\"\x02\x03\x01\x17\x02\x03\x02\x17\x03\x03\x02!\x02\x04\x02\x18\x02\x04\x03\x18\x03\x04\x03\"\x02\x03\x03\x17\x03\x05\x00#\x03\x04\x00\"\x02\x05\x00\x19\x03\x04\x01\"\x03\x05\x01#\x02\x04\x01\x18\x02\x04\x02\x18\x03\x04\x02\"\x02\x05\x02\x19\x02\x05\x03\x19\x03\x05\x03#\x02\x04\x03\x18\x03\x06\x00$\x03\x05\x00#\x02\x06\x00\x1a\x03\x05\x01#\x03\x06\x01$\x02\x05\x01\x19\x02\x05\x02\x19\x03\x05\x02#\x02\x06\x02\x1a\x02\x06\x03\x1a\x03\x06\x03$\x02\x05\x03\x19\x03\a\x00%\x03\x06\x00$\x02\a\x00\x1b\x03\x06\x01$\x03\a\x01%\x02\x06\x01\x1a\x02\x06\x02\x1a\x03\x06\x02$\x02\a\x02\x1b\x02\a\x03\x1b\x03\a\x03%\x02\x06\x03\x1a\x03\b\x00&\x03\a\x00%\x02\b\x00\x1c\x03\a\x01%\x03\b\x01&\x02\a\x01\x1b\x02\a\x02\x1b\x03\a\x02%\x02\b\x02\x1c\x02\b\x03\x1c\x03\b\x03&\x02\a\x03\x1b\x03\t\x00'\x03\b\x00&\x02\t\x00\x1d\x03\b\x01&\x03\t\x01'\x02\b\x01\x1c\x02\b\x02\x1c\x03\b\x02&\x02\t\x02\x1d\x02\t\x03\x1d\x03\t\x03'\x02\b\x03\x1c\x03\t\x00'\x03\t\x01'\x02\t\x01\x1d\x02\t\x02\x1d\x03\t\x02'\x02\t\x03\x1d\x03\x00\x00\x1e\x03\x00\x02\x1e\x03\x00\x03\x1e\x03\x01\x00\x1f\x03\x00\x01\x1e\x03\x00\x02\x1e\x03\x01\x02\x1f\x03\x01\x03\x1f\x03\x00\x03\x1e\x03\x02\x00 \x03\x01\x01\x1f\x03\x01\x02\x1f\x03\x02\x02 \x03\x02\x03 \x03\x01\x03\x1f\x03\x03\x00!\x03\x02\x01 \x03\x02\x02 \x03\x03\x02!\x03\x03\x03!\x03\x02\x03 \x03\x04\x00\"\x03\x03\x01!\x03\x03\x02!\x03\x04\x02\"\x03\x04\x03\"\x03\x03\x03!\x03\x05\x00#\x03\x04\x01\"\x03\x04\x02\"\x03\x05\x02#\x03\x05\x03#\x03\x04\x03\"\x03\x06\x00$\x03\x05\x01#\x03\x05\x02#\x03\x06\x02$\x03\x06\x03$\x03\x05\x03#\x03\a\x00%\x03\x06\x01$\x03\x06\x02$\x03\a\x02%\x03\a\x03%\x03\x06\x03$\x03\b\x00&\x03\a\x01%\x03\a\x02%\x03\b\x02&\x03\b\x03&\x03\a\x03%\x03\t\x00'\x03\b\x01&\x03\b\x02&\x03\t\x02'\x03\t\x03'\x03\b\x03&\x03\t\x01'\x03\t\x02'\x03\t\x03'",
+		start: []int32{0, 3, 9, 15, 21, 27, 33, 39, 45, 51, 57, 60, 66, 78, 90, 102, 114, 126, 138, 150, 162, 174, 180, 186, 198, 210, 222, 234, 246, 258, 270, 282, 294, 300, 306, 318, 330, 342, 354, 366, 378, 390, 402, 414, 420, 423, 429, 435, 441, 447, 453, 459, 465, 471, 477, 480},
+	},
+	{rows: 5, cols: 11, cells: "0,0;0,1;1,0;1,1;", sym: true, n: 40,
+		versions: []generatedVersion{
+			{lo: Pos{0, 0}, n: Pos{4, 10}, masks: []uint64{0x1803, 0x3006, 0x600c, 0xc018, 0x18030, 0x30060, 0x600c0, 0xc0180, 0x180300, 0x300600, 0xc01800, 0x1803000, 0x3006000, 0x600c000, 0xc018000, 0x18030000, 0x30060000, 0x600c0000, 0xc0180000, 0x180300000, 0x600c00000, 0xc01800000, 0x1803000000, 0x3006000000, 0x600c000000, 0xc018000000, 0x18030000000, 0x30060000000, 0x600c0000000, 0xc0180000000, 0x300600000000, 0x600c00000000, 0xc01800000000, 0x1803000000000, 0x3006000000000, 0x600c000000000, 0xc018000000000, 0x18030000000000, 0x30060000000000, 0x600c0000000000}},
+		},
+		spots: "\x00\x00\x00\x00\x00\x01\x00\x01\x00\x02\x00\x02\x00\x03\x00\x03\x00\x04\x00\x04\x00\x05\x00\x05\x00\x06\x00\x06\x00\a\x00\a\x00\b\x00\b\x00\t\x00\t\x01\x00\x00\n\x01\x01\x00\v\x01\x02\x00\f\x01\x03\x00\r\x01\x04\x00\x0e\x01\x05\x00\x0f\x01\x06\x00\x10\x01\a\x00\x11\x01\b\x00\x12\x01\t\x00\x13\x02\x00\x00\x14\x02\x01\x00\x15\x02\x02\x00\x16\x02\x03\x00\x17\x02\x04\x00\x18\x02\x05\x00\x19\x02\x06\x00\x1a\x02\a\x00\x1b\x02\b\x00\x1c\x02\t\x00\x1d\x03\x00\x00\x1e\x03\x01\x00\x1f\x03\x02\x00 \x03\x03\x00!\x03\x04\x00\"\x03\x05\x00#\x03\x06\x00$\x03\a\x00%\x03\b\x00&\x03\t\x00'",
+		cover: "\x00\x00\x00\x00\x00\x01\x00\x01\x00\x00\x00\x00\x00\x02\x00\x02\x00\x01\x00\x01\x00\x03\x00\x03\x00\x02\x00\x02\x00\x04\x00\x04\x00\x03\x00\x03\x00\x05\x00\x05\x00\x04\x00\x04\x00\x06\x00\x06\x00\x05\x00\x05\x00\a\x00\a\x00\x06\x00\x06\x00\b\x00\b\x00\a\x00\a\x00\t\x00\t\x00\b\x00\b\x00\t\x00\t\x01\x00\x00\n\x00\x00\x00\x00\x01\x01\x00\v\x01\x00\x00\n\x00\x01\x00\x01\x00\x00\x00\x00\x01\x02\x00\f\x01\x01\x00\v\x00\x02\x00\x02\x00\x01\x00\x01\x01\x03\x00\r\x01\x02\x00\f\x00\x03\x00\x03\x00\x02\x00\x02\x01\x04\x00\x0e\x01\x03\x00\r\x00\x04\x00\x04\x00\x03\x00\x03\x01\x05\x00\x0f\x01\x04\x00\x0e\x00\x05\x00\x05\x00\x04\x00\x04\x01\x06\x00\x10\x01\x05\x00\x0f\x00\x06\x00\x06\x00\x05\x00\x05\x01\a\x00\x11\x01\x06\x00\x10\x00\a\x00\a\x00\x06\x00\x06\x01\b\x00\x12\x01\a\x00\x11\x00\b\x00\b\x00\a\x00\a\x01\t\x00\x13\x01\b\x00\x12\x00\t\x00\t\x00\b\x00\b\x01\t\x00\x13\x00\t\x00\t\x02\x00\x00\x14\x01\x00\x00\n\x02\x01\x00\x15\x02\x00\x00\x14\x01\x01\x00\v\x01\x00\x00\n\x02\x02\x00\x16\x02\x01\x00\x15\x01\x02\x00\f\x01\x01\x00\v\x02\x03\x00\x17\x02\x02\x00\x16\x01\x03\x00\r\x01\x02\x00\f\x02\x04\x00\x18\x02\x03\x00\x17\x01\x04\x00\x0e\x01\x03\x00\r\x02\x05\x00\x19\x02\x04\x00\x18\x01\x05\x00\x0f\x01\x04\x00\x0e\x02\x06\x00\x1a\x02\x05\x00\x19\x01\x06\x00\x10\x01\x05\x00\x0f\x02\a\x00\x1b\x02\x06\x00\x1a\x01\a\x00\x11\x01\x06\x00\x10\x02\b\x00\x1c\x02\a\x00\x1b\x01\b\x00\x12\x01\a\x00\x11\x02\t\x00\x1d\x02\b\x00\x1c\x01\t\x00\x13\x01\b\x00\x12\x02\t\x00\x1d\x01\t\x00\x13\x03\x00\x00\x1e\x02\x00\x00\x14\x03\x01\x00\x1f\x03\x00\x00\x1e\x02\x01\x00\x15\x02\x00\x00\x14\x03\x02\x00 \x03\x01\x00\x1f\x02\x02\x00\x16\x02\x01\x00\x15\x03\x03\x00!\x03\x02\x00 \x02\x03\x00\x17\x02\x02\x00\x16\x03\x04\x00\"\x03\x03\x00!\x02\x04\x00\x18\x02\x03\x00\x17\x03\x05\x00#\x03\x04\x00\"\x02\x05\x00\x19\x02\x04\x00\x18\x03\x06\x00$\x03\x05\x00#\x02\x06\x00\x1a\x02\x05\x00\x19\x03\a\x00%\x03\x06\x00$\x02\a\x00\x1b\x02\x06\x00\x1a\x03\b\x00&\x03\a\x00%\x02\b\x00\x1c\x02\a\x00\x1b\x03\t\x00'\x03\b\x00&\x02\t\x00\x1d\x02\b\x00\x1c\x03\t\x00'\x02\t\x00\x1d\x03\x00\x00\x1e\x03\x01\x00\x1f\x03\x00\x00\x1e\x03\x02\x00 \x03\x01\x00\x1f\x03\x03\x00!\x03\x02\x00 \x03\x04\x00\"\x03\x03\x00!\x03\x05\x00#\x03\x04\x00\"\x03\x06\x00$\x03\x05\x00#\x03\a\x00%\x03\x06\x00$\x03\b\x00&\x03\a\x00%\x03\t\x00'\x03\b\x00&\x03\t\x00'",
+		start: []int32{0, 1, 3, 5, 7, 9, 11, 13, 15, 17, 19, 20, 22, 26, 30, 34, 38, 42, 46, 50, 54, 58, 60, 62, 66, 70, 74, 78, 82, 86, 90, 94, 98, 100, 102, 106, 110, 114, 118, 122, 126, 130, 134, 138, 140, 141, 143, 145, 147, 149, 151, 153, 155, 157, 159, 160},
+	},
+	{rows: 5, cols: 11, cells: "0,0;0,1;1,0;1,1;2,0;", sym: false, n: 264,
+		versions: []generatedVersion{
+			{lo: Pos{0, 0}, n: Pos{3, 10}, masks: []uint64{0x401803, 0x803006, 0x100600c, 0x200c018, 0x4018030, 0x8030060, 0x100600c0, 0x200c0180, 0x40180300, 0x80300600, 0x200c01800, 0x401803000, 0x803006000, 0x100600c000, 0x200c018000, 0x4018030000, 0x8030060000, 0x100600c0000, 0x200c0180000, 0x40180300000, 0x100600c00000, 0x200c01800000, 0x401803000000, 0x803006000000, 0x100600c000000, 0x200c018000000, 0x4018030000000, 0x8030060000000, 0x100600c0000000, 0x200c0180000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 10}, masks: []uint64{0x801803, 0x1003006, 0x200600c, 0x400c018, 0x8018030, 0x10030060, 0x200600c0, 0x400c0180, 0x80180300, 0x100300600, 0x400c01800, 0x801803000, 0x1003006000, 0x200600c000, 0x400c018000, 0x8018030000, 0x10030060000, 0x200600c0000, 0x400c0180000, 0x80180300000, 0x200600c00000, 0x400c01800000, 0x801803000000, 0x1003006000000, 0x200600c000000, 0x400c018000000, 0x8018030000000, 0x10030060000000, 0x200600c0000000, 0x400c0180000000}},
+			{lo: Pos{0, 0}, n: Pos{4, 9}, masks: []uint64{0x3007, 0x600e, 0xc01c, 0x18038, 0x30070, 0x600e0, 0xc01c0, 0x180380, 0x300700, 0x1803800, 0x3007000, 0x600e000, 0xc01c000, 0x18038000, 0x30070000, 0x600e0000, 0xc01c0000, 0x180380000, 0xc01c00000, 0x1803800000, 0x3007000000, 0x600e000000, 0xc01c000000, 0x18038000000, 0x30070000000, 0x600e0000000, 0xc01c0000000, 0x600e00000000, 0xc01c00000000, 0x1803800000000, 0x3007000000000, 0x600e000000000, 0xc01c000000000, 0x18038000000000, 0x30070000000000, 0x600e0000000000}},
+			{lo: Pos{0, 0}, n: Pos{4, 9}, masks: []uint64{0x3806, 0x700c, 0xe018, 0x1c030, 0x38060, 0x700c0, 0xe0180, 0x1c0300, 0x380600, 0x1c03000, 0x3806000, 0x700c000, 0xe018000, 0x1c030000, 0x38060000, 0x700c0000, 0xe0180000, 0x1c0300000, 0xe01800000, 0x1c03000000, 0x3806000000, 0x700c000000, 0xe018000000, 0x1c030000000, 0x38060000000, 0x700c0000000, 0xe0180000000, 0x700c00000000, 0xe01800000000, 0x1c03000000000, 0x3806000000000, 0x700c000000000, 0xe018000000000, 0x1c030000000000, 0x38060000000000, 0x700c0000000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 10}, masks: []uint64{0xc01802, 0x1803004, 0x3006008, 0x600c010, 0xc018020, 0x18030040, 0x30060080, 0x600c0100, 0xc0180200, 0x180300400, 0x600c01000, 0xc01802000, 0x1803004000, 0x3006008000, 0x600c010000, 0xc018020000, 0x18030040000, 0x30060080000, 0x600c0100000, 0xc0180200000, 0x300600800000, 0x600c01000000, 0xc01802000000, 0x1803004000000, 0x3006008000000, 0x600c010000000, 0xc018020000000, 0x18030040000000, 0x30060080000000, 0x600c0100000000}},
+			{lo: Pos{0, 0}, n: Pos{4, 9}, masks: []uint64{0x3803, 0x7006, 0xe00c, 0x1c018, 0x38030, 0x70060, 0xe00c0, 0x1c0180, 0x380300, 0x1c01800, 0x3803000, 0x7006000, 0xe00c000, 0x1c018000, 0x38030000, 0x70060000, 0xe00c0000, 0x1c0180000, 0xe00c00000, 0x1c01800000, 0x3803000000, 0x7006000000, 0xe00c000000, 0x1c018000000, 0x38030000000, 0x70060000000, 0xe00c0000000, 0x700600000000, 0xe00c00000000, 0x1c01800000000, 0x3803000000000, 0x7006000000000, 0xe00c000000000, 0x1c018000000000, 0x38030000000000, 0x70060000000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 10}, masks: []uint64{0xc01801, 0x1803002, 0x3006004, 0x600c008, 0xc018010, 0x18030020, 0x30060040, 0x600c0080, 0xc0180100, 0x180300200, 0x600c00800, 0xc01801000, 0x1803002000, 0x3006004000, 0x600c008000, 0xc018010000, 0x18030020000, 0x30060040000, 0x600c0080000, 0xc0180100000, 0x300600400000, 0x600c00800000, 0xc01801000000, 0x1803002000000, 0x3006004000000, 0x600c008000000, 0xc018010000000, 0x18030020000000, 0x30060040000000, 0x600c0080000000}},
+			{lo: Pos{0, 0}, n: Pos{4, 9}, masks: []uint64{0x1807, 0x300e, 0x601c, 0xc038, 0x18070, 0x300e0, 0x601c0, 0xc0380, 0x180700, 0xc03800, 0x1807000, 0x300e000, 0x601c000, 0xc038000, 0x18070000, 0x300e0000, 0x601c0000, 0xc0380000, 0x601c00000, 0xc03800000, 0x1807000000, 0x300e000000, 0x601c000000, 0xc038000000, 0x18070000000, 0x300e0000000, 0x601c0000000, 0x300e00000000, 0x601c00000000, 0xc03800000000, 0x1807000000000, 0x300e000000000, 0x601c000000000, 0xc038000000000, 0x18070000000000, 0x300e0000000000}},
+		},
+		spots: "\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x02\x00\x00\x00\x03\x00\x00\x00\x04\x00\x00\x00\x05\x00\x00\x00\x06\x00\x00\x00\a\x00\x00\x01\x00\x01\x00\x01\x01\x01\x00\x01\x02\x01\x00\x01\x03\x01\x00\x01\x04\x01\x00\x01\x05\x01\x00\x01\x06\x01\x00\x01\a\x01\x00\x02\x00\x02\x00\x02\x01\x02\x00\x02\x02\x02\x00\x02\x03\x02\x00\x02\x04\x02\x00\x02\x05\x02\x00\x02\x06\x02\x00\x02\a\x02\x00\x03\x00\x03\x00\x03\x01\x03\x00\x03\x02\x03\x00\x03\x03\x03\x00\x03\x04\x03\x00\x03\x05\x03\x00\x03\x06\x03\x00\x03\a\x03\x00\x04\x00\x04\x00\x04\x01\x04\x00\x04\x02\x04\x00\x04\x03\x04\x00\x04\x04\x04\x00\x04\x05\x04\x00\x04\x06\x04\x00\x04\a\x04\x00\x05\x00\x05\x00\x05\x01\x05\x00\x05\x02\x05\x00\x05\x03\x05\x00\x05\x04\x05\x00\x05\x05\x05\x00\x05\x06\x05\x00\x05\a\x05\x00\x06\x00\x06\x00\x06\x01\x06\x00\x06\x02\x06\x00\x06\x03\x06\x00\x06\x04\x06\x00\x06\x05\x06\x00\x06\x06\x06\x00\x06\a\x06\x00\a\x00\a\x00\a\x01\a\x00\a\x02\a\x00\a\x03\a\x00\a\x04\a\x00\a\x05\a\x00\a\x06\a\x00\a\a\a\x00\b\x00\b\x00\b\x01\b\x00\b\x02\b\x00\b\x03\b\x00\b\x04\b\x00\b\x05\b\x00\b\x06\b\x00\b\a\b\x00\t\x00\t\x00\t\x01\t\x00\t\x04\t\x00\t\x06\t\x01\x00\x00\n\x01\x00\x01\n\x01\x00\x02\t\x01\x00\x03\t\x01\x00\x04\n\x01\x00\x05\t\x01\x00\x06\n\x01\x00\a\t\x01\x01\x00\v\x01\x01\x01\v\x01\x01\x02\n\x01\x01\x03\n\x01\x01\x04\v\x01\x01\x05\n\x01\x01\x06\v\x01\x01\a\n\x01\x02\x00\f\x01\x02\x01\f\x01\x02\x02\v\x01\x02\x03\v\x01\x02\x04\f\x01\x02\x05\v\x01\x02\x06\f\x01\x02\a\v\x01\x03\x00\r\x01\x03\x01\r\x01\x03\x02\f\x01\x03\x03\f\x01\x03\x04\r\x01\x03\x05\f\x01\x03\x06\r\x01\x03\a\f\x01\x04\x00\x0e\x01\x04\x01\x0e\x01\x04\x02\r\x01\x04\x03\r\x01\x04\x04\x0e\x01\x04\x05\r\x01\x04\x06\x0e\x01\x04\a\r\x01\x05\x00\x0f\x01\x05\x01\x0f\x01\x05\x02\x0e\x01\x05\x03\x0e\x01\x05\x04\x0f\x01\x05\x05\x0e\x01\x05\x06\x0f\x01\x05\a\x0e\x01\x06\x00\x10\x01\x06\x01\x10\x01\x06\x02\x0f\x01\x06\x03\x0f\x01\x06\x04\x10\x01\x06\x05\x0f\x01\x06\x06\x10\x01\x06\a\x0f\x01\a\x00\x11\x01\a\x01\x11\x01\a\x02\x10\x01\a\x03\x10\x01\a\x04\x11\x01\a\x05\x10\x01\a\x06\x11\x01\a\a\x10\x01\b\x00\x12\x01\b\x01\x12\x01\b\x02\x11\x01\b\x03\x11\x01\b\x04\x12\x01\b\x05\x11\x01\b\x06\x12\x01\b\a\x11\x01\t\x00\x13\x01\t\x01\x13\x01\t\x04\x13\x01\t\x06\x13\x02\x00\x00\x14\x02\x00\x01\x14\x02\x00\x02\x12\x02\x00\x03\x12\x02\x00\x04\x14\x02\x00\x05\x12\x02\x00\x06\x14\x02\x00\a\x12\x02\x01\x00\x15\x02\x01\x01\x15\x02\x01\x02\x13\x02\x01\x03\x13\x02\x01\x04\x15\x02\x01\x05\x13\x02\x01\x06\x15\x02\x01\a\x13\x02\x02\x00\x16\x02\x02\x01\x16\x02\x02\x02\x14\x02\x02\x03\x14\x02\x02\x04\x16\x02\x02\x05\x14\x02\x02\x06\x16\x02\x02\a\x14\x02\x03\x00\x17\x02\x03\x01\x17\x02\x03\x02\x15\x02\x03\x03\x15\x02\x03\x04\x17\x02\x03\x05\x15\x02\x03\x06\x17\x02\x03\a\x15\x02\x04\x00\x18\x02\x04\x01\x18\x02\x04\x02\x16\x02\x04\x03\x16\x02\x04\x04\x18\x02\x04\x05\x16\x02\x04\x06\x18\x02\x04\a\x16\x02\x05\x00\x19\x02\x05\x01\x19\x02\x05\x02\x17\x02\x05\x03\x17\x02\x05\x04\x19\x02\x05\x05\x17\x02\x05\x06\x19\x02\x05\a\x17\x02\x06\x00\x1a\x02\x06\x01\x1a\x02\x06\x02\x18\x02\x06\x03\x18\x02\x06\x04\x1a\x02\x06\x05\x18\x02\x06\x06\x1a\x02\x06\a\x18\x02\a\x00\x1b\x02\a\x01\x1b\x02\a\x02\x19\x02\a\x03\x19\x02\a\x04\x1b\x02\a\x05\x19\x02\a\x06\x1b\x02\a\a\x19\x02\b\x00\x1c\x02\b\x01\x1c\x02\b\x02\x1a\x02\b\x03\x1a\x02\b\x04\x1c\x02\b\x05\x1a\x02\b\x06\x1c\x02\b\a\x1a\x02\t\x00\x1d\x02\t\x01\x1d\x02\t\x04\x1d\x02\t\x06\x1d\x03\x00\x02\x1b\x03\x00\x03\x1b\x03\x00\x05\x1b\x03\x00\a\x1b\x03\x01\x02\x1c\x03\x01\x03\x1c\x03\x01\x05\x1c\x03\x01\a\x1c\x03\x02\x02\x1d\x03\x02\x03\x1d\x03\x02\x05\x1d\x03\x02\a\x1d\x03\x03\x02\x1e\x03\x03\x03\x1e\x03\x03\x05\x1e\x03\x03\a\x1e\x03\x04\x02\x1f\x03\x04\x03\x1f\x03\x04\x05\x1f\x03\x04\a\x1f\x03\x05\x02 \x03\x05\x03 \x03\x05\x05 \x03\x05\a \x03\x06\x02!\x03\x06\x03!\x03\x06\x05!\x03\x06\a!\x03\a\x02\"\x03\a\x03\"\x03\a\x05\"\x03\a\a\"\x03\b\x02#\x03\b\x03#\x03\b\x05#\x03\b\a#",
+		cover: "\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x02\x00\x00\x00\x05\x00\x00\x00\x06\x00\x00\x00\a\x00\x00\x01\x00\x01\x00\x00\x00\x00\x00\x00\x01\x00\x00\x01\x01\x01\x00\x00\x02\x00\x00\x01\x02\x01\x00\x00\x03\x00\x00\x00\x04\x00\x00\x01\x05\x01\x00\x00\x05\x00\x00\x01\x06\x01\x00\x01\a\x01\x00\x00\a\x00\x00\x02\x00\x02\x00\x01\x00\x01\x00\x01\x01\x01\x00\x02\x01\x02\x00\x00\x02\x00\x00\x01\x02\x01\x00\x02\x02\x02\x00\x00\x03\x00\x00\x01\x03\x01\x00\x01\x04\x01\x00\x02\x05\x02\x00\x01\x05\x01\x00\x02\x06\x02\x00\x02\a\x02\x00\x01\a\x01\x00\x00\a\x00\x00\x03\x00\x03\x00\x02\x00\x02\x00\x02\x01\x02\x00\x03\x01\x03\x00\x01\x02\x01\x00\x02\x02\x02\x00\x03\x02\x03\x00\x01\x03\x01\x00\x02\x03\x02\x00\x02\x04\x02\x00\x03\x05\x03\x00\x02\x05\x02\x00\x03\x06\x03\x00\x03\a\x03\x00\x02\a\x02\x00\x01\a\x01\x00\x04\x00\x04\x00\x03\x00\x03\x00\x03\x01\x03\x00\x04\x01\x04\x00\x02\x02\x02\x00\x03\x02\x03\x00\x04\x02\x04\x00\x02\x03\x02\x00\x03\x03\x03\x00\x03\x04\x03\x00\x04\x05\x04\x00\x03\x05\x03\x00\x04\x06\x04\x00\x04\a\x04\x00\x03\a\x03\x00\x02\a\x02\x00\x05\x00\x05\x00\x04\x00\x04\x00\x04\x01\x04\x00\x05\x01\x05\x00\x03\x02\x03\x00\x04\x02\x04\x00\x05\x02\x05\x00\x03\x03\x03\x00\x04\x03\x04\x00\x04\x04\x04\x00\x05\x05\x05\x00\x04\x05\x04\x00\x05\x06\x05\x00\x05\a\x05\x00\x04\a\x04\x00\x03\a\x03\x00\x06\x00\x06\x00\x05\x00\x05\x00\x05\x01\x05\x00\x06\x01\x06\x00\x04\x02\x04\x00\x05\x02\x05\x00\x06\x02\x06\x00\x04\x03\x04\x00\x05\x03\x05\x00\x05\x04\x05\x00\x06\x05\x06\x00\x05\x05\x05\x00\x06\x06\x06\x00\x06\a\x06\x00\x05\a\x05\x00\x04\a\x04\x00\a\x00\a\x00\x06\x00\x06\x00\x06\x01\x06\x00\a\x01\a\x00\x05\x02\x05\x00\x06\x02\x06\x00\a\x02\a\x00\x05\x03\x05\x00\x06\x03\x06\x00\x06\x04\x06\x00\a\x05\a\x00\x06\x05\x06\x00\a\x06\a\x00\a\a\a\x00\x06\a\x06\x00\x05\a\x05\x00\b\x00\b\x00\a\x00\a\x00\a\x01\a\x00\b\x01\b\x00\x06\x02\x06\x00\a\x02\a\x00\b\x02\b\x00\x06\x03\x06\x00\a\x03\a\x00\a\x04\a\x00\b\x05\b\x00\a\x05\a\x00\b\x06\b\x00\b\a\b\x00\a\a\a\x00\x06\a\x06\x00\t\x00\t\x00\b\x00\b\x00\b\x01\b\x00\t\x01\t\x00\a\x02\a\x00\b\x02\b\x00\a\x03\a\x00\b\x03\b\x00\b\x04\b\x00\b\x05\b\x00\t\x06\t\x00\b\a\b\x00\a\a\a\x00\t\x00\t\x00\t\x01\t\x00\b\x02\b\x00\b\x03\b\x00\t\x04\t\x00\b\a\b\x01\x00\x00\n\x00\x00\x00\x00\x01\x00\x01\n\x00\x00\x01\x00\x01\x00\x02\t\x00\x00\x03\x00\x00\x00\x04\x00\x00\x00\x05\x00\x01\x00\x05\t\x00\x00\x06\x00\x01\x00\x06\n\x01\x00\a\t\x00\x00\a\x00\x01\x01\x00\v\x01\x00\x00\n\x00\x01\x00\x01\x00\x00\x00\x00\x01\x00\x01\n\x01\x01\x01\v\x00\x00\x01\x00\x00\x01\x01\x01\x01\x00\x02\t\x00\x00\x02\x00\x01\x01\x02\n\x00\x00\x03\x00\x01\x00\x03\t\x00\x01\x03\x01\x00\x00\x04\x00\x00\x01\x04\x01\x01\x00\x04\n\x00\x01\x05\x01\x01\x01\x05\n\x00\x00\x05\x00\x01\x00\x05\t\x00\x01\x06\x01\x00\x00\x06\x00\x01\x01\x06\v\x01\x01\a\n\x00\x01\a\x01\x01\x00\a\t\x00\x00\a\x00\x01\x02\x00\f\x01\x01\x00\v\x00\x02\x00\x02\x00\x01\x00\x01\x01\x01\x01\v\x01\x02\x01\f\x00\x01\x01\x01\x00\x02\x01\x02\x01\x00\x02\t\x00\x00\x02\x00\x01\x01\x02\n\x00\x01\x02\x01\x01\x02\x02\v\x00\x00\x03\x00\x01\x00\x03\t\x00\x01\x03\x01\x01\x01\x03\n\x00\x02\x03\x02\x00\x01\x04\x01\x00\x02\x04\x02\x01\x01\x04\v\x00\x02\x05\x02\x01\x02\x05\v\x00\x01\x05\x01\x01\x01\x05\n\x00\x00\x05\x00\x00\x02\x06\x02\x00\x01\x06\x01\x01\x02\x06\f\x01\x02\a\v\x00\x02\a\x02\x01\x01\a\n\x00\x01\a\x01\x01\x00\a\t\x01\x03\x00\r\x01\x02\x00\f\x00\x03\x00\x03\x00\x02\x00\x02\x01\x02\x01\f\x01\x03\x01\r\x00\x02\x01\x02\x00\x03\x01\x03\x01\x01\x02\n\x00\x01\x02\x01\x01\x02\x02\v\x00\x02\x02\x02\x01\x03\x02\f\x00\x01\x03\x01\x01\x01\x03\n\x00\x02\x03\x02\x01\x02\x03\v\x00\x03\x03\x03\x00\x02\x04\x02\x00\x03\x04\x03\x01\x02\x04\f\x00\x03\x05\x03\x01\x03\x05\f\x00\x02\x05\x02\x01\x02\x05\v\x00\x01\x05\x01\x00\x03\x06\x03\x00\x02\x06\x02\x01\x03\x06\r\x01\x03\a\f\x00\x03\a\x03\x01\x02\a\v\x00\x02\a\x02\x01\x01\a\n\x01\x04\x00\x0e\x01\x03\x00\r\x00\x04\x00\x04\x00\x03\x00\x03\x01\x03\x01\r\x01\x04\x01\x0e\x00\x03\x01\x03\x00\x04\x01\x04\x01\x02\x02\v\x00\x02\x02\x02\x01\x03\x02\f\x00\x03\x02\x03\x01\x04\x02\r\x00\x02\x03\x02\x01\x02\x03\v\x00\x03\x03\x03\x01\x03\x03\f\x00\x04\x03\x04\x00\x03\x04\x03\x00\x04\x04\x04\x01\x03\x04\r\x00\x04\x05\x04\x01\x04\x05\r\x00\x03\x05\x03\x01\x03\x05\f\x00\x02\x05\x02\x00\x04\x06\x04\x00\x03\x06\x03\x01\x04\x06\x0e\x01\x04\a\r\x00\x04\a\x04\x01\x03\a\f\x00\x03\a\x03\x01\x02\a\v\x01\x05\x00\x0f\x01\x04\x00\x0e\x00\x05\x00\x05\x00\x04\x00\x04\x01\x04\x01\x0e\x01\x05\x01\x0f\x00\x04\x01\x04\x00\x05\x01\x05\x01\x03\x02\f\x00\x03\x02\x03\x01\x04\x02\r\x00\x04\x02\x04\x01\x05\x02\x0e\x00\x03\x03\x03\x01\x03\x03\f\x00\x04\x03\x04\x01\x04\x03\r\x00\x05\x03\x05\x00\x04\x04\x04\x00\x05\x04\x05\x01\x04\x04\x0e\x00\x05\x05\x05\x01\x05\x05\x0e\x00\x04\x05\x04\x01\x04\x05\r\x00\x03\x05\x03\x00\x05\x06\x05\x00\x04\x06\x04\x01\x05\x06\x0f\x01\x05\a\x0e\x00\x05\a\x05\x01\x04\a\r\x00\x04\a\x04\x01\x03\a\f\x01\x06\x00\x10\x01\x05\x00\x0f\x00\x06\x00\x06\x00\x05\x00\x05\x01\x05\x01\x0f\x01\x06\x01\x10\x00\x05\x01\x05\x00\x06\x01\x06\x01\x04\x02\r\x00\x04\x02\x04\x01\x05\x02\x0e\x00\x05\x02\x05\x01\x06\x02\x0f\x00\x04\x03\x04\x01\x04\x03\r\x00\x05\x03\x05\x01\x05\x03\x0e\x00\x06\x03\x06\x00\x05\x04\x05\x00\x06\x04\x06\x01\x05\x04\x0f\x00\x06\x05\x06\x01\x06\x05\x0f\x00\x05\x05\x05\x01\x05\x05\x0e\x00\x04\x05\x04\x00\x06\x06\x06\x00\x05\x06\x05\x01\x06\x06\x10\x01\x06\a\x0f\x00\x06\a\x06\x01\x05\a\x0e\x00\x05\a\x05\x01\x04\a\r\x01\a\x00\x11\x01\x06\x00\x10\x00\a\x00\a\x00\x06\x00\x06\x01\x06\x01\x10\x01\a\x01\x11\x00\x06\x01\x06\x00\a\x01\a\x01\x05\x02\x0e\x00\x05\x02\x05\x01\x06\x02\x0f\x00\x06\x02\x06\x01\a\x02\x10\x00\x05\x03\x05\x01\x05\x03\x0e\x00\x06\x03\x06\x01\x06\x03\x0f\x00\a\x03\a\x00\x06\x04\x06\x00\a\x04\a\x01\x06\x04\x10\x00\a\x05\a\x01\a\x05\x10\x00\x06\x05\x06\x01\x06\x05\x0f\x00\x05\x05\x05\x00\a\x06\a\x00\x06\x06\x06\x01\a\x06\x11\x01\a\a\x10\x00\a\a\a\x01\x06\a\x0f\x00\x06\a\x06\x01\x05\a\x0e\x01\b\x00\x12\x01\a\x00\x11\x00\b\x00\b\x00\a\x00\a\x01\a\x01\x11\x01\b\x01\x12\x00\a\x01\a\x00\b\x01\b\x01\x06\x02\x0f\x00\x06\x02\x06\x01\a\x02\x10\x00\a\x02\a\x01\b\x02\x11\x00\x06\x03\x06\x01\x06\x03\x0f\x00\a\x03\a\x01\a\x03\x10\x00\b\x03\b\x00\a\x04\a\x00\b\x04\b\x01\a\x04\x11\x00\b\x05\b\x01\b\x05\x11\x00\a\x05\a\x01\a\x05\x10\x00\x06\x05\x06\x00\b\x06\b\x00\a\x06\a\x01\b\x06\x12\x01\b\a\x11\x00\b\a\b\x01\a\a\x10\x00\a\a\a\x01\x06\a\x0f\x01\t\x00\x13\x01\b\x00\x12\x00\t\x00\t\x00\b\x00\b\x01\b\x01\x12\x01\t\x01\x13\x00\b\x01\b\x00\t\x01\t\x01\a\x02\x10\x00\a\x02\a\x01\b\x02\x11\x00\b\x02\b\x00\a\x03\a\x01\a\x03\x10\x00\b\x03\b\x01\b\x03\x11\x00\b\x04\b\x00\t\x04\t\x01\b\x04\x12\x00\b\x05\b\x01\b\x05\x11\x00\a\x05\a\x00\t\x06\t\x00\b\x06\b\x01\t\x06\x13\x01\b\a\x11\x00\b\a\b\x01\a\a\x10\x01\t\x00\x13\x00\t\x00\t\x01\t\x01\x13\x00\t\x01\t\x01\b\x02\x11\x00\b\x02\b\x00\b\x03\b\x01\b\x03\x11\x00\t\x04\t\x01\t\x04\x13\x00\b\x05\b\x00\t\x06\t\x01\b\a\x11\x02\x00\x00\x14\x01\x00\x00\n\x00\x00\x00\x00\x02\x00\x01\x14\x01\x00\x01\n\x02\x00\x02\x12\x01\x00\x03\t\x00\x00\x04\x00\x01\x00\x04\n\x01\x00\x05\t\x02\x00\x05\x12\x00\x00\x06\x00\x01\x00\x06\n\x02\x00\x06\x14\x02\x00\a\x12\x01\x00\a\t\x02\x01\x00\x15\x02\x00\x00\x14\x01\x01\x00\v\x01\x00\x00\n\x00\x01\x00\x01\x02\x00\x01\x14\x02\x01\x01\x15\x01\x00\x01\n\x01\x01\x01\v\x00\x00\x01\x00\x02\x00\x02\x12\x01\x00\x02\t\x02\x01\x02\x13\x01\x00\x03\t\x02\x00\x03\x12\x01\x01\x03\n\x00\x00\x04\x00\x00\x01\x04\x01\x01\x00\x04\n\x01\x01\x04\v\x02\x00\x04\x14\x01\x01\x05\n\x02\x01\x05\x13\x01\x00\x05\t\x02\x00\x05\x12\x00\x01\x06\x01\x00\x00\x06\x00\x01\x01\x06\v\x01\x00\x06\n\x02\x01\x06\x15\x02\x01\a\x13\x01\x01\a\n\x02\x00\a\x12\x01\x00\a\t\x02\x02\x00\x16\x02\x01\x00\x15\x01\x02\x00\f\x01\x01\x00\v\x00\x02\x00\x02\x02\x01\x01\x15\x02\x02\x01\x16\x01\x01\x01\v\x01\x02\x01\f\x00\x01\x01\x01\x02\x00\x02\x12\x01\x00\x02\t\x02\x01\x02\x13\x01\x01\x02\n\x02\x02\x02\x14\x01\x00\x03\t\x02\x00\x03\x12\x01\x01\x03\n\x02\x01\x03\x13\x01\x02\x03\v\x00\x01\x04\x01\x00\x02\x04\x02\x01\x01\x04\v\x01\x02\x04\f\x02\x01\x04\x15\x01\x02\x05\v\x02\x02\x05\x14\x01\x01\x05\n\x02\x01\x05\x13\x01\x00\x05\t\x00\x02\x06\x02\x00\x01\x06\x01\x01\x02\x06\f\x01\x01\x06\v\x02\x02\x06\x16\x02\x02\a\x14\x01\x02\a\v\x02\x01\a\x13\x01\x01\a\n\x02\x00\a\x12\x02\x03\x00\x17\x02\x02\x00\x16\x01\x03\x00\r\x01\x02\x00\f\x00\x03\x00\x03\x02\x02\x01\x16\x02\x03\x01\x17\x01\x02\x01\f\x01\x03\x01\r\x00\x02\x01\x02\x02\x01\x02\x13\x01\x01\x02\n\x02\x02\x02\x14\x01\x02\x02\v\x02\x03\x02\x15\x01\x01\x03\n\x02\x01\x03\x13\x01\x02\x03\v\x02\x02\x03\x14\x01\x03\x03\f\x00\x02\x04\x02\x00\x03\x04\x03\x01\x02\x04\f\x01\x03\x04\r\x02\x02\x04\x16\x01\x03\x05\f\x02\x03\x05\x15\x01\x02\x05\v\x02\x02\x05\x14\x01\x01\x05\n\x00\x03\x06\x03\x00\x02\x06\x02\x01\x03\x06\r\x01\x02\x06\f\x02\x03\x06\x17\x02\x03\a\x15\x01\x03\a\f\x02\x02\a\x14\x01\x02\a\v\x02\x01\a\x13\x02\x04\x00\x18\x02\x03\x00\x17\x01\x04\x00\x0e\x01\x03\x00\r\x00\x04\x00\x04\x02\x03\x01\x17\x02\x04\x01\x18\x01\x03\x01\r\x01\x04\x01\x0e\x00\x03\x01\x03\x02\x02\x02\x14\x01\x02\x02\v\x02\x03\x02\x15\x01\x03\x02\f\x02\x04\x02\x16\x01\x02\x03\v\x02\x02\x03\x14\x01\x03\x03\f\x02\x03\x03\x15\x01\x04\x03\r\x00\x03\x04\x03\x00\x04\x04\x04\x01\x03\x04\r\x01\x04\x04\x0e\x02\x03\x04\x17\x01\x04\x05\r\x02\x04\x05\x16\x01\x03\x05\f\x02\x03\x05\x15\x01\x02\x05\v\x00\x04\x06\x04\x00\x03\x06\x03\x01\x04\x06\x0e\x01\x03\x06\r\x02\x04\x06\x18\x02\x04\a\x16\x01\x04\a\r\x02\x03\a\x15\x01\x03\a\f\x02\x02\a\x14\x02\x05\x00\x19\x02\x04\x00\x18\x01\x05\x00\x0f\x01\x04\x00\x0e\x00\x05\x00\x05\x02\x04\x01\x18\x02\x05\x01\x19\x01\x04\x01\x0e\x01\x05\x01\x0f\x00\x04\x01\x04\x02\x03\x02\x15\x01\x03\x02\f\x02\x04\x02\x16\x01\x04\x02\r\x02\x05\x02\x17\x01\x03\x03\f\x02\x03\x03\x15\x01\x04\x03\r\x02\x04\x03\x16\x01\x05\x03\x0e\x00\x04\x04\x04\x00\x05\x04\x05\x01\x04\x04\x0e\x01\x05\x04\x0f\x02\x04\x04\x18\x01\x05\x05\x0e\x02\x05\x05\x17\x01\x04\x05\r\x02\x04\x05\x16\x01\x03\x05\f\x00\x05\x06\x05\x00\x04\x06\x04\x01\x05\x06\x0f\x01\x04\x06\x0e\x02\x05\x06\x19\x02\x05\a\x17\x01\x05\a\x0e\x02\x04\a\x16\x01\x04\a\r\x02\x03\a\x15\x02\x06\x00\x1a\x02\x05\x00\x19\x01\x06\x00\x10\x01\x05\x00\x0f\x00\x06\x00\x06\x02\x05\x01\x19\x02\x06\x01\x1a\x01\x05\x01\x0f\x01\x06\x01\x10\x00\x05\x01\x05\x02\x04\x02\x16\x01\x04\x02\r\x02\x05\x02\x17\x01\x05\x02\x0e\x02\x06\x02\x18\x01\x04\x03\r\x02\x04\x03\x16\x01\x05\x03\x0e\x02\x05\x03\x17\x01\x06\x03\x0f\x00\x05\x04\x05\x00\x06\x04\x06\x01\x05\x04\x0f\x01\x06\x04\x10\x02\x05\x04\x19\x01\x06\x05\x0f\x02\x06\x05\x18\x01\x05\x05\x0e\x02\x05\x05\x17\x01\x04\x05\r\x00\x06\x06\x06\x00\x05\x06\x05\x01\x06\x06\x10\x01\x05\x06\x0f\x02\x06\x06\x1a\x02\x06\a\x18\x01\x06\a\x0f\x02\x05\a\x17\x01\x05\a\x0e\x02\x04\a\x16\x02\a\x00\x1b\x02\x06\x00\x1a\x01\a\x00\x11\x01\x06\x00\x10\x00\a\x00\a\x02\x06\x01\x1a\x02\a\x01\x1b\x01\x06\x01\x10\x01\a\x01\x11\x00\x06\x01\x06\x02\x05\x02\x17\x01\x05\x02\x0e\x02\x06\x02\x18\x01\x06\x02\x0f\x02\a\x02\x19\x01\x05\x03\x0e\x02\x05\x03\x17\x01\x06\x03\x0f\x02\x06\x03\x18\x01\a\x03\x10\x00\x06\x04\x06\x00\a\x04\a\x01\x06\x04\x10\x01\a\x04\x11\x02\x06\x04\x1a\x01\a\x05\x10\x02\a\x05\x19\x01\x06\x05\x0f\x02\x06\x05\x18\x01\x05\x05\x0e\x00\a\x06\a\x00\x06\x06\x06\x01\a\x06\x11\x01\x06\x06\x10\x02\a\x06\x1b\x02\a\a\x19\x01\a\a\x10\x02\x06\a\x18\x01\x06\a\x0f\x02\x05\a\x17\x02\b\x00\x1c\x02\a\x00\x1b\x01\b\x00\x12\x01\a\x00\x11\x00\b\x00\b\x02\a\x01\x1b\x02\b\x01\x1c\x01\a\x01\x11\x01\b\x01\x12\x00\a\x01\a\x02\x06\x02\x18\x01\x06\x02\x0f\x02\a\x02\x19\x01\a\x02\x10\x02\b\x02\x1a\x01\x06\x03\x0f\x02\x06\x03\x18\x01\a\x03\x10\x02\a\x03\x19\x01\b\x03\x11\x00\a\x04\a\x00\b\x04\b\x01\a\x04\x11\x01\b\x04\x12\x02\a\x04\x1b\x01\b\x05\x11\x02\b\x05\x1a\x01\a\x05\x10\x02\a\x05\x19\x01\x06\x05\x0f\x00\b\x06\b\x00\a\x06\a\x01\b\x06\x12\x01\a\x06\x11\x02\b\x06\x1c\x02\b\a\x1a\x01\b\a\x11\x02\a\a\x19\x01\a\a\x10\x02\x06\a\x18\x02\t\x00\x1d\x02\b\x00\x1c\x01\t\x00\x13\x01\b\x00\x12\x00\t\x00\t\x02\b\x01\x1c\x02\t\x01\x1d\x01\b\x01\x12\x01\t\x01\x13\x00\b\x01\b\x02\a\x02\x19\x01\a\x02\x10\x02\b\x02\x1a\x01\b\x02\x11\x01\a\x03\x10\x02\a\x03\x19\x01\b\x03\x11\x02\b\x03\x1a\x00\b\x04\b\x00\t\x04\t\x01\b\x04\x12\x01\t\x04\x13\x02\b\x04\x1c\x01\b\x05\x11\x02\b\x05\x1a\x01\a\x05\x10\x00\t\x06\t\x00\b\x06\b\x01\t\x06\x13\x01\b\x06\x12\x02\t\x06\x1d\x02\b\a\x1a\x01\b\a\x11\x02\a\a\x19\x02\t\x00\x1d\x01\t\x00\x13\x02\t\x01\x1d\x01\t\x01\x13\x00\t\x01\t\x02\b\x02\x1a\x01\b\x02\x11\x01\b\x03\x11\x02\b\x03\x1a\x00\t\x04\t\x01\t\x04\x13\x02\t\x04\x1d\x01\b\x05\x11\x00\t\x06\t\x01\t\x06\x13\x02\b\a\x1a\x02\x00\x00\x14\x01\x00\x00\n\x02\x00\x01\x14\x03\x00\x02\x1b\x02\x00\x03\x12\x01\x00\x04\n\x02\x00\x04\x14\x02\x00\x05\x12\x03\x00\x05\x1b\x01\x00\x06\n\x02\x00\x06\x14\x03\x00\a\x1b\x02\x00\a\x12\x02\x01\x00\x15\x02\x00\x00\x14\x01\x01\x00\v\x02\x00\x01\x14\x02\x01\x01\x15\x01\x00\x01\n\x03\x00\x02\x1b\x02\x00\x02\x12\x03\x01\x02\x1c\x02\x00\x03\x12\x03\x00\x03\x1b\x02\x01\x03\x13\x01\x00\x04\n\x01\x01\x04\v\x02\x00\x04\x14\x02\x01\x04\x15\x02\x01\x05\x13\x03\x01\x05\x1c\x02\x00\x05\x12\x03\x00\x05\x1b\x01\x01\x06\v\x01\x00\x06\n\x02\x01\x06\x15\x02\x00\x06\x14\x03\x01\a\x1c\x02\x01\a\x13\x03\x00\a\x1b\x02\x00\a\x12\x02\x02\x00\x16\x02\x01\x00\x15\x01\x02\x00\f\x02\x01\x01\x15\x02\x02\x01\x16\x01\x01\x01\v\x03\x00\x02\x1b\x02\x00\x02\x12\x03\x01\x02\x1c\x02\x01\x02\x13\x03\x02\x02\x1d\x02\x00\x03\x12\x03\x00\x03\x1b\x02\x01\x03\x13\x03\x01\x03\x1c\x02\x02\x03\x14\x01\x01\x04\v\x01\x02\x04\f\x02\x01\x04\x15\x02\x02\x04\x16\x02\x02\x05\x14\x03\x02\x05\x1d\x02\x01\x05\x13\x03\x01\x05\x1c\x02\x00\x05\x12\x01\x02\x06\f\x01\x01\x06\v\x02\x02\x06\x16\x02\x01\x06\x15\x03\x02\a\x1d\x02\x02\a\x14\x03\x01\a\x1c\x02\x01\a\x13\x03\x00\a\x1b\x02\x03\x00\x17\x02\x02\x00\x16\x01\x03\x00\r\x02\x02\x01\x16\x02\x03\x01\x17\x01\x02\x01\f\x03\x01\x02\x1c\x02\x01\x02\x13\x03\x02\x02\x1d\x02\x02\x02\x14\x03\x03\x02\x1e\x02\x01\x03\x13\x03\x01\x03\x1c\x02\x02\x03\x14\x03\x02\x03\x1d\x02\x03\x03\x15\x01\x02\x04\f\x01\x03\x04\r\x02\x02\x04\x16\x02\x03\x04\x17\x02\x03\x05\x15\x03\x03\x05\x1e\x02\x02\x05\x14\x03\x02\x05\x1d\x02\x01\x05\x13\x01\x03\x06\r\x01\x02\x06\f\x02\x03\x06\x17\x02\x02\x06\x16\x03\x03\a\x1e\x02\x03\a\x15\x03\x02\a\x1d\x02\x02\a\x14\x03\x01\a\x1c\x02\x04\x00\x18\x02\x03\x00\x17\x01\x04\x00\x0e\x02\x03\x01\x17\x02\x04\x01\x18\x01\x03\x01\r\x03\x02\x02\x1d\x02\x02\x02\x14\x03\x03\x02\x1e\x02\x03\x02\x15\x03\x04\x02\x1f\x02\x02\x03\x14\x03\x02\x03\x1d\x02\x03\x03\x15\x03\x03\x03\x1e\x02\x04\x03\x16\x01\x03\x04\r\x01\x04\x04\x0e\x02\x03\x04\x17\x02\x04\x04\x18\x02\x04\x05\x16\x03\x04\x05\x1f\x02\x03\x05\x15\x03\x03\x05\x1e\x02\x02\x05\x14\x01\x04\x06\x0e\x01\x03\x06\r\x02\x04\x06\x18\x02\x03\x06\x17\x03\x04\a\x1f\x02\x04\a\x16\x03\x03\a\x1e\x02\x03\a\x15\x03\x02\a\x1d\x02\x05\x00\x19\x02\x04\x00\x18\x01\x05\x00\x0f\x02\x04\x01\x18\x02\x05\x01\x19\x01\x04\x01\x0e\x03\x03\x02\x1e\x02\x03\x02\x15\x03\x04\x02\x1f\x02\x04\x02\x16\x03\x05\x02 \x02\x03\x03\x15\x03\x03\x03\x1e\x02\x04\x03\x16\x03\x04\x03\x1f\x02\x05\x03\x17\x01\x04\x04\x0e\x01\x05\x04\x0f\x02\x04\x04\x18\x02\x05\x04\x19\x02\x05\x05\x17\x03\x05\x05 \x02\x04\x05\x16\x03\x04\x05\x1f\x02\x03\x05\x15\x01\x05\x06\x0f\x01\x04\x06\x0e\x02\x05\x06\x19\x02\x04\x06\x18\x03\x05\a \x02\x05\a\x17\x03\x04\a\x1f\x02\x04\a\x16\x03\x03\a\x1e\x02\x06\x00\x1a\x02\x05\x00\x19\x01\x06\x00\x10\x02\x05\x01\x19\x02\x06\x01\x1a\x01\x05\x01\x0f\x03\x04\x02\x1f\x02\x04\x02\x16\x03\x05\x02 \x02\x05\x02\x17\x03\x06\x02!\x02\x04\x03\x16\x03\x04\x03\x1f\x02\x05\x03\x17\x03\x05\x03 \x02\x06\x03\x18\x01\x05\x04\x0f\x01\x06\x04\x10\x02\x05\x04\x19\x02\x06\x04\x1a\x02\x06\x05\x18\x03\x06\x05!\x02\x05\x05\x17\x03\x05\x05 \x02\x04\x05\x16\x01\x06\x06\x10\x01\x05\x06\x0f\x02\x06\x06\x1a\x02\x05\x06\x19\x03\x06\a!\x02\x06\a\x18\x03\x05\a \x02\x05\a\x17\x03\x04\a\x1f\x02\a\x00\x1b\x02\x06\x00\x1a\x01\a\x00\x11\x02\x06\x01\x1a\x02\a\x01\x1b\x01\x06\x01\x10\x03\x05\x02 \x02\x05\x02\x17\x03\x06\x02!\x02\x06\x02\x18\x03\a\x02\"\x02\x05\x03\x17\x03\x05\x03 \x02\x06\x03\x18\x03\x06\x03!\x02\a\x03\x19\x01\x06\x04\x10\x01\a\x04\x11\x02\x06\x04\x1a\x02\a\x04\x1b\x02\a\x05\x19\x03\a\x05\"\x02\x06\x05\x18\x03\x06\x05!\x02\x05\x05\x17\x01\a\x06\x11\x01\x06\x06\x10\x02\a\x06\x1b\x02\x06\x06\x1a\x03\a\a\"\x02\a\a\x19\x03\x06\a!\x02\x06\a\x18\x03\x05\a \x02\b\x00\x1c\x02\a\x00\x1b\x01\b\x00\x12\x02\a\x01\x1b\x02\b\x01\x1c\x01\a\x01\x11\x03\x06\x02!\x02\x06\x02\x18\x03\a\x02\"\x02\a\x02\x19\x03\b\x02#\x02\x06\x03\x18\x03\x06\x03!\x02\a\x03\x19\x03\a\x03\"\x02\b\x03\x1a\x01\a\x04\x11\x01\b\x04\x12\x02\a\x04\x1b\x02\b\x04\x1c\x02\b\x05\x1a\x03\b\x05#\x02\a\x05\x19\x03\a\x05\"\x02\x06\x05\x18\x01\b\x06\x12\x01\a\x06\x11\x02\b\x06\x1c\x02\a\x06\x1b\x03\b\a#\x02\b\a\x1a\x03\a\a\"\x02\a\a\x19\x03\x06\a!\x02\t\x00\x1d\x02\b\x00\x1c\x01\t\x00\x13\x02\b\x01\x1c\x02\t\x01\x1d\x01\b\x01\x12\x03\a\x02\"\x02\a\x02\x19\x03\b\x02#\x02\b\x02\x1a\x02\a\x03\x19\x03\a\x03\"\x02\b\x03\x1a\x03\b\x03#\x01\b\x04\x12\x01\t\x04\x13\x02\b\x04\x1c\x02\t\x04\x1d\x02\b\x05\x1a\x03\b\x05#\x02\a\x05\x19\x01\t\x06\x13\x01\b\x06\x12\x02\t\x06\x1d\x02\b\x06\x1c\x03\b\a#\x02\b\a\x1a\x03\a\a\"\x02\t\x00\x1d\x02\t\x01\x1d\x01\t\x01\x13\x03\b\x02#\x02\b\x02\x1a\x02\b\x03\x1a\x03\b\x03#\x01\t\x04\x13\x02\t\x04\x1d\x02\b\x05\x1a\x01\t\x06\x13\x02\t\x06\x1d\x03\b\a#\x02\x00\x00\x14\x03\x00\x03\x1b\x02\x00\x04\x14\x03\x00\x05\x1b\x02\x00\x06\x14\x03\x00\a\x1b\x02\x01\x00\x15\x02\x00\x01\x14\x03\x00\x02\x1b\x03\x00\x03\x1b\x03\x01\x03\x1c\x02\x00\x04\x14\x02\x01\x04\x15\x03\x01\x05\x1c\x03\x00\x05\x1b\x02\x01\x06\x15\x02\x00\x06\x14\x03\x01\a\x1c\x03\x00\a\x1b\x02\x02\x00\x16\x02\x01\x01\x15\x03\x00\x02\x1b\x03\x01\x02\x1c\x03\x00\x03\x1b\x03\x01\x03\x1c\x03\x02\x03\x1d\x02\x01\x04\x15\x02\x02\x04\x16\x03\x02\x05\x1d\x03\x01\x05\x1c\x03\x00\x05\x1b\x02\x02\x06\x16\x02\x01\x06\x15\x03\x02\a\x1d\x03\x01\a\x1c\x02\x03\x00\x17\x02\x02\x01\x16\x03\x01\x02\x1c\x03\x02\x02\x1d\x03\x01\x03\x1c\x03\x02\x03\x1d\x03\x03\x03\x1e\x02\x02\x04\x16\x02\x03\x04\x17\x03\x03\x05\x1e\x03\x02\x05\x1d\x03\x01\x05\x1c\x02\x03\x06\x17\x02\x02\x06\x16\x03\x03\a\x1e\x03\x02\a\x1d\x02\x04\x00\x18\x02\x03\x01\x17\x03\x02\x02\x1d\x03\x03\x02\x1e\x03\x02\x03\x1d\x03\x03\x03\x1e\x03\x04\x03\x1f\x02\x03\x04\x17\x02\x04\x04\x18\x03\x04\x05\x1f\x03\x03\x05\x1e\x03\x02\x05\x1d\x02\x04\x06\x18\x02\x03\x06\x17\x03\x04\a\x1f\x03\x03\a\x1e\x02\x05\x00\x19\x02\x04\x01\x18\x03\x03\x02\x1e\x03\x04\x02\x1f\x03\x03\x03\x1e\x03\x04\x03\x1f\x03\x05\x03 \x02\x04\x04\x18\x02\x05\x04\x19\x03\x05\x05 \x03\x04\x05\x1f\x03\x03\x05\x1e\x02\x05\x06\x19\x02\x04\x06\x18\x03\x05\a \x03\x04\a\x1f\x02\x06\x00\x1a\x02\x05\x01\x19\x03\x04\x02\x1f\x03\x05\x02 \x03\x04\x03\x1f\x03\x05\x03 \x03\x06\x03!\x02\x05\x04\x19\x02\x06\x04\x1a\x03\x06\x05!\x03\x05\x05 \x03\x04\x05\x1f\x02\x06\x06\x1a\x02\x05\x06\x19\x03\x06\a!\x03\x05\a \x02\a\x00\x1b\x02\x06\x01\x1a\x03\x05\x02 \x03\x06\x02!\x03\x05\x03 \x03\x06\x03!\x03\a\x03\"\x02\x06\x04\x1a\x02\a\x04\x1b\x03\a\x05\"\x03\x06\x05!\x03\x05\x05 \x02\a\x06\x1b\x02\x06\x06\x1a\x03\a\a\"\x03\x06\a!\x02\b\x00\x1c\x02\a\x01\x1b\x03\x06\x02!\x03\a\x02\"\x03\x06\x03!\x03\a\x03\"\x03\b\x03#\x02\a\x04\x1b\x02\b\x04\x1c\x03\b\x05#\x03\a\x05\"\x03\x06\x05!\x02\b\x06\x1c\x02\a\x06\x1b\x03\b\a#\x03\a\a\"\x02\t\x00\x1d\x02\b\x01\x1c\x03\a\x02\"\x03\b\x02#\x03\a\x03\"\x03\b\x03#\x02\b\x04\x1c\x02\t\x04\x1d\x03\b\x05#\x03\a\x05\"\x02\t\x06\x1d\x02\b\x06\x1c\x03\b\a#\x02\t\x01\x1d\x03\b\x02#\x03\b\x03#\x02\t\x04\x1d\x03\b\x05#\x02\t\x06\x1d",
+		start: []int32{0, 6, 19, 35, 51, 67, 83, 99, 115, 131, 144, 150, 163, 191, 225, 259, 293, 327, 361, 395, 429, 457, 470, 486, 520, 560, 600, 640, 680, 720, 760, 800, 834, 850, 863, 891, 925, 959, 993, 1027, 1061, 1095, 1129, 1157, 1170, 1176, 1189, 1205, 1221, 1237, 1253, 1269, 1285, 1301, 1314, 1320},
+	},
+	{rows: 5, cols: 11, cells: "0,0;0,1;1,1;1,2;", sym: true, n: 132,
+		versions: []generatedVersion{
+			{lo: Pos{0, 0}, n: Pos{4, 9}, masks: []uint64{0x3003, 0x6006, 0xc00c, 0x18018, 0x30030, 0x60060, 0xc00c0, 0x180180, 0x300300, 0x1801800, 0x3003000, 0x6006000, 0xc00c000, 0x18018000, 0x30030000, 0x60060000, 0xc00c0000, 0x180180000, 0xc00c00000, 0x1801800000, 0x3003000000, 0x6006000000, 0xc00c000000, 0x18018000000, 0x30030000000, 0x60060000000, 0xc00c0000000, 0x600600000000, 0xc00c00000000, 0x1801800000000, 0x3003000000000, 0x6006000000000, 0xc00c000000000, 0x18018000000000, 0x30030000000000, 0x60060000000000}},
+			{lo: Pos{0, 0}, n: Pos{4, 9}, masks: []uint64{0x1806, 0x300c, 0x6018, 0xc030, 0x18060, 0x300c0, 0x60180, 0xc0300, 0x180600, 0xc03000, 0x1806000, 0x300c000, 0x6018000, 0xc030000, 0x18060000, 0x300c0000, 0x60180000, 0xc0300000, 0x601800000, 0xc03000000, 0x1806000000, 0x300c000000, 0x6018000000, 0xc030000000, 0x18060000000, 0x300c0000000, 0x60180000000, 0x300c00000000, 0x601800000000, 0xc03000000000, 0x1806000000000, 0x300c000000000, 0x6018000000000, 0xc030000000000, 0x18060000000000, 0x300c0000000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 10}, masks: []uint64{0x401802, 0x803004, 0x1006008, 0x200c010, 0x4018020, 0x8030040, 0x10060080, 0x200c0100, 0x40180200, 0x80300400, 0x200c01000, 0x401802000, 0x803004000, 0x1006008000, 0x200c010000, 0x4018020000, 0x8030040000, 0x10060080000, 0x200c0100000, 0x40180200000, 0x100600800000, 0x200c01000000, 0x401802000000, 0x803004000000, 0x1006008000000, 0x200c010000000, 0x4018020000000, 0x8030040000000, 0x10060080000000, 0x200c0100000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 10}, masks: []uint64{0x801801, 0x1003002, 0x2006004, 0x400c008, 0x8018010, 0x10030020, 0x20060040, 0x400c0080, 0x80180100, 0x100300200, 0x400c00800, 0x801801000, 0x1003002000, 0x2006004000, 0x400c008000, 0x8018010000, 0x10030020000, 0x20060040000, 0x400c0080000, 0x80180100000, 0x200600400000, 0x400c00800000, 0x801801000000, 0x1003002000000, 0x2006004000000, 0x400c008000000, 0x8018010000000, 0x10030020000000, 0x20060040000000, 0x400c0080000000}},
+		},
+		spots: "\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x02\x00\x00\x00\x03\x00\x00\x01\x00\x01\x00\x01\x01\x01\x00\x01\x02\x01\x00\x01\x03\x01\x00\x02\x00\x02\x00\x02\x01\x02\x00\x02\x02\x02\x00\x02\x03\x02\x00\x03\x00\x03\x00\x03\x01\x03\x00\x03\x02\x03\x00\x03\x03\x03\x00\x04\x00\x04\x00\x04\x01\x04\x00\x04\x02\x04\x00\x04\x03\x04\x00\x05\x00\x05\x00\x05\x01\x05\x00\x05\x02\x05\x00\x05\x03\x05\x00\x06\x00\x06\x00\x06\x01\x06\x00\x06\x02\x06\x00\x06\x03\x06\x00\a\x00\a\x00\a\x01\a\x00\a\x02\a\x00\a\x03\a\x00\b\x00\b\x00\b\x01\b\x00\b\x02\b\x00\b\x03\b\x00\t\x02\t\x00\t\x03\t\x01\x00\x00\t\x01\x00\x01\t\x01\x00\x02\n\x01\x00\x03\n\x01\x01\x00\n\x01\x01\x01\n\x01\x01\x02\v\x01\x01\x03\v\x01\x02\x00\v\x01\x02\x01\v\x01\x02\x02\f\x01\x02\x03\f\x01\x03\x00\f\x01\x03\x01\f\x01\x03\x02\r\x01\x03\x03\r\x01\x04\x00\r\x01\x04\x01\r\x01\x04\x02\x0e\x01\x04\x03\x0e\x01\x05\x00\x0e\x01\x05\x01\x0e\x01\x05\x02\x0f\x01\x05\x03\x0f\x01\x06\x00\x0f\x01\x06\x01\x0f\x01\x06\x02\x10\x01\x06\x03\x10\x01\a\x00\x10\x01\a\x01\x10\x01\a\x02\x11\x01\a\x03\x11\x01\b\x00\x11\x01\b\x01\x11\x01\b\x02\x12\x01\b\x03\x12\x01\t\x02\x13\x01\t\x03\x13\x02\x00\x00\x12\x02\x00\x01\x12\x02\x00\x02\x14\x02\x00\x03\x14\x02\x01\x00\x13\x02\x01\x01\x13\x02\x01\x02\x15\x02\x01\x03\x15\x02\x02\x00\x14\x02\x02\x01\x14\x02\x02\x02\x16\x02\x02\x03\x16\x02\x03\x00\x15\x02\x03\x01\x15\x02\x03\x02\x17\x02\x03\x03\x17\x02\x04\x00\x16\x02\x04\x01\x16\x02\x04\x02\x18\x02\x04\x03\x18\x02\x05\x00\x17\x02\x05\x01\x17\x02\x05\x02\x19\x02\x05\x03\x19\x02\x06\x00\x18\x02\x06\x01\x18\x02\x06\x02\x1a\x02\x06\x03\x1a\x02\a\x00\x19\x02\a\x01\x19\x02\a\x02\x1b\x02\a\x03\x1b\x02\b\x00\x1a\x02\b\x01\x1a\x02\b\x02\x1c\x02\b\x03\x1c\x02\t\x02\x1d\x02\t\x03\x1d\x03\x00\x00\x1b\x03\x00\x01\x1b\x03\x01\x00\x1c\x03\x01\x01\x1c\x03\x02\x00\x1d\x03\x02\x01\x1d\x03\x03\x00\x1e\x03\x03\x01\x1e\x03\x04\x00\x1f\x03\x04\x01\x1f\x03\x05\x00 \x03\x05\x01 \x03\x06\x00!\x03\x06\x01!\x03\a\x00\"\x03\a\x01\"\x03\b\x00#\x03\b\x01#",
+		cover: "\x00\x00\x00\x00\x00\x00\x03\x00\x00\x01\x00\x01\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x02\x00\x00\x01\x03\x01\x00\x02\x00\x02\x00\x01\x00\x01\x00\x00\x01\x00\x00\x01\x01\x01\x00\x01\x02\x01\x00\x02\x03\x02\x00\x03\x00\x03\x00\x02\x00\x02\x00\x01\x01\x01\x00\x02\x01\x02\x00\x02\x02\x02\x00\x03\x03\x03\x00\x04\x00\x04\x00\x03\x00\x03\x00\x02\x01\x02\x00\x03\x01\x03\x00\x03\x02\x03\x00\x04\x03\x04\x00\x05\x00\x05\x00\x04\x00\x04\x00\x03\x01\x03\x00\x04\x01\x04\x00\x04\x02\x04\x00\x05\x03\x05\x00\x06\x00\x06\x00\x05\x00\x05\x00\x04\x01\x04\x00\x05\x01\x05\x00\x05\x02\x05\x00\x06\x03\x06\x00\a\x00\a\x00\x06\x00\x06\x00\x05\x01\x05\x00\x06\x01\x06\x00\x06\x02\x06\x00\a\x03\a\x00\b\x00\b\x00\a\x00\a\x00\x06\x01\x06\x00\a\x01\a\x00\a\x02\a\x00\b\x03\b\x00\b\x00\b\x00\a\x01\a\x00\b\x01\b\x00\b\x02\b\x00\t\x03\t\x00\b\x01\b\x00\t\x02\t\x01\x00\x00\t\x00\x00\x01\x00\x00\x00\x02\x00\x00\x00\x03\x00\x01\x00\x03\n\x01\x01\x00\n\x01\x00\x00\t\x00\x00\x00\x00\x01\x00\x01\t\x00\x00\x01\x00\x00\x01\x01\x01\x01\x00\x02\n\x00\x00\x02\x00\x00\x01\x02\x01\x00\x00\x03\x00\x00\x01\x03\x01\x01\x01\x03\v\x01\x02\x00\v\x01\x01\x00\n\x00\x01\x00\x01\x00\x00\x00\x00\x01\x00\x01\t\x01\x01\x01\n\x00\x01\x01\x01\x00\x02\x01\x02\x01\x01\x02\v\x00\x01\x02\x01\x00\x02\x02\x02\x00\x01\x03\x01\x00\x02\x03\x02\x01\x02\x03\f\x01\x03\x00\f\x01\x02\x00\v\x00\x02\x00\x02\x00\x01\x00\x01\x01\x01\x01\n\x01\x02\x01\v\x00\x02\x01\x02\x00\x03\x01\x03\x01\x02\x02\f\x00\x02\x02\x02\x00\x03\x02\x03\x00\x02\x03\x02\x00\x03\x03\x03\x01\x03\x03\r\x01\x04\x00\r\x01\x03\x00\f\x00\x03\x00\x03\x00\x02\x00\x02\x01\x02\x01\v\x01\x03\x01\f\x00\x03\x01\x03\x00\x04\x01\x04\x01\x03\x02\r\x00\x03\x02\x03\x00\x04\x02\x04\x00\x03\x03\x03\x00\x04\x03\x04\x01\x04\x03\x0e\x01\x05\x00\x0e\x01\x04\x00\r\x00\x04\x00\x04\x00\x03\x00\x03\x01\x03\x01\f\x01\x04\x01\r\x00\x04\x01\x04\x00\x05\x01\x05\x01\x04\x02\x0e\x00\x04\x02\x04\x00\x05\x02\x05\x00\x04\x03\x04\x00\x05\x03\x05\x01\x05\x03\x0f\x01\x06\x00\x0f\x01\x05\x00\x0e\x00\x05\x00\x05\x00\x04\x00\x04\x01\x04\x01\r\x01\x05\x01\x0e\x00\x05\x01\x05\x00\x06\x01\x06\x01\x05\x02\x0f\x00\x05\x02\x05\x00\x06\x02\x06\x00\x05\x03\x05\x00\x06\x03\x06\x01\x06\x03\x10\x01\a\x00\x10\x01\x06\x00\x0f\x00\x06\x00\x06\x00\x05\x00\x05\x01\x05\x01\x0e\x01\x06\x01\x0f\x00\x06\x01\x06\x00\a\x01\a\x01\x06\x02\x10\x00\x06\x02\x06\x00\a\x02\a\x00\x06\x03\x06\x00\a\x03\a\x01\a\x03\x11\x01\b\x00\x11\x01\a\x00\x10\x00\a\x00\a\x00\x06\x00\x06\x01\x06\x01\x0f\x01\a\x01\x10\x00\a\x01\a\x00\b\x01\b\x01\a\x02\x11\x00\a\x02\a\x00\b\x02\b\x00\a\x03\a\x00\b\x03\b\x01\b\x03\x12\x01\b\x00\x11\x00\b\x00\b\x00\a\x00\a\x01\a\x01\x10\x01\b\x01\x11\x00\b\x01\b\x01\b\x02\x12\x00\b\x02\b\x00\t\x02\t\x00\b\x03\b\x00\t\x03\t\x01\t\x03\x13\x00\b\x00\b\x01\b\x01\x11\x01\t\x02\x13\x00\t\x02\t\x00\t\x03\t\x02\x00\x00\x12\x01\x00\x01\t\x01\x00\x02\n\x00\x00\x02\x00\x01\x00\x03\n\x02\x00\x03\x14\x02\x01\x00\x13\x02\x00\x00\x12\x01\x00\x00\t\x02\x00\x01\x12\x01\x00\x01\t\x01\x01\x01\n\x02\x00\x02\x14\x01\x00\x02\n\x01\x01\x02\v\x00\x01\x02\x01\x00\x00\x03\x00\x01\x00\x03\n\x01\x01\x03\v\x02\x01\x03\x15\x02\x02\x00\x14\x02\x01\x00\x13\x01\x01\x00\n\x01\x00\x00\t\x02\x00\x01\x12\x02\x01\x01\x13\x01\x01\x01\n\x01\x02\x01\v\x02\x01\x02\x15\x01\x01\x02\v\x01\x02\x02\f\x00\x02\x02\x02\x00\x01\x03\x01\x01\x01\x03\v\x01\x02\x03\f\x02\x02\x03\x16\x02\x03\x00\x15\x02\x02\x00\x14\x01\x02\x00\v\x01\x01\x00\n\x02\x01\x01\x13\x02\x02\x01\x14\x01\x02\x01\v\x01\x03\x01\f\x02\x02\x02\x16\x01\x02\x02\f\x01\x03\x02\r\x00\x03\x02\x03\x00\x02\x03\x02\x01\x02\x03\f\x01\x03\x03\r\x02\x03\x03\x17\x02\x04\x00\x16\x02\x03\x00\x15\x01\x03\x00\f\x01\x02\x00\v\x02\x02\x01\x14\x02\x03\x01\x15\x01\x03\x01\f\x01\x04\x01\r\x02\x03\x02\x17\x01\x03\x02\r\x01\x04\x02\x0e\x00\x04\x02\x04\x00\x03\x03\x03\x01\x03\x03\r\x01\x04\x03\x0e\x02\x04\x03\x18\x02\x05\x00\x17\x02\x04\x00\x16\x01\x04\x00\r\x01\x03\x00\f\x02\x03\x01\x15\x02\x04\x01\x16\x01\x04\x01\r\x01\x05\x01\x0e\x02\x04\x02\x18\x01\x04\x02\x0e\x01\x05\x02\x0f\x00\x05\x02\x05\x00\x04\x03\x04\x01\x04\x03\x0e\x01\x05\x03\x0f\x02\x05\x03\x19\x02\x06\x00\x18\x02\x05\x00\x17\x01\x05\x00\x0e\x01\x04\x00\r\x02\x04\x01\x16\x02\x05\x01\x17\x01\x05\x01\x0e\x01\x06\x01\x0f\x02\x05\x02\x19\x01\x05\x02\x0f\x01\x06\x02\x10\x00\x06\x02\x06\x00\x05\x03\x05\x01\x05\x03\x0f\x01\x06\x03\x10\x02\x06\x03\x1a\x02\a\x00\x19\x02\x06\x00\x18\x01\x06\x00\x0f\x01\x05\x00\x0e\x02\x05\x01\x17\x02\x06\x01\x18\x01\x06\x01\x0f\x01\a\x01\x10\x02\x06\x02\x1a\x01\x06\x02\x10\x01\a\x02\x11\x00\a\x02\a\x00\x06\x03\x06\x01\x06\x03\x10\x01\a\x03\x11\x02\a\x03\x1b\x02\b\x00\x1a\x02\a\x00\x19\x01\a\x00\x10\x01\x06\x00\x0f\x02\x06\x01\x18\x02\a\x01\x19\x01\a\x01\x10\x01\b\x01\x11\x02\a\x02\x1b\x01\a\x02\x11\x01\b\x02\x12\x00\b\x02\b\x00\a\x03\a\x01\a\x03\x11\x01\b\x03\x12\x02\b\x03\x1c\x02\b\x00\x1a\x01\b\x00\x11\x01\a\x00\x10\x02\a\x01\x19\x02\b\x01\x1a\x01\b\x01\x11\x02\b\x02\x1c\x01\b\x02\x12\x01\t\x02\x13\x00\t\x02\t\x00\b\x03\b\x01\b\x03\x12\x01\t\x03\x13\x02\t\x03\x1d\x01\b\x00\x11\x02\b\x01\x1a\x02\t\x02\x1d\x01\t\x02\x13\x00\t\x03\t\x01\t\x03\x13\x03\x00\x00\x1b\x02\x00\x01\x12\x02\x00\x02\x14\x01\x00\x02\n\x02\x00\x03\x14\x03\x01\x00\x1c\x03\x00\x00\x1b\x02\x00\x00\x12\x03\x00\x01\x1b\x02\x00\x01\x12\x02\x01\x01\x13\x02\x00\x02\x14\x02\x01\x02\x15\x01\x01\x02\v\x01\x00\x03\n\x02\x00\x03\x14\x02\x01\x03\x15\x03\x02\x00\x1d\x03\x01\x00\x1c\x02\x01\x00\x13\x02\x00\x00\x12\x03\x00\x01\x1b\x03\x01\x01\x1c\x02\x01\x01\x13\x02\x02\x01\x14\x02\x01\x02\x15\x02\x02\x02\x16\x01\x02\x02\f\x01\x01\x03\v\x02\x01\x03\x15\x02\x02\x03\x16\x03\x03\x00\x1e\x03\x02\x00\x1d\x02\x02\x00\x14\x02\x01\x00\x13\x03\x01\x01\x1c\x03\x02\x01\x1d\x02\x02\x01\x14\x02\x03\x01\x15\x02\x02\x02\x16\x02\x03\x02\x17\x01\x03\x02\r\x01\x02\x03\f\x02\x02\x03\x16\x02\x03\x03\x17\x03\x04\x00\x1f\x03\x03\x00\x1e\x02\x03\x00\x15\x02\x02\x00\x14\x03\x02\x01\x1d\x03\x03\x01\x1e\x02\x03\x01\x15\x02\x04\x01\x16\x02\x03\x02\x17\x02\x04\x02\x18\x01\x04\x02\x0e\x01\x03\x03\r\x02\x03\x03\x17\x02\x04\x03\x18\x03\x05\x00 \x03\x04\x00\x1f\x02\x04\x00\x16\x02\x03\x00\x15\x03\x03\x01\x1e\x03\x04\x01\x1f\x02\x04\x01\x16\x02\x05\x01\x17\x02\x04\x02\x18\x02\x05\x02\x19\x01\x05\x02\x0f\x01\x04\x03\x0e\x02\x04\x03\x18\x02\x05\x03\x19\x03\x06\x00!\x03\x05\x00 \x02\x05\x00\x17\x02\x04\x00\x16\x03\x04\x01\x1f\x03\x05\x01 \x02\x05\x01\x17\x02\x06\x01\x18\x02\x05\x02\x19\x02\x06\x02\x1a\x01\x06\x02\x10\x01\x05\x03\x0f\x02\x05\x03\x19\x02\x06\x03\x1a\x03\a\x00\"\x03\x06\x00!\x02\x06\x00\x18\x02\x05\x00\x17\x03\x05\x01 \x03\x06\x01!\x02\x06\x01\x18\x02\a\x01\x19\x02\x06\x02\x1a\x02\a\x02\x1b\x01\a\x02\x11\x01\x06\x03\x10\x02\x06\x03\x1a\x02\a\x03\x1b\x03\b\x00#\x03\a\x00\"\x02\a\x00\x19\x02\x06\x00\x18\x03\x06\x01!\x03\a\x01\"\x02\a\x01\x19\x02\b\x01\x1a\x02\a\x02\x1b\x02\b\x02\x1c\x01\b\x02\x12\x01\a\x03\x11\x02\a\x03\x1b\x02\b\x03\x1c\x03\b\x00#\x02\b\x00\x1a\x02\a\x00\x19\x03\a\x01\"\x03\b\x01#\x02\b\x01\x1a\x02\b\x02\x1c\x02\t\x02\x1d\x01\t\x02\x13\x01\b\x03\x12\x02\b\x03\x1c\x02\t\x03\x1d\x02\b\x00\x1a\x03\b\x01#\x02\t\x02\x1d\x01\t\x03\x13\x02\t\x03\x1d\x03\x00\x01\x1b\x02\x00\x02\x14\x03\x00\x00\x1b\x03\x00\x01\x1b\x03\x01\x01\x1c\x02\x01\x02\x15\x02\x00\x03\x14\x03\x01\x00\x1c\x03\x00\x00\x1b\x03\x01\x01\x1c\x03\x02\x01\x1d\x02\x02\x02\x16\x02\x01\x03\x15\x03\x02\x00\x1d\x03\x01\x00\x1c\x03\x02\x01\x1d\x03\x03\x01\x1e\x02\x03\x02\x17\x02\x02\x03\x16\x03\x03\x00\x1e\x03\x02\x00\x1d\x03\x03\x01\x1e\x03\x04\x01\x1f\x02\x04\x02\x18\x02\x03\x03\x17\x03\x04\x00\x1f\x03\x03\x00\x1e\x03\x04\x01\x1f\x03\x05\x01 \x02\x05\x02\x19\x02\x04\x03\x18\x03\x05\x00 \x03\x04\x00\x1f\x03\x05\x01 \x03\x06\x01!\x02\x06\x02\x1a\x02\x05\x03\x19\x03\x06\x00!\x03\x05\x00 \x03\x06\x01!\x03\a\x01\"\x02\a\x02\x1b\x02\x06\x03\x1a\x03\a\x00\"\x03\x06\x00!\x03\a\x01\"\x03\b\x01#\x02\b\x02\x1c\x02\a\x03\x1b\x03\b\x00#\x03\a\x00\"\x03\b\x01#\x02\t\x02\x1d\x02\b\x03\x1c\x03\b\x00#\x02\t\x03\x1d",
+		start: []int32{0, 2, 7, 13, 19, 25, 31, 37, 43, 49, 54, 56, 61, 73, 87, 101, 115, 129, 143, 157, 171, 183, 188, 194, 208, 224, 240, 256, 272, 288, 304, 320, 334, 340, 345, 357, 371, 385, 399, 413, 427, 441, 455, 467, 472, 474, 479, 485, 491, 497, 503, 509, 515, 521, 526, 528},
+	},
+	{rows: 5, cols: 11, cells: "0,0;0,1;1,1;1,2;1,3;", sym: false, n: 208,
+		versions: []generatedVersion{
+			{lo: Pos{0, 0}, n: Pos{4, 8}, masks: []uint64{0x7003, 0xe006, 0x1c00c, 0x38018, 0x70030, 0xe0060, 0x1c00c0, 0x380180, 0x3801800, 0x7003000, 0xe006000, 0x1c00c000, 0x38018000, 0x70030000, 0xe0060000, 0x1c00c0000, 0x1c00c00000, 0x3801800000, 0x7003000000, 0xe006000000, 0x1c00c000000, 0x38018000000, 0x70030000000, 0xe0060000000, 0xe00600000000, 0x1c00c00000000, 0x3801800000000, 0x7003000000000, 0xe006000000000, 0x1c00c000000000, 0x38018000000000, 0x70030000000000}},
+			{lo: Pos{0, 0}, n: Pos{4, 8}, masks: []uint64{0x380c, 0x7018, 0xe030, 0x1c060, 0x380c0, 0x70180, 0xe0300, 0x1c0600, 0x1c06000, 0x380c000, 0x7018000, 0xe030000, 0x1c060000, 0x380c0000, 0x70180000, 0xe0300000, 0xe03000000, 0x1c06000000, 0x380c000000, 0x7018000000, 0xe030000000, 0x1c060000000, 0x380c0000000, 0x70180000000, 0x701800000000, 0xe03000000000, 0x1c06000000000, 0x380c000000000, 0x7018000000000, 0xe030000000000, 0x1c060000000000, 0x380c0000000000}},
+			{lo: Pos{0, 0}, n: Pos{2, 10}, masks: []uint64{0x200401802, 0x400803004, 0x801006008, 0x100200c010, 0x2004018020, 0x4008030040, 0x8010060080, 0x100200c0100, 0x20040180200, 0x40080300400, 0x100200c01000, 0x200401802000, 0x400803004000, 0x801006008000, 0x100200c010000, 0x2004018020000, 0x4008030040000, 0x8010060080000, 0x100200c0100000, 0x20040180200000}},
+			{lo: Pos{0, 0}, n: Pos{2, 10}, masks: []uint64{0x400c00801, 0x801801002, 0x1003002004, 0x2006004008, 0x400c008010, 0x8018010020, 0x10030020040, 0x20060040080, 0x400c0080100, 0x80180100200, 0x200600400800, 0x400c00801000, 0x801801002000, 0x1003002004000, 0x2006004008000, 0x400c008010000, 0x8018010020000, 0x10030020040000, 0x20060040080000, 0x400c0080100000}},
+			{lo: Pos{0, 0}, n: Pos{4, 8}, masks: []uint64{0x6007, 0xc00e, 0x1801c, 0x30038, 0x60070, 0xc00e0, 0x1801c0, 0x300380, 0x3003800, 0x6007000, 0xc00e000, 0x1801c000, 0x30038000, 0x60070000, 0xc00e0000, 0x1801c0000, 0x1801c00000, 0x3003800000, 0x6007000000, 0xc00e000000, 0x1801c000000, 0x30038000000, 0x60070000000, 0xc00e0000000, 0xc00e00000000, 0x1801c00000000, 0x3003800000000, 0x6007000000000, 0xc00e000000000, 0x1801c000000000, 0x30038000000000, 0x60070000000000}},
+			{lo: Pos{0, 0}, n: Pos{2, 10}, masks: []uint64{0x200c01002, 0x401802004, 0x803004008, 0x1006008010, 0x200c010020, 0x4018020040, 0x8030040080, 0x10060080100, 0x200c0100200, 0x40180200400, 0x100600801000, 0x200c01002000, 0x401802004000, 0x803004008000, 0x1006008010000, 0x200c010020000, 0x4018020040000, 0x8030040080000, 0x10060080100000, 0x200c0100200000}},
+			{lo: Pos{0, 0}, n: Pos{4, 8}, masks: []uint64{0x180e, 0x301c, 0x6038, 0xc070, 0x180e0, 0x301c0, 0x60380, 0xc0700, 0xc07000, 0x180e000, 0x301c000, 0x6038000, 0xc070000, 0x180e0000, 0x301c0000, 0x60380000, 0x603800000, 0xc07000000, 0x180e000000, 0x301c000000, 0x6038000000, 0xc070000000, 0x180e0000000, 0x301c0000000, 0x301c00000000, 0x603800000000, 0xc07000000000, 0x180e000000000, 0x301c000000000, 0x6038000000000, 0xc070000000000, 0x180e0000000000}},
+			{lo: Pos{0, 0}, n: Pos{2, 10}, masks: []uint64{0x400801801, 0x801003002, 0x1002006004, 0x200400c008, 0x4008018010, 0x8010030020, 0x10020060040, 0x200400c0080, 0x40080180100, 0x80100300200, 0x200400c00800, 0x400801801000, 0x801003002000, 0x1002006004000, 0x200400c008000, 0x4008018010000, 0x8010030020000, 0x10020060040000, 0x200400c0080000, 0x40080180100000}},
+		},
+		spots: "\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x02\x00\x00\x00\x03\x00\x00\x00\x04\x00\x00\x00\x05\x00\x00\x00\x06\x00\x00\x00\a\x00\x00\x01\x00\x01\x00\x01\x01\x01\x00\x01\x02\x01\x00\x01\x03\x01\x00\x01\x04\x01\x00\x01\x05\x01\x00\x01\x06\x01\x00\x01\a\x01\x00\x02\x00\x02\x00\x02\x01\x02\x00\x02\x02\x02\x00\x02\x03\x02\x00\x02\x04\x02\x00\x02\x05\x02\x00\x02\x06\x02\x00\x02\a\x02\x00\x03\x00\x03\x00\x03\x01\x03\x00\x03\x02\x03\x00\x03\x03\x03\x00\x03\x04\x03\x00\x03\x05\x03\x00\x03\x06\x03\x00\x03\a\x03\x00\x04\x00\x04\x00\x04\x01\x04\x00\x04\x02\x04\x00\x04\x03\x04\x00\x04\x04\x04\x00\x04\x05\x04\x00\x04\x06\x04\x00\x04\a\x04\x00\x05\x00\x05\x00\x05\x01\x05\x00\x05\x02\x05\x00\x05\x03\x05\x00\x05\x04\x05\x00\x05\x05\x05\x00\x05\x06\x05\x00\x05\a\x05\x00\x06\x00\x06\x00\x06\x01\x06\x00\x06\x02\x06\x00\x06\x03\x06\x00\x06\x04\x06\x00\x06\x05\x06\x00\x06\x06\x06\x00\x06\a\x06\x00\a\x00\a\x00\a\x01\a\x00\a\x02\a\x00\a\x03\a\x00\a\x04\a\x00\a\x05\a\x00\a\x06\a\x00\a\a\a\x00\b\x02\b\x00\b\x03\b\x00\b\x05\b\x00\b\a\b\x00\t\x02\t\x00\t\x03\t\x00\t\x05\t\x00\t\a\t\x01\x00\x00\b\x01\x00\x01\b\x01\x00\x02\n\x01\x00\x03\n\x01\x00\x04\b\x01\x00\x05\n\x01\x00\x06\b\x01\x00\a\n\x01\x01\x00\t\x01\x01\x01\t\x01\x01\x02\v\x01\x01\x03\v\x01\x01\x04\t\x01\x01\x05\v\x01\x01\x06\t\x01\x01\a\v\x01\x02\x00\n\x01\x02\x01\n\x01\x02\x02\f\x01\x02\x03\f\x01\x02\x04\n\x01\x02\x05\f\x01\x02\x06\n\x01\x02\a\f\x01\x03\x00\v\x01\x03\x01\v\x01\x03\x02\r\x01\x03\x03\r\x01\x03\x04\v\x01\x03\x05\r\x01\x03\x06\v\x01\x03\a\r\x01\x04\x00\f\x01\x04\x01\f\x01\x04\x02\x0e\x01\x04\x03\x0e\x01\x04\x04\f\x01\x04\x05\x0e\x01\x04\x06\f\x01\x04\a\x0e\x01\x05\x00\r\x01\x05\x01\r\x01\x05\x02\x0f\x01\x05\x03\x0f\x01\x05\x04\r\x01\x05\x05\x0f\x01\x05\x06\r\x01\x05\a\x0f\x01\x06\x00\x0e\x01\x06\x01\x0e\x01\x06\x02\x10\x01\x06\x03\x10\x01\x06\x04\x0e\x01\x06\x05\x10\x01\x06\x06\x0e\x01\x06\a\x10\x01\a\x00\x0f\x01\a\x01\x0f\x01\a\x02\x11\x01\a\x03\x11\x01\a\x04\x0f\x01\a\x05\x11\x01\a\x06\x0f\x01\a\a\x11\x01\b\x02\x12\x01\b\x03\x12\x01\b\x05\x12\x01\b\a\x12\x01\t\x02\x13\x01\t\x03\x13\x01\t\x05\x13\x01\t\a\x13\x02\x00\x00\x10\x02\x00\x01\x10\x02\x00\x04\x10\x02\x00\x06\x10\x02\x01\x00\x11\x02\x01\x01\x11\x02\x01\x04\x11\x02\x01\x06\x11\x02\x02\x00\x12\x02\x02\x01\x12\x02\x02\x04\x12\x02\x02\x06\x12\x02\x03\x00\x13\x02\x03\x01\x13\x02\x03\x04\x13\x02\x03\x06\x13\x02\x04\x00\x14\x02\x04\x01\x14\x02\x04\x04\x14\x02\x04\x06\x14\x02\x05\x00\x15\x02\x05\x01\x15\x02\x05\x04\x15\x02\x05\x06\x15\x02\x06\x00\x16\x02\x06\x01\x16\x02\x06\x04\x16\x02\x06\x06\x16\x02\a\x00\x17\x02\a\x01\x17\x02\a\x04\x17\x02\a\x06\x17\x03\x00\x00\x18\x03\x00\x01\x18\x03\x00\x04\x18\x03\x00\x06\x18\x03\x01\x00\x19\x03\x01\x01\x19\x03\x01\x04\x19\x03\x01\x06\x19\x03\x02\x00\x1a\x03\x02\x01\x1a\x03\x02\x04\x1a\x03\x02\x06\x1a\x03\x03\x00\x1b\x03\x03\x01\x1b\x03\x03\x04\x1b\x03\x03\x06\x1b\x03\x04\x00\x1c\x03\x04\x01\x1c\x03\x04\x04\x1c\x03\x04\x06\x1c\x03\x05\x00\x1d\x03\x05\x01\x1d\x03\x05\x04\x1d\x03\x05\x06\x1d\x03\x06\x00\x1e\x03\x06\x01\x1e\x03\x06\x04\x1e\x03\x06\x06\x1e\x03\a\x00\x1f\x03\a\x01\x1f\x03\a\x04\x1f\x03\a\x06\x1f",
+		cover: "\x00\x00\x00\x00\x00\x00\x03\x00\x00\x00\x04\x00\x00\x00\a\x00\x00\x01\x00\x01\x00\x00\x00\x00\x00\x00\x02\x00\x00\x01\x03\x01\x00\x00\x04\x00\x00\x01\x04\x01\x00\x00\x05\x00\x00\x00\x06\x00\x00\x01\a\x01\x00\x02\x00\x02\x00\x01\x00\x01\x00\x00\x01\x00\x00\x01\x02\x01\x00\x02\x03\x02\x00\x00\x04\x00\x00\x01\x04\x01\x00\x02\x04\x02\x00\x01\x05\x01\x00\x01\x06\x01\x00\x00\x06\x00\x00\x02\a\x02\x00\x03\x00\x03\x00\x02\x00\x02\x00\x00\x01\x00\x00\x01\x01\x01\x00\x02\x02\x02\x00\x03\x03\x03\x00\x01\x04\x01\x00\x02\x04\x02\x00\x03\x04\x03\x00\x02\x05\x02\x00\x02\x06\x02\x00\x01\x06\x01\x00\x00\x06\x00\x00\x03\a\x03\x00\x04\x00\x04\x00\x03\x00\x03\x00\x01\x01\x01\x00\x02\x01\x02\x00\x03\x02\x03\x00\x04\x03\x04\x00\x02\x04\x02\x00\x03\x04\x03\x00\x04\x04\x04\x00\x03\x05\x03\x00\x03\x06\x03\x00\x02\x06\x02\x00\x01\x06\x01\x00\x04\a\x04\x00\x05\x00\x05\x00\x04\x00\x04\x00\x02\x01\x02\x00\x03\x01\x03\x00\x04\x02\x04\x00\x05\x03\x05\x00\x03\x04\x03\x00\x04\x04\x04\x00\x05\x04\x05\x00\x04\x05\x04\x00\x04\x06\x04\x00\x03\x06\x03\x00\x02\x06\x02\x00\x05\a\x05\x00\x06\x00\x06\x00\x05\x00\x05\x00\x03\x01\x03\x00\x04\x01\x04\x00\x05\x02\x05\x00\x06\x03\x06\x00\x04\x04\x04\x00\x05\x04\x05\x00\x06\x04\x06\x00\x05\x05\x05\x00\x05\x06\x05\x00\x04\x06\x04\x00\x03\x06\x03\x00\x06\a\x06\x00\a\x00\a\x00\x06\x00\x06\x00\x04\x01\x04\x00\x05\x01\x05\x00\x06\x02\x06\x00\a\x03\a\x00\x05\x04\x05\x00\x06\x04\x06\x00\a\x04\a\x00\x06\x05\x06\x00\x06\x06\x06\x00\x05\x06\x05\x00\x04\x06\x04\x00\a\a\a\x00\a\x00\a\x00\x05\x01\x05\x00\x06\x01\x06\x00\a\x02\a\x00\b\x03\b\x00\x06\x04\x06\x00\a\x04\a\x00\a\x05\a\x00\a\x06\a\x00\x06\x06\x06\x00\x05\x06\x05\x00\b\a\b\x00\x06\x01\x06\x00\a\x01\a\x00\b\x02\b\x00\t\x03\t\x00\a\x04\a\x00\b\x05\b\x00\a\x06\a\x00\x06\x06\x06\x00\t\a\t\x00\a\x01\a\x00\t\x02\t\x00\t\x05\t\x00\a\x06\a\x01\x00\x00\b\x00\x00\x01\x00\x00\x00\x02\x00\x00\x00\x03\x00\x01\x00\x03\n\x01\x00\x04\b\x00\x00\x06\x00\x01\x00\a\n\x00\x00\a\x00\x01\x01\x00\t\x01\x00\x00\b\x00\x00\x00\x00\x00\x00\x01\x00\x00\x01\x01\x01\x01\x00\x02\n\x00\x00\x02\x00\x00\x01\x02\x01\x00\x01\x03\x01\x01\x01\x03\v\x01\x00\x04\b\x01\x01\x04\t\x00\x00\x05\x00\x01\x00\x05\n\x00\x01\x06\x01\x00\x00\x06\x00\x01\x00\x06\b\x01\x01\a\v\x00\x01\a\x01\x00\x00\a\x00\x01\x02\x00\n\x01\x01\x00\t\x00\x01\x00\x01\x00\x00\x00\x00\x01\x00\x01\b\x00\x00\x01\x00\x00\x01\x01\x01\x00\x02\x01\x02\x01\x01\x02\v\x00\x01\x02\x01\x00\x02\x02\x02\x00\x02\x03\x02\x01\x02\x03\f\x00\x00\x04\x00\x01\x00\x04\b\x01\x01\x04\t\x01\x02\x04\n\x00\x01\x05\x01\x01\x01\x05\v\x00\x02\x06\x02\x00\x01\x06\x01\x01\x01\x06\t\x01\x00\x06\b\x01\x02\a\f\x00\x02\a\x02\x00\x01\a\x01\x01\x03\x00\v\x01\x02\x00\n\x00\x02\x00\x02\x00\x01\x00\x01\x00\x00\x00\x00\x01\x00\x01\b\x01\x01\x01\t\x00\x01\x01\x01\x00\x02\x01\x02\x00\x03\x01\x03\x01\x02\x02\f\x00\x02\x02\x02\x00\x03\x02\x03\x00\x03\x03\x03\x01\x03\x03\r\x00\x00\x04\x00\x00\x01\x04\x01\x01\x01\x04\t\x01\x02\x04\n\x01\x03\x04\v\x00\x02\x05\x02\x01\x02\x05\f\x00\x03\x06\x03\x00\x02\x06\x02\x01\x02\x06\n\x01\x01\x06\t\x01\x00\x06\b\x01\x03\a\r\x00\x03\a\x03\x00\x02\a\x02\x01\x04\x00\f\x01\x03\x00\v\x00\x03\x00\x03\x00\x02\x00\x02\x00\x01\x00\x01\x01\x01\x01\t\x01\x02\x01\n\x00\x02\x01\x02\x00\x03\x01\x03\x00\x04\x01\x04\x01\x03\x02\r\x00\x03\x02\x03\x00\x04\x02\x04\x00\x04\x03\x04\x01\x04\x03\x0e\x00\x01\x04\x01\x00\x02\x04\x02\x01\x02\x04\n\x01\x03\x04\v\x01\x04\x04\f\x00\x03\x05\x03\x01\x03\x05\r\x00\x04\x06\x04\x00\x03\x06\x03\x01\x03\x06\v\x01\x02\x06\n\x01\x01\x06\t\x01\x04\a\x0e\x00\x04\a\x04\x00\x03\a\x03\x01\x05\x00\r\x01\x04\x00\f\x00\x04\x00\x04\x00\x03\x00\x03\x00\x02\x00\x02\x01\x02\x01\n\x01\x03\x01\v\x00\x03\x01\x03\x00\x04\x01\x04\x00\x05\x01\x05\x01\x04\x02\x0e\x00\x04\x02\x04\x00\x05\x02\x05\x00\x05\x03\x05\x01\x05\x03\x0f\x00\x02\x04\x02\x00\x03\x04\x03\x01\x03\x04\v\x01\x04\x04\f\x01\x05\x04\r\x00\x04\x05\x04\x01\x04\x05\x0e\x00\x05\x06\x05\x00\x04\x06\x04\x01\x04\x06\f\x01\x03\x06\v\x01\x02\x06\n\x01\x05\a\x0f\x00\x05\a\x05\x00\x04\a\x04\x01\x06\x00\x0e\x01\x05\x00\r\x00\x05\x00\x05\x00\x04\x00\x04\x00\x03\x00\x03\x01\x03\x01\v\x01\x04\x01\f\x00\x04\x01\x04\x00\x05\x01\x05\x00\x06\x01\x06\x01\x05\x02\x0f\x00\x05\x02\x05\x00\x06\x02\x06\x00\x06\x03\x06\x01\x06\x03\x10\x00\x03\x04\x03\x00\x04\x04\x04\x01\x04\x04\f\x01\x05\x04\r\x01\x06\x04\x0e\x00\x05\x05\x05\x01\x05\x05\x0f\x00\x06\x06\x06\x00\x05\x06\x05\x01\x05\x06\r\x01\x04\x06\f\x01\x03\x06\v\x01\x06\a\x10\x00\x06\a\x06\x00\x05\a\x05\x01\a\x00\x0f\x01\x06\x00\x0e\x00\x06\x00\x06\x00\x05\x00\x05\x00\x04\x00\x04\x01\x04\x01\f\x01\x05\x01\r\x00\x05\x01\x05\x00\x06\x01\x06\x00\a\x01\a\x01\x06\x02\x10\x00\x06\x02\x06\x00\a\x02\a\x00\a\x03\a\x01\a\x03\x11\x00\x04\x04\x04\x00\x05\x04\x05\x01\x05\x04\r\x01\x06\x04\x0e\x01\a\x04\x0f\x00\x06\x05\x06\x01\x06\x05\x10\x00\a\x06\a\x00\x06\x06\x06\x01\x06\x06\x0e\x01\x05\x06\r\x01\x04\x06\f\x01\a\a\x11\x00\a\a\a\x00\x06\a\x06\x01\a\x00\x0f\x00\a\x00\a\x00\x06\x00\x06\x00\x05\x00\x05\x01\x05\x01\r\x01\x06\x01\x0e\x00\x06\x01\x06\x00\a\x01\a\x01\a\x02\x11\x00\a\x02\a\x00\b\x02\b\x00\b\x03\b\x01\b\x03\x12\x00\x05\x04\x05\x00\x06\x04\x06\x01\x06\x04\x0e\x01\a\x04\x0f\x00\a\x05\a\x01\a\x05\x11\x00\a\x06\a\x01\a\x06\x0f\x01\x06\x06\x0e\x01\x05\x06\r\x01\b\a\x12\x00\b\a\b\x00\a\a\a\x00\a\x00\a\x00\x06\x00\x06\x01\x06\x01\x0e\x01\a\x01\x0f\x00\a\x01\a\x01\b\x02\x12\x00\b\x02\b\x00\t\x02\t\x00\t\x03\t\x01\t\x03\x13\x00\x06\x04\x06\x00\a\x04\a\x01\a\x04\x0f\x00\b\x05\b\x01\b\x05\x12\x01\a\x06\x0f\x01\x06\x06\x0e\x01\t\a\x13\x00\t\a\t\x00\b\a\b\x00\a\x00\a\x01\a\x01\x0f\x01\t\x02\x13\x00\t\x02\t\x00\a\x04\a\x00\t\x05\t\x01\t\x05\x13\x01\a\x06\x0f\x00\t\a\t\x02\x00\x00\x10\x01\x00\x01\b\x01\x00\x02\n\x00\x00\x02\x00\x00\x00\x03\x00\x01\x00\x03\n\x02\x00\x04\x10\x00\x00\x05\x00\x01\x00\x06\b\x01\x00\a\n\x02\x01\x00\x11\x02\x00\x00\x10\x01\x00\x00\b\x01\x00\x01\b\x01\x01\x01\t\x01\x00\x02\n\x01\x01\x02\v\x00\x01\x02\x01\x00\x00\x03\x00\x00\x01\x03\x01\x01\x01\x03\v\x02\x00\x04\x10\x02\x01\x04\x11\x00\x01\x05\x01\x00\x00\x05\x00\x01\x00\x05\n\x01\x01\x06\t\x01\x00\x06\b\x02\x00\x06\x10\x01\x01\a\v\x01\x00\a\n\x00\x00\a\x00\x02\x02\x00\x12\x02\x01\x00\x11\x01\x01\x00\t\x01\x00\x00\b\x02\x00\x01\x10\x01\x00\x01\b\x01\x01\x01\t\x01\x02\x01\n\x01\x01\x02\v\x01\x02\x02\f\x00\x02\x02\x02\x00\x01\x03\x01\x00\x02\x03\x02\x01\x02\x03\f\x01\x00\x04\b\x02\x00\x04\x10\x02\x01\x04\x11\x02\x02\x04\x12\x00\x02\x05\x02\x00\x01\x05\x01\x01\x01\x05\v\x01\x02\x06\n\x01\x01\x06\t\x02\x01\x06\x11\x02\x00\x06\x10\x01\x02\a\f\x01\x01\a\v\x00\x01\a\x01\x02\x03\x00\x13\x02\x02\x00\x12\x01\x02\x00\n\x01\x01\x00\t\x01\x00\x00\b\x02\x00\x01\x10\x02\x01\x01\x11\x01\x01\x01\t\x01\x02\x01\n\x01\x03\x01\v\x01\x02\x02\f\x01\x03\x02\r\x00\x03\x02\x03\x00\x02\x03\x02\x00\x03\x03\x03\x01\x03\x03\r\x01\x00\x04\b\x01\x01\x04\t\x02\x01\x04\x11\x02\x02\x04\x12\x02\x03\x04\x13\x00\x03\x05\x03\x00\x02\x05\x02\x01\x02\x05\f\x01\x03\x06\v\x01\x02\x06\n\x02\x02\x06\x12\x02\x01\x06\x11\x02\x00\x06\x10\x01\x03\a\r\x01\x02\a\f\x00\x02\a\x02\x02\x04\x00\x14\x02\x03\x00\x13\x01\x03\x00\v\x01\x02\x00\n\x01\x01\x00\t\x02\x01\x01\x11\x02\x02\x01\x12\x01\x02\x01\n\x01\x03\x01\v\x01\x04\x01\f\x01\x03\x02\r\x01\x04\x02\x0e\x00\x04\x02\x04\x00\x03\x03\x03\x00\x04\x03\x04\x01\x04\x03\x0e\x01\x01\x04\t\x01\x02\x04\n\x02\x02\x04\x12\x02\x03\x04\x13\x02\x04\x04\x14\x00\x04\x05\x04\x00\x03\x05\x03\x01\x03\x05\r\x01\x04\x06\f\x01\x03\x06\v\x02\x03\x06\x13\x02\x02\x06\x12\x02\x01\x06\x11\x01\x04\a\x0e\x01\x03\a\r\x00\x03\a\x03\x02\x05\x00\x15\x02\x04\x00\x14\x01\x04\x00\f\x01\x03\x00\v\x01\x02\x00\n\x02\x02\x01\x12\x02\x03\x01\x13\x01\x03\x01\v\x01\x04\x01\f\x01\x05\x01\r\x01\x04\x02\x0e\x01\x05\x02\x0f\x00\x05\x02\x05\x00\x04\x03\x04\x00\x05\x03\x05\x01\x05\x03\x0f\x01\x02\x04\n\x01\x03\x04\v\x02\x03\x04\x13\x02\x04\x04\x14\x02\x05\x04\x15\x00\x05\x05\x05\x00\x04\x05\x04\x01\x04\x05\x0e\x01\x05\x06\r\x01\x04\x06\f\x02\x04\x06\x14\x02\x03\x06\x13\x02\x02\x06\x12\x01\x05\a\x0f\x01\x04\a\x0e\x00\x04\a\x04\x02\x06\x00\x16\x02\x05\x00\x15\x01\x05\x00\r\x01\x04\x00\f\x01\x03\x00\v\x02\x03\x01\x13\x02\x04\x01\x14\x01\x04\x01\f\x01\x05\x01\r\x01\x06\x01\x0e\x01\x05\x02\x0f\x01\x06\x02\x10\x00\x06\x02\x06\x00\x05\x03\x05\x00\x06\x03\x06\x01\x06\x03\x10\x01\x03\x04\v\x01\x04\x04\f\x02\x04\x04\x14\x02\x05\x04\x15\x02\x06\x04\x16\x00\x06\x05\x06\x00\x05\x05\x05\x01\x05\x05\x0f\x01\x06\x06\x0e\x01\x05\x06\r\x02\x05\x06\x15\x02\x04\x06\x14\x02\x03\x06\x13\x01\x06\a\x10\x01\x05\a\x0f\x00\x05\a\x05\x02\a\x00\x17\x02\x06\x00\x16\x01\x06\x00\x0e\x01\x05\x00\r\x01\x04\x00\f\x02\x04\x01\x14\x02\x05\x01\x15\x01\x05\x01\r\x01\x06\x01\x0e\x01\a\x01\x0f\x01\x06\x02\x10\x01\a\x02\x11\x00\a\x02\a\x00\x06\x03\x06\x00\a\x03\a\x01\a\x03\x11\x01\x04\x04\f\x01\x05\x04\r\x02\x05\x04\x15\x02\x06\x04\x16\x02\a\x04\x17\x00\a\x05\a\x00\x06\x05\x06\x01\x06\x05\x10\x01\a\x06\x0f\x01\x06\x06\x0e\x02\x06\x06\x16\x02\x05\x06\x15\x02\x04\x06\x14\x01\a\a\x11\x01\x06\a\x10\x00\x06\a\x06\x02\a\x00\x17\x01\a\x00\x0f\x01\x06\x00\x0e\x01\x05\x00\r\x02\x05\x01\x15\x02\x06\x01\x16\x01\x06\x01\x0e\x01\a\x01\x0f\x01\a\x02\x11\x01\b\x02\x12\x00\b\x02\b\x00\a\x03\a\x00\b\x03\b\x01\b\x03\x12\x01\x05\x04\r\x01\x06\x04\x0e\x02\x06\x04\x16\x02\a\x04\x17\x00\b\x05\b\x00\a\x05\a\x01\a\x05\x11\x01\a\x06\x0f\x02\a\x06\x17\x02\x06\x06\x16\x02\x05\x06\x15\x01\b\a\x12\x01\a\a\x11\x00\a\a\a\x01\a\x00\x0f\x01\x06\x00\x0e\x02\x06\x01\x16\x02\a\x01\x17\x01\a\x01\x0f\x01\b\x02\x12\x01\t\x02\x13\x00\t\x02\t\x00\b\x03\b\x00\t\x03\t\x01\t\x03\x13\x01\x06\x04\x0e\x01\a\x04\x0f\x02\a\x04\x17\x00\t\x05\t\x00\b\x05\b\x01\b\x05\x12\x02\a\x06\x17\x02\x06\x06\x16\x01\t\a\x13\x01\b\a\x12\x00\b\a\b\x01\a\x00\x0f\x02\a\x01\x17\x01\t\x02\x13\x00\t\x03\t\x01\a\x04\x0f\x00\t\x05\t\x01\t\x05\x13\x02\a\x06\x17\x01\t\a\x13\x00\t\a\t\x03\x00\x00\x18\x02\x00\x01\x10\x01\x00\x02\n\x00\x00\x02\x00\x01\x00\x03\n\x03\x00\x04\x18\x00\x00\x05\x00\x01\x00\x05\n\x02\x00\x06\x10\x03\x01\x00\x19\x03\x00\x00\x18\x02\x00\x00\x10\x02\x00\x01\x10\x02\x01\x01\x11\x01\x01\x02\v\x00\x01\x02\x01\x00\x00\x03\x00\x01\x00\x03\n\x01\x01\x03\v\x03\x00\x04\x18\x03\x01\x04\x19\x00\x01\x05\x01\x01\x01\x05\v\x01\x00\x05\n\x02\x01\x06\x11\x02\x00\x06\x10\x03\x00\x06\x18\x01\x00\a\n\x00\x00\a\x00\x03\x02\x00\x1a\x03\x01\x00\x19\x02\x01\x00\x11\x02\x00\x00\x10\x03\x00\x01\x18\x02\x00\x01\x10\x02\x01\x01\x11\x02\x02\x01\x12\x01\x02\x02\f\x00\x02\x02\x02\x00\x01\x03\x01\x01\x01\x03\v\x01\x02\x03\f\x02\x00\x04\x10\x03\x00\x04\x18\x03\x01\x04\x19\x03\x02\x04\x1a\x00\x02\x05\x02\x01\x02\x05\f\x01\x01\x05\v\x02\x02\x06\x12\x02\x01\x06\x11\x03\x01\x06\x19\x03\x00\x06\x18\x01\x01\a\v\x00\x01\a\x01\x03\x03\x00\x1b\x03\x02\x00\x1a\x02\x02\x00\x12\x02\x01\x00\x11\x02\x00\x00\x10\x03\x00\x01\x18\x03\x01\x01\x19\x02\x01\x01\x11\x02\x02\x01\x12\x02\x03\x01\x13\x01\x03\x02\r\x00\x03\x02\x03\x00\x02\x03\x02\x01\x02\x03\f\x01\x03\x03\r\x02\x00\x04\x10\x02\x01\x04\x11\x03\x01\x04\x19\x03\x02\x04\x1a\x03\x03\x04\x1b\x00\x03\x05\x03\x01\x03\x05\r\x01\x02\x05\f\x02\x03\x06\x13\x02\x02\x06\x12\x03\x02\x06\x1a\x03\x01\x06\x19\x03\x00\x06\x18\x01\x02\a\f\x00\x02\a\x02\x03\x04\x00\x1c\x03\x03\x00\x1b\x02\x03\x00\x13\x02\x02\x00\x12\x02\x01\x00\x11\x03\x01\x01\x19\x03\x02\x01\x1a\x02\x02\x01\x12\x02\x03\x01\x13\x02\x04\x01\x14\x01\x04\x02\x0e\x00\x04\x02\x04\x00\x03\x03\x03\x01\x03\x03\r\x01\x04\x03\x0e\x02\x01\x04\x11\x02\x02\x04\x12\x03\x02\x04\x1a\x03\x03\x04\x1b\x03\x04\x04\x1c\x00\x04\x05\x04\x01\x04\x05\x0e\x01\x03\x05\r\x02\x04\x06\x14\x02\x03\x06\x13\x03\x03\x06\x1b\x03\x02\x06\x1a\x03\x01\x06\x19\x01\x03\a\r\x00\x03\a\x03\x03\x05\x00\x1d\x03\x04\x00\x1c\x02\x04\x00\x14\x02\x03\x00\x13\x02\x02\x00\x12\x03\x02\x01\x1a\x03\x03\x01\x1b\x02\x03\x01\x13\x02\x04\x01\x14\x02\x05\x01\x15\x01\x05\x02\x0f\x00\x05\x02\x05\x00\x04\x03\x04\x01\x04\x03\x0e\x01\x05\x03\x0f\x02\x02\x04\x12\x02\x03\x04\x13\x03\x03\x04\x1b\x03\x04\x04\x1c\x03\x05\x04\x1d\x00\x05\x05\x05\x01\x05\x05\x0f\x01\x04\x05\x0e\x02\x05\x06\x15\x02\x04\x06\x14\x03\x04\x06\x1c\x03\x03\x06\x1b\x03\x02\x06\x1a\x01\x04\a\x0e\x00\x04\a\x04\x03\x06\x00\x1e\x03\x05\x00\x1d\x02\x05\x00\x15\x02\x04\x00\x14\x02\x03\x00\x13\x03\x03\x01\x1b\x03\x04\x01\x1c\x02\x04\x01\x14\x02\x05\x01\x15\x02\x06\x01\x16\x01\x06\x02\x10\x00\x06\x02\x06\x00\x05\x03\x05\x01\x05\x03\x0f\x01\x06\x03\x10\x02\x03\x04\x13\x02\x04\x04\x14\x03\x04\x04\x1c\x03\x05\x04\x1d\x03\x06\x04\x1e\x00\x06\x05\x06\x01\x06\x05\x10\x01\x05\x05\x0f\x02\x06\x06\x16\x02\x05\x06\x15\x03\x05\x06\x1d\x03\x04\x06\x1c\x03\x03\x06\x1b\x01\x05\a\x0f\x00\x05\a\x05\x03\a\x00\x1f\x03\x06\x00\x1e\x02\x06\x00\x16\x02\x05\x00\x15\x02\x04\x00\x14\x03\x04\x01\x1c\x03\x05\x01\x1d\x02\x05\x01\x15\x02\x06\x01\x16\x02\a\x01\x17\x01\a\x02\x11\x00\a\x02\a\x00\x06\x03\x06\x01\x06\x03\x10\x01\a\x03\x11\x02\x04\x04\x14\x02\x05\x04\x15\x03\x05\x04\x1d\x03\x06\x04\x1e\x03\a\x04\x1f\x00\a\x05\a\x01\a\x05\x11\x01\x06\x05\x10\x02\a\x06\x17\x02\x06\x06\x16\x03\x06\x06\x1e\x03\x05\x06\x1d\x03\x04\x06\x1c\x01\x06\a\x10\x00\x06\a\x06\x03\a\x00\x1f\x02\a\x00\x17\x02\x06\x00\x16\x02\x05\x00\x15\x03\x05\x01\x1d\x03\x06\x01\x1e\x02\x06\x01\x16\x02\a\x01\x17\x01\b\x02\x12\x00\b\x02\b\x00\a\x03\a\x01\a\x03\x11\x01\b\x03\x12\x02\x05\x04\x15\x02\x06\x04\x16\x03\x06\x04\x1e\x03\a\x04\x1f\x00\b\x05\b\x01\b\x05\x12\x01\a\x05\x11\x02\a\x06\x17\x03\a\x06\x1f\x03\x06\x06\x1e\x03\x05\x06\x1d\x01\a\a\x11\x00\a\a\a\x02\a\x00\x17\x02\x06\x00\x16\x03\x06\x01\x1e\x03\a\x01\x1f\x02\a\x01\x17\x01\t\x02\x13\x00\t\x02\t\x00\b\x03\b\x01\b\x03\x12\x01\t\x03\x13\x02\x06\x04\x16\x02\a\x04\x17\x03\a\x04\x1f\x00\t\x05\t\x01\t\x05\x13\x01\b\x05\x12\x03\a\x06\x1f\x03\x06\x06\x1e\x01\b\a\x12\x00\b\a\b\x02\a\x00\x17\x03\a\x01\x1f\x00\t\x03\t\x01\t\x03\x13\x02\a\x04\x17\x01\t\x05\x13\x03\a\x06\x1f\x01\t\a\x13\x00\t\a\t\x03\x00\x01\x18\x01\x00\x02\n\x01\x00\x05\n\x03\x00\x06\x18\x03\x00\x00\x18\x03\x00\x01\x18\x03\x01\x01\x19\x01\x01\x02\v\x01\x00\x03\n\x01\x01\x05\v\x03\x01\x06\x19\x03\x00\x06\x18\x01\x00\a\n\x03\x01\x00\x19\x03\x00\x00\x18\x03\x00\x01\x18\x03\x01\x01\x19\x03\x02\x01\x1a\x01\x02\x02\f\x01\x01\x03\v\x03\x00\x04\x18\x01\x02\x05\f\x03\x02\x06\x1a\x03\x01\x06\x19\x01\x01\a\v\x03\x02\x00\x1a\x03\x01\x00\x19\x03\x00\x00\x18\x03\x01\x01\x19\x03\x02\x01\x1a\x03\x03\x01\x1b\x01\x03\x02\r\x01\x02\x03\f\x03\x00\x04\x18\x03\x01\x04\x19\x01\x03\x05\r\x03\x03\x06\x1b\x03\x02\x06\x1a\x01\x02\a\f\x03\x03\x00\x1b\x03\x02\x00\x1a\x03\x01\x00\x19\x03\x02\x01\x1a\x03\x03\x01\x1b\x03\x04\x01\x1c\x01\x04\x02\x0e\x01\x03\x03\r\x03\x01\x04\x19\x03\x02\x04\x1a\x01\x04\x05\x0e\x03\x04\x06\x1c\x03\x03\x06\x1b\x01\x03\a\r\x03\x04\x00\x1c\x03\x03\x00\x1b\x03\x02\x00\x1a\x03\x03\x01\x1b\x03\x04\x01\x1c\x03\x05\x01\x1d\x01\x05\x02\x0f\x01\x04\x03\x0e\x03\x02\x04\x1a\x03\x03\x04\x1b\x01\x05\x05\x0f\x03\x05\x06\x1d\x03\x04\x06\x1c\x01\x04\a\x0e\x03\x05\x00\x1d\x03\x04\x00\x1c\x03\x03\x00\x1b\x03\x04\x01\x1c\x03\x05\x01\x1d\x03\x06\x01\x1e\x01\x06\x02\x10\x01\x05\x03\x0f\x03\x03\x04\x1b\x03\x04\x04\x1c\x01\x06\x05\x10\x03\x06\x06\x1e\x03\x05\x06\x1d\x01\x05\a\x0f\x03\x06\x00\x1e\x03\x05\x00\x1d\x03\x04\x00\x1c\x03\x05\x01\x1d\x03\x06\x01\x1e\x03\a\x01\x1f\x01\a\x02\x11\x01\x06\x03\x10\x03\x04\x04\x1c\x03\x05\x04\x1d\x01\a\x05\x11\x03\a\x06\x1f\x03\x06\x06\x1e\x01\x06\a\x10\x03\a\x00\x1f\x03\x06\x00\x1e\x03\x05\x00\x1d\x03\x06\x01\x1e\x03\a\x01\x1f\x01\b\x02\x12\x01\a\x03\x11\x03\x05\x04\x1d\x03\x06\x04\x1e\x01\b\x05\x12\x03\a\x06\x1f\x01\a\a\x11\x03\a\x00\x1f\x03\x06\x00\x1e\x03\a\x01\x1f\x01\t\x02\x13\x01\b\x03\x12\x03\x06\x04\x1e\x03\a\x04\x1f\x01\t\x05\x13\x01\b\a\x12\x03\a\x00\x1f\x01\t\x03\x13\x03\a\x04\x1f\x01\t\a\x13",
+		start: []int32{0, 4, 13, 25, 39, 53, 67, 81, 95, 107, 116, 120, 129, 149, 175, 205, 235, 265, 295, 325, 351, 371, 380, 390, 412, 440, 472, 504, 536, 568, 600, 628, 650, 660, 669, 689, 715, 745, 775, 805, 835, 865, 891, 911, 920, 924, 933, 945, 959, 973, 987, 1001, 1015, 1027, 1036, 1040},
+	},
+	{rows: 5, cols: 11, cells: "0,0;0,1;1,1;2,1;2,2;", sym: true, n: 108,
+		versions: []generatedVersion{
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0x1801003, 0x3002006, 0x600400c, 0xc008018, 0x18010030, 0x30020060, 0x600400c0, 0xc0080180, 0x180100300, 0xc00801800, 0x1801003000, 0x3002006000, 0x600400c000, 0xc008018000, 0x18010030000, 0x30020060000, 0x600400c0000, 0xc0080180000, 0x600400c00000, 0xc00801800000, 0x1801003000000, 0x3002006000000, 0x600400c000000, 0xc008018000000, 0x18010030000000, 0x30020060000000, 0x600400c0000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0xc01006, 0x180200c, 0x3004018, 0x6008030, 0xc010060, 0x180200c0, 0x30040180, 0x60080300, 0xc0100600, 0x600803000, 0xc01006000, 0x180200c000, 0x3004018000, 0x6008030000, 0xc010060000, 0x180200c0000, 0x30040180000, 0x60080300000, 0x300401800000, 0x600803000000, 0xc01006000000, 0x180200c000000, 0x3004018000000, 0x6008030000000, 0xc010060000000, 0x180200c0000000, 0x30040180000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0x403804, 0x807008, 0x100e010, 0x201c020, 0x4038040, 0x8070080, 0x100e0100, 0x201c0200, 0x40380400, 0x201c02000, 0x403804000, 0x807008000, 0x100e010000, 0x201c020000, 0x4038040000, 0x8070080000, 0x100e0100000, 0x201c0200000, 0x100e01000000, 0x201c02000000, 0x403804000000, 0x807008000000, 0x100e010000000, 0x201c020000000, 0x4038040000000, 0x8070080000000, 0x100e0100000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0x1003801, 0x2007002, 0x400e004, 0x801c008, 0x10038010, 0x20070020, 0x400e0040, 0x801c0080, 0x100380100, 0x801c00800, 0x1003801000, 0x2007002000, 0x400e004000, 0x801c008000, 0x10038010000, 0x20070020000, 0x400e0040000, 0x801c0080000, 0x400e00400000, 0x801c00800000, 0x1003801000000, 0x2007002000000, 0x400e004000000, 0x801c008000000, 0x10038010000000, 0x20070020000000, 0x400e0040000000}},
+		},
+		spots: "\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x02\x00\x00\x00\x03\x00\x00\x01\x00\x01\x00\x01\x01\x01\x00\x01\x02\x01\x00\x01\x03\x01\x00\x02\x00\x02\x00\x02\x01\x02\x00\x02\x02\x02\x00\x02\x03\x02\x00\x03\x00\x03\x00\x03\x01\x03\x00\x03\x02\x03\x00\x03\x03\x03\x00\x04\x00\x04\x00\x04\x01\x04\x00\x04\x02\x04\x00\x04\x03\x04\x00\x05\x00\x05\x00\x05\x01\x05\x00\x05\x02\x05\x00\x05\x03\x05\x00\x06\x00\x06\x00\x06\x01\x06\x00\x06\x02\x06\x00\x06\x03\x06\x00\a\x00\a\x00\a\x01\a\x00\a\x02\a\x00\a\x03\a\x00\b\x00\b\x00\b\x01\b\x00\b\x02\b\x00\b\x03\b\x01\x00\x00\t\x01\x00\x01\t\x01\x00\x02\t\x01\x00\x03\t\x01\x01\x00\n\x01\x01\x01\n\x01\x01\x02\n\x01\x01\x03\n\x01\x02\x00\v\x01\x02\x01\v\x01\x02\x02\v\x01\x02\x03\v\x01\x03\x00\f\x01\x03\x01\f\x01\x03\x02\f\x01\x03\x03\f\x01\x04\x00\r\x01\x04\x01\r\x01\x04\x02\r\x01\x04\x03\r\x01\x05\x00\x0e\x01\x05\x01\x0e\x01\x05\x02\x0e\x01\x05\x03\x0e\x01\x06\x00\x0f\x01\x06\x01\x0f\x01\x06\x02\x0f\x01\x06\x03\x0f\x01\a\x00\x10\x01\a\x01\x10\x01\a\x02\x10\x01\a\x03\x10\x01\b\x00\x11\x01\b\x01\x11\x01\b\x02\x11\x01\b\x03\x11\x02\x00\x00\x12\x02\x00\x01\x12\x02\x00\x02\x12\x02\x00\x03\x12\x02\x01\x00\x13\x02\x01\x01\x13\x02\x01\x02\x13\x02\x01\x03\x13\x02\x02\x00\x14\x02\x02\x01\x14\x02\x02\x02\x14\x02\x02\x03\x14\x02\x03\x00\x15\x02\x03\x01\x15\x02\x03\x02\x15\x02\x03\x03\x15\x02\x04\x00\x16\x02\x04\x01\x16\x02\x04\x02\x16\x02\x04\x03\x16\x02\x05\x00\x17\x02\x05\x01\x17\x02\x05\x02\x17\x02\x05\x03\x17\x02\x06\x00\x18\x02\x06\x01\x18\x02\x06\x02\x18\x02\x06\x03\x18\x02\a\x00\x19\x02\a\x01\x19\x02\a\x02\x19\x02\a\x03\x19\x02\b\x00\x1a\x02\b\x01\x1a\x02\b\x02\x1a\x02\b\x03\x1a",
+		cover: "\x00\x00\x00\x00\x00\x00\x03\x00\x00\x01\x00\x01\x00\x00\x00\x00\x00\x00\x01\x00\x00\x01\x03\x01\x00\x02\x00\x02\x00\x01\x00\x01\x00\x00\x01\x00\x00\x01\x01\x01\x00\x00\x02\x00\x00\x02\x03\x02\x00\x03\x00\x03\x00\x02\x00\x02\x00\x01\x01\x01\x00\x02\x01\x02\x00\x01\x02\x01\x00\x03\x03\x03\x00\x04\x00\x04\x00\x03\x00\x03\x00\x02\x01\x02\x00\x03\x01\x03\x00\x02\x02\x02\x00\x04\x03\x04\x00\x05\x00\x05\x00\x04\x00\x04\x00\x03\x01\x03\x00\x04\x01\x04\x00\x03\x02\x03\x00\x05\x03\x05\x00\x06\x00\x06\x00\x05\x00\x05\x00\x04\x01\x04\x00\x05\x01\x05\x00\x04\x02\x04\x00\x06\x03\x06\x00\a\x00\a\x00\x06\x00\x06\x00\x05\x01\x05\x00\x06\x01\x06\x00\x05\x02\x05\x00\a\x03\a\x00\b\x00\b\x00\a\x00\a\x00\x06\x01\x06\x00\a\x01\a\x00\x06\x02\x06\x00\b\x03\b\x00\b\x00\b\x00\a\x01\a\x00\b\x01\b\x00\a\x02\a\x00\b\x01\b\x00\b\x02\b\x01\x00\x00\t\x00\x00\x02\x00\x00\x00\x03\x00\x01\x00\x03\t\x01\x01\x00\n\x01\x00\x00\t\x00\x00\x00\x00\x01\x00\x01\t\x00\x00\x01\x00\x00\x00\x02\x00\x00\x01\x02\x01\x00\x00\x03\x00\x00\x01\x03\x01\x01\x01\x03\n\x01\x02\x00\v\x01\x01\x00\n\x00\x01\x00\x01\x01\x00\x01\t\x01\x01\x01\n\x00\x01\x01\x01\x01\x00\x02\t\x00\x00\x02\x00\x00\x01\x02\x01\x00\x02\x02\x02\x00\x00\x03\x00\x00\x01\x03\x01\x00\x02\x03\x02\x01\x02\x03\v\x01\x03\x00\f\x01\x02\x00\v\x00\x02\x00\x02\x01\x01\x01\n\x01\x02\x01\v\x00\x02\x01\x02\x01\x01\x02\n\x00\x01\x02\x01\x00\x02\x02\x02\x00\x03\x02\x03\x00\x01\x03\x01\x00\x02\x03\x02\x00\x03\x03\x03\x01\x03\x03\f\x01\x04\x00\r\x01\x03\x00\f\x00\x03\x00\x03\x01\x02\x01\v\x01\x03\x01\f\x00\x03\x01\x03\x01\x02\x02\v\x00\x02\x02\x02\x00\x03\x02\x03\x00\x04\x02\x04\x00\x02\x03\x02\x00\x03\x03\x03\x00\x04\x03\x04\x01\x04\x03\r\x01\x05\x00\x0e\x01\x04\x00\r\x00\x04\x00\x04\x01\x03\x01\f\x01\x04\x01\r\x00\x04\x01\x04\x01\x03\x02\f\x00\x03\x02\x03\x00\x04\x02\x04\x00\x05\x02\x05\x00\x03\x03\x03\x00\x04\x03\x04\x00\x05\x03\x05\x01\x05\x03\x0e\x01\x06\x00\x0f\x01\x05\x00\x0e\x00\x05\x00\x05\x01\x04\x01\r\x01\x05\x01\x0e\x00\x05\x01\x05\x01\x04\x02\r\x00\x04\x02\x04\x00\x05\x02\x05\x00\x06\x02\x06\x00\x04\x03\x04\x00\x05\x03\x05\x00\x06\x03\x06\x01\x06\x03\x0f\x01\a\x00\x10\x01\x06\x00\x0f\x00\x06\x00\x06\x01\x05\x01\x0e\x01\x06\x01\x0f\x00\x06\x01\x06\x01\x05\x02\x0e\x00\x05\x02\x05\x00\x06\x02\x06\x00\a\x02\a\x00\x05\x03\x05\x00\x06\x03\x06\x00\a\x03\a\x01\a\x03\x10\x01\b\x00\x11\x01\a\x00\x10\x00\a\x00\a\x01\x06\x01\x0f\x01\a\x01\x10\x00\a\x01\a\x01\x06\x02\x0f\x00\x06\x02\x06\x00\a\x02\a\x00\b\x02\b\x00\x06\x03\x06\x00\a\x03\a\x00\b\x03\b\x01\b\x03\x11\x01\b\x00\x11\x00\b\x00\b\x01\a\x01\x10\x01\b\x01\x11\x00\b\x01\b\x01\a\x02\x10\x00\a\x02\a\x00\b\x02\b\x00\a\x03\a\x00\b\x03\b\x01\b\x01\x11\x01\b\x02\x11\x00\b\x02\b\x00\b\x03\b\x02\x00\x00\x12\x00\x00\x01\x00\x01\x00\x02\t\x00\x00\x02\x00\x01\x00\x03\t\x02\x00\x03\x12\x02\x01\x00\x13\x02\x00\x00\x12\x01\x00\x00\t\x00\x00\x00\x00\x02\x00\x01\x12\x01\x00\x01\t\x00\x00\x01\x00\x00\x01\x01\x01\x01\x00\x02\t\x01\x01\x02\n\x00\x01\x02\x01\x01\x00\x03\t\x01\x01\x03\n\x02\x01\x03\x13\x02\x02\x00\x14\x02\x01\x00\x13\x01\x01\x00\n\x00\x01\x00\x01\x00\x00\x00\x00\x02\x00\x01\x12\x02\x01\x01\x13\x01\x01\x01\n\x00\x01\x01\x01\x00\x02\x01\x02\x02\x00\x02\x12\x01\x00\x02\t\x01\x01\x02\n\x01\x02\x02\v\x00\x02\x02\x02\x00\x00\x03\x00\x01\x00\x03\t\x01\x01\x03\n\x01\x02\x03\v\x02\x02\x03\x14\x02\x03\x00\x15\x02\x02\x00\x14\x01\x02\x00\v\x00\x02\x00\x02\x00\x01\x00\x01\x02\x01\x01\x13\x02\x02\x01\x14\x01\x02\x01\v\x00\x02\x01\x02\x00\x03\x01\x03\x02\x01\x02\x13\x01\x01\x02\n\x01\x02\x02\v\x01\x03\x02\f\x00\x03\x02\x03\x00\x01\x03\x01\x01\x01\x03\n\x01\x02\x03\v\x01\x03\x03\f\x02\x03\x03\x15\x02\x04\x00\x16\x02\x03\x00\x15\x01\x03\x00\f\x00\x03\x00\x03\x00\x02\x00\x02\x02\x02\x01\x14\x02\x03\x01\x15\x01\x03\x01\f\x00\x03\x01\x03\x00\x04\x01\x04\x02\x02\x02\x14\x01\x02\x02\v\x01\x03\x02\f\x01\x04\x02\r\x00\x04\x02\x04\x00\x02\x03\x02\x01\x02\x03\v\x01\x03\x03\f\x01\x04\x03\r\x02\x04\x03\x16\x02\x05\x00\x17\x02\x04\x00\x16\x01\x04\x00\r\x00\x04\x00\x04\x00\x03\x00\x03\x02\x03\x01\x15\x02\x04\x01\x16\x01\x04\x01\r\x00\x04\x01\x04\x00\x05\x01\x05\x02\x03\x02\x15\x01\x03\x02\f\x01\x04\x02\r\x01\x05\x02\x0e\x00\x05\x02\x05\x00\x03\x03\x03\x01\x03\x03\f\x01\x04\x03\r\x01\x05\x03\x0e\x02\x05\x03\x17\x02\x06\x00\x18\x02\x05\x00\x17\x01\x05\x00\x0e\x00\x05\x00\x05\x00\x04\x00\x04\x02\x04\x01\x16\x02\x05\x01\x17\x01\x05\x01\x0e\x00\x05\x01\x05\x00\x06\x01\x06\x02\x04\x02\x16\x01\x04\x02\r\x01\x05\x02\x0e\x01\x06\x02\x0f\x00\x06\x02\x06\x00\x04\x03\x04\x01\x04\x03\r\x01\x05\x03\x0e\x01\x06\x03\x0f\x02\x06\x03\x18\x02\a\x00\x19\x02\x06\x00\x18\x01\x06\x00\x0f\x00\x06\x00\x06\x00\x05\x00\x05\x02\x05\x01\x17\x02\x06\x01\x18\x01\x06\x01\x0f\x00\x06\x01\x06\x00\a\x01\a\x02\x05\x02\x17\x01\x05\x02\x0e\x01\x06\x02\x0f\x01\a\x02\x10\x00\a\x02\a\x00\x05\x03\x05\x01\x05\x03\x0e\x01\x06\x03\x0f\x01\a\x03\x10\x02\a\x03\x19\x02\b\x00\x1a\x02\a\x00\x19\x01\a\x00\x10\x00\a\x00\a\x00\x06\x00\x06\x02\x06\x01\x18\x02\a\x01\x19\x01\a\x01\x10\x00\a\x01\a\x00\b\x01\b\x02\x06\x02\x18\x01\x06\x02\x0f\x01\a\x02\x10\x01\b\x02\x11\x00\b\x02\b\x00\x06\x03\x06\x01\x06\x03\x0f\x01\a\x03\x10\x01\b\x03\x11\x02\b\x03\x1a\x02\b\x00\x1a\x01\b\x00\x11\x00\b\x00\b\x00\a\x00\a\x02\a\x01\x19\x02\b\x01\x1a\x01\b\x01\x11\x00\b\x01\b\x02\a\x02\x19\x01\a\x02\x10\x01\b\x02\x11\x00\a\x03\a\x01\a\x03\x10\x01\b\x03\x11\x00\b\x00\b\x02\b\x01\x1a\x02\b\x02\x1a\x01\b\x02\x11\x00\b\x03\b\x01\b\x03\x11\x01\x00\x01\t\x02\x00\x02\x12\x01\x00\x02\t\x02\x00\x03\x12\x02\x00\x00\x12\x01\x00\x00\t\x02\x00\x01\x12\x01\x00\x01\t\x01\x01\x01\n\x02\x00\x02\x12\x02\x01\x02\x13\x01\x01\x02\n\x02\x00\x03\x12\x02\x01\x03\x13\x02\x01\x00\x13\x01\x01\x00\n\x01\x00\x00\t\x02\x01\x01\x13\x01\x01\x01\n\x01\x02\x01\v\x02\x00\x02\x12\x02\x01\x02\x13\x02\x02\x02\x14\x01\x02\x02\v\x01\x00\x03\t\x02\x00\x03\x12\x02\x01\x03\x13\x02\x02\x03\x14\x02\x02\x00\x14\x01\x02\x00\v\x01\x01\x00\n\x02\x02\x01\x14\x01\x02\x01\v\x01\x03\x01\f\x02\x01\x02\x13\x02\x02\x02\x14\x02\x03\x02\x15\x01\x03\x02\f\x01\x01\x03\n\x02\x01\x03\x13\x02\x02\x03\x14\x02\x03\x03\x15\x02\x03\x00\x15\x01\x03\x00\f\x01\x02\x00\v\x02\x03\x01\x15\x01\x03\x01\f\x01\x04\x01\r\x02\x02\x02\x14\x02\x03\x02\x15\x02\x04\x02\x16\x01\x04\x02\r\x01\x02\x03\v\x02\x02\x03\x14\x02\x03\x03\x15\x02\x04\x03\x16\x02\x04\x00\x16\x01\x04\x00\r\x01\x03\x00\f\x02\x04\x01\x16\x01\x04\x01\r\x01\x05\x01\x0e\x02\x03\x02\x15\x02\x04\x02\x16\x02\x05\x02\x17\x01\x05\x02\x0e\x01\x03\x03\f\x02\x03\x03\x15\x02\x04\x03\x16\x02\x05\x03\x17\x02\x05\x00\x17\x01\x05\x00\x0e\x01\x04\x00\r\x02\x05\x01\x17\x01\x05\x01\x0e\x01\x06\x01\x0f\x02\x04\x02\x16\x02\x05\x02\x17\x02\x06\x02\x18\x01\x06\x02\x0f\x01\x04\x03\r\x02\x04\x03\x16\x02\x05\x03\x17\x02\x06\x03\x18\x02\x06\x00\x18\x01\x06\x00\x0f\x01\x05\x00\x0e\x02\x06\x01\x18\x01\x06\x01\x0f\x01\a\x01\x10\x02\x05\x02\x17\x02\x06\x02\x18\x02\a\x02\x19\x01\a\x02\x10\x01\x05\x03\x0e\x02\x05\x03\x17\x02\x06\x03\x18\x02\a\x03\x19\x02\a\x00\x19\x01\a\x00\x10\x01\x06\x00\x0f\x02\a\x01\x19\x01\a\x01\x10\x01\b\x01\x11\x02\x06\x02\x18\x02\a\x02\x19\x02\b\x02\x1a\x01\b\x02\x11\x01\x06\x03\x0f\x02\x06\x03\x18\x02\a\x03\x19\x02\b\x03\x1a\x02\b\x00\x1a\x01\b\x00\x11\x01\a\x00\x10\x02\b\x01\x1a\x01\b\x01\x11\x02\a\x02\x19\x02\b\x02\x1a\x01\a\x03\x10\x02\a\x03\x19\x02\b\x03\x1a\x01\b\x00\x11\x02\b\x02\x1a\x01\b\x03\x11\x02\b\x03\x1a\x02\x00\x01\x12\x02\x00\x02\x12\x02\x00\x00\x12\x02\x00\x01\x12\x02\x01\x01\x13\x02\x01\x02\x13\x02\x01\x00\x13\x02\x00\x00\x12\x02\x01\x01\x13\x02\x02\x01\x14\x02\x02\x02\x14\x02\x00\x03\x12\x02\x02\x00\x14\x02\x01\x00\x13\x02\x02\x01\x14\x02\x03\x01\x15\x02\x03\x02\x15\x02\x01\x03\x13\x02\x03\x00\x15\x02\x02\x00\x14\x02\x03\x01\x15\x02\x04\x01\x16\x02\x04\x02\x16\x02\x02\x03\x14\x02\x04\x00\x16\x02\x03\x00\x15\x02\x04\x01\x16\x02\x05\x01\x17\x02\x05\x02\x17\x02\x03\x03\x15\x02\x05\x00\x17\x02\x04\x00\x16\x02\x05\x01\x17\x02\x06\x01\x18\x02\x06\x02\x18\x02\x04\x03\x16\x02\x06\x00\x18\x02\x05\x00\x17\x02\x06\x01\x18\x02\a\x01\x19\x02\a\x02\x19\x02\x05\x03\x17\x02\a\x00\x19\x02\x06\x00\x18\x02\a\x01\x19\x02\b\x01\x1a\x02\b\x02\x1a\x02\x06\x03\x18\x02\b\x00\x1a\x02\a\x00\x19\x02\b\x01\x1a\x02\a\x03\x19\x02\b\x00\x1a\x02\b\x03\x1a",
+		start: []int32{0, 2, 6, 12, 18, 24, 30, 36, 42, 48, 52, 54, 58, 68, 82, 96, 110, 124, 138, 152, 166, 176, 180, 186, 200, 220, 240, 260, 280, 300, 320, 340, 354, 360, 364, 374, 388, 402, 416, 430, 444, 458, 472, 482, 486, 488, 492, 498, 504, 510, 516, 522, 528, 534, 538, 540},
+	},
+	{rows: 5, cols: 11, cells: "0,0;0,2;1,0;1,1;1,2;", sym: false, n: 132,
+		versions: []generatedVersion{
+			{lo: Pos{0, 0}, n: Pos{4, 9}, masks: []uint64{0x3805, 0x700a, 0xe014, 0x1c028, 0x38050, 0x700a0, 0xe0140, 0x1c0280, 0x380500, 0x1c02800, 0x3805000, 0x700a000, 0xe014000, 0x1c028000, 0x38050000, 0x700a0000, 0xe0140000, 0x1c0280000, 0xe01400000, 0x1c02800000, 0x3805000000, 0x700a000000, 0xe014000000, 0x1c028000000, 0x38050000000, 0x700a0000000, 0xe0140000000, 0x700a00000000, 0xe01400000000, 0x1c02800000000, 0x3805000000000, 0x700a000000000, 0xe014000000000, 0x1c028000000000, 0x38050000000000, 0x700a0000000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 10}, masks: []uint64{0xc00803, 0x1801006, 0x300200c, 0x6004018, 0xc008030, 0x18010060, 0x300200c0, 0x60040180, 0xc0080300, 0x180100600, 0x600401800, 0xc00803000, 0x1801006000, 0x300200c000, 0x6004018000, 0xc008030000, 0x18010060000, 0x300200c0000, 0x60040180000, 0xc0080300000, 0x300200c00000, 0x600401800000, 0xc00803000000, 0x1801006000000, 0x300200c000000, 0x6004018000000, 0xc008030000000, 0x18010060000000, 0x300200c0000000, 0x60040180000000}},
+			{lo: Pos{0, 0}, n: Pos{4, 9}, masks: []uint64{0x2807, 0x500e, 0xa01c, 0x14038, 0x28070, 0x500e0, 0xa01c0, 0x140380, 0x280700, 0x1403800, 0x2807000, 0x500e000, 0xa01c000, 0x14038000, 0x28070000, 0x500e0000, 0xa01c0000, 0x140380000, 0xa01c00000, 0x1403800000, 0x2807000000, 0x500e000000, 0xa01c000000, 0x14038000000, 0x28070000000, 0x500e0000000, 0xa01c0000000, 0x500e00000000, 0xa01c00000000, 0x1403800000000, 0x2807000000000, 0x500e000000000, 0xa01c000000000, 0x14038000000000, 0x28070000000000, 0x500e0000000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 10}, masks: []uint64{0xc01003, 0x1802006, 0x300400c, 0x6008018, 0xc010030, 0x18020060, 0x300400c0, 0x60080180, 0xc0100300, 0x180200600, 0x600801800, 0xc01003000, 0x1802006000, 0x300400c000, 0x6008018000, 0xc010030000, 0x18020060000, 0x300400c0000, 0x60080180000, 0xc0100300000, 0x300400c00000, 0x600801800000, 0xc01003000000, 0x1802006000000, 0x300400c000000, 0x6008018000000, 0xc010030000000, 0x18020060000000, 0x300400c0000000, 0x60080180000000}},
+		},
+		spots: "\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x02\x00\x00\x00\x03\x00\x00\x01\x00\x01\x00\x01\x01\x01\x00\x01\x02\x01\x00\x01\x03\x01\x00\x02\x00\x02\x00\x02\x01\x02\x00\x02\x02\x02\x00\x02\x03\x02\x00\x03\x00\x03\x00\x03\x01\x03\x00\x03\x02\x03\x00\x03\x03\x03\x00\x04\x00\x04\x00\x04\x01\x04\x00\x04\x02\x04\x00\x04\x03\x04\x00\x05\x00\x05\x00\x05\x01\x05\x00\x05\x02\x05\x00\x05\x03\x05\x00\x06\x00\x06\x00\x06\x01\x06\x00\x06\x02\x06\x00\x06\x03\x06\x00\a\x00\a\x00\a\x01\a\x00\a\x02\a\x00\a\x03\a\x00\b\x00\b\x00\b\x01\b\x00\b\x02\b\x00\b\x03\b\x00\t\x01\t\x00\t\x03\t\x01\x00\x00\t\x01\x00\x01\n\x01\x00\x02\t\x01\x00\x03\n\x01\x01\x00\n\x01\x01\x01\v\x01\x01\x02\n\x01\x01\x03\v\x01\x02\x00\v\x01\x02\x01\f\x01\x02\x02\v\x01\x02\x03\f\x01\x03\x00\f\x01\x03\x01\r\x01\x03\x02\f\x01\x03\x03\r\x01\x04\x00\r\x01\x04\x01\x0e\x01\x04\x02\r\x01\x04\x03\x0e\x01\x05\x00\x0e\x01\x05\x01\x0f\x01\x05\x02\x0e\x01\x05\x03\x0f\x01\x06\x00\x0f\x01\x06\x01\x10\x01\x06\x02\x0f\x01\x06\x03\x10\x01\a\x00\x10\x01\a\x01\x11\x01\a\x02\x10\x01\a\x03\x11\x01\b\x00\x11\x01\b\x01\x12\x01\b\x02\x11\x01\b\x03\x12\x01\t\x01\x13\x01\t\x03\x13\x02\x00\x00\x12\x02\x00\x01\x14\x02\x00\x02\x12\x02\x00\x03\x14\x02\x01\x00\x13\x02\x01\x01\x15\x02\x01\x02\x13\x02\x01\x03\x15\x02\x02\x00\x14\x02\x02\x01\x16\x02\x02\x02\x14\x02\x02\x03\x16\x02\x03\x00\x15\x02\x03\x01\x17\x02\x03\x02\x15\x02\x03\x03\x17\x02\x04\x00\x16\x02\x04\x01\x18\x02\x04\x02\x16\x02\x04\x03\x18\x02\x05\x00\x17\x02\x05\x01\x19\x02\x05\x02\x17\x02\x05\x03\x19\x02\x06\x00\x18\x02\x06\x01\x1a\x02\x06\x02\x18\x02\x06\x03\x1a\x02\a\x00\x19\x02\a\x01\x1b\x02\a\x02\x19\x02\a\x03\x1b\x02\b\x00\x1a\x02\b\x01\x1c\x02\b\x02\x1a\x02\b\x03\x1c\x02\t\x01\x1d\x02\t\x03\x1d\x03\x00\x00\x1b\x03\x00\x02\x1b\x03\x01\x00\x1c\x03\x01\x02\x1c\x03\x02\x00\x1d\x03\x02\x02\x1d\x03\x03\x00\x1e\x03\x03\x02\x1e\x03\x04\x00\x1f\x03\x04\x02\x1f\x03\x05\x00 \x03\x05\x02 \x03\x06\x00!\x03\x06\x02!\x03\a\x00\"\x03\a\x02\"\x03\b\x00#\x03\b\x02#",
+		cover: "\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x02\x00\x00\x00\x03\x00\x00\x01\x00\x01\x00\x00\x01\x00\x00\x01\x01\x01\x00\x00\x02\x00\x00\x01\x02\x01\x00\x01\x03\x01\x00\x00\x03\x00\x00\x02\x00\x02\x00\x00\x00\x00\x00\x01\x01\x01\x00\x02\x01\x02\x00\x00\x02\x00\x00\x01\x02\x01\x00\x02\x02\x02\x00\x02\x03\x02\x00\x01\x03\x01\x00\x03\x00\x03\x00\x01\x00\x01\x00\x02\x01\x02\x00\x03\x01\x03\x00\x01\x02\x01\x00\x02\x02\x02\x00\x03\x02\x03\x00\x03\x03\x03\x00\x02\x03\x02\x00\x04\x00\x04\x00\x02\x00\x02\x00\x03\x01\x03\x00\x04\x01\x04\x00\x02\x02\x02\x00\x03\x02\x03\x00\x04\x02\x04\x00\x04\x03\x04\x00\x03\x03\x03\x00\x05\x00\x05\x00\x03\x00\x03\x00\x04\x01\x04\x00\x05\x01\x05\x00\x03\x02\x03\x00\x04\x02\x04\x00\x05\x02\x05\x00\x05\x03\x05\x00\x04\x03\x04\x00\x06\x00\x06\x00\x04\x00\x04\x00\x05\x01\x05\x00\x06\x01\x06\x00\x04\x02\x04\x00\x05\x02\x05\x00\x06\x02\x06\x00\x06\x03\x06\x00\x05\x03\x05\x00\a\x00\a\x00\x05\x00\x05\x00\x06\x01\x06\x00\a\x01\a\x00\x05\x02\x05\x00\x06\x02\x06\x00\a\x02\a\x00\a\x03\a\x00\x06\x03\x06\x00\b\x00\b\x00\x06\x00\x06\x00\a\x01\a\x00\b\x01\b\x00\x06\x02\x06\x00\a\x02\a\x00\b\x02\b\x00\b\x03\b\x00\a\x03\a\x00\a\x00\a\x00\b\x01\b\x00\t\x01\t\x00\a\x02\a\x00\b\x02\b\x00\t\x03\t\x00\b\x03\b\x00\b\x00\b\x00\t\x01\t\x00\b\x02\b\x00\t\x03\t\x01\x00\x00\t\x00\x00\x00\x00\x01\x00\x01\n\x00\x00\x01\x00\x00\x00\x02\x00\x01\x00\x02\t\x01\x00\x03\n\x01\x01\x00\n\x00\x01\x00\x01\x00\x00\x00\x00\x01\x00\x01\n\x01\x01\x01\v\x00\x01\x01\x01\x00\x01\x02\x01\x01\x00\x02\t\x01\x01\x02\n\x01\x01\x03\v\x00\x00\x03\x00\x01\x00\x03\n\x01\x02\x00\v\x01\x00\x00\t\x00\x02\x00\x02\x00\x01\x00\x01\x00\x00\x00\x00\x01\x01\x01\v\x01\x02\x01\f\x00\x02\x01\x02\x00\x00\x02\x00\x00\x02\x02\x02\x01\x00\x02\t\x01\x01\x02\n\x01\x02\x02\v\x01\x02\x03\f\x00\x01\x03\x01\x01\x01\x03\v\x01\x03\x00\f\x01\x01\x00\n\x00\x03\x00\x03\x00\x02\x00\x02\x00\x01\x00\x01\x01\x02\x01\f\x01\x03\x01\r\x00\x03\x01\x03\x00\x01\x02\x01\x00\x03\x02\x03\x01\x01\x02\n\x01\x02\x02\v\x01\x03\x02\f\x01\x03\x03\r\x00\x02\x03\x02\x01\x02\x03\f\x01\x04\x00\r\x01\x02\x00\v\x00\x04\x00\x04\x00\x03\x00\x03\x00\x02\x00\x02\x01\x03\x01\r\x01\x04\x01\x0e\x00\x04\x01\x04\x00\x02\x02\x02\x00\x04\x02\x04\x01\x02\x02\v\x01\x03\x02\f\x01\x04\x02\r\x01\x04\x03\x0e\x00\x03\x03\x03\x01\x03\x03\r\x01\x05\x00\x0e\x01\x03\x00\f\x00\x05\x00\x05\x00\x04\x00\x04\x00\x03\x00\x03\x01\x04\x01\x0e\x01\x05\x01\x0f\x00\x05\x01\x05\x00\x03\x02\x03\x00\x05\x02\x05\x01\x03\x02\f\x01\x04\x02\r\x01\x05\x02\x0e\x01\x05\x03\x0f\x00\x04\x03\x04\x01\x04\x03\x0e\x01\x06\x00\x0f\x01\x04\x00\r\x00\x06\x00\x06\x00\x05\x00\x05\x00\x04\x00\x04\x01\x05\x01\x0f\x01\x06\x01\x10\x00\x06\x01\x06\x00\x04\x02\x04\x00\x06\x02\x06\x01\x04\x02\r\x01\x05\x02\x0e\x01\x06\x02\x0f\x01\x06\x03\x10\x00\x05\x03\x05\x01\x05\x03\x0f\x01\a\x00\x10\x01\x05\x00\x0e\x00\a\x00\a\x00\x06\x00\x06\x00\x05\x00\x05\x01\x06\x01\x10\x01\a\x01\x11\x00\a\x01\a\x00\x05\x02\x05\x00\a\x02\a\x01\x05\x02\x0e\x01\x06\x02\x0f\x01\a\x02\x10\x01\a\x03\x11\x00\x06\x03\x06\x01\x06\x03\x10\x01\b\x00\x11\x01\x06\x00\x0f\x00\b\x00\b\x00\a\x00\a\x00\x06\x00\x06\x01\a\x01\x11\x01\b\x01\x12\x00\b\x01\b\x00\x06\x02\x06\x00\b\x02\b\x01\x06\x02\x0f\x01\a\x02\x10\x01\b\x02\x11\x01\b\x03\x12\x00\a\x03\a\x01\a\x03\x11\x01\a\x00\x10\x00\b\x00\b\x00\a\x00\a\x01\b\x01\x12\x01\t\x01\x13\x00\t\x01\t\x00\a\x02\a\x01\a\x02\x10\x01\b\x02\x11\x01\t\x03\x13\x00\b\x03\b\x01\b\x03\x12\x01\b\x00\x11\x00\b\x00\b\x01\t\x01\x13\x00\b\x02\b\x01\b\x02\x11\x00\t\x03\t\x01\t\x03\x13\x02\x00\x00\x12\x01\x00\x00\t\x02\x00\x01\x14\x01\x00\x01\n\x00\x00\x01\x00\x01\x00\x02\t\x02\x00\x02\x12\x00\x00\x03\x00\x02\x00\x03\x14\x02\x01\x00\x13\x01\x01\x00\n\x01\x00\x00\t\x02\x00\x01\x14\x00\x00\x01\x00\x02\x01\x01\x15\x01\x01\x01\v\x00\x01\x01\x01\x01\x01\x02\n\x02\x00\x02\x12\x02\x01\x02\x13\x00\x01\x03\x01\x02\x01\x03\x15\x00\x00\x03\x00\x01\x00\x03\n\x02\x00\x03\x14\x02\x02\x00\x14\x02\x00\x00\x12\x01\x02\x00\v\x01\x01\x00\n\x01\x00\x00\t\x02\x01\x01\x15\x00\x01\x01\x01\x02\x02\x01\x16\x01\x02\x01\f\x00\x02\x01\x02\x01\x00\x02\t\x01\x02\x02\v\x02\x00\x02\x12\x02\x01\x02\x13\x02\x02\x02\x14\x00\x02\x03\x02\x02\x02\x03\x16\x00\x01\x03\x01\x01\x01\x03\v\x02\x01\x03\x15\x02\x03\x00\x15\x02\x01\x00\x13\x01\x03\x00\f\x01\x02\x00\v\x01\x01\x00\n\x02\x02\x01\x16\x00\x02\x01\x02\x02\x03\x01\x17\x01\x03\x01\r\x00\x03\x01\x03\x01\x01\x02\n\x01\x03\x02\f\x02\x01\x02\x13\x02\x02\x02\x14\x02\x03\x02\x15\x00\x03\x03\x03\x02\x03\x03\x17\x00\x02\x03\x02\x01\x02\x03\f\x02\x02\x03\x16\x02\x04\x00\x16\x02\x02\x00\x14\x01\x04\x00\r\x01\x03\x00\f\x01\x02\x00\v\x02\x03\x01\x17\x00\x03\x01\x03\x02\x04\x01\x18\x01\x04\x01\x0e\x00\x04\x01\x04\x01\x02\x02\v\x01\x04\x02\r\x02\x02\x02\x14\x02\x03\x02\x15\x02\x04\x02\x16\x00\x04\x03\x04\x02\x04\x03\x18\x00\x03\x03\x03\x01\x03\x03\r\x02\x03\x03\x17\x02\x05\x00\x17\x02\x03\x00\x15\x01\x05\x00\x0e\x01\x04\x00\r\x01\x03\x00\f\x02\x04\x01\x18\x00\x04\x01\x04\x02\x05\x01\x19\x01\x05\x01\x0f\x00\x05\x01\x05\x01\x03\x02\f\x01\x05\x02\x0e\x02\x03\x02\x15\x02\x04\x02\x16\x02\x05\x02\x17\x00\x05\x03\x05\x02\x05\x03\x19\x00\x04\x03\x04\x01\x04\x03\x0e\x02\x04\x03\x18\x02\x06\x00\x18\x02\x04\x00\x16\x01\x06\x00\x0f\x01\x05\x00\x0e\x01\x04\x00\r\x02\x05\x01\x19\x00\x05\x01\x05\x02\x06\x01\x1a\x01\x06\x01\x10\x00\x06\x01\x06\x01\x04\x02\r\x01\x06\x02\x0f\x02\x04\x02\x16\x02\x05\x02\x17\x02\x06\x02\x18\x00\x06\x03\x06\x02\x06\x03\x1a\x00\x05\x03\x05\x01\x05\x03\x0f\x02\x05\x03\x19\x02\a\x00\x19\x02\x05\x00\x17\x01\a\x00\x10\x01\x06\x00\x0f\x01\x05\x00\x0e\x02\x06\x01\x1a\x00\x06\x01\x06\x02\a\x01\x1b\x01\a\x01\x11\x00\a\x01\a\x01\x05\x02\x0e\x01\a\x02\x10\x02\x05\x02\x17\x02\x06\x02\x18\x02\a\x02\x19\x00\a\x03\a\x02\a\x03\x1b\x00\x06\x03\x06\x01\x06\x03\x10\x02\x06\x03\x1a\x02\b\x00\x1a\x02\x06\x00\x18\x01\b\x00\x11\x01\a\x00\x10\x01\x06\x00\x0f\x02\a\x01\x1b\x00\a\x01\a\x02\b\x01\x1c\x01\b\x01\x12\x00\b\x01\b\x01\x06\x02\x0f\x01\b\x02\x11\x02\x06\x02\x18\x02\a\x02\x19\x02\b\x02\x1a\x00\b\x03\b\x02\b\x03\x1c\x00\a\x03\a\x01\a\x03\x11\x02\a\x03\x1b\x02\a\x00\x19\x01\b\x00\x11\x01\a\x00\x10\x02\b\x01\x1c\x00\b\x01\b\x02\t\x01\x1d\x01\t\x01\x13\x00\t\x01\t\x01\a\x02\x10\x02\a\x02\x19\x02\b\x02\x1a\x00\t\x03\t\x02\t\x03\x1d\x00\b\x03\b\x01\b\x03\x12\x02\b\x03\x1c\x02\b\x00\x1a\x01\b\x00\x11\x02\t\x01\x1d\x00\t\x01\t\x01\b\x02\x11\x02\b\x02\x1a\x00\t\x03\t\x01\t\x03\x13\x02\t\x03\x1d\x03\x00\x00\x1b\x02\x00\x00\x12\x02\x00\x01\x14\x01\x00\x01\n\x02\x00\x02\x12\x03\x00\x02\x1b\x01\x00\x03\n\x03\x01\x00\x1c\x02\x01\x00\x13\x02\x00\x00\x12\x01\x00\x01\n\x02\x01\x01\x15\x01\x01\x01\v\x02\x01\x02\x13\x03\x00\x02\x1b\x03\x01\x02\x1c\x01\x01\x03\v\x01\x00\x03\n\x02\x00\x03\x14\x03\x02\x00\x1d\x03\x00\x00\x1b\x02\x02\x00\x14\x02\x01\x00\x13\x02\x00\x00\x12\x01\x01\x01\v\x02\x02\x01\x16\x01\x02\x01\f\x02\x00\x02\x12\x02\x02\x02\x14\x03\x00\x02\x1b\x03\x01\x02\x1c\x03\x02\x02\x1d\x01\x02\x03\f\x01\x01\x03\v\x02\x01\x03\x15\x03\x03\x00\x1e\x03\x01\x00\x1c\x02\x03\x00\x15\x02\x02\x00\x14\x02\x01\x00\x13\x01\x02\x01\f\x02\x03\x01\x17\x01\x03\x01\r\x02\x01\x02\x13\x02\x03\x02\x15\x03\x01\x02\x1c\x03\x02\x02\x1d\x03\x03\x02\x1e\x01\x03\x03\r\x01\x02\x03\f\x02\x02\x03\x16\x03\x04\x00\x1f\x03\x02\x00\x1d\x02\x04\x00\x16\x02\x03\x00\x15\x02\x02\x00\x14\x01\x03\x01\r\x02\x04\x01\x18\x01\x04\x01\x0e\x02\x02\x02\x14\x02\x04\x02\x16\x03\x02\x02\x1d\x03\x03\x02\x1e\x03\x04\x02\x1f\x01\x04\x03\x0e\x01\x03\x03\r\x02\x03\x03\x17\x03\x05\x00 \x03\x03\x00\x1e\x02\x05\x00\x17\x02\x04\x00\x16\x02\x03\x00\x15\x01\x04\x01\x0e\x02\x05\x01\x19\x01\x05\x01\x0f\x02\x03\x02\x15\x02\x05\x02\x17\x03\x03\x02\x1e\x03\x04\x02\x1f\x03\x05\x02 \x01\x05\x03\x0f\x01\x04\x03\x0e\x02\x04\x03\x18\x03\x06\x00!\x03\x04\x00\x1f\x02\x06\x00\x18\x02\x05\x00\x17\x02\x04\x00\x16\x01\x05\x01\x0f\x02\x06\x01\x1a\x01\x06\x01\x10\x02\x04\x02\x16\x02\x06\x02\x18\x03\x04\x02\x1f\x03\x05\x02 \x03\x06\x02!\x01\x06\x03\x10\x01\x05\x03\x0f\x02\x05\x03\x19\x03\a\x00\"\x03\x05\x00 \x02\a\x00\x19\x02\x06\x00\x18\x02\x05\x00\x17\x01\x06\x01\x10\x02\a\x01\x1b\x01\a\x01\x11\x02\x05\x02\x17\x02\a\x02\x19\x03\x05\x02 \x03\x06\x02!\x03\a\x02\"\x01\a\x03\x11\x01\x06\x03\x10\x02\x06\x03\x1a\x03\b\x00#\x03\x06\x00!\x02\b\x00\x1a\x02\a\x00\x19\x02\x06\x00\x18\x01\a\x01\x11\x02\b\x01\x1c\x01\b\x01\x12\x02\x06\x02\x18\x02\b\x02\x1a\x03\x06\x02!\x03\a\x02\"\x03\b\x02#\x01\b\x03\x12\x01\a\x03\x11\x02\a\x03\x1b\x03\a\x00\"\x02\b\x00\x1a\x02\a\x00\x19\x01\b\x01\x12\x02\t\x01\x1d\x01\t\x01\x13\x02\a\x02\x19\x03\a\x02\"\x03\b\x02#\x01\t\x03\x13\x01\b\x03\x12\x02\b\x03\x1c\x03\b\x00#\x02\b\x00\x1a\x01\t\x01\x13\x02\b\x02\x1a\x03\b\x02#\x01\t\x03\x13\x02\t\x03\x1d\x03\x00\x00\x1b\x02\x00\x01\x14\x03\x00\x02\x1b\x02\x00\x03\x14\x03\x01\x00\x1c\x03\x00\x00\x1b\x02\x00\x01\x14\x02\x01\x01\x15\x03\x01\x02\x1c\x02\x01\x03\x15\x02\x00\x03\x14\x03\x02\x00\x1d\x03\x01\x00\x1c\x03\x00\x00\x1b\x02\x01\x01\x15\x02\x02\x01\x16\x03\x00\x02\x1b\x03\x02\x02\x1d\x02\x02\x03\x16\x02\x01\x03\x15\x03\x03\x00\x1e\x03\x02\x00\x1d\x03\x01\x00\x1c\x02\x02\x01\x16\x02\x03\x01\x17\x03\x01\x02\x1c\x03\x03\x02\x1e\x02\x03\x03\x17\x02\x02\x03\x16\x03\x04\x00\x1f\x03\x03\x00\x1e\x03\x02\x00\x1d\x02\x03\x01\x17\x02\x04\x01\x18\x03\x02\x02\x1d\x03\x04\x02\x1f\x02\x04\x03\x18\x02\x03\x03\x17\x03\x05\x00 \x03\x04\x00\x1f\x03\x03\x00\x1e\x02\x04\x01\x18\x02\x05\x01\x19\x03\x03\x02\x1e\x03\x05\x02 \x02\x05\x03\x19\x02\x04\x03\x18\x03\x06\x00!\x03\x05\x00 \x03\x04\x00\x1f\x02\x05\x01\x19\x02\x06\x01\x1a\x03\x04\x02\x1f\x03\x06\x02!\x02\x06\x03\x1a\x02\x05\x03\x19\x03\a\x00\"\x03\x06\x00!\x03\x05\x00 \x02\x06\x01\x1a\x02\a\x01\x1b\x03\x05\x02 \x03\a\x02\"\x02\a\x03\x1b\x02\x06\x03\x1a\x03\b\x00#\x03\a\x00\"\x03\x06\x00!\x02\a\x01\x1b\x02\b\x01\x1c\x03\x06\x02!\x03\b\x02#\x02\b\x03\x1c\x02\a\x03\x1b\x03\b\x00#\x03\a\x00\"\x02\b\x01\x1c\x02\t\x01\x1d\x03\a\x02\"\x02\t\x03\x1d\x02\b\x03\x1c\x03\b\x00#\x02\t\x01\x1d\x03\b\x02#\x02\t\x03\x1d",
+		start: []int32{0, 4, 11, 20, 29, 38, 47, 56, 65, 74, 81, 85, 92, 104, 120, 136, 152, 168, 184, 200, 216, 228, 235, 244, 260, 280, 300, 320, 340, 360, 380, 400, 416, 425, 432, 444, 460, 476, 492, 508, 524, 540, 556, 568, 575, 579, 586, 595, 604, 613, 622, 631, 640, 649, 656, 660},
+	},
+	{rows: 5, cols: 11, cells: "0,0;1,0;1,1;1,2;2,1;", sym: false, n: 216,
+		versions: []generatedVersion{
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0x803801, 0x1007002, 0x200e004, 0x401c008, 0x8038010, 0x10070020, 0x200e0040, 0x401c0080, 0x80380100, 0x401c00800, 0x803801000, 0x1007002000, 0x200e004000, 0x401c008000, 0x8038010000, 0x10070020000, 0x200e0040000, 0x401c0080000, 0x200e00400000, 0x401c00800000, 0x803801000000, 0x1007002000000, 0x200e004000000, 0x401c008000000, 0x8038010000000, 0x10070020000000, 0x200e0040000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0x803804, 0x1007008, 0x200e010, 0x401c020, 0x8038040, 0x10070080, 0x200e0100, 0x401c0200, 0x80380400, 0x401c02000, 0x803804000, 0x1007008000, 0x200e010000, 0x401c020000, 0x8038040000, 0x10070080000, 0x200e0100000, 0x401c0200000, 0x200e01000000, 0x401c02000000, 0x803804000000, 0x1007008000000, 0x200e010000000, 0x401c020000000, 0x8038040000000, 0x10070080000000, 0x200e0100000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0x801806, 0x100300c, 0x2006018, 0x400c030, 0x8018060, 0x100300c0, 0x20060180, 0x400c0300, 0x80180600, 0x400c03000, 0x801806000, 0x100300c000, 0x2006018000, 0x400c030000, 0x8018060000, 0x100300c0000, 0x20060180000, 0x400c0300000, 0x200601800000, 0x400c03000000, 0x801806000000, 0x100300c000000, 0x2006018000000, 0x400c030000000, 0x8018060000000, 0x100300c0000000, 0x20060180000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0x1801802, 0x3003004, 0x6006008, 0xc00c010, 0x18018020, 0x30030040, 0x60060080, 0xc00c0100, 0x180180200, 0xc00c01000, 0x1801802000, 0x3003004000, 0x6006008000, 0xc00c010000, 0x18018020000, 0x30030040000, 0x60060080000, 0xc00c0100000, 0x600600800000, 0xc00c01000000, 0x1801802000000, 0x3003004000000, 0x6006008000000, 0xc00c010000000, 0x18018020000000, 0x30030040000000, 0x60060080000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0x1003802, 0x2007004, 0x400e008, 0x801c010, 0x10038020, 0x20070040, 0x400e0080, 0x801c0100, 0x100380200, 0x801c01000, 0x1003802000, 0x2007004000, 0x400e008000, 0x801c010000, 0x10038020000, 0x20070040000, 0x400e0080000, 0x801c0100000, 0x400e00800000, 0x801c01000000, 0x1003802000000, 0x2007004000000, 0x400e008000000, 0x801c010000000, 0x10038020000000, 0x20070040000000, 0x400e0080000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0xc03002, 0x1806004, 0x300c008, 0x6018010, 0xc030020, 0x18060040, 0x300c0080, 0x60180100, 0xc0300200, 0x601801000, 0xc03002000, 0x1806004000, 0x300c008000, 0x6018010000, 0xc030020000, 0x18060040000, 0x300c0080000, 0x60180100000, 0x300c00800000, 0x601801000000, 0xc03002000000, 0x1806004000000, 0x300c008000000, 0x6018010000000, 0xc030020000000, 0x18060040000000, 0x300c0080000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0x403802, 0x807004, 0x100e008, 0x201c010, 0x4038020, 0x8070040, 0x100e0080, 0x201c0100, 0x40380200, 0x201c01000, 0x403802000, 0x807004000, 0x100e008000, 0x201c010000, 0x4038020000, 0x8070040000, 0x100e0080000, 0x201c0100000, 0x100e00800000, 0x201c01000000, 0x403802000000, 0x807004000000, 0x100e008000000, 0x201c010000000, 0x4038020000000, 0x8070040000000, 0x100e0080000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0x803003, 0x1006006, 0x200c00c, 0x4018018, 0x8030030, 0x10060060, 0x200c00c0, 0x40180180, 0x80300300, 0x401801800, 0x803003000, 0x1006006000, 0x200c00c000, 0x4018018000, 0x8030030000, 0x10060060000, 0x200c00c0000, 0x40180180000, 0x200c00c00000, 0x401801800000, 0x803003000000, 0x1006006000000, 0x200c00c000000, 0x4018018000000, 0x8030030000000, 0x10060060000000, 0x200c00c0000000}},
+		},
+		spots: "\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x02\x00\x00\x00\x03\x00\x00\x00\x04\x00\x00\x00\x05\x00\x00\x00\x06\x00\x00\x00\a\x00\x00\x01\x00\x01\x00\x01\x01\x01\x00\x01\x02\x01\x00\x01\x03\x01\x00\x01\x04\x01\x00\x01\x05\x01\x00\x01\x06\x01\x00\x01\a\x01\x00\x02\x00\x02\x00\x02\x01\x02\x00\x02\x02\x02\x00\x02\x03\x02\x00\x02\x04\x02\x00\x02\x05\x02\x00\x02\x06\x02\x00\x02\a\x02\x00\x03\x00\x03\x00\x03\x01\x03\x00\x03\x02\x03\x00\x03\x03\x03\x00\x03\x04\x03\x00\x03\x05\x03\x00\x03\x06\x03\x00\x03\a\x03\x00\x04\x00\x04\x00\x04\x01\x04\x00\x04\x02\x04\x00\x04\x03\x04\x00\x04\x04\x04\x00\x04\x05\x04\x00\x04\x06\x04\x00\x04\a\x04\x00\x05\x00\x05\x00\x05\x01\x05\x00\x05\x02\x05\x00\x05\x03\x05\x00\x05\x04\x05\x00\x05\x05\x05\x00\x05\x06\x05\x00\x05\a\x05\x00\x06\x00\x06\x00\x06\x01\x06\x00\x06\x02\x06\x00\x06\x03\x06\x00\x06\x04\x06\x00\x06\x05\x06\x00\x06\x06\x06\x00\x06\a\x06\x00\a\x00\a\x00\a\x01\a\x00\a\x02\a\x00\a\x03\a\x00\a\x04\a\x00\a\x05\a\x00\a\x06\a\x00\a\a\a\x00\b\x00\b\x00\b\x01\b\x00\b\x02\b\x00\b\x03\b\x00\b\x04\b\x00\b\x05\b\x00\b\x06\b\x00\b\a\b\x01\x00\x00\t\x01\x00\x01\t\x01\x00\x02\t\x01\x00\x03\t\x01\x00\x04\t\x01\x00\x05\t\x01\x00\x06\t\x01\x00\a\t\x01\x01\x00\n\x01\x01\x01\n\x01\x01\x02\n\x01\x01\x03\n\x01\x01\x04\n\x01\x01\x05\n\x01\x01\x06\n\x01\x01\a\n\x01\x02\x00\v\x01\x02\x01\v\x01\x02\x02\v\x01\x02\x03\v\x01\x02\x04\v\x01\x02\x05\v\x01\x02\x06\v\x01\x02\a\v\x01\x03\x00\f\x01\x03\x01\f\x01\x03\x02\f\x01\x03\x03\f\x01\x03\x04\f\x01\x03\x05\f\x01\x03\x06\f\x01\x03\a\f\x01\x04\x00\r\x01\x04\x01\r\x01\x04\x02\r\x01\x04\x03\r\x01\x04\x04\r\x01\x04\x05\r\x01\x04\x06\r\x01\x04\a\r\x01\x05\x00\x0e\x01\x05\x01\x0e\x01\x05\x02\x0e\x01\x05\x03\x0e\x01\x05\x04\x0e\x01\x05\x05\x0e\x01\x05\x06\x0e\x01\x05\a\x0e\x01\x06\x00\x0f\x01\x06\x01\x0f\x01\x06\x02\x0f\x01\x06\x03\x0f\x01\x06\x04\x0f\x01\x06\x05\x0f\x01\x06\x06\x0f\x01\x06\a\x0f\x01\a\x00\x10\x01\a\x01\x10\x01\a\x02\x10\x01\a\x03\x10\x01\a\x04\x10\x01\a\x05\x10\x01\a\x06\x10\x01\a\a\x10\x01\b\x00\x11\x01\b\x01\x11\x01\b\x02\x11\x01\b\x03\x11\x01\b\x04\x11\x01\b\x05\x11\x01\b\x06\x11\x01\b\a\x11\x02\x00\x00\x12\x02\x00\x01\x12\x02\x00\x02\x12\x02\x00\x03\x12\x02\x00\x04\x12\x02\x00\x05\x12\x02\x00\x06\x12\x02\x00\a\x12\x02\x01\x00\x13\x02\x01\x01\x13\x02\x01\x02\x13\x02\x01\x03\x13\x02\x01\x04\x13\x02\x01\x05\x13\x02\x01\x06\x13\x02\x01\a\x13\x02\x02\x00\x14\x02\x02\x01\x14\x02\x02\x02\x14\x02\x02\x03\x14\x02\x02\x04\x14\x02\x02\x05\x14\x02\x02\x06\x14\x02\x02\a\x14\x02\x03\x00\x15\x02\x03\x01\x15\x02\x03\x02\x15\x02\x03\x03\x15\x02\x03\x04\x15\x02\x03\x05\x15\x02\x03\x06\x15\x02\x03\a\x15\x02\x04\x00\x16\x02\x04\x01\x16\x02\x04\x02\x16\x02\x04\x03\x16\x02\x04\x04\x16\x02\x04\x05\x16\x02\x04\x06\x16\x02\x04\a\x16\x02\x05\x00\x17\x02\x05\x01\x17\x02\x05\x02\x17\x02\x05\x03\x17\x02\x05\x04\x17\x02\x05\x05\x17\x02\x05\x06\x17\x02\x05\a\x17\x02\x06\x00\x18\x02\x06\x01\x18\x02\x06\x02\x18\x02\x06\x03\x18\x02\x06\x04\x18\x02\x06\x05\x18\x02\x06\x06\x18\x02\x06\a\x18\x02\a\x00\x19\x02\a\x01\x19\x02\a\x02\x19\x02\a\x03\x19\x02\a\x04\x19\x02\a\x05\x19\x02\a\x06\x19\x02\a\a\x19\x02\b\x00\x1a\x02\b\x01\x1a\x02\b\x02\x1a\x02\b\x03\x1a\x02\b\x04\x1a\x02\b\x05\x1a\x02\b\x06\x1a\x02\b\a\x1a",
+		cover: "\x00\x00\x00\x00\x00\x00\a\x00\x00\x01\x00\x01\x00\x00\x02\x00\x00\x00\x03\x00\x00\x00\x04\x00\x00\x00\x05\x00\x00\x00\x06\x00\x00\x01\a\x01\x00\x00\a\x00\x00\x02\x00\x02\x00\x00\x01\x00\x00\x00\x02\x00\x00\x01\x02\x01\x00\x01\x03\x01\x00\x01\x04\x01\x00\x01\x05\x01\x00\x01\x06\x01\x00\x02\a\x02\x00\x01\a\x01\x00\x03\x00\x03\x00\x01\x01\x01\x00\x01\x02\x01\x00\x02\x02\x02\x00\x02\x03\x02\x00\x02\x04\x02\x00\x02\x05\x02\x00\x02\x06\x02\x00\x03\a\x03\x00\x02\a\x02\x00\x04\x00\x04\x00\x02\x01\x02\x00\x02\x02\x02\x00\x03\x02\x03\x00\x03\x03\x03\x00\x03\x04\x03\x00\x03\x05\x03\x00\x03\x06\x03\x00\x04\a\x04\x00\x03\a\x03\x00\x05\x00\x05\x00\x03\x01\x03\x00\x03\x02\x03\x00\x04\x02\x04\x00\x04\x03\x04\x00\x04\x04\x04\x00\x04\x05\x04\x00\x04\x06\x04\x00\x05\a\x05\x00\x04\a\x04\x00\x06\x00\x06\x00\x04\x01\x04\x00\x04\x02\x04\x00\x05\x02\x05\x00\x05\x03\x05\x00\x05\x04\x05\x00\x05\x05\x05\x00\x05\x06\x05\x00\x06\a\x06\x00\x05\a\x05\x00\a\x00\a\x00\x05\x01\x05\x00\x05\x02\x05\x00\x06\x02\x06\x00\x06\x03\x06\x00\x06\x04\x06\x00\x06\x05\x06\x00\x06\x06\x06\x00\a\a\a\x00\x06\a\x06\x00\b\x00\b\x00\x06\x01\x06\x00\x06\x02\x06\x00\a\x02\a\x00\a\x03\a\x00\a\x04\a\x00\a\x05\a\x00\a\x06\a\x00\b\a\b\x00\a\a\a\x00\a\x01\a\x00\a\x02\a\x00\b\x02\b\x00\b\x03\b\x00\b\x04\b\x00\b\x05\b\x00\b\x06\b\x00\b\a\b\x00\b\x01\b\x00\b\x02\b\x01\x00\x00\t\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x02\x00\x00\x00\x03\x00\x00\x00\x04\x00\x00\x00\x06\x00\x01\x00\a\t\x01\x01\x00\n\x00\x01\x00\x01\x00\x00\x00\x00\x00\x00\x01\x00\x00\x01\x01\x01\x01\x00\x02\t\x00\x00\x02\x00\x00\x01\x02\x01\x00\x00\x03\x00\x01\x00\x03\t\x00\x01\x03\x01\x00\x00\x04\x00\x00\x01\x04\x01\x01\x00\x04\t\x00\x00\x05\x00\x01\x00\x05\t\x00\x01\x06\x01\x00\x00\x06\x00\x01\x00\x06\t\x01\x01\a\n\x01\x00\a\t\x00\x00\a\x00\x01\x02\x00\v\x00\x02\x00\x02\x00\x01\x00\x01\x00\x00\x00\x00\x01\x00\x01\t\x00\x00\x01\x00\x00\x01\x01\x01\x00\x02\x01\x02\x01\x00\x02\t\x01\x01\x02\n\x00\x01\x02\x01\x00\x02\x02\x02\x00\x01\x03\x01\x01\x01\x03\n\x00\x02\x03\x02\x00\x00\x04\x00\x00\x01\x04\x01\x00\x02\x04\x02\x01\x01\x04\n\x00\x01\x05\x01\x01\x01\x05\n\x00\x00\x05\x00\x00\x02\x06\x02\x00\x01\x06\x01\x00\x00\x06\x00\x01\x01\x06\n\x01\x02\a\v\x01\x01\a\n\x00\x01\a\x01\x00\x00\a\x00\x01\x03\x00\f\x00\x03\x00\x03\x00\x02\x00\x02\x00\x01\x00\x01\x01\x01\x01\n\x00\x01\x01\x01\x00\x02\x01\x02\x00\x03\x01\x03\x01\x01\x02\n\x01\x02\x02\v\x00\x02\x02\x02\x00\x03\x02\x03\x00\x02\x03\x02\x01\x02\x03\v\x00\x03\x03\x03\x00\x01\x04\x01\x00\x02\x04\x02\x00\x03\x04\x03\x01\x02\x04\v\x00\x02\x05\x02\x01\x02\x05\v\x00\x01\x05\x01\x00\x03\x06\x03\x00\x02\x06\x02\x00\x01\x06\x01\x01\x02\x06\v\x01\x03\a\f\x01\x02\a\v\x00\x02\a\x02\x00\x01\a\x01\x01\x04\x00\r\x00\x04\x00\x04\x00\x03\x00\x03\x00\x02\x00\x02\x01\x02\x01\v\x00\x02\x01\x02\x00\x03\x01\x03\x00\x04\x01\x04\x01\x02\x02\v\x01\x03\x02\f\x00\x03\x02\x03\x00\x04\x02\x04\x00\x03\x03\x03\x01\x03\x03\f\x00\x04\x03\x04\x00\x02\x04\x02\x00\x03\x04\x03\x00\x04\x04\x04\x01\x03\x04\f\x00\x03\x05\x03\x01\x03\x05\f\x00\x02\x05\x02\x00\x04\x06\x04\x00\x03\x06\x03\x00\x02\x06\x02\x01\x03\x06\f\x01\x04\a\r\x01\x03\a\f\x00\x03\a\x03\x00\x02\a\x02\x01\x05\x00\x0e\x00\x05\x00\x05\x00\x04\x00\x04\x00\x03\x00\x03\x01\x03\x01\f\x00\x03\x01\x03\x00\x04\x01\x04\x00\x05\x01\x05\x01\x03\x02\f\x01\x04\x02\r\x00\x04\x02\x04\x00\x05\x02\x05\x00\x04\x03\x04\x01\x04\x03\r\x00\x05\x03\x05\x00\x03\x04\x03\x00\x04\x04\x04\x00\x05\x04\x05\x01\x04\x04\r\x00\x04\x05\x04\x01\x04\x05\r\x00\x03\x05\x03\x00\x05\x06\x05\x00\x04\x06\x04\x00\x03\x06\x03\x01\x04\x06\r\x01\x05\a\x0e\x01\x04\a\r\x00\x04\a\x04\x00\x03\a\x03\x01\x06\x00\x0f\x00\x06\x00\x06\x00\x05\x00\x05\x00\x04\x00\x04\x01\x04\x01\r\x00\x04\x01\x04\x00\x05\x01\x05\x00\x06\x01\x06\x01\x04\x02\r\x01\x05\x02\x0e\x00\x05\x02\x05\x00\x06\x02\x06\x00\x05\x03\x05\x01\x05\x03\x0e\x00\x06\x03\x06\x00\x04\x04\x04\x00\x05\x04\x05\x00\x06\x04\x06\x01\x05\x04\x0e\x00\x05\x05\x05\x01\x05\x05\x0e\x00\x04\x05\x04\x00\x06\x06\x06\x00\x05\x06\x05\x00\x04\x06\x04\x01\x05\x06\x0e\x01\x06\a\x0f\x01\x05\a\x0e\x00\x05\a\x05\x00\x04\a\x04\x01\a\x00\x10\x00\a\x00\a\x00\x06\x00\x06\x00\x05\x00\x05\x01\x05\x01\x0e\x00\x05\x01\x05\x00\x06\x01\x06\x00\a\x01\a\x01\x05\x02\x0e\x01\x06\x02\x0f\x00\x06\x02\x06\x00\a\x02\a\x00\x06\x03\x06\x01\x06\x03\x0f\x00\a\x03\a\x00\x05\x04\x05\x00\x06\x04\x06\x00\a\x04\a\x01\x06\x04\x0f\x00\x06\x05\x06\x01\x06\x05\x0f\x00\x05\x05\x05\x00\a\x06\a\x00\x06\x06\x06\x00\x05\x06\x05\x01\x06\x06\x0f\x01\a\a\x10\x01\x06\a\x0f\x00\x06\a\x06\x00\x05\a\x05\x01\b\x00\x11\x00\b\x00\b\x00\a\x00\a\x00\x06\x00\x06\x01\x06\x01\x0f\x00\x06\x01\x06\x00\a\x01\a\x00\b\x01\b\x01\x06\x02\x0f\x01\a\x02\x10\x00\a\x02\a\x00\b\x02\b\x00\a\x03\a\x01\a\x03\x10\x00\b\x03\b\x00\x06\x04\x06\x00\a\x04\a\x00\b\x04\b\x01\a\x04\x10\x00\a\x05\a\x01\a\x05\x10\x00\x06\x05\x06\x00\b\x06\b\x00\a\x06\a\x00\x06\x06\x06\x01\a\x06\x10\x01\b\a\x11\x01\a\a\x10\x00\a\a\a\x00\x06\a\x06\x00\b\x00\b\x00\a\x00\a\x01\a\x01\x10\x00\a\x01\a\x00\b\x01\b\x01\a\x02\x10\x01\b\x02\x11\x00\b\x02\b\x00\b\x03\b\x01\b\x03\x11\x00\a\x04\a\x00\b\x04\b\x01\b\x04\x11\x00\b\x05\b\x01\b\x05\x11\x00\a\x05\a\x00\b\x06\b\x00\a\x06\a\x01\b\x06\x11\x01\b\a\x11\x00\b\a\b\x00\a\a\a\x00\b\x00\b\x01\b\x01\x11\x00\b\x01\b\x01\b\x02\x11\x00\b\x04\b\x00\b\x05\b\x00\b\x06\b\x00\b\a\b\x02\x00\x00\x12\x01\x00\x00\t\x01\x00\x01\t\x01\x00\x02\t\x01\x00\x03\t\x01\x00\x04\t\x00\x00\x05\x00\x00\x00\x06\x00\x01\x00\x06\t\x02\x00\a\x12\x02\x01\x00\x13\x01\x01\x00\n\x01\x00\x00\t\x00\x00\x00\x00\x01\x00\x01\t\x01\x01\x01\n\x00\x00\x01\x00\x02\x00\x02\x12\x01\x00\x02\t\x00\x00\x02\x00\x01\x01\x02\n\x00\x00\x03\x00\x01\x00\x03\t\x02\x00\x03\x12\x01\x01\x03\n\x01\x00\x04\t\x01\x01\x04\n\x02\x00\x04\x12\x00\x01\x05\x01\x00\x00\x05\x00\x01\x00\x05\t\x02\x00\x05\x12\x00\x01\x06\x01\x01\x01\x06\n\x01\x00\x06\t\x02\x00\x06\x12\x02\x01\a\x13\x02\x00\a\x12\x01\x00\a\t\x00\x00\a\x00\x02\x02\x00\x14\x01\x02\x00\v\x01\x01\x00\n\x01\x00\x00\t\x00\x01\x00\x01\x02\x00\x01\x12\x01\x00\x01\t\x01\x01\x01\n\x01\x02\x01\v\x00\x01\x01\x01\x02\x00\x02\x12\x02\x01\x02\x13\x01\x01\x02\n\x00\x01\x02\x01\x01\x02\x02\v\x00\x00\x03\x00\x00\x01\x03\x01\x01\x01\x03\n\x02\x01\x03\x13\x01\x02\x03\v\x00\x00\x04\x00\x01\x00\x04\t\x01\x01\x04\n\x01\x02\x04\v\x02\x01\x04\x13\x00\x02\x05\x02\x00\x01\x05\x01\x01\x01\x05\n\x02\x01\x05\x13\x01\x00\x05\t\x00\x02\x06\x02\x01\x02\x06\v\x01\x01\x06\n\x01\x00\x06\t\x02\x01\x06\x13\x02\x02\a\x14\x02\x01\a\x13\x01\x01\a\n\x00\x01\a\x01\x01\x00\a\t\x02\x03\x00\x15\x01\x03\x00\f\x01\x02\x00\v\x01\x01\x00\n\x00\x02\x00\x02\x02\x01\x01\x13\x01\x01\x01\n\x01\x02\x01\v\x01\x03\x01\f\x00\x02\x01\x02\x02\x01\x02\x13\x02\x02\x02\x14\x01\x02\x02\v\x00\x02\x02\x02\x01\x03\x02\f\x00\x01\x03\x01\x00\x02\x03\x02\x01\x02\x03\v\x02\x02\x03\x14\x01\x03\x03\f\x00\x01\x04\x01\x01\x01\x04\n\x01\x02\x04\v\x01\x03\x04\f\x02\x02\x04\x14\x00\x03\x05\x03\x00\x02\x05\x02\x01\x02\x05\v\x02\x02\x05\x14\x01\x01\x05\n\x00\x03\x06\x03\x01\x03\x06\f\x01\x02\x06\v\x01\x01\x06\n\x02\x02\x06\x14\x02\x03\a\x15\x02\x02\a\x14\x01\x02\a\v\x00\x02\a\x02\x01\x01\a\n\x02\x04\x00\x16\x01\x04\x00\r\x01\x03\x00\f\x01\x02\x00\v\x00\x03\x00\x03\x02\x02\x01\x14\x01\x02\x01\v\x01\x03\x01\f\x01\x04\x01\r\x00\x03\x01\x03\x02\x02\x02\x14\x02\x03\x02\x15\x01\x03\x02\f\x00\x03\x02\x03\x01\x04\x02\r\x00\x02\x03\x02\x00\x03\x03\x03\x01\x03\x03\f\x02\x03\x03\x15\x01\x04\x03\r\x00\x02\x04\x02\x01\x02\x04\v\x01\x03\x04\f\x01\x04\x04\r\x02\x03\x04\x15\x00\x04\x05\x04\x00\x03\x05\x03\x01\x03\x05\f\x02\x03\x05\x15\x01\x02\x05\v\x00\x04\x06\x04\x01\x04\x06\r\x01\x03\x06\f\x01\x02\x06\v\x02\x03\x06\x15\x02\x04\a\x16\x02\x03\a\x15\x01\x03\a\f\x00\x03\a\x03\x01\x02\a\v\x02\x05\x00\x17\x01\x05\x00\x0e\x01\x04\x00\r\x01\x03\x00\f\x00\x04\x00\x04\x02\x03\x01\x15\x01\x03\x01\f\x01\x04\x01\r\x01\x05\x01\x0e\x00\x04\x01\x04\x02\x03\x02\x15\x02\x04\x02\x16\x01\x04\x02\r\x00\x04\x02\x04\x01\x05\x02\x0e\x00\x03\x03\x03\x00\x04\x03\x04\x01\x04\x03\r\x02\x04\x03\x16\x01\x05\x03\x0e\x00\x03\x04\x03\x01\x03\x04\f\x01\x04\x04\r\x01\x05\x04\x0e\x02\x04\x04\x16\x00\x05\x05\x05\x00\x04\x05\x04\x01\x04\x05\r\x02\x04\x05\x16\x01\x03\x05\f\x00\x05\x06\x05\x01\x05\x06\x0e\x01\x04\x06\r\x01\x03\x06\f\x02\x04\x06\x16\x02\x05\a\x17\x02\x04\a\x16\x01\x04\a\r\x00\x04\a\x04\x01\x03\a\f\x02\x06\x00\x18\x01\x06\x00\x0f\x01\x05\x00\x0e\x01\x04\x00\r\x00\x05\x00\x05\x02\x04\x01\x16\x01\x04\x01\r\x01\x05\x01\x0e\x01\x06\x01\x0f\x00\x05\x01\x05\x02\x04\x02\x16\x02\x05\x02\x17\x01\x05\x02\x0e\x00\x05\x02\x05\x01\x06\x02\x0f\x00\x04\x03\x04\x00\x05\x03\x05\x01\x05\x03\x0e\x02\x05\x03\x17\x01\x06\x03\x0f\x00\x04\x04\x04\x01\x04\x04\r\x01\x05\x04\x0e\x01\x06\x04\x0f\x02\x05\x04\x17\x00\x06\x05\x06\x00\x05\x05\x05\x01\x05\x05\x0e\x02\x05\x05\x17\x01\x04\x05\r\x00\x06\x06\x06\x01\x06\x06\x0f\x01\x05\x06\x0e\x01\x04\x06\r\x02\x05\x06\x17\x02\x06\a\x18\x02\x05\a\x17\x01\x05\a\x0e\x00\x05\a\x05\x01\x04\a\r\x02\a\x00\x19\x01\a\x00\x10\x01\x06\x00\x0f\x01\x05\x00\x0e\x00\x06\x00\x06\x02\x05\x01\x17\x01\x05\x01\x0e\x01\x06\x01\x0f\x01\a\x01\x10\x00\x06\x01\x06\x02\x05\x02\x17\x02\x06\x02\x18\x01\x06\x02\x0f\x00\x06\x02\x06\x01\a\x02\x10\x00\x05\x03\x05\x00\x06\x03\x06\x01\x06\x03\x0f\x02\x06\x03\x18\x01\a\x03\x10\x00\x05\x04\x05\x01\x05\x04\x0e\x01\x06\x04\x0f\x01\a\x04\x10\x02\x06\x04\x18\x00\a\x05\a\x00\x06\x05\x06\x01\x06\x05\x0f\x02\x06\x05\x18\x01\x05\x05\x0e\x00\a\x06\a\x01\a\x06\x10\x01\x06\x06\x0f\x01\x05\x06\x0e\x02\x06\x06\x18\x02\a\a\x19\x02\x06\a\x18\x01\x06\a\x0f\x00\x06\a\x06\x01\x05\a\x0e\x02\b\x00\x1a\x01\b\x00\x11\x01\a\x00\x10\x01\x06\x00\x0f\x00\a\x00\a\x02\x06\x01\x18\x01\x06\x01\x0f\x01\a\x01\x10\x01\b\x01\x11\x00\a\x01\a\x02\x06\x02\x18\x02\a\x02\x19\x01\a\x02\x10\x00\a\x02\a\x01\b\x02\x11\x00\x06\x03\x06\x00\a\x03\a\x01\a\x03\x10\x02\a\x03\x19\x01\b\x03\x11\x00\x06\x04\x06\x01\x06\x04\x0f\x01\a\x04\x10\x01\b\x04\x11\x02\a\x04\x19\x00\b\x05\b\x00\a\x05\a\x01\a\x05\x10\x02\a\x05\x19\x01\x06\x05\x0f\x00\b\x06\b\x01\b\x06\x11\x01\a\x06\x10\x01\x06\x06\x0f\x02\a\x06\x19\x02\b\a\x1a\x02\a\a\x19\x01\a\a\x10\x00\a\a\a\x01\x06\a\x0f\x01\b\x00\x11\x01\a\x00\x10\x00\b\x00\b\x02\a\x01\x19\x01\a\x01\x10\x01\b\x01\x11\x00\b\x01\b\x02\a\x02\x19\x02\b\x02\x1a\x01\b\x02\x11\x00\b\x02\b\x00\a\x03\a\x00\b\x03\b\x01\b\x03\x11\x02\b\x03\x1a\x00\a\x04\a\x01\a\x04\x10\x01\b\x04\x11\x02\b\x04\x1a\x00\b\x05\b\x01\b\x05\x11\x02\b\x05\x1a\x01\a\x05\x10\x01\b\x06\x11\x01\a\x06\x10\x02\b\x06\x1a\x02\b\a\x1a\x01\b\a\x11\x00\b\a\b\x01\a\a\x10\x01\b\x00\x11\x02\b\x01\x1a\x01\b\x01\x11\x02\b\x02\x1a\x00\b\x03\b\x00\b\x04\b\x01\b\x04\x11\x01\b\x05\x11\x01\b\x06\x11\x01\b\a\x11\x02\x00\x00\x12\x02\x00\x01\x12\x02\x00\x02\x12\x02\x00\x03\x12\x02\x00\x04\x12\x01\x00\x05\t\x01\x00\x06\t\x02\x00\x06\x12\x02\x01\x00\x13\x02\x00\x00\x12\x01\x00\x00\t\x02\x00\x01\x12\x02\x01\x01\x13\x01\x00\x01\t\x02\x00\x02\x12\x01\x00\x02\t\x02\x01\x02\x13\x01\x00\x03\t\x02\x00\x03\x12\x02\x01\x03\x13\x02\x00\x04\x12\x02\x01\x04\x13\x01\x01\x05\n\x01\x00\x05\t\x02\x00\x05\x12\x01\x01\x06\n\x02\x01\x06\x13\x02\x00\x06\x12\x02\x00\a\x12\x01\x00\a\t\x02\x02\x00\x14\x02\x01\x00\x13\x02\x00\x00\x12\x01\x01\x00\n\x02\x00\x01\x12\x02\x01\x01\x13\x02\x02\x01\x14\x01\x01\x01\n\x02\x01\x02\x13\x01\x01\x02\n\x02\x02\x02\x14\x01\x00\x03\t\x01\x01\x03\n\x02\x01\x03\x13\x02\x02\x03\x14\x01\x00\x04\t\x02\x00\x04\x12\x02\x01\x04\x13\x02\x02\x04\x14\x01\x02\x05\v\x01\x01\x05\n\x02\x01\x05\x13\x02\x00\x05\x12\x01\x02\x06\v\x02\x02\x06\x14\x02\x01\x06\x13\x02\x00\x06\x12\x02\x01\a\x13\x01\x01\a\n\x02\x00\a\x12\x02\x03\x00\x15\x02\x02\x00\x14\x02\x01\x00\x13\x01\x02\x00\v\x02\x01\x01\x13\x02\x02\x01\x14\x02\x03\x01\x15\x01\x02\x01\v\x02\x02\x02\x14\x01\x02\x02\v\x02\x03\x02\x15\x01\x01\x03\n\x01\x02\x03\v\x02\x02\x03\x14\x02\x03\x03\x15\x01\x01\x04\n\x02\x01\x04\x13\x02\x02\x04\x14\x02\x03\x04\x15\x01\x03\x05\f\x01\x02\x05\v\x02\x02\x05\x14\x02\x01\x05\x13\x01\x03\x06\f\x02\x03\x06\x15\x02\x02\x06\x14\x02\x01\x06\x13\x02\x02\a\x14\x01\x02\a\v\x02\x01\a\x13\x02\x04\x00\x16\x02\x03\x00\x15\x02\x02\x00\x14\x01\x03\x00\f\x02\x02\x01\x14\x02\x03\x01\x15\x02\x04\x01\x16\x01\x03\x01\f\x02\x03\x02\x15\x01\x03\x02\f\x02\x04\x02\x16\x01\x02\x03\v\x01\x03\x03\f\x02\x03\x03\x15\x02\x04\x03\x16\x01\x02\x04\v\x02\x02\x04\x14\x02\x03\x04\x15\x02\x04\x04\x16\x01\x04\x05\r\x01\x03\x05\f\x02\x03\x05\x15\x02\x02\x05\x14\x01\x04\x06\r\x02\x04\x06\x16\x02\x03\x06\x15\x02\x02\x06\x14\x02\x03\a\x15\x01\x03\a\f\x02\x02\a\x14\x02\x05\x00\x17\x02\x04\x00\x16\x02\x03\x00\x15\x01\x04\x00\r\x02\x03\x01\x15\x02\x04\x01\x16\x02\x05\x01\x17\x01\x04\x01\r\x02\x04\x02\x16\x01\x04\x02\r\x02\x05\x02\x17\x01\x03\x03\f\x01\x04\x03\r\x02\x04\x03\x16\x02\x05\x03\x17\x01\x03\x04\f\x02\x03\x04\x15\x02\x04\x04\x16\x02\x05\x04\x17\x01\x05\x05\x0e\x01\x04\x05\r\x02\x04\x05\x16\x02\x03\x05\x15\x01\x05\x06\x0e\x02\x05\x06\x17\x02\x04\x06\x16\x02\x03\x06\x15\x02\x04\a\x16\x01\x04\a\r\x02\x03\a\x15\x02\x06\x00\x18\x02\x05\x00\x17\x02\x04\x00\x16\x01\x05\x00\x0e\x02\x04\x01\x16\x02\x05\x01\x17\x02\x06\x01\x18\x01\x05\x01\x0e\x02\x05\x02\x17\x01\x05\x02\x0e\x02\x06\x02\x18\x01\x04\x03\r\x01\x05\x03\x0e\x02\x05\x03\x17\x02\x06\x03\x18\x01\x04\x04\r\x02\x04\x04\x16\x02\x05\x04\x17\x02\x06\x04\x18\x01\x06\x05\x0f\x01\x05\x05\x0e\x02\x05\x05\x17\x02\x04\x05\x16\x01\x06\x06\x0f\x02\x06\x06\x18\x02\x05\x06\x17\x02\x04\x06\x16\x02\x05\a\x17\x01\x05\a\x0e\x02\x04\a\x16\x02\a\x00\x19\x02\x06\x00\x18\x02\x05\x00\x17\x01\x06\x00\x0f\x02\x05\x01\x17\x02\x06\x01\x18\x02\a\x01\x19\x01\x06\x01\x0f\x02\x06\x02\x18\x01\x06\x02\x0f\x02\a\x02\x19\x01\x05\x03\x0e\x01\x06\x03\x0f\x02\x06\x03\x18\x02\a\x03\x19\x01\x05\x04\x0e\x02\x05\x04\x17\x02\x06\x04\x18\x02\a\x04\x19\x01\a\x05\x10\x01\x06\x05\x0f\x02\x06\x05\x18\x02\x05\x05\x17\x01\a\x06\x10\x02\a\x06\x19\x02\x06\x06\x18\x02\x05\x06\x17\x02\x06\a\x18\x01\x06\a\x0f\x02\x05\a\x17\x02\b\x00\x1a\x02\a\x00\x19\x02\x06\x00\x18\x01\a\x00\x10\x02\x06\x01\x18\x02\a\x01\x19\x02\b\x01\x1a\x01\a\x01\x10\x02\a\x02\x19\x01\a\x02\x10\x02\b\x02\x1a\x01\x06\x03\x0f\x01\a\x03\x10\x02\a\x03\x19\x02\b\x03\x1a\x01\x06\x04\x0f\x02\x06\x04\x18\x02\a\x04\x19\x02\b\x04\x1a\x01\b\x05\x11\x01\a\x05\x10\x02\a\x05\x19\x02\x06\x05\x18\x01\b\x06\x11\x02\b\x06\x1a\x02\a\x06\x19\x02\x06\x06\x18\x02\a\a\x19\x01\a\a\x10\x02\x06\a\x18\x02\b\x00\x1a\x02\a\x00\x19\x01\b\x00\x11\x02\a\x01\x19\x02\b\x01\x1a\x01\b\x01\x11\x02\b\x02\x1a\x01\b\x02\x11\x01\a\x03\x10\x01\b\x03\x11\x02\b\x03\x1a\x01\a\x04\x10\x02\a\x04\x19\x02\b\x04\x1a\x01\b\x05\x11\x02\b\x05\x1a\x02\a\x05\x19\x02\b\x06\x1a\x02\a\x06\x19\x02\b\a\x1a\x01\b\a\x11\x02\a\a\x19\x02\b\x00\x1a\x02\b\x01\x1a\x01\b\x03\x11\x01\b\x04\x11\x02\b\x04\x1a\x02\b\x05\x1a\x02\b\x06\x1a\x02\b\a\x1a\x02\x00\x05\x12\x02\x00\x06\x12\x02\x00\x00\x12\x02\x00\x01\x12\x02\x00\x02\x12\x02\x00\x03\x12\x02\x01\x05\x13\x02\x00\x05\x12\x02\x01\x06\x13\x02\x00\a\x12\x02\x01\x00\x13\x02\x01\x01\x13\x02\x01\x02\x13\x02\x00\x03\x12\x02\x01\x03\x13\x02\x00\x04\x12\x02\x02\x05\x14\x02\x01\x05\x13\x02\x02\x06\x14\x02\x01\a\x13\x02\x02\x00\x14\x02\x02\x01\x14\x02\x02\x02\x14\x02\x01\x03\x13\x02\x02\x03\x14\x02\x01\x04\x13\x02\x03\x05\x15\x02\x02\x05\x14\x02\x03\x06\x15\x02\x02\a\x14\x02\x03\x00\x15\x02\x03\x01\x15\x02\x03\x02\x15\x02\x02\x03\x14\x02\x03\x03\x15\x02\x02\x04\x14\x02\x04\x05\x16\x02\x03\x05\x15\x02\x04\x06\x16\x02\x03\a\x15\x02\x04\x00\x16\x02\x04\x01\x16\x02\x04\x02\x16\x02\x03\x03\x15\x02\x04\x03\x16\x02\x03\x04\x15\x02\x05\x05\x17\x02\x04\x05\x16\x02\x05\x06\x17\x02\x04\a\x16\x02\x05\x00\x17\x02\x05\x01\x17\x02\x05\x02\x17\x02\x04\x03\x16\x02\x05\x03\x17\x02\x04\x04\x16\x02\x06\x05\x18\x02\x05\x05\x17\x02\x06\x06\x18\x02\x05\a\x17\x02\x06\x00\x18\x02\x06\x01\x18\x02\x06\x02\x18\x02\x05\x03\x17\x02\x06\x03\x18\x02\x05\x04\x17\x02\a\x05\x19\x02\x06\x05\x18\x02\a\x06\x19\x02\x06\a\x18\x02\a\x00\x19\x02\a\x01\x19\x02\a\x02\x19\x02\x06\x03\x18\x02\a\x03\x19\x02\x06\x04\x18\x02\b\x05\x1a\x02\a\x05\x19\x02\b\x06\x1a\x02\a\a\x19\x02\b\x00\x1a\x02\b\x01\x1a\x02\b\x02\x1a\x02\a\x03\x19\x02\b\x03\x1a\x02\a\x04\x19\x02\b\x05\x1a\x02\b\a\x1a\x02\b\x03\x1a\x02\b\x04\x1a",
+		start: []int32{0, 2, 10, 20, 30, 40, 50, 60, 70, 80, 88, 90, 98, 120, 150, 180, 210, 240, 270, 300, 330, 352, 360, 370, 400, 440, 480, 520, 560, 600, 640, 680, 710, 720, 728, 750, 780, 810, 840, 870, 900, 930, 960, 982, 990, 992, 1000, 1010, 1020, 1030, 1040, 1050, 1060, 1070, 1078, 1080},
+	},
+	{rows: 5, cols: 11, cells: "0,0;1,0;1,1;2,1;2,2;", sym: false, n: 108,
+		versions: []generatedVersion{
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0x1801801, 0x3003002, 0x6006004, 0xc00c008, 0x18018010, 0x30030020, 0x60060040, 0xc00c0080, 0x180180100, 0xc00c00800, 0x1801801000, 0x3003002000, 0x6006004000, 0xc00c008000, 0x18018010000, 0x30030020000, 0x60060040000, 0xc00c0080000, 0x600600400000, 0xc00c00800000, 0x1801801000000, 0x3003002000000, 0x6006004000000, 0xc00c008000000, 0x18018010000000, 0x30030020000000, 0x60060040000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0xc03004, 0x1806008, 0x300c010, 0x6018020, 0xc030040, 0x18060080, 0x300c0100, 0x60180200, 0xc0300400, 0x601802000, 0xc03004000, 0x1806008000, 0x300c010000, 0x6018020000, 0xc030040000, 0x18060080000, 0x300c0100000, 0x60180200000, 0x300c01000000, 0x601802000000, 0xc03004000000, 0x1806008000000, 0x300c010000000, 0x6018020000000, 0xc030040000000, 0x18060080000000, 0x300c0100000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0x401806, 0x80300c, 0x1006018, 0x200c030, 0x4018060, 0x80300c0, 0x10060180, 0x200c0300, 0x40180600, 0x200c03000, 0x401806000, 0x80300c000, 0x1006018000, 0x200c030000, 0x4018060000, 0x80300c0000, 0x10060180000, 0x200c0300000, 0x100601800000, 0x200c03000000, 0x401806000000, 0x80300c000000, 0x1006018000000, 0x200c030000000, 0x4018060000000, 0x80300c0000000, 0x10060180000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0x1003003, 0x2006006, 0x400c00c, 0x8018018, 0x10030030, 0x20060060, 0x400c00c0, 0x80180180, 0x100300300, 0x801801800, 0x1003003000, 0x2006006000, 0x400c00c000, 0x8018018000, 0x10030030000, 0x20060060000, 0x400c00c0000, 0x80180180000, 0x400c00c00000, 0x801801800000, 0x1003003000000, 0x2006006000000, 0x400c00c000000, 0x8018018000000, 0x10030030000000, 0x20060060000000, 0x400c00c0000000}},
+		},
+		spots: "\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x02\x00\x00\x00\x03\x00\x00\x01\x00\x01\x00\x01\x01\x01\x00\x01\x02\x01\x00\x01\x03\x01\x00\x02\x00\x02\x00\x02\x01\x02\x00\x02\x02\x02\x00\x02\x03\x02\x00\x03\x00\x03\x00\x03\x01\x03\x00\x03\x02\x03\x00\x03\x03\x03\x00\x04\x00\x04\x00\x04\x01\x04\x00\x04\x02\x04\x00\x04\x03\x04\x00\x05\x00\x05\x00\x05\x01\x05\x00\x05\x02\x05\x00\x05\x03\x05\x00\x06\x00\x06\x00\x06\x01\x06\x00\x06\x02\x06\x00\x06\x03\x06\x00\a\x00\a\x00\a\x01\a\x00\a\x02\a\x00\a\x03\a\x00\b\x00\b\x00\b\x01\b\x00\b\x02\b\x00\b\x03\b\x01\x00\x00\t\x01\x00\x01\t\x01\x00\x02\t\x01\x00\x03\t\x01\x01\x00\n\x01\x01\x01\n\x01\x01\x02\n\x01\x01\x03\n\x01\x02\x00\v\x01\x02\x01\v\x01\x02\x02\v\x01\x02\x03\v\x01\x03\x00\f\x01\x03\x01\f\x01\x03\x02\f\x01\x03\x03\f\x01\x04\x00\r\x01\x04\x01\r\x01\x04\x02\r\x01\x04\x03\r\x01\x05\x00\x0e\x01\x05\x01\x0e\x01\x05\x02\x0e\x01\x05\x03\x0e\x01\x06\x00\x0f\x01\x06\x01\x0f\x01\x06\x02\x0f\x01\x06\x03\x0f\x01\a\x00\x10\x01\a\x01\x10\x01\a\x02\x10\x01\a\x03\x10\x01\b\x00\x11\x01\b\x01\x11\x01\b\x02\x11\x01\b\x03\x11\x02\x00\x00\x12\x02\x00\x01\x12\x02\x00\x02\x12\x02\x00\x03\x12\x02\x01\x00\x13\x02\x01\x01\x13\x02\x01\x02\x13\x02\x01\x03\x13\x02\x02\x00\x14\x02\x02\x01\x14\x02\x02\x02\x14\x02\x02\x03\x14\x02\x03\x00\x15\x02\x03\x01\x15\x02\x03\x02\x15\x02\x03\x03\x15\x02\x04\x00\x16\x02\x04\x01\x16\x02\x04\x02\x16\x02\x04\x03\x16\x02\x05\x00\x17\x02\x05\x01\x17\x02\x05\x02\x17\x02\x05\x03\x17\x02\x06\x00\x18\x02\x06\x01\x18\x02\x06\x02\x18\x02\x06\x03\x18\x02\a\x00\x19\x02\a\x01\x19\x02\a\x02\x19\x02\a\x03\x19\x02\b\x00\x1a\x02\b\x01\x1a\x02\b\x02\x1a\x02\b\x03\x1a",
+		cover: "\x00\x00\x00\x00\x00\x00\x03\x00\x00\x01\x00\x01\x00\x00\x02\x00\x00\x00\x03\x00\x00\x01\x03\x01\x00\x02\x00\x02\x00\x00\x01\x00\x00\x00\x02\x00\x00\x01\x02\x01\x00\x01\x03\x01\x00\x02\x03\x02\x00\x03\x00\x03\x00\x01\x01\x01\x00\x01\x02\x01\x00\x02\x02\x02\x00\x02\x03\x02\x00\x03\x03\x03\x00\x04\x00\x04\x00\x02\x01\x02\x00\x02\x02\x02\x00\x03\x02\x03\x00\x03\x03\x03\x00\x04\x03\x04\x00\x05\x00\x05\x00\x03\x01\x03\x00\x03\x02\x03\x00\x04\x02\x04\x00\x04\x03\x04\x00\x05\x03\x05\x00\x06\x00\x06\x00\x04\x01\x04\x00\x04\x02\x04\x00\x05\x02\x05\x00\x05\x03\x05\x00\x06\x03\x06\x00\a\x00\a\x00\x05\x01\x05\x00\x05\x02\x05\x00\x06\x02\x06\x00\x06\x03\x06\x00\a\x03\a\x00\b\x00\b\x00\x06\x01\x06\x00\x06\x02\x06\x00\a\x02\a\x00\a\x03\a\x00\b\x03\b\x00\a\x01\a\x00\a\x02\a\x00\b\x02\b\x00\b\x03\b\x00\b\x01\b\x00\b\x02\b\x01\x00\x00\t\x00\x00\x00\x00\x00\x00\x02\x00\x01\x00\x03\t\x01\x01\x00\n\x00\x01\x00\x01\x00\x00\x00\x00\x00\x00\x01\x00\x01\x00\x02\t\x00\x00\x02\x00\x00\x01\x02\x01\x00\x00\x03\x00\x01\x00\x03\t\x01\x01\x03\n\x01\x02\x00\v\x00\x02\x00\x02\x00\x01\x00\x01\x01\x00\x01\t\x00\x00\x01\x00\x00\x01\x01\x01\x01\x00\x02\t\x01\x01\x02\n\x00\x01\x02\x01\x00\x02\x02\x02\x00\x00\x03\x00\x00\x01\x03\x01\x01\x01\x03\n\x01\x02\x03\v\x01\x03\x00\f\x00\x03\x00\x03\x00\x02\x00\x02\x01\x01\x01\n\x00\x01\x01\x01\x00\x02\x01\x02\x01\x01\x02\n\x01\x02\x02\v\x00\x02\x02\x02\x00\x03\x02\x03\x00\x01\x03\x01\x00\x02\x03\x02\x01\x02\x03\v\x01\x03\x03\f\x01\x04\x00\r\x00\x04\x00\x04\x00\x03\x00\x03\x01\x02\x01\v\x00\x02\x01\x02\x00\x03\x01\x03\x01\x02\x02\v\x01\x03\x02\f\x00\x03\x02\x03\x00\x04\x02\x04\x00\x02\x03\x02\x00\x03\x03\x03\x01\x03\x03\f\x01\x04\x03\r\x01\x05\x00\x0e\x00\x05\x00\x05\x00\x04\x00\x04\x01\x03\x01\f\x00\x03\x01\x03\x00\x04\x01\x04\x01\x03\x02\f\x01\x04\x02\r\x00\x04\x02\x04\x00\x05\x02\x05\x00\x03\x03\x03\x00\x04\x03\x04\x01\x04\x03\r\x01\x05\x03\x0e\x01\x06\x00\x0f\x00\x06\x00\x06\x00\x05\x00\x05\x01\x04\x01\r\x00\x04\x01\x04\x00\x05\x01\x05\x01\x04\x02\r\x01\x05\x02\x0e\x00\x05\x02\x05\x00\x06\x02\x06\x00\x04\x03\x04\x00\x05\x03\x05\x01\x05\x03\x0e\x01\x06\x03\x0f\x01\a\x00\x10\x00\a\x00\a\x00\x06\x00\x06\x01\x05\x01\x0e\x00\x05\x01\x05\x00\x06\x01\x06\x01\x05\x02\x0e\x01\x06\x02\x0f\x00\x06\x02\x06\x00\a\x02\a\x00\x05\x03\x05\x00\x06\x03\x06\x01\x06\x03\x0f\x01\a\x03\x10\x01\b\x00\x11\x00\b\x00\b\x00\a\x00\a\x01\x06\x01\x0f\x00\x06\x01\x06\x00\a\x01\a\x01\x06\x02\x0f\x01\a\x02\x10\x00\a\x02\a\x00\b\x02\b\x00\x06\x03\x06\x00\a\x03\a\x01\a\x03\x10\x01\b\x03\x11\x00\b\x00\b\x01\a\x01\x10\x00\a\x01\a\x00\b\x01\b\x01\a\x02\x10\x01\b\x02\x11\x00\b\x02\b\x00\a\x03\a\x00\b\x03\b\x01\b\x03\x11\x01\b\x01\x11\x00\b\x01\b\x01\b\x02\x11\x00\b\x03\b\x02\x00\x00\x12\x01\x00\x00\t\x00\x00\x01\x00\x01\x00\x02\t\x00\x00\x02\x00\x02\x00\x03\x12\x02\x01\x00\x13\x01\x01\x00\n\x01\x00\x00\t\x00\x00\x00\x00\x01\x00\x01\t\x00\x00\x01\x00\x00\x01\x01\x01\x02\x00\x02\x12\x01\x00\x02\t\x01\x01\x02\n\x00\x01\x02\x01\x01\x00\x03\t\x02\x00\x03\x12\x02\x01\x03\x13\x02\x02\x00\x14\x01\x02\x00\v\x01\x01\x00\n\x00\x01\x00\x01\x00\x00\x00\x00\x02\x00\x01\x12\x01\x00\x01\t\x01\x01\x01\n\x00\x01\x01\x01\x00\x02\x01\x02\x02\x00\x02\x12\x02\x01\x02\x13\x01\x01\x02\n\x01\x02\x02\v\x00\x02\x02\x02\x00\x00\x03\x00\x01\x00\x03\t\x01\x01\x03\n\x02\x01\x03\x13\x02\x02\x03\x14\x02\x03\x00\x15\x01\x03\x00\f\x01\x02\x00\v\x00\x02\x00\x02\x00\x01\x00\x01\x02\x01\x01\x13\x01\x01\x01\n\x01\x02\x01\v\x00\x02\x01\x02\x00\x03\x01\x03\x02\x01\x02\x13\x02\x02\x02\x14\x01\x02\x02\v\x01\x03\x02\f\x00\x03\x02\x03\x00\x01\x03\x01\x01\x01\x03\n\x01\x02\x03\v\x02\x02\x03\x14\x02\x03\x03\x15\x02\x04\x00\x16\x01\x04\x00\r\x01\x03\x00\f\x00\x03\x00\x03\x00\x02\x00\x02\x02\x02\x01\x14\x01\x02\x01\v\x01\x03\x01\f\x00\x03\x01\x03\x00\x04\x01\x04\x02\x02\x02\x14\x02\x03\x02\x15\x01\x03\x02\f\x01\x04\x02\r\x00\x04\x02\x04\x00\x02\x03\x02\x01\x02\x03\v\x01\x03\x03\f\x02\x03\x03\x15\x02\x04\x03\x16\x02\x05\x00\x17\x01\x05\x00\x0e\x01\x04\x00\r\x00\x04\x00\x04\x00\x03\x00\x03\x02\x03\x01\x15\x01\x03\x01\f\x01\x04\x01\r\x00\x04\x01\x04\x00\x05\x01\x05\x02\x03\x02\x15\x02\x04\x02\x16\x01\x04\x02\r\x01\x05\x02\x0e\x00\x05\x02\x05\x00\x03\x03\x03\x01\x03\x03\f\x01\x04\x03\r\x02\x04\x03\x16\x02\x05\x03\x17\x02\x06\x00\x18\x01\x06\x00\x0f\x01\x05\x00\x0e\x00\x05\x00\x05\x00\x04\x00\x04\x02\x04\x01\x16\x01\x04\x01\r\x01\x05\x01\x0e\x00\x05\x01\x05\x00\x06\x01\x06\x02\x04\x02\x16\x02\x05\x02\x17\x01\x05\x02\x0e\x01\x06\x02\x0f\x00\x06\x02\x06\x00\x04\x03\x04\x01\x04\x03\r\x01\x05\x03\x0e\x02\x05\x03\x17\x02\x06\x03\x18\x02\a\x00\x19\x01\a\x00\x10\x01\x06\x00\x0f\x00\x06\x00\x06\x00\x05\x00\x05\x02\x05\x01\x17\x01\x05\x01\x0e\x01\x06\x01\x0f\x00\x06\x01\x06\x00\a\x01\a\x02\x05\x02\x17\x02\x06\x02\x18\x01\x06\x02\x0f\x01\a\x02\x10\x00\a\x02\a\x00\x05\x03\x05\x01\x05\x03\x0e\x01\x06\x03\x0f\x02\x06\x03\x18\x02\a\x03\x19\x02\b\x00\x1a\x01\b\x00\x11\x01\a\x00\x10\x00\a\x00\a\x00\x06\x00\x06\x02\x06\x01\x18\x01\x06\x01\x0f\x01\a\x01\x10\x00\a\x01\a\x00\b\x01\b\x02\x06\x02\x18\x02\a\x02\x19\x01\a\x02\x10\x01\b\x02\x11\x00\b\x02\b\x00\x06\x03\x06\x01\x06\x03\x0f\x01\a\x03\x10\x02\a\x03\x19\x02\b\x03\x1a\x01\b\x00\x11\x00\b\x00\b\x00\a\x00\a\x02\a\x01\x19\x01\a\x01\x10\x01\b\x01\x11\x00\b\x01\b\x02\a\x02\x19\x02\b\x02\x1a\x01\b\x02\x11\x00\a\x03\a\x01\a\x03\x10\x01\b\x03\x11\x02\b\x03\x1a\x00\b\x00\b\x02\b\x01\x1a\x01\b\x01\x11\x02\b\x02\x1a\x00\b\x03\b\x01\b\x03\x11\x02\x00\x00\x12\x01\x00\x01\t\x02\x00\x02\x12\x01\x00\x02\t\x02\x01\x00\x13\x02\x00\x00\x12\x01\x00\x00\t\x02\x00\x01\x12\x01\x00\x01\t\x01\x01\x01\n\x02\x00\x02\x12\x02\x01\x02\x13\x01\x01\x02\n\x02\x00\x03\x12\x02\x02\x00\x14\x02\x01\x00\x13\x01\x01\x00\n\x01\x00\x00\t\x02\x00\x01\x12\x02\x01\x01\x13\x01\x01\x01\n\x01\x02\x01\v\x02\x01\x02\x13\x02\x02\x02\x14\x01\x02\x02\v\x01\x00\x03\t\x02\x00\x03\x12\x02\x01\x03\x13\x02\x03\x00\x15\x02\x02\x00\x14\x01\x02\x00\v\x01\x01\x00\n\x02\x01\x01\x13\x02\x02\x01\x14\x01\x02\x01\v\x01\x03\x01\f\x02\x02\x02\x14\x02\x03\x02\x15\x01\x03\x02\f\x01\x01\x03\n\x02\x01\x03\x13\x02\x02\x03\x14\x02\x04\x00\x16\x02\x03\x00\x15\x01\x03\x00\f\x01\x02\x00\v\x02\x02\x01\x14\x02\x03\x01\x15\x01\x03\x01\f\x01\x04\x01\r\x02\x03\x02\x15\x02\x04\x02\x16\x01\x04\x02\r\x01\x02\x03\v\x02\x02\x03\x14\x02\x03\x03\x15\x02\x05\x00\x17\x02\x04\x00\x16\x01\x04\x00\r\x01\x03\x00\f\x02\x03\x01\x15\x02\x04\x01\x16\x01\x04\x01\r\x01\x05\x01\x0e\x02\x04\x02\x16\x02\x05\x02\x17\x01\x05\x02\x0e\x01\x03\x03\f\x02\x03\x03\x15\x02\x04\x03\x16\x02\x06\x00\x18\x02\x05\x00\x17\x01\x05\x00\x0e\x01\x04\x00\r\x02\x04\x01\x16\x02\x05\x01\x17\x01\x05\x01\x0e\x01\x06\x01\x0f\x02\x05\x02\x17\x02\x06\x02\x18\x01\x06\x02\x0f\x01\x04\x03\r\x02\x04\x03\x16\x02\x05\x03\x17\x02\a\x00\x19\x02\x06\x00\x18\x01\x06\x00\x0f\x01\x05\x00\x0e\x02\x05\x01\x17\x02\x06\x01\x18\x01\x06\x01\x0f\x01\a\x01\x10\x02\x06\x02\x18\x02\a\x02\x19\x01\a\x02\x10\x01\x05\x03\x0e\x02\x05\x03\x17\x02\x06\x03\x18\x02\b\x00\x1a\x02\a\x00\x19\x01\a\x00\x10\x01\x06\x00\x0f\x02\x06\x01\x18\x02\a\x01\x19\x01\a\x01\x10\x01\b\x01\x11\x02\a\x02\x19\x02\b\x02\x1a\x01\b\x02\x11\x01\x06\x03\x0f\x02\x06\x03\x18\x02\a\x03\x19\x02\b\x00\x1a\x01\b\x00\x11\x01\a\x00\x10\x02\a\x01\x19\x02\b\x01\x1a\x01\b\x01\x11\x02\b\x02\x1a\x01\a\x03\x10\x02\a\x03\x19\x02\b\x03\x1a\x01\b\x00\x11\x02\b\x01\x1a\x01\b\x03\x11\x02\b\x03\x1a\x02\x00\x01\x12\x02\x00\x02\x12\x02\x00\x00\x12\x02\x00\x01\x12\x02\x01\x01\x13\x02\x01\x02\x13\x02\x01\x00\x13\x02\x00\x00\x12\x02\x01\x01\x13\x02\x02\x01\x14\x02\x02\x02\x14\x02\x00\x03\x12\x02\x02\x00\x14\x02\x01\x00\x13\x02\x02\x01\x14\x02\x03\x01\x15\x02\x03\x02\x15\x02\x01\x03\x13\x02\x03\x00\x15\x02\x02\x00\x14\x02\x03\x01\x15\x02\x04\x01\x16\x02\x04\x02\x16\x02\x02\x03\x14\x02\x04\x00\x16\x02\x03\x00\x15\x02\x04\x01\x16\x02\x05\x01\x17\x02\x05\x02\x17\x02\x03\x03\x15\x02\x05\x00\x17\x02\x04\x00\x16\x02\x05\x01\x17\x02\x06\x01\x18\x02\x06\x02\x18\x02\x04\x03\x16\x02\x06\x00\x18\x02\x05\x00\x17\x02\x06\x01\x18\x02\a\x01\x19\x02\a\x02\x19\x02\x05\x03\x17\x02\a\x00\x19\x02\x06\x00\x18\x02\a\x01\x19\x02\b\x01\x1a\x02\b\x02\x1a\x02\x06\x03\x18\x02\b\x00\x1a\x02\a\x00\x19\x02\b\x01\x1a\x02\a\x03\x19\x02\b\x00\x1a\x02\b\x03\x1a",
+		start: []int32{0, 2, 6, 12, 18, 24, 30, 36, 42, 48, 52, 54, 58, 68, 82, 96, 110, 124, 138, 152, 166, 176, 180, 186, 200, 220, 240, 260, 280, 300, 320, 340, 354, 360, 364, 374, 388, 402, 416, 430, 444, 458, 472, 482, 486, 488, 492, 498, 504, 510, 516, 522, 528, 534, 538, 540},
+	},
+	{rows: 5, cols: 11, cells: "0,0;1,0;1,1;2,1;2,2;", sym: true, n: 108,
+		versions: []generatedVersion{
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0x1801801, 0x3003002, 0x6006004, 0xc00c008, 0x18018010, 0x30030020, 0x60060040, 0xc00c0080, 0x180180100, 0xc00c00800, 0x1801801000, 0x3003002000, 0x6006004000, 0xc00c008000, 0x18018010000, 0x30030020000, 0x60060040000, 0xc00c0080000, 0x600600400000, 0xc00c00800000, 0x1801801000000, 0x3003002000000, 0x6006004000000, 0xc00c008000000, 0x18018010000000, 0x30030020000000, 0x60060040000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0xc03004, 0x1806008, 0x300c010, 0x6018020, 0xc030040, 0x18060080, 0x300c0100, 0x60180200, 0xc0300400, 0x601802000, 0xc03004000, 0x1806008000, 0x300c010000, 0x6018020000, 0xc030040000, 0x18060080000, 0x300c0100000, 0x60180200000, 0x300c01000000, 0x601802000000, 0xc03004000000, 0x1806008000000, 0x300c010000000, 0x6018020000000, 0xc030040000000, 0x18060080000000, 0x300c0100000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0x401806, 0x80300c, 0x1006018, 0x200c030, 0x4018060, 0x80300c0, 0x10060180, 0x200c0300, 0x40180600, 0x200c03000, 0x401806000, 0x80300c000, 0x1006018000, 0x200c030000, 0x4018060000, 0x80300c0000, 0x10060180000, 0x200c0300000, 0x100601800000, 0x200c03000000, 0x401806000000, 0x80300c000000, 0x1006018000000, 0x200c030000000, 0x4018060000000, 0x80300c0000000, 0x10060180000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0x1003003, 0x2006006, 0x400c00c, 0x8018018, 0x10030030, 0x20060060, 0x400c00c0, 0x80180180, 0x100300300, 0x801801800, 0x1003003000, 0x2006006000, 0x400c00c000, 0x8018018000, 0x10030030000, 0x20060060000, 0x400c00c0000, 0x80180180000, 0x400c00c00000, 0x801801800000, 0x1003003000000, 0x2006006000000, 0x400c00c000000, 0x8018018000000, 0x10030030000000, 0x20060060000000, 0x400c00c0000000}},
+		},
+		spots: "\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x02\x00\x00\x00\x03\x00\x00\x01\x00\x01\x00\x01\x01\x01\x00\x01\x02\x01\x00\x01\x03\x01\x00\x02\x00\x02\x00\x02\x01\x02\x00\x02\x02\x02\x00\x02\x03\x02\x00\x03\x00\x03\x00\x03\x01\x03\x00\x03\x02\x03\x00\x03\x03\x03\x00\x04\x00\x04\x00\x04\x01\x04\x00\x04\x02\x04\x00\x04\x03\x04\x00\x05\x00\x05\x00\x05\x01\x05\x00\x05\x02\x05\x00\x05\x03\x05\x00\x06\x00\x06\x00\x06\x01\x06\x00\x06\x02\x06\x00\x06\x03\x06\x00\a\x00\a\x00\a\x01\a\x00\a\x02\a\x00\a\x03\a\x00\b\x00\b\x00\b\x01\b\x00\b\x02\b\x00\b\x03\b\x01\x00\x00\t\x01\x00\x01\t\x01\x00\x02\t\x01\x00\x03\t\x01\x01\x00\n\x01\x01\x01\n\x01\x01\x02\n\x01\x01\x03\n\x01\x02\x00\v\x01\x02\x01\v\x01\x02\x02\v\x01\x02\x03\v\x01\x03\x00\f\x01\x03\x01\f\x01\x03\x02\f\x01\x03\x03\f\x01\x04\x00\r\x01\x04\x01\r\x01\x04\x02\r\x01\x04\x03\r\x01\x05\x00\x0e\x01\x05\x01\x0e\x01\x05\x02\x0e\x01\x05\x03\x0e\x01\x06\x00\x0f\x01\x06\x01\x0f\x01\x06\x02\x0f\x01\x06\x03\x0f\x01\a\x00\x10\x01\a\x01\x10\x01\a\x02\x10\x01\a\x03\x10\x01\b\x00\x11\x01\b\x01\x11\x01\b\x02\x11\x01\b\x03\x11\x02\x00\x00\x12\x02\x00\x01\x12\x02\x00\x02\x12\x02\x00\x03\x12\x02\x01\x00\x13\x02\x01\x01\x13\x02\x01\x02\x13\x02\x01\x03\x13\x02\x02\x00\x14\x02\x02\x01\x14\x02\x02\x02\x14\x02\x02\x03\x14\x02\x03\x00\x15\x02\x03\x01\x15\x02\x03\x02\x15\x02\x03\x03\x15\x02\x04\x00\x16\x02\x04\x01\x16\x02\x04\x02\x16\x02\x04\x03\x16\x02\x05\x00\x17\x02\x05\x01\x17\x02\x05\x02\x17\x02\x05\x03\x17\x02\x06\x00\x18\x02\x06\x01\x18\x02\x06\x02\x18\x02\x06\x03\x18\x02\a\x00\x19\x02\a\x01\x19\x02\a\x02\x19\x02\a\x03\x19\x02\b\x00\x1a\x02\b\x01\x1a\x02\b\x02\x1a\x02\b\x03\x1a",
+		cover: "\x00\x00\x00\x00\x00\x00\x03\x00\x00\x01\x00\x01\x00\x00\x02\x00\x00\x00\x03\x00\x00\x01\x03\x01\x00\x02\x00\x02\x00\x00\x01\x00\x00\x00\x02\x00\x00\x01\x02\x01\x00\x01\x03\x01\x00\x02\x03\x02\x00\x03\x00\x03\x00\x01\x01\x01\x00\x01\x02\x01\x00\x02\x02\x02\x00\x02\x03\x02\x00\x03\x03\x03\x00\x04\x00\x04\x00\x02\x01\x02\x00\x02\x02\x02\x00\x03\x02\x03\x00\x03\x03\x03\x00\x04\x03\x04\x00\x05\x00\x05\x00\x03\x01\x03\x00\x03\x02\x03\x00\x04\x02\x04\x00\x04\x03\x04\x00\x05\x03\x05\x00\x06\x00\x06\x00\x04\x01\x04\x00\x04\x02\x04\x00\x05\x02\x05\x00\x05\x03\x05\x00\x06\x03\x06\x00\a\x00\a\x00\x05\x01\x05\x00\x05\x02\x05\x00\x06\x02\x06\x00\x06\x03\x06\x00\a\x03\a\x00\b\x00\b\x00\x06\x01\x06\x00\x06\x02\x06\x00\a\x02\a\x00\a\x03\a\x00\b\x03\b\x00\a\x01\a\x00\a\x02\a\x00\b\x02\b\x00\b\x03\b\x00\b\x01\b\x00\b\x02\b\x01\x00\x00\t\x00\x00\x00\x00\x00\x00\x02\x00\x01\x00\x03\t\x01\x01\x00\n\x00\x01\x00\x01\x00\x00\x00\x00\x00\x00\x01\x00\x01\x00\x02\t\x00\x00\x02\x00\x00\x01\x02\x01\x00\x00\x03\x00\x01\x00\x03\t\x01\x01\x03\n\x01\x02\x00\v\x00\x02\x00\x02\x00\x01\x00\x01\x01\x00\x01\t\x00\x00\x01\x00\x00\x01\x01\x01\x01\x00\x02\t\x01\x01\x02\n\x00\x01\x02\x01\x00\x02\x02\x02\x00\x00\x03\x00\x00\x01\x03\x01\x01\x01\x03\n\x01\x02\x03\v\x01\x03\x00\f\x00\x03\x00\x03\x00\x02\x00\x02\x01\x01\x01\n\x00\x01\x01\x01\x00\x02\x01\x02\x01\x01\x02\n\x01\x02\x02\v\x00\x02\x02\x02\x00\x03\x02\x03\x00\x01\x03\x01\x00\x02\x03\x02\x01\x02\x03\v\x01\x03\x03\f\x01\x04\x00\r\x00\x04\x00\x04\x00\x03\x00\x03\x01\x02\x01\v\x00\x02\x01\x02\x00\x03\x01\x03\x01\x02\x02\v\x01\x03\x02\f\x00\x03\x02\x03\x00\x04\x02\x04\x00\x02\x03\x02\x00\x03\x03\x03\x01\x03\x03\f\x01\x04\x03\r\x01\x05\x00\x0e\x00\x05\x00\x05\x00\x04\x00\x04\x01\x03\x01\f\x00\x03\x01\x03\x00\x04\x01\x04\x01\x03\x02\f\x01\x04\x02\r\x00\x04\x02\x04\x00\x05\x02\x05\x00\x03\x03\x03\x00\x04\x03\x04\x01\x04\x03\r\x01\x05\x03\x0e\x01\x06\x00\x0f\x00\x06\x00\x06\x00\x05\x00\x05\x01\x04\x01\r\x00\x04\x01\x04\x00\x05\x01\x05\x01\x04\x02\r\x01\x05\x02\x0e\x00\x05\x02\x05\x00\x06\x02\x06\x00\x04\x03\x04\x00\x05\x03\x05\x01\x05\x03\x0e\x01\x06\x03\x0f\x01\a\x00\x10\x00\a\x00\a\x00\x06\x00\x06\x01\x05\x01\x0e\x00\x05\x01\x05\x00\x06\x01\x06\x01\x05\x02\x0e\x01\x06\x02\x0f\x00\x06\x02\x06\x00\a\x02\a\x00\x05\x03\x05\x00\x06\x03\x06\x01\x06\x03\x0f\x01\a\x03\x10\x01\b\x00\x11\x00\b\x00\b\x00\a\x00\a\x01\x06\x01\x0f\x00\x06\x01\x06\x00\a\x01\a\x01\x06\x02\x0f\x01\a\x02\x10\x00\a\x02\a\x00\b\x02\b\x00\x06\x03\x06\x00\a\x03\a\x01\a\x03\x10\x01\b\x03\x11\x00\b\x00\b\x01\a\x01\x10\x00\a\x01\a\x00\b\x01\b\x01\a\x02\x10\x01\b\x02\x11\x00\b\x02\b\x00\a\x03\a\x00\b\x03\b\x01\b\x03\x11\x01\b\x01\x11\x00\b\x01\b\x01\b\x02\x11\x00\b\x03\b\x02\x00\x00\x12\x01\x00\x00\t\x00\x00\x01\x00\x01\x00\x02\t\x00\x00\x02\x00\x02\x00\x03\x12\x02\x01\x00\x13\x01\x01\x00\n\x01\x00\x00\t\x00\x00\x00\x00\x01\x00\x01\t\x00\x00\x01\x00\x00\x01\x01\x01\x02\x00\x02\x12\x01\x00\x02\t\x01\x01\x02\n\x00\x01\x02\x01\x01\x00\x03\t\x02\x00\x03\x12\x02\x01\x03\x13\x02\x02\x00\x14\x01\x02\x00\v\x01\x01\x00\n\x00\x01\x00\x01\x00\x00\x00\x00\x02\x00\x01\x12\x01\x00\x01\t\x01\x01\x01\n\x00\x01\x01\x01\x00\x02\x01\x02\x02\x00\x02\x12\x02\x01\x02\x13\x01\x01\x02\n\x01\x02\x02\v\x00\x02\x02\x02\x00\x00\x03\x00\x01\x00\x03\t\x01\x01\x03\n\x02\x01\x03\x13\x02\x02\x03\x14\x02\x03\x00\x15\x01\x03\x00\f\x01\x02\x00\v\x00\x02\x00\x02\x00\x01\x00\x01\x02\x01\x01\x13\x01\x01\x01\n\x01\x02\x01\v\x00\x02\x01\x02\x00\x03\x01\x03\x02\x01\x02\x13\x02\x02\x02\x14\x01\x02\x02\v\x01\x03\x02\f\x00\x03\x02\x03\x00\x01\x03\x01\x01\x01\x03\n\x01\x02\x03\v\x02\x02\x03\x14\x02\x03\x03\x15\x02\x04\x00\x16\x01\x04\x00\r\x01\x03\x00\f\x00\x03\x00\x03\x00\x02\x00\x02\x02\x02\x01\x14\x01\x02\x01\v\x01\x03\x01\f\x00\x03\x01\x03\x00\x04\x01\x04\x02\x02\x02\x14\x02\x03\x02\x15\x01\x03\x02\f\x01\x04\x02\r\x00\x04\x02\x04\x00\x02\x03\x02\x01\x02\x03\v\x01\x03\x03\f\x02\x03\x03\x15\x02\x04\x03\x16\x02\x05\x00\x17\x01\x05\x00\x0e\x01\x04\x00\r\x00\x04\x00\x04\x00\x03\x00\x03\x02\x03\x01\x15\x01\x03\x01\f\x01\x04\x01\r\x00\x04\x01\x04\x00\x05\x01\x05\x02\x03\x02\x15\x02\x04\x02\x16\x01\x04\x02\r\x01\x05\x02\x0e\x00\x05\x02\x05\x00\x03\x03\x03\x01\x03\x03\f\x01\x04\x03\r\x02\x04\x03\x16\x02\x05\x03\x17\x02\x06\x00\x18\x01\x06\x00\x0f\x01\x05\x00\x0e\x00\x05\x00\x05\x00\x04\x00\x04\x02\x04\x01\x16\x01\x04\x01\r\x01\x05\x01\x0e\x00\x05\x01\x05\x00\x06\x01\x06\x02\x04\x02\x16\x02\x05\x02\x17\x01\x05\x02\x0e\x01\x06\x02\x0f\x00\x06\x02\x06\x00\x04\x03\x04\x01\x04\x03\r\x01\x05\x03\x0e\x02\x05\x03\x17\x02\x06\x03\x18\x02\a\x00\x19\x01\a\x00\x10\x01\x06\x00\x0f\x00\x06\x00\x06\x00\x05\x00\x05\x02\x05\x01\x17\x01\x05\x01\x0e\x01\x06\x01\x0f\x00\x06\x01\x06\x00\a\x01\a\x02\x05\x02\x17\x02\x06\x02\x18\x01\x06\x02\x0f\x01\a\x02\x10\x00\a\x02\a\x00\x05\x03\x05\x01\x05\x03\x0e\x01\x06\x03\x0f\x02\x06\x03\x18\x02\a\x03\x19\x02\b\x00\x1a\x01\b\x00\x11\x01\a\x00\x10\x00\a\x00\a\x00\x06\x00\x06\x02\x06\x01\x18\x01\x06\x01\x0f\x01\a\x01\x10\x00\a\x01\a\x00\b\x01\b\x02\x06\x02\x18\x02\a\x02\x19\x01\a\x02\x10\x01\b\x02\x11\x00\b\x02\b\x00\x06\x03\x06\x01\x06\x03\x0f\x01\a\x03\x10\x02\a\x03\x19\x02\b\x03\x1a\x01\b\x00\x11\x00\b\x00\b\x00\a\x00\a\x02\a\x01\x19\x01\a\x01\x10\x01\b\x01\x11\x00\b\x01\b\x02\a\x02\x19\x02\b\x02\x1a\x01\b\x02\x11\x00\a\x03\a\x01\a\x03\x10\x01\b\x03\x11\x02\b\x03\x1a\x00\b\x00\b\x02\b\x01\x1a\x01\b\x01\x11\x02\b\x02\x1a\x00\b\x03\b\x01\b\x03\x11\x02\x00\x00\x12\x01\x00\x01\t\x02\x00\x02\x12\x01\x00\x02\t\x02\x01\x00\x13\x02\x00\x00\x12\x01\x00\x00\t\x02\x00\x01\x12\x01\x00\x01\t\x01\x01\x01\n\x02\x00\x02\x12\x02\x01\x02\x13\x01\x01\x02\n\x02\x00\x03\x12\x02\x02\x00\x14\x02\x01\x00\x13\x01\x01\x00\n\x01\x00\x00\t\x02\x00\x01\x12\x02\x01\x01\x13\x01\x01\x01\n\x01\x02\x01\v\x02\x01\x02\x13\x02\x02\x02\x14\x01\x02\x02\v\x01\x00\x03\t\x02\x00\x03\x12\x02\x01\x03\x13\x02\x03\x00\x15\x02\x02\x00\x14\x01\x02\x00\v\x01\x01\x00\n\x02\x01\x01\x13\x02\x02\x01\x14\x01\x02\x01\v\x01\x03\x01\f\x02\x02\x02\x14\x02\x03\x02\x15\x01\x03\x02\f\x01\x01\x03\n\x02\x01\x03\x13\x02\x02\x03\x14\x02\x04\x00\x16\x02\x03\x00\x15\x01\x03\x00\f\x01\x02\x00\v\x02\x02\x01\x14\x02\x03\x01\x15\x01\x03\x01\f\x01\x04\x01\r\x02\x03\x02\x15\x02\x04\x02\x16\x01\x04\x02\r\x01\x02\x03\v\x02\x02\x03\x14\x02\x03\x03\x15\x02\x05\x00\x17\x02\x04\x00\x16\x01\x04\x00\r\x01\x03\x00\f\x02\x03\x01\x15\x02\x04\x01\x16\x01\x04\x01\r\x01\x05\x01\x0e\x02\x04\x02\x16\x02\x05\x02\x17\x01\x05\x02\x0e\x01\x03\x03\f\x02\x03\x03\x15\x02\x04\x03\x16\x02\x06\x00\x18\x02\x05\x00\x17\x01\x05\x00\x0e\x01\x04\x00\r\x02\x04\x01\x16\x02\x05\x01\x17\x01\x05\x01\x0e\x01\x06\x01\x0f\x02\x05\x02\x17\x02\x06\x02\x18\x01\x06\x02\x0f\x01\x04\x03\r\x02\x04\x03\x16\x02\x05\x03\x17\x02\a\x00\x19\x02\x06\x00\x18\x01\x06\x00\x0f\x01\x05\x00\x0e\x02\x05\x01\x17\x02\x06\x01\x18\x01\x06\x01\x0f\x01\a\x01\x10\x02\x06\x02\x18\x02\a\x02\x19\x01\a\x02\x10\x01\x05\x03\x0e\x02\x05\x03\x17\x02\x06\x03\x18\x02\b\x00\x1a\x02\a\x00\x19\x01\a\x00\x10\x01\x06\x00\x0f\x02\x06\x01\x18\x02\a\x01\x19\x01\a\x01\x10\x01\b\x01\x11\x02\a\x02\x19\x02\b\x02\x1a\x01\b\x02\x11\x01\x06\x03\x0f\x02\x06\x03\x18\x02\a\x03\x19\x02\b\x00\x1a\x01\b\x00\x11\x01\a\x00\x10\x02\a\x01\x19\x02\b\x01\x1a\x01\b\x01\x11\x02\b\x02\x1a\x01\a\x03\x10\x02\a\x03\x19\x02\b\x03\x1a\x01\b\x00\x11\x02\b\x01\x1a\x01\b\x03\x11\x02\b\x03\x1a\x02\x00\x01\x12\x02\x00\x02\x12\x02\x00\x00\x12\x02\x00\x01\x12\x02\x01\x01\x13\x02\x01\x02\x13\x02\x01\x00\x13\x02\x00\x00\x12\x02\x01\x01\x13\x02\x02\x01\x14\x02\x02\x02\x14\x02\x00\x03\x12\x02\x02\x00\x14\x02\x01\x00\x13\x02\x02\x01\x14\x02\x03\x01\x15\x02\x03\x02\x15\x02\x01\x03\x13\x02\x03\x00\x15\x02\x02\x00\x14\x02\x03\x01\x15\x02\x04\x01\x16\x02\x04\x02\x16\x02\x02\x03\x14\x02\x04\x00\x16\x02\x03\x00\x15\x02\x04\x01\x16\x02\x05\x01\x17\x02\x05\x02\x17\x02\x03\x03\x15\x02\x05\x00\x17\x02\x04\x00\x16\x02\x05\x01\x17\x02\x06\x01\x18\x02\x06\x02\x18\x02\x04\x03\x16\x02\x06\x00\x18\x02\x05\x00\x17\x02\x06\x01\x18\x02\a\x01\x19\x02\a\x02\x19\x02\x05\x03\x17\x02\a\x00\x19\x02\x06\x00\x18\x02\a\x01\x19\x02\b\x01\x1a\x02\b\x02\x1a\x02\x06\x03\x18\x02\b\x00\x1a\x02\a\x00\x19\x02\b\x01\x1a\x02\a\x03\x19\x02\b\x00\x1a\x02\b\x03\x1a",
+		start: []int32{0, 2, 6, 12, 18, 24, 30, 36, 42, 48, 52, 54, 58, 68, 82, 96, 110, 124, 138, 152, 166, 176, 180, 186, 200, 220, 240, 260, 280, 300, 320, 340, 354, 360, 364, 374, 388, 402, 416, 430, 444, 458, 472, 482, 486, 488, 492, 498, 504, 510, 516, 522, 528, 534, 538, 540},
+	},
+	{rows: 5, cols: 11, cells: "0,0;1,0;2,0;0,1;2,1;", sym: true, n: 132,
+		versions: []generatedVersion{
+			{lo: Pos{0, 0}, n: Pos{3, 10}, masks: []uint64{0xc00803, 0x1801006, 0x300200c, 0x6004018, 0xc008030, 0x18010060, 0x300200c0, 0x60040180, 0xc0080300, 0x180100600, 0x600401800, 0xc00803000, 0x1801006000, 0x300200c000, 0x6004018000, 0xc008030000, 0x18010060000, 0x300200c0000, 0x60040180000, 0xc0080300000, 0x300200c00000, 0x600401800000, 0xc00803000000, 0x1801006000000, 0x300200c000000, 0x6004018000000, 0xc008030000000, 0x18010060000000, 0x300200c0000000, 0x60040180000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 10}, masks: []uint64{0xc01003, 0x1802006, 0x300400c, 0x6008018, 0xc010030, 0x18020060, 0x300400c0, 0x60080180, 0xc0100300, 0x180200600, 0x600801800, 0xc01003000, 0x1802006000, 0x300400c000, 0x6008018000, 0xc010030000, 0x18020060000, 0x300400c0000, 0x60080180000, 0xc0100300000, 0x300400c00000, 0x600801800000, 0xc01003000000, 0x1802006000000, 0x300400c000000, 0x6008018000000, 0xc010030000000, 0x18020060000000, 0x300400c0000000, 0x60080180000000}},
+			{lo: Pos{0, 0}, n: Pos{4, 9}, masks: []uint64{0x2807, 0x500e, 0xa01c, 0x14038, 0x28070, 0x500e0, 0xa01c0, 0x140380, 0x280700, 0x1403800, 0x2807000, 0x500e000, 0xa01c000, 0x14038000, 0x28070000, 0x500e0000, 0xa01c0000, 0x140380000, 0xa01c00000, 0x1403800000, 0x2807000000, 0x500e000000, 0xa01c000000, 0x14038000000, 0x28070000000, 0x500e0000000, 0xa01c0000000, 0x500e00000000, 0xa01c00000000, 0x1403800000000, 0x2807000000000, 0x500e000000000, 0xa01c000000000, 0x14038000000000, 0x28070000000000, 0x500e0000000000}},
+			{lo: Pos{0, 0}, n: Pos{4, 9}, masks: []uint64{0x3805, 0x700a, 0xe014, 0x1c028, 0x38050, 0x700a0, 0xe0140, 0x1c0280, 0x380500, 0x1c02800, 0x3805000, 0x700a000, 0xe014000, 0x1c028000, 0x38050000, 0x700a0000, 0xe0140000, 0x1c0280000, 0xe01400000, 0x1c02800000, 0x3805000000, 0x700a000000, 0xe014000000, 0x1c028000000, 0x38050000000, 0x700a0000000, 0xe0140000000, 0x700a00000000, 0xe01400000000, 0x1c02800000000, 0x3805000000000, 0x700a000000000, 0xe014000000000, 0x1c028000000000, 0x38050000000000, 0x700a0000000000}},
+		},
+		spots: "\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x02\x00\x00\x00\x03\x00\x00\x01\x00\x01\x00\x01\x01\x01\x00\x01\x02\x01\x00\x01\x03\x01\x00\x02\x00\x02\x00\x02\x01\x02\x00\x02\x02\x02\x00\x02\x03\x02\x00\x03\x00\x03\x00\x03\x01\x03\x00\x03\x02\x03\x00\x03\x03\x03\x00\x04\x00\x04\x00\x04\x01\x04\x00\x04\x02\x04\x00\x04\x03\x04\x00\x05\x00\x05\x00\x05\x01\x05\x00\x05\x02\x05\x00\x05\x03\x05\x00\x06\x00\x06\x00\x06\x01\x06\x00\x06\x02\x06\x00\x06\x03\x06\x00\a\x00\a\x00\a\x01\a\x00\a\x02\a\x00\a\x03\a\x00\b\x00\b\x00\b\x01\b\x00\b\x02\b\x00\b\x03\b\x00\t\x00\t\x00\t\x01\t\x01\x00\x00\n\x01\x00\x01\n\x01\x00\x02\t\x01\x00\x03\t\x01\x01\x00\v\x01\x01\x01\v\x01\x01\x02\n\x01\x01\x03\n\x01\x02\x00\f\x01\x02\x01\f\x01\x02\x02\v\x01\x02\x03\v\x01\x03\x00\r\x01\x03\x01\r\x01\x03\x02\f\x01\x03\x03\f\x01\x04\x00\x0e\x01\x04\x01\x0e\x01\x04\x02\r\x01\x04\x03\r\x01\x05\x00\x0f\x01\x05\x01\x0f\x01\x05\x02\x0e\x01\x05\x03\x0e\x01\x06\x00\x10\x01\x06\x01\x10\x01\x06\x02\x0f\x01\x06\x03\x0f\x01\a\x00\x11\x01\a\x01\x11\x01\a\x02\x10\x01\a\x03\x10\x01\b\x00\x12\x01\b\x01\x12\x01\b\x02\x11\x01\b\x03\x11\x01\t\x00\x13\x01\t\x01\x13\x02\x00\x00\x14\x02\x00\x01\x14\x02\x00\x02\x12\x02\x00\x03\x12\x02\x01\x00\x15\x02\x01\x01\x15\x02\x01\x02\x13\x02\x01\x03\x13\x02\x02\x00\x16\x02\x02\x01\x16\x02\x02\x02\x14\x02\x02\x03\x14\x02\x03\x00\x17\x02\x03\x01\x17\x02\x03\x02\x15\x02\x03\x03\x15\x02\x04\x00\x18\x02\x04\x01\x18\x02\x04\x02\x16\x02\x04\x03\x16\x02\x05\x00\x19\x02\x05\x01\x19\x02\x05\x02\x17\x02\x05\x03\x17\x02\x06\x00\x1a\x02\x06\x01\x1a\x02\x06\x02\x18\x02\x06\x03\x18\x02\a\x00\x1b\x02\a\x01\x1b\x02\a\x02\x19\x02\a\x03\x19\x02\b\x00\x1c\x02\b\x01\x1c\x02\b\x02\x1a\x02\b\x03\x1a\x02\t\x00\x1d\x02\t\x01\x1d\x03\x00\x02\x1b\x03\x00\x03\x1b\x03\x01\x02\x1c\x03\x01\x03\x1c\x03\x02\x02\x1d\x03\x02\x03\x1d\x03\x03\x02\x1e\x03\x03\x03\x1e\x03\x04\x02\x1f\x03\x04\x03\x1f\x03\x05\x02 \x03\x05\x03 \x03\x06\x02!\x03\x06\x03!\x03\a\x02\"\x03\a\x03\"\x03\b\x02#\x03\b\x03#",
+		cover: "\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x02\x00\x00\x00\x03\x00\x00\x01\x00\x01\x00\x00\x00\x00\x00\x00\x01\x00\x00\x01\x01\x01\x00\x00\x02\x00\x00\x01\x02\x01\x00\x01\x03\x01\x00\x02\x00\x02\x00\x01\x00\x01\x00\x01\x01\x01\x00\x02\x01\x02\x00\x00\x02\x00\x00\x01\x02\x01\x00\x02\x02\x02\x00\x00\x03\x00\x00\x02\x03\x02\x00\x03\x00\x03\x00\x02\x00\x02\x00\x02\x01\x02\x00\x03\x01\x03\x00\x01\x02\x01\x00\x02\x02\x02\x00\x03\x02\x03\x00\x01\x03\x01\x00\x03\x03\x03\x00\x04\x00\x04\x00\x03\x00\x03\x00\x03\x01\x03\x00\x04\x01\x04\x00\x02\x02\x02\x00\x03\x02\x03\x00\x04\x02\x04\x00\x02\x03\x02\x00\x04\x03\x04\x00\x05\x00\x05\x00\x04\x00\x04\x00\x04\x01\x04\x00\x05\x01\x05\x00\x03\x02\x03\x00\x04\x02\x04\x00\x05\x02\x05\x00\x03\x03\x03\x00\x05\x03\x05\x00\x06\x00\x06\x00\x05\x00\x05\x00\x05\x01\x05\x00\x06\x01\x06\x00\x04\x02\x04\x00\x05\x02\x05\x00\x06\x02\x06\x00\x04\x03\x04\x00\x06\x03\x06\x00\a\x00\a\x00\x06\x00\x06\x00\x06\x01\x06\x00\a\x01\a\x00\x05\x02\x05\x00\x06\x02\x06\x00\a\x02\a\x00\x05\x03\x05\x00\a\x03\a\x00\b\x00\b\x00\a\x00\a\x00\a\x01\a\x00\b\x01\b\x00\x06\x02\x06\x00\a\x02\a\x00\b\x02\b\x00\x06\x03\x06\x00\b\x03\b\x00\t\x00\t\x00\b\x00\b\x00\b\x01\b\x00\t\x01\t\x00\a\x02\a\x00\b\x02\b\x00\a\x03\a\x00\t\x00\t\x00\t\x01\t\x00\b\x02\b\x00\b\x03\b\x01\x00\x00\n\x00\x00\x00\x00\x01\x00\x01\n\x01\x00\x02\t\x00\x00\x02\x00\x00\x00\x03\x00\x01\x00\x03\t\x01\x01\x00\v\x00\x01\x00\x01\x01\x00\x00\n\x01\x00\x01\n\x00\x00\x01\x00\x01\x01\x01\v\x01\x00\x02\t\x01\x01\x02\n\x00\x01\x02\x01\x00\x00\x03\x00\x00\x01\x03\x01\x01\x01\x03\n\x01\x02\x00\f\x00\x02\x00\x02\x01\x01\x00\v\x01\x01\x01\v\x00\x01\x01\x01\x01\x02\x01\f\x01\x00\x02\t\x01\x01\x02\n\x01\x02\x02\v\x00\x00\x02\x00\x00\x02\x02\x02\x00\x00\x03\x00\x00\x01\x03\x01\x00\x02\x03\x02\x01\x00\x03\t\x01\x02\x03\v\x01\x03\x00\r\x00\x03\x00\x03\x01\x02\x00\f\x01\x02\x01\f\x00\x02\x01\x02\x01\x03\x01\r\x01\x01\x02\n\x01\x02\x02\v\x01\x03\x02\f\x00\x01\x02\x01\x00\x03\x02\x03\x00\x01\x03\x01\x00\x02\x03\x02\x00\x03\x03\x03\x01\x01\x03\n\x01\x03\x03\f\x01\x04\x00\x0e\x00\x04\x00\x04\x01\x03\x00\r\x01\x03\x01\r\x00\x03\x01\x03\x01\x04\x01\x0e\x01\x02\x02\v\x01\x03\x02\f\x01\x04\x02\r\x00\x02\x02\x02\x00\x04\x02\x04\x00\x02\x03\x02\x00\x03\x03\x03\x00\x04\x03\x04\x01\x02\x03\v\x01\x04\x03\r\x01\x05\x00\x0f\x00\x05\x00\x05\x01\x04\x00\x0e\x01\x04\x01\x0e\x00\x04\x01\x04\x01\x05\x01\x0f\x01\x03\x02\f\x01\x04\x02\r\x01\x05\x02\x0e\x00\x03\x02\x03\x00\x05\x02\x05\x00\x03\x03\x03\x00\x04\x03\x04\x00\x05\x03\x05\x01\x03\x03\f\x01\x05\x03\x0e\x01\x06\x00\x10\x00\x06\x00\x06\x01\x05\x00\x0f\x01\x05\x01\x0f\x00\x05\x01\x05\x01\x06\x01\x10\x01\x04\x02\r\x01\x05\x02\x0e\x01\x06\x02\x0f\x00\x04\x02\x04\x00\x06\x02\x06\x00\x04\x03\x04\x00\x05\x03\x05\x00\x06\x03\x06\x01\x04\x03\r\x01\x06\x03\x0f\x01\a\x00\x11\x00\a\x00\a\x01\x06\x00\x10\x01\x06\x01\x10\x00\x06\x01\x06\x01\a\x01\x11\x01\x05\x02\x0e\x01\x06\x02\x0f\x01\a\x02\x10\x00\x05\x02\x05\x00\a\x02\a\x00\x05\x03\x05\x00\x06\x03\x06\x00\a\x03\a\x01\x05\x03\x0e\x01\a\x03\x10\x01\b\x00\x12\x00\b\x00\b\x01\a\x00\x11\x01\a\x01\x11\x00\a\x01\a\x01\b\x01\x12\x01\x06\x02\x0f\x01\a\x02\x10\x01\b\x02\x11\x00\x06\x02\x06\x00\b\x02\b\x00\x06\x03\x06\x00\a\x03\a\x00\b\x03\b\x01\x06\x03\x0f\x01\b\x03\x11\x01\t\x00\x13\x00\t\x00\t\x01\b\x00\x12\x01\b\x01\x12\x00\b\x01\b\x01\t\x01\x13\x01\a\x02\x10\x01\b\x02\x11\x00\a\x02\a\x00\a\x03\a\x00\b\x03\b\x01\a\x03\x10\x01\t\x00\x13\x01\t\x01\x13\x00\t\x01\t\x01\b\x02\x11\x00\b\x02\b\x00\b\x03\b\x01\b\x03\x11\x02\x00\x00\x14\x01\x00\x00\n\x00\x00\x00\x00\x02\x00\x01\x14\x00\x00\x01\x00\x02\x00\x02\x12\x01\x00\x02\t\x01\x00\x03\t\x02\x00\x03\x12\x02\x01\x00\x15\x01\x01\x00\v\x00\x01\x00\x01\x02\x00\x00\x14\x00\x00\x00\x00\x02\x00\x01\x14\x01\x00\x01\n\x00\x00\x01\x00\x02\x01\x01\x15\x00\x01\x01\x01\x02\x00\x02\x12\x02\x01\x02\x13\x01\x01\x02\n\x01\x00\x03\t\x01\x01\x03\n\x02\x01\x03\x13\x02\x02\x00\x16\x01\x02\x00\f\x00\x02\x00\x02\x02\x01\x00\x15\x00\x01\x00\x01\x02\x01\x01\x15\x01\x01\x01\v\x00\x01\x01\x01\x02\x02\x01\x16\x00\x02\x01\x02\x02\x00\x02\x12\x02\x01\x02\x13\x02\x02\x02\x14\x01\x00\x02\t\x01\x02\x02\v\x01\x00\x03\t\x01\x01\x03\n\x01\x02\x03\v\x02\x00\x03\x12\x02\x02\x03\x14\x02\x03\x00\x17\x01\x03\x00\r\x00\x03\x00\x03\x02\x02\x00\x16\x00\x02\x00\x02\x02\x02\x01\x16\x01\x02\x01\f\x00\x02\x01\x02\x02\x03\x01\x17\x00\x03\x01\x03\x02\x01\x02\x13\x02\x02\x02\x14\x02\x03\x02\x15\x01\x01\x02\n\x01\x03\x02\f\x01\x01\x03\n\x01\x02\x03\v\x01\x03\x03\f\x02\x01\x03\x13\x02\x03\x03\x15\x02\x04\x00\x18\x01\x04\x00\x0e\x00\x04\x00\x04\x02\x03\x00\x17\x00\x03\x00\x03\x02\x03\x01\x17\x01\x03\x01\r\x00\x03\x01\x03\x02\x04\x01\x18\x00\x04\x01\x04\x02\x02\x02\x14\x02\x03\x02\x15\x02\x04\x02\x16\x01\x02\x02\v\x01\x04\x02\r\x01\x02\x03\v\x01\x03\x03\f\x01\x04\x03\r\x02\x02\x03\x14\x02\x04\x03\x16\x02\x05\x00\x19\x01\x05\x00\x0f\x00\x05\x00\x05\x02\x04\x00\x18\x00\x04\x00\x04\x02\x04\x01\x18\x01\x04\x01\x0e\x00\x04\x01\x04\x02\x05\x01\x19\x00\x05\x01\x05\x02\x03\x02\x15\x02\x04\x02\x16\x02\x05\x02\x17\x01\x03\x02\f\x01\x05\x02\x0e\x01\x03\x03\f\x01\x04\x03\r\x01\x05\x03\x0e\x02\x03\x03\x15\x02\x05\x03\x17\x02\x06\x00\x1a\x01\x06\x00\x10\x00\x06\x00\x06\x02\x05\x00\x19\x00\x05\x00\x05\x02\x05\x01\x19\x01\x05\x01\x0f\x00\x05\x01\x05\x02\x06\x01\x1a\x00\x06\x01\x06\x02\x04\x02\x16\x02\x05\x02\x17\x02\x06\x02\x18\x01\x04\x02\r\x01\x06\x02\x0f\x01\x04\x03\r\x01\x05\x03\x0e\x01\x06\x03\x0f\x02\x04\x03\x16\x02\x06\x03\x18\x02\a\x00\x1b\x01\a\x00\x11\x00\a\x00\a\x02\x06\x00\x1a\x00\x06\x00\x06\x02\x06\x01\x1a\x01\x06\x01\x10\x00\x06\x01\x06\x02\a\x01\x1b\x00\a\x01\a\x02\x05\x02\x17\x02\x06\x02\x18\x02\a\x02\x19\x01\x05\x02\x0e\x01\a\x02\x10\x01\x05\x03\x0e\x01\x06\x03\x0f\x01\a\x03\x10\x02\x05\x03\x17\x02\a\x03\x19\x02\b\x00\x1c\x01\b\x00\x12\x00\b\x00\b\x02\a\x00\x1b\x00\a\x00\a\x02\a\x01\x1b\x01\a\x01\x11\x00\a\x01\a\x02\b\x01\x1c\x00\b\x01\b\x02\x06\x02\x18\x02\a\x02\x19\x02\b\x02\x1a\x01\x06\x02\x0f\x01\b\x02\x11\x01\x06\x03\x0f\x01\a\x03\x10\x01\b\x03\x11\x02\x06\x03\x18\x02\b\x03\x1a\x02\t\x00\x1d\x01\t\x00\x13\x00\t\x00\t\x02\b\x00\x1c\x00\b\x00\b\x02\b\x01\x1c\x01\b\x01\x12\x00\b\x01\b\x02\t\x01\x1d\x00\t\x01\t\x02\a\x02\x19\x02\b\x02\x1a\x01\a\x02\x10\x01\a\x03\x10\x01\b\x03\x11\x02\a\x03\x19\x02\t\x00\x1d\x00\t\x00\t\x02\t\x01\x1d\x01\t\x01\x13\x00\t\x01\t\x02\b\x02\x1a\x01\b\x02\x11\x01\b\x03\x11\x02\b\x03\x1a\x02\x00\x00\x14\x01\x00\x00\n\x01\x00\x01\n\x03\x00\x02\x1b\x02\x00\x02\x12\x02\x00\x03\x12\x03\x00\x03\x1b\x02\x01\x00\x15\x01\x01\x00\v\x01\x00\x00\n\x02\x00\x01\x14\x01\x00\x01\n\x01\x01\x01\v\x03\x00\x02\x1b\x03\x01\x02\x1c\x02\x01\x02\x13\x02\x00\x03\x12\x02\x01\x03\x13\x03\x01\x03\x1c\x02\x02\x00\x16\x01\x02\x00\f\x01\x01\x00\v\x02\x01\x01\x15\x01\x01\x01\v\x01\x02\x01\f\x03\x00\x02\x1b\x03\x01\x02\x1c\x03\x02\x02\x1d\x02\x00\x02\x12\x02\x02\x02\x14\x02\x00\x03\x12\x02\x01\x03\x13\x02\x02\x03\x14\x03\x00\x03\x1b\x03\x02\x03\x1d\x02\x03\x00\x17\x01\x03\x00\r\x01\x02\x00\f\x02\x02\x01\x16\x01\x02\x01\f\x01\x03\x01\r\x03\x01\x02\x1c\x03\x02\x02\x1d\x03\x03\x02\x1e\x02\x01\x02\x13\x02\x03\x02\x15\x02\x01\x03\x13\x02\x02\x03\x14\x02\x03\x03\x15\x03\x01\x03\x1c\x03\x03\x03\x1e\x02\x04\x00\x18\x01\x04\x00\x0e\x01\x03\x00\r\x02\x03\x01\x17\x01\x03\x01\r\x01\x04\x01\x0e\x03\x02\x02\x1d\x03\x03\x02\x1e\x03\x04\x02\x1f\x02\x02\x02\x14\x02\x04\x02\x16\x02\x02\x03\x14\x02\x03\x03\x15\x02\x04\x03\x16\x03\x02\x03\x1d\x03\x04\x03\x1f\x02\x05\x00\x19\x01\x05\x00\x0f\x01\x04\x00\x0e\x02\x04\x01\x18\x01\x04\x01\x0e\x01\x05\x01\x0f\x03\x03\x02\x1e\x03\x04\x02\x1f\x03\x05\x02 \x02\x03\x02\x15\x02\x05\x02\x17\x02\x03\x03\x15\x02\x04\x03\x16\x02\x05\x03\x17\x03\x03\x03\x1e\x03\x05\x03 \x02\x06\x00\x1a\x01\x06\x00\x10\x01\x05\x00\x0f\x02\x05\x01\x19\x01\x05\x01\x0f\x01\x06\x01\x10\x03\x04\x02\x1f\x03\x05\x02 \x03\x06\x02!\x02\x04\x02\x16\x02\x06\x02\x18\x02\x04\x03\x16\x02\x05\x03\x17\x02\x06\x03\x18\x03\x04\x03\x1f\x03\x06\x03!\x02\a\x00\x1b\x01\a\x00\x11\x01\x06\x00\x10\x02\x06\x01\x1a\x01\x06\x01\x10\x01\a\x01\x11\x03\x05\x02 \x03\x06\x02!\x03\a\x02\"\x02\x05\x02\x17\x02\a\x02\x19\x02\x05\x03\x17\x02\x06\x03\x18\x02\a\x03\x19\x03\x05\x03 \x03\a\x03\"\x02\b\x00\x1c\x01\b\x00\x12\x01\a\x00\x11\x02\a\x01\x1b\x01\a\x01\x11\x01\b\x01\x12\x03\x06\x02!\x03\a\x02\"\x03\b\x02#\x02\x06\x02\x18\x02\b\x02\x1a\x02\x06\x03\x18\x02\a\x03\x19\x02\b\x03\x1a\x03\x06\x03!\x03\b\x03#\x02\t\x00\x1d\x01\t\x00\x13\x01\b\x00\x12\x02\b\x01\x1c\x01\b\x01\x12\x01\t\x01\x13\x03\a\x02\"\x03\b\x02#\x02\a\x02\x19\x02\a\x03\x19\x02\b\x03\x1a\x03\a\x03\"\x01\t\x00\x13\x02\t\x01\x1d\x01\t\x01\x13\x03\b\x02#\x02\b\x02\x1a\x02\b\x03\x1a\x03\b\x03#\x02\x00\x00\x14\x02\x00\x01\x14\x03\x00\x02\x1b\x03\x00\x03\x1b\x02\x01\x00\x15\x02\x00\x00\x14\x02\x00\x01\x14\x02\x01\x01\x15\x03\x01\x02\x1c\x03\x00\x03\x1b\x03\x01\x03\x1c\x02\x02\x00\x16\x02\x01\x00\x15\x02\x01\x01\x15\x02\x02\x01\x16\x03\x00\x02\x1b\x03\x02\x02\x1d\x03\x00\x03\x1b\x03\x01\x03\x1c\x03\x02\x03\x1d\x02\x03\x00\x17\x02\x02\x00\x16\x02\x02\x01\x16\x02\x03\x01\x17\x03\x01\x02\x1c\x03\x03\x02\x1e\x03\x01\x03\x1c\x03\x02\x03\x1d\x03\x03\x03\x1e\x02\x04\x00\x18\x02\x03\x00\x17\x02\x03\x01\x17\x02\x04\x01\x18\x03\x02\x02\x1d\x03\x04\x02\x1f\x03\x02\x03\x1d\x03\x03\x03\x1e\x03\x04\x03\x1f\x02\x05\x00\x19\x02\x04\x00\x18\x02\x04\x01\x18\x02\x05\x01\x19\x03\x03\x02\x1e\x03\x05\x02 \x03\x03\x03\x1e\x03\x04\x03\x1f\x03\x05\x03 \x02\x06\x00\x1a\x02\x05\x00\x19\x02\x05\x01\x19\x02\x06\x01\x1a\x03\x04\x02\x1f\x03\x06\x02!\x03\x04\x03\x1f\x03\x05\x03 \x03\x06\x03!\x02\a\x00\x1b\x02\x06\x00\x1a\x02\x06\x01\x1a\x02\a\x01\x1b\x03\x05\x02 \x03\a\x02\"\x03\x05\x03 \x03\x06\x03!\x03\a\x03\"\x02\b\x00\x1c\x02\a\x00\x1b\x02\a\x01\x1b\x02\b\x01\x1c\x03\x06\x02!\x03\b\x02#\x03\x06\x03!\x03\a\x03\"\x03\b\x03#\x02\t\x00\x1d\x02\b\x00\x1c\x02\b\x01\x1c\x02\t\x01\x1d\x03\a\x02\"\x03\a\x03\"\x03\b\x03#\x02\t\x00\x1d\x02\t\x01\x1d\x03\b\x02#\x03\b\x03#",
+		start: []int32{0, 4, 11, 20, 29, 38, 47, 56, 65, 74, 81, 85, 92, 104, 120, 136, 152, 168, 184, 200, 216, 228, 235, 244, 260, 280, 300, 320, 340, 360, 380, 400, 416, 425, 432, 444, 460, 476, 492, 508, 524, 540, 556, 568, 575, 579, 586, 595, 604, 613, 622, 631, 640, 649, 656, 660},
+	},
+	{rows: 5, cols: 11, cells: "0,0;1,0;2,0;1,1;", sym: true, n: 132,
+		versions: []generatedVersion{
+			{lo: Pos{0, 0}, n: Pos{3, 10}, masks: []uint64{0x401801, 0x803002, 0x1006004, 0x200c008, 0x4018010, 0x8030020, 0x10060040, 0x200c0080, 0x40180100, 0x80300200, 0x200c00800, 0x401801000, 0x803002000, 0x1006004000, 0x200c008000, 0x4018010000, 0x8030020000, 0x10060040000, 0x200c0080000, 0x40180100000, 0x100600400000, 0x200c00800000, 0x401801000000, 0x803002000000, 0x1006004000000, 0x200c008000000, 0x4018010000000, 0x8030020000000, 0x10060040000000, 0x200c0080000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 10}, masks: []uint64{0x801802, 0x1003004, 0x2006008, 0x400c010, 0x8018020, 0x10030040, 0x20060080, 0x400c0100, 0x80180200, 0x100300400, 0x400c01000, 0x801802000, 0x1003004000, 0x2006008000, 0x400c010000, 0x8018020000, 0x10030040000, 0x20060080000, 0x400c0100000, 0x80180200000, 0x200600800000, 0x400c01000000, 0x801802000000, 0x1003004000000, 0x2006008000000, 0x400c010000000, 0x8018020000000, 0x10030040000000, 0x20060080000000, 0x400c0100000000}},
+			{lo: Pos{0, 0}, n: Pos{4, 9}, masks: []uint64{0x1007, 0x200e, 0x401c, 0x8038, 0x10070, 0x200e0, 0x401c0, 0x80380, 0x100700, 0x803800, 0x1007000, 0x200e000, 0x401c000, 0x8038000, 0x10070000, 0x200e0000, 0x401c0000, 0x80380000, 0x401c00000, 0x803800000, 0x1007000000, 0x200e000000, 0x401c000000, 0x8038000000, 0x10070000000, 0x200e0000000, 0x401c0000000, 0x200e00000000, 0x401c00000000, 0x803800000000, 0x1007000000000, 0x200e000000000, 0x401c000000000, 0x8038000000000, 0x10070000000000, 0x200e0000000000}},
+			{lo: Pos{0, 0}, n: Pos{4, 9}, masks: []uint64{0x3802, 0x7004, 0xe008, 0x1c010, 0x38020, 0x70040, 0xe0080, 0x1c0100, 0x380200, 0x1c01000, 0x3802000, 0x7004000, 0xe008000, 0x1c010000, 0x38020000, 0x70040000, 0xe0080000, 0x1c0100000, 0xe00800000, 0x1c01000000, 0x3802000000, 0x7004000000, 0xe008000000, 0x1c010000000, 0x38020000000, 0x70040000000, 0xe0080000000, 0x700400000000, 0xe00800000000, 0x1c01000000000, 0x3802000000000, 0x7004000000000, 0xe008000000000, 0x1c010000000000, 0x38020000000000, 0x70040000000000}},
+		},
+		spots: "\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x02\x00\x00\x00\x03\x00\x00\x01\x00\x01\x00\x01\x01\x01\x00\x01\x02\x01\x00\x01\x03\x01\x00\x02\x00\x02\x00\x02\x01\x02\x00\x02\x02\x02\x00\x02\x03\x02\x00\x03\x00\x03\x00\x03\x01\x03\x00\x03\x02\x03\x00\x03\x03\x03\x00\x04\x00\x04\x00\x04\x01\x04\x00\x04\x02\x04\x00\x04\x03\x04\x00\x05\x00\x05\x00\x05\x01\x05\x00\x05\x02\x05\x00\x05\x03\x05\x00\x06\x00\x06\x00\x06\x01\x06\x00\x06\x02\x06\x00\x06\x03\x06\x00\a\x00\a\x00\a\x01\a\x00\a\x02\a\x00\a\x03\a\x00\b\x00\b\x00\b\x01\b\x00\b\x02\b\x00\b\x03\b\x00\t\x00\t\x00\t\x01\t\x01\x00\x00\n\x01\x00\x01\n\x01\x00\x02\t\x01\x00\x03\t\x01\x01\x00\v\x01\x01\x01\v\x01\x01\x02\n\x01\x01\x03\n\x01\x02\x00\f\x01\x02\x01\f\x01\x02\x02\v\x01\x02\x03\v\x01\x03\x00\r\x01\x03\x01\r\x01\x03\x02\f\x01\x03\x03\f\x01\x04\x00\x0e\x01\x04\x01\x0e\x01\x04\x02\r\x01\x04\x03\r\x01\x05\x00\x0f\x01\x05\x01\x0f\x01\x05\x02\x0e\x01\x05\x03\x0e\x01\x06\x00\x10\x01\x06\x01\x10\x01\x06\x02\x0f\x01\x06\x03\x0f\x01\a\x00\x11\x01\a\x01\x11\x01\a\x02\x10\x01\a\x03\x10\x01\b\x00\x12\x01\b\x01\x12\x01\b\x02\x11\x01\b\x03\x11\x01\t\x00\x13\x01\t\x01\x13\x02\x00\x00\x14\x02\x00\x01\x14\x02\x00\x02\x12\x02\x00\x03\x12\x02\x01\x00\x15\x02\x01\x01\x15\x02\x01\x02\x13\x02\x01\x03\x13\x02\x02\x00\x16\x02\x02\x01\x16\x02\x02\x02\x14\x02\x02\x03\x14\x02\x03\x00\x17\x02\x03\x01\x17\x02\x03\x02\x15\x02\x03\x03\x15\x02\x04\x00\x18\x02\x04\x01\x18\x02\x04\x02\x16\x02\x04\x03\x16\x02\x05\x00\x19\x02\x05\x01\x19\x02\x05\x02\x17\x02\x05\x03\x17\x02\x06\x00\x1a\x02\x06\x01\x1a\x02\x06\x02\x18\x02\x06\x03\x18\x02\a\x00\x1b\x02\a\x01\x1b\x02\a\x02\x19\x02\a\x03\x19\x02\b\x00\x1c\x02\b\x01\x1c\x02\b\x02\x1a\x02\b\x03\x1a\x02\t\x00\x1d\x02\t\x01\x1d\x03\x00\x02\x1b\x03\x00\x03\x1b\x03\x01\x02\x1c\x03\x01\x03\x1c\x03\x02\x02\x1d\x03\x02\x03\x1d\x03\x03\x02\x1e\x03\x03\x03\x1e\x03\x04\x02\x1f\x03\x04\x03\x1f\x03\x05\x02 \x03\x05\x03 \x03\x06\x02!\x03\x06\x03!\x03\a\x02\"\x03\a\x03\"\x03\b\x02#\x03\b\x03#",
+		cover: "\x00\x00\x00\x00\x00\x00\x02\x00\x00\x01\x00\x01\x00\x00\x01\x00\x00\x00\x02\x00\x00\x01\x02\x01\x00\x00\x03\x00\x00\x02\x00\x02\x00\x01\x01\x01\x00\x00\x02\x00\x00\x01\x02\x01\x00\x02\x02\x02\x00\x01\x03\x01\x00\x03\x00\x03\x00\x02\x01\x02\x00\x01\x02\x01\x00\x02\x02\x02\x00\x03\x02\x03\x00\x02\x03\x02\x00\x04\x00\x04\x00\x03\x01\x03\x00\x02\x02\x02\x00\x03\x02\x03\x00\x04\x02\x04\x00\x03\x03\x03\x00\x05\x00\x05\x00\x04\x01\x04\x00\x03\x02\x03\x00\x04\x02\x04\x00\x05\x02\x05\x00\x04\x03\x04\x00\x06\x00\x06\x00\x05\x01\x05\x00\x04\x02\x04\x00\x05\x02\x05\x00\x06\x02\x06\x00\x05\x03\x05\x00\a\x00\a\x00\x06\x01\x06\x00\x05\x02\x05\x00\x06\x02\x06\x00\a\x02\a\x00\x06\x03\x06\x00\b\x00\b\x00\a\x01\a\x00\x06\x02\x06\x00\a\x02\a\x00\b\x02\b\x00\a\x03\a\x00\t\x00\t\x00\b\x01\b\x00\a\x02\a\x00\b\x02\b\x00\b\x03\b\x00\t\x01\t\x00\b\x02\b\x01\x00\x00\n\x00\x00\x00\x00\x00\x00\x01\x00\x01\x00\x02\t\x00\x00\x03\x00\x01\x01\x00\v\x00\x01\x00\x01\x00\x00\x00\x00\x01\x00\x01\n\x00\x00\x01\x00\x00\x01\x01\x01\x01\x00\x02\t\x01\x01\x02\n\x00\x00\x02\x00\x00\x00\x03\x00\x00\x01\x03\x01\x01\x00\x03\t\x01\x02\x00\f\x00\x02\x00\x02\x00\x01\x00\x01\x01\x01\x01\v\x00\x01\x01\x01\x00\x02\x01\x02\x01\x00\x02\t\x01\x01\x02\n\x01\x02\x02\v\x00\x01\x02\x01\x00\x00\x03\x00\x00\x01\x03\x01\x00\x02\x03\x02\x01\x01\x03\n\x01\x03\x00\r\x00\x03\x00\x03\x00\x02\x00\x02\x01\x02\x01\f\x00\x02\x01\x02\x00\x03\x01\x03\x01\x01\x02\n\x01\x02\x02\v\x01\x03\x02\f\x00\x02\x02\x02\x00\x01\x03\x01\x00\x02\x03\x02\x00\x03\x03\x03\x01\x02\x03\v\x01\x04\x00\x0e\x00\x04\x00\x04\x00\x03\x00\x03\x01\x03\x01\r\x00\x03\x01\x03\x00\x04\x01\x04\x01\x02\x02\v\x01\x03\x02\f\x01\x04\x02\r\x00\x03\x02\x03\x00\x02\x03\x02\x00\x03\x03\x03\x00\x04\x03\x04\x01\x03\x03\f\x01\x05\x00\x0f\x00\x05\x00\x05\x00\x04\x00\x04\x01\x04\x01\x0e\x00\x04\x01\x04\x00\x05\x01\x05\x01\x03\x02\f\x01\x04\x02\r\x01\x05\x02\x0e\x00\x04\x02\x04\x00\x03\x03\x03\x00\x04\x03\x04\x00\x05\x03\x05\x01\x04\x03\r\x01\x06\x00\x10\x00\x06\x00\x06\x00\x05\x00\x05\x01\x05\x01\x0f\x00\x05\x01\x05\x00\x06\x01\x06\x01\x04\x02\r\x01\x05\x02\x0e\x01\x06\x02\x0f\x00\x05\x02\x05\x00\x04\x03\x04\x00\x05\x03\x05\x00\x06\x03\x06\x01\x05\x03\x0e\x01\a\x00\x11\x00\a\x00\a\x00\x06\x00\x06\x01\x06\x01\x10\x00\x06\x01\x06\x00\a\x01\a\x01\x05\x02\x0e\x01\x06\x02\x0f\x01\a\x02\x10\x00\x06\x02\x06\x00\x05\x03\x05\x00\x06\x03\x06\x00\a\x03\a\x01\x06\x03\x0f\x01\b\x00\x12\x00\b\x00\b\x00\a\x00\a\x01\a\x01\x11\x00\a\x01\a\x00\b\x01\b\x01\x06\x02\x0f\x01\a\x02\x10\x01\b\x02\x11\x00\a\x02\a\x00\x06\x03\x06\x00\a\x03\a\x00\b\x03\b\x01\a\x03\x10\x01\t\x00\x13\x00\t\x00\t\x00\b\x00\b\x01\b\x01\x12\x00\b\x01\b\x00\t\x01\t\x01\a\x02\x10\x01\b\x02\x11\x00\b\x02\b\x00\a\x03\a\x00\b\x03\b\x01\b\x03\x11\x00\t\x00\t\x01\t\x01\x13\x00\t\x01\t\x01\b\x02\x11\x00\b\x03\b\x02\x00\x00\x14\x01\x00\x00\n\x00\x00\x00\x00\x01\x00\x01\n\x02\x00\x02\x12\x01\x00\x03\t\x02\x01\x00\x15\x01\x01\x00\v\x00\x01\x00\x01\x01\x00\x00\n\x02\x00\x01\x14\x01\x00\x01\n\x00\x00\x01\x00\x01\x01\x01\v\x02\x00\x02\x12\x02\x01\x02\x13\x01\x00\x02\t\x01\x00\x03\t\x01\x01\x03\n\x02\x00\x03\x12\x02\x02\x00\x16\x01\x02\x00\f\x00\x02\x00\x02\x01\x01\x00\v\x02\x01\x01\x15\x01\x01\x01\v\x00\x01\x01\x01\x01\x02\x01\f\x02\x00\x02\x12\x02\x01\x02\x13\x02\x02\x02\x14\x01\x01\x02\n\x01\x00\x03\t\x01\x01\x03\n\x01\x02\x03\v\x02\x01\x03\x13\x02\x03\x00\x17\x01\x03\x00\r\x00\x03\x00\x03\x01\x02\x00\f\x02\x02\x01\x16\x01\x02\x01\f\x00\x02\x01\x02\x01\x03\x01\r\x02\x01\x02\x13\x02\x02\x02\x14\x02\x03\x02\x15\x01\x02\x02\v\x01\x01\x03\n\x01\x02\x03\v\x01\x03\x03\f\x02\x02\x03\x14\x02\x04\x00\x18\x01\x04\x00\x0e\x00\x04\x00\x04\x01\x03\x00\r\x02\x03\x01\x17\x01\x03\x01\r\x00\x03\x01\x03\x01\x04\x01\x0e\x02\x02\x02\x14\x02\x03\x02\x15\x02\x04\x02\x16\x01\x03\x02\f\x01\x02\x03\v\x01\x03\x03\f\x01\x04\x03\r\x02\x03\x03\x15\x02\x05\x00\x19\x01\x05\x00\x0f\x00\x05\x00\x05\x01\x04\x00\x0e\x02\x04\x01\x18\x01\x04\x01\x0e\x00\x04\x01\x04\x01\x05\x01\x0f\x02\x03\x02\x15\x02\x04\x02\x16\x02\x05\x02\x17\x01\x04\x02\r\x01\x03\x03\f\x01\x04\x03\r\x01\x05\x03\x0e\x02\x04\x03\x16\x02\x06\x00\x1a\x01\x06\x00\x10\x00\x06\x00\x06\x01\x05\x00\x0f\x02\x05\x01\x19\x01\x05\x01\x0f\x00\x05\x01\x05\x01\x06\x01\x10\x02\x04\x02\x16\x02\x05\x02\x17\x02\x06\x02\x18\x01\x05\x02\x0e\x01\x04\x03\r\x01\x05\x03\x0e\x01\x06\x03\x0f\x02\x05\x03\x17\x02\a\x00\x1b\x01\a\x00\x11\x00\a\x00\a\x01\x06\x00\x10\x02\x06\x01\x1a\x01\x06\x01\x10\x00\x06\x01\x06\x01\a\x01\x11\x02\x05\x02\x17\x02\x06\x02\x18\x02\a\x02\x19\x01\x06\x02\x0f\x01\x05\x03\x0e\x01\x06\x03\x0f\x01\a\x03\x10\x02\x06\x03\x18\x02\b\x00\x1c\x01\b\x00\x12\x00\b\x00\b\x01\a\x00\x11\x02\a\x01\x1b\x01\a\x01\x11\x00\a\x01\a\x01\b\x01\x12\x02\x06\x02\x18\x02\a\x02\x19\x02\b\x02\x1a\x01\a\x02\x10\x01\x06\x03\x0f\x01\a\x03\x10\x01\b\x03\x11\x02\a\x03\x19\x02\t\x00\x1d\x01\t\x00\x13\x00\t\x00\t\x01\b\x00\x12\x02\b\x01\x1c\x01\b\x01\x12\x00\b\x01\b\x01\t\x01\x13\x02\a\x02\x19\x02\b\x02\x1a\x01\b\x02\x11\x01\a\x03\x10\x01\b\x03\x11\x02\b\x03\x1a\x01\t\x00\x13\x02\t\x01\x1d\x01\t\x01\x13\x00\t\x01\t\x02\b\x02\x1a\x01\b\x03\x11\x02\x00\x00\x14\x01\x00\x00\n\x02\x00\x01\x14\x03\x00\x02\x1b\x02\x00\x03\x12\x02\x01\x00\x15\x01\x01\x00\v\x02\x00\x00\x14\x02\x00\x01\x14\x01\x00\x01\n\x02\x01\x01\x15\x03\x00\x02\x1b\x03\x01\x02\x1c\x02\x00\x02\x12\x02\x00\x03\x12\x02\x01\x03\x13\x03\x00\x03\x1b\x02\x02\x00\x16\x01\x02\x00\f\x02\x01\x00\x15\x02\x01\x01\x15\x01\x01\x01\v\x02\x02\x01\x16\x03\x00\x02\x1b\x03\x01\x02\x1c\x03\x02\x02\x1d\x02\x01\x02\x13\x02\x00\x03\x12\x02\x01\x03\x13\x02\x02\x03\x14\x03\x01\x03\x1c\x02\x03\x00\x17\x01\x03\x00\r\x02\x02\x00\x16\x02\x02\x01\x16\x01\x02\x01\f\x02\x03\x01\x17\x03\x01\x02\x1c\x03\x02\x02\x1d\x03\x03\x02\x1e\x02\x02\x02\x14\x02\x01\x03\x13\x02\x02\x03\x14\x02\x03\x03\x15\x03\x02\x03\x1d\x02\x04\x00\x18\x01\x04\x00\x0e\x02\x03\x00\x17\x02\x03\x01\x17\x01\x03\x01\r\x02\x04\x01\x18\x03\x02\x02\x1d\x03\x03\x02\x1e\x03\x04\x02\x1f\x02\x03\x02\x15\x02\x02\x03\x14\x02\x03\x03\x15\x02\x04\x03\x16\x03\x03\x03\x1e\x02\x05\x00\x19\x01\x05\x00\x0f\x02\x04\x00\x18\x02\x04\x01\x18\x01\x04\x01\x0e\x02\x05\x01\x19\x03\x03\x02\x1e\x03\x04\x02\x1f\x03\x05\x02 \x02\x04\x02\x16\x02\x03\x03\x15\x02\x04\x03\x16\x02\x05\x03\x17\x03\x04\x03\x1f\x02\x06\x00\x1a\x01\x06\x00\x10\x02\x05\x00\x19\x02\x05\x01\x19\x01\x05\x01\x0f\x02\x06\x01\x1a\x03\x04\x02\x1f\x03\x05\x02 \x03\x06\x02!\x02\x05\x02\x17\x02\x04\x03\x16\x02\x05\x03\x17\x02\x06\x03\x18\x03\x05\x03 \x02\a\x00\x1b\x01\a\x00\x11\x02\x06\x00\x1a\x02\x06\x01\x1a\x01\x06\x01\x10\x02\a\x01\x1b\x03\x05\x02 \x03\x06\x02!\x03\a\x02\"\x02\x06\x02\x18\x02\x05\x03\x17\x02\x06\x03\x18\x02\a\x03\x19\x03\x06\x03!\x02\b\x00\x1c\x01\b\x00\x12\x02\a\x00\x1b\x02\a\x01\x1b\x01\a\x01\x11\x02\b\x01\x1c\x03\x06\x02!\x03\a\x02\"\x03\b\x02#\x02\a\x02\x19\x02\x06\x03\x18\x02\a\x03\x19\x02\b\x03\x1a\x03\a\x03\"\x02\t\x00\x1d\x01\t\x00\x13\x02\b\x00\x1c\x02\b\x01\x1c\x01\b\x01\x12\x02\t\x01\x1d\x03\a\x02\"\x03\b\x02#\x02\b\x02\x1a\x02\a\x03\x19\x02\b\x03\x1a\x03\b\x03#\x02\t\x00\x1d\x02\t\x01\x1d\x01\t\x01\x13\x03\b\x02#\x02\b\x03\x1a\x02\x00\x00\x14\x03\x00\x03\x1b\x02\x01\x00\x15\x02\x00\x01\x14\x03\x00\x02\x1b\x03\x00\x03\x1b\x03\x01\x03\x1c\x02\x02\x00\x16\x02\x01\x01\x15\x03\x01\x02\x1c\x03\x00\x03\x1b\x03\x01\x03\x1c\x03\x02\x03\x1d\x02\x03\x00\x17\x02\x02\x01\x16\x03\x02\x02\x1d\x03\x01\x03\x1c\x03\x02\x03\x1d\x03\x03\x03\x1e\x02\x04\x00\x18\x02\x03\x01\x17\x03\x03\x02\x1e\x03\x02\x03\x1d\x03\x03\x03\x1e\x03\x04\x03\x1f\x02\x05\x00\x19\x02\x04\x01\x18\x03\x04\x02\x1f\x03\x03\x03\x1e\x03\x04\x03\x1f\x03\x05\x03 \x02\x06\x00\x1a\x02\x05\x01\x19\x03\x05\x02 \x03\x04\x03\x1f\x03\x05\x03 \x03\x06\x03!\x02\a\x00\x1b\x02\x06\x01\x1a\x03\x06\x02!\x03\x05\x03 \x03\x06\x03!\x03\a\x03\"\x02\b\x00\x1c\x02\a\x01\x1b\x03\a\x02\"\x03\x06\x03!\x03\a\x03\"\x03\b\x03#\x02\t\x00\x1d\x02\b\x01\x1c\x03\b\x02#\x03\a\x03\"\x03\b\x03#\x02\t\x01\x1d\x03\b\x03#",
+		start: []int32{0, 2, 7, 13, 19, 25, 31, 37, 43, 49, 54, 56, 61, 73, 87, 101, 115, 129, 143, 157, 171, 183, 188, 194, 208, 224, 240, 256, 272, 288, 304, 320, 334, 340, 345, 357, 371, 385, 399, 413, 427, 441, 455, 467, 472, 474, 479, 485, 491, 497, 503, 509, 515, 521, 526, 528},
+	},
+	{rows: 5, cols: 11, cells: "0,0;1,0;2,0;2,1;2,2;", sym: false, n: 108,
+		versions: []generatedVersion{
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0x1c00801, 0x3801002, 0x7002004, 0xe004008, 0x1c008010, 0x38010020, 0x70020040, 0xe0040080, 0x1c0080100, 0xe00400800, 0x1c00801000, 0x3801002000, 0x7002004000, 0xe004008000, 0x1c008010000, 0x38010020000, 0x70020040000, 0xe0040080000, 0x700200400000, 0xe00400800000, 0x1c00801000000, 0x3801002000000, 0x7002004000000, 0xe004008000000, 0x1c008010000000, 0x38010020000000, 0x70020040000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0x1c02004, 0x3804008, 0x7008010, 0xe010020, 0x1c020040, 0x38040080, 0x70080100, 0xe0100200, 0x1c0200400, 0xe01002000, 0x1c02004000, 0x3804008000, 0x7008010000, 0xe010020000, 0x1c020040000, 0x38040080000, 0x70080100000, 0xe0100200000, 0x700801000000, 0xe01002000000, 0x1c02004000000, 0x3804008000000, 0x7008010000000, 0xe010020000000, 0x1c020040000000, 0x38040080000000, 0x70080100000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0x400807, 0x80100e, 0x100201c, 0x2004038, 0x4008070, 0x80100e0, 0x100201c0, 0x20040380, 0x40080700, 0x200403800, 0x400807000, 0x80100e000, 0x100201c000, 0x2004038000, 0x4008070000, 0x80100e0000, 0x100201c0000, 0x20040380000, 0x100201c00000, 0x200403800000, 0x400807000000, 0x80100e000000, 0x100201c000000, 0x2004038000000, 0x4008070000000, 0x80100e0000000, 0x100201c0000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0x1002007, 0x200400e, 0x400801c, 0x8010038, 0x10020070, 0x200400e0, 0x400801c0, 0x80100380, 0x100200700, 0x801003800, 0x1002007000, 0x200400e000, 0x400801c000, 0x8010038000, 0x10020070000, 0x200400e0000, 0x400801c0000, 0x80100380000, 0x400801c00000, 0x801003800000, 0x1002007000000, 0x200400e000000, 0x400801c000000, 0x8010038000000, 0x10020070000000, 0x200400e0000000, 0x400801c0000000}},
+		},
+		spots: "\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x02\x00\x00\x00\x03\x00\x00\x01\x00\x01\x00\x01\x01\x01\x00\x01\x02\x01\x00\x01\x03\x01\x00\x02\x00\x02\x00\x02\x01\x02\x00\x02\x02\x02\x00\x02\x03\x02\x00\x03\x00\x03\x00\x03\x01\x03\x00\x03\x02\x03\x00\x03\x03\x03\x00\x04\x00\x04\x00\x04\x01\x04\x00\x04\x02\x04\x00\x04\x03\x04\x00\x05\x00\x05\x00\x05\x01\x05\x00\x05\x02\x05\x00\x05\x03\x05\x00\x06\x00\x06\x00\x06\x01\x06\x00\x06\x02\x06\x00\x06\x03\x06\x00\a\x00\a\x00\a\x01\a\x00\a\x02\a\x00\a\x03\a\x00\b\x00\b\x00\b\x01\b\x00\b\x02\b\x00\b\x03\b\x01\x00\x00\t\x01\x00\x01\t\x01\x00\x02\t\x01\x00\x03\t\x01\x01\x00\n\x01\x01\x01\n\x01\x01\x02\n\x01\x01\x03\n\x01\x02\x00\v\x01\x02\x01\v\x01\x02\x02\v\x01\x02\x03\v\x01\x03\x00\f\x01\x03\x01\f\x01\x03\x02\f\x01\x03\x03\f\x01\x04\x00\r\x01\x04\x01\r\x01\x04\x02\r\x01\x04\x03\r\x01\x05\x00\x0e\x01\x05\x01\x0e\x01\x05\x02\x0e\x01\x05\x03\x0e\x01\x06\x00\x0f\x01\x06\x01\x0f\x01\x06\x02\x0f\x01\x06\x03\x0f\x01\a\x00\x10\x01\a\x01\x10\x01\a\x02\x10\x01\a\x03\x10\x01\b\x00\x11\x01\b\x01\x11\x01\b\x02\x11\x01\b\x03\x11\x02\x00\x00\x12\x02\x00\x01\x12\x02\x00\x02\x12\x02\x00\x03\x12\x02\x01\x00\x13\x02\x01\x01\x13\x02\x01\x02\x13\x02\x01\x03\x13\x02\x02\x00\x14\x02\x02\x01\x14\x02\x02\x02\x14\x02\x02\x03\x14\x02\x03\x00\x15\x02\x03\x01\x15\x02\x03\x02\x15\x02\x03\x03\x15\x02\x04\x00\x16\x02\x04\x01\x16\x02\x04\x02\x16\x02\x04\x03\x16\x02\x05\x00\x17\x02\x05\x01\x17\x02\x05\x02\x17\x02\x05\x03\x17\x02\x06\x00\x18\x02\x06\x01\x18\x02\x06\x02\x18\x02\x06\x03\x18\x02\a\x00\x19\x02\a\x01\x19\x02\a\x02\x19\x02\a\x03\x19\x02\b\x00\x1a\x02\b\x01\x1a\x02\b\x02\x1a\x02\b\x03\x1a",
+		cover: "\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x03\x00\x00\x01\x00\x01\x00\x00\x02\x00\x00\x01\x02\x01\x00\x00\x03\x00\x00\x01\x03\x01\x00\x02\x00\x02\x00\x00\x01\x00\x00\x00\x02\x00\x00\x01\x02\x01\x00\x02\x02\x02\x00\x00\x03\x00\x00\x01\x03\x01\x00\x02\x03\x02\x00\x03\x00\x03\x00\x01\x01\x01\x00\x01\x02\x01\x00\x02\x02\x02\x00\x03\x02\x03\x00\x01\x03\x01\x00\x02\x03\x02\x00\x03\x03\x03\x00\x04\x00\x04\x00\x02\x01\x02\x00\x02\x02\x02\x00\x03\x02\x03\x00\x04\x02\x04\x00\x02\x03\x02\x00\x03\x03\x03\x00\x04\x03\x04\x00\x05\x00\x05\x00\x03\x01\x03\x00\x03\x02\x03\x00\x04\x02\x04\x00\x05\x02\x05\x00\x03\x03\x03\x00\x04\x03\x04\x00\x05\x03\x05\x00\x06\x00\x06\x00\x04\x01\x04\x00\x04\x02\x04\x00\x05\x02\x05\x00\x06\x02\x06\x00\x04\x03\x04\x00\x05\x03\x05\x00\x06\x03\x06\x00\a\x00\a\x00\x05\x01\x05\x00\x05\x02\x05\x00\x06\x02\x06\x00\a\x02\a\x00\x05\x03\x05\x00\x06\x03\x06\x00\a\x03\a\x00\b\x00\b\x00\x06\x01\x06\x00\x06\x02\x06\x00\a\x02\a\x00\b\x02\b\x00\x06\x03\x06\x00\a\x03\a\x00\b\x03\b\x00\a\x01\a\x00\a\x02\a\x00\b\x02\b\x00\a\x03\a\x00\b\x03\b\x00\b\x01\b\x00\b\x02\b\x00\b\x03\b\x01\x00\x00\t\x00\x00\x00\x00\x01\x00\x02\t\x00\x00\x02\x00\x01\x00\x03\t\x01\x01\x00\n\x00\x01\x00\x01\x01\x00\x02\t\x01\x01\x02\n\x00\x01\x02\x01\x01\x00\x03\t\x01\x01\x03\n\x01\x02\x00\v\x00\x02\x00\x02\x01\x00\x01\t\x00\x00\x01\x00\x01\x00\x02\t\x01\x01\x02\n\x01\x02\x02\v\x00\x02\x02\x02\x00\x00\x03\x00\x01\x00\x03\t\x01\x01\x03\n\x01\x02\x03\v\x01\x03\x00\f\x00\x03\x00\x03\x01\x01\x01\n\x00\x01\x01\x01\x01\x01\x02\n\x01\x02\x02\v\x01\x03\x02\f\x00\x03\x02\x03\x00\x01\x03\x01\x01\x01\x03\n\x01\x02\x03\v\x01\x03\x03\f\x01\x04\x00\r\x00\x04\x00\x04\x01\x02\x01\v\x00\x02\x01\x02\x01\x02\x02\v\x01\x03\x02\f\x01\x04\x02\r\x00\x04\x02\x04\x00\x02\x03\x02\x01\x02\x03\v\x01\x03\x03\f\x01\x04\x03\r\x01\x05\x00\x0e\x00\x05\x00\x05\x01\x03\x01\f\x00\x03\x01\x03\x01\x03\x02\f\x01\x04\x02\r\x01\x05\x02\x0e\x00\x05\x02\x05\x00\x03\x03\x03\x01\x03\x03\f\x01\x04\x03\r\x01\x05\x03\x0e\x01\x06\x00\x0f\x00\x06\x00\x06\x01\x04\x01\r\x00\x04\x01\x04\x01\x04\x02\r\x01\x05\x02\x0e\x01\x06\x02\x0f\x00\x06\x02\x06\x00\x04\x03\x04\x01\x04\x03\r\x01\x05\x03\x0e\x01\x06\x03\x0f\x01\a\x00\x10\x00\a\x00\a\x01\x05\x01\x0e\x00\x05\x01\x05\x01\x05\x02\x0e\x01\x06\x02\x0f\x01\a\x02\x10\x00\a\x02\a\x00\x05\x03\x05\x01\x05\x03\x0e\x01\x06\x03\x0f\x01\a\x03\x10\x01\b\x00\x11\x00\b\x00\b\x01\x06\x01\x0f\x00\x06\x01\x06\x01\x06\x02\x0f\x01\a\x02\x10\x01\b\x02\x11\x00\b\x02\b\x00\x06\x03\x06\x01\x06\x03\x0f\x01\a\x03\x10\x01\b\x03\x11\x01\a\x01\x10\x00\a\x01\a\x01\a\x02\x10\x01\b\x02\x11\x00\a\x03\a\x01\a\x03\x10\x01\b\x03\x11\x01\b\x01\x11\x00\b\x01\b\x01\b\x02\x11\x00\b\x03\b\x01\b\x03\x11\x02\x00\x00\x12\x01\x00\x00\t\x00\x00\x00\x00\x00\x00\x01\x00\x02\x00\x02\x12\x01\x00\x02\t\x00\x00\x02\x00\x02\x00\x03\x12\x02\x01\x00\x13\x01\x01\x00\n\x00\x01\x00\x01\x00\x00\x00\x00\x00\x00\x01\x00\x00\x01\x01\x01\x02\x00\x02\x12\x02\x01\x02\x13\x01\x01\x02\n\x00\x01\x02\x01\x02\x00\x03\x12\x02\x01\x03\x13\x02\x02\x00\x14\x01\x02\x00\v\x00\x02\x00\x02\x00\x01\x00\x01\x00\x00\x00\x00\x02\x00\x01\x12\x01\x00\x01\t\x00\x00\x01\x00\x00\x01\x01\x01\x00\x02\x01\x02\x02\x00\x02\x12\x02\x01\x02\x13\x02\x02\x02\x14\x01\x02\x02\v\x00\x02\x02\x02\x00\x00\x03\x00\x01\x00\x03\t\x02\x00\x03\x12\x02\x01\x03\x13\x02\x02\x03\x14\x02\x03\x00\x15\x01\x03\x00\f\x00\x03\x00\x03\x00\x02\x00\x02\x00\x01\x00\x01\x02\x01\x01\x13\x01\x01\x01\n\x00\x01\x01\x01\x00\x02\x01\x02\x00\x03\x01\x03\x02\x01\x02\x13\x02\x02\x02\x14\x02\x03\x02\x15\x01\x03\x02\f\x00\x03\x02\x03\x00\x01\x03\x01\x01\x01\x03\n\x02\x01\x03\x13\x02\x02\x03\x14\x02\x03\x03\x15\x02\x04\x00\x16\x01\x04\x00\r\x00\x04\x00\x04\x00\x03\x00\x03\x00\x02\x00\x02\x02\x02\x01\x14\x01\x02\x01\v\x00\x02\x01\x02\x00\x03\x01\x03\x00\x04\x01\x04\x02\x02\x02\x14\x02\x03\x02\x15\x02\x04\x02\x16\x01\x04\x02\r\x00\x04\x02\x04\x00\x02\x03\x02\x01\x02\x03\v\x02\x02\x03\x14\x02\x03\x03\x15\x02\x04\x03\x16\x02\x05\x00\x17\x01\x05\x00\x0e\x00\x05\x00\x05\x00\x04\x00\x04\x00\x03\x00\x03\x02\x03\x01\x15\x01\x03\x01\f\x00\x03\x01\x03\x00\x04\x01\x04\x00\x05\x01\x05\x02\x03\x02\x15\x02\x04\x02\x16\x02\x05\x02\x17\x01\x05\x02\x0e\x00\x05\x02\x05\x00\x03\x03\x03\x01\x03\x03\f\x02\x03\x03\x15\x02\x04\x03\x16\x02\x05\x03\x17\x02\x06\x00\x18\x01\x06\x00\x0f\x00\x06\x00\x06\x00\x05\x00\x05\x00\x04\x00\x04\x02\x04\x01\x16\x01\x04\x01\r\x00\x04\x01\x04\x00\x05\x01\x05\x00\x06\x01\x06\x02\x04\x02\x16\x02\x05\x02\x17\x02\x06\x02\x18\x01\x06\x02\x0f\x00\x06\x02\x06\x00\x04\x03\x04\x01\x04\x03\r\x02\x04\x03\x16\x02\x05\x03\x17\x02\x06\x03\x18\x02\a\x00\x19\x01\a\x00\x10\x00\a\x00\a\x00\x06\x00\x06\x00\x05\x00\x05\x02\x05\x01\x17\x01\x05\x01\x0e\x00\x05\x01\x05\x00\x06\x01\x06\x00\a\x01\a\x02\x05\x02\x17\x02\x06\x02\x18\x02\a\x02\x19\x01\a\x02\x10\x00\a\x02\a\x00\x05\x03\x05\x01\x05\x03\x0e\x02\x05\x03\x17\x02\x06\x03\x18\x02\a\x03\x19\x02\b\x00\x1a\x01\b\x00\x11\x00\b\x00\b\x00\a\x00\a\x00\x06\x00\x06\x02\x06\x01\x18\x01\x06\x01\x0f\x00\x06\x01\x06\x00\a\x01\a\x00\b\x01\b\x02\x06\x02\x18\x02\a\x02\x19\x02\b\x02\x1a\x01\b\x02\x11\x00\b\x02\b\x00\x06\x03\x06\x01\x06\x03\x0f\x02\x06\x03\x18\x02\a\x03\x19\x02\b\x03\x1a\x00\b\x00\b\x00\a\x00\a\x02\a\x01\x19\x01\a\x01\x10\x00\a\x01\a\x00\b\x01\b\x02\a\x02\x19\x02\b\x02\x1a\x00\a\x03\a\x01\a\x03\x10\x02\a\x03\x19\x02\b\x03\x1a\x00\b\x00\b\x02\b\x01\x1a\x01\b\x01\x11\x00\b\x01\b\x02\b\x02\x1a\x00\b\x03\b\x01\b\x03\x11\x02\b\x03\x1a\x02\x00\x00\x12\x01\x00\x00\t\x01\x00\x01\t\x02\x00\x02\x12\x01\x00\x02\t\x02\x01\x00\x13\x01\x01\x00\n\x01\x00\x00\t\x01\x00\x01\t\x01\x01\x01\n\x02\x01\x02\x13\x01\x01\x02\n\x02\x02\x00\x14\x01\x02\x00\v\x01\x01\x00\n\x01\x00\x00\t\x02\x00\x01\x12\x01\x00\x01\t\x01\x01\x01\n\x01\x02\x01\v\x02\x02\x02\x14\x01\x02\x02\v\x01\x00\x03\t\x02\x00\x03\x12\x02\x03\x00\x15\x01\x03\x00\f\x01\x02\x00\v\x01\x01\x00\n\x02\x01\x01\x13\x01\x01\x01\n\x01\x02\x01\v\x01\x03\x01\f\x02\x03\x02\x15\x01\x03\x02\f\x01\x01\x03\n\x02\x01\x03\x13\x02\x04\x00\x16\x01\x04\x00\r\x01\x03\x00\f\x01\x02\x00\v\x02\x02\x01\x14\x01\x02\x01\v\x01\x03\x01\f\x01\x04\x01\r\x02\x04\x02\x16\x01\x04\x02\r\x01\x02\x03\v\x02\x02\x03\x14\x02\x05\x00\x17\x01\x05\x00\x0e\x01\x04\x00\r\x01\x03\x00\f\x02\x03\x01\x15\x01\x03\x01\f\x01\x04\x01\r\x01\x05\x01\x0e\x02\x05\x02\x17\x01\x05\x02\x0e\x01\x03\x03\f\x02\x03\x03\x15\x02\x06\x00\x18\x01\x06\x00\x0f\x01\x05\x00\x0e\x01\x04\x00\r\x02\x04\x01\x16\x01\x04\x01\r\x01\x05\x01\x0e\x01\x06\x01\x0f\x02\x06\x02\x18\x01\x06\x02\x0f\x01\x04\x03\r\x02\x04\x03\x16\x02\a\x00\x19\x01\a\x00\x10\x01\x06\x00\x0f\x01\x05\x00\x0e\x02\x05\x01\x17\x01\x05\x01\x0e\x01\x06\x01\x0f\x01\a\x01\x10\x02\a\x02\x19\x01\a\x02\x10\x01\x05\x03\x0e\x02\x05\x03\x17\x02\b\x00\x1a\x01\b\x00\x11\x01\a\x00\x10\x01\x06\x00\x0f\x02\x06\x01\x18\x01\x06\x01\x0f\x01\a\x01\x10\x01\b\x01\x11\x02\b\x02\x1a\x01\b\x02\x11\x01\x06\x03\x0f\x02\x06\x03\x18\x01\b\x00\x11\x01\a\x00\x10\x02\a\x01\x19\x01\a\x01\x10\x01\b\x01\x11\x01\a\x03\x10\x02\a\x03\x19\x01\b\x00\x11\x02\b\x01\x1a\x01\b\x01\x11\x01\b\x03\x11\x02\b\x03\x1a\x02\x00\x00\x12\x02\x00\x01\x12\x02\x00\x02\x12\x02\x01\x00\x13\x02\x00\x00\x12\x02\x00\x01\x12\x02\x01\x01\x13\x02\x01\x02\x13\x02\x02\x00\x14\x02\x01\x00\x13\x02\x00\x00\x12\x02\x00\x01\x12\x02\x01\x01\x13\x02\x02\x01\x14\x02\x02\x02\x14\x02\x00\x03\x12\x02\x03\x00\x15\x02\x02\x00\x14\x02\x01\x00\x13\x02\x01\x01\x13\x02\x02\x01\x14\x02\x03\x01\x15\x02\x03\x02\x15\x02\x01\x03\x13\x02\x04\x00\x16\x02\x03\x00\x15\x02\x02\x00\x14\x02\x02\x01\x14\x02\x03\x01\x15\x02\x04\x01\x16\x02\x04\x02\x16\x02\x02\x03\x14\x02\x05\x00\x17\x02\x04\x00\x16\x02\x03\x00\x15\x02\x03\x01\x15\x02\x04\x01\x16\x02\x05\x01\x17\x02\x05\x02\x17\x02\x03\x03\x15\x02\x06\x00\x18\x02\x05\x00\x17\x02\x04\x00\x16\x02\x04\x01\x16\x02\x05\x01\x17\x02\x06\x01\x18\x02\x06\x02\x18\x02\x04\x03\x16\x02\a\x00\x19\x02\x06\x00\x18\x02\x05\x00\x17\x02\x05\x01\x17\x02\x06\x01\x18\x02\a\x01\x19\x02\a\x02\x19\x02\x05\x03\x17\x02\b\x00\x1a\x02\a\x00\x19\x02\x06\x00\x18\x02\x06\x01\x18\x02\a\x01\x19\x02\b\x01\x1a\x02\b\x02\x1a\x02\x06\x03\x18\x02\b\x00\x1a\x02\a\x00\x19\x02\a\x01\x19\x02\b\x01\x1a\x02\a\x03\x19\x02\b\x00\x1a\x02\b\x01\x1a\x02\b\x03\x1a",
+		start: []int32{0, 3, 8, 16, 24, 32, 40, 48, 56, 64, 69, 72, 77, 84, 96, 108, 120, 132, 144, 156, 168, 175, 180, 188, 200, 220, 240, 260, 280, 300, 320, 340, 352, 360, 365, 372, 384, 396, 408, 420, 432, 444, 456, 463, 468, 471, 476, 484, 492, 500, 508, 516, 524, 532, 537, 540},
+	},
+	{rows: 5, cols: 11, cells: "0,0;1,0;2,0;2,1;2,2;", sym: true, n: 108,
+		versions: []generatedVersion{
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0x1c00801, 0x3801002, 0x7002004, 0xe004008, 0x1c008010, 0x38010020, 0x70020040, 0xe0040080, 0x1c0080100, 0xe00400800, 0x1c00801000, 0x3801002000, 0x7002004000, 0xe004008000, 0x1c008010000, 0x38010020000, 0x70020040000, 0xe0040080000, 0x700200400000, 0xe00400800000, 0x1c00801000000, 0x3801002000000, 0x7002004000000, 0xe004008000000, 0x1c008010000000, 0x38010020000000, 0x70020040000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0x1c02004, 0x3804008, 0x7008010, 0xe010020, 0x1c020040, 0x38040080, 0x70080100, 0xe0100200, 0x1c0200400, 0xe01002000, 0x1c02004000, 0x3804008000, 0x7008010000, 0xe010020000, 0x1c020040000, 0x38040080000, 0x70080100000, 0xe0100200000, 0x700801000000, 0xe01002000000, 0x1c02004000000, 0x3804008000000, 0x7008010000000, 0xe010020000000, 0x1c020040000000, 0x38040080000000, 0x70080100000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0x400807, 0x80100e, 0x100201c, 0x2004038, 0x4008070, 0x80100e0, 0x100201c0, 0x20040380, 0x40080700, 0x200403800, 0x400807000, 0x80100e000, 0x100201c000, 0x2004038000, 0x4008070000, 0x80100e0000, 0x100201c0000, 0x20040380000, 0x100201c00000, 0x200403800000, 0x400807000000, 0x80100e000000, 0x100201c000000, 0x2004038000000, 0x4008070000000, 0x80100e0000000, 0x100201c0000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0x1002007, 0x200400e, 0x400801c, 0x8010038, 0x10020070, 0x200400e0, 0x400801c0, 0x80100380, 0x100200700, 0x801003800, 0x1002007000, 0x200400e000, 0x400801c000, 0x8010038000, 0x10020070000, 0x200400e0000, 0x400801c0000, 0x80100380000, 0x400801c00000, 0x801003800000, 0x1002007000000, 0x200400e000000, 0x400801c000000, 0x8010038000000, 0x10020070000000, 0x200400e0000000, 0x400801c0000000}},
+		},
+		spots: "\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x02\x00\x00\x00\x03\x00\x00\x01\x00\x01\x00\x01\x01\x01\x00\x01\x02\x01\x00\x01\x03\x01\x00\x02\x00\x02\x00\x02\x01\x02\x00\x02\x02\x02\x00\x02\x03\x02\x00\x03\x00\x03\x00\x03\x01\x03\x00\x03\x02\x03\x00\x03\x03\x03\x00\x04\x00\x04\x00\x04\x01\x04\x00\x04\x02\x04\x00\x04\x03\x04\x00\x05\x00\x05\x00\x05\x01\x05\x00\x05\x02\x05\x00\x05\x03\x05\x00\x06\x00\x06\x00\x06\x01\x06\x00\x06\x02\x06\x00\x06\x03\x06\x00\a\x00\a\x00\a\x01\a\x00\a\x02\a\x00\a\x03\a\x00\b\x00\b\x00\b\x01\b\x00\b\x02\b\x00\b\x03\b\x01\x00\x00\t\x01\x00\x01\t\x01\x00\x02\t\x01\x00\x03\t\x01\x01\x00\n\x01\x01\x01\n\x01\x01\x02\n\x01\x01\x03\n\x01\x02\x00\v\x01\x02\x01\v\x01\x02\x02\v\x01\x02\x03\v\x01\x03\x00\f\x01\x03\x01\f\x01\x03\x02\f\x01\x03\x03\f\x01\x04\x00\r\x01\x04\x01\r\x01\x04\x02\r\x01\x04\x03\r\x01\x05\x00\x0e\x01\x05\x01\x0e\x01\x05\x02\x0e\x01\x05\x03\x0e\x01\x06\x00\x0f\x01\x06\x01\x0f\x01\x06\x02\x0f\x01\x06\x03\x0f\x01\a\x00\x10\x01\a\x01\x10\x01\a\x02\x10\x01\a\x03\x10\x01\b\x00\x11\x01\b\x01\x11\x01\b\x02\x11\x01\b\x03\x11\x02\x00\x00\x12\x02\x00\x01\x12\x02\x00\x02\x12\x02\x00\x03\x12\x02\x01\x00\x13\x02\x01\x01\x13\x02\x01\x02\x13\x02\x01\x03\x13\x02\x02\x00\x14\x02\x02\x01\x14\x02\x02\x02\x14\x02\x02\x03\x14\x02\x03\x00\x15\x02\x03\x01\x15\x02\x03\x02\x15\x02\x03\x03\x15\x02\x04\x00\x16\x02\x04\x01\x16\x02\x04\x02\x16\x02\x04\x03\x16\x02\x05\x00\x17\x02\x05\x01\x17\x02\x05\x02\x17\x02\x05\x03\x17\x02\x06\x00\x18\x02\x06\x01\x18\x02\x06\x02\x18\x02\x06\x03\x18\x02\a\x00\x19\x02\a\x01\x19\x02\a\x02\x19\x02\a\x03\x19\x02\b\x00\x1a\x02\b\x01\x1a\x02\b\x02\x1a\x02\b\x03\x1a",
+		cover: "\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x03\x00\x00\x01\x00\x01\x00\x00\x02\x00\x00\x01\x02\x01\x00\x00\x03\x00\x00\x01\x03\x01\x00\x02\x00\x02\x00\x00\x01\x00\x00\x00\x02\x00\x00\x01\x02\x01\x00\x02\x02\x02\x00\x00\x03\x00\x00\x01\x03\x01\x00\x02\x03\x02\x00\x03\x00\x03\x00\x01\x01\x01\x00\x01\x02\x01\x00\x02\x02\x02\x00\x03\x02\x03\x00\x01\x03\x01\x00\x02\x03\x02\x00\x03\x03\x03\x00\x04\x00\x04\x00\x02\x01\x02\x00\x02\x02\x02\x00\x03\x02\x03\x00\x04\x02\x04\x00\x02\x03\x02\x00\x03\x03\x03\x00\x04\x03\x04\x00\x05\x00\x05\x00\x03\x01\x03\x00\x03\x02\x03\x00\x04\x02\x04\x00\x05\x02\x05\x00\x03\x03\x03\x00\x04\x03\x04\x00\x05\x03\x05\x00\x06\x00\x06\x00\x04\x01\x04\x00\x04\x02\x04\x00\x05\x02\x05\x00\x06\x02\x06\x00\x04\x03\x04\x00\x05\x03\x05\x00\x06\x03\x06\x00\a\x00\a\x00\x05\x01\x05\x00\x05\x02\x05\x00\x06\x02\x06\x00\a\x02\a\x00\x05\x03\x05\x00\x06\x03\x06\x00\a\x03\a\x00\b\x00\b\x00\x06\x01\x06\x00\x06\x02\x06\x00\a\x02\a\x00\b\x02\b\x00\x06\x03\x06\x00\a\x03\a\x00\b\x03\b\x00\a\x01\a\x00\a\x02\a\x00\b\x02\b\x00\a\x03\a\x00\b\x03\b\x00\b\x01\b\x00\b\x02\b\x00\b\x03\b\x01\x00\x00\t\x00\x00\x00\x00\x01\x00\x02\t\x00\x00\x02\x00\x01\x00\x03\t\x01\x01\x00\n\x00\x01\x00\x01\x01\x00\x02\t\x01\x01\x02\n\x00\x01\x02\x01\x01\x00\x03\t\x01\x01\x03\n\x01\x02\x00\v\x00\x02\x00\x02\x01\x00\x01\t\x00\x00\x01\x00\x01\x00\x02\t\x01\x01\x02\n\x01\x02\x02\v\x00\x02\x02\x02\x00\x00\x03\x00\x01\x00\x03\t\x01\x01\x03\n\x01\x02\x03\v\x01\x03\x00\f\x00\x03\x00\x03\x01\x01\x01\n\x00\x01\x01\x01\x01\x01\x02\n\x01\x02\x02\v\x01\x03\x02\f\x00\x03\x02\x03\x00\x01\x03\x01\x01\x01\x03\n\x01\x02\x03\v\x01\x03\x03\f\x01\x04\x00\r\x00\x04\x00\x04\x01\x02\x01\v\x00\x02\x01\x02\x01\x02\x02\v\x01\x03\x02\f\x01\x04\x02\r\x00\x04\x02\x04\x00\x02\x03\x02\x01\x02\x03\v\x01\x03\x03\f\x01\x04\x03\r\x01\x05\x00\x0e\x00\x05\x00\x05\x01\x03\x01\f\x00\x03\x01\x03\x01\x03\x02\f\x01\x04\x02\r\x01\x05\x02\x0e\x00\x05\x02\x05\x00\x03\x03\x03\x01\x03\x03\f\x01\x04\x03\r\x01\x05\x03\x0e\x01\x06\x00\x0f\x00\x06\x00\x06\x01\x04\x01\r\x00\x04\x01\x04\x01\x04\x02\r\x01\x05\x02\x0e\x01\x06\x02\x0f\x00\x06\x02\x06\x00\x04\x03\x04\x01\x04\x03\r\x01\x05\x03\x0e\x01\x06\x03\x0f\x01\a\x00\x10\x00\a\x00\a\x01\x05\x01\x0e\x00\x05\x01\x05\x01\x05\x02\x0e\x01\x06\x02\x0f\x01\a\x02\x10\x00\a\x02\a\x00\x05\x03\x05\x01\x05\x03\x0e\x01\x06\x03\x0f\x01\a\x03\x10\x01\b\x00\x11\x00\b\x00\b\x01\x06\x01\x0f\x00\x06\x01\x06\x01\x06\x02\x0f\x01\a\x02\x10\x01\b\x02\x11\x00\b\x02\b\x00\x06\x03\x06\x01\x06\x03\x0f\x01\a\x03\x10\x01\b\x03\x11\x01\a\x01\x10\x00\a\x01\a\x01\a\x02\x10\x01\b\x02\x11\x00\a\x03\a\x01\a\x03\x10\x01\b\x03\x11\x01\b\x01\x11\x00\b\x01\b\x01\b\x02\x11\x00\b\x03\b\x01\b\x03\x11\x02\x00\x00\x12\x01\x00\x00\t\x00\x00\x00\x00\x00\x00\x01\x00\x02\x00\x02\x12\x01\x00\x02\t\x00\x00\x02\x00\x02\x00\x03\x12\x02\x01\x00\x13\x01\x01\x00\n\x00\x01\x00\x01\x00\x00\x00\x00\x00\x00\x01\x00\x00\x01\x01\x01\x02\x00\x02\x12\x02\x01\x02\x13\x01\x01\x02\n\x00\x01\x02\x01\x02\x00\x03\x12\x02\x01\x03\x13\x02\x02\x00\x14\x01\x02\x00\v\x00\x02\x00\x02\x00\x01\x00\x01\x00\x00\x00\x00\x02\x00\x01\x12\x01\x00\x01\t\x00\x00\x01\x00\x00\x01\x01\x01\x00\x02\x01\x02\x02\x00\x02\x12\x02\x01\x02\x13\x02\x02\x02\x14\x01\x02\x02\v\x00\x02\x02\x02\x00\x00\x03\x00\x01\x00\x03\t\x02\x00\x03\x12\x02\x01\x03\x13\x02\x02\x03\x14\x02\x03\x00\x15\x01\x03\x00\f\x00\x03\x00\x03\x00\x02\x00\x02\x00\x01\x00\x01\x02\x01\x01\x13\x01\x01\x01\n\x00\x01\x01\x01\x00\x02\x01\x02\x00\x03\x01\x03\x02\x01\x02\x13\x02\x02\x02\x14\x02\x03\x02\x15\x01\x03\x02\f\x00\x03\x02\x03\x00\x01\x03\x01\x01\x01\x03\n\x02\x01\x03\x13\x02\x02\x03\x14\x02\x03\x03\x15\x02\x04\x00\x16\x01\x04\x00\r\x00\x04\x00\x04\x00\x03\x00\x03\x00\x02\x00\x02\x02\x02\x01\x14\x01\x02\x01\v\x00\x02\x01\x02\x00\x03\x01\x03\x00\x04\x01\x04\x02\x02\x02\x14\x02\x03\x02\x15\x02\x04\x02\x16\x01\x04\x02\r\x00\x04\x02\x04\x00\x02\x03\x02\x01\x02\x03\v\x02\x02\x03\x14\x02\x03\x03\x15\x02\x04\x03\x16\x02\x05\x00\x17\x01\x05\x00\x0e\x00\x05\x00\x05\x00\x04\x00\x04\x00\x03\x00\x03\x02\x03\x01\x15\x01\x03\x01\f\x00\x03\x01\x03\x00\x04\x01\x04\x00\x05\x01\x05\x02\x03\x02\x15\x02\x04\x02\x16\x02\x05\x02\x17\x01\x05\x02\x0e\x00\x05\x02\x05\x00\x03\x03\x03\x01\x03\x03\f\x02\x03\x03\x15\x02\x04\x03\x16\x02\x05\x03\x17\x02\x06\x00\x18\x01\x06\x00\x0f\x00\x06\x00\x06\x00\x05\x00\x05\x00\x04\x00\x04\x02\x04\x01\x16\x01\x04\x01\r\x00\x04\x01\x04\x00\x05\x01\x05\x00\x06\x01\x06\x02\x04\x02\x16\x02\x05\x02\x17\x02\x06\x02\x18\x01\x06\x02\x0f\x00\x06\x02\x06\x00\x04\x03\x04\x01\x04\x03\r\x02\x04\x03\x16\x02\x05\x03\x17\x02\x06\x03\x18\x02\a\x00\x19\x01\a\x00\x10\x00\a\x00\a\x00\x06\x00\x06\x00\x05\x00\x05\x02\x05\x01\x17\x01\x05\x01\x0e\x00\x05\x01\x05\x00\x06\x01\x06\x00\a\x01\a\x02\x05\x02\x17\x02\x06\x02\x18\x02\a\x02\x19\x01\a\x02\x10\x00\a\x02\a\x00\x05\x03\x05\x01\x05\x03\x0e\x02\x05\x03\x17\x02\x06\x03\x18\x02\a\x03\x19\x02\b\x00\x1a\x01\b\x00\x11\x00\b\x00\b\x00\a\x00\a\x00\x06\x00\x06\x02\x06\x01\x18\x01\x06\x01\x0f\x00\x06\x01\x06\x00\a\x01\a\x00\b\x01\b\x02\x06\x02\x18\x02\a\x02\x19\x02\b\x02\x1a\x01\b\x02\x11\x00\b\x02\b\x00\x06\x03\x06\x01\x06\x03\x0f\x02\x06\x03\x18\x02\a\x03\x19\x02\b\x03\x1a\x00\b\x00\b\x00\a\x00\a\x02\a\x01\x19\x01\a\x01\x10\x00\a\x01\a\x00\b\x01\b\x02\a\x02\x19\x02\b\x02\x1a\x00\a\x03\a\x01\a\x03\x10\x02\a\x03\x19\x02\b\x03\x1a\x00\b\x00\b\x02\b\x01\x1a\x01\b\x01\x11\x00\b\x01\b\x02\b\x02\x1a\x00\b\x03\b\x01\b\x03\x11\x02\b\x03\x1a\x02\x00\x00\x12\x01\x00\x00\t\x01\x00\x01\t\x02\x00\x02\x12\x01\x00\x02\t\x02\x01\x00\x13\x01\x01\x00\n\x01\x00\x00\t\x01\x00\x01\t\x01\x01\x01\n\x02\x01\x02\x13\x01\x01\x02\n\x02\x02\x00\x14\x01\x02\x00\v\x01\x01\x00\n\x01\x00\x00\t\x02\x00\x01\x12\x01\x00\x01\t\x01\x01\x01\n\x01\x02\x01\v\x02\x02\x02\x14\x01\x02\x02\v\x01\x00\x03\t\x02\x00\x03\x12\x02\x03\x00\x15\x01\x03\x00\f\x01\x02\x00\v\x01\x01\x00\n\x02\x01\x01\x13\x01\x01\x01\n\x01\x02\x01\v\x01\x03\x01\f\x02\x03\x02\x15\x01\x03\x02\f\x01\x01\x03\n\x02\x01\x03\x13\x02\x04\x00\x16\x01\x04\x00\r\x01\x03\x00\f\x01\x02\x00\v\x02\x02\x01\x14\x01\x02\x01\v\x01\x03\x01\f\x01\x04\x01\r\x02\x04\x02\x16\x01\x04\x02\r\x01\x02\x03\v\x02\x02\x03\x14\x02\x05\x00\x17\x01\x05\x00\x0e\x01\x04\x00\r\x01\x03\x00\f\x02\x03\x01\x15\x01\x03\x01\f\x01\x04\x01\r\x01\x05\x01\x0e\x02\x05\x02\x17\x01\x05\x02\x0e\x01\x03\x03\f\x02\x03\x03\x15\x02\x06\x00\x18\x01\x06\x00\x0f\x01\x05\x00\x0e\x01\x04\x00\r\x02\x04\x01\x16\x01\x04\x01\r\x01\x05\x01\x0e\x01\x06\x01\x0f\x02\x06\x02\x18\x01\x06\x02\x0f\x01\x04\x03\r\x02\x04\x03\x16\x02\a\x00\x19\x01\a\x00\x10\x01\x06\x00\x0f\x01\x05\x00\x0e\x02\x05\x01\x17\x01\x05\x01\x0e\x01\x06\x01\x0f\x01\a\x01\x10\x02\a\x02\x19\x01\a\x02\x10\x01\x05\x03\x0e\x02\x05\x03\x17\x02\b\x00\x1a\x01\b\x00\x11\x01\a\x00\x10\x01\x06\x00\x0f\x02\x06\x01\x18\x01\x06\x01\x0f\x01\a\x01\x10\x01\b\x01\x11\x02\b\x02\x1a\x01\b\x02\x11\x01\x06\x03\x0f\x02\x06\x03\x18\x01\b\x00\x11\x01\a\x00\x10\x02\a\x01\x19\x01\a\x01\x10\x01\b\x01\x11\x01\a\x03\x10\x02\a\x03\x19\x01\b\x00\x11\x02\b\x01\x1a\x01\b\x01\x11\x01\b\x03\x11\x02\b\x03\x1a\x02\x00\x00\x12\x02\x00\x01\x12\x02\x00\x02\x12\x02\x01\x00\x13\x02\x00\x00\x12\x02\x00\x01\x12\x02\x01\x01\x13\x02\x01\x02\x13\x02\x02\x00\x14\x02\x01\x00\x13\x02\x00\x00\x12\x02\x00\x01\x12\x02\x01\x01\x13\x02\x02\x01\x14\x02\x02\x02\x14\x02\x00\x03\x12\x02\x03\x00\x15\x02\x02\x00\x14\x02\x01\x00\x13\x02\x01\x01\x13\x02\x02\x01\x14\x02\x03\x01\x15\x02\x03\x02\x15\x02\x01\x03\x13\x02\x04\x00\x16\x02\x03\x00\x15\x02\x02\x00\x14\x02\x02\x01\x14\x02\x03\x01\x15\x02\x04\x01\x16\x02\x04\x02\x16\x02\x02\x03\x14\x02\x05\x00\x17\x02\x04\x00\x16\x02\x03\x00\x15\x02\x03\x01\x15\x02\x04\x01\x16\x02\x05\x01\x17\x02\x05\x02\x17\x02\x03\x03\x15\x02\x06\x00\x18\x02\x05\x00\x17\x02\x04\x00\x16\x02\x04\x01\x16\x02\x05\x01\x17\x02\x06\x01\x18\x02\x06\x02\x18\x02\x04\x03\x16\x02\a\x00\x19\x02\x06\x00\x18\x02\x05\x00\x17\x02\x05\x01\x17\x02\x06\x01\x18\x02\a\x01\x19\x02\a\x02\x19\x02\x05\x03\x17\x02\b\x00\x1a\x02\a\x00\x19\x02\x06\x00\x18\x02\x06\x01\x18\x02\a\x01\x19\x02\b\x01\x1a\x02\b\x02\x1a\x02\x06\x03\x18\x02\b\x00\x1a\x02\a\x00\x19\x02\a\x01\x19\x02\b\x01\x1a\x02\a\x03\x19\x02\b\x00\x1a\x02\b\x01\x1a\x02\b\x03\x1a",
+		start: []int32{0, 3, 8, 16, 24, 32, 40, 48, 56, 64, 69, 72, 77, 84, 96, 108, 120, 132, 144, 156, 168, 175, 180, 188, 200, 220, 240, 260, 280, 300, 320, 340, 352, 360, 365, 372, 384, 396, 408, 420, 432, 444, 456, 463, 468, 471, 476, 484, 492, 500, 508, 516, 524, 532, 537, 540},
+	},
+	{rows: 5, cols: 11, cells: "0,0;1,0;2,0;3,0;3,1;", sym: false, n: 208,
+		versions: []generatedVersion{
+			{lo: Pos{0, 0}, n: Pos{2, 10}, masks: []uint64{0x600400801, 0xc00801002, 0x1801002004, 0x3002004008, 0x6004008010, 0xc008010020, 0x18010020040, 0x30020040080, 0x60040080100, 0xc0080100200, 0x300200400800, 0x600400801000, 0xc00801002000, 0x1801002004000, 0x3002004008000, 0x6004008010000, 0xc008010020000, 0x18010020040000, 0x30020040080000, 0x60040080100000}},
+			{lo: Pos{0, 0}, n: Pos{2, 10}, masks: []uint64{0x600801002, 0xc01002004, 0x1802004008, 0x3004008010, 0x6008010020, 0xc010020040, 0x18020040080, 0x30040080100, 0x60080100200, 0xc0100200400, 0x300400801000, 0x600801002000, 0xc01002004000, 0x1802004008000, 0x3004008010000, 0x6008010020000, 0xc010020040000, 0x18020040080000, 0x30040080100000, 0x60080100200000}},
+			{lo: Pos{0, 0}, n: Pos{4, 8}, masks: []uint64{0x80f, 0x101e, 0x203c, 0x4078, 0x80f0, 0x101e0, 0x203c0, 0x40780, 0x407800, 0x80f000, 0x101e000, 0x203c000, 0x4078000, 0x80f0000, 0x101e0000, 0x203c0000, 0x203c00000, 0x407800000, 0x80f000000, 0x101e000000, 0x203c000000, 0x4078000000, 0x80f0000000, 0x101e0000000, 0x101e00000000, 0x203c00000000, 0x407800000000, 0x80f000000000, 0x101e000000000, 0x203c000000000, 0x4078000000000, 0x80f0000000000}},
+			{lo: Pos{0, 0}, n: Pos{4, 8}, masks: []uint64{0x7801, 0xf002, 0x1e004, 0x3c008, 0x78010, 0xf0020, 0x1e0040, 0x3c0080, 0x3c00800, 0x7801000, 0xf002000, 0x1e004000, 0x3c008000, 0x78010000, 0xf0020000, 0x1e0040000, 0x1e00400000, 0x3c00800000, 0x7801000000, 0xf002000000, 0x1e004000000, 0x3c008000000, 0x78010000000, 0xf0020000000, 0xf00200000000, 0x1e00400000000, 0x3c00800000000, 0x7801000000000, 0xf002000000000, 0x1e004000000000, 0x3c008000000000, 0x78010000000000}},
+			{lo: Pos{0, 0}, n: Pos{2, 10}, masks: []uint64{0x400801003, 0x801002006, 0x100200400c, 0x2004008018, 0x4008010030, 0x8010020060, 0x100200400c0, 0x20040080180, 0x40080100300, 0x80100200600, 0x200400801800, 0x400801003000, 0x801002006000, 0x100200400c000, 0x2004008018000, 0x4008010030000, 0x8010020060000, 0x100200400c0000, 0x20040080180000, 0x40080100300000}},
+			{lo: Pos{0, 0}, n: Pos{4, 8}, masks: []uint64{0x7808, 0xf010, 0x1e020, 0x3c040, 0x78080, 0xf0100, 0x1e0200, 0x3c0400, 0x3c04000, 0x7808000, 0xf010000, 0x1e020000, 0x3c040000, 0x78080000, 0xf0100000, 0x1e0200000, 0x1e02000000, 0x3c04000000, 0x7808000000, 0xf010000000, 0x1e020000000, 0x3c040000000, 0x78080000000, 0xf0100000000, 0xf01000000000, 0x1e02000000000, 0x3c04000000000, 0x7808000000000, 0xf010000000000, 0x1e020000000000, 0x3c040000000000, 0x78080000000000}},
+			{lo: Pos{0, 0}, n: Pos{2, 10}, masks: []uint64{0x200400803, 0x400801006, 0x80100200c, 0x1002004018, 0x2004008030, 0x4008010060, 0x80100200c0, 0x10020040180, 0x20040080300, 0x40080100600, 0x100200401800, 0x200400803000, 0x400801006000, 0x80100200c000, 0x1002004018000, 0x2004008030000, 0x4008010060000, 0x80100200c0000, 0x10020040180000, 0x20040080300000}},
+			{lo: Pos{0, 0}, n: Pos{4, 8}, masks: []uint64{0x400f, 0x801e, 0x1003c, 0x20078, 0x400f0, 0x801e0, 0x1003c0, 0x200780, 0x2007800, 0x400f000, 0x801e000, 0x1003c000, 0x20078000, 0x400f0000, 0x801e0000, 0x1003c0000, 0x1003c00000, 0x2007800000, 0x400f000000, 0x801e000000, 0x1003c000000, 0x20078000000, 0x400f0000000, 0x801e0000000, 0x801e00000000, 0x1003c00000000, 0x2007800000000, 0x400f000000000, 0x801e000000000, 0x1003c000000000, 0x20078000000000, 0x400f0000000000}},
+		},
+		spots: "\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x02\x00\x00\x00\x03\x00\x00\x00\x04\x00\x00\x00\x05\x00\x00\x00\x06\x00\x00\x00\a\x00\x00\x01\x00\x01\x00\x01\x01\x01\x00\x01\x02\x01\x00\x01\x03\x01\x00\x01\x04\x01\x00\x01\x05\x01\x00\x01\x06\x01\x00\x01\a\x01\x00\x02\x00\x02\x00\x02\x01\x02\x00\x02\x02\x02\x00\x02\x03\x02\x00\x02\x04\x02\x00\x02\x05\x02\x00\x02\x06\x02\x00\x02\a\x02\x00\x03\x00\x03\x00\x03\x01\x03\x00\x03\x02\x03\x00\x03\x03\x03\x00\x03\x04\x03\x00\x03\x05\x03\x00\x03\x06\x03\x00\x03\a\x03\x00\x04\x00\x04\x00\x04\x01\x04\x00\x04\x02\x04\x00\x04\x03\x04\x00\x04\x04\x04\x00\x04\x05\x04\x00\x04\x06\x04\x00\x04\a\x04\x00\x05\x00\x05\x00\x05\x01\x05\x00\x05\x02\x05\x00\x05\x03\x05\x00\x05\x04\x05\x00\x05\x05\x05\x00\x05\x06\x05\x00\x05\a\x05\x00\x06\x00\x06\x00\x06\x01\x06\x00\x06\x02\x06\x00\x06\x03\x06\x00\x06\x04\x06\x00\x06\x05\x06\x00\x06\x06\x06\x00\x06\a\x06\x00\a\x00\a\x00\a\x01\a\x00\a\x02\a\x00\a\x03\a\x00\a\x04\a\x00\a\x05\a\x00\a\x06\a\x00\a\a\a\x00\b\x00\b\x00\b\x01\b\x00\b\x04\b\x00\b\x06\b\x00\t\x00\t\x00\t\x01\t\x00\t\x04\t\x00\t\x06\t\x01\x00\x00\n\x01\x00\x01\n\x01\x00\x02\b\x01\x00\x03\b\x01\x00\x04\n\x01\x00\x05\b\x01\x00\x06\n\x01\x00\a\b\x01\x01\x00\v\x01\x01\x01\v\x01\x01\x02\t\x01\x01\x03\t\x01\x01\x04\v\x01\x01\x05\t\x01\x01\x06\v\x01\x01\a\t\x01\x02\x00\f\x01\x02\x01\f\x01\x02\x02\n\x01\x02\x03\n\x01\x02\x04\f\x01\x02\x05\n\x01\x02\x06\f\x01\x02\a\n\x01\x03\x00\r\x01\x03\x01\r\x01\x03\x02\v\x01\x03\x03\v\x01\x03\x04\r\x01\x03\x05\v\x01\x03\x06\r\x01\x03\a\v\x01\x04\x00\x0e\x01\x04\x01\x0e\x01\x04\x02\f\x01\x04\x03\f\x01\x04\x04\x0e\x01\x04\x05\f\x01\x04\x06\x0e\x01\x04\a\f\x01\x05\x00\x0f\x01\x05\x01\x0f\x01\x05\x02\r\x01\x05\x03\r\x01\x05\x04\x0f\x01\x05\x05\r\x01\x05\x06\x0f\x01\x05\a\r\x01\x06\x00\x10\x01\x06\x01\x10\x01\x06\x02\x0e\x01\x06\x03\x0e\x01\x06\x04\x10\x01\x06\x05\x0e\x01\x06\x06\x10\x01\x06\a\x0e\x01\a\x00\x11\x01\a\x01\x11\x01\a\x02\x0f\x01\a\x03\x0f\x01\a\x04\x11\x01\a\x05\x0f\x01\a\x06\x11\x01\a\a\x0f\x01\b\x00\x12\x01\b\x01\x12\x01\b\x04\x12\x01\b\x06\x12\x01\t\x00\x13\x01\t\x01\x13\x01\t\x04\x13\x01\t\x06\x13\x02\x00\x02\x10\x02\x00\x03\x10\x02\x00\x05\x10\x02\x00\a\x10\x02\x01\x02\x11\x02\x01\x03\x11\x02\x01\x05\x11\x02\x01\a\x11\x02\x02\x02\x12\x02\x02\x03\x12\x02\x02\x05\x12\x02\x02\a\x12\x02\x03\x02\x13\x02\x03\x03\x13\x02\x03\x05\x13\x02\x03\a\x13\x02\x04\x02\x14\x02\x04\x03\x14\x02\x04\x05\x14\x02\x04\a\x14\x02\x05\x02\x15\x02\x05\x03\x15\x02\x05\x05\x15\x02\x05\a\x15\x02\x06\x02\x16\x02\x06\x03\x16\x02\x06\x05\x16\x02\x06\a\x16\x02\a\x02\x17\x02\a\x03\x17\x02\a\x05\x17\x02\a\a\x17\x03\x00\x02\x18\x03\x00\x03\x18\x03\x00\x05\x18\x03\x00\a\x18\x03\x01\x02\x19\x03\x01\x03\x19\x03\x01\x05\x19\x03\x01\a\x19\x03\x02\x02\x1a\x03\x02\x03\x1a\x03\x02\x05\x1a\x03\x02\a\x1a\x03\x03\x02\x1b\x03\x03\x03\x1b\x03\x03\x05\x1b\x03\x03\a\x1b\x03\x04\x02\x1c\x03\x04\x03\x1c\x03\x04\x05\x1c\x03\x04\a\x1c\x03\x05\x02\x1d\x03\x05\x03\x1d\x03\x05\x05\x1d\x03\x05\a\x1d\x03\x06\x02\x1e\x03\x06\x03\x1e\x03\x06\x05\x1e\x03\x06\a\x1e\x03\a\x02\x1f\x03\a\x03\x1f\x03\a\x05\x1f\x03\a\a\x1f",
+		cover: "\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x03\x00\x00\x00\x04\x00\x00\x00\x06\x00\x00\x00\a\x00\x00\x01\x00\x01\x00\x00\x01\x00\x00\x00\x02\x00\x00\x01\x02\x01\x00\x01\x03\x01\x00\x00\x04\x00\x00\x01\x04\x01\x00\x01\x06\x01\x00\x00\x06\x00\x00\x01\a\x01\x00\x00\a\x00\x00\x02\x00\x02\x00\x01\x01\x01\x00\x00\x02\x00\x00\x01\x02\x01\x00\x02\x02\x02\x00\x02\x03\x02\x00\x01\x04\x01\x00\x02\x04\x02\x00\x02\x06\x02\x00\x01\x06\x01\x00\x02\a\x02\x00\x01\a\x01\x00\x00\a\x00\x00\x03\x00\x03\x00\x02\x01\x02\x00\x00\x02\x00\x00\x01\x02\x01\x00\x02\x02\x02\x00\x03\x02\x03\x00\x03\x03\x03\x00\x02\x04\x02\x00\x03\x04\x03\x00\x00\x05\x00\x00\x03\x06\x03\x00\x02\x06\x02\x00\x03\a\x03\x00\x02\a\x02\x00\x01\a\x01\x00\x00\a\x00\x00\x04\x00\x04\x00\x03\x01\x03\x00\x01\x02\x01\x00\x02\x02\x02\x00\x03\x02\x03\x00\x04\x02\x04\x00\x04\x03\x04\x00\x03\x04\x03\x00\x04\x04\x04\x00\x01\x05\x01\x00\x04\x06\x04\x00\x03\x06\x03\x00\x04\a\x04\x00\x03\a\x03\x00\x02\a\x02\x00\x01\a\x01\x00\x05\x00\x05\x00\x04\x01\x04\x00\x02\x02\x02\x00\x03\x02\x03\x00\x04\x02\x04\x00\x05\x02\x05\x00\x05\x03\x05\x00\x04\x04\x04\x00\x05\x04\x05\x00\x02\x05\x02\x00\x05\x06\x05\x00\x04\x06\x04\x00\x05\a\x05\x00\x04\a\x04\x00\x03\a\x03\x00\x02\a\x02\x00\x06\x00\x06\x00\x05\x01\x05\x00\x03\x02\x03\x00\x04\x02\x04\x00\x05\x02\x05\x00\x06\x02\x06\x00\x06\x03\x06\x00\x05\x04\x05\x00\x06\x04\x06\x00\x03\x05\x03\x00\x06\x06\x06\x00\x05\x06\x05\x00\x06\a\x06\x00\x05\a\x05\x00\x04\a\x04\x00\x03\a\x03\x00\a\x00\a\x00\x06\x01\x06\x00\x04\x02\x04\x00\x05\x02\x05\x00\x06\x02\x06\x00\a\x02\a\x00\a\x03\a\x00\x06\x04\x06\x00\a\x04\a\x00\x04\x05\x04\x00\a\x06\a\x00\x06\x06\x06\x00\a\a\a\x00\x06\a\x06\x00\x05\a\x05\x00\x04\a\x04\x00\b\x00\b\x00\a\x01\a\x00\x05\x02\x05\x00\x06\x02\x06\x00\a\x02\a\x00\a\x04\a\x00\b\x04\b\x00\x05\x05\x05\x00\b\x06\b\x00\a\x06\a\x00\a\a\a\x00\x06\a\x06\x00\x05\a\x05\x00\t\x00\t\x00\b\x01\b\x00\x06\x02\x06\x00\a\x02\a\x00\b\x04\b\x00\t\x04\t\x00\x06\x05\x06\x00\t\x06\t\x00\b\x06\b\x00\a\a\a\x00\x06\a\x06\x00\t\x01\t\x00\a\x02\a\x00\t\x04\t\x00\a\x05\a\x00\t\x06\t\x00\a\a\a\x01\x00\x00\n\x00\x00\x00\x00\x01\x00\x02\b\x00\x00\x02\x00\x00\x00\x03\x00\x01\x00\x03\b\x01\x00\x04\n\x00\x00\x05\x00\x00\x00\x06\x00\x01\x00\x06\n\x01\x00\a\b\x01\x01\x00\v\x00\x01\x00\x01\x01\x00\x01\n\x00\x00\x01\x00\x01\x00\x02\b\x01\x01\x02\t\x00\x01\x02\x01\x00\x00\x03\x00\x00\x01\x03\x01\x01\x01\x03\t\x00\x00\x04\x00\x01\x00\x04\n\x01\x01\x04\v\x00\x01\x05\x01\x00\x00\x05\x00\x00\x01\x06\x01\x01\x01\x06\v\x01\x00\x06\n\x01\x01\a\t\x01\x00\a\b\x01\x02\x00\f\x00\x02\x00\x02\x01\x01\x01\v\x00\x01\x01\x01\x01\x00\x02\b\x01\x01\x02\t\x01\x02\x02\n\x00\x02\x02\x02\x00\x00\x03\x00\x00\x01\x03\x01\x00\x02\x03\x02\x01\x02\x03\n\x00\x01\x04\x01\x01\x01\x04\v\x01\x02\x04\f\x00\x02\x05\x02\x00\x01\x05\x01\x00\x00\x05\x00\x00\x02\x06\x02\x01\x02\x06\f\x01\x01\x06\v\x01\x02\a\n\x01\x01\a\t\x01\x00\a\b\x01\x03\x00\r\x00\x03\x00\x03\x01\x02\x01\f\x00\x02\x01\x02\x01\x00\x02\b\x01\x01\x02\t\x01\x02\x02\n\x01\x03\x02\v\x00\x03\x02\x03\x00\x00\x03\x00\x00\x01\x03\x01\x00\x02\x03\x02\x00\x03\x03\x03\x01\x03\x03\v\x00\x02\x04\x02\x01\x02\x04\f\x01\x03\x04\r\x00\x03\x05\x03\x00\x02\x05\x02\x00\x01\x05\x01\x00\x00\x05\x00\x01\x00\x05\b\x00\x03\x06\x03\x01\x03\x06\r\x01\x02\x06\f\x01\x03\a\v\x01\x02\a\n\x01\x01\a\t\x01\x00\a\b\x00\x00\a\x00\x01\x04\x00\x0e\x00\x04\x00\x04\x01\x03\x01\r\x00\x03\x01\x03\x01\x01\x02\t\x01\x02\x02\n\x01\x03\x02\v\x01\x04\x02\f\x00\x04\x02\x04\x00\x01\x03\x01\x00\x02\x03\x02\x00\x03\x03\x03\x00\x04\x03\x04\x01\x04\x03\f\x00\x03\x04\x03\x01\x03\x04\r\x01\x04\x04\x0e\x00\x04\x05\x04\x00\x03\x05\x03\x00\x02\x05\x02\x00\x01\x05\x01\x01\x01\x05\t\x00\x04\x06\x04\x01\x04\x06\x0e\x01\x03\x06\r\x01\x04\a\f\x01\x03\a\v\x01\x02\a\n\x01\x01\a\t\x00\x01\a\x01\x01\x05\x00\x0f\x00\x05\x00\x05\x01\x04\x01\x0e\x00\x04\x01\x04\x01\x02\x02\n\x01\x03\x02\v\x01\x04\x02\f\x01\x05\x02\r\x00\x05\x02\x05\x00\x02\x03\x02\x00\x03\x03\x03\x00\x04\x03\x04\x00\x05\x03\x05\x01\x05\x03\r\x00\x04\x04\x04\x01\x04\x04\x0e\x01\x05\x04\x0f\x00\x05\x05\x05\x00\x04\x05\x04\x00\x03\x05\x03\x00\x02\x05\x02\x01\x02\x05\n\x00\x05\x06\x05\x01\x05\x06\x0f\x01\x04\x06\x0e\x01\x05\a\r\x01\x04\a\f\x01\x03\a\v\x01\x02\a\n\x00\x02\a\x02\x01\x06\x00\x10\x00\x06\x00\x06\x01\x05\x01\x0f\x00\x05\x01\x05\x01\x03\x02\v\x01\x04\x02\f\x01\x05\x02\r\x01\x06\x02\x0e\x00\x06\x02\x06\x00\x03\x03\x03\x00\x04\x03\x04\x00\x05\x03\x05\x00\x06\x03\x06\x01\x06\x03\x0e\x00\x05\x04\x05\x01\x05\x04\x0f\x01\x06\x04\x10\x00\x06\x05\x06\x00\x05\x05\x05\x00\x04\x05\x04\x00\x03\x05\x03\x01\x03\x05\v\x00\x06\x06\x06\x01\x06\x06\x10\x01\x05\x06\x0f\x01\x06\a\x0e\x01\x05\a\r\x01\x04\a\f\x01\x03\a\v\x00\x03\a\x03\x01\a\x00\x11\x00\a\x00\a\x01\x06\x01\x10\x00\x06\x01\x06\x01\x04\x02\f\x01\x05\x02\r\x01\x06\x02\x0e\x01\a\x02\x0f\x00\a\x02\a\x00\x04\x03\x04\x00\x05\x03\x05\x00\x06\x03\x06\x00\a\x03\a\x01\a\x03\x0f\x00\x06\x04\x06\x01\x06\x04\x10\x01\a\x04\x11\x00\a\x05\a\x00\x06\x05\x06\x00\x05\x05\x05\x00\x04\x05\x04\x01\x04\x05\f\x00\a\x06\a\x01\a\x06\x11\x01\x06\x06\x10\x01\a\a\x0f\x01\x06\a\x0e\x01\x05\a\r\x01\x04\a\f\x00\x04\a\x04\x01\b\x00\x12\x00\b\x00\b\x01\a\x01\x11\x00\a\x01\a\x01\x05\x02\r\x01\x06\x02\x0e\x01\a\x02\x0f\x00\x05\x03\x05\x00\x06\x03\x06\x00\a\x03\a\x00\a\x04\a\x01\a\x04\x11\x01\b\x04\x12\x00\a\x05\a\x00\x06\x05\x06\x00\x05\x05\x05\x01\x05\x05\r\x00\b\x06\b\x01\b\x06\x12\x01\a\x06\x11\x01\a\a\x0f\x01\x06\a\x0e\x01\x05\a\r\x00\x05\a\x05\x01\t\x00\x13\x00\t\x00\t\x01\b\x01\x12\x00\b\x01\b\x01\x06\x02\x0e\x01\a\x02\x0f\x00\x06\x03\x06\x00\a\x03\a\x00\b\x04\b\x01\b\x04\x12\x01\t\x04\x13\x00\a\x05\a\x00\x06\x05\x06\x01\x06\x05\x0e\x00\t\x06\t\x01\t\x06\x13\x01\b\x06\x12\x01\a\a\x0f\x01\x06\a\x0e\x00\x06\a\x06\x01\t\x01\x13\x00\t\x01\t\x01\a\x02\x0f\x00\a\x03\a\x00\t\x04\t\x01\t\x04\x13\x00\a\x05\a\x01\a\x05\x0f\x01\t\x06\x13\x01\a\a\x0f\x00\a\a\a\x01\x00\x00\n\x00\x00\x00\x00\x02\x00\x02\x10\x01\x00\x02\b\x01\x00\x03\b\x02\x00\x03\x10\x01\x00\x05\b\x00\x00\x06\x00\x01\x00\x06\n\x02\x00\a\x10\x01\x01\x00\v\x00\x01\x00\x01\x01\x00\x01\n\x00\x00\x01\x00\x02\x00\x02\x10\x02\x01\x02\x11\x01\x01\x02\t\x01\x00\x03\b\x01\x01\x03\t\x02\x01\x03\x11\x00\x00\x04\x00\x01\x00\x04\n\x01\x01\x05\t\x01\x00\x05\b\x00\x01\x06\x01\x01\x01\x06\v\x02\x01\a\x11\x02\x00\a\x10\x01\x02\x00\f\x00\x02\x00\x02\x01\x01\x01\v\x00\x01\x01\x01\x02\x00\x02\x10\x02\x01\x02\x11\x02\x02\x02\x12\x01\x02\x02\n\x01\x00\x03\b\x01\x01\x03\t\x01\x02\x03\n\x02\x02\x03\x12\x00\x01\x04\x01\x01\x01\x04\v\x01\x02\x05\n\x01\x01\x05\t\x01\x00\x05\b\x00\x02\x06\x02\x01\x02\x06\f\x02\x02\a\x12\x02\x01\a\x11\x02\x00\a\x10\x01\x03\x00\r\x00\x03\x00\x03\x01\x02\x01\f\x00\x02\x01\x02\x02\x00\x02\x10\x02\x01\x02\x11\x02\x02\x02\x12\x02\x03\x02\x13\x01\x03\x02\v\x01\x00\x03\b\x01\x01\x03\t\x01\x02\x03\n\x01\x03\x03\v\x02\x03\x03\x13\x00\x02\x04\x02\x01\x02\x04\f\x01\x03\x05\v\x01\x02\x05\n\x01\x01\x05\t\x01\x00\x05\b\x02\x00\x05\x10\x00\x03\x06\x03\x01\x03\x06\r\x02\x03\a\x13\x02\x02\a\x12\x02\x01\a\x11\x02\x00\a\x10\x01\x00\a\b\x01\x04\x00\x0e\x00\x04\x00\x04\x01\x03\x01\r\x00\x03\x01\x03\x02\x01\x02\x11\x02\x02\x02\x12\x02\x03\x02\x13\x02\x04\x02\x14\x01\x04\x02\f\x01\x01\x03\t\x01\x02\x03\n\x01\x03\x03\v\x01\x04\x03\f\x02\x04\x03\x14\x00\x03\x04\x03\x01\x03\x04\r\x01\x04\x05\f\x01\x03\x05\v\x01\x02\x05\n\x01\x01\x05\t\x02\x01\x05\x11\x00\x04\x06\x04\x01\x04\x06\x0e\x02\x04\a\x14\x02\x03\a\x13\x02\x02\a\x12\x02\x01\a\x11\x01\x01\a\t\x01\x05\x00\x0f\x00\x05\x00\x05\x01\x04\x01\x0e\x00\x04\x01\x04\x02\x02\x02\x12\x02\x03\x02\x13\x02\x04\x02\x14\x02\x05\x02\x15\x01\x05\x02\r\x01\x02\x03\n\x01\x03\x03\v\x01\x04\x03\f\x01\x05\x03\r\x02\x05\x03\x15\x00\x04\x04\x04\x01\x04\x04\x0e\x01\x05\x05\r\x01\x04\x05\f\x01\x03\x05\v\x01\x02\x05\n\x02\x02\x05\x12\x00\x05\x06\x05\x01\x05\x06\x0f\x02\x05\a\x15\x02\x04\a\x14\x02\x03\a\x13\x02\x02\a\x12\x01\x02\a\n\x01\x06\x00\x10\x00\x06\x00\x06\x01\x05\x01\x0f\x00\x05\x01\x05\x02\x03\x02\x13\x02\x04\x02\x14\x02\x05\x02\x15\x02\x06\x02\x16\x01\x06\x02\x0e\x01\x03\x03\v\x01\x04\x03\f\x01\x05\x03\r\x01\x06\x03\x0e\x02\x06\x03\x16\x00\x05\x04\x05\x01\x05\x04\x0f\x01\x06\x05\x0e\x01\x05\x05\r\x01\x04\x05\f\x01\x03\x05\v\x02\x03\x05\x13\x00\x06\x06\x06\x01\x06\x06\x10\x02\x06\a\x16\x02\x05\a\x15\x02\x04\a\x14\x02\x03\a\x13\x01\x03\a\v\x01\a\x00\x11\x00\a\x00\a\x01\x06\x01\x10\x00\x06\x01\x06\x02\x04\x02\x14\x02\x05\x02\x15\x02\x06\x02\x16\x02\a\x02\x17\x01\a\x02\x0f\x01\x04\x03\f\x01\x05\x03\r\x01\x06\x03\x0e\x01\a\x03\x0f\x02\a\x03\x17\x00\x06\x04\x06\x01\x06\x04\x10\x01\a\x05\x0f\x01\x06\x05\x0e\x01\x05\x05\r\x01\x04\x05\f\x02\x04\x05\x14\x00\a\x06\a\x01\a\x06\x11\x02\a\a\x17\x02\x06\a\x16\x02\x05\a\x15\x02\x04\a\x14\x01\x04\a\f\x01\b\x00\x12\x00\b\x00\b\x01\a\x01\x11\x00\a\x01\a\x02\x05\x02\x15\x02\x06\x02\x16\x02\a\x02\x17\x01\x05\x03\r\x01\x06\x03\x0e\x01\a\x03\x0f\x00\a\x04\a\x01\a\x04\x11\x01\a\x05\x0f\x01\x06\x05\x0e\x01\x05\x05\r\x02\x05\x05\x15\x00\b\x06\b\x01\b\x06\x12\x02\a\a\x17\x02\x06\a\x16\x02\x05\a\x15\x01\x05\a\r\x01\t\x00\x13\x00\t\x00\t\x01\b\x01\x12\x00\b\x01\b\x02\x06\x02\x16\x02\a\x02\x17\x01\x06\x03\x0e\x01\a\x03\x0f\x00\b\x04\b\x01\b\x04\x12\x01\a\x05\x0f\x01\x06\x05\x0e\x02\x06\x05\x16\x00\t\x06\t\x01\t\x06\x13\x02\a\a\x17\x02\x06\a\x16\x01\x06\a\x0e\x01\t\x01\x13\x00\t\x01\t\x02\a\x02\x17\x01\a\x03\x0f\x00\t\x04\t\x01\t\x04\x13\x01\a\x05\x0f\x02\a\x05\x17\x02\a\a\x17\x01\a\a\x0f\x01\x00\x00\n\x00\x00\x00\x00\x00\x00\x01\x00\x03\x00\x02\x18\x02\x00\x02\x10\x02\x00\x03\x10\x03\x00\x03\x18\x02\x00\x05\x10\x00\x00\x06\x00\x01\x00\x06\n\x03\x00\a\x18\x01\x01\x00\v\x00\x01\x00\x01\x00\x00\x00\x00\x01\x00\x01\n\x00\x00\x01\x00\x00\x01\x01\x01\x03\x00\x02\x18\x03\x01\x02\x19\x02\x01\x02\x11\x02\x00\x03\x10\x02\x01\x03\x11\x03\x01\x03\x19\x00\x00\x04\x00\x01\x00\x04\n\x02\x01\x05\x11\x02\x00\x05\x10\x00\x01\x06\x01\x01\x01\x06\v\x03\x01\a\x19\x03\x00\a\x18\x01\x02\x00\f\x00\x02\x00\x02\x00\x01\x00\x01\x01\x01\x01\v\x00\x01\x01\x01\x00\x02\x01\x02\x03\x00\x02\x18\x03\x01\x02\x19\x03\x02\x02\x1a\x02\x02\x02\x12\x02\x00\x03\x10\x02\x01\x03\x11\x02\x02\x03\x12\x03\x02\x03\x1a\x00\x01\x04\x01\x01\x01\x04\v\x02\x02\x05\x12\x02\x01\x05\x11\x02\x00\x05\x10\x00\x02\x06\x02\x01\x02\x06\f\x03\x02\a\x1a\x03\x01\a\x19\x03\x00\a\x18\x01\x03\x00\r\x00\x03\x00\x03\x00\x02\x00\x02\x01\x02\x01\f\x00\x02\x01\x02\x00\x03\x01\x03\x03\x00\x02\x18\x03\x01\x02\x19\x03\x02\x02\x1a\x03\x03\x02\x1b\x02\x03\x02\x13\x02\x00\x03\x10\x02\x01\x03\x11\x02\x02\x03\x12\x02\x03\x03\x13\x03\x03\x03\x1b\x00\x02\x04\x02\x01\x02\x04\f\x02\x03\x05\x13\x02\x02\x05\x12\x02\x01\x05\x11\x02\x00\x05\x10\x03\x00\x05\x18\x00\x03\x06\x03\x01\x03\x06\r\x03\x03\a\x1b\x03\x02\a\x1a\x03\x01\a\x19\x03\x00\a\x18\x02\x00\a\x10\x01\x04\x00\x0e\x00\x04\x00\x04\x00\x03\x00\x03\x01\x03\x01\r\x00\x03\x01\x03\x00\x04\x01\x04\x03\x01\x02\x19\x03\x02\x02\x1a\x03\x03\x02\x1b\x03\x04\x02\x1c\x02\x04\x02\x14\x02\x01\x03\x11\x02\x02\x03\x12\x02\x03\x03\x13\x02\x04\x03\x14\x03\x04\x03\x1c\x00\x03\x04\x03\x01\x03\x04\r\x02\x04\x05\x14\x02\x03\x05\x13\x02\x02\x05\x12\x02\x01\x05\x11\x03\x01\x05\x19\x00\x04\x06\x04\x01\x04\x06\x0e\x03\x04\a\x1c\x03\x03\a\x1b\x03\x02\a\x1a\x03\x01\a\x19\x02\x01\a\x11\x01\x05\x00\x0f\x00\x05\x00\x05\x00\x04\x00\x04\x01\x04\x01\x0e\x00\x04\x01\x04\x00\x05\x01\x05\x03\x02\x02\x1a\x03\x03\x02\x1b\x03\x04\x02\x1c\x03\x05\x02\x1d\x02\x05\x02\x15\x02\x02\x03\x12\x02\x03\x03\x13\x02\x04\x03\x14\x02\x05\x03\x15\x03\x05\x03\x1d\x00\x04\x04\x04\x01\x04\x04\x0e\x02\x05\x05\x15\x02\x04\x05\x14\x02\x03\x05\x13\x02\x02\x05\x12\x03\x02\x05\x1a\x00\x05\x06\x05\x01\x05\x06\x0f\x03\x05\a\x1d\x03\x04\a\x1c\x03\x03\a\x1b\x03\x02\a\x1a\x02\x02\a\x12\x01\x06\x00\x10\x00\x06\x00\x06\x00\x05\x00\x05\x01\x05\x01\x0f\x00\x05\x01\x05\x00\x06\x01\x06\x03\x03\x02\x1b\x03\x04\x02\x1c\x03\x05\x02\x1d\x03\x06\x02\x1e\x02\x06\x02\x16\x02\x03\x03\x13\x02\x04\x03\x14\x02\x05\x03\x15\x02\x06\x03\x16\x03\x06\x03\x1e\x00\x05\x04\x05\x01\x05\x04\x0f\x02\x06\x05\x16\x02\x05\x05\x15\x02\x04\x05\x14\x02\x03\x05\x13\x03\x03\x05\x1b\x00\x06\x06\x06\x01\x06\x06\x10\x03\x06\a\x1e\x03\x05\a\x1d\x03\x04\a\x1c\x03\x03\a\x1b\x02\x03\a\x13\x01\a\x00\x11\x00\a\x00\a\x00\x06\x00\x06\x01\x06\x01\x10\x00\x06\x01\x06\x00\a\x01\a\x03\x04\x02\x1c\x03\x05\x02\x1d\x03\x06\x02\x1e\x03\a\x02\x1f\x02\a\x02\x17\x02\x04\x03\x14\x02\x05\x03\x15\x02\x06\x03\x16\x02\a\x03\x17\x03\a\x03\x1f\x00\x06\x04\x06\x01\x06\x04\x10\x02\a\x05\x17\x02\x06\x05\x16\x02\x05\x05\x15\x02\x04\x05\x14\x03\x04\x05\x1c\x00\a\x06\a\x01\a\x06\x11\x03\a\a\x1f\x03\x06\a\x1e\x03\x05\a\x1d\x03\x04\a\x1c\x02\x04\a\x14\x01\b\x00\x12\x00\b\x00\b\x00\a\x00\a\x01\a\x01\x11\x00\a\x01\a\x00\b\x01\b\x03\x05\x02\x1d\x03\x06\x02\x1e\x03\a\x02\x1f\x02\x05\x03\x15\x02\x06\x03\x16\x02\a\x03\x17\x00\a\x04\a\x01\a\x04\x11\x02\a\x05\x17\x02\x06\x05\x16\x02\x05\x05\x15\x03\x05\x05\x1d\x00\b\x06\b\x01\b\x06\x12\x03\a\a\x1f\x03\x06\a\x1e\x03\x05\a\x1d\x02\x05\a\x15\x01\t\x00\x13\x00\t\x00\t\x00\b\x00\b\x01\b\x01\x12\x00\b\x01\b\x00\t\x01\t\x03\x06\x02\x1e\x03\a\x02\x1f\x02\x06\x03\x16\x02\a\x03\x17\x00\b\x04\b\x01\b\x04\x12\x02\a\x05\x17\x02\x06\x05\x16\x03\x06\x05\x1e\x00\t\x06\t\x01\t\x06\x13\x03\a\a\x1f\x03\x06\a\x1e\x02\x06\a\x16\x00\t\x00\t\x01\t\x01\x13\x00\t\x01\t\x03\a\x02\x1f\x02\a\x03\x17\x00\t\x04\t\x01\t\x04\x13\x02\a\x05\x17\x03\a\x05\x1f\x03\a\a\x1f\x02\a\a\x17\x01\x00\x00\n\x01\x00\x01\n\x03\x00\x02\x18\x03\x00\x03\x18\x03\x00\x05\x18\x01\x00\x06\n\x01\x01\x00\v\x01\x00\x00\n\x01\x00\x01\n\x01\x01\x01\v\x03\x01\x02\x19\x03\x00\x03\x18\x03\x01\x03\x19\x01\x00\x04\n\x03\x01\x05\x19\x03\x00\x05\x18\x01\x01\x06\v\x01\x02\x00\f\x01\x01\x00\v\x01\x01\x01\v\x01\x02\x01\f\x03\x02\x02\x1a\x03\x00\x03\x18\x03\x01\x03\x19\x03\x02\x03\x1a\x01\x01\x04\v\x03\x02\x05\x1a\x03\x01\x05\x19\x03\x00\x05\x18\x01\x02\x06\f\x01\x03\x00\r\x01\x02\x00\f\x01\x02\x01\f\x01\x03\x01\r\x03\x03\x02\x1b\x03\x00\x03\x18\x03\x01\x03\x19\x03\x02\x03\x1a\x03\x03\x03\x1b\x01\x02\x04\f\x03\x03\x05\x1b\x03\x02\x05\x1a\x03\x01\x05\x19\x03\x00\x05\x18\x01\x03\x06\r\x03\x00\a\x18\x01\x04\x00\x0e\x01\x03\x00\r\x01\x03\x01\r\x01\x04\x01\x0e\x03\x04\x02\x1c\x03\x01\x03\x19\x03\x02\x03\x1a\x03\x03\x03\x1b\x03\x04\x03\x1c\x01\x03\x04\r\x03\x04\x05\x1c\x03\x03\x05\x1b\x03\x02\x05\x1a\x03\x01\x05\x19\x01\x04\x06\x0e\x03\x01\a\x19\x01\x05\x00\x0f\x01\x04\x00\x0e\x01\x04\x01\x0e\x01\x05\x01\x0f\x03\x05\x02\x1d\x03\x02\x03\x1a\x03\x03\x03\x1b\x03\x04\x03\x1c\x03\x05\x03\x1d\x01\x04\x04\x0e\x03\x05\x05\x1d\x03\x04\x05\x1c\x03\x03\x05\x1b\x03\x02\x05\x1a\x01\x05\x06\x0f\x03\x02\a\x1a\x01\x06\x00\x10\x01\x05\x00\x0f\x01\x05\x01\x0f\x01\x06\x01\x10\x03\x06\x02\x1e\x03\x03\x03\x1b\x03\x04\x03\x1c\x03\x05\x03\x1d\x03\x06\x03\x1e\x01\x05\x04\x0f\x03\x06\x05\x1e\x03\x05\x05\x1d\x03\x04\x05\x1c\x03\x03\x05\x1b\x01\x06\x06\x10\x03\x03\a\x1b\x01\a\x00\x11\x01\x06\x00\x10\x01\x06\x01\x10\x01\a\x01\x11\x03\a\x02\x1f\x03\x04\x03\x1c\x03\x05\x03\x1d\x03\x06\x03\x1e\x03\a\x03\x1f\x01\x06\x04\x10\x03\a\x05\x1f\x03\x06\x05\x1e\x03\x05\x05\x1d\x03\x04\x05\x1c\x01\a\x06\x11\x03\x04\a\x1c\x01\b\x00\x12\x01\a\x00\x11\x01\a\x01\x11\x01\b\x01\x12\x03\x05\x03\x1d\x03\x06\x03\x1e\x03\a\x03\x1f\x01\a\x04\x11\x03\a\x05\x1f\x03\x06\x05\x1e\x03\x05\x05\x1d\x01\b\x06\x12\x03\x05\a\x1d\x01\t\x00\x13\x01\b\x00\x12\x01\b\x01\x12\x01\t\x01\x13\x03\x06\x03\x1e\x03\a\x03\x1f\x01\b\x04\x12\x03\a\x05\x1f\x03\x06\x05\x1e\x01\t\x06\x13\x03\x06\a\x1e\x01\t\x00\x13\x01\t\x01\x13\x03\a\x03\x1f\x01\t\x04\x13\x03\a\x05\x1f\x03\a\a\x1f",
+		start: []int32{0, 6, 17, 30, 46, 62, 78, 94, 110, 123, 134, 140, 151, 171, 195, 225, 255, 285, 315, 345, 369, 389, 400, 410, 428, 450, 478, 506, 534, 562, 590, 612, 630, 640, 651, 671, 695, 725, 755, 785, 815, 845, 869, 889, 900, 906, 917, 930, 946, 962, 978, 994, 1010, 1023, 1034, 1040},
+	},
+	{rows: 5, cols: 11, cells: "0,1;0,2;1,0;1,1;2,1;", sym: false, n: 216,
+		versions: []generatedVersion{
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0x801806, 0x100300c, 0x2006018, 0x400c030, 0x8018060, 0x100300c0, 0x20060180, 0x400c0300, 0x80180600, 0x400c03000, 0x801806000, 0x100300c000, 0x2006018000, 0x400c030000, 0x8018060000, 0x100300c0000, 0x20060180000, 0x400c0300000, 0x200601800000, 0x400c03000000, 0x801806000000, 0x100300c000000, 0x2006018000000, 0x400c030000000, 0x8018060000000, 0x100300c0000000, 0x20060180000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0x803003, 0x1006006, 0x200c00c, 0x4018018, 0x8030030, 0x10060060, 0x200c00c0, 0x40180180, 0x80300300, 0x401801800, 0x803003000, 0x1006006000, 0x200c00c000, 0x4018018000, 0x8030030000, 0x10060060000, 0x200c00c0000, 0x40180180000, 0x200c00c00000, 0x401801800000, 0x803003000000, 0x1006006000000, 0x200c00c000000, 0x4018018000000, 0x8030030000000, 0x10060060000000, 0x200c00c0000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0x1003802, 0x2007004, 0x400e008, 0x801c010, 0x10038020, 0x20070040, 0x400e0080, 0x801c0100, 0x100380200, 0x801c01000, 0x1003802000, 0x2007004000, 0x400e008000, 0x801c010000, 0x10038020000, 0x20070040000, 0x400e0080000, 0x801c0100000, 0x400e00800000, 0x801c01000000, 0x1003802000000, 0x2007004000000, 0x400e008000000, 0x801c010000000, 0x10038020000000, 0x20070040000000, 0x400e0080000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0x803804, 0x1007008, 0x200e010, 0x401c020, 0x8038040, 0x10070080, 0x200e0100, 0x401c0200, 0x80380400, 0x401c02000, 0x803804000, 0x1007008000, 0x200e010000, 0x401c020000, 0x8038040000, 0x10070080000, 0x200e0100000, 0x401c0200000, 0x200e01000000, 0x401c02000000, 0x803804000000, 0x1007008000000, 0x200e010000000, 0x401c020000000, 0x8038040000000, 0x10070080000000, 0x200e0100000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0xc03002, 0x1806004, 0x300c008, 0x6018010, 0xc030020, 0x18060040, 0x300c0080, 0x60180100, 0xc0300200, 0x601801000, 0xc03002000, 0x1806004000, 0x300c008000, 0x6018010000, 0xc030020000, 0x18060040000, 0x300c0080000, 0x60180100000, 0x300c00800000, 0x601801000000, 0xc03002000000, 0x1806004000000, 0x300c008000000, 0x6018010000000, 0xc030020000000, 0x18060040000000, 0x300c0080000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0x803801, 0x1007002, 0x200e004, 0x401c008, 0x8038010, 0x10070020, 0x200e0040, 0x401c0080, 0x80380100, 0x401c00800, 0x803801000, 0x1007002000, 0x200e004000, 0x401c008000, 0x8038010000, 0x10070020000, 0x200e0040000, 0x401c0080000, 0x200e00400000, 0x401c00800000, 0x803801000000, 0x1007002000000, 0x200e004000000, 0x401c008000000, 0x8038010000000, 0x10070020000000, 0x200e0040000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0x1801802, 0x3003004, 0x6006008, 0xc00c010, 0x18018020, 0x30030040, 0x60060080, 0xc00c0100, 0x180180200, 0xc00c01000, 0x1801802000, 0x3003004000, 0x6006008000, 0xc00c010000, 0x18018020000, 0x30030040000, 0x60060080000, 0xc00c0100000, 0x600600800000, 0xc00c01000000, 0x1801802000000, 0x3003004000000, 0x6006008000000, 0xc00c010000000, 0x18018020000000, 0x30030040000000, 0x60060080000000}},
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0x403802, 0x807004, 0x100e008, 0x201c010, 0x4038020, 0x8070040, 0x100e0080, 0x201c0100, 0x40380200, 0x201c01000, 0x403802000, 0x807004000, 0x100e008000, 0x201c010000, 0x4038020000, 0x8070040000, 0x100e0080000, 0x201c0100000, 0x100e00800000, 0x201c01000000, 0x403802000000, 0x807004000000, 0x100e008000000, 0x201c010000000, 0x4038020000000, 0x8070040000000, 0x100e0080000000}},
+		},
+		spots: "\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x02\x00\x00\x00\x03\x00\x00\x00\x04\x00\x00\x00\x05\x00\x00\x00\x06\x00\x00\x00\a\x00\x00\x01\x00\x01\x00\x01\x01\x01\x00\x01\x02\x01\x00\x01\x03\x01\x00\x01\x04\x01\x00\x01\x05\x01\x00\x01\x06\x01\x00\x01\a\x01\x00\x02\x00\x02\x00\x02\x01\x02\x00\x02\x02\x02\x00\x02\x03\x02\x00\x02\x04\x02\x00\x02\x05\x02\x00\x02\x06\x02\x00\x02\a\x02\x00\x03\x00\x03\x00\x03\x01\x03\x00\x03\x02\x03\x00\x03\x03\x03\x00\x03\x04\x03\x00\x03\x05\x03\x00\x03\x06\x03\x00\x03\a\x03\x00\x04\x00\x04\x00\x04\x01\x04\x00\x04\x02\x04\x00\x04\x03\x04\x00\x04\x04\x04\x00\x04\x05\x04\x00\x04\x06\x04\x00\x04\a\x04\x00\x05\x00\x05\x00\x05\x01\x05\x00\x05\x02\x05\x00\x05\x03\x05\x00\x05\x04\x05\x00\x05\x05\x05\x00\x05\x06\x05\x00\x05\a\x05\x00\x06\x00\x06\x00\x06\x01\x06\x00\x06\x02\x06\x00\x06\x03\x06\x00\x06\x04\x06\x00\x06\x05\x06\x00\x06\x06\x06\x00\x06\a\x06\x00\a\x00\a\x00\a\x01\a\x00\a\x02\a\x00\a\x03\a\x00\a\x04\a\x00\a\x05\a\x00\a\x06\a\x00\a\a\a\x00\b\x00\b\x00\b\x01\b\x00\b\x02\b\x00\b\x03\b\x00\b\x04\b\x00\b\x05\b\x00\b\x06\b\x00\b\a\b\x01\x00\x00\t\x01\x00\x01\t\x01\x00\x02\t\x01\x00\x03\t\x01\x00\x04\t\x01\x00\x05\t\x01\x00\x06\t\x01\x00\a\t\x01\x01\x00\n\x01\x01\x01\n\x01\x01\x02\n\x01\x01\x03\n\x01\x01\x04\n\x01\x01\x05\n\x01\x01\x06\n\x01\x01\a\n\x01\x02\x00\v\x01\x02\x01\v\x01\x02\x02\v\x01\x02\x03\v\x01\x02\x04\v\x01\x02\x05\v\x01\x02\x06\v\x01\x02\a\v\x01\x03\x00\f\x01\x03\x01\f\x01\x03\x02\f\x01\x03\x03\f\x01\x03\x04\f\x01\x03\x05\f\x01\x03\x06\f\x01\x03\a\f\x01\x04\x00\r\x01\x04\x01\r\x01\x04\x02\r\x01\x04\x03\r\x01\x04\x04\r\x01\x04\x05\r\x01\x04\x06\r\x01\x04\a\r\x01\x05\x00\x0e\x01\x05\x01\x0e\x01\x05\x02\x0e\x01\x05\x03\x0e\x01\x05\x04\x0e\x01\x05\x05\x0e\x01\x05\x06\x0e\x01\x05\a\x0e\x01\x06\x00\x0f\x01\x06\x01\x0f\x01\x06\x02\x0f\x01\x06\x03\x0f\x01\x06\x04\x0f\x01\x06\x05\x0f\x01\x06\x06\x0f\x01\x06\a\x0f\x01\a\x00\x10\x01\a\x01\x10\x01\a\x02\x10\x01\a\x03\x10\x01\a\x04\x10\x01\a\x05\x10\x01\a\x06\x10\x01\a\a\x10\x01\b\x00\x11\x01\b\x01\x11\x01\b\x02\x11\x01\b\x03\x11\x01\b\x04\x11\x01\b\x05\x11\x01\b\x06\x11\x01\b\a\x11\x02\x00\x00\x12\x02\x00\x01\x12\x02\x00\x02\x12\x02\x00\x03\x12\x02\x00\x04\x12\x02\x00\x05\x12\x02\x00\x06\x12\x02\x00\a\x12\x02\x01\x00\x13\x02\x01\x01\x13\x02\x01\x02\x13\x02\x01\x03\x13\x02\x01\x04\x13\x02\x01\x05\x13\x02\x01\x06\x13\x02\x01\a\x13\x02\x02\x00\x14\x02\x02\x01\x14\x02\x02\x02\x14\x02\x02\x03\x14\x02\x02\x04\x14\x02\x02\x05\x14\x02\x02\x06\x14\x02\x02\a\x14\x02\x03\x00\x15\x02\x03\x01\x15\x02\x03\x02\x15\x02\x03\x03\x15\x02\x03\x04\x15\x02\x03\x05\x15\x02\x03\x06\x15\x02\x03\a\x15\x02\x04\x00\x16\x02\x04\x01\x16\x02\x04\x02\x16\x02\x04\x03\x16\x02\x04\x04\x16\x02\x04\x05\x16\x02\x04\x06\x16\x02\x04\a\x16\x02\x05\x00\x17\x02\x05\x01\x17\x02\x05\x02\x17\x02\x05\x03\x17\x02\x05\x04\x17\x02\x05\x05\x17\x02\x05\x06\x17\x02\x05\a\x17\x02\x06\x00\x18\x02\x06\x01\x18\x02\x06\x02\x18\x02\x06\x03\x18\x02\x06\x04\x18\x02\x06\x05\x18\x02\x06\x06\x18\x02\x06\a\x18\x02\a\x00\x19\x02\a\x01\x19\x02\a\x02\x19\x02\a\x03\x19\x02\a\x04\x19\x02\a\x05\x19\x02\a\x06\x19\x02\a\a\x19\x02\b\x00\x1a\x02\b\x01\x1a\x02\b\x02\x1a\x02\b\x03\x1a\x02\b\x04\x1a\x02\b\x05\x1a\x02\b\x06\x1a\x02\b\a\x1a",
+		cover: "\x00\x00\x01\x00\x00\x00\x05\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x01\x01\x01\x00\x00\x02\x00\x00\x00\x04\x00\x00\x01\x05\x01\x00\x00\x06\x00\x00\x00\a\x00\x00\x01\x00\x01\x00\x00\x00\x00\x00\x01\x01\x01\x00\x02\x01\x02\x00\x01\x02\x01\x00\x00\x03\x00\x00\x01\x04\x01\x00\x02\x05\x02\x00\x01\x06\x01\x00\x01\a\x01\x00\x02\x00\x02\x00\x01\x00\x01\x00\x02\x01\x02\x00\x03\x01\x03\x00\x02\x02\x02\x00\x01\x03\x01\x00\x02\x04\x02\x00\x03\x05\x03\x00\x02\x06\x02\x00\x02\a\x02\x00\x03\x00\x03\x00\x02\x00\x02\x00\x03\x01\x03\x00\x04\x01\x04\x00\x03\x02\x03\x00\x02\x03\x02\x00\x03\x04\x03\x00\x04\x05\x04\x00\x03\x06\x03\x00\x03\a\x03\x00\x04\x00\x04\x00\x03\x00\x03\x00\x04\x01\x04\x00\x05\x01\x05\x00\x04\x02\x04\x00\x03\x03\x03\x00\x04\x04\x04\x00\x05\x05\x05\x00\x04\x06\x04\x00\x04\a\x04\x00\x05\x00\x05\x00\x04\x00\x04\x00\x05\x01\x05\x00\x06\x01\x06\x00\x05\x02\x05\x00\x04\x03\x04\x00\x05\x04\x05\x00\x06\x05\x06\x00\x05\x06\x05\x00\x05\a\x05\x00\x06\x00\x06\x00\x05\x00\x05\x00\x06\x01\x06\x00\a\x01\a\x00\x06\x02\x06\x00\x05\x03\x05\x00\x06\x04\x06\x00\a\x05\a\x00\x06\x06\x06\x00\x06\a\x06\x00\a\x00\a\x00\x06\x00\x06\x00\a\x01\a\x00\b\x01\b\x00\a\x02\a\x00\x06\x03\x06\x00\a\x04\a\x00\b\x05\b\x00\a\x06\a\x00\a\a\a\x00\b\x00\b\x00\a\x00\a\x00\b\x01\b\x00\b\x02\b\x00\a\x03\a\x00\b\x04\b\x00\b\x06\b\x00\b\a\b\x00\b\x00\b\x00\b\x03\b\x00\x00\x00\x00\x01\x00\x01\t\x00\x00\x02\x00\x00\x00\x03\x00\x00\x00\x05\x00\x01\x00\x05\t\x00\x00\x06\x00\x00\x00\a\x00\x01\x00\x00\t\x00\x01\x00\x01\x00\x00\x00\x00\x01\x00\x01\t\x01\x01\x01\n\x00\x00\x01\x00\x01\x00\x02\t\x00\x00\x02\x00\x00\x01\x02\x01\x00\x00\x03\x00\x00\x01\x03\x01\x00\x00\x04\x00\x01\x00\x04\t\x00\x01\x05\x01\x01\x01\x05\n\x00\x00\x05\x00\x00\x01\x06\x01\x00\x00\x06\x00\x01\x00\x06\t\x00\x01\a\x01\x01\x00\a\t\x00\x00\a\x00\x01\x01\x00\n\x01\x00\x00\t\x00\x02\x00\x02\x00\x01\x00\x01\x01\x01\x01\n\x01\x02\x01\v\x00\x00\x01\x00\x00\x01\x01\x01\x00\x00\x02\x00\x01\x01\x02\n\x00\x01\x02\x01\x00\x02\x02\x02\x00\x00\x03\x00\x01\x00\x03\t\x00\x01\x03\x01\x00\x02\x03\x02\x00\x00\x04\x00\x00\x01\x04\x01\x01\x01\x04\n\x00\x02\x05\x02\x01\x02\x05\v\x00\x01\x05\x01\x00\x00\x05\x00\x00\x02\x06\x02\x00\x01\x06\x01\x01\x01\x06\n\x00\x02\a\x02\x01\x01\a\n\x00\x01\a\x01\x00\x00\a\x00\x01\x02\x00\v\x01\x01\x00\n\x00\x03\x00\x03\x00\x02\x00\x02\x01\x02\x01\v\x01\x03\x01\f\x00\x01\x01\x01\x00\x02\x01\x02\x00\x01\x02\x01\x01\x02\x02\v\x00\x02\x02\x02\x00\x03\x02\x03\x00\x01\x03\x01\x01\x01\x03\n\x00\x02\x03\x02\x00\x03\x03\x03\x00\x01\x04\x01\x00\x02\x04\x02\x01\x02\x04\v\x00\x03\x05\x03\x01\x03\x05\f\x00\x02\x05\x02\x00\x01\x05\x01\x00\x03\x06\x03\x00\x02\x06\x02\x01\x02\x06\v\x00\x03\a\x03\x01\x02\a\v\x00\x02\a\x02\x00\x01\a\x01\x01\x03\x00\f\x01\x02\x00\v\x00\x04\x00\x04\x00\x03\x00\x03\x01\x03\x01\f\x01\x04\x01\r\x00\x02\x01\x02\x00\x03\x01\x03\x00\x02\x02\x02\x01\x03\x02\f\x00\x03\x02\x03\x00\x04\x02\x04\x00\x02\x03\x02\x01\x02\x03\v\x00\x03\x03\x03\x00\x04\x03\x04\x00\x02\x04\x02\x00\x03\x04\x03\x01\x03\x04\f\x00\x04\x05\x04\x01\x04\x05\r\x00\x03\x05\x03\x00\x02\x05\x02\x00\x04\x06\x04\x00\x03\x06\x03\x01\x03\x06\f\x00\x04\a\x04\x01\x03\a\f\x00\x03\a\x03\x00\x02\a\x02\x01\x04\x00\r\x01\x03\x00\f\x00\x05\x00\x05\x00\x04\x00\x04\x01\x04\x01\r\x01\x05\x01\x0e\x00\x03\x01\x03\x00\x04\x01\x04\x00\x03\x02\x03\x01\x04\x02\r\x00\x04\x02\x04\x00\x05\x02\x05\x00\x03\x03\x03\x01\x03\x03\f\x00\x04\x03\x04\x00\x05\x03\x05\x00\x03\x04\x03\x00\x04\x04\x04\x01\x04\x04\r\x00\x05\x05\x05\x01\x05\x05\x0e\x00\x04\x05\x04\x00\x03\x05\x03\x00\x05\x06\x05\x00\x04\x06\x04\x01\x04\x06\r\x00\x05\a\x05\x01\x04\a\r\x00\x04\a\x04\x00\x03\a\x03\x01\x05\x00\x0e\x01\x04\x00\r\x00\x06\x00\x06\x00\x05\x00\x05\x01\x05\x01\x0e\x01\x06\x01\x0f\x00\x04\x01\x04\x00\x05\x01\x05\x00\x04\x02\x04\x01\x05\x02\x0e\x00\x05\x02\x05\x00\x06\x02\x06\x00\x04\x03\x04\x01\x04\x03\r\x00\x05\x03\x05\x00\x06\x03\x06\x00\x04\x04\x04\x00\x05\x04\x05\x01\x05\x04\x0e\x00\x06\x05\x06\x01\x06\x05\x0f\x00\x05\x05\x05\x00\x04\x05\x04\x00\x06\x06\x06\x00\x05\x06\x05\x01\x05\x06\x0e\x00\x06\a\x06\x01\x05\a\x0e\x00\x05\a\x05\x00\x04\a\x04\x01\x06\x00\x0f\x01\x05\x00\x0e\x00\a\x00\a\x00\x06\x00\x06\x01\x06\x01\x0f\x01\a\x01\x10\x00\x05\x01\x05\x00\x06\x01\x06\x00\x05\x02\x05\x01\x06\x02\x0f\x00\x06\x02\x06\x00\a\x02\a\x00\x05\x03\x05\x01\x05\x03\x0e\x00\x06\x03\x06\x00\a\x03\a\x00\x05\x04\x05\x00\x06\x04\x06\x01\x06\x04\x0f\x00\a\x05\a\x01\a\x05\x10\x00\x06\x05\x06\x00\x05\x05\x05\x00\a\x06\a\x00\x06\x06\x06\x01\x06\x06\x0f\x00\a\a\a\x01\x06\a\x0f\x00\x06\a\x06\x00\x05\a\x05\x01\a\x00\x10\x01\x06\x00\x0f\x00\b\x00\b\x00\a\x00\a\x01\a\x01\x10\x01\b\x01\x11\x00\x06\x01\x06\x00\a\x01\a\x00\x06\x02\x06\x01\a\x02\x10\x00\a\x02\a\x00\b\x02\b\x00\x06\x03\x06\x01\x06\x03\x0f\x00\a\x03\a\x00\b\x03\b\x00\x06\x04\x06\x00\a\x04\a\x01\a\x04\x10\x00\b\x05\b\x01\b\x05\x11\x00\a\x05\a\x00\x06\x05\x06\x00\b\x06\b\x00\a\x06\a\x01\a\x06\x10\x00\b\a\b\x01\a\a\x10\x00\a\a\a\x00\x06\a\x06\x01\b\x00\x11\x01\a\x00\x10\x00\b\x00\b\x01\b\x01\x11\x00\a\x01\a\x00\b\x01\b\x00\a\x02\a\x01\b\x02\x11\x00\b\x02\b\x00\a\x03\a\x01\a\x03\x10\x00\b\x03\b\x00\a\x04\a\x00\b\x04\b\x01\b\x04\x11\x00\b\x05\b\x00\a\x05\a\x00\b\x06\b\x01\b\x06\x11\x01\b\a\x11\x00\b\a\b\x00\a\a\a\x01\b\x00\x11\x00\b\x01\b\x00\b\x02\b\x00\b\x03\b\x01\b\x03\x11\x00\b\x04\b\x00\b\x05\b\x00\b\a\b\x01\x00\x00\t\x02\x00\x01\x12\x01\x00\x02\t\x01\x00\x03\t\x00\x00\x04\x00\x01\x00\x05\t\x02\x00\x05\x12\x01\x00\x06\t\x01\x00\a\t\x00\x00\a\x00\x02\x00\x00\x12\x01\x01\x00\n\x01\x00\x00\t\x00\x00\x00\x00\x02\x00\x01\x12\x02\x01\x01\x13\x01\x00\x01\t\x00\x00\x01\x00\x02\x00\x02\x12\x01\x00\x02\t\x01\x01\x02\n\x00\x00\x03\x00\x01\x00\x03\t\x01\x01\x03\n\x00\x00\x04\x00\x00\x01\x04\x01\x01\x00\x04\t\x02\x00\x04\x12\x01\x01\x05\n\x02\x01\x05\x13\x00\x00\x05\x00\x01\x00\x05\t\x00\x00\x06\x00\x01\x01\x06\n\x01\x00\x06\t\x02\x00\x06\x12\x01\x01\a\n\x00\x01\a\x01\x02\x00\a\x12\x01\x00\a\t\x02\x01\x00\x13\x02\x00\x00\x12\x01\x02\x00\v\x01\x01\x00\n\x00\x01\x00\x01\x02\x01\x01\x13\x02\x02\x01\x14\x01\x00\x01\t\x01\x01\x01\n\x00\x01\x01\x01\x01\x00\x02\t\x00\x00\x02\x00\x02\x01\x02\x13\x01\x01\x02\n\x01\x02\x02\v\x01\x00\x03\t\x02\x00\x03\x12\x00\x01\x03\x01\x01\x01\x03\n\x01\x02\x03\v\x00\x01\x04\x01\x00\x02\x04\x02\x01\x00\x04\t\x01\x01\x04\n\x02\x01\x04\x13\x01\x02\x05\v\x02\x02\x05\x14\x00\x01\x05\x01\x01\x01\x05\n\x01\x00\x05\t\x00\x01\x06\x01\x00\x00\x06\x00\x01\x02\x06\v\x01\x01\x06\n\x02\x01\x06\x13\x01\x02\a\v\x00\x02\a\x02\x02\x01\a\x13\x01\x01\a\n\x01\x00\a\t\x02\x02\x00\x14\x02\x01\x00\x13\x01\x03\x00\f\x01\x02\x00\v\x00\x02\x00\x02\x02\x02\x01\x14\x02\x03\x01\x15\x01\x01\x01\n\x01\x02\x01\v\x00\x02\x01\x02\x01\x01\x02\n\x00\x01\x02\x01\x02\x02\x02\x14\x01\x02\x02\v\x01\x03\x02\f\x01\x01\x03\n\x02\x01\x03\x13\x00\x02\x03\x02\x01\x02\x03\v\x01\x03\x03\f\x00\x02\x04\x02\x00\x03\x04\x03\x01\x01\x04\n\x01\x02\x04\v\x02\x02\x04\x14\x01\x03\x05\f\x02\x03\x05\x15\x00\x02\x05\x02\x01\x02\x05\v\x01\x01\x05\n\x00\x02\x06\x02\x00\x01\x06\x01\x01\x03\x06\f\x01\x02\x06\v\x02\x02\x06\x14\x01\x03\a\f\x00\x03\a\x03\x02\x02\a\x14\x01\x02\a\v\x01\x01\a\n\x02\x03\x00\x15\x02\x02\x00\x14\x01\x04\x00\r\x01\x03\x00\f\x00\x03\x00\x03\x02\x03\x01\x15\x02\x04\x01\x16\x01\x02\x01\v\x01\x03\x01\f\x00\x03\x01\x03\x01\x02\x02\v\x00\x02\x02\x02\x02\x03\x02\x15\x01\x03\x02\f\x01\x04\x02\r\x01\x02\x03\v\x02\x02\x03\x14\x00\x03\x03\x03\x01\x03\x03\f\x01\x04\x03\r\x00\x03\x04\x03\x00\x04\x04\x04\x01\x02\x04\v\x01\x03\x04\f\x02\x03\x04\x15\x01\x04\x05\r\x02\x04\x05\x16\x00\x03\x05\x03\x01\x03\x05\f\x01\x02\x05\v\x00\x03\x06\x03\x00\x02\x06\x02\x01\x04\x06\r\x01\x03\x06\f\x02\x03\x06\x15\x01\x04\a\r\x00\x04\a\x04\x02\x03\a\x15\x01\x03\a\f\x01\x02\a\v\x02\x04\x00\x16\x02\x03\x00\x15\x01\x05\x00\x0e\x01\x04\x00\r\x00\x04\x00\x04\x02\x04\x01\x16\x02\x05\x01\x17\x01\x03\x01\f\x01\x04\x01\r\x00\x04\x01\x04\x01\x03\x02\f\x00\x03\x02\x03\x02\x04\x02\x16\x01\x04\x02\r\x01\x05\x02\x0e\x01\x03\x03\f\x02\x03\x03\x15\x00\x04\x03\x04\x01\x04\x03\r\x01\x05\x03\x0e\x00\x04\x04\x04\x00\x05\x04\x05\x01\x03\x04\f\x01\x04\x04\r\x02\x04\x04\x16\x01\x05\x05\x0e\x02\x05\x05\x17\x00\x04\x05\x04\x01\x04\x05\r\x01\x03\x05\f\x00\x04\x06\x04\x00\x03\x06\x03\x01\x05\x06\x0e\x01\x04\x06\r\x02\x04\x06\x16\x01\x05\a\x0e\x00\x05\a\x05\x02\x04\a\x16\x01\x04\a\r\x01\x03\a\f\x02\x05\x00\x17\x02\x04\x00\x16\x01\x06\x00\x0f\x01\x05\x00\x0e\x00\x05\x00\x05\x02\x05\x01\x17\x02\x06\x01\x18\x01\x04\x01\r\x01\x05\x01\x0e\x00\x05\x01\x05\x01\x04\x02\r\x00\x04\x02\x04\x02\x05\x02\x17\x01\x05\x02\x0e\x01\x06\x02\x0f\x01\x04\x03\r\x02\x04\x03\x16\x00\x05\x03\x05\x01\x05\x03\x0e\x01\x06\x03\x0f\x00\x05\x04\x05\x00\x06\x04\x06\x01\x04\x04\r\x01\x05\x04\x0e\x02\x05\x04\x17\x01\x06\x05\x0f\x02\x06\x05\x18\x00\x05\x05\x05\x01\x05\x05\x0e\x01\x04\x05\r\x00\x05\x06\x05\x00\x04\x06\x04\x01\x06\x06\x0f\x01\x05\x06\x0e\x02\x05\x06\x17\x01\x06\a\x0f\x00\x06\a\x06\x02\x05\a\x17\x01\x05\a\x0e\x01\x04\a\r\x02\x06\x00\x18\x02\x05\x00\x17\x01\a\x00\x10\x01\x06\x00\x0f\x00\x06\x00\x06\x02\x06\x01\x18\x02\a\x01\x19\x01\x05\x01\x0e\x01\x06\x01\x0f\x00\x06\x01\x06\x01\x05\x02\x0e\x00\x05\x02\x05\x02\x06\x02\x18\x01\x06\x02\x0f\x01\a\x02\x10\x01\x05\x03\x0e\x02\x05\x03\x17\x00\x06\x03\x06\x01\x06\x03\x0f\x01\a\x03\x10\x00\x06\x04\x06\x00\a\x04\a\x01\x05\x04\x0e\x01\x06\x04\x0f\x02\x06\x04\x18\x01\a\x05\x10\x02\a\x05\x19\x00\x06\x05\x06\x01\x06\x05\x0f\x01\x05\x05\x0e\x00\x06\x06\x06\x00\x05\x06\x05\x01\a\x06\x10\x01\x06\x06\x0f\x02\x06\x06\x18\x01\a\a\x10\x00\a\a\a\x02\x06\a\x18\x01\x06\a\x0f\x01\x05\a\x0e\x02\a\x00\x19\x02\x06\x00\x18\x01\b\x00\x11\x01\a\x00\x10\x00\a\x00\a\x02\a\x01\x19\x02\b\x01\x1a\x01\x06\x01\x0f\x01\a\x01\x10\x00\a\x01\a\x01\x06\x02\x0f\x00\x06\x02\x06\x02\a\x02\x19\x01\a\x02\x10\x01\b\x02\x11\x01\x06\x03\x0f\x02\x06\x03\x18\x00\a\x03\a\x01\a\x03\x10\x01\b\x03\x11\x00\a\x04\a\x00\b\x04\b\x01\x06\x04\x0f\x01\a\x04\x10\x02\a\x04\x19\x01\b\x05\x11\x02\b\x05\x1a\x00\a\x05\a\x01\a\x05\x10\x01\x06\x05\x0f\x00\a\x06\a\x00\x06\x06\x06\x01\b\x06\x11\x01\a\x06\x10\x02\a\x06\x19\x01\b\a\x11\x00\b\a\b\x02\a\a\x19\x01\a\a\x10\x01\x06\a\x0f\x02\b\x00\x1a\x02\a\x00\x19\x01\b\x00\x11\x00\b\x00\b\x02\b\x01\x1a\x01\a\x01\x10\x01\b\x01\x11\x00\b\x01\b\x01\a\x02\x10\x00\a\x02\a\x02\b\x02\x1a\x01\b\x02\x11\x01\a\x03\x10\x02\a\x03\x19\x00\b\x03\b\x01\b\x03\x11\x00\b\x04\b\x01\a\x04\x10\x01\b\x04\x11\x02\b\x04\x1a\x00\b\x05\b\x01\b\x05\x11\x01\a\x05\x10\x00\b\x06\b\x00\a\x06\a\x01\b\x06\x11\x02\b\x06\x1a\x02\b\a\x1a\x01\b\a\x11\x01\a\a\x10\x02\b\x00\x1a\x01\b\x01\x11\x01\b\x02\x11\x00\b\x02\b\x01\b\x03\x11\x02\b\x03\x1a\x01\b\x04\x11\x01\b\x05\x11\x00\b\x06\b\x01\b\a\x11\x02\x00\x00\x12\x02\x00\x02\x12\x02\x00\x03\x12\x01\x00\x04\t\x02\x00\x05\x12\x02\x00\x06\x12\x02\x00\a\x12\x01\x00\a\t\x02\x01\x00\x13\x02\x00\x00\x12\x01\x00\x00\t\x02\x00\x01\x12\x01\x00\x01\t\x02\x00\x02\x12\x02\x01\x02\x13\x01\x00\x03\t\x02\x00\x03\x12\x02\x01\x03\x13\x01\x00\x04\t\x01\x01\x04\n\x02\x00\x04\x12\x02\x01\x05\x13\x01\x00\x05\t\x02\x00\x05\x12\x01\x00\x06\t\x02\x01\x06\x13\x02\x00\x06\x12\x02\x01\a\x13\x01\x01\a\n\x02\x00\a\x12\x02\x02\x00\x14\x02\x01\x00\x13\x01\x01\x00\n\x02\x00\x01\x12\x02\x01\x01\x13\x01\x01\x01\n\x02\x00\x02\x12\x01\x00\x02\t\x02\x01\x02\x13\x02\x02\x02\x14\x02\x00\x03\x12\x01\x01\x03\n\x02\x01\x03\x13\x02\x02\x03\x14\x01\x01\x04\n\x01\x02\x04\v\x02\x00\x04\x12\x02\x01\x04\x13\x02\x02\x05\x14\x01\x01\x05\n\x02\x01\x05\x13\x02\x00\x05\x12\x01\x01\x06\n\x01\x00\x06\t\x02\x02\x06\x14\x02\x01\x06\x13\x02\x02\a\x14\x01\x02\a\v\x02\x01\a\x13\x02\x00\a\x12\x02\x03\x00\x15\x02\x02\x00\x14\x01\x02\x00\v\x02\x01\x01\x13\x02\x02\x01\x14\x01\x02\x01\v\x02\x01\x02\x13\x01\x01\x02\n\x02\x02\x02\x14\x02\x03\x02\x15\x02\x01\x03\x13\x01\x02\x03\v\x02\x02\x03\x14\x02\x03\x03\x15\x01\x02\x04\v\x01\x03\x04\f\x02\x01\x04\x13\x02\x02\x04\x14\x02\x03\x05\x15\x01\x02\x05\v\x02\x02\x05\x14\x02\x01\x05\x13\x01\x02\x06\v\x01\x01\x06\n\x02\x03\x06\x15\x02\x02\x06\x14\x02\x03\a\x15\x01\x03\a\f\x02\x02\a\x14\x02\x01\a\x13\x02\x04\x00\x16\x02\x03\x00\x15\x01\x03\x00\f\x02\x02\x01\x14\x02\x03\x01\x15\x01\x03\x01\f\x02\x02\x02\x14\x01\x02\x02\v\x02\x03\x02\x15\x02\x04\x02\x16\x02\x02\x03\x14\x01\x03\x03\f\x02\x03\x03\x15\x02\x04\x03\x16\x01\x03\x04\f\x01\x04\x04\r\x02\x02\x04\x14\x02\x03\x04\x15\x02\x04\x05\x16\x01\x03\x05\f\x02\x03\x05\x15\x02\x02\x05\x14\x01\x03\x06\f\x01\x02\x06\v\x02\x04\x06\x16\x02\x03\x06\x15\x02\x04\a\x16\x01\x04\a\r\x02\x03\a\x15\x02\x02\a\x14\x02\x05\x00\x17\x02\x04\x00\x16\x01\x04\x00\r\x02\x03\x01\x15\x02\x04\x01\x16\x01\x04\x01\r\x02\x03\x02\x15\x01\x03\x02\f\x02\x04\x02\x16\x02\x05\x02\x17\x02\x03\x03\x15\x01\x04\x03\r\x02\x04\x03\x16\x02\x05\x03\x17\x01\x04\x04\r\x01\x05\x04\x0e\x02\x03\x04\x15\x02\x04\x04\x16\x02\x05\x05\x17\x01\x04\x05\r\x02\x04\x05\x16\x02\x03\x05\x15\x01\x04\x06\r\x01\x03\x06\f\x02\x05\x06\x17\x02\x04\x06\x16\x02\x05\a\x17\x01\x05\a\x0e\x02\x04\a\x16\x02\x03\a\x15\x02\x06\x00\x18\x02\x05\x00\x17\x01\x05\x00\x0e\x02\x04\x01\x16\x02\x05\x01\x17\x01\x05\x01\x0e\x02\x04\x02\x16\x01\x04\x02\r\x02\x05\x02\x17\x02\x06\x02\x18\x02\x04\x03\x16\x01\x05\x03\x0e\x02\x05\x03\x17\x02\x06\x03\x18\x01\x05\x04\x0e\x01\x06\x04\x0f\x02\x04\x04\x16\x02\x05\x04\x17\x02\x06\x05\x18\x01\x05\x05\x0e\x02\x05\x05\x17\x02\x04\x05\x16\x01\x05\x06\x0e\x01\x04\x06\r\x02\x06\x06\x18\x02\x05\x06\x17\x02\x06\a\x18\x01\x06\a\x0f\x02\x05\a\x17\x02\x04\a\x16\x02\a\x00\x19\x02\x06\x00\x18\x01\x06\x00\x0f\x02\x05\x01\x17\x02\x06\x01\x18\x01\x06\x01\x0f\x02\x05\x02\x17\x01\x05\x02\x0e\x02\x06\x02\x18\x02\a\x02\x19\x02\x05\x03\x17\x01\x06\x03\x0f\x02\x06\x03\x18\x02\a\x03\x19\x01\x06\x04\x0f\x01\a\x04\x10\x02\x05\x04\x17\x02\x06\x04\x18\x02\a\x05\x19\x01\x06\x05\x0f\x02\x06\x05\x18\x02\x05\x05\x17\x01\x06\x06\x0f\x01\x05\x06\x0e\x02\a\x06\x19\x02\x06\x06\x18\x02\a\a\x19\x01\a\a\x10\x02\x06\a\x18\x02\x05\a\x17\x02\b\x00\x1a\x02\a\x00\x19\x01\a\x00\x10\x02\x06\x01\x18\x02\a\x01\x19\x01\a\x01\x10\x02\x06\x02\x18\x01\x06\x02\x0f\x02\a\x02\x19\x02\b\x02\x1a\x02\x06\x03\x18\x01\a\x03\x10\x02\a\x03\x19\x02\b\x03\x1a\x01\a\x04\x10\x01\b\x04\x11\x02\x06\x04\x18\x02\a\x04\x19\x02\b\x05\x1a\x01\a\x05\x10\x02\a\x05\x19\x02\x06\x05\x18\x01\a\x06\x10\x01\x06\x06\x0f\x02\b\x06\x1a\x02\a\x06\x19\x02\b\a\x1a\x01\b\a\x11\x02\a\a\x19\x02\x06\a\x18\x02\b\x00\x1a\x01\b\x00\x11\x02\a\x01\x19\x02\b\x01\x1a\x01\b\x01\x11\x02\a\x02\x19\x01\a\x02\x10\x02\b\x02\x1a\x02\a\x03\x19\x01\b\x03\x11\x02\b\x03\x1a\x01\b\x04\x11\x02\a\x04\x19\x02\b\x04\x1a\x01\b\x05\x11\x02\b\x05\x1a\x02\a\x05\x19\x01\b\x06\x11\x01\a\x06\x10\x02\b\x06\x1a\x02\b\a\x1a\x02\a\a\x19\x02\b\x01\x1a\x02\b\x02\x1a\x01\b\x02\x11\x02\b\x03\x1a\x02\b\x04\x1a\x02\b\x05\x1a\x01\b\x06\x11\x02\b\a\x1a\x02\x00\x04\x12\x02\x00\a\x12\x02\x00\x00\x12\x02\x00\x01\x12\x02\x00\x03\x12\x02\x00\x04\x12\x02\x01\x04\x13\x02\x00\x05\x12\x02\x00\x06\x12\x02\x01\a\x13\x02\x01\x00\x13\x02\x01\x01\x13\x02\x00\x02\x12\x02\x01\x03\x13\x02\x01\x04\x13\x02\x02\x04\x14\x02\x01\x05\x13\x02\x01\x06\x13\x02\x00\x06\x12\x02\x02\a\x14\x02\x02\x00\x14\x02\x02\x01\x14\x02\x01\x02\x13\x02\x02\x03\x14\x02\x02\x04\x14\x02\x03\x04\x15\x02\x02\x05\x14\x02\x02\x06\x14\x02\x01\x06\x13\x02\x03\a\x15\x02\x03\x00\x15\x02\x03\x01\x15\x02\x02\x02\x14\x02\x03\x03\x15\x02\x03\x04\x15\x02\x04\x04\x16\x02\x03\x05\x15\x02\x03\x06\x15\x02\x02\x06\x14\x02\x04\a\x16\x02\x04\x00\x16\x02\x04\x01\x16\x02\x03\x02\x15\x02\x04\x03\x16\x02\x04\x04\x16\x02\x05\x04\x17\x02\x04\x05\x16\x02\x04\x06\x16\x02\x03\x06\x15\x02\x05\a\x17\x02\x05\x00\x17\x02\x05\x01\x17\x02\x04\x02\x16\x02\x05\x03\x17\x02\x05\x04\x17\x02\x06\x04\x18\x02\x05\x05\x17\x02\x05\x06\x17\x02\x04\x06\x16\x02\x06\a\x18\x02\x06\x00\x18\x02\x06\x01\x18\x02\x05\x02\x17\x02\x06\x03\x18\x02\x06\x04\x18\x02\a\x04\x19\x02\x06\x05\x18\x02\x06\x06\x18\x02\x05\x06\x17\x02\a\a\x19\x02\a\x00\x19\x02\a\x01\x19\x02\x06\x02\x18\x02\a\x03\x19\x02\a\x04\x19\x02\b\x04\x1a\x02\a\x05\x19\x02\a\x06\x19\x02\x06\x06\x18\x02\b\a\x1a\x02\b\x00\x1a\x02\b\x01\x1a\x02\a\x02\x19\x02\b\x03\x1a\x02\b\x04\x1a\x02\b\x05\x1a\x02\b\x06\x1a\x02\a\x06\x19\x02\b\x02\x1a\x02\b\x06\x1a",
+		start: []int32{0, 2, 10, 20, 30, 40, 50, 60, 70, 80, 88, 90, 98, 120, 150, 180, 210, 240, 270, 300, 330, 352, 360, 370, 400, 440, 480, 520, 560, 600, 640, 680, 710, 720, 728, 750, 780, 810, 840, 870, 900, 930, 960, 982, 990, 992, 1000, 1010, 1020, 1030, 1040, 1050, 1060, 1070, 1078, 1080},
+	},
+	{rows: 5, cols: 11, cells: "0,1;1,0;1,1;1,2;2,1;", sym: true, n: 27,
+		versions: []generatedVersion{
+			{lo: Pos{0, 0}, n: Pos{3, 9}, masks: []uint64{0x803802, 0x1007004, 0x200e008, 0x401c010, 0x8038020, 0x10070040, 0x200e0080, 0x401c0100, 0x80380200, 0x401c01000, 0x803802000, 0x1007004000, 0x200e008000, 0x401c010000, 0x8038020000, 0x10070040000, 0x200e0080000, 0x401c0100000, 0x200e00800000, 0x401c01000000, 0x803802000000, 0x1007004000000, 0x200e008000000, 0x401c010000000, 0x8038020000000, 0x10070040000000, 0x200e0080000000}},
+		},
+		spots: "\x00\x00\x00\x00\x00\x01\x00\x01\x00\x02\x00\x02\x00\x03\x00\x03\x00\x04\x00\x04\x00\x05\x00\x05\x00\x06\x00\x06\x00\a\x00\a\x00\b\x00\b\x01\x00\x00\t\x01\x01\x00\n\x01\x02\x00\v\x01\x03\x00\f\x01\x04\x00\r\x01\x05\x00\x0e\x01\x06\x00\x0f\x01\a\x00\x10\x01\b\x00\x11\x02\x00\x00\x12\x02\x01\x00\x13\x02\x02\x00\x14\x02\x03\x00\x15\x02\x04\x00\x16\x02\x05\x00\x17\x02\x06\x00\x18\x02\a\x00\x19\x02\b\x00\x1a",
+		cover: "\x00\x00\x00\x00\x00\x01\x00\x01\x00\x02\x00\x02\x00\x03\x00\x03\x00\x04\x00\x04\x00\x05\x00\x05\x00\x06\x00\x06\x00\a\x00\a\x00\b\x00\b\x00\x00\x00\x00\x01\x00\x00\t\x00\x01\x00\x01\x00\x00\x00\x00\x01\x01\x00\n\x00\x02\x00\x02\x00\x01\x00\x01\x00\x00\x00\x00\x01\x02\x00\v\x00\x03\x00\x03\x00\x02\x00\x02\x00\x01\x00\x01\x01\x03\x00\f\x00\x04\x00\x04\x00\x03\x00\x03\x00\x02\x00\x02\x01\x04\x00\r\x00\x05\x00\x05\x00\x04\x00\x04\x00\x03\x00\x03\x01\x05\x00\x0e\x00\x06\x00\x06\x00\x05\x00\x05\x00\x04\x00\x04\x01\x06\x00\x0f\x00\a\x00\a\x00\x06\x00\x06\x00\x05\x00\x05\x01\a\x00\x10\x00\b\x00\b\x00\a\x00\a\x00\x06\x00\x06\x01\b\x00\x11\x00\b\x00\b\x00\a\x00\a\x00\b\x00\b\x01\x00\x00\t\x02\x00\x00\x12\x01\x01\x00\n\x01\x00\x00\t\x00\x00\x00\x00\x02\x01\x00\x13\x01\x02\x00\v\x01\x01\x00\n\x01\x00\x00\t\x00\x01\x00\x01\x02\x02\x00\x14\x01\x03\x00\f\x01\x02\x00\v\x01\x01\x00\n\x00\x02\x00\x02\x02\x03\x00\x15\x01\x04\x00\r\x01\x03\x00\f\x01\x02\x00\v\x00\x03\x00\x03\x02\x04\x00\x16\x01\x05\x00\x0e\x01\x04\x00\r\x01\x03\x00\f\x00\x04\x00\x04\x02\x05\x00\x17\x01\x06\x00\x0f\x01\x05\x00\x0e\x01\x04\x00\r\x00\x05\x00\x05\x02\x06\x00\x18\x01\a\x00\x10\x01\x06\x00\x0f\x01\x05\x00\x0e\x00\x06\x00\x06\x02\a\x00\x19\x01\b\x00\x11\x01\a\x00\x10\x01\x06\x00\x0f\x00\a\x00\a\x02\b\x00\x1a\x01\b\x00\x11\x01\a\x00\x10\x00\b\x00\b\x01\b\x00\x11\x02\x00\x00\x12\x02\x01\x00\x13\x02\x00\x00\x12\x01\x00\x00\t\x02\x02\x00\x14\x02\x01\x00\x13\x02\x00\x00\x12\x01\x01\x00\n\x02\x03\x00\x15\x02\x02\x00\x14\x02\x01\x00\x13\x01\x02\x00\v\x02\x04\x00\x16\x02\x03\x00\x15\x02\x02\x00\x14\x01\x03\x00\f\x02\x05\x00\x17\x02\x04\x00\x16\x02\x03\x00\x15\x01\x04\x00\r\x02\x06\x00\x18\x02\x05\x00\x17\x02\x04\x00\x16\x01\x05\x00\x0e\x02\a\x00\x19\x02\x06\x00\x18\x02\x05\x00\x17\x01\x06\x00\x0f\x02\b\x00\x1a\x02\a\x00\x19\x02\x06\x00\x18\x01\a\x00\x10\x02\b\x00\x1a\x02\a\x00\x19\x01\b\x00\x11\x02\b\x00\x1a\x02\x00\x00\x12\x02\x01\x00\x13\x02\x02\x00\x14\x02\x03\x00\x15\x02\x04\x00\x16\x02\x05\x00\x17\x02\x06\x00\x18\x02\a\x00\x19\x02\b\x00\x1a",
+		start: []int32{0, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 9, 10, 13, 17, 21, 25, 29, 33, 37, 41, 44, 45, 46, 50, 55, 60, 65, 70, 75, 80, 85, 89, 90, 91, 94, 98, 102, 106, 110, 114, 118, 122, 125, 126, 126, 127, 128, 129, 130, 131, 132, 133, 134, 135, 135},
+	},
+}
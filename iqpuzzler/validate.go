@@ -0,0 +1,130 @@
+package iqpuzzler
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ValidatePieces checks that every piece is a non-empty, 4-connected set of
+// distinct cells that fits on a board with the given dimensions, and that no
+// two pieces have the same name, letter, or shape under the transformations
+// in tx.
+func ValidatePieces(ps []Piece, dimX, dimY int) error {
+	var (
+		problems []string
+		names    = make(map[string]bool)
+		letters  = make(map[byte]string)
+		shapes   = make(map[string]string)
+	)
+	for _, p := range ps {
+		if names[p.name] {
+			problems = append(problems, fmt.Sprintf("duplicate piece name %q", p.name))
+		}
+		names[p.name] = true
+		if other, ok := letters[p.letter]; ok {
+			problems = append(problems, fmt.Sprintf("pieces %q and %q have the same letter %c", other, p.name, p.letter))
+		}
+		letters[p.letter] = p.name
+		if err := validateShape(p, dimX, dimY); err != nil {
+			problems = append(problems, err.Error())
+			continue
+		}
+		var key = shapeKey(p.CanonicalForm())
+		if other, ok := shapes[key]; ok {
+			problems = append(problems, fmt.Sprintf("pieces %q and %q have the same shape", other, p.name))
+			continue
+		}
+		shapes[key] = p.name
+	}
+	if len(problems) > 0 {
+		return errors.New(strings.Join(problems, "\n"))
+	}
+	return nil
+}
+
+func validateShape(p Piece, dimX, dimY int) error {
+	if len(p.pos) == 0 {
+		return fmt.Errorf("piece %q is empty", p.name)
+	}
+	var cells = make(map[Pos]bool)
+	for _, pos := range p.pos {
+		if cells[pos] {
+			return fmt.Errorf("piece %q contains cell %v twice", p.name, pos)
+		}
+		cells[pos] = true
+	}
+	var min, max = BoundingBox(p.pos)
+	var h, w = max[0] - min[0] + 1, max[1] - min[1] + 1
+	if !(h <= dimX && w <= dimY) && !(w <= dimX && h <= dimY) {
+		return fmt.Errorf("piece %q (%dx%d) does not fit on the %dx%d board", p.name, h, w, dimX, dimY)
+	}
+	// Flood fill from the first cell; every cell must be reached.
+	var (
+		seen  = map[Pos]bool{p.pos[0]: true}
+		stack = []Pos{p.pos[0]}
+	)
+	for len(stack) > 0 {
+		var c = stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, d := range []Pos{{-1, 0}, {1, 0}, {0, -1}, {0, 1}} {
+			var n = c.Add(d)
+			if cells[n] && !seen[n] {
+				seen[n] = true
+				stack = append(stack, n)
+			}
+		}
+	}
+	if len(seen) != len(cells) {
+		return fmt.Errorf("piece %q is not connected", p.name)
+	}
+	return nil
+}
+
+// Validate checks the invariants of the game: every move covers cells on
+// the board which are neither pre-occupied nor covered by another move, the
+// occupied cells are exactly the pre-occupied ones and those covered by the
+// moves, and the count agrees with them. It describes the first violation
+// found.
+func (g *Game) Validate() error {
+	var (
+		b     = g.board
+		cover = make([][]int, b.rows)
+		count int
+	)
+	for x := range cover {
+		cover[x] = make([]int, b.cols)
+	}
+	for i := range g.numMoves() {
+		var m = g.moveAt(i)
+		if b.wrap && m.wrap != (Pos{b.rows, b.cols}) {
+			return fmt.Errorf("move %d (%v) was not made on a %dx%d toroidal board", i+1, m, b.rows, b.cols)
+		}
+		for _, p := range m.Image() {
+			switch {
+			case !b.inBounds(p):
+				return fmt.Errorf("move %d (%v) covers %v outside the board", i+1, m, p)
+			case b.marks[p[0]][p[1]] != 0:
+				return fmt.Errorf("move %d (%v) covers the pre-occupied cell %v", i+1, m, p)
+			case cover[p[0]][p[1]] != 0:
+				return fmt.Errorf("moves %d and %d both cover %v", cover[p[0]][p[1]], i+1, p)
+			}
+			cover[p[0]][p[1]] = i + 1
+		}
+	}
+	for x := 0; x < b.rows; x++ {
+		for y := 0; y < b.cols; y++ {
+			var want = b.marks[x][y] != 0 || cover[x][y] != 0
+			if got := g.occupied(Pos{x, y}); got != want {
+				return fmt.Errorf("cell %v is marked occupied=%t, want %t", Pos{x, y}, got, want)
+			}
+			if want {
+				count++
+			}
+		}
+	}
+	if g.count != count {
+		return fmt.Errorf("game counts %d occupied cells, want %d", g.count, count)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package iqpuzzler
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateBuiltinPieces(t *testing.T) {
+	for _, name := range PieceSetNames() {
+		var set, _ = LookupPieceSet(name)
+		for _, preset := range PresetNames() {
+			if p, _ := LookupPreset(preset); p.Set == name {
+				if err := ValidatePieces(set.Pieces, p.Rows, p.Cols); err != nil {
+					t.Errorf("piece set %s on %s: %v", name, preset, err)
+				}
+			}
+		}
+	}
+}
+
+func TestValidatePieces(t *testing.T) {
+	var tests = []struct {
+		name   string
+		pieces []Piece
+		// want is a part of the error.
+		want string
+	}{
+		{"empty", []Piece{NewPiece("a", 'A', nil)}, `piece "a" is empty`},
+		{"twice", []Piece{NewPiece("a", 'A', []Pos{{0, 0}, {0, 0}})}, `piece "a" contains cell [0 0] twice`},
+		{"disconnected", []Piece{NewPiece("a", 'A', []Pos{{0, 0}, {1, 1}})}, `piece "a" is not connected`},
+		{"diagonal only", []Piece{NewPiece("a", 'A', []Pos{{0, 0}, {0, 1}, {1, 2}})}, `piece "a" is not connected`},
+		{"too large", []Piece{NewPiece("a", 'A', []Pos{{0, 0}, {0, 1}, {0, 2}, {0, 3}, {0, 4}, {0, 5}})}, `piece "a" (1x6) does not fit on the 4x5 board`},
+		{"same name", []Piece{NewPiece("a", 'A', []Pos{{0, 0}}), NewPiece("a", 'B', []Pos{{0, 0}, {0, 1}})}, `duplicate piece name "a"`},
+		{"same letter", []Piece{NewPiece("a", 'A', []Pos{{0, 0}}), NewPiece("b", 'A', []Pos{{0, 0}, {0, 1}})}, `pieces "a" and "b" have the same letter A`},
+		{"same shape", []Piece{
+			NewPiece("a", 'A', []Pos{{0, 0}, {0, 1}, {1, 1}}),
+			NewPiece("b", 'B', []Pos{{5, 5}, {6, 5}, {6, 4}}),
+		}, `pieces "a" and "b" have the same shape`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var err = ValidatePieces(test.pieces, 4, 5)
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Errorf("ValidatePieces = %v, want %q", err, test.want)
+			}
+		})
+	}
+	var ok = []Piece{NewPiece("a", 'A', []Pos{{0, 0}, {0, 1}, {1, 1}}), NewPiece("b", 'B', []Pos{{0, 0}, {0, 1}, {0, 2}})}
+	if err := ValidatePieces(ok, 4, 5); err != nil {
+		t.Errorf("ValidatePieces of distinct pieces: %v", err)
+	}
+}